@@ -174,6 +174,7 @@ func IsSystemTx(tx sdk.Tx, isAuthorizedSigner func(string) bool) bool {
 		*crosschaintypes.MsgVoteInbound,
 		*crosschaintypes.MsgAddOutboundTracker,
 		*crosschaintypes.MsgAddInboundTracker,
+		*crosschaintypes.MsgConsolidateUTXOs,
 		*observertypes.MsgVoteBlockHeader,
 		*observertypes.MsgVoteTSS,
 		*observertypes.MsgVoteBlame:
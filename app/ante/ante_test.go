@@ -38,6 +38,7 @@ func TestIsSystemTx(t *testing.T) {
 	//		*cctxtypes.MsgVoteOutbound,
 	//		*cctxtypes.MsgAddOutboundTracker,
 	//		*cctxtypes.MsgAddInboundTracker,
+	//		*cctxtypes.MsgConsolidateUTXOs,
 	//		*observertypes.MsgVoteBlockHeader,
 	//		*observertypes.MsgVoteTSS,
 	//		*observertypes.MsgVoteBlame:
@@ -188,6 +189,24 @@ func TestIsSystemTx(t *testing.T) {
 
 			true,
 		},
+		{
+			"MsgConsolidateUTXOs",
+			buildTxFromMsg(&crosschaintypes.MsgConsolidateUTXOs{
+				Creator: sample.AccAddress(),
+			}),
+			isAuthorized,
+
+			true,
+		},
+		{
+			"MsgExec{MsgConsolidateUTXOs}",
+			buildAuthzTxFromMsg(&crosschaintypes.MsgConsolidateUTXOs{
+				Creator: sample.AccAddress(),
+			}),
+			isAuthorized,
+
+			true,
+		},
 		{
 			"MsgVoteTSS",
 			buildTxFromMsg(&observertypes.MsgVoteTSS{
@@ -541,6 +541,9 @@ func New(
 			&app.FungibleKeeper,
 			app.StakingKeeper,
 			app.BankKeeper,
+			app.DistrKeeper,
+			app.EmissionsKeeper,
+			&app.CrosschainKeeper,
 			appCodec,
 			storetypes.TransientGasConfig(),
 		),
@@ -0,0 +1,95 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/zeta-chain/node/zetaclient/config"
+)
+
+var rescanArgs = rescanArguments{}
+
+type rescanArguments struct {
+	chainID int64
+	from    uint64
+	to      uint64
+}
+
+var rescanCmd = &cobra.Command{
+	Use:   "rescan",
+	Short: "Backfill the missed inbounds of a chain over a block range using the admin API of the running zetaclient",
+	Long: `Replays the inbound scanning of a chain over the block range [from, to] and votes for the inbounds
+whose cctxs are not created yet. For Solana, the range is given in slots.
+The backfill runs in the background of the running zetaclient, its progress is reported in the zetaclient logs.`,
+	RunE: rescan,
+}
+
+func init() {
+	rescanCmd.Flags().Int64Var(&rescanArgs.chainID, "chain", 0, "chain id to backfill")
+	rescanCmd.Flags().Uint64Var(&rescanArgs.from, "from", 0, "first block to backfill")
+	rescanCmd.Flags().Uint64Var(&rescanArgs.to, "to", 0, "last block to backfill")
+
+	for _, flag := range []string{"chain", "from", "to"} {
+		if err := rescanCmd.MarkFlagRequired(flag); err != nil {
+			panic(err)
+		}
+	}
+
+	RootCmd.AddCommand(rescanCmd)
+}
+
+// rescan requests the admin API of the running zetaclient to backfill the missed inbounds
+func rescan(cmd *cobra.Command, _ []string) error {
+	if err := setHomeDir(); err != nil {
+		return err
+	}
+
+	cfg, err := config.Load(rootArgs.zetaCoreHome)
+	if err != nil {
+		return errors.Wrap(err, "failed to load config")
+	}
+	if !cfg.AdminAPI.Enabled() {
+		return errors.New("admin API is not enabled in the zetaclient config")
+	}
+
+	query := url.Values{}
+	query.Set("from", fmt.Sprint(rescanArgs.from))
+	query.Set("to", fmt.Sprint(rescanArgs.to))
+	target := fmt.Sprintf(
+		"http://127.0.0.1:%d/chains/%d/backfill?%s",
+		cfg.AdminAPI.Port,
+		rescanArgs.chainID,
+		query.Encode(),
+	)
+
+	req, err := http.NewRequestWithContext(cmd.Context(), http.MethodPost, target, nil)
+	if err != nil {
+		return errors.Wrap(err, "failed to create admin API request")
+	}
+	req.Header.Set("Authorization", "Bearer "+cfg.AdminAPI.Token)
+
+	client := &http.Client{Timeout: 10 * time.Second}
+	res, err := client.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "failed to call admin API")
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return errors.Wrap(err, "failed to read admin API response")
+	}
+	if res.StatusCode != http.StatusAccepted {
+		return fmt.Errorf("admin API returned status %d: %s", res.StatusCode, body)
+	}
+
+	fmt.Printf("inbound backfill started: %s\n", body)
+
+	return nil
+}
@@ -25,6 +25,7 @@ import (
 	"github.com/zeta-chain/node/pkg/constant"
 	zetaos "github.com/zeta-chain/node/pkg/os"
 	observerTypes "github.com/zeta-chain/node/x/observer/types"
+	"github.com/zeta-chain/node/zetaclient/admin"
 	"github.com/zeta-chain/node/zetaclient/chains/base"
 	"github.com/zeta-chain/node/zetaclient/config"
 	zctx "github.com/zeta-chain/node/zetaclient/context"
@@ -358,6 +359,23 @@ func start(_ *cobra.Command, _ []string) error {
 		return err
 	}
 
+	// Start the local admin API to inspect and steer the orchestrator
+	if cfg.AdminAPI.Enabled() {
+		adminServer, err := admin.NewServer(cfg.AdminAPI, appContext, maestro, logger.Std)
+		if err != nil {
+			startLogger.Error().Err(err).Msg("Unable to create admin API server")
+			return err
+		}
+
+		go func() {
+			if err := adminServer.Start(); err != nil {
+				startLogger.Error().Err(err).Msg("adminServer error")
+			}
+		}()
+		// the shutdown error is logged by Stop
+		defer func() { _ = adminServer.Stop() }()
+	}
+
 	// start zeta supply checker
 	// TODO: enable
 	// https://github.com/zeta-chain/node/issues/1354
@@ -23,6 +23,9 @@ const (
 	// CmdMigrateTssFunds is used for CCTX of type cmd to give the instruction to the TSS to transfer its funds on a new address
 	CmdMigrateTssFunds = "cmd_migrate_tss_funds"
 
+	// CmdConsolidateUTXOs is used for CCTX of type cmd to give the instruction to the TSS to consolidate its Bitcoin UTXOs
+	CmdConsolidateUTXOs = "cmd_consolidate_utxos"
+
 	// BTCWithdrawalDustAmount is the minimum satoshis that can be withdrawn from zEVM to avoid outbound dust output
 	// The Bitcoin protocol sets a minimum output value to 546 satoshis (dust limit) but we set it to 1000 satoshis
 	BTCWithdrawalDustAmount = 1000
@@ -0,0 +1,89 @@
+package solana
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/gagliardetto/solana-go"
+	"github.com/pkg/errors"
+)
+
+// AccountMeta is an account passed to the destination program of a gateway 'execute'
+type AccountMeta struct {
+	// PublicKey is the public key of the account
+	PublicKey [32]byte `json:"publicKey"`
+
+	// IsWritable is true if the account is writable by the destination program
+	IsWritable bool `json:"isWritable"`
+}
+
+// ExecuteMsg is the message relayed from ZEVM to call a Solana program through the gateway 'execute'.
+// It is ABI encoded as tuple((bytes32 publicKey, bool isWritable)[] accounts, bytes data).
+type ExecuteMsg struct {
+	// Accounts are the accounts passed to the destination program
+	Accounts []AccountMeta `json:"accounts"`
+
+	// Data is the instruction data passed to the destination program
+	Data []byte `json:"data"`
+}
+
+// executeMsgArguments returns the ABI arguments of the execute message
+func executeMsgArguments() (abi.Arguments, error) {
+	msgType, err := abi.NewType("tuple", "", []abi.ArgumentMarshaling{
+		{
+			Name: "accounts",
+			Type: "tuple[]",
+			Components: []abi.ArgumentMarshaling{
+				{Name: "publicKey", Type: "bytes32"},
+				{Name: "isWritable", Type: "bool"},
+			},
+		},
+		{Name: "data", Type: "bytes"},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return abi.Arguments{{Type: msgType}}, nil
+}
+
+// EncodeExecuteMsg ABI encodes the execute message
+func EncodeExecuteMsg(msg ExecuteMsg) ([]byte, error) {
+	args, err := executeMsgArguments()
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to create execute message ABI type")
+	}
+
+	return args.Pack(msg)
+}
+
+// DecodeExecuteMsg decodes the ABI encoded execute message
+func DecodeExecuteMsg(data []byte) (ExecuteMsg, error) {
+	args, err := executeMsgArguments()
+	if err != nil {
+		return ExecuteMsg{}, errors.Wrap(err, "unable to create execute message ABI type")
+	}
+
+	unpacked, err := args.Unpack(data)
+	if err != nil {
+		return ExecuteMsg{}, errors.Wrap(err, "unable to unpack execute message")
+	}
+
+	msg, ok := abi.ConvertType(unpacked[0], new(ExecuteMsg)).(*ExecuteMsg)
+	if !ok {
+		return ExecuteMsg{}, errors.New("unable to convert execute message")
+	}
+
+	return *msg, nil
+}
+
+// RemainingAccounts returns the accounts of the execute message as Solana account metas
+func (msg ExecuteMsg) RemainingAccounts() []*solana.AccountMeta {
+	accounts := make([]*solana.AccountMeta, 0, len(msg.Accounts))
+	for _, account := range msg.Accounts {
+		accounts = append(accounts, &solana.AccountMeta{
+			PublicKey:  solana.PublicKeyFromBytes(account.PublicKey[:]),
+			IsWritable: account.IsWritable,
+		})
+	}
+
+	return accounts
+}
@@ -0,0 +1,47 @@
+package solana_test
+
+import (
+	"testing"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/stretchr/testify/require"
+
+	contracts "github.com/zeta-chain/node/pkg/contracts/solana"
+)
+
+func Test_ExecuteMsg(t *testing.T) {
+	account1 := solana.MustPublicKeyFromBase58("37yGiHAnLvWZUNVwu9esp74YQFqxU1qHCbABkDvRddUQ")
+	account2 := solana.MustPublicKeyFromBase58("94U5AHQMKkV5txNJ17QPXWoh474PheGou6cNP2FEuL1d")
+
+	msg := contracts.ExecuteMsg{
+		Accounts: []contracts.AccountMeta{
+			{PublicKey: account1, IsWritable: true},
+			{PublicKey: account2, IsWritable: false},
+		},
+		Data: []byte("hello"),
+	}
+
+	t.Run("should encode and decode execute message", func(t *testing.T) {
+		encoded, err := contracts.EncodeExecuteMsg(msg)
+		require.NoError(t, err)
+
+		decoded, err := contracts.DecodeExecuteMsg(encoded)
+		require.NoError(t, err)
+		require.Equal(t, msg, decoded)
+	})
+
+	t.Run("should return remaining accounts", func(t *testing.T) {
+		accounts := msg.RemainingAccounts()
+		require.Len(t, accounts, 2)
+		require.Equal(t, account1, accounts[0].PublicKey)
+		require.True(t, accounts[0].IsWritable)
+		require.False(t, accounts[0].IsSigner)
+		require.Equal(t, account2, accounts[1].PublicKey)
+		require.False(t, accounts[1].IsWritable)
+	})
+
+	t.Run("should fail to decode invalid execute message", func(t *testing.T) {
+		_, err := contracts.DecodeExecuteMsg([]byte("invalid"))
+		require.ErrorContains(t, err, "unable to unpack execute message")
+	})
+}
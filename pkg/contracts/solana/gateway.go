@@ -16,6 +16,9 @@ const (
 	// AccountsNumberOfDeposit is the number of accounts required for Solana gateway deposit instruction
 	// [signer, pda, system_program]
 	AccountsNumDeposit = 3
+
+	// ErrorNonceMismatch is the error name returned by the gateway program when the nonce of an outbound mismatches
+	ErrorNonceMismatch = "NonceMismatch"
 )
 
 // DiscriminatorInitialize returns the discriminator for Solana gateway 'initialize' instruction
@@ -40,7 +43,17 @@ func DiscriminatorWithdraw() [8]byte {
 
 // DiscriminatorWithdrawSPL returns the discriminator for Solana gateway 'withdraw_spl_token' instruction
 func DiscriminatorWithdrawSPL() [8]byte {
-	return [8]byte{156, 234, 11, 89, 235, 246, 32}
+	return [8]byte{219, 156, 234, 11, 89, 235, 246, 32}
+}
+
+// DiscriminatorExecute returns the discriminator for Solana gateway 'execute' instruction
+func DiscriminatorExecute() [8]byte {
+	return [8]byte{130, 221, 242, 154, 13, 193, 189, 29}
+}
+
+// DiscriminatorIncrementNonce returns the discriminator for Solana gateway 'increment_nonce' instruction
+func DiscriminatorIncrementNonce() [8]byte {
+	return [8]byte{84, 149, 209, 233, 228, 66, 195, 237}
 }
 
 // ParseGatewayAddressAndPda parses the gateway id and program derived address from the given string
@@ -59,3 +72,13 @@ func ParseGatewayIDAndPda(address string) (solana.PublicKey, solana.PublicKey, e
 
 	return gatewayID, pda, err
 }
+
+// FindAssociatedTokenAccount returns the associated token account (ATA) of a wallet for the given SPL token mint
+func FindAssociatedTokenAccount(wallet solana.PublicKey, mint solana.PublicKey) (solana.PublicKey, error) {
+	ata, _, err := solana.FindAssociatedTokenAddress(wallet, mint)
+	if err != nil {
+		return ata, errors.Wrapf(err, "unable to find associated token account for wallet %s mint %s", wallet, mint)
+	}
+
+	return ata, nil
+}
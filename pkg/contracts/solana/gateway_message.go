@@ -8,7 +8,7 @@ import (
 	"github.com/gagliardetto/solana-go"
 )
 
-// MsgWithdraw is the message for the Solana gateway withdraw/withdraw_spl instruction
+// MsgWithdraw is the message for the Solana gateway withdraw instruction
 type MsgWithdraw struct {
 	// chainID is the chain ID of Solana chain
 	chainID uint64
@@ -105,3 +105,343 @@ func (msg *MsgWithdraw) Signer() (common.Address, error) {
 
 	return RecoverSigner(msgHash[:], msgSig[:])
 }
+
+// MsgWithdrawSPL is the message for the Solana gateway withdraw_spl_token instruction
+type MsgWithdrawSPL struct {
+	// chainID is the chain ID of Solana chain
+	chainID uint64
+
+	// Nonce is the nonce for the withdraw_spl_token
+	nonce uint64
+
+	// amount is the token amount (in smallest unit) for the withdraw_spl_token
+	amount uint64
+
+	// mintAccount is the mint address of the SPL token
+	mintAccount solana.PublicKey
+
+	// to is the recipient wallet address for the withdraw_spl_token
+	to solana.PublicKey
+
+	// recipientAta is the recipient's associated token account for the SPL token
+	recipientAta solana.PublicKey
+
+	// signature is the signature of the message
+	signature [65]byte
+}
+
+// NewMsgWithdrawSPL returns a new withdraw_spl_token message.
+// The recipient's associated token account is derived from the recipient wallet and the mint account.
+func NewMsgWithdrawSPL(chainID, nonce, amount uint64, mintAccount, to solana.PublicKey) (*MsgWithdrawSPL, error) {
+	recipientAta, err := FindAssociatedTokenAccount(to, mintAccount)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgWithdrawSPL{
+		chainID:      chainID,
+		nonce:        nonce,
+		amount:       amount,
+		mintAccount:  mintAccount,
+		to:           to,
+		recipientAta: recipientAta,
+	}, nil
+}
+
+// ChainID returns the chain ID of the message
+func (msg *MsgWithdrawSPL) ChainID() uint64 {
+	return msg.chainID
+}
+
+// Nonce returns the nonce of the message
+func (msg *MsgWithdrawSPL) Nonce() uint64 {
+	return msg.nonce
+}
+
+// Amount returns the amount of the message
+func (msg *MsgWithdrawSPL) Amount() uint64 {
+	return msg.amount
+}
+
+// MintAccount returns the mint account of the SPL token
+func (msg *MsgWithdrawSPL) MintAccount() solana.PublicKey {
+	return msg.mintAccount
+}
+
+// To returns the recipient wallet address of the message
+func (msg *MsgWithdrawSPL) To() solana.PublicKey {
+	return msg.to
+}
+
+// RecipientAta returns the recipient's associated token account of the message
+func (msg *MsgWithdrawSPL) RecipientAta() solana.PublicKey {
+	return msg.recipientAta
+}
+
+// Hash packs the withdraw_spl_token message and computes the hash
+func (msg *MsgWithdrawSPL) Hash() [32]byte {
+	var message []byte
+	buff := make([]byte, 8)
+
+	binary.BigEndian.PutUint64(buff, msg.chainID)
+	message = append(message, buff...)
+
+	binary.BigEndian.PutUint64(buff, msg.nonce)
+	message = append(message, buff...)
+
+	binary.BigEndian.PutUint64(buff, msg.amount)
+	message = append(message, buff...)
+
+	message = append(message, msg.mintAccount.Bytes()...)
+
+	message = append(message, msg.recipientAta.Bytes()...)
+
+	return crypto.Keccak256Hash(message)
+}
+
+// SetSignature attaches the signature to the message
+func (msg *MsgWithdrawSPL) SetSignature(signature [65]byte) *MsgWithdrawSPL {
+	msg.signature = signature
+	return msg
+}
+
+// SigRSV returns the full 65-byte [R+S+V] signature
+func (msg *MsgWithdrawSPL) SigRSV() [65]byte {
+	return msg.signature
+}
+
+// SigRS returns the 64-byte [R+S] core part of the signature
+func (msg *MsgWithdrawSPL) SigRS() [64]byte {
+	var sig [64]byte
+	copy(sig[:], msg.signature[:64])
+	return sig
+}
+
+// SigV returns the V part (recovery ID) of the signature
+func (msg *MsgWithdrawSPL) SigV() uint8 {
+	return msg.signature[64]
+}
+
+// Signer returns the signer of the message
+func (msg *MsgWithdrawSPL) Signer() (common.Address, error) {
+	msgHash := msg.Hash()
+	msgSig := msg.SigRSV()
+
+	return RecoverSigner(msgHash[:], msgSig[:])
+}
+
+// MsgExecute is the message for the Solana gateway execute instruction
+type MsgExecute struct {
+	// chainID is the chain ID of Solana chain
+	chainID uint64
+
+	// nonce is the nonce for the execute
+	nonce uint64
+
+	// amount is the lamports amount transferred to the destination program for the execute
+	amount uint64
+
+	// to is the destination program to call
+	to solana.PublicKey
+
+	// sender is the ZEVM address that initiated the call
+	sender common.Address
+
+	// data is the instruction data passed to the destination program
+	data []byte
+
+	// remainingAccounts are the accounts passed to the destination program
+	remainingAccounts []*solana.AccountMeta
+
+	// signature is the signature of the message
+	signature [65]byte
+}
+
+// NewMsgExecute returns a new execute message
+func NewMsgExecute(
+	chainID, nonce, amount uint64,
+	to solana.PublicKey,
+	sender common.Address,
+	data []byte,
+	remainingAccounts []*solana.AccountMeta,
+) *MsgExecute {
+	return &MsgExecute{
+		chainID:           chainID,
+		nonce:             nonce,
+		amount:            amount,
+		to:                to,
+		sender:            sender,
+		data:              data,
+		remainingAccounts: remainingAccounts,
+	}
+}
+
+// ChainID returns the chain ID of the message
+func (msg *MsgExecute) ChainID() uint64 {
+	return msg.chainID
+}
+
+// Nonce returns the nonce of the message
+func (msg *MsgExecute) Nonce() uint64 {
+	return msg.nonce
+}
+
+// Amount returns the amount of the message
+func (msg *MsgExecute) Amount() uint64 {
+	return msg.amount
+}
+
+// To returns the destination program of the message
+func (msg *MsgExecute) To() solana.PublicKey {
+	return msg.to
+}
+
+// Sender returns the ZEVM sender of the message
+func (msg *MsgExecute) Sender() common.Address {
+	return msg.sender
+}
+
+// Data returns the instruction data passed to the destination program
+func (msg *MsgExecute) Data() []byte {
+	return msg.data
+}
+
+// RemainingAccounts returns the accounts passed to the destination program
+func (msg *MsgExecute) RemainingAccounts() []*solana.AccountMeta {
+	return msg.remainingAccounts
+}
+
+// Hash packs the execute message and computes the hash.
+// The instruction discriminator is prepended to separate the message from other gateway messages.
+func (msg *MsgExecute) Hash() [32]byte {
+	discriminator := DiscriminatorExecute()
+	message := append([]byte{}, discriminator[:]...)
+	buff := make([]byte, 8)
+
+	binary.BigEndian.PutUint64(buff, msg.chainID)
+	message = append(message, buff...)
+
+	binary.BigEndian.PutUint64(buff, msg.nonce)
+	message = append(message, buff...)
+
+	binary.BigEndian.PutUint64(buff, msg.amount)
+	message = append(message, buff...)
+
+	message = append(message, msg.to.Bytes()...)
+
+	message = append(message, msg.sender.Bytes()...)
+
+	message = append(message, msg.data...)
+
+	return crypto.Keccak256Hash(message)
+}
+
+// SetSignature attaches the signature to the message
+func (msg *MsgExecute) SetSignature(signature [65]byte) *MsgExecute {
+	msg.signature = signature
+	return msg
+}
+
+// SigRSV returns the full 65-byte [R+S+V] signature
+func (msg *MsgExecute) SigRSV() [65]byte {
+	return msg.signature
+}
+
+// SigRS returns the 64-byte [R+S] core part of the signature
+func (msg *MsgExecute) SigRS() [64]byte {
+	var sig [64]byte
+	copy(sig[:], msg.signature[:64])
+	return sig
+}
+
+// SigV returns the V part (recovery ID) of the signature
+func (msg *MsgExecute) SigV() uint8 {
+	return msg.signature[64]
+}
+
+// Signer returns the signer of the message
+func (msg *MsgExecute) Signer() (common.Address, error) {
+	msgHash := msg.Hash()
+	msgSig := msg.SigRSV()
+
+	return RecoverSigner(msgHash[:], msgSig[:])
+}
+
+// MsgIncrementNonce is the message for the Solana gateway increment_nonce instruction.
+// It consumes the nonce of an outbound that can't be executed (e.g. a failing 'execute'),
+// so the outbound can be reverted.
+type MsgIncrementNonce struct {
+	// chainID is the chain ID of Solana chain
+	chainID uint64
+
+	// nonce is the nonce to consume
+	nonce uint64
+
+	// signature is the signature of the message
+	signature [65]byte
+}
+
+// NewMsgIncrementNonce returns a new increment_nonce message
+func NewMsgIncrementNonce(chainID, nonce uint64) *MsgIncrementNonce {
+	return &MsgIncrementNonce{
+		chainID: chainID,
+		nonce:   nonce,
+	}
+}
+
+// ChainID returns the chain ID of the message
+func (msg *MsgIncrementNonce) ChainID() uint64 {
+	return msg.chainID
+}
+
+// Nonce returns the nonce of the message
+func (msg *MsgIncrementNonce) Nonce() uint64 {
+	return msg.nonce
+}
+
+// Hash packs the increment_nonce message and computes the hash.
+// The instruction discriminator is prepended to separate the message from other gateway messages.
+func (msg *MsgIncrementNonce) Hash() [32]byte {
+	discriminator := DiscriminatorIncrementNonce()
+	message := append([]byte{}, discriminator[:]...)
+	buff := make([]byte, 8)
+
+	binary.BigEndian.PutUint64(buff, msg.chainID)
+	message = append(message, buff...)
+
+	binary.BigEndian.PutUint64(buff, msg.nonce)
+	message = append(message, buff...)
+
+	return crypto.Keccak256Hash(message)
+}
+
+// SetSignature attaches the signature to the message
+func (msg *MsgIncrementNonce) SetSignature(signature [65]byte) *MsgIncrementNonce {
+	msg.signature = signature
+	return msg
+}
+
+// SigRSV returns the full 65-byte [R+S+V] signature
+func (msg *MsgIncrementNonce) SigRSV() [65]byte {
+	return msg.signature
+}
+
+// SigRS returns the 64-byte [R+S] core part of the signature
+func (msg *MsgIncrementNonce) SigRS() [64]byte {
+	var sig [64]byte
+	copy(sig[:], msg.signature[:64])
+	return sig
+}
+
+// SigV returns the V part (recovery ID) of the signature
+func (msg *MsgIncrementNonce) SigV() uint8 {
+	return msg.signature[64]
+}
+
+// Signer returns the signer of the message
+func (msg *MsgIncrementNonce) Signer() (common.Address, error) {
+	msgHash := msg.Hash()
+	msgSig := msg.SigRSV()
+
+	return RecoverSigner(msgHash[:], msgSig[:])
+}
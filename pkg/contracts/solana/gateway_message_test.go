@@ -7,6 +7,8 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/gagliardetto/solana-go"
 	"github.com/zeta-chain/node/pkg/chains"
 	contracts "github.com/zeta-chain/node/pkg/contracts/solana"
@@ -29,3 +31,120 @@ func Test_MsgWithdrawHash(t *testing.T) {
 		require.True(t, bytes.Equal(hash[:], wantHashBytes))
 	})
 }
+
+func Test_MsgWithdrawSPL(t *testing.T) {
+	// #nosec G115 always positive
+	chainID := uint64(chains.SolanaLocalnet.ChainId)
+	nonce := uint64(0)
+	amount := uint64(1336000)
+	mint := solana.MustPublicKeyFromBase58("4zMMC9srt5Ri5X14GAgXhaHii3GnPAEERYPJgZJDncDU")
+	to := solana.MustPublicKeyFromBase58("37yGiHAnLvWZUNVwu9esp74YQFqxU1qHCbABkDvRddUQ")
+
+	t.Run("should derive recipient associated token account", func(t *testing.T) {
+		msg, err := contracts.NewMsgWithdrawSPL(chainID, nonce, amount, mint, to)
+		require.NoError(t, err)
+
+		wantAta, _, err := solana.FindAssociatedTokenAddress(to, mint)
+		require.NoError(t, err)
+		require.Equal(t, wantAta, msg.RecipientAta())
+		require.Equal(t, mint, msg.MintAccount())
+		require.Equal(t, to, msg.To())
+	})
+
+	t.Run("should hash mint account and recipient associated token account", func(t *testing.T) {
+		msg, err := contracts.NewMsgWithdrawSPL(chainID, nonce, amount, mint, to)
+		require.NoError(t, err)
+
+		// the hash should differ from the one of a SOL withdraw message
+		hash := msg.Hash()
+		hashSOL := contracts.NewMsgWithdraw(chainID, nonce, amount, to).Hash()
+		require.NotEqual(t, hashSOL, hash)
+
+		// the hash should change with the mint account
+		msgOther, err := contracts.NewMsgWithdrawSPL(chainID, nonce, amount, to, mint)
+		require.NoError(t, err)
+		require.NotEqual(t, msgOther.Hash(), hash)
+	})
+
+	t.Run("should recover signer", func(t *testing.T) {
+		privKey, err := crypto.GenerateKey()
+		require.NoError(t, err)
+
+		msg, err := contracts.NewMsgWithdrawSPL(chainID, nonce, amount, mint, to)
+		require.NoError(t, err)
+		hash := msg.Hash()
+
+		sig, err := crypto.Sign(hash[:], privKey)
+		require.NoError(t, err)
+
+		var signature [65]byte
+		copy(signature[:], sig)
+		signer, err := msg.SetSignature(signature).Signer()
+		require.NoError(t, err)
+		require.Equal(t, crypto.PubkeyToAddress(privKey.PublicKey), signer)
+	})
+}
+
+func Test_MsgExecute(t *testing.T) {
+	// #nosec G115 always positive
+	chainID := uint64(chains.SolanaLocalnet.ChainId)
+	program := solana.MustPublicKeyFromBase58("4zMMC9srt5Ri5X14GAgXhaHii3GnPAEERYPJgZJDncDU")
+	sender := common.HexToAddress("0xaD32427bA235a8350b7805C1b85147c8ea03F437")
+	accounts := []*solana.AccountMeta{
+		solana.Meta(solana.MustPublicKeyFromBase58("37yGiHAnLvWZUNVwu9esp74YQFqxU1qHCbABkDvRddUQ")).WRITE(),
+	}
+
+	t.Run("should hash sender and data", func(t *testing.T) {
+		msg := contracts.NewMsgExecute(chainID, 1, 1000, program, sender, []byte("hello"), accounts)
+		hash := msg.Hash()
+
+		msgOtherData := contracts.NewMsgExecute(chainID, 1, 1000, program, sender, []byte("world"), accounts)
+		require.NotEqual(t, msgOtherData.Hash(), hash)
+
+		msgOtherSender := contracts.NewMsgExecute(chainID, 1, 1000, program, common.Address{}, []byte("hello"), accounts)
+		require.NotEqual(t, msgOtherSender.Hash(), hash)
+	})
+
+	t.Run("should recover signer", func(t *testing.T) {
+		privKey, err := crypto.GenerateKey()
+		require.NoError(t, err)
+
+		msg := contracts.NewMsgExecute(chainID, 1, 1000, program, sender, []byte("hello"), accounts)
+		hash := msg.Hash()
+		sig, err := crypto.Sign(hash[:], privKey)
+		require.NoError(t, err)
+
+		var signature [65]byte
+		copy(signature[:], sig)
+		signer, err := msg.SetSignature(signature).Signer()
+		require.NoError(t, err)
+		require.Equal(t, crypto.PubkeyToAddress(privKey.PublicKey), signer)
+	})
+}
+
+func Test_MsgIncrementNonce(t *testing.T) {
+	// #nosec G115 always positive
+	chainID := uint64(chains.SolanaLocalnet.ChainId)
+
+	t.Run("should hash chain ID and nonce", func(t *testing.T) {
+		hash := contracts.NewMsgIncrementNonce(chainID, 1).Hash()
+		require.NotEqual(t, contracts.NewMsgIncrementNonce(chainID, 2).Hash(), hash)
+		require.NotEqual(t, contracts.NewMsgIncrementNonce(chainID+1, 1).Hash(), hash)
+	})
+
+	t.Run("should recover signer", func(t *testing.T) {
+		privKey, err := crypto.GenerateKey()
+		require.NoError(t, err)
+
+		msg := contracts.NewMsgIncrementNonce(chainID, 1)
+		hash := msg.Hash()
+		sig, err := crypto.Sign(hash[:], privKey)
+		require.NoError(t, err)
+
+		var signature [65]byte
+		copy(signature[:], sig)
+		signer, err := msg.SetSignature(signature).Signer()
+		require.NoError(t, err)
+		require.Equal(t, crypto.PubkeyToAddress(privKey.PublicKey), signer)
+	})
+}
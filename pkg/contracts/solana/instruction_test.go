@@ -6,6 +6,8 @@ import (
 	"github.com/stretchr/testify/require"
 
 	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/gagliardetto/solana-go"
+	"github.com/near/borsh-go"
 	contracts "github.com/zeta-chain/node/pkg/contracts/solana"
 )
 
@@ -78,3 +80,134 @@ func Test_RecoverSigner(t *testing.T) {
 	require.NotEqual(t, ethcommon.Address{}, signer)
 	require.NotEqual(t, testSigner, signer.String())
 }
+
+func Test_ParseInstructionWithdrawSPL(t *testing.T) {
+	var sigRS [64]byte
+	sigTest := getTestSignature()
+	copy(sigRS[:], sigTest[:64])
+
+	params := contracts.WithdrawSPLInstructionParams{
+		Discriminator: contracts.DiscriminatorWithdrawSPL(),
+		Amount:        1336000,
+		Signature:     sigRS,
+		RecoveryID:    0,
+		MessageHash:   getTestmessageHash(),
+		Nonce:         2,
+	}
+
+	t.Run("should parse instruction withdraw_spl_token", func(t *testing.T) {
+		data, err := borsh.Serialize(params)
+		require.NoError(t, err)
+
+		inst, err := contracts.ParseInstructionWithdrawSPL(solana.CompiledInstruction{Data: data})
+		require.NoError(t, err)
+
+		// check sender, nonce and amount
+		sender, err := inst.Signer()
+		require.NoError(t, err)
+		require.Equal(t, testSigner, sender.String())
+		require.EqualValues(t, 2, inst.GatewayNonce())
+		require.EqualValues(t, 1336000, inst.TokenAmount())
+	})
+
+	t.Run("should return error on invalid instruction data", func(t *testing.T) {
+		inst, err := contracts.ParseInstructionWithdrawSPL(solana.CompiledInstruction{Data: []byte("invalid")})
+		require.ErrorContains(t, err, "error deserializing instruction")
+		require.Nil(t, inst)
+	})
+
+	t.Run("should return error on discriminator mismatch", func(t *testing.T) {
+		paramsWithdraw := params
+		paramsWithdraw.Discriminator = contracts.DiscriminatorWithdraw()
+		data, err := borsh.Serialize(paramsWithdraw)
+		require.NoError(t, err)
+
+		inst, err := contracts.ParseInstructionWithdrawSPL(solana.CompiledInstruction{Data: data})
+		require.ErrorContains(t, err, "not a withdraw_spl_token instruction")
+		require.Nil(t, inst)
+	})
+}
+
+func Test_ParseInstructionExecute(t *testing.T) {
+	var sigRS [64]byte
+	sigTest := getTestSignature()
+	copy(sigRS[:], sigTest[:64])
+
+	params := contracts.ExecuteInstructionParams{
+		Discriminator: contracts.DiscriminatorExecute(),
+		Amount:        1336000,
+		Sender:        ethcommon.HexToAddress(testSigner),
+		Data:          []byte("hello"),
+		Signature:     sigRS,
+		RecoveryID:    0,
+		MessageHash:   getTestmessageHash(),
+		Nonce:         3,
+	}
+
+	t.Run("should parse instruction execute", func(t *testing.T) {
+		data, err := borsh.Serialize(params)
+		require.NoError(t, err)
+
+		inst, err := contracts.ParseInstructionExecute(solana.CompiledInstruction{Data: data})
+		require.NoError(t, err)
+		require.Equal(t, []byte("hello"), inst.Data)
+
+		// check sender, nonce and amount
+		sender, err := inst.Signer()
+		require.NoError(t, err)
+		require.Equal(t, testSigner, sender.String())
+		require.EqualValues(t, 3, inst.GatewayNonce())
+		require.EqualValues(t, 1336000, inst.TokenAmount())
+	})
+
+	t.Run("should return error on discriminator mismatch", func(t *testing.T) {
+		paramsOther := params
+		paramsOther.Discriminator = contracts.DiscriminatorWithdraw()
+		data, err := borsh.Serialize(paramsOther)
+		require.NoError(t, err)
+
+		inst, err := contracts.ParseInstructionExecute(solana.CompiledInstruction{Data: data})
+		require.ErrorContains(t, err, "not an execute instruction")
+		require.Nil(t, inst)
+	})
+}
+
+func Test_ParseInstructionIncrementNonce(t *testing.T) {
+	var sigRS [64]byte
+	sigTest := getTestSignature()
+	copy(sigRS[:], sigTest[:64])
+
+	params := contracts.IncrementNonceInstructionParams{
+		Discriminator: contracts.DiscriminatorIncrementNonce(),
+		Signature:     sigRS,
+		RecoveryID:    0,
+		MessageHash:   getTestmessageHash(),
+		Nonce:         4,
+	}
+
+	t.Run("should parse instruction increment_nonce", func(t *testing.T) {
+		data, err := borsh.Serialize(params)
+		require.NoError(t, err)
+
+		inst, err := contracts.ParseInstructionIncrementNonce(solana.CompiledInstruction{Data: data})
+		require.NoError(t, err)
+
+		// check sender, nonce and amount
+		sender, err := inst.Signer()
+		require.NoError(t, err)
+		require.Equal(t, testSigner, sender.String())
+		require.EqualValues(t, 4, inst.GatewayNonce())
+		require.Zero(t, inst.TokenAmount())
+	})
+
+	t.Run("should return error on discriminator mismatch", func(t *testing.T) {
+		paramsOther := params
+		paramsOther.Discriminator = contracts.DiscriminatorExecute()
+		data, err := borsh.Serialize(paramsOther)
+		require.NoError(t, err)
+
+		inst, err := contracts.ParseInstructionIncrementNonce(solana.CompiledInstruction{Data: data})
+		require.ErrorContains(t, err, "not an increment_nonce instruction")
+		require.Nil(t, inst)
+	})
+}
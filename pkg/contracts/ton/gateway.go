@@ -15,8 +15,8 @@ import (
 //
 // Gateway.ParseTransaction parses Gateway transaction.
 // The parser reads tx body cell and decodes it based on Operation code (op)
-//   - inbound transactions: deposit, donate, depositAndCall
-//   - outbound transactions: not implemented yet
+//   - inbound transactions: deposit, donate, depositAndCall, jetton deposit (TEP-74 transfer_notification)
+//   - outbound transactions: withdraw, withdrawJetton, withdrawAndCall
 //   - errors for all other transactions
 //
 // `Send*` methods work the same way by constructing (& signing) tx body cell that is expected by the contract
@@ -22,7 +22,36 @@ const (
 	OpDepositAndCall
 )
 
-const OpWithdraw Op = 200
+// Outbound operations
+const (
+	OpWithdraw Op = 200 + iota
+	OpWithdrawJetton
+	OpWithdrawAndCall
+)
+
+// TEP-74 jetton operations
+// https://github.com/ton-blockchain/TEPs/blob/master/text/0074-jettons-standard.md
+const (
+	OpJettonTransfer             Op = 0x0f8a7ea5
+	OpJettonTransferNotification Op = 0x7362d09c
+)
+
+// OutboundMsg represents an external message signed by TSS and processed by the Gateway
+// (withdrawal, jetton withdrawal or withdrawal and call)
+type OutboundMsg interface {
+	ExternalMsg
+	Hash() ([32]byte, error)
+	SetSignature(sig [65]byte)
+	Signer() (eth.Address, error)
+	GetSeqno() uint32
+	GetAmount() math.Uint
+}
+
+var (
+	_ OutboundMsg = (*Withdrawal)(nil)
+	_ OutboundMsg = (*JettonWithdrawal)(nil)
+	_ OutboundMsg = (*WithdrawalAndCall)(nil)
+)
 
 // Donation represents a donation operation
 type Donation struct {
@@ -84,6 +113,66 @@ func (d DepositAndCall) AsBody() (*boc.Cell, error) {
 	return b, writeDepositAndCallBody(b, d.Recipient, d.CallData)
 }
 
+// JettonDeposit represents a jetton (TEP-74) deposit with optional call data.
+// The Gateway receives transfer_notification from its own jetton wallet,
+// thus the wallet address identifies the jetton and is used as the asset of the deposit.
+type JettonDeposit struct {
+	Deposit
+	Jetton   ton.AccountID
+	CallData []byte
+}
+
+// Memo casts jetton deposit to memo bytes
+func (d JettonDeposit) Memo() []byte {
+	if len(d.CallData) == 0 {
+		return d.Deposit.Memo()
+	}
+
+	return DepositAndCall{Deposit: d.Deposit, CallData: d.CallData}.Memo()
+}
+
+// AsBody casts struct to transfer_notification body sent by the jetton wallet.
+func (d JettonDeposit) AsBody() (*boc.Cell, error) {
+	forwardPayload := boc.NewCell()
+
+	var err error
+	if len(d.CallData) == 0 {
+		err = ErrCollect(
+			forwardPayload.WriteUint(uint64(OpDeposit), sizeOpCode),
+			forwardPayload.WriteBytes(d.Recipient.Bytes()),
+		)
+	} else {
+		callDataCell, errCell := MarshalSnakeCell(d.CallData)
+		if errCell != nil {
+			return nil, errCell
+		}
+
+		err = ErrCollect(
+			forwardPayload.WriteUint(uint64(OpDepositAndCall), sizeOpCode),
+			forwardPayload.WriteBytes(d.Recipient.Bytes()),
+			forwardPayload.AddRef(callDataCell),
+		)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	// transfer_notification#7362d09c query_id:uint64 amount:(VarUInteger 16)
+	//   sender:MsgAddress forward_payload:(Either Cell ^Cell)
+	b := boc.NewCell()
+	err = ErrCollect(
+		b.WriteUint(uint64(OpJettonTransferNotification), sizeOpCode),
+		b.WriteUint(0, sizeQueryID),
+		tlb.Marshal(b, uintToVarUInteger16(d.Amount)),
+		tlb.Marshal(b, d.Sender.ToMsgAddress()),
+		b.WriteBit(true),
+		b.AddRef(forwardPayload),
+	)
+
+	return b, err
+}
+
 func writeDepositBody(b *boc.Cell, recipient eth.Address) error {
 	return ErrCollect(
 		b.WriteUint(uint64(OpDeposit), sizeOpCode),
@@ -145,36 +234,219 @@ func (w *Withdrawal) Signer() (eth.Address, error) {
 		return eth.Address{}, err
 	}
 
-	var sig [65]byte
-	copy(sig[:], w.Sig[:])
+	return recoverSigner(hash, w.Sig)
+}
 
-	// recovery id
-	// https://bitcoin.stackexchange.com/questions/38351/ecdsa-v-r-s-what-is-v
-	if sig[64] >= 27 {
-		sig[64] -= 27
+// GetSeqno returns the seqno (nonce) of the withdrawal.
+func (w *Withdrawal) GetSeqno() uint32 {
+	return w.Seqno
+}
+
+// GetAmount returns the withdrawal amount.
+func (w *Withdrawal) GetAmount() math.Uint {
+	return w.Amount
+}
+
+func (w *Withdrawal) AsBody() (*boc.Cell, error) {
+	payload, err := w.payload()
+	if err != nil {
+		return nil, err
 	}
 
-	pub, err := crypto.SigToPub(hash[:], sig[:])
+	return externalMsgBody(payload, w.Sig)
+}
+
+func (w *Withdrawal) payload() (*boc.Cell, error) {
+	payload := boc.NewCell()
+
+	err := ErrCollect(
+		payload.WriteUint(uint64(OpWithdraw), sizeOpCode),
+		tlb.Marshal(payload, w.Recipient.ToMsgAddress()),
+		tlb.Marshal(payload, tlb.Coins(w.Amount.Uint64())),
+		payload.WriteUint(uint64(w.Seqno), sizeSeqno),
+	)
+
+	if err != nil {
+		return nil, errors.New("unable to marshal payload as cell")
+	}
+
+	return payload, nil
+}
+
+// JettonWithdrawal represents a jetton withdrawal external message.
+// Jetton is the Gateway's jetton wallet that transfers jettons to the recipient.
+// ForwardFee is the amount of TON attached by the Gateway to pay for the jetton transfer.
+type JettonWithdrawal struct {
+	Jetton     ton.AccountID
+	Recipient  ton.AccountID
+	Amount     math.Uint
+	ForwardFee math.Uint
+	Seqno      uint32
+	Sig        [65]byte
+}
+
+func (w *JettonWithdrawal) emptySig() bool {
+	return w.Sig == [65]byte{}
+}
+
+// Hash returns hash of the jetton withdrawal message. (used for signing)
+func (w *JettonWithdrawal) Hash() ([32]byte, error) {
+	payload, err := w.payload()
+	if err != nil {
+		return [32]byte{}, err
+	}
+
+	return payload.Hash256()
+}
+
+// SetSignature sets signature to the jetton withdrawal message.
+// Note that signature has the following order: [R, S, V (recovery ID)]
+func (w *JettonWithdrawal) SetSignature(sig [65]byte) {
+	copy(w.Sig[:], sig[:])
+}
+
+// Signer returns EVM address of the signer (e.g. TSS)
+func (w *JettonWithdrawal) Signer() (eth.Address, error) {
+	hash, err := w.Hash()
 	if err != nil {
 		return eth.Address{}, err
 	}
 
-	return crypto.PubkeyToAddress(*pub), nil
+	return recoverSigner(hash, w.Sig)
 }
 
-func (w *Withdrawal) AsBody() (*boc.Cell, error) {
+// GetSeqno returns the seqno (nonce) of the jetton withdrawal.
+func (w *JettonWithdrawal) GetSeqno() uint32 {
+	return w.Seqno
+}
+
+// GetAmount returns the amount of jettons to withdraw.
+func (w *JettonWithdrawal) GetAmount() math.Uint {
+	return w.Amount
+}
+
+func (w *JettonWithdrawal) AsBody() (*boc.Cell, error) {
 	payload, err := w.payload()
 	if err != nil {
 		return nil, err
 	}
 
+	return externalMsgBody(payload, w.Sig)
+}
+
+func (w *JettonWithdrawal) payload() (*boc.Cell, error) {
+	payload := boc.NewCell()
+
+	err := ErrCollect(
+		payload.WriteUint(uint64(OpWithdrawJetton), sizeOpCode),
+		tlb.Marshal(payload, w.Jetton.ToMsgAddress()),
+		tlb.Marshal(payload, w.Recipient.ToMsgAddress()),
+		tlb.Marshal(payload, uintToVarUInteger16(w.Amount)),
+		payload.WriteUint(uint64(w.Seqno), sizeSeqno),
+		tlb.Marshal(payload, tlb.Coins(w.ForwardFee.Uint64())),
+	)
+
+	if err != nil {
+		return nil, errors.New("unable to marshal payload as cell")
+	}
+
+	return payload, nil
+}
+
+// WithdrawalAndCall represents a withdrawal external message that forwards
+// TON and the call data (as message body) to the recipient contract.
+// ForwardFee is the amount of TON attached on top of Amount to pay for the recipient's execution.
+type WithdrawalAndCall struct {
+	Recipient  ton.AccountID
+	Amount     math.Uint
+	ForwardFee math.Uint
+	Seqno      uint32
+	CallData   []byte
+	Sig        [65]byte
+}
+
+func (w *WithdrawalAndCall) emptySig() bool {
+	return w.Sig == [65]byte{}
+}
+
+// Hash returns hash of the withdrawal and call message. (used for signing)
+func (w *WithdrawalAndCall) Hash() ([32]byte, error) {
+	payload, err := w.payload()
+	if err != nil {
+		return [32]byte{}, err
+	}
+
+	return payload.Hash256()
+}
+
+// SetSignature sets signature to the withdrawal and call message.
+// Note that signature has the following order: [R, S, V (recovery ID)]
+func (w *WithdrawalAndCall) SetSignature(sig [65]byte) {
+	copy(w.Sig[:], sig[:])
+}
+
+// Signer returns EVM address of the signer (e.g. TSS)
+func (w *WithdrawalAndCall) Signer() (eth.Address, error) {
+	hash, err := w.Hash()
+	if err != nil {
+		return eth.Address{}, err
+	}
+
+	return recoverSigner(hash, w.Sig)
+}
+
+// GetSeqno returns the seqno (nonce) of the withdrawal and call.
+func (w *WithdrawalAndCall) GetSeqno() uint32 {
+	return w.Seqno
+}
+
+// GetAmount returns the amount of TON forwarded to the recipient.
+func (w *WithdrawalAndCall) GetAmount() math.Uint {
+	return w.Amount
+}
+
+func (w *WithdrawalAndCall) AsBody() (*boc.Cell, error) {
+	payload, err := w.payload()
+	if err != nil {
+		return nil, err
+	}
+
+	return externalMsgBody(payload, w.Sig)
+}
+
+func (w *WithdrawalAndCall) payload() (*boc.Cell, error) {
+	callDataCell, err := MarshalSnakeCell(w.CallData)
+	if err != nil {
+		return nil, errors.New("unable to marshal call data as cell")
+	}
+
+	payload := boc.NewCell()
+
+	err = ErrCollect(
+		payload.WriteUint(uint64(OpWithdrawAndCall), sizeOpCode),
+		tlb.Marshal(payload, w.Recipient.ToMsgAddress()),
+		tlb.Marshal(payload, tlb.Coins(w.Amount.Uint64())),
+		payload.WriteUint(uint64(w.Seqno), sizeSeqno),
+		tlb.Marshal(payload, tlb.Coins(w.ForwardFee.Uint64())),
+		payload.AddRef(callDataCell),
+	)
+
+	if err != nil {
+		return nil, errors.New("unable to marshal payload as cell")
+	}
+
+	return payload, nil
+}
+
+// externalMsgBody composes external message body: signature + payload as a cell ref
+func externalMsgBody(payload *boc.Cell, sig [65]byte) (*boc.Cell, error) {
 	var (
 		body    = boc.NewCell()
-		v, r, s = splitSignature(w.Sig)
+		v, r, s = splitSignature(sig)
 	)
 
 	// note that in TVM, the order of signature is different (v, r, s)
-	err = ErrCollect(
+	err := ErrCollect(
 		body.WriteUint(uint64(v), 8),
 		body.WriteBytes(r[:]),
 		body.WriteBytes(s[:]),
@@ -187,21 +459,23 @@ func (w *Withdrawal) AsBody() (*boc.Cell, error) {
 	return body, nil
 }
 
-func (w *Withdrawal) payload() (*boc.Cell, error) {
-	payload := boc.NewCell()
+// recoverSigner returns EVM address of the message signer
+func recoverSigner(hash [32]byte, signature [65]byte) (eth.Address, error) {
+	var sig [65]byte
+	copy(sig[:], signature[:])
 
-	err := ErrCollect(
-		payload.WriteUint(uint64(OpWithdraw), sizeOpCode),
-		tlb.Marshal(payload, w.Recipient.ToMsgAddress()),
-		tlb.Marshal(payload, tlb.Coins(w.Amount.Uint64())),
-		payload.WriteUint(uint64(w.Seqno), sizeSeqno),
-	)
+	// recovery id
+	// https://bitcoin.stackexchange.com/questions/38351/ecdsa-v-r-s-what-is-v
+	if sig[64] >= 27 {
+		sig[64] -= 27
+	}
 
+	pub, err := crypto.SigToPub(hash[:], sig[:])
 	if err != nil {
-		return nil, errors.New("unable to marshal payload as cell")
+		return eth.Address{}, err
 	}
 
-	return payload, nil
+	return crypto.PubkeyToAddress(*pub), nil
 }
 
 // Ton Virtual Machine (TVM) uses different order of signature params (v,r,s) instead of (r,s,v);
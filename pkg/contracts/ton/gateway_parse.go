@@ -1,6 +1,8 @@
 package ton
 
 import (
+	"fmt"
+
 	"cosmossdk.io/math"
 	"github.com/pkg/errors"
 	"github.com/tonkeeper/tongo/boc"
@@ -55,6 +57,9 @@ func (gw *Gateway) parseInbound(tx ton.Transaction) (*Transaction, error) {
 		content, errContent = parseDeposit(tx, sender, body)
 	case OpDepositAndCall:
 		content, errContent = parseDepositAndCall(tx, sender, body)
+	case OpJettonTransferNotification:
+		// jettons are sent by the gateway's jetton wallet
+		content, errContent = parseJettonDeposit(sender, body)
 	default:
 		// #nosec G115 always in range
 		return nil, errors.Wrapf(ErrUnknownOp, "op code %d", int64(op))
@@ -155,6 +160,86 @@ func parseDepositAndCall(tx ton.Transaction, sender ton.AccountID, body *boc.Cel
 	return DepositAndCall{Deposit: deposit, CallData: callData}, nil
 }
 
+// parseJettonDeposit parses TEP-74 transfer_notification sent by the gateway's jetton wallet.
+// Forward payload contains deposit op code, the recipient and an optional call data cell.
+//
+// transfer_notification#7362d09c query_id:uint64 amount:(VarUInteger 16)
+//
+//	sender:MsgAddress forward_payload:(Either Cell ^Cell)
+func parseJettonDeposit(jetton ton.AccountID, body *boc.Cell) (JettonDeposit, error) {
+	// skip query id
+	if err := body.Skip(sizeQueryID); err != nil {
+		return JettonDeposit{}, err
+	}
+
+	var (
+		amount tlb.VarUInteger16
+		sender tlb.MsgAddress
+	)
+
+	err := ErrCollect(
+		tlb.Unmarshal(body, &amount),
+		tlb.Unmarshal(body, &sender),
+	)
+	if err != nil {
+		return JettonDeposit{}, errors.Wrap(err, "unable to unmarshal transfer notification")
+	}
+
+	senderID, err := parseAccount(sender)
+	if err != nil {
+		return JettonDeposit{}, errors.Wrap(err, "unable to parse sender")
+	}
+
+	isRef, err := body.ReadBit()
+	if err != nil {
+		return JettonDeposit{}, errors.Wrap(err, "unable to read forward payload")
+	}
+
+	payload := body
+	if isRef {
+		if payload, err = body.NextRef(); err != nil {
+			return JettonDeposit{}, errors.Wrap(err, "unable to read forward payload cell")
+		}
+	}
+
+	op, err := payload.ReadUint(sizeOpCode)
+	if err != nil {
+		return JettonDeposit{}, errors.Wrap(err, "unable to read forward payload op code")
+	}
+
+	// #nosec G115 always in range
+	if opCode := Op(op); opCode != OpDeposit && opCode != OpDepositAndCall {
+		return JettonDeposit{}, errors.Errorf("unknown forward payload op code %d", op)
+	}
+
+	recipient, err := UnmarshalEVMAddress(payload)
+	if err != nil {
+		return JettonDeposit{}, errors.Wrap(err, "unable to read recipient")
+	}
+
+	var callData []byte
+	if Op(op) == OpDepositAndCall {
+		callDataCell, err := payload.NextRef()
+		if err != nil {
+			return JettonDeposit{}, errors.Wrap(err, "unable to read call data cell")
+		}
+
+		if callData, err = UnmarshalSnakeCell(callDataCell); err != nil {
+			return JettonDeposit{}, errors.Wrap(err, "unable to unmarshal call data")
+		}
+	}
+
+	return JettonDeposit{
+		Deposit: Deposit{
+			Sender:    senderID,
+			Amount:    varUInteger16ToUint(amount),
+			Recipient: recipient,
+		},
+		Jetton:   jetton,
+		CallData: callData,
+	}, nil
+}
+
 // an outbound is a tx that was initiated by TSS signature with external message
 func isOutbound(tx ton.Transaction) bool {
 	return tx.Msgs.InMsg.Exists &&
@@ -178,23 +263,34 @@ func (gw *Gateway) parseOutbound(tx ton.Transaction) (*Transaction, error) {
 		return nil, errParse(err, "unable to read op code")
 	}
 
-	// #nosec G115 always in range
-	opCode := Op(op)
+	var (
+		// #nosec G115 always in range
+		opCode = Op(op)
 
-	if opCode != OpWithdraw {
+		content    any
+		errContent error
+	)
+
+	switch opCode {
+	case OpWithdraw:
+		content, errContent = parseWithdrawal(tx, sig, payload)
+	case OpWithdrawJetton:
+		content, errContent = parseJettonWithdrawal(tx, sig, payload)
+	case OpWithdrawAndCall:
+		content, errContent = parseWithdrawalAndCall(tx, sig, payload)
+	default:
 		return nil, errors.Wrapf(ErrUnknownOp, "op code %d", op)
 	}
 
-	withdrawal, err := parseWithdrawal(tx, sig, payload)
-	if err != nil {
-		return nil, errParse(err, "unable to parse withdrawal")
+	if errContent != nil {
+		return nil, errParse(errContent, fmt.Sprintf("unable to parse outbound for op code %d", op))
 	}
 
 	return &Transaction{
 		Transaction: tx,
 		Operation:   opCode,
 		ExitCode:    exitCodeFromTx(tx),
-		content:     withdrawal,
+		content:     content,
 	}, nil
 }
 
@@ -214,12 +310,6 @@ func parseExternalMessage(b *boc.Cell) ([65]byte, *boc.Cell, error) {
 }
 
 func parseWithdrawal(tx ton.Transaction, sig [65]byte, payload *boc.Cell) (Withdrawal, error) {
-	// Note that ECDSA sig has the following order: (v, r, s) but in EVM we have (r, s, v)
-	var sigFlipped [65]byte
-
-	copy(sigFlipped[:64], sig[1:])
-	sigFlipped[64] = sig[0]
-
 	var (
 		recipient tlb.MsgAddress
 		amount    tlb.Coins
@@ -240,15 +330,9 @@ func parseWithdrawal(tx ton.Transaction, sig [65]byte, payload *boc.Cell) (Withd
 		return Withdrawal{}, errors.Wrap(err, "unable to parse recipient from payload")
 	}
 
-	// ensure a single outgoing message for the withdrawal
-	if tx.OutMsgCnt != 1 {
-		return Withdrawal{}, errors.Wrap(ErrParse, "invalid out messages count")
-	}
-
-	// tlb.Message{}
-	outMsg := tx.Msgs.OutMsgs.Values()[0].Value
-	if outMsg.Info.SumType != "IntMsgInfo" || outMsg.Info.IntMsgInfo == nil {
-		return Withdrawal{}, errors.Wrap(ErrParse, "invalid out message")
+	outMsg, err := singleOutMessage(tx)
+	if err != nil {
+		return Withdrawal{}, err
 	}
 
 	msgRecipientAddr, err := parseAccount(outMsg.Info.IntMsgInfo.Dest)
@@ -268,10 +352,196 @@ func parseWithdrawal(tx ton.Transaction, sig [65]byte, payload *boc.Cell) (Withd
 		Recipient: recipientAddr,
 		Amount:    math.NewUint(uint64(amount)),
 		Seqno:     seqno,
-		Sig:       sigFlipped,
+		Sig:       flipSignature(sig),
+	}, nil
+}
+
+func parseJettonWithdrawal(tx ton.Transaction, sig [65]byte, payload *boc.Cell) (JettonWithdrawal, error) {
+	var (
+		jetton     tlb.MsgAddress
+		recipient  tlb.MsgAddress
+		amount     tlb.VarUInteger16
+		seqno      uint32
+		forwardFee tlb.Coins
+	)
+
+	err := ErrCollect(
+		tlb.Unmarshal(payload, &jetton),
+		tlb.Unmarshal(payload, &recipient),
+		tlb.Unmarshal(payload, &amount),
+		tlb.Unmarshal(payload, &seqno),
+		tlb.Unmarshal(payload, &forwardFee),
+	)
+	if err != nil {
+		return JettonWithdrawal{}, errors.Wrap(err, "unable to unmarshal payload")
+	}
+
+	jettonAddr, err := parseAccount(jetton)
+	if err != nil {
+		return JettonWithdrawal{}, errors.Wrap(err, "unable to parse jetton wallet from payload")
+	}
+
+	recipientAddr, err := parseAccount(recipient)
+	if err != nil {
+		return JettonWithdrawal{}, errors.Wrap(err, "unable to parse recipient from payload")
+	}
+
+	// the gateway asks its jetton wallet to transfer jettons to the recipient
+	outMsg, err := singleOutMessage(tx)
+	if err != nil {
+		return JettonWithdrawal{}, err
+	}
+
+	msgJettonAddr, err := parseAccount(outMsg.Info.IntMsgInfo.Dest)
+
+	switch {
+	case err != nil:
+		return JettonWithdrawal{}, errors.Wrap(err, "unable to parse jetton wallet from out msg")
+	case jettonAddr != msgJettonAddr:
+		// should not happen
+		return JettonWithdrawal{}, errors.Wrap(ErrParse, "jetton wallet mismatch")
+	case forwardFee != outMsg.Info.IntMsgInfo.Value.Grams:
+		// should not happen
+		return JettonWithdrawal{}, errors.Wrap(ErrParse, "forward fee mismatch")
+	}
+
+	// transfer#0f8a7ea5 query_id:uint64 amount:(VarUInteger 16) destination:MsgAddress ...
+	var (
+		transferBody = boc.Cell(outMsg.Body.Value)
+		transfer     = &transferBody
+
+		transferAmount      tlb.VarUInteger16
+		transferDestination tlb.MsgAddress
+	)
+
+	op, err := transfer.ReadUint(sizeOpCode)
+	if err != nil {
+		return JettonWithdrawal{}, errors.Wrap(err, "unable to read jetton transfer op code")
+	}
+
+	// #nosec G115 always in range
+	if Op(op) != OpJettonTransfer {
+		return JettonWithdrawal{}, errors.Wrapf(ErrParse, "out msg is not a jetton transfer (op %d)", op)
+	}
+
+	err = ErrCollect(
+		transfer.Skip(sizeQueryID),
+		tlb.Unmarshal(transfer, &transferAmount),
+		tlb.Unmarshal(transfer, &transferDestination),
+	)
+	if err != nil {
+		return JettonWithdrawal{}, errors.Wrap(err, "unable to unmarshal jetton transfer")
+	}
+
+	transferRecipientAddr, err := parseAccount(transferDestination)
+
+	switch {
+	case err != nil:
+		return JettonWithdrawal{}, errors.Wrap(err, "unable to parse recipient from jetton transfer")
+	case recipientAddr != transferRecipientAddr:
+		// should not happen
+		return JettonWithdrawal{}, errors.Wrap(ErrParse, "recipient mismatch")
+	case !varUInteger16ToUint(amount).Equal(varUInteger16ToUint(transferAmount)):
+		// should not happen
+		return JettonWithdrawal{}, errors.Wrap(ErrParse, "amount mismatch")
+	}
+
+	return JettonWithdrawal{
+		Jetton:     jettonAddr,
+		Recipient:  recipientAddr,
+		Amount:     varUInteger16ToUint(amount),
+		ForwardFee: math.NewUint(uint64(forwardFee)),
+		Seqno:      seqno,
+		Sig:        flipSignature(sig),
+	}, nil
+}
+
+func parseWithdrawalAndCall(tx ton.Transaction, sig [65]byte, payload *boc.Cell) (WithdrawalAndCall, error) {
+	var (
+		recipient  tlb.MsgAddress
+		amount     tlb.Coins
+		seqno      uint32
+		forwardFee tlb.Coins
+	)
+
+	err := ErrCollect(
+		tlb.Unmarshal(payload, &recipient),
+		tlb.Unmarshal(payload, &amount),
+		tlb.Unmarshal(payload, &seqno),
+		tlb.Unmarshal(payload, &forwardFee),
+	)
+	if err != nil {
+		return WithdrawalAndCall{}, errors.Wrap(err, "unable to unmarshal payload")
+	}
+
+	callDataCell, err := payload.NextRef()
+	if err != nil {
+		return WithdrawalAndCall{}, errors.Wrap(err, "unable to read call data cell")
+	}
+
+	callData, err := UnmarshalSnakeCell(callDataCell)
+	if err != nil {
+		return WithdrawalAndCall{}, errors.Wrap(err, "unable to unmarshal call data")
+	}
+
+	recipientAddr, err := parseAccount(recipient)
+	if err != nil {
+		return WithdrawalAndCall{}, errors.Wrap(err, "unable to parse recipient from payload")
+	}
+
+	// the call data is forwarded as the body of the message to the recipient
+	outMsg, err := singleOutMessage(tx)
+	if err != nil {
+		return WithdrawalAndCall{}, err
+	}
+
+	msgRecipientAddr, err := parseAccount(outMsg.Info.IntMsgInfo.Dest)
+
+	switch {
+	case err != nil:
+		return WithdrawalAndCall{}, errors.Wrap(err, "unable to parse recipient from out msg")
+	case recipientAddr != msgRecipientAddr:
+		// should not happen
+		return WithdrawalAndCall{}, errors.Wrap(ErrParse, "recipient mismatch")
+	case amount+forwardFee != outMsg.Info.IntMsgInfo.Value.Grams:
+		// should not happen, the forward fee is attached on top of the amount
+		return WithdrawalAndCall{}, errors.Wrap(ErrParse, "amount mismatch")
+	}
+
+	return WithdrawalAndCall{
+		Recipient:  recipientAddr,
+		Amount:     math.NewUint(uint64(amount)),
+		ForwardFee: math.NewUint(uint64(forwardFee)),
+		Seqno:      seqno,
+		CallData:   callData,
+		Sig:        flipSignature(sig),
 	}, nil
 }
 
+// singleOutMessage ensures a single outgoing internal message for the outbound and returns it
+func singleOutMessage(tx ton.Transaction) (tlb.Message, error) {
+	if tx.OutMsgCnt != 1 {
+		return tlb.Message{}, errors.Wrap(ErrParse, "invalid out messages count")
+	}
+
+	outMsg := tx.Msgs.OutMsgs.Values()[0].Value
+	if outMsg.Info.SumType != "IntMsgInfo" || outMsg.Info.IntMsgInfo == nil {
+		return tlb.Message{}, errors.Wrap(ErrParse, "invalid out message")
+	}
+
+	return outMsg, nil
+}
+
+// Note that ECDSA sig has the following order: (v, r, s) but in EVM we have (r, s, v)
+func flipSignature(sig [65]byte) [65]byte {
+	var sigFlipped [65]byte
+
+	copy(sigFlipped[:64], sig[1:])
+	sigFlipped[64] = sig[0]
+
+	return sigFlipped
+}
+
 func parseAccount(raw tlb.MsgAddress) (ton.AccountID, error) {
 	if raw.SumType != "AddrStd" {
 		return ton.AccountID{}, errors.Wrapf(ErrParse, "invalid address type %s", raw.SumType)
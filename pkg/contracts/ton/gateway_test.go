@@ -11,6 +11,7 @@ import (
 	"testing"
 
 	"cosmossdk.io/math"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
@@ -163,6 +164,81 @@ func TestParsing(t *testing.T) {
 
 	})
 
+	t.Run("Jetton deposit", func(t *testing.T) {
+		// ARRANGE
+		// Given a tx (jetton transfer_notification is put into the inbound message)
+		tx, fx := getFixtureTX(t, "01-deposit")
+
+		// Given a gateway contract
+		gw := NewGateway(ton.MustParseAccountID(fx.Account))
+
+		// Given a jetton deposit sent by the gateway's jetton wallet (source of the inbound message)
+		jettonWallet, err := ton.AccountIDFromTlb(tx.Msgs.InMsg.Value.Value.Info.IntMsgInfo.Src)
+		require.NoError(t, err)
+
+		const largeAmount = "123456789000000000000000000" // exceeds uint64
+
+		deposit := JettonDeposit{
+			Deposit: Deposit{
+				Sender:    ton.MustParseAccountID("0:552f6db5da0cae7f0b3ab4ab58d85927f6beb962cda426a6a6ee751c82cead1f"),
+				Amount:    math.NewUintFromString(largeAmount),
+				Recipient: common.HexToAddress("0xA1eb8D65b765D259E7520B791bc4783AdeFDd998"),
+			},
+			Jetton: *jettonWallet,
+		}
+
+		for _, tt := range []struct {
+			name     string
+			callData []byte
+		}{
+			{"without call data", nil},
+			{"with call data", []byte("hello jettons")},
+			{"with long call data", readFixtureFile(t, "testdata/long-call-data.txt")},
+		} {
+			t.Run(tt.name, func(t *testing.T) {
+				deposit.CallData = tt.callData
+
+				// ACT
+				parsedTX := alterBodyAndParse(gw, tx, lo.Must(deposit.AsBody()))
+
+				// ASSERT
+				assert.Equal(t, OpJettonTransferNotification, parsedTX.Operation)
+				assert.True(t, parsedTX.IsInbound())
+
+				deposit2, err := parsedTX.JettonDeposit()
+				require.NoError(t, err)
+
+				assert.Equal(t, deposit, deposit2)
+				assert.Equal(t, largeAmount, deposit2.Amount.String())
+
+				// Check that other casting fails
+				_, err = parsedTX.Deposit()
+				assert.ErrorIs(t, err, ErrCast)
+			})
+		}
+
+		t.Run("unknown forward payload", func(t *testing.T) {
+			// ARRANGE
+			body := boc.NewCell()
+			require.NoError(t, ErrCollect(
+				body.WriteUint(uint64(OpJettonTransferNotification), sizeOpCode),
+				body.WriteUint(0, sizeQueryID),
+				tlb.Marshal(body, uintToVarUInteger16(math.NewUint(1))),
+				tlb.Marshal(body, deposit.Sender.ToMsgAddress()),
+				body.WriteBit(false),
+				body.WriteUint(uint64(OpDonate), sizeOpCode),
+			))
+			tx.Msgs.InMsg.Value.Value.Body.Value = tlb.Any(*body)
+
+			// ACT
+			_, err := gw.ParseTransaction(tx)
+
+			// ASSERT
+			assert.ErrorIs(t, err, ErrParse)
+			assert.ErrorContains(t, err, "unknown forward payload op code")
+		})
+	})
+
 	t.Run("Withdrawal", func(t *testing.T) {
 		// ARRANGE
 		// Given a tx
@@ -305,6 +381,83 @@ func TestWithdrawal(t *testing.T) {
 	})
 }
 
+func TestOutboundMsg(t *testing.T) {
+	var (
+		privateKey = evmWallet(t, "0xb984cd65727cfd03081fc7bf33bf5c208bca697ce16139b5ded275887e81395a")
+		tss        = crypto.PubkeyToAddress(privateKey.PublicKey)
+		recipient  = ton.MustParseAccountID("0:552f6db5da0cae7f0b3ab4ab58d85927f6beb962cda426a6a6ee751c82cead1f")
+		jetton     = ton.MustParseAccountID("0:997d889c815aeac21c47f86ae0e38383efc3c3463067582f6263ad48c5a1485b")
+	)
+
+	for _, tt := range []struct {
+		name string
+		msg  OutboundMsg
+		op   Op
+	}{
+		{
+			name: "Withdrawal",
+			msg:  &Withdrawal{Recipient: recipient, Amount: Coins(5), Seqno: 2},
+			op:   OpWithdraw,
+		},
+		{
+			name: "Jetton withdrawal",
+			msg: &JettonWithdrawal{
+				Jetton:     jetton,
+				Recipient:  recipient,
+				Amount:     math.NewUint(1_000_000),
+				ForwardFee: math.NewUint(50_000_000),
+				Seqno:      3,
+			},
+			op: OpWithdrawJetton,
+		},
+		{
+			name: "Withdrawal and call",
+			msg: &WithdrawalAndCall{
+				Recipient:  recipient,
+				Amount:     Coins(1),
+				ForwardFee: math.NewUint(50_000_000),
+				Seqno:      4,
+				CallData:   []byte("call me maybe"),
+			},
+			op: OpWithdrawAndCall,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			// ARRANGE
+			hash, err := tt.msg.Hash()
+			require.NoError(t, err)
+
+			sig, err := crypto.Sign(hash[:], privateKey)
+			require.NoError(t, err)
+
+			var sigArray [65]byte
+			copy(sigArray[:], sig)
+
+			// ACT
+			tt.msg.SetSignature(sigArray)
+			body, err := tt.msg.AsBody()
+			require.NoError(t, err)
+
+			// ASSERT
+			require.False(t, tt.msg.emptySig())
+
+			// Check signer
+			signer, err := tt.msg.Signer()
+			require.NoError(t, err)
+			assert.Equal(t, tss, signer)
+
+			// Check that external message contains the payload with expected op code
+			sigBack, payload, err := parseExternalMessage(body)
+			require.NoError(t, err)
+			assert.Equal(t, sigArray, flipSignature(sigBack))
+
+			op, err := payload.ReadUint(sizeOpCode)
+			require.NoError(t, err)
+			assert.Equal(t, tt.op, Op(op))
+		})
+	}
+}
+
 func TestFiltering(t *testing.T) {
 	t.Run("Inbound", func(t *testing.T) {
 		for _, tt := range []struct {
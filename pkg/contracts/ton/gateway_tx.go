@@ -51,6 +51,36 @@ func (tx *Transaction) Withdrawal() (Withdrawal, error) {
 	return retrieveContent[Withdrawal](tx)
 }
 
+// JettonDeposit casts the transaction content to a JettonDeposit.
+func (tx *Transaction) JettonDeposit() (JettonDeposit, error) {
+	return retrieveContent[JettonDeposit](tx)
+}
+
+// JettonWithdrawal casts the transaction content to a JettonWithdrawal.
+func (tx *Transaction) JettonWithdrawal() (JettonWithdrawal, error) {
+	return retrieveContent[JettonWithdrawal](tx)
+}
+
+// WithdrawalAndCall casts the transaction content to a WithdrawalAndCall.
+func (tx *Transaction) WithdrawalAndCall() (WithdrawalAndCall, error) {
+	return retrieveContent[WithdrawalAndCall](tx)
+}
+
+// OutboundMsg casts the transaction content to a signed outbound message
+// (withdrawal, jetton withdrawal or withdrawal and call).
+func (tx *Transaction) OutboundMsg() (OutboundMsg, error) {
+	switch content := tx.content.(type) {
+	case Withdrawal:
+		return &content, nil
+	case JettonWithdrawal:
+		return &content, nil
+	case WithdrawalAndCall:
+		return &content, nil
+	default:
+		return nil, errors.Wrapf(ErrCast, "not an outbound message (op %d)", int(tx.Operation))
+	}
+}
+
 func retrieveContent[T any](tx *Transaction) (T, error) {
 	typed, ok := tx.content.(T)
 	if !ok {
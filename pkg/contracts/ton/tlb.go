@@ -2,6 +2,7 @@ package ton
 
 import (
 	"bytes"
+	"math/big"
 
 	"cosmossdk.io/math"
 	eth "github.com/ethereum/go-ethereum/common"
@@ -68,6 +69,15 @@ func GramsToUint(g tlb.Grams) math.Uint {
 	return math.NewUint(uint64(g))
 }
 
+func uintToVarUInteger16(v math.Uint) tlb.VarUInteger16 {
+	return tlb.VarUInteger16(*v.BigInt())
+}
+
+func varUInteger16ToUint(v tlb.VarUInteger16) math.Uint {
+	i := big.Int(v)
+	return math.NewUintFromBigInt(&i)
+}
+
 func ErrCollect(errs ...error) error {
 	for i, err := range errs {
 		if err != nil {
@@ -1,8 +1,11 @@
 package crypto
 
 import (
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
 	"github.com/btcsuite/btcd/btcutil"
 	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/txscript"
 	ethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
 
@@ -36,6 +39,32 @@ func GetTssAddrBTC(tssPubkey string, bitcoinParams *chaincfg.Params) (string, er
 	return addrWPKH.EncodeAddress(), nil
 }
 
+// GetTssAddrBTCTaproot returns the bitcoin taproot (P2TR) address of the tss pubkey
+func GetTssAddrBTCTaproot(tssPubkey string, bitcoinParams *chaincfg.Params) (string, error) {
+	pubk, err := cosmos.GetPubKeyFromBech32(cosmos.Bech32PubKeyTypeAccPub, tssPubkey)
+	if err != nil {
+		return "", err
+	}
+	addrTR, err := NewAddressBTCTaproot(pubk.Bytes(), bitcoinParams)
+	if err != nil {
+		return "", err
+	}
+
+	return addrTR.EncodeAddress(), nil
+}
+
+// NewAddressBTCTaproot returns the key-path only taproot (P2TR) address of a secp256k1 pubkey.
+// The output key is the internal key tweaked with an empty script tree as recommended by BIP86.
+func NewAddressBTCTaproot(pubKey []byte, bitcoinParams *chaincfg.Params) (*btcutil.AddressTaproot, error) {
+	internalKey, err := btcec.ParsePubKey(pubKey)
+	if err != nil {
+		return nil, err
+	}
+	outputKey := txscript.ComputeTaprootKeyNoScript(internalKey)
+
+	return btcutil.NewAddressTaproot(schnorr.SerializePubKey(outputKey), bitcoinParams)
+}
+
 func getKeyAddrBTCWitnessPubkeyHash(
 	tssPubkey string,
 	bitcoinParams *chaincfg.Params,
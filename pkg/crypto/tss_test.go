@@ -1,6 +1,7 @@
 package crypto
 
 import (
+	"encoding/hex"
 	"testing"
 
 	"github.com/btcsuite/btcd/btcutil"
@@ -103,3 +104,42 @@ func TestGetTssAddrBTC(t *testing.T) {
 		})
 	}
 }
+
+func TestGetTssAddrBTCTaproot(t *testing.T) {
+	_, pubKey, _ := testdata.KeyTestPubAddr()
+	pk, err := cosmos.Bech32ifyPubKey(cosmos.Bech32PubKeyTypeAccPub, pubKey)
+	require.NoError(t, err)
+
+	t.Run("Valid TSS pubkey mainnet params", func(t *testing.T) {
+		addr, err := GetTssAddrBTCTaproot(pk, &chaincfg.MainNetParams)
+		require.NoError(t, err)
+		expectedAddr, err := NewAddressBTCTaproot(pubKey.Bytes(), &chaincfg.MainNetParams)
+		require.NoError(t, err)
+		require.Equal(t, expectedAddr.EncodeAddress(), addr)
+		require.Equal(t, "bc1p", addr[:4])
+	})
+
+	t.Run("Invalid TSS pubkey mainnet params", func(t *testing.T) {
+		addr, err := GetTssAddrBTCTaproot("invalid", &chaincfg.MainNetParams)
+		require.Error(t, err)
+		require.Empty(t, addr)
+	})
+}
+
+func TestNewAddressBTCTaproot(t *testing.T) {
+	t.Run("should match BIP86 test vector", func(t *testing.T) {
+		// internal key of m/86'/0'/0'/0/0 in https://github.com/bitcoin/bips/blob/master/bip-0086.mediawiki
+		pubKey, err := hex.DecodeString("02cc8a4bc64d897bddc5fbc2f670f7a8ba0b386779106cf1223c6fc5d7cd6fc115")
+		require.NoError(t, err)
+
+		addr, err := NewAddressBTCTaproot(pubKey, &chaincfg.MainNetParams)
+		require.NoError(t, err)
+		require.Equal(t, "bc1p5cyxnuxmeuwuvkwfem96lqzszd02n6xdcjrs20cac6yqjjwudpxqkedrcr", addr.EncodeAddress())
+	})
+
+	t.Run("should fail on invalid pubkey", func(t *testing.T) {
+		addr, err := NewAddressBTCTaproot([]byte{0x02, 0x01}, &chaincfg.MainNetParams)
+		require.Error(t, err)
+		require.Nil(t, addr)
+	})
+}
@@ -9,6 +9,10 @@ const (
 	// EVMSend is the gas limit required to transfer tokens on an EVM based chain
 	EVMSend = 21_000
 
+	// BTCOutboundBytesMax is the maximum size in vBytes of a Bitcoin outbound (21 inputs and 2 outputs)
+	// the gas limit of Bitcoin outbounds is their size in vBytes
+	BTCOutboundBytesMax = 1543
+
 	// TODO: Move gas limits from zeta-client to this file
 	// https://github.com/zeta-chain/node/issues/1606
 )
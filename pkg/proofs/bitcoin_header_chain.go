@@ -0,0 +1,336 @@
+package proofs
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/wire"
+
+	"github.com/zeta-chain/node/pkg/chains"
+)
+
+const (
+	// bitcoinMedianTimeBlocks is the number of previous blocks used to compute the median-time-past
+	bitcoinMedianTimeBlocks = 11
+
+	// bitcoinMaxTimeWarp is the maximum number of seconds the first block of a difficulty period
+	// can be earlier than its parent on networks enforcing BIP94
+	bitcoinMaxTimeWarp = 600
+)
+
+// BitcoinNetworkRules defines the consensus rules used to validate the difficulty of Bitcoin block headers
+type BitcoinNetworkRules struct {
+	// PowLimit is the highest proof-of-work target allowed on the network
+	PowLimit *big.Int
+
+	// PowLimitBits is the highest proof-of-work target in compact form
+	PowLimitBits uint32
+
+	// TargetTimespan is the desired duration of a difficulty adjustment period
+	TargetTimespan time.Duration
+
+	// TargetTimePerBlock is the desired duration between two blocks
+	TargetTimePerBlock time.Duration
+
+	// RetargetAdjustmentFactor bounds the difficulty change of a difficulty adjustment
+	RetargetAdjustmentFactor int64
+
+	// ReduceMinDifficulty allows a block to use the minimum difficulty when it is mined
+	// more than MinDiffReductionTime after its parent (testnets)
+	ReduceMinDifficulty bool
+
+	// MinDiffReductionTime is the time after which a block can use the minimum difficulty
+	MinDiffReductionTime time.Duration
+
+	// NoRetargeting disables the difficulty adjustment (regtest)
+	NoRetargeting bool
+
+	// EnforceBIP94 enables the time warp fix of BIP94 (testnet4):
+	// the difficulty adjustment is based on the first block of the period instead of the last one
+	// and the first block of a period can't be earlier than its parent by more than 10 minutes
+	EnforceBIP94 bool
+
+	// GenesisTimestamp is the timestamp of the genesis block of the network
+	GenesisTimestamp time.Time
+
+	// MinimumWork is the minimum work a block header must have on the network
+	// the lightclient doesn't store the chain from genesis, so the minimum chainwork of the network
+	// is enforced as a floor on the work of each block header, nil if the network has no floor
+	MinimumWork *big.Int
+}
+
+// BlocksPerRetarget returns the number of blocks between two difficulty adjustments
+func (r BitcoinNetworkRules) BlocksPerRetarget() int64 {
+	return int64(r.TargetTimespan / r.TargetTimePerBlock)
+}
+
+// newBitcoinNetworkRules returns the network rules derived from the btcd chain parameters
+func newBitcoinNetworkRules(params *chaincfg.Params) BitcoinNetworkRules {
+	return BitcoinNetworkRules{
+		PowLimit:                 params.PowLimit,
+		PowLimitBits:             params.PowLimitBits,
+		TargetTimespan:           params.TargetTimespan,
+		TargetTimePerBlock:       params.TargetTimePerBlock,
+		RetargetAdjustmentFactor: params.RetargetAdjustmentFactor,
+		ReduceMinDifficulty:      params.ReduceMinDifficulty,
+		MinDiffReductionTime:     params.MinDiffReductionTime,
+		NoRetargeting:            params.PoWNoRetargeting,
+		GenesisTimestamp:         params.GenesisBlock.Header.Timestamp,
+	}
+}
+
+// GetBitcoinNetworkRules returns the difficulty rules of the Bitcoin network for the given chain ID
+func GetBitcoinNetworkRules(chainID int64) (BitcoinNetworkRules, error) {
+	switch chainID {
+	case chains.BitcoinMainnet.ChainId:
+		rules := newBitcoinNetworkRules(&chaincfg.MainNetParams)
+		// the work of a block with a difficulty of 1T, the mainnet difficulty is above it since 2017
+		rules.MinimumWork = blockchain.CalcWork(0x18011978)
+		return rules, nil
+	case chains.BitcoinTestnet.ChainId:
+		return newBitcoinNetworkRules(&chaincfg.TestNet3Params), nil
+	case chains.BitcoinTestnet4.ChainId:
+		// testnet4 shares the difficulty parameters of testnet3 and enforces BIP94
+		// btcd doesn't define the testnet4 parameters
+		rules := newBitcoinNetworkRules(&chaincfg.TestNet3Params)
+		rules.EnforceBIP94 = true
+		rules.GenesisTimestamp = time.Unix(1714777860, 0)
+		return rules, nil
+	case chains.BitcoinSignetTestnet.ChainId:
+		return newBitcoinNetworkRules(&chaincfg.SigNetParams), nil
+	case chains.BitcoinRegtest.ChainId:
+		return newBitcoinNetworkRules(&chaincfg.RegressionNetParams), nil
+	default:
+		return BitcoinNetworkRules{}, fmt.Errorf("chain id %d is not a bitcoin chain", chainID)
+	}
+}
+
+// BitcoinHeaderGetter returns the stored Bitcoin block header with the given hash
+// it returns false if the block header is not stored, for example if it has been pruned
+type BitcoinHeaderGetter func(hash []byte) (*wire.BlockHeader, bool)
+
+// BitcoinPeriodState is the state of the difficulty period of a stored Bitcoin block header
+// It's stored along with the block header so the difficulty required for its child is computed
+// without walking back the ancestors of the period
+type BitcoinPeriodState struct {
+	// StartTimestamp is the timestamp of the first block of the period, 0 if the first block is not stored
+	StartTimestamp int64
+
+	// StartBits are the difficulty bits of the first block of the period
+	StartBits uint32
+
+	// LastBits are the difficulty bits of the last block of the period up to the block header
+	// that doesn't use the minimum difficulty
+	LastBits uint32
+}
+
+// IsKnown returns true if the first block of the period is stored
+func (s BitcoinPeriodState) IsKnown() bool {
+	return s.StartTimestamp != 0
+}
+
+// NextBitcoinPeriodState returns the period state of the Bitcoin block header at the given height
+// from the period state of its parent
+func NextBitcoinPeriodState(
+	chainID int64,
+	headerBytes []byte,
+	height int64,
+	parentPeriod BitcoinPeriodState,
+) (BitcoinPeriodState, error) {
+	rules, err := GetBitcoinNetworkRules(chainID)
+	if err != nil {
+		return BitcoinPeriodState{}, err
+	}
+	var header wire.BlockHeader
+	if err := header.Deserialize(bytes.NewReader(headerBytes)); err != nil {
+		return BitcoinPeriodState{}, fmt.Errorf("cannot deserialize Bitcoin header (%s)", err)
+	}
+
+	return nextBitcoinPeriodState(rules, &header, height, parentPeriod), nil
+}
+
+// nextBitcoinPeriodState returns the period state of the block header from the period state of its parent
+// the period state is unknown until the first block of a period is stored
+func nextBitcoinPeriodState(
+	rules BitcoinNetworkRules,
+	header *wire.BlockHeader,
+	height int64,
+	parentPeriod BitcoinPeriodState,
+) BitcoinPeriodState {
+	if height%rules.BlocksPerRetarget() == 0 {
+		return BitcoinPeriodState{
+			StartTimestamp: header.Timestamp.Unix(),
+			StartBits:      header.Bits,
+			LastBits:       header.Bits,
+		}
+	}
+	if !parentPeriod.IsKnown() {
+		return BitcoinPeriodState{}
+	}
+
+	period := parentPeriod
+	if header.Bits != rules.PowLimitBits {
+		period.LastBits = header.Bits
+	}
+	return period
+}
+
+// ValidateBitcoinHeaderChain validates a Bitcoin block header against its stored parent
+// It checks the minimum work of the network, the median-time-past and the difficulty adjustment rules
+// The difficulty is computed from the parent and its period state, the checks requiring ancestors that are
+// not stored are skipped, the headers voted before the history is available are trusted from the observers
+// supermajority. The first block of a difficulty period is rejected if the first block of the previous period is
+// not stored, its difficulty could not be checked and would be trusted by all the following blocks
+func ValidateBitcoinHeaderChain(
+	chainID int64,
+	headerBytes []byte,
+	height int64,
+	getHeader BitcoinHeaderGetter,
+	parentPeriod BitcoinPeriodState,
+) error {
+	rules, err := GetBitcoinNetworkRules(chainID)
+	if err != nil {
+		return err
+	}
+	var header wire.BlockHeader
+	if err := header.Deserialize(bytes.NewReader(headerBytes)); err != nil {
+		return fmt.Errorf("cannot deserialize Bitcoin header (%s)", err)
+	}
+
+	// check the minimum work
+	if rules.MinimumWork != nil && blockchain.CalcWork(header.Bits).Cmp(rules.MinimumWork) < 0 {
+		return fmt.Errorf("block work for bits %08x is lower than minimum work %s", header.Bits, rules.MinimumWork)
+	}
+
+	parent, found := getHeader(header.PrevBlock[:])
+	if !found {
+		return nil
+	}
+
+	// check the timestamp is after the median-time-past
+	medianTime := bitcoinMedianTimePast(parent, getHeader)
+	if !header.Timestamp.After(medianTime) {
+		return fmt.Errorf("block timestamp %v is not after median time past %v", header.Timestamp, medianTime)
+	}
+
+	// check the difficulty bits
+	expectedBits, found, err := bitcoinRequiredBits(rules, &header, height, parent, parentPeriod)
+	if err != nil {
+		return err
+	}
+	if found && header.Bits != expectedBits {
+		return fmt.Errorf("block bits %08x don't match required bits %08x", header.Bits, expectedBits)
+	}
+
+	return nil
+}
+
+// bitcoinMedianTimePast returns the median timestamp of the last blocks ending with the given header
+// only the stored ancestors are used if the history is shorter than the median window
+func bitcoinMedianTimePast(header *wire.BlockHeader, getHeader BitcoinHeaderGetter) time.Time {
+	timestamps := make([]int64, 0, bitcoinMedianTimeBlocks)
+	for i := 0; i < bitcoinMedianTimeBlocks && header != nil; i++ {
+		timestamps = append(timestamps, header.Timestamp.Unix())
+
+		ancestor, found := getHeader(header.PrevBlock[:])
+		if !found {
+			break
+		}
+		header = ancestor
+	}
+
+	sort.Slice(timestamps, func(i, j int) bool { return timestamps[i] < timestamps[j] })
+	return time.Unix(timestamps[len(timestamps)/2], 0)
+}
+
+// bitcoinRequiredBits returns the difficulty bits required for the block header at the given height
+// it returns false if the required bits of a min difficulty network depend on a period that is not stored
+// and an error if the block header starts a period and the first block of the previous period is not stored
+func bitcoinRequiredBits(
+	rules BitcoinNetworkRules,
+	header *wire.BlockHeader,
+	height int64,
+	parent *wire.BlockHeader,
+	parentPeriod BitcoinPeriodState,
+) (uint32, bool, error) {
+	blocksPerRetarget := rules.BlocksPerRetarget()
+
+	// the difficulty only changes on the first block of a difficulty period
+	if height%blocksPerRetarget != 0 {
+		if !rules.ReduceMinDifficulty {
+			return parent.Bits, true, nil
+		}
+
+		// a block can use the minimum difficulty if it is mined long after its parent
+		if header.Timestamp.After(parent.Timestamp.Add(rules.MinDiffReductionTime)) {
+			return rules.PowLimitBits, true, nil
+		}
+
+		// otherwise it uses the difficulty of the last block of the period that didn't use the minimum difficulty
+		if parent.Bits != rules.PowLimitBits || (height-1)%blocksPerRetarget == 0 {
+			return parent.Bits, true, nil
+		}
+		if !parentPeriod.IsKnown() {
+			return 0, false, nil
+		}
+		return parentPeriod.LastBits, true, nil
+	}
+
+	// BIP94: the first block of a period can't be earlier than its parent by more than 10 minutes
+	if rules.EnforceBIP94 && header.Timestamp.Unix() < parent.Timestamp.Unix()-bitcoinMaxTimeWarp {
+		return 0, false, fmt.Errorf(
+			"block timestamp %v is more than %d seconds before parent timestamp %v",
+			header.Timestamp,
+			bitcoinMaxTimeWarp,
+			parent.Timestamp,
+		)
+	}
+
+	if rules.NoRetargeting {
+		return parent.Bits, true, nil
+	}
+
+	// the first block of the previous difficulty period is the first block of the period of the parent
+	if !parentPeriod.IsKnown() {
+		return 0, false, fmt.Errorf(
+			"cannot retarget difficulty at height %d: first block of the previous period is not stored",
+			height,
+		)
+	}
+
+	return bitcoinRetarget(rules, parentPeriod, parent), true, nil
+}
+
+// bitcoinRetarget computes the difficulty bits of the next period from the state of a period and its last block
+func bitcoinRetarget(rules BitcoinNetworkRules, period BitcoinPeriodState, last *wire.BlockHeader) uint32 {
+	targetTimespan := int64(rules.TargetTimespan / time.Second)
+	minTimespan := targetTimespan / rules.RetargetAdjustmentFactor
+	maxTimespan := targetTimespan * rules.RetargetAdjustmentFactor
+
+	// limit the adjustment
+	actualTimespan := last.Timestamp.Unix() - period.StartTimestamp
+	if actualTimespan < minTimespan {
+		actualTimespan = minTimespan
+	} else if actualTimespan > maxTimespan {
+		actualTimespan = maxTimespan
+	}
+
+	// BIP94 uses the difficulty of the first block so min difficulty blocks can't lower the difficulty
+	oldTarget := blockchain.CompactToBig(last.Bits)
+	if rules.EnforceBIP94 {
+		oldTarget = blockchain.CompactToBig(period.StartBits)
+	}
+
+	newTarget := new(big.Int).Mul(oldTarget, big.NewInt(actualTimespan))
+	newTarget.Div(newTarget, big.NewInt(targetTimespan))
+	if newTarget.Cmp(rules.PowLimit) > 0 {
+		newTarget.Set(rules.PowLimit)
+	}
+
+	return blockchain.BigToCompact(newTarget)
+}
@@ -0,0 +1,246 @@
+package proofs
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/node/pkg/chains"
+)
+
+// testBitcoinChain is an in-memory Bitcoin header chain used to test the difficulty rules
+type testBitcoinChain struct {
+	rules   BitcoinNetworkRules
+	headers map[chainhash.Hash]*wire.BlockHeader
+	tip     *wire.BlockHeader
+	period  BitcoinPeriodState
+	height  int64
+}
+
+// newTestBitcoinChain creates a chain of the network with a first block at the given height
+func newTestBitcoinChain(
+	t *testing.T,
+	chainID int64,
+	height int64,
+	bits uint32,
+	timestamp time.Time,
+) *testBitcoinChain {
+	rules, err := GetBitcoinNetworkRules(chainID)
+	require.NoError(t, err)
+
+	first := &wire.BlockHeader{
+		Version:   1,
+		Timestamp: timestamp,
+		Bits:      bits,
+	}
+	return &testBitcoinChain{
+		rules:   rules,
+		headers: map[chainhash.Hash]*wire.BlockHeader{first.BlockHash(): first},
+		tip:     first,
+		period:  nextBitcoinPeriodState(rules, first, height, BitcoinPeriodState{}),
+		height:  height,
+	}
+}
+
+// next returns a header extending the tip without adding it to the chain
+func (c *testBitcoinChain) next(bits uint32, spacing time.Duration) *wire.BlockHeader {
+	return &wire.BlockHeader{
+		Version:   1,
+		PrevBlock: c.tip.BlockHash(),
+		Timestamp: c.tip.Timestamp.Add(spacing),
+		Bits:      bits,
+	}
+}
+
+// extend adds n headers with the given bits and spacing to the chain
+func (c *testBitcoinChain) extend(n int, bits uint32, spacing time.Duration) {
+	for i := 0; i < n; i++ {
+		header := c.next(bits, spacing)
+		c.headers[header.BlockHash()] = header
+		c.tip = header
+		c.height++
+		c.period = nextBitcoinPeriodState(c.rules, header, c.height, c.period)
+	}
+}
+
+func (c *testBitcoinChain) getHeader(hash []byte) (*wire.BlockHeader, bool) {
+	h, err := chainhash.NewHash(hash)
+	if err != nil {
+		return nil, false
+	}
+	header, found := c.headers[*h]
+	return header, found
+}
+
+// validate validates the header as the next block of the chain
+func (c *testBitcoinChain) validate(t *testing.T, chainID int64, header *wire.BlockHeader) error {
+	var buf bytes.Buffer
+	require.NoError(t, header.Serialize(&buf))
+	return ValidateBitcoinHeaderChain(chainID, buf.Bytes(), c.height+1, c.getHeader, c.period)
+}
+
+func TestGetBitcoinNetworkRules(t *testing.T) {
+	for _, chain := range []chains.Chain{
+		chains.BitcoinMainnet,
+		chains.BitcoinTestnet,
+		chains.BitcoinTestnet4,
+		chains.BitcoinSignetTestnet,
+		chains.BitcoinRegtest,
+	} {
+		rules, err := GetBitcoinNetworkRules(chain.ChainId)
+		require.NoError(t, err, chain.Name)
+		require.EqualValues(t, 2016, rules.BlocksPerRetarget(), chain.Name)
+	}
+
+	rules, err := GetBitcoinNetworkRules(chains.BitcoinTestnet4.ChainId)
+	require.NoError(t, err)
+	require.True(t, rules.EnforceBIP94)
+	require.True(t, rules.ReduceMinDifficulty)
+
+	rules, err = GetBitcoinNetworkRules(chains.BitcoinMainnet.ChainId)
+	require.NoError(t, err)
+	require.NotNil(t, rules.MinimumWork)
+
+	_, err = GetBitcoinNetworkRules(chains.Ethereum.ChainId)
+	require.Error(t, err)
+}
+
+func TestValidateBitcoinHeaderChain(t *testing.T) {
+	const (
+		mainnetBits = 0x1703a30c
+		spacing     = 10 * time.Minute
+	)
+	start := time.Unix(1700000000, 0)
+
+	t.Run("should accept header without stored ancestors", func(t *testing.T) {
+		c := newTestBitcoinChain(t, chains.BitcoinMainnet.ChainId, 800_000, mainnetBits, start)
+		header := &wire.BlockHeader{Version: 1, Timestamp: start, Bits: mainnetBits}
+		require.NoError(t, c.validate(t, chains.BitcoinMainnet.ChainId, header))
+	})
+
+	t.Run("should fail if header work is lower than minimum work", func(t *testing.T) {
+		c := newTestBitcoinChain(t, chains.BitcoinMainnet.ChainId, 800_000, mainnetBits, start)
+		header := &wire.BlockHeader{Version: 1, Timestamp: start, Bits: 0x1d00ffff}
+		require.ErrorContains(t, c.validate(t, chains.BitcoinMainnet.ChainId, header), "minimum work")
+	})
+
+	t.Run("should fail if not a bitcoin chain", func(t *testing.T) {
+		c := newTestBitcoinChain(t, chains.BitcoinMainnet.ChainId, 800_000, mainnetBits, start)
+		require.Error(t, c.validate(t, chains.Ethereum.ChainId, c.next(mainnetBits, spacing)))
+	})
+
+	t.Run("should accept header with parent bits within a difficulty period", func(t *testing.T) {
+		c := newTestBitcoinChain(t, chains.BitcoinMainnet.ChainId, 800_000, mainnetBits, start)
+		c.extend(20, mainnetBits, spacing)
+		require.NoError(t, c.validate(t, chains.BitcoinMainnet.ChainId, c.next(mainnetBits, spacing)))
+	})
+
+	t.Run("should fail if bits change within a difficulty period", func(t *testing.T) {
+		c := newTestBitcoinChain(t, chains.BitcoinMainnet.ChainId, 800_000, mainnetBits, start)
+		c.extend(20, mainnetBits, spacing)
+		err := c.validate(t, chains.BitcoinMainnet.ChainId, c.next(mainnetBits-1, spacing))
+		require.ErrorContains(t, err, "required bits")
+	})
+
+	t.Run("should fail if timestamp is not after median time past", func(t *testing.T) {
+		c := newTestBitcoinChain(t, chains.BitcoinMainnet.ChainId, 800_000, mainnetBits, start)
+		c.extend(20, mainnetBits, spacing)
+
+		// the median of the last 11 blocks is the timestamp of the 6th block before the tip
+		err := c.validate(t, chains.BitcoinMainnet.ChainId, c.next(mainnetBits, -5*spacing))
+		require.ErrorContains(t, err, "median time past")
+
+		require.NoError(t, c.validate(t, chains.BitcoinMainnet.ChainId, c.next(mainnetBits, -4*spacing)))
+	})
+
+	t.Run("should validate the difficulty adjustment", func(t *testing.T) {
+		// the blocks of the period are mined twice faster than expected, the difficulty increases
+		c := newTestBitcoinChain(t, chains.BitcoinMainnet.ChainId, 2016*400, mainnetBits, start)
+		c.extend(2015, mainnetBits, spacing/2)
+		require.EqualValues(t, start.Unix(), c.period.StartTimestamp)
+
+		expectedBits := bitcoinRetarget(c.rules, c.period, c.tip)
+		require.Negative(t, blockchain.CompactToBig(expectedBits).Cmp(blockchain.CompactToBig(mainnetBits)))
+		require.NoError(t, c.validate(t, chains.BitcoinMainnet.ChainId, c.next(expectedBits, spacing)))
+
+		err := c.validate(t, chains.BitcoinMainnet.ChainId, c.next(mainnetBits, spacing))
+		require.ErrorContains(t, err, "required bits")
+	})
+
+	t.Run("should limit the difficulty adjustment", func(t *testing.T) {
+		// the blocks of the period are mined 10 times slower than expected, the difficulty is divided by 4
+		c := newTestBitcoinChain(t, chains.BitcoinMainnet.ChainId, 2016*400, mainnetBits, start)
+		c.extend(2015, mainnetBits, spacing*10)
+
+		target := new(big.Int).Mul(blockchain.CompactToBig(mainnetBits), big.NewInt(4))
+		expectedBits := blockchain.BigToCompact(target)
+		require.NoError(t, c.validate(t, chains.BitcoinMainnet.ChainId, c.next(expectedBits, spacing)))
+	})
+
+	t.Run("should fail to retarget if the first block of the period is not stored", func(t *testing.T) {
+		c := newTestBitcoinChain(t, chains.BitcoinMainnet.ChainId, 2016*400+1, mainnetBits, start)
+		c.extend(2014, mainnetBits, spacing/2)
+		require.False(t, c.period.IsKnown())
+
+		err := c.validate(t, chains.BitcoinMainnet.ChainId, c.next(mainnetBits, spacing))
+		require.ErrorContains(t, err, "first block of the previous period is not stored")
+	})
+
+	t.Run("should allow min difficulty blocks on testnet", func(t *testing.T) {
+		const testnetBits = 0x1a0ffff0
+		c := newTestBitcoinChain(t, chains.BitcoinTestnet.ChainId, 2016*1488, testnetBits, start)
+		c.extend(20, testnetBits, spacing)
+
+		// min difficulty is allowed after 20 minutes
+		require.NoError(t, c.validate(t, chains.BitcoinTestnet.ChainId, c.next(0x1d00ffff, 21*time.Minute)))
+		err := c.validate(t, chains.BitcoinTestnet.ChainId, c.next(0x1d00ffff, 20*time.Minute))
+		require.ErrorContains(t, err, "required bits")
+
+		// the following blocks use the difficulty of the last block that didn't use the min difficulty
+		c.extend(3, 0x1d00ffff, 21*time.Minute)
+		require.NoError(t, c.validate(t, chains.BitcoinTestnet.ChainId, c.next(testnetBits, spacing)))
+		err = c.validate(t, chains.BitcoinTestnet.ChainId, c.next(0x1d00ffff, spacing))
+		require.ErrorContains(t, err, "required bits")
+	})
+
+	t.Run("should skip the min difficulty check on testnet if the first block of the period is not stored", func(t *testing.T) {
+		const testnetBits = 0x1a0ffff0
+		c := newTestBitcoinChain(t, chains.BitcoinTestnet.ChainId, 2016*1488+1, 0x1d00ffff, start)
+		c.extend(3, 0x1d00ffff, 21*time.Minute)
+		require.False(t, c.period.IsKnown())
+
+		// the difficulty of the last block that didn't use the min difficulty is unknown
+		require.NoError(t, c.validate(t, chains.BitcoinTestnet.ChainId, c.next(testnetBits, spacing)))
+	})
+
+	t.Run("should use the first block of the period to retarget on testnet4", func(t *testing.T) {
+		const testnetBits = 0x1a0ffff0
+		c := newTestBitcoinChain(t, chains.BitcoinTestnet4.ChainId, 2016*30, testnetBits, start)
+		c.extend(2014, testnetBits, spacing)
+		// the last block of the period is a min difficulty block
+		c.extend(1, 0x1d00ffff, 21*time.Minute)
+		require.EqualValues(t, testnetBits, c.period.StartBits)
+		require.EqualValues(t, testnetBits, c.period.LastBits)
+
+		expectedBits := bitcoinRetarget(c.rules, c.period, c.tip)
+		require.NotEqual(t, uint32(0x1d00ffff), expectedBits)
+		require.NoError(t, c.validate(t, chains.BitcoinTestnet4.ChainId, c.next(expectedBits, spacing)))
+
+		// BIP94: the first block of the period can't be more than 10 minutes earlier than its parent
+		err := c.validate(t, chains.BitcoinTestnet4.ChainId, c.next(expectedBits, -11*time.Minute))
+		require.ErrorContains(t, err, "before parent timestamp")
+	})
+
+	t.Run("should not retarget on regtest", func(t *testing.T) {
+		const regtestBits = 0x207fffff
+		c := newTestBitcoinChain(t, chains.BitcoinRegtest.ChainId, 2016*10, regtestBits, start)
+		c.extend(2015, regtestBits, time.Second)
+		require.NoError(t, c.validate(t, chains.BitcoinRegtest.ChainId, c.next(regtestBits, time.Second)))
+	})
+}
@@ -74,8 +74,63 @@ func (m *Proof) GetValues() [][]byte {
 	return nil
 }
 
+// ReceiptProof proves the inclusion of a transaction and its receipt at the
+// same index of a block, against the transactions and receipts roots
+type ReceiptProof struct {
+	TxProof      *Proof `protobuf:"bytes,1,opt,name=tx_proof,json=txProof,proto3" json:"tx_proof,omitempty"`
+	ReceiptProof *Proof `protobuf:"bytes,2,opt,name=receipt_proof,json=receiptProof,proto3" json:"receipt_proof,omitempty"`
+}
+
+func (m *ReceiptProof) Reset()         { *m = ReceiptProof{} }
+func (m *ReceiptProof) String() string { return proto.CompactTextString(m) }
+func (*ReceiptProof) ProtoMessage()    {}
+func (*ReceiptProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c049832c17ba64fb, []int{1}
+}
+func (m *ReceiptProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReceiptProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReceiptProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReceiptProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptProof.Merge(m, src)
+}
+func (m *ReceiptProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReceiptProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptProof proto.InternalMessageInfo
+
+func (m *ReceiptProof) GetTxProof() *Proof {
+	if m != nil {
+		return m.TxProof
+	}
+	return nil
+}
+
+func (m *ReceiptProof) GetReceiptProof() *Proof {
+	if m != nil {
+		return m.ReceiptProof
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Proof)(nil), "zetachain.zetacore.pkg.proofs.ethereum.Proof")
+	proto.RegisterType((*ReceiptProof)(nil), "zetachain.zetacore.pkg.proofs.ethereum.ReceiptProof")
 }
 
 func init() {
@@ -83,19 +138,22 @@ func init() {
 }
 
 var fileDescriptor_c049832c17ba64fb = []byte{
-	// 181 bytes of a gzipped FileDescriptorProto
+	// 237 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x32, 0xad, 0x4a, 0x2d, 0x49,
 	0x4c, 0xce, 0x48, 0xcc, 0xcc, 0xd3, 0x07, 0xb3, 0xf2, 0x8b, 0x52, 0xf5, 0x0b, 0xb2, 0xd3, 0xf5,
 	0x0b, 0x8a, 0xf2, 0xf3, 0xd3, 0x8a, 0xf5, 0x53, 0x4b, 0x32, 0x52, 0x8b, 0x52, 0x4b, 0x73, 0xe1,
 	0x0c, 0xbd, 0x82, 0xa2, 0xfc, 0x92, 0x7c, 0x21, 0x35, 0xb8, 0x36, 0x3d, 0x98, 0x36, 0xbd, 0x82,
 	0xec, 0x74, 0x3d, 0x88, 0x36, 0x3d, 0x98, 0x6a, 0x25, 0x63, 0x2e, 0xd6, 0x00, 0x90, 0x90, 0x90,
 	0x10, 0x17, 0x4b, 0x76, 0x6a, 0x65, 0xb1, 0x04, 0xa3, 0x02, 0xb3, 0x06, 0x4f, 0x10, 0x98, 0x2d,
-	0x24, 0xc6, 0xc5, 0x56, 0x96, 0x98, 0x53, 0x9a, 0x5a, 0x2c, 0xc1, 0x04, 0x16, 0x85, 0xf2, 0x9c,
-	0x3c, 0x4e, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f,
-	0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0x4a, 0x2f, 0x3d, 0xb3, 0x24,
-	0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x17, 0xec, 0x5c, 0x5d, 0x88, 0xcb, 0xf3, 0xf2, 0x53, 0xb0,
-	0xba, 0x3a, 0x89, 0x0d, 0xec, 0x5a, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x31, 0xb2, 0x78,
-	0x64, 0xe6, 0x00, 0x00, 0x00,
+	0x24, 0xc6, 0xc5, 0x56, 0x96, 0x98, 0x53, 0x9a, 0x5a, 0x2c, 0xc1, 0x04, 0x16, 0x85, 0xf2, 0x94,
+	0xd6, 0x30, 0x72, 0xf1, 0x04, 0xa5, 0x26, 0xa7, 0x66, 0x16, 0x94, 0x40, 0x34, 0x7b, 0x70, 0x71,
+	0x94, 0x54, 0xc4, 0x83, 0xcd, 0x96, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x36, 0xd2, 0xd5, 0x23, 0xce,
+	0x01, 0x7a, 0x60, 0x03, 0x82, 0xd8, 0x4b, 0x2a, 0x20, 0x26, 0x05, 0x71, 0xf1, 0x16, 0x41, 0x4c,
+	0x86, 0x1a, 0xc7, 0x44, 0x8e, 0x71, 0x3c, 0x45, 0x48, 0xae, 0x73, 0xf2, 0x38, 0xf1, 0x48, 0x8e,
+	0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58,
+	0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0xbd, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4,
+	0xfc, 0x5c, 0x70, 0xe8, 0xea, 0x42, 0x02, 0x3a, 0x2f, 0x3f, 0x05, 0x6b, 0x20, 0x27, 0xb1, 0x81,
+	0x03, 0xd7, 0x18, 0x30, 0x00, 0xcf, 0x71, 0x0e, 0xb0, 0x95, 0x01, 0x00, 0x00,
 }
 
 func (m *Proof) Marshal() (dAtA []byte, err error) {
@@ -139,6 +197,53 @@ func (m *Proof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ReceiptProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReceiptProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReceiptProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ReceiptProof != nil {
+		{
+			size, err := m.ReceiptProof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEthereum(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TxProof != nil {
+		{
+			size, err := m.TxProof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEthereum(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEthereum(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEthereum(v)
 	base := offset
@@ -171,6 +276,23 @@ func (m *Proof) Size() (n int) {
 	return n
 }
 
+func (m *ReceiptProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TxProof != nil {
+		l = m.TxProof.Size()
+		n += 1 + l + sovEthereum(uint64(l))
+	}
+	if m.ReceiptProof != nil {
+		l = m.ReceiptProof.Size()
+		n += 1 + l + sovEthereum(uint64(l))
+	}
+	return n
+}
+
 func sovEthereum(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -291,6 +413,128 @@ func (m *Proof) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ReceiptProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEthereum
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReceiptProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReceiptProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxProof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEthereum
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEthereum
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEthereum
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TxProof == nil {
+				m.TxProof = &Proof{}
+			}
+			if err := m.TxProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiptProof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEthereum
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEthereum
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEthereum
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ReceiptProof == nil {
+				m.ReceiptProof = &Proof{}
+			}
+			if err := m.ReceiptProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEthereum(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEthereum
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEthereum(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -111,6 +111,33 @@ func (m *Proof) Verify(rootHash common.Hash, key int) ([]byte, error) {
 	return trie.VerifyProof(rootHash, indexBuf, m)
 }
 
+// NewReceiptProof returns a new ReceiptProof from the transaction and receipt proofs
+func NewReceiptProof(txProof *Proof, receiptProof *Proof) *ReceiptProof {
+	return &ReceiptProof{
+		TxProof:      txProof,
+		ReceiptProof: receiptProof,
+	}
+}
+
+// Verify verifies the transaction and receipt proofs against the given roots and key.
+// Typically, the roots are the transactions and receipts roots from a trusted block header,
+// and the key is the index of the transaction in the block.
+// Returns the transaction and receipt in bytes if the proofs are valid.
+func (m *ReceiptProof) Verify(txRoot common.Hash, receiptRoot common.Hash, key int) ([]byte, []byte, error) {
+	if m.TxProof == nil || m.ReceiptProof == nil {
+		return nil, nil, errors.New("missing transaction or receipt proof")
+	}
+	txBytes, err := m.TxProof.Verify(txRoot, key)
+	if err != nil {
+		return nil, nil, err
+	}
+	receiptBytes, err := m.ReceiptProof.Verify(receiptRoot, key)
+	if err != nil {
+		return nil, nil, err
+	}
+	return txBytes, receiptBytes, nil
+}
+
 type Trie struct {
 	*trie.Trie
 }
@@ -5,6 +5,7 @@ import (
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"math/big"
 	"time"
 
 	"github.com/btcsuite/btcd/blockchain"
@@ -14,7 +15,6 @@ import (
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/rlp"
 
-	"github.com/zeta-chain/node/pkg/chains"
 	"github.com/zeta-chain/node/pkg/proofs/bitcoin"
 )
 
@@ -88,6 +88,24 @@ func (h HeaderData) ValidateTimestamp(zetaTime time.Time) error {
 	}
 }
 
+// Work returns the work contributed by the block to the chain, used to select the canonical chain among forks
+// For Bitcoin, it is the proof-of-work of the block derived from its difficulty target
+// For Ethereum, there is no proof-of-work since the merge, each block contributes a work of 1 so the longest chain wins
+func (h HeaderData) Work() (*big.Int, error) {
+	switch data := h.Data.(type) {
+	case *HeaderData_EthereumHeader:
+		return big.NewInt(1), nil
+	case *HeaderData_BitcoinHeader:
+		var header wire.BlockHeader
+		if err := header.Deserialize(bytes.NewReader(data.BitcoinHeader)); err != nil {
+			return nil, err
+		}
+		return blockchain.CalcWork(header.Bits), nil
+	default:
+		return nil, errors.New("unrecognized header type")
+	}
+}
+
 // Validate performs a basic validation of the HeaderData
 func (h HeaderData) Validate(blockHash []byte, chainID int64, height int64) error {
 	switch data := h.Data.(type) {
@@ -150,17 +168,17 @@ func ValidateBitcoinHeader(headerBytes []byte, blockHash []byte, chainID int64)
 	}
 
 	// Timestamp must be not earlier than genesis block
-	chainParams, err := chains.GetBTCChainParams(chainID)
+	rules, err := GetBitcoinNetworkRules(chainID)
 	if err != nil {
-		return fmt.Errorf("cannot get chain params (%s) for chain id (%d)", err, chainID)
+		return fmt.Errorf("cannot get network rules (%s) for chain id (%d)", err, chainID)
 	}
-	if chainParams.GenesisBlock.Header.Timestamp.After(header.Timestamp) {
+	if rules.GenesisTimestamp.After(header.Timestamp) {
 		return fmt.Errorf("block timestamp %v is before genesis block", header.Timestamp)
 	}
 
 	// Verify the proof-of-work
 	liteBlock := btcutil.NewBlock(&wire.MsgBlock{Header: header})
-	err = blockchain.CheckProofOfWork(liteBlock, chainParams.PowLimit)
+	err = blockchain.CheckProofOfWork(liteBlock, rules.PowLimit)
 	if err != nil {
 		return fmt.Errorf("proof-of-work verification failed (%s)", err)
 	}
@@ -47,6 +47,10 @@ func TestTrueEthereumHeader(t *testing.T) {
 
 	err = headerData.ValidateTimestamp(time.Now())
 	require.NoError(t, err)
+
+	work, err := headerData.Work()
+	require.NoError(t, err)
+	require.EqualValues(t, 1, work.Int64())
 }
 
 func TestFalseEthereumHeader(t *testing.T) {
@@ -82,6 +86,11 @@ func TestTrueBitcoinHeader(t *testing.T) {
 
 		// Validate
 		validateTrueBitcoinHeader(t, header, headerBytes)
+
+		// Check work
+		work, err := NewBitcoinHeader(headerBytes).Work()
+		require.NoError(t, err)
+		require.Equal(t, blockchain.CalcWork(header.Bits), work)
 	}
 }
 
@@ -111,6 +120,10 @@ func TestNonExistentHeaderType(t *testing.T) {
 
 	err = headerData.ValidateTimestamp(time.Now())
 	require.ErrorContains(t, err, "unrecognized header type")
+
+	work, err := headerData.Work()
+	require.EqualError(t, err, "unrecognized header type")
+	require.Nil(t, work)
 }
 
 func BitcoinHeaderValidationLiveTest(t *testing.T) {
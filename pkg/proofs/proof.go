@@ -42,6 +42,15 @@ func NewEthereumProof(proof *ethereum.Proof) *Proof {
 	}
 }
 
+// NewEthereumReceiptProof returns a new Proof containing an Ethereum transaction and receipt proof
+func NewEthereumReceiptProof(txProof *ethereum.Proof, receiptProof *ethereum.Proof) *Proof {
+	return &Proof{
+		Proof: &Proof_EthereumReceiptProof{
+			EthereumReceiptProof: ethereum.NewReceiptProof(txProof, receiptProof),
+		},
+	}
+}
+
 // NewBitcoinProof returns a new Proof containing a Bitcoin proof
 func NewBitcoinProof(txBytes []byte, path []byte, index uint) *Proof {
 	return &Proof{
@@ -61,12 +70,7 @@ func NewBitcoinProof(txBytes []byte, path []byte, index uint) *Proof {
 func (p Proof) Verify(headerData HeaderData, txIndex int) ([]byte, error) {
 	switch proof := p.Proof.(type) {
 	case *Proof_EthereumProof:
-		ethHeaderBytes := headerData.GetEthereumHeader()
-		if ethHeaderBytes == nil {
-			return nil, errors.New("can't verify ethereum proof against non-ethereum header")
-		}
-		var ethHeader ethtypes.Header
-		err := rlp.DecodeBytes(ethHeaderBytes, &ethHeader)
+		ethHeader, err := decodeEthereumHeader(headerData)
 		if err != nil {
 			return nil, err
 		}
@@ -75,6 +79,9 @@ func (p Proof) Verify(headerData HeaderData, txIndex int) ([]byte, error) {
 			return nil, NewErrInvalidProof(err)
 		}
 		return val, nil
+	case *Proof_EthereumReceiptProof:
+		txBytes, _, err := p.VerifyReceipt(headerData, txIndex)
+		return txBytes, err
 	case *Proof_BitcoinProof:
 		btcHeaderBytes := headerData.GetBitcoinHeader()
 		if len(btcHeaderBytes) != bitcoin.BitcoinBlockHeaderLen {
@@ -97,3 +104,32 @@ func (p Proof) Verify(headerData HeaderData, txIndex int) ([]byte, error) {
 		return nil, errors.New("unrecognized proof type")
 	}
 }
+
+// VerifyReceipt verifies the transaction and receipt proof against the header
+// Returns the verified tx and receipt in bytes if the verification is successful
+func (p Proof) VerifyReceipt(headerData HeaderData, txIndex int) ([]byte, []byte, error) {
+	proof, ok := p.Proof.(*Proof_EthereumReceiptProof)
+	if !ok {
+		return nil, nil, errors.New("not an ethereum receipt proof")
+	}
+	ethHeader, err := decodeEthereumHeader(headerData)
+	if err != nil {
+		return nil, nil, err
+	}
+	txBytes, receiptBytes, err := proof.EthereumReceiptProof.Verify(ethHeader.TxHash, ethHeader.ReceiptHash, txIndex)
+	if err != nil {
+		return nil, nil, NewErrInvalidProof(err)
+	}
+	return txBytes, receiptBytes, nil
+}
+
+// decodeEthereumHeader decodes the ethereum header from the header data
+func decodeEthereumHeader(headerData HeaderData) (ethtypes.Header, error) {
+	var ethHeader ethtypes.Header
+	ethHeaderBytes := headerData.GetEthereumHeader()
+	if ethHeaderBytes == nil {
+		return ethHeader, errors.New("can't verify ethereum proof against non-ethereum header")
+	}
+	err := rlp.DecodeBytes(ethHeaderBytes, &ethHeader)
+	return ethHeader, err
+}
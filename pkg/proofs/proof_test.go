@@ -111,6 +111,100 @@ func TestEthereumMerkleProof(t *testing.T) {
 	})
 }
 
+func TestEthereumReceiptProof(t *testing.T) {
+	header, err := testdata.ReadEthHeader()
+	require.NoError(t, err)
+	b, err := rlp.EncodeToBytes(&header)
+	require.NoError(t, err)
+	headerData := NewEthereumHeader(b)
+
+	var txs types.Transactions
+	var receipts types.Receipts
+	for i := 0; i < testdata.TxsCount; i++ {
+		tx, err := testdata.ReadEthTx(i)
+		require.NoError(t, err)
+		txs = append(txs, &tx)
+
+		receipt, err := testdata.ReadEthReceipt(i)
+		require.NoError(t, err)
+		receipts = append(receipts, &receipt)
+	}
+	txsTree := ethereum.NewTrie(txs)
+	receiptsTree := ethereum.NewTrie(receipts)
+
+	t.Run("should verify tx and receipt proof", func(t *testing.T) {
+		for i := range receipts {
+			txProof, err := txsTree.GenerateProof(i)
+			require.NoError(t, err)
+			receiptProof, err := receiptsTree.GenerateProof(i)
+			require.NoError(t, err)
+
+			proof := NewEthereumReceiptProof(txProof, receiptProof)
+
+			txBytes, receiptBytes, err := proof.VerifyReceipt(headerData, i)
+			require.NoError(t, err)
+
+			var tx types.Transaction
+			require.NoError(t, tx.UnmarshalBinary(txBytes))
+			require.Equal(t, txs[i].Hash(), tx.Hash())
+
+			var receipt types.Receipt
+			require.NoError(t, receipt.UnmarshalBinary(receiptBytes))
+			require.Equal(t, receipts[i].Status, receipt.Status)
+			require.Len(t, receipt.Logs, len(receipts[i].Logs))
+
+			// Verify returns the tx bytes
+			verified, err := proof.Verify(headerData, i)
+			require.NoError(t, err)
+			require.Equal(t, txBytes, verified)
+		}
+	})
+
+	t.Run("should fail to verify receipt proof at another index", func(t *testing.T) {
+		txProof, err := txsTree.GenerateProof(0)
+		require.NoError(t, err)
+		receiptProof, err := receiptsTree.GenerateProof(1)
+		require.NoError(t, err)
+
+		proof := NewEthereumReceiptProof(txProof, receiptProof)
+
+		_, _, err = proof.VerifyReceipt(headerData, 0)
+		require.True(t, IsErrorInvalidProof(err))
+	})
+
+	t.Run("should fail to verify swapped tx and receipt proofs", func(t *testing.T) {
+		txProof, err := txsTree.GenerateProof(0)
+		require.NoError(t, err)
+		receiptProof, err := receiptsTree.GenerateProof(0)
+		require.NoError(t, err)
+
+		proof := NewEthereumReceiptProof(receiptProof, txProof)
+
+		_, _, err = proof.VerifyReceipt(headerData, 0)
+		require.True(t, IsErrorInvalidProof(err))
+	})
+
+	t.Run("should fail to verify receipt of non-receipt proof", func(t *testing.T) {
+		txProof, err := txsTree.GenerateProof(0)
+		require.NoError(t, err)
+
+		_, _, err = NewEthereumProof(txProof).VerifyReceipt(headerData, 0)
+		require.ErrorContains(t, err, "not an ethereum receipt proof")
+	})
+
+	t.Run("should fail to verify against non-ethereum header", func(t *testing.T) {
+		txProof, err := txsTree.GenerateProof(0)
+		require.NoError(t, err)
+		receiptProof, err := receiptsTree.GenerateProof(0)
+		require.NoError(t, err)
+
+		proof := NewEthereumReceiptProof(txProof, receiptProof)
+
+		_, _, err = proof.VerifyReceipt(NewBitcoinHeader(make([]byte, 80)), 0)
+		require.ErrorContains(t, err, "non-ethereum header")
+	})
+}
+
 func BitcoinMerkleProofLiveTest(t *testing.T) {
 	client := createBTCClient(t)
 	bn, err := client.GetBlockCount()
@@ -104,7 +104,6 @@ func (m *BlockHeader) GetHeader() HeaderData {
 
 type HeaderData struct {
 	// Types that are valid to be assigned to Data:
-	//
 	//	*HeaderData_EthereumHeader
 	//	*HeaderData_BitcoinHeader
 	Data isHeaderData_Data `protobuf_oneof:"data"`
@@ -190,9 +189,9 @@ func (*HeaderData) XXX_OneofWrappers() []interface{} {
 
 type Proof struct {
 	// Types that are valid to be assigned to Proof:
-	//
 	//	*Proof_EthereumProof
 	//	*Proof_BitcoinProof
+	//	*Proof_EthereumReceiptProof
 	Proof isProof_Proof `protobuf_oneof:"proof"`
 }
 
@@ -241,9 +240,13 @@ type Proof_EthereumProof struct {
 type Proof_BitcoinProof struct {
 	BitcoinProof *bitcoin.Proof `protobuf:"bytes,2,opt,name=bitcoin_proof,json=bitcoinProof,proto3,oneof" json:"bitcoin_proof,omitempty"`
 }
+type Proof_EthereumReceiptProof struct {
+	EthereumReceiptProof *ethereum.ReceiptProof `protobuf:"bytes,3,opt,name=ethereum_receipt_proof,json=ethereumReceiptProof,proto3,oneof" json:"ethereum_receipt_proof,omitempty"`
+}
 
-func (*Proof_EthereumProof) isProof_Proof() {}
-func (*Proof_BitcoinProof) isProof_Proof()  {}
+func (*Proof_EthereumProof) isProof_Proof()        {}
+func (*Proof_BitcoinProof) isProof_Proof()         {}
+func (*Proof_EthereumReceiptProof) isProof_Proof() {}
 
 func (m *Proof) GetProof() isProof_Proof {
 	if m != nil {
@@ -266,11 +269,19 @@ func (m *Proof) GetBitcoinProof() *bitcoin.Proof {
 	return nil
 }
 
+func (m *Proof) GetEthereumReceiptProof() *ethereum.ReceiptProof {
+	if x, ok := m.GetProof().(*Proof_EthereumReceiptProof); ok {
+		return x.EthereumReceiptProof
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Proof) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*Proof_EthereumProof)(nil),
 		(*Proof_BitcoinProof)(nil),
+		(*Proof_EthereumReceiptProof)(nil),
 	}
 }
 
@@ -285,32 +296,34 @@ func init() {
 }
 
 var fileDescriptor_874830d2276ded66 = []byte{
-	// 398 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x52, 0x41, 0xab, 0xd3, 0x40,
-	0x10, 0xce, 0xf6, 0xa5, 0x7d, 0x32, 0xa9, 0x4f, 0x58, 0x44, 0xe2, 0x03, 0xd3, 0x52, 0x10, 0x5b,
-	0xb1, 0x09, 0xb4, 0x78, 0x16, 0x82, 0x60, 0xbd, 0x49, 0x04, 0x0f, 0x5e, 0xc2, 0x36, 0x59, 0xb3,
-	0xa1, 0x36, 0x1b, 0xd2, 0xed, 0xc5, 0x5f, 0xe1, 0x1f, 0xd2, 0x73, 0x8f, 0x3d, 0x7a, 0x12, 0x69,
-	0xff, 0x88, 0x64, 0x76, 0x37, 0x7a, 0x6a, 0x4f, 0x3b, 0x3b, 0xf3, 0xcd, 0xf7, 0xcd, 0x0c, 0x1f,
-	0xbc, 0xfc, 0xc6, 0x15, 0xcb, 0x04, 0x2b, 0xab, 0x08, 0x23, 0xd9, 0xf0, 0xa8, 0xde, 0x14, 0x51,
-	0xdd, 0x48, 0xf9, 0x65, 0x67, 0x9e, 0xb0, 0x6e, 0xa4, 0x92, 0xf4, 0x59, 0x87, 0x0d, 0x2d, 0x36,
-	0xac, 0x37, 0x45, 0xa8, 0x41, 0xf7, 0x8f, 0x0b, 0x59, 0x48, 0x44, 0x46, 0x6d, 0xa4, 0x9b, 0xee,
-	0x97, 0x97, 0x05, 0xd6, 0xa5, 0xca, 0x64, 0x59, 0xd9, 0xd7, 0x34, 0xbd, 0xbe, 0xdc, 0xc4, 0x95,
-	0xe0, 0x0d, 0xdf, 0x6f, 0xbb, 0x40, 0xb7, 0x4d, 0x7e, 0x12, 0xf0, 0xe2, 0xaf, 0x32, 0xdb, 0xac,
-	0x38, 0xcb, 0x79, 0x43, 0x9f, 0xc0, 0x40, 0xf0, 0xb2, 0x10, 0xca, 0x27, 0x63, 0x32, 0xbd, 0x49,
-	0xcc, 0x8f, 0x52, 0x70, 0x05, 0xdb, 0x09, 0xbf, 0x37, 0x26, 0xd3, 0x61, 0x82, 0x31, 0x1d, 0x81,
-	0x57, 0xb3, 0x86, 0x57, 0x2a, 0xc5, 0xd2, 0x0d, 0x96, 0x40, 0xa7, 0x56, 0x2d, 0xe0, 0x29, 0x3c,
-	0xc0, 0x89, 0xd2, 0x32, 0xf7, 0x5d, 0xa4, 0xbb, 0xc5, 0xff, 0xfb, 0x9c, 0xbe, 0x6b, 0x75, 0x5a,
-	0x45, 0xbf, 0x3f, 0x26, 0x53, 0x6f, 0x31, 0x0b, 0x2f, 0x5e, 0x2a, 0xd4, 0xe3, 0xbd, 0x65, 0x8a,
-	0xc5, 0xee, 0xe1, 0xf7, 0xc8, 0x49, 0x4c, 0xfb, 0x44, 0x00, 0xfc, 0xab, 0xd1, 0x19, 0x3c, 0xb2,
-	0x0b, 0xa6, 0x86, 0xbf, 0xdd, 0x63, 0xb8, 0x72, 0x92, 0x3b, 0x5b, 0x30, 0x9b, 0xbe, 0x80, 0x3b,
-	0x73, 0x41, 0x8b, 0xec, 0x19, 0xe4, 0x43, 0x93, 0xd7, 0xc0, 0x78, 0x00, 0x6e, 0xce, 0x14, 0x9b,
-	0xfc, 0x20, 0xd0, 0xff, 0xd0, 0x4e, 0x43, 0x3f, 0x41, 0x47, 0x96, 0xe2, 0x7c, 0x28, 0xe2, 0x2d,
-	0xe6, 0x57, 0x96, 0xe8, 0x6e, 0x8f, 0x34, 0xad, 0x92, 0xcd, 0x68, 0xde, 0x8f, 0x60, 0xa5, 0x0d,
-	0x6d, 0x0f, 0x69, 0x5f, 0x5d, 0xa1, 0xb5, 0x46, 0xb0, 0xac, 0x43, 0x93, 0xc0, 0x7f, 0x7c, 0x0b,
-	0x7d, 0xc4, 0xc5, 0x6f, 0x0e, 0xa7, 0x80, 0x1c, 0x4f, 0x01, 0xf9, 0x73, 0x0a, 0xc8, 0xf7, 0x73,
-	0xe0, 0x1c, 0xcf, 0x81, 0xf3, 0xeb, 0x1c, 0x38, 0x9f, 0x9f, 0x17, 0xa5, 0x12, 0xfb, 0x75, 0x98,
-	0xc9, 0x2d, 0x9a, 0x67, 0xae, 0x7d, 0x54, 0xc9, 0xfc, 0x7f, 0x0f, 0xad, 0x07, 0x68, 0x99, 0xe5,
-	0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xea, 0x34, 0x1b, 0x28, 0x01, 0x03, 0x00, 0x00,
+	// 424 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0x4f, 0x8b, 0xd3, 0x40,
+	0x14, 0xcf, 0xa4, 0x69, 0x57, 0x5e, 0xea, 0x0a, 0xc3, 0xb2, 0xc4, 0x05, 0xb3, 0xa5, 0x20, 0x76,
+	0xc5, 0x4d, 0x60, 0x57, 0xcf, 0x42, 0x10, 0xac, 0x37, 0x89, 0xe0, 0xc1, 0x4b, 0x98, 0x26, 0x63,
+	0x26, 0x74, 0x37, 0x13, 0xb2, 0xd3, 0x8b, 0x9f, 0xc2, 0xef, 0xe1, 0x77, 0xf0, 0xdc, 0x63, 0x8f,
+	0x9e, 0x44, 0xda, 0x2f, 0x22, 0x79, 0x33, 0x13, 0x7b, 0x6a, 0x3d, 0xcd, 0x9b, 0xf7, 0x7e, 0x7f,
+	0xde, 0x7b, 0x3c, 0x78, 0xf9, 0x8d, 0x2b, 0x96, 0x0b, 0x56, 0xd5, 0x31, 0x46, 0xb2, 0xe5, 0x71,
+	0xb3, 0x2c, 0xe3, 0xa6, 0x95, 0xf2, 0xeb, 0x83, 0x79, 0xa2, 0xa6, 0x95, 0x4a, 0xd2, 0x67, 0x3d,
+	0x36, 0xb2, 0xd8, 0xa8, 0x59, 0x96, 0x91, 0x06, 0x5d, 0x9c, 0x95, 0xb2, 0x94, 0x88, 0x8c, 0xbb,
+	0x48, 0x93, 0x2e, 0x6e, 0x0f, 0x1b, 0x2c, 0x2a, 0x95, 0xcb, 0xaa, 0xb6, 0xaf, 0x21, 0xbd, 0x39,
+	0x4c, 0xe2, 0x4a, 0xf0, 0x96, 0xaf, 0xee, 0xfb, 0x40, 0xd3, 0xa6, 0x3f, 0x09, 0xf8, 0xc9, 0x9d,
+	0xcc, 0x97, 0x73, 0xce, 0x0a, 0xde, 0xd2, 0x73, 0x18, 0x09, 0x5e, 0x95, 0x42, 0x05, 0x64, 0x42,
+	0x66, 0x83, 0xd4, 0xfc, 0x28, 0x05, 0x4f, 0xb0, 0x07, 0x11, 0xb8, 0x13, 0x32, 0x1b, 0xa7, 0x18,
+	0xd3, 0x4b, 0xf0, 0x1b, 0xd6, 0xf2, 0x5a, 0x65, 0x58, 0x1a, 0x60, 0x09, 0x74, 0x6a, 0xde, 0x01,
+	0x9e, 0xc2, 0x23, 0xec, 0x28, 0xab, 0x8a, 0xc0, 0x43, 0xb9, 0x13, 0xfc, 0x7f, 0x28, 0xe8, 0xfb,
+	0xce, 0xa7, 0x73, 0x0c, 0x86, 0x13, 0x32, 0xf3, 0x6f, 0xae, 0xa2, 0x83, 0x9b, 0x8a, 0x74, 0x7b,
+	0xef, 0x98, 0x62, 0x89, 0xb7, 0xfe, 0x7d, 0xe9, 0xa4, 0x86, 0x3e, 0x15, 0x00, 0xff, 0x6a, 0xf4,
+	0x0a, 0x9e, 0xd8, 0x01, 0x33, 0xa3, 0xdf, 0xcd, 0x31, 0x9e, 0x3b, 0xe9, 0xa9, 0x2d, 0x98, 0x49,
+	0x5f, 0xc0, 0xa9, 0xd9, 0xa0, 0x45, 0xba, 0x06, 0xf9, 0xd8, 0xe4, 0x35, 0x30, 0x19, 0x81, 0x57,
+	0x30, 0xc5, 0xa6, 0x3f, 0x5c, 0x18, 0x7e, 0xec, 0xba, 0xa1, 0x9f, 0xa1, 0x17, 0xcb, 0xb0, 0x3f,
+	0x34, 0xf1, 0x6f, 0xae, 0x8f, 0x0c, 0xd1, 0xef, 0x1e, 0x65, 0x3a, 0x27, 0x9b, 0xd1, 0xba, 0x9f,
+	0xc0, 0x5a, 0x1b, 0x59, 0x17, 0x65, 0x5f, 0x1d, 0x91, 0xb5, 0x87, 0x60, 0x55, 0xc7, 0x26, 0xa1,
+	0x45, 0xef, 0xe0, 0xbc, 0x6f, 0xb6, 0xe5, 0x39, 0xaf, 0x1a, 0x65, 0xd4, 0x07, 0xa8, 0xfe, 0xfa,
+	0x7f, 0x9b, 0x4e, 0x35, 0xd9, 0xba, 0x9c, 0xd9, 0xc2, 0x7e, 0x3e, 0x39, 0x81, 0x21, 0xf2, 0x92,
+	0xb7, 0xeb, 0x6d, 0x48, 0x36, 0xdb, 0x90, 0xfc, 0xd9, 0x86, 0xe4, 0xfb, 0x2e, 0x74, 0x36, 0xbb,
+	0xd0, 0xf9, 0xb5, 0x0b, 0x9d, 0x2f, 0xcf, 0xcb, 0x4a, 0x89, 0xd5, 0x22, 0xca, 0xe5, 0x3d, 0x9e,
+	0xea, 0xb5, 0xbe, 0xda, 0x5a, 0x16, 0xfb, 0x17, 0xbb, 0x18, 0xe1, 0x81, 0xde, 0xfe, 0x1d, 0x00,
+	0xe1, 0x15, 0x4e, 0x3c, 0x6f, 0x03, 0x00, 0x00,
 }
 
 func (m *BlockHeader) Marshal() (dAtA []byte, err error) {
@@ -508,6 +521,27 @@ func (m *Proof_BitcoinProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	return len(dAtA) - i, nil
 }
+func (m *Proof_EthereumReceiptProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Proof_EthereumReceiptProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.EthereumReceiptProof != nil {
+		{
+			size, err := m.EthereumReceiptProof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProofs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
 func encodeVarintProofs(dAtA []byte, offset int, v uint64) int {
 	offset -= sovProofs(v)
 	base := offset
@@ -616,6 +650,18 @@ func (m *Proof_BitcoinProof) Size() (n int) {
 	}
 	return n
 }
+func (m *Proof_EthereumReceiptProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EthereumReceiptProof != nil {
+		l = m.EthereumReceiptProof.Size()
+		n += 1 + l + sovProofs(uint64(l))
+	}
+	return n
+}
 
 func sovProofs(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
@@ -1027,6 +1073,41 @@ func (m *Proof) Unmarshal(dAtA []byte) error {
 			}
 			m.Proof = &Proof_BitcoinProof{v}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumReceiptProof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProofs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProofs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProofs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ethereum.ReceiptProof{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Proof = &Proof_EthereumReceiptProof{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProofs(dAtA[iNdEx:])
@@ -85,6 +85,18 @@ func (c *Clients) GetCctxByHash(ctx context.Context, sendHash string) (*types.Cr
 	return resp.CrossChainTx, nil
 }
 
+// GetInboundHashToCctx returns the indexes of the cross chain transactions created from an inbound hash
+func (c *Clients) GetInboundHashToCctx(ctx context.Context, inboundHash string) (types.InboundHashToCctx, error) {
+	in := &types.QueryGetInboundHashToCctxRequest{InboundHash: inboundHash}
+
+	resp, err := c.Crosschain.InboundHashToCctx(ctx, in)
+	if err != nil {
+		return types.InboundHashToCctx{}, errors.Wrap(err, "failed to get inbound hash to cctx")
+	}
+
+	return resp.InboundHashToCctx, nil
+}
+
 // GetCctxByNonce returns a cross chain transaction by nonce
 func (c *Clients) GetCctxByNonce(ctx context.Context, chainID int64, nonce uint64) (*types.CrossChainTx, error) {
 	resp, err := c.Crosschain.CctxByNonce(ctx, &types.QueryGetCctxByNonceRequest{
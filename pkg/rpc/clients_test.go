@@ -9,6 +9,7 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 
+	sdkmath "cosmossdk.io/math"
 	tmtypes "github.com/cometbft/cometbft/proto/tendermint/types"
 	"github.com/cosmos/cosmos-sdk/client/grpc/tmservice"
 	"github.com/cosmos/cosmos-sdk/types"
@@ -76,6 +77,16 @@ func setupZetacoreClients(t *testing.T) Clients {
 	return c
 }
 
+// sampleBallotTally returns a ballot tally with non-zero powers, the tally is always set in the query response
+func sampleBallotTally() observertypes.BallotTally {
+	return observertypes.BallotTally{
+		SuccessPower:  sdkmath.NewInt(2),
+		FailurePower:  sdkmath.NewInt(1),
+		NotVotedPower: sdkmath.NewInt(1),
+		TotalPower:    sdkmath.NewInt(4),
+	}
+}
+
 func TestZetacore_GetBallot(t *testing.T) {
 	ctx := context.Background()
 
@@ -84,6 +95,7 @@ func TestZetacore_GetBallot(t *testing.T) {
 		Voters:           nil,
 		ObservationType:  0,
 		BallotStatus:     0,
+		Tally:            sampleBallotTally(),
 	}
 	input := observertypes.QueryBallotByIdentifierRequest{BallotIdentifier: "123"}
 	method := "/zetachain.zetacore.observer.Query/BallotByIdentifier"
@@ -541,6 +553,7 @@ func TestZetacore_GetBallotByID(t *testing.T) {
 
 	expectedOutput := observertypes.QueryBallotByIdentifierResponse{
 		BallotIdentifier: "ballot1235",
+		Tally:            sampleBallotTally(),
 	}
 	input := observertypes.QueryBallotByIdentifierRequest{BallotIdentifier: "ballot1235"}
 	method := "/zetachain.zetacore.observer.Query/BallotByIdentifier"
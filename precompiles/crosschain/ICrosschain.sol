@@ -0,0 +1,103 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.26;
+
+/// @dev The ICrosschain contract's address.
+address constant ICROSSCHAIN_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000069; // 105
+
+/// @dev The ICrosschain contract's instance.
+ICrosschain constant ICROSSCHAIN_CONTRACT = ICrosschain(
+    ICROSSCHAIN_PRECOMPILE_ADDRESS
+);
+
+/// @notice Revert information of a CCTX
+/// @param revertAddress Address receiving the reverted funds on the sender chain
+/// @param callOnRevert Whether the revert address is called on revert
+/// @param abortAddress ZEVM address receiving the funds if the CCTX is aborted
+/// @param revertMessage Message passed to the revert address on revert
+/// @param revertGasLimit Gas limit of the revert call
+/// @param revertHash Hash of the revert outbound, empty if the CCTX has not been reverted
+/// @param isAbortRefunded Whether the funds of an aborted CCTX have been refunded
+struct RevertInfo {
+    string revertAddress;
+    bool callOnRevert;
+    string abortAddress;
+    bytes revertMessage;
+    uint256 revertGasLimit;
+    string revertHash;
+    bool isAbortRefunded;
+}
+
+/// @notice Cross-chain transaction
+/// @param index CCTX index
+/// @param status CCTX status: 0 PendingInbound, 1 PendingOutbound, 3 OutboundMined,
+///        4 PendingRevert, 5 Reverted, 6 Aborted, 7 PendingDelay
+/// @param statusMessage Status message
+/// @param errorMessage Error message of a failed CCTX
+/// @param senderChainId Chain ID of the inbound
+/// @param inboundHash Hash of the inbound on the sender chain
+/// @param receiverChainId Chain ID of the outbound
+/// @param outboundHash Hash of the outbound on the receiver chain, empty if not yet observed
+/// @param revertInfo Revert information
+struct CCTX {
+    string index;
+    uint8 status;
+    string statusMessage;
+    string errorMessage;
+    int64 senderChainId;
+    string inboundHash;
+    int64 receiverChainId;
+    string outboundHash;
+    RevertInfo revertInfo;
+}
+
+/// @notice Gas price of a chain voted by the observers
+/// @param chainId Chain ID
+/// @param gasPrice Median gas price
+/// @param priorityFee Median priority fee
+/// @param blockNumber Block number of the median gas price
+struct GasPrice {
+    int64 chainId;
+    uint256 gasPrice;
+    uint256 priorityFee;
+    uint64 blockNumber;
+}
+
+/// @notice Pending outbound nonces of a chain for the current TSS
+/// @param chainId Chain ID
+/// @param nonceLow Lowest pending nonce
+/// @param nonceHigh Next nonce to be assigned
+struct PendingNonces {
+    int64 chainId;
+    int64 nonceLow;
+    int64 nonceHigh;
+}
+
+interface ICrosschain {
+    /// @notice Get a CCTX from its index.
+    /// @param index CCTX index.
+    /// @return cctx CCTX.
+    function getCctx(
+        string memory index
+    ) external view returns (CCTX calldata cctx);
+
+    /// @notice Get the CCTXs created from an inbound.
+    /// @param inboundHash Hash of the inbound on the sender chain.
+    /// @return cctxs CCTXs created from the inbound.
+    function getCctxsByInboundHash(
+        string memory inboundHash
+    ) external view returns (CCTX[] calldata cctxs);
+
+    /// @notice Get the current gas price of a chain.
+    /// @param chainId Chain ID.
+    /// @return gasPrice Gas price.
+    function getGasPrice(
+        int64 chainId
+    ) external view returns (GasPrice calldata gasPrice);
+
+    /// @notice Get the pending outbound nonces of a chain.
+    /// @param chainId Chain ID.
+    /// @return pendingNonces Pending nonces.
+    function getPendingNonces(
+        int64 chainId
+    ) external view returns (PendingNonces calldata pendingNonces);
+}
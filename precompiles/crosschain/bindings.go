@@ -0,0 +1,7 @@
+//go:generate sh -c "solc ICrosschain.sol --combined-json abi | jq '.contracts.\"ICrosschain.sol:ICrosschain\"'  > ICrosschain.json"
+//go:generate sh -c "cat ICrosschain.json | jq .abi > ICrosschain.abi"
+//go:generate sh -c "abigen --abi ICrosschain.abi  --pkg crosschain --type ICrosschain --out ICrosschain.gen.go"
+
+package crosschain
+
+var _ Contract
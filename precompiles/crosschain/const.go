@@ -0,0 +1,19 @@
+package crosschain
+
+const (
+	// Read methods.
+	GetCctxMethodName               = "getCctx"
+	GetCctxsByInboundHashMethodName = "getCctxsByInboundHash"
+	GetGasPriceMethodName           = "getGasPrice"
+	GetPendingNoncesMethodName      = "getPendingNonces"
+
+	// Number of store reads of the methods, charged with the read costs of the KV gas config.
+	// getCctxsByInboundHash reads the inbound hash mapping, each CCTX read is charged during the execution.
+	GetCctxMethodReads               = 1
+	GetCctxsByInboundHashMethodReads = 1
+	GetGasPriceMethodReads           = 1
+	GetPendingNoncesMethodReads      = 2
+
+	// Default number of store reads for unknown methods.
+	DefaultReads = 0
+)
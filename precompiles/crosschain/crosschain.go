@@ -0,0 +1,154 @@
+package crosschain
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	precompiletypes "github.com/zeta-chain/node/precompiles/types"
+	crosschainkeeper "github.com/zeta-chain/node/x/crosschain/keeper"
+)
+
+var (
+	ABI             abi.ABI
+	ContractAddress = common.HexToAddress("0x0000000000000000000000000000000000000069")
+
+	// ReadsRequiredByMethod is the number of store reads of each method.
+	// The gas of the methods is derived from the read costs of the KV gas config.
+	ReadsRequiredByMethod = map[[4]byte]uint64{}
+)
+
+func init() {
+	initABI()
+}
+
+func initABI() {
+	if err := ABI.UnmarshalJSON([]byte(ICrosschainMetaData.ABI)); err != nil {
+		panic(err)
+	}
+
+	ReadsRequiredByMethod = map[[4]byte]uint64{}
+	for methodName := range ABI.Methods {
+		var methodID [4]byte
+		copy(methodID[:], ABI.Methods[methodName].ID[:4])
+		switch methodName {
+		case GetCctxMethodName:
+			ReadsRequiredByMethod[methodID] = GetCctxMethodReads
+		case GetCctxsByInboundHashMethodName:
+			ReadsRequiredByMethod[methodID] = GetCctxsByInboundHashMethodReads
+		case GetGasPriceMethodName:
+			ReadsRequiredByMethod[methodID] = GetGasPriceMethodReads
+		case GetPendingNoncesMethodName:
+			ReadsRequiredByMethod[methodID] = GetPendingNoncesMethodReads
+		default:
+			ReadsRequiredByMethod[methodID] = DefaultReads
+		}
+	}
+}
+
+type Contract struct {
+	precompiletypes.BaseContract
+
+	crosschainKeeper crosschainkeeper.Keeper
+	cdc              codec.Codec
+	kvGasConfig      storetypes.GasConfig
+}
+
+func NewICrosschainContract(
+	crosschainKeeper *crosschainkeeper.Keeper,
+	cdc codec.Codec,
+	kvGasConfig storetypes.GasConfig,
+) *Contract {
+	return &Contract{
+		BaseContract:     precompiletypes.NewBaseContract(ContractAddress),
+		crosschainKeeper: *crosschainKeeper,
+		cdc:              cdc,
+		kvGasConfig:      kvGasConfig,
+	}
+}
+
+// Address() is required to implement the PrecompiledContract interface.
+func (c *Contract) Address() common.Address {
+	return ContractAddress
+}
+
+// Abi() is required to implement the PrecompiledContract interface.
+func (c *Contract) Abi() abi.ABI {
+	return ABI
+}
+
+// RequiredGas is required to implement the PrecompiledContract interface.
+// The gas has to be calculated deterministically based on the input.
+// All the methods are read-only, the gas is the read cost of the store reads of the method.
+func (c *Contract) RequiredGas(input []byte) uint64 {
+	// get methodID (first 4 bytes)
+	var methodID [4]byte
+	copy(methodID[:], input[:4])
+
+	// base cost to prevent large input size
+	baseCost := uint64(len(input)) * c.kvGasConfig.ReadCostPerByte
+
+	if reads, ok := ReadsRequiredByMethod[methodID]; ok {
+		return reads*c.kvGasConfig.ReadCostFlat + baseCost
+	}
+
+	// Can not happen, but return 0 if the method is not found.
+	return 0
+}
+
+// useReadGas charges the gas of store reads that can't be known before the execution.
+func (c *Contract) useReadGas(contract *vm.Contract, reads, bytes uint64) error {
+	gas := reads*c.kvGasConfig.ReadCostFlat + bytes*c.kvGasConfig.ReadCostPerByte
+	if !contract.UseGas(gas) {
+		return vm.ErrOutOfGas
+	}
+	return nil
+}
+
+// Run is the entrypoint of the precompiled contract, it switches over the input method,
+// and execute them accordingly.
+// All the methods are read-only, the size of the returned data is charged as bytes read from the store.
+func (c *Contract) Run(evm *vm.EVM, contract *vm.Contract, _ bool) ([]byte, error) {
+	method, err := ABI.MethodById(contract.Input[:4])
+	if err != nil {
+		return nil, err
+	}
+
+	args, err := method.Inputs.Unpack(contract.Input[4:])
+	if err != nil {
+		return nil, err
+	}
+
+	stateDB := evm.StateDB.(precompiletypes.ExtStateDB)
+
+	var res []byte
+	execErr := stateDB.ExecuteNativeAction(contract.Address(), nil, func(ctx sdk.Context) error {
+		switch method.Name {
+		case GetCctxMethodName:
+			res, err = c.getCctx(ctx, method, args)
+		case GetCctxsByInboundHashMethodName:
+			res, err = c.getCctxsByInboundHash(ctx, contract, method, args)
+		case GetGasPriceMethodName:
+			res, err = c.getGasPrice(ctx, method, args)
+		case GetPendingNoncesMethodName:
+			res, err = c.getPendingNonces(ctx, method, args)
+		default:
+			err = precompiletypes.ErrInvalidMethod{
+				Method: method.Name,
+			}
+		}
+		return err
+	})
+	if execErr != nil {
+		return nil, err
+	}
+
+	if err := c.useReadGas(contract, 0, uint64(len(res))); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
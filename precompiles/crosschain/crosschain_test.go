@@ -0,0 +1,77 @@
+package crosschain
+
+import (
+	"encoding/json"
+	"testing"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/stretchr/testify/require"
+	ethermint "github.com/zeta-chain/ethermint/types"
+
+	"github.com/zeta-chain/node/testutil/keeper"
+)
+
+func Test_ICrosschainContract(t *testing.T) {
+	var encoding ethermint.EncodingConfig
+	appCodec := encoding.Codec
+	crosschainKeeper, _, _, _ := keeper.CrosschainKeeper(t)
+	gasConfig := storetypes.TransientGasConfig()
+
+	t.Run("should create contract and check address and ABI", func(t *testing.T) {
+		contract := NewICrosschainContract(crosschainKeeper, appCodec, gasConfig)
+		require.NotNil(t, contract, "NewICrosschainContract() should not return a nil contract")
+
+		address := contract.Address()
+		require.Equal(t, ContractAddress, address, "contract address should match the precompiled address")
+
+		abi := contract.Abi()
+		require.NotNil(t, abi, "contract ABI should not be nil")
+	})
+
+	t.Run("should check methods are present in ABI", func(t *testing.T) {
+		abi := NewICrosschainContract(crosschainKeeper, appCodec, gasConfig).Abi()
+
+		for _, methodName := range []string{
+			GetCctxMethodName,
+			GetCctxsByInboundHashMethodName,
+			GetGasPriceMethodName,
+			GetPendingNoncesMethodName,
+		} {
+			require.NotNil(t, abi.Methods[methodName], "%s method should be present in the ABI", methodName)
+			require.True(t, abi.Methods[methodName].IsConstant(), "%s method should be a view method", methodName)
+		}
+	})
+
+	t.Run("should check gas requirements for methods", func(t *testing.T) {
+		contract := NewICrosschainContract(crosschainKeeper, appCodec, gasConfig)
+		abi := contract.Abi()
+
+		for methodName, reads := range map[string]uint64{
+			GetCctxMethodName:               GetCctxMethodReads,
+			GetCctxsByInboundHashMethodName: GetCctxsByInboundHashMethodReads,
+			GetGasPriceMethodName:           GetGasPriceMethodReads,
+			GetPendingNoncesMethodName:      GetPendingNoncesMethodReads,
+		} {
+			input := abi.Methods[methodName].ID
+			expected := reads*gasConfig.ReadCostFlat + uint64(len(input))*gasConfig.ReadCostPerByte
+			require.Equal(t, expected, contract.RequiredGas(input), methodName)
+		}
+
+		t.Run("invalid method", func(t *testing.T) {
+			invalidMethodBytes := []byte("invalidMethod")
+			gasInvalidMethod := contract.RequiredGas(invalidMethodBytes)
+			require.Equal(t, uint64(0), gasInvalidMethod)
+		})
+	})
+}
+
+func Test_InvalidABI(t *testing.T) {
+	ICrosschainMetaData.ABI = "invalid json"
+	defer func() {
+		if r := recover(); r != nil {
+			require.IsType(t, &json.SyntaxError{}, r, "expected error type: json.SyntaxError, got: %T", r)
+		}
+	}()
+
+	initABI()
+}
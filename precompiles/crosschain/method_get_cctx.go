@@ -0,0 +1,138 @@
+package crosschain
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	precompiletypes "github.com/zeta-chain/node/precompiles/types"
+	crosschaintypes "github.com/zeta-chain/node/x/crosschain/types"
+)
+
+// getCctx returns a CCTX from its index.
+// Call this function using solidity with the following signature:
+// From ICrosschain.sol: function getCctx(string memory index) external view returns (CCTX calldata cctx);
+func (c *Contract) getCctx(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 1 {
+		return nil, &precompiletypes.ErrInvalidNumberOfArgs{
+			Got:    len(args),
+			Expect: 1,
+		}
+	}
+
+	index, ok := args[0].(string)
+	if !ok {
+		return nil, precompiletypes.ErrInvalidArgument{
+			Got: args[0],
+		}
+	}
+
+	cctx, found := c.crosschainKeeper.GetCrossChainTx(ctx, index)
+	if !found {
+		return nil, precompiletypes.ErrNotFound{
+			What: "cctx",
+			Key:  index,
+		}
+	}
+
+	return method.Outputs.Pack(newCCTX(cctx))
+}
+
+// getCctxsByInboundHash returns the CCTXs created from an inbound.
+// The inbound hash mapping read is charged in RequiredGas, each CCTX read is charged during the execution.
+// Call this function using solidity with the following signature:
+// From ICrosschain.sol: function getCctxsByInboundHash(string memory inboundHash) external view returns (CCTX[] calldata cctxs);
+func (c *Contract) getCctxsByInboundHash(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 1 {
+		return nil, &precompiletypes.ErrInvalidNumberOfArgs{
+			Got:    len(args),
+			Expect: 1,
+		}
+	}
+
+	inboundHash, ok := args[0].(string)
+	if !ok {
+		return nil, precompiletypes.ErrInvalidArgument{
+			Got: args[0],
+		}
+	}
+
+	inboundHashToCctx, found := c.crosschainKeeper.GetInboundHashToCctx(ctx, inboundHash)
+	if !found {
+		return nil, precompiletypes.ErrNotFound{
+			What: "inbound hash",
+			Key:  inboundHash,
+		}
+	}
+
+	if err := c.useReadGas(contract, uint64(len(inboundHashToCctx.CctxIndex)), 0); err != nil {
+		return nil, err
+	}
+
+	cctxs := make([]CCTX, 0, len(inboundHashToCctx.CctxIndex))
+	for _, index := range inboundHashToCctx.CctxIndex {
+		cctx, found := c.crosschainKeeper.GetCrossChainTx(ctx, index)
+		if !found {
+			return nil, precompiletypes.ErrNotFound{
+				What: "cctx",
+				Key:  index,
+			}
+		}
+		cctxs = append(cctxs, newCCTX(cctx))
+	}
+
+	return method.Outputs.Pack(cctxs)
+}
+
+// newCCTX converts a CCTX of the crosschain module into its ABI representation.
+// The outbound hash is the hash of the outbound to the receiver chain, the revert hash is the hash of
+// the revert outbound to the sender chain if the CCTX has been reverted.
+func newCCTX(cctx crosschaintypes.CrossChainTx) CCTX {
+	res := CCTX{
+		Index: cctx.Index,
+		RevertInfo: RevertInfo{
+			RevertAddress:  cctx.RevertOptions.RevertAddress,
+			CallOnRevert:   cctx.RevertOptions.CallOnRevert,
+			AbortAddress:   cctx.RevertOptions.AbortAddress,
+			RevertMessage:  cctx.RevertOptions.RevertMessage,
+			RevertGasLimit: big.NewInt(0),
+		},
+	}
+	if !cctx.RevertOptions.RevertGasLimit.IsNil() {
+		res.RevertInfo.RevertGasLimit = cctx.RevertOptions.RevertGasLimit.BigInt()
+	}
+
+	if cctx.CctxStatus != nil {
+		res.Status = uint8(cctx.CctxStatus.Status)
+		res.StatusMessage = cctx.CctxStatus.StatusMessage
+		res.ErrorMessage = cctx.CctxStatus.ErrorMessage
+		res.RevertInfo.IsAbortRefunded = cctx.CctxStatus.IsAbortRefunded
+	}
+
+	if cctx.InboundParams != nil {
+		res.SenderChainId = cctx.InboundParams.SenderChainId
+		res.InboundHash = cctx.InboundParams.ObservedHash
+	}
+
+	if len(cctx.OutboundParams) > 0 && cctx.OutboundParams[0] != nil {
+		res.ReceiverChainId = cctx.OutboundParams[0].ReceiverChainId
+		res.OutboundHash = cctx.OutboundParams[0].Hash
+	}
+
+	if len(cctx.OutboundParams) > 1 && cctx.OutboundParams[1] != nil {
+		res.RevertInfo.RevertHash = cctx.OutboundParams[1].Hash
+	}
+
+	return res
+}
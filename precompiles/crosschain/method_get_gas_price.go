@@ -0,0 +1,55 @@
+package crosschain
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+
+	precompiletypes "github.com/zeta-chain/node/precompiles/types"
+)
+
+// getGasPrice returns the median gas price and priority fee voted by the observers for a chain.
+// Call this function using solidity with the following signature:
+// From ICrosschain.sol: function getGasPrice(int64 chainId) external view returns (GasPrice calldata gasPrice);
+func (c *Contract) getGasPrice(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 1 {
+		return nil, &precompiletypes.ErrInvalidNumberOfArgs{
+			Got:    len(args),
+			Expect: 1,
+		}
+	}
+
+	chainID, ok := args[0].(int64)
+	if !ok {
+		return nil, precompiletypes.ErrInvalidArgument{
+			Got: args[0],
+		}
+	}
+
+	gasPrice, found := c.crosschainKeeper.GetGasPrice(ctx, chainID)
+	if !found || gasPrice.MedianIndex >= uint64(len(gasPrice.Prices)) {
+		return nil, precompiletypes.ErrNotFound{
+			What: "gas price",
+			Key:  fmt.Sprintf("chain %d", chainID),
+		}
+	}
+
+	median, priorityFee, _ := c.crosschainKeeper.GetMedianGasValues(ctx, chainID)
+
+	var blockNumber uint64
+	if gasPrice.MedianIndex < uint64(len(gasPrice.BlockNums)) {
+		blockNumber = gasPrice.BlockNums[gasPrice.MedianIndex]
+	}
+
+	return method.Outputs.Pack(GasPrice{
+		ChainId:     chainID,
+		GasPrice:    median.BigInt(),
+		PriorityFee: priorityFee.BigInt(),
+		BlockNumber: blockNumber,
+	})
+}
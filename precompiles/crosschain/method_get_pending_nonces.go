@@ -0,0 +1,57 @@
+package crosschain
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+
+	precompiletypes "github.com/zeta-chain/node/precompiles/types"
+)
+
+// getPendingNonces returns the pending outbound nonces of a chain for the current TSS.
+// Call this function using solidity with the following signature:
+// From ICrosschain.sol: function getPendingNonces(int64 chainId) external view returns (PendingNonces calldata pendingNonces);
+func (c *Contract) getPendingNonces(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 1 {
+		return nil, &precompiletypes.ErrInvalidNumberOfArgs{
+			Got:    len(args),
+			Expect: 1,
+		}
+	}
+
+	chainID, ok := args[0].(int64)
+	if !ok {
+		return nil, precompiletypes.ErrInvalidArgument{
+			Got: args[0],
+		}
+	}
+
+	observerKeeper := c.crosschainKeeper.GetObserverKeeper()
+
+	tss, found := observerKeeper.GetTSS(ctx)
+	if !found {
+		return nil, precompiletypes.ErrNotFound{
+			What: "tss",
+			Key:  "current",
+		}
+	}
+
+	pendingNonces, found := observerKeeper.GetPendingNonces(ctx, tss.TssPubkey, chainID)
+	if !found {
+		return nil, precompiletypes.ErrNotFound{
+			What: "pending nonces",
+			Key:  fmt.Sprintf("chain %d", chainID),
+		}
+	}
+
+	return method.Outputs.Pack(PendingNonces{
+		ChainId:   chainID,
+		NonceLow:  pendingNonces.NonceLow,
+		NonceHigh: pendingNonces.NonceHigh,
+	})
+}
@@ -0,0 +1,279 @@
+package crosschain
+
+import (
+	"math/big"
+	"testing"
+
+	"cosmossdk.io/math"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/require"
+	ethermint "github.com/zeta-chain/ethermint/types"
+	"github.com/zeta-chain/ethermint/x/evm/statedb"
+
+	precompiletypes "github.com/zeta-chain/node/precompiles/types"
+	"github.com/zeta-chain/node/testutil/keeper"
+	"github.com/zeta-chain/node/testutil/sample"
+	crosschainkeeper "github.com/zeta-chain/node/x/crosschain/keeper"
+	crosschaintypes "github.com/zeta-chain/node/x/crosschain/types"
+	observertypes "github.com/zeta-chain/node/x/observer/types"
+)
+
+func Test_Methods(t *testing.T) {
+	t.Run("should get a cctx", func(t *testing.T) {
+		ts := setupChain(t)
+		cctx := sample.CrossChainTx(t, "foo")
+		cctx.CctxStatus.Status = crosschaintypes.CctxStatus_Reverted
+		cctx.RevertOptions = crosschaintypes.RevertOptions{
+			RevertAddress:  sample.EthAddress().Hex(),
+			CallOnRevert:   true,
+			AbortAddress:   sample.EthAddress().Hex(),
+			RevertMessage:  []byte("revert"),
+			RevertGasLimit: math.NewUint(100_000),
+		}
+		ts.crosschainKeeper.SetCrossChainTx(ts.ctx, *cctx)
+
+		methodID := ts.crosschainABI.Methods[GetCctxMethodName]
+		ts.mockVMContract.Input = packInputArgs(t, methodID, cctx.Index)
+
+		res, err := ts.crosschainContract.Run(ts.mockEVM, ts.mockVMContract, true)
+		require.NoError(t, err)
+
+		got := *abi.ConvertType(mustUnpack(t, methodID, res)[0], new(CCTX)).(*CCTX)
+		require.Equal(t, cctx.Index, got.Index)
+		require.EqualValues(t, crosschaintypes.CctxStatus_Reverted, got.Status)
+		require.Equal(t, cctx.CctxStatus.StatusMessage, got.StatusMessage)
+		require.Equal(t, cctx.CctxStatus.ErrorMessage, got.ErrorMessage)
+		require.Equal(t, cctx.InboundParams.SenderChainId, got.SenderChainId)
+		require.Equal(t, cctx.InboundParams.ObservedHash, got.InboundHash)
+		require.Equal(t, cctx.OutboundParams[0].ReceiverChainId, got.ReceiverChainId)
+		require.Equal(t, cctx.OutboundParams[0].Hash, got.OutboundHash)
+		require.Equal(t, cctx.RevertOptions.RevertAddress, got.RevertInfo.RevertAddress)
+		require.True(t, got.RevertInfo.CallOnRevert)
+		require.Equal(t, cctx.RevertOptions.AbortAddress, got.RevertInfo.AbortAddress)
+		require.Equal(t, []byte("revert"), got.RevertInfo.RevertMessage)
+		require.EqualValues(t, 100_000, got.RevertInfo.RevertGasLimit.Uint64())
+		require.Equal(t, cctx.OutboundParams[1].Hash, got.RevertInfo.RevertHash)
+		require.Equal(t, cctx.CctxStatus.IsAbortRefunded, got.RevertInfo.IsAbortRefunded)
+	})
+
+	t.Run("should fail to get a cctx not found", func(t *testing.T) {
+		ts := setupChain(t)
+
+		methodID := ts.crosschainABI.Methods[GetCctxMethodName]
+		ts.mockVMContract.Input = packInputArgs(t, methodID, "foo")
+
+		res, err := ts.crosschainContract.Run(ts.mockEVM, ts.mockVMContract, true)
+		require.ErrorIs(t, err, precompiletypes.ErrNotFound{What: "cctx", Key: "foo"})
+		require.Empty(t, res)
+	})
+
+	t.Run("should get the cctxs of an inbound hash", func(t *testing.T) {
+		ts := setupChain(t)
+		cctx1 := sample.CrossChainTx(t, "foo")
+		cctx2 := sample.CrossChainTx(t, "bar")
+		ts.crosschainKeeper.SetCrossChainTx(ts.ctx, *cctx1)
+		ts.crosschainKeeper.SetCrossChainTx(ts.ctx, *cctx2)
+		ts.crosschainKeeper.SetInboundHashToCctx(ts.ctx, crosschaintypes.InboundHashToCctx{
+			InboundHash: "inbound",
+			CctxIndex:   []string{cctx1.Index, cctx2.Index},
+		})
+
+		methodID := ts.crosschainABI.Methods[GetCctxsByInboundHashMethodName]
+		ts.mockVMContract.Input = packInputArgs(t, methodID, "inbound")
+
+		gasBefore := ts.mockVMContract.Gas
+		res, err := ts.crosschainContract.Run(ts.mockEVM, ts.mockVMContract, true)
+		require.NoError(t, err)
+
+		var got []CCTX
+		require.NoError(t, methodID.Outputs.Copy(&got, mustUnpack(t, methodID, res)))
+		require.Len(t, got, 2)
+		require.Equal(t, cctx1.Index, got[0].Index)
+		require.Equal(t, cctx2.Index, got[1].Index)
+
+		// the cctx reads and the result are charged during the execution
+		gasConfig := storetypes.TransientGasConfig()
+		expectedGas := 2*gasConfig.ReadCostFlat + uint64(len(res))*gasConfig.ReadCostPerByte
+		require.Equal(t, expectedGas, gasBefore-ts.mockVMContract.Gas)
+	})
+
+	t.Run("should fail to get the cctxs of an unknown inbound hash", func(t *testing.T) {
+		ts := setupChain(t)
+
+		methodID := ts.crosschainABI.Methods[GetCctxsByInboundHashMethodName]
+		ts.mockVMContract.Input = packInputArgs(t, methodID, "inbound")
+
+		_, err := ts.crosschainContract.Run(ts.mockEVM, ts.mockVMContract, true)
+		require.ErrorIs(t, err, precompiletypes.ErrNotFound{What: "inbound hash", Key: "inbound"})
+	})
+
+	t.Run("should fail with out of gas if the cctx reads can't be paid", func(t *testing.T) {
+		ts := setupChain(t)
+		cctx := sample.CrossChainTx(t, "foo")
+		ts.crosschainKeeper.SetCrossChainTx(ts.ctx, *cctx)
+		ts.crosschainKeeper.SetInboundHashToCctx(ts.ctx, crosschaintypes.InboundHashToCctx{
+			InboundHash: "inbound",
+			CctxIndex:   []string{cctx.Index},
+		})
+
+		methodID := ts.crosschainABI.Methods[GetCctxsByInboundHashMethodName]
+		ts.mockVMContract.Input = packInputArgs(t, methodID, "inbound")
+		ts.mockVMContract.Gas = storetypes.TransientGasConfig().ReadCostFlat - 1
+
+		_, err := ts.crosschainContract.Run(ts.mockEVM, ts.mockVMContract, true)
+		require.ErrorIs(t, err, vm.ErrOutOfGas)
+	})
+
+	t.Run("should get the gas price of a chain", func(t *testing.T) {
+		ts := setupChain(t)
+		ts.crosschainKeeper.SetGasPrice(ts.ctx, crosschaintypes.GasPrice{
+			Index:        "1",
+			ChainId:      1,
+			Signers:      []string{sample.AccAddress(), sample.AccAddress(), sample.AccAddress()},
+			BlockNums:    []uint64{10, 11, 12},
+			Prices:       []uint64{100, 200, 300},
+			PriorityFees: []uint64{1, 2, 3},
+			MedianIndex:  1,
+		})
+
+		methodID := ts.crosschainABI.Methods[GetGasPriceMethodName]
+		ts.mockVMContract.Input = packInputArgs(t, methodID, int64(1))
+
+		res, err := ts.crosschainContract.Run(ts.mockEVM, ts.mockVMContract, true)
+		require.NoError(t, err)
+
+		got := *abi.ConvertType(mustUnpack(t, methodID, res)[0], new(GasPrice)).(*GasPrice)
+		require.Equal(t, int64(1), got.ChainId)
+		require.EqualValues(t, 200, got.GasPrice.Uint64())
+		require.EqualValues(t, 2, got.PriorityFee.Uint64())
+		require.EqualValues(t, 11, got.BlockNumber)
+	})
+
+	t.Run("should fail to get the gas price of an unknown chain", func(t *testing.T) {
+		ts := setupChain(t)
+
+		methodID := ts.crosschainABI.Methods[GetGasPriceMethodName]
+		ts.mockVMContract.Input = packInputArgs(t, methodID, int64(1))
+
+		_, err := ts.crosschainContract.Run(ts.mockEVM, ts.mockVMContract, true)
+		require.ErrorIs(t, err, precompiletypes.ErrNotFound{What: "gas price", Key: "chain 1"})
+	})
+
+	t.Run("should get the pending nonces of a chain", func(t *testing.T) {
+		ts := setupChain(t)
+		tss := sample.Tss()
+		ts.zetaKeepers.ObserverKeeper.SetTSS(ts.ctx, tss)
+		ts.zetaKeepers.ObserverKeeper.SetPendingNonces(ts.ctx, observertypes.PendingNonces{
+			NonceLow:  10,
+			NonceHigh: 15,
+			ChainId:   1,
+			Tss:       tss.TssPubkey,
+		})
+
+		methodID := ts.crosschainABI.Methods[GetPendingNoncesMethodName]
+		ts.mockVMContract.Input = packInputArgs(t, methodID, int64(1))
+
+		res, err := ts.crosschainContract.Run(ts.mockEVM, ts.mockVMContract, true)
+		require.NoError(t, err)
+
+		got := *abi.ConvertType(mustUnpack(t, methodID, res)[0], new(PendingNonces)).(*PendingNonces)
+		require.Equal(t, int64(1), got.ChainId)
+		require.Equal(t, int64(10), got.NonceLow)
+		require.Equal(t, int64(15), got.NonceHigh)
+	})
+
+	t.Run("should fail to get the pending nonces without tss", func(t *testing.T) {
+		ts := setupChain(t)
+
+		methodID := ts.crosschainABI.Methods[GetPendingNoncesMethodName]
+		ts.mockVMContract.Input = packInputArgs(t, methodID, int64(1))
+
+		_, err := ts.crosschainContract.Run(ts.mockEVM, ts.mockVMContract, true)
+		require.ErrorIs(t, err, precompiletypes.ErrNotFound{What: "tss", Key: "current"})
+	})
+
+	t.Run("should fail to get the pending nonces of an unknown chain", func(t *testing.T) {
+		ts := setupChain(t)
+		ts.zetaKeepers.ObserverKeeper.SetTSS(ts.ctx, sample.Tss())
+
+		methodID := ts.crosschainABI.Methods[GetPendingNoncesMethodName]
+		ts.mockVMContract.Input = packInputArgs(t, methodID, int64(1))
+
+		_, err := ts.crosschainContract.Run(ts.mockEVM, ts.mockVMContract, true)
+		require.ErrorIs(t, err, precompiletypes.ErrNotFound{What: "pending nonces", Key: "chain 1"})
+	})
+}
+
+type testSuite struct {
+	ctx                sdk.Context
+	crosschainKeeper   *crosschainkeeper.Keeper
+	zetaKeepers        keeper.ZetaKeepers
+	crosschainContract *Contract
+	crosschainABI      abi.ABI
+	mockEVM            *vm.EVM
+	mockVMContract     *vm.Contract
+}
+
+func setupChain(t *testing.T) testSuite {
+	crosschainKeeper, ctx, sdkKeepers, zetaKeepers := keeper.CrosschainKeeper(t)
+
+	var encoding ethermint.EncodingConfig
+	appCodec := encoding.Codec
+	gasConfig := storetypes.TransientGasConfig()
+
+	contract := NewICrosschainContract(crosschainKeeper, appCodec, gasConfig)
+	require.NotNil(t, contract, "NewICrosschainContract() should not return a nil contract")
+
+	abi := contract.Abi()
+	require.NotNil(t, abi, "contract ABI should not be nil")
+
+	mockEVM := vm.NewEVM(
+		vm.BlockContext{},
+		vm.TxContext{},
+		statedb.New(ctx, sdkKeepers.EvmKeeper, statedb.TxConfig{}),
+		&params.ChainConfig{},
+		vm.Config{},
+	)
+
+	mockVMContract := vm.NewContract(
+		contractRef{address: common.Address{}},
+		contractRef{address: ContractAddress},
+		big.NewInt(0),
+		1_000_000,
+	)
+
+	return testSuite{
+		ctx,
+		crosschainKeeper,
+		zetaKeepers,
+		contract,
+		abi,
+		mockEVM,
+		mockVMContract,
+	}
+}
+
+func packInputArgs(t *testing.T, methodID abi.Method, args ...interface{}) []byte {
+	input, err := methodID.Inputs.Pack(args...)
+	require.NoError(t, err)
+	return append(methodID.ID, input...)
+}
+
+func mustUnpack(t *testing.T, method abi.Method, data []byte) []interface{} {
+	res, err := method.Outputs.Unpack(data)
+	require.NoError(t, err)
+	return res
+}
+
+type contractRef struct {
+	address common.Address
+}
+
+func (c contractRef) Address() common.Address {
+	return c.address
+}
@@ -0,0 +1,61 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.26;
+
+/// @dev The IDistribution contract's address.
+address constant IDISTRIBUTION_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000068; // 104
+
+/// @dev The IDistribution contract's instance.
+IDistribution constant IDISTRIBUTION_CONTRACT = IDistribution(
+    IDISTRIBUTION_PRECOMPILE_ADDRESS
+);
+
+/// @notice Reward coin
+/// @param zrc20 ZRC20 address of the reward, zero address for native coins like ZETA
+/// @param denom Cosmos denomination of the reward
+/// @param amount Reward amount
+struct Reward {
+    address zrc20;
+    string denom;
+    uint256 amount;
+}
+
+interface IDistribution {
+    /// @notice ClaimedRewards event is emitted for each reward claimed when claimRewards function is called.
+    /// @param claim_address Delegator address claiming the rewards.
+    /// @param zrc20_token ZRC20 address of the reward, zero address for native coins like ZETA.
+    /// @param validator Validator address.
+    /// @param amount Claimed amount.
+    event ClaimedRewards(
+        address indexed claim_address,
+        address indexed zrc20_token,
+        address indexed validator,
+        uint256 amount
+    );
+
+    /// @notice WithdrawnEmission event is emitted when withdrawEmission function is called successfully.
+    /// @param withdrawer Observer address withdrawing the emission.
+    /// @param amount Withdrawn amount.
+    event WithdrawnEmission(address indexed withdrawer, uint256 amount);
+
+    /// @notice Claim the delegation rewards of the caller from a validator.
+    /// @dev ZRC20 rewards are unlocked as ZRC20 tokens, native rewards like ZETA are sent as native coins.
+    /// @param validator Validator address.
+    /// @return success Boolean indicating whether the claim was successful.
+    function claimRewards(
+        string memory validator
+    ) external returns (bool success);
+
+    /// @notice Get the pending delegation rewards of a delegator from all validators.
+    /// @param delegator Delegator address.
+    /// @return rewards Pending rewards.
+    function getRewards(
+        address delegator
+    ) external view returns (Reward[] calldata rewards);
+
+    /// @notice Withdraw the observer emission of the caller.
+    /// @param amount Amount of ZETA to withdraw.
+    /// @return success Boolean indicating whether the withdrawal was successful.
+    function withdrawEmission(
+        uint256 amount
+    ) external returns (bool success);
+}
@@ -0,0 +1,7 @@
+//go:generate sh -c "solc IDistribution.sol --combined-json abi | jq '.contracts.\"IDistribution.sol:IDistribution\"'  > IDistribution.json"
+//go:generate sh -c "cat IDistribution.json | jq .abi > IDistribution.abi"
+//go:generate sh -c "abigen --abi IDistribution.abi  --pkg distribution --type IDistribution --out IDistribution.gen.go"
+
+package distribution
+
+var _ Contract
@@ -0,0 +1,19 @@
+package distribution
+
+const (
+	// Write methods.
+	ClaimRewardsMethodName = "claimRewards"
+	ClaimRewardsMethodGas  = 200_000
+	ClaimRewardsEventName  = "ClaimedRewards"
+
+	WithdrawEmissionMethodName = "withdrawEmission"
+	WithdrawEmissionMethodGas  = 50_000
+	WithdrawEmissionEventName  = "WithdrawnEmission"
+
+	// Read methods.
+	GetRewardsMethodName = "getRewards"
+	GetRewardsMethodGas  = 10_000
+
+	// Default gas for unknown methods.
+	DefaultGas = 0
+)
@@ -0,0 +1,178 @@
+package distribution
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	precompiletypes "github.com/zeta-chain/node/precompiles/types"
+	emissionskeeper "github.com/zeta-chain/node/x/emissions/keeper"
+	fungiblekeeper "github.com/zeta-chain/node/x/fungible/keeper"
+)
+
+var (
+	ABI                 abi.ABI
+	ContractAddress     = common.HexToAddress("0x0000000000000000000000000000000000000068")
+	GasRequiredByMethod = map[[4]byte]uint64{}
+	ViewMethod          = map[[4]byte]bool{}
+)
+
+func init() {
+	initABI()
+}
+
+func initABI() {
+	if err := ABI.UnmarshalJSON([]byte(IDistributionMetaData.ABI)); err != nil {
+		panic(err)
+	}
+
+	GasRequiredByMethod = map[[4]byte]uint64{}
+	for methodName := range ABI.Methods {
+		var methodID [4]byte
+		copy(methodID[:], ABI.Methods[methodName].ID[:4])
+		switch methodName {
+		case ClaimRewardsMethodName:
+			GasRequiredByMethod[methodID] = ClaimRewardsMethodGas
+		case WithdrawEmissionMethodName:
+			GasRequiredByMethod[methodID] = WithdrawEmissionMethodGas
+		case GetRewardsMethodName:
+			GasRequiredByMethod[methodID] = GetRewardsMethodGas
+			ViewMethod[methodID] = true
+		default:
+			GasRequiredByMethod[methodID] = DefaultGas
+		}
+	}
+}
+
+type Contract struct {
+	precompiletypes.BaseContract
+
+	distributionKeeper distrkeeper.Keeper
+	emissionsKeeper    emissionskeeper.Keeper
+	fungibleKeeper     fungiblekeeper.Keeper
+	bankKeeper         bankkeeper.Keeper
+	cdc                codec.Codec
+	kvGasConfig        storetypes.GasConfig
+}
+
+func NewIDistributionContract(
+	ctx sdk.Context,
+	distributionKeeper distrkeeper.Keeper,
+	emissionsKeeper emissionskeeper.Keeper,
+	fungibleKeeper fungiblekeeper.Keeper,
+	bankKeeper bankkeeper.Keeper,
+	cdc codec.Codec,
+	kvGasConfig storetypes.GasConfig,
+) *Contract {
+	accAddress := sdk.AccAddress(ContractAddress.Bytes())
+	if fungibleKeeper.GetAuthKeeper().GetAccount(ctx, accAddress) == nil {
+		fungibleKeeper.GetAuthKeeper().SetAccount(ctx, authtypes.NewBaseAccount(accAddress, nil, 0, 0))
+	}
+
+	return &Contract{
+		BaseContract:       precompiletypes.NewBaseContract(ContractAddress),
+		distributionKeeper: distributionKeeper,
+		emissionsKeeper:    emissionsKeeper,
+		fungibleKeeper:     fungibleKeeper,
+		bankKeeper:         bankKeeper,
+		cdc:                cdc,
+		kvGasConfig:        kvGasConfig,
+	}
+}
+
+// Address() is required to implement the PrecompiledContract interface.
+func (c *Contract) Address() common.Address {
+	return ContractAddress
+}
+
+// Abi() is required to implement the PrecompiledContract interface.
+func (c *Contract) Abi() abi.ABI {
+	return ABI
+}
+
+// RequiredGas is required to implement the PrecompiledContract interface.
+// The gas has to be calculated deterministically based on the input.
+func (c *Contract) RequiredGas(input []byte) uint64 {
+	// get methodID (first 4 bytes)
+	var methodID [4]byte
+	copy(methodID[:], input[:4])
+	// base cost to prevent large input size
+	baseCost := uint64(len(input)) * c.kvGasConfig.WriteCostPerByte
+	if ViewMethod[methodID] {
+		baseCost = uint64(len(input)) * c.kvGasConfig.ReadCostPerByte
+	}
+
+	if requiredGas, ok := GasRequiredByMethod[methodID]; ok {
+		return requiredGas + baseCost
+	}
+
+	// Can not happen, but return 0 if the method is not found.
+	return 0
+}
+
+// Run is the entrypoint of the precompiled contract, it switches over the input method,
+// and execute them accordingly.
+func (c *Contract) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) ([]byte, error) {
+	method, err := ABI.MethodById(contract.Input[:4])
+	if err != nil {
+		return nil, err
+	}
+
+	args, err := method.Inputs.Unpack(contract.Input[4:])
+	if err != nil {
+		return nil, err
+	}
+
+	stateDB := evm.StateDB.(precompiletypes.ExtStateDB)
+
+	switch method.Name {
+	// ClaimRewards and WithdrawEmission methods are both not allowed in read-only mode.
+	case ClaimRewardsMethodName, WithdrawEmissionMethodName:
+		if readOnly {
+			return nil, precompiletypes.ErrWriteMethod{
+				Method: method.Name,
+			}
+		}
+
+		var res []byte
+		execErr := stateDB.ExecuteNativeAction(contract.Address(), nil, func(ctx sdk.Context) error {
+			if method.Name == ClaimRewardsMethodName {
+				res, err = c.claimRewards(ctx, evm, contract, method, args)
+			} else if method.Name == WithdrawEmissionMethodName {
+				res, err = c.withdrawEmission(ctx, evm, contract, method, args)
+			}
+			return err
+		})
+		if execErr != nil {
+			res, errPack := method.Outputs.Pack(false)
+			if errPack != nil {
+				return nil, errPack
+			}
+
+			return res, err
+		}
+		return res, nil
+
+	case GetRewardsMethodName:
+		var res []byte
+		execErr := stateDB.ExecuteNativeAction(contract.Address(), nil, func(ctx sdk.Context) error {
+			res, err = c.getRewards(ctx, method, args)
+			return err
+		})
+		if execErr != nil {
+			return nil, err
+		}
+		return res, nil
+
+	default:
+		return nil, precompiletypes.ErrInvalidMethod{
+			Method: method.Name,
+		}
+	}
+}
@@ -0,0 +1,98 @@
+package distribution
+
+import (
+	"encoding/json"
+	"testing"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/stretchr/testify/require"
+	ethermint "github.com/zeta-chain/ethermint/types"
+
+	"github.com/zeta-chain/node/testutil/keeper"
+)
+
+func Test_IDistributionContract(t *testing.T) {
+	var encoding ethermint.EncodingConfig
+	appCodec := encoding.Codec
+	fungibleKeeper, ctx, sdkKeepers, zetaKeepers := keeper.FungibleKeeper(t)
+	gasConfig := storetypes.TransientGasConfig()
+
+	newContract := func() *Contract {
+		return NewIDistributionContract(
+			ctx,
+			sdkKeepers.DistributionKeeper,
+			*zetaKeepers.EmissionsKeeper,
+			*fungibleKeeper,
+			sdkKeepers.BankKeeper,
+			appCodec,
+			gasConfig,
+		)
+	}
+
+	t.Run("should create contract and check address and ABI", func(t *testing.T) {
+		contract := newContract()
+		require.NotNil(t, contract, "NewIDistributionContract() should not return a nil contract")
+
+		address := contract.Address()
+		require.Equal(t, ContractAddress, address, "contract address should match the precompiled address")
+
+		abi := contract.Abi()
+		require.NotNil(t, abi, "contract ABI should not be nil")
+	})
+
+	t.Run("should check methods are present in ABI", func(t *testing.T) {
+		abi := newContract().Abi()
+
+		require.NotNil(t, abi.Methods[ClaimRewardsMethodName], "claimRewards method should be present in the ABI")
+		require.NotNil(t, abi.Methods[GetRewardsMethodName], "getRewards method should be present in the ABI")
+		require.NotNil(
+			t,
+			abi.Methods[WithdrawEmissionMethodName],
+			"withdrawEmission method should be present in the ABI",
+		)
+	})
+
+	t.Run("should check gas requirements for methods", func(t *testing.T) {
+		contract := newContract()
+		abi := contract.Abi()
+		var method [4]byte
+
+		t.Run("claimRewards", func(t *testing.T) {
+			gas := contract.RequiredGas(abi.Methods[ClaimRewardsMethodName].ID)
+			copy(method[:], abi.Methods[ClaimRewardsMethodName].ID[:4])
+			baseCost := uint64(len(method)) * gasConfig.WriteCostPerByte
+			require.Equal(t, GasRequiredByMethod[method]+baseCost, gas)
+		})
+
+		t.Run("withdrawEmission", func(t *testing.T) {
+			gas := contract.RequiredGas(abi.Methods[WithdrawEmissionMethodName].ID)
+			copy(method[:], abi.Methods[WithdrawEmissionMethodName].ID[:4])
+			baseCost := uint64(len(method)) * gasConfig.WriteCostPerByte
+			require.Equal(t, GasRequiredByMethod[method]+baseCost, gas)
+		})
+
+		t.Run("getRewards", func(t *testing.T) {
+			gas := contract.RequiredGas(abi.Methods[GetRewardsMethodName].ID)
+			copy(method[:], abi.Methods[GetRewardsMethodName].ID[:4])
+			baseCost := uint64(len(method)) * gasConfig.ReadCostPerByte
+			require.Equal(t, GasRequiredByMethod[method]+baseCost, gas)
+		})
+
+		t.Run("invalid method", func(t *testing.T) {
+			invalidMethodBytes := []byte("invalidMethod")
+			gasInvalidMethod := contract.RequiredGas(invalidMethodBytes)
+			require.Equal(t, uint64(0), gasInvalidMethod)
+		})
+	})
+}
+
+func Test_InvalidABI(t *testing.T) {
+	IDistributionMetaData.ABI = "invalid json"
+	defer func() {
+		if r := recover(); r != nil {
+			require.IsType(t, &json.SyntaxError{}, r, "expected error type: json.SyntaxError, got: %T", r)
+		}
+	}()
+
+	initABI()
+}
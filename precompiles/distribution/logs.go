@@ -0,0 +1,70 @@
+package distribution
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/zeta-chain/node/precompiles/logs"
+)
+
+func (c *Contract) addClaimRewardsLog(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	delegator common.Address,
+	zrc20Token common.Address,
+	validator sdk.ValAddress,
+	amount *big.Int,
+) error {
+	event := c.Abi().Events[ClaimRewardsEventName]
+
+	// delegator, token and validator are indexed event params
+	topics, err := logs.MakeTopics(
+		event,
+		[]interface{}{delegator},
+		[]interface{}{zrc20Token},
+		[]interface{}{common.BytesToAddress(validator.Bytes())},
+	)
+	if err != nil {
+		return err
+	}
+
+	// amount is part of event data
+	data, err := logs.PackArguments([]logs.Argument{
+		{Type: "uint256", Value: amount},
+	})
+	if err != nil {
+		return err
+	}
+
+	logs.AddLog(ctx, c.Address(), stateDB, topics, data)
+
+	return nil
+}
+
+func (c *Contract) addWithdrawEmissionLog(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	withdrawer common.Address,
+	amount *big.Int,
+) error {
+	event := c.Abi().Events[WithdrawEmissionEventName]
+
+	topics, err := logs.MakeTopics(event, []interface{}{withdrawer})
+	if err != nil {
+		return err
+	}
+
+	data, err := logs.PackArguments([]logs.Argument{
+		{Type: "uint256", Value: amount},
+	})
+	if err != nil {
+		return err
+	}
+
+	logs.AddLog(ctx, c.Address(), stateDB, topics, data)
+
+	return nil
+}
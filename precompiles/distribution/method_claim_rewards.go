@@ -0,0 +1,131 @@
+package distribution
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/zeta-chain/node/cmd/zetacored/config"
+	"github.com/zeta-chain/node/precompiles/bank"
+	precompiletypes "github.com/zeta-chain/node/precompiles/types"
+	fungibletypes "github.com/zeta-chain/node/x/fungible/types"
+)
+
+// claimRewards claims the delegation rewards of the caller from a validator.
+// The delegator is the direct caller of the precompile and not the transaction origin,
+// this way contracts, like liquid staking contracts, can claim the rewards of their own delegations.
+// The rewards are sent to the withdraw address of the delegator. ZRC20 rewards distributed with the staking
+// precompile are converted back into ZRC20 tokens: the cosmos coins are burnt and the ZRC20 locked
+// in the bank precompile are unlocked to the withdraw address.
+// Call this function using solidity with the following signature:
+// From IDistribution.sol: function claimRewards(string memory validator) external returns (bool success);
+func (c *Contract) claimRewards(
+	ctx sdk.Context,
+	evm *vm.EVM,
+	contract *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 1 {
+		return nil, &precompiletypes.ErrInvalidNumberOfArgs{
+			Got:    len(args),
+			Expect: 1,
+		}
+	}
+
+	validatorAddress, ok := args[0].(string)
+	if !ok {
+		return nil, precompiletypes.ErrInvalidArgument{
+			Got: args[0],
+		}
+	}
+
+	validator, err := sdk.ValAddressFromBech32(validatorAddress)
+	if err != nil {
+		return nil, &precompiletypes.ErrInvalidAddr{
+			Got:    validatorAddress,
+			Reason: err.Error(),
+		}
+	}
+
+	delegator := contract.CallerAddress
+	delegatorAddr := sdk.AccAddress(delegator.Bytes())
+
+	rewards, err := c.distributionKeeper.WithdrawDelegationRewards(ctx, delegatorAddr, validator)
+	if err != nil {
+		return nil, &precompiletypes.ErrUnexpected{
+			When: "WithdrawDelegationRewards",
+			Got:  err.Error(),
+		}
+	}
+
+	stateDB := evm.StateDB.(precompiletypes.ExtStateDB)
+	withdrawAddr := c.distributionKeeper.GetDelegatorWithdrawAddr(ctx, delegatorAddr)
+	for _, reward := range rewards {
+		// Native rewards, like ZETA, are kept as cosmos coins and are logged with the zero address.
+		zrc20Addr, isZRC20 := precompiletypes.CosmosDenomToZRC20(reward.Denom)
+		if isZRC20 {
+			if err := c.unlockZRC20Reward(ctx, withdrawAddr, zrc20Addr, reward); err != nil {
+				return nil, err
+			}
+		}
+
+		// if caller is not the same as origin it means call is coming through smart contract,
+		// and because state of smart contract calling precompile might be updated as well
+		// manually increase ZETA amount in stateDB, so it is properly reflected in bank module
+		if reward.Denom == config.BaseDenom && contract.CallerAddress != evm.Origin {
+			stateDB.AddBalance(common.BytesToAddress(withdrawAddr.Bytes()), reward.Amount.BigInt())
+		}
+
+		if err := c.addClaimRewardsLog(ctx, stateDB, delegator, zrc20Addr, validator, reward.Amount.BigInt()); err != nil {
+			return nil, &precompiletypes.ErrUnexpected{
+				When: "AddClaimRewardsLog",
+				Got:  err.Error(),
+			}
+		}
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// unlockZRC20Reward burns the ZRC20 cosmos coins received as reward by the withdraw address
+// and unlocks the same amount of ZRC20 tokens from the bank precompile to the withdraw address.
+func (c *Contract) unlockZRC20Reward(
+	ctx sdk.Context,
+	withdrawAddr sdk.AccAddress,
+	zrc20Addr common.Address,
+	reward sdk.Coin,
+) error {
+	coinSet := sdk.NewCoins(reward)
+
+	if err := c.bankKeeper.SendCoinsFromAccountToModule(ctx, withdrawAddr, fungibletypes.ModuleName, coinSet); err != nil {
+		return &precompiletypes.ErrUnexpected{
+			When: "SendCoinsFromAccountToModule",
+			Got:  err.Error(),
+		}
+	}
+
+	if err := c.bankKeeper.BurnCoins(ctx, fungibletypes.ModuleName, coinSet); err != nil {
+		return &precompiletypes.ErrUnexpected{
+			When: "BurnCoins",
+			Got:  err.Error(),
+		}
+	}
+
+	err := c.fungibleKeeper.UnlockZRC20(
+		ctx,
+		zrc20Addr,
+		common.BytesToAddress(withdrawAddr.Bytes()),
+		bank.ContractAddress,
+		reward.Amount.BigInt(),
+	)
+	if err != nil {
+		return &precompiletypes.ErrUnexpected{
+			When: "UnlockZRC20InBank",
+			Got:  err.Error(),
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,61 @@
+package distribution
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	precompiletypes "github.com/zeta-chain/node/precompiles/types"
+)
+
+// getRewards returns the pending delegation rewards of a delegator from all its validators.
+// The amounts are truncated to the integer amounts that can be claimed.
+// Call this function using solidity with the following signature:
+// From IDistribution.sol: function getRewards(address delegator) external view returns (Reward[] calldata rewards);
+func (c *Contract) getRewards(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 1 {
+		return nil, &precompiletypes.ErrInvalidNumberOfArgs{
+			Got:    len(args),
+			Expect: 1,
+		}
+	}
+
+	delegator, ok := args[0].(common.Address)
+	if !ok {
+		return nil, &precompiletypes.ErrInvalidAddr{
+			Got: delegator.String(),
+		}
+	}
+
+	res, err := distrkeeper.NewQuerier(c.distributionKeeper).DelegationTotalRewards(
+		ctx,
+		&distrtypes.QueryDelegationTotalRewardsRequest{
+			DelegatorAddress: sdk.AccAddress(delegator.Bytes()).String(),
+		},
+	)
+	if err != nil {
+		return nil, &precompiletypes.ErrUnexpected{
+			When: "DelegationTotalRewards",
+			Got:  err.Error(),
+		}
+	}
+
+	total, _ := res.Total.TruncateDecimal()
+	rewards := make([]Reward, 0, len(total))
+	for _, coin := range total {
+		zrc20Addr, _ := precompiletypes.CosmosDenomToZRC20(coin.Denom)
+		rewards = append(rewards, Reward{
+			Zrc20:  zrc20Addr,
+			Denom:  coin.Denom,
+			Amount: coin.Amount.BigInt(),
+		})
+	}
+
+	return method.Outputs.Pack(rewards)
+}
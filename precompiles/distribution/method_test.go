@@ -0,0 +1,588 @@
+package distribution
+
+import (
+	"math/big"
+	"math/rand"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/require"
+	ethermint "github.com/zeta-chain/ethermint/types"
+	evmkeeper "github.com/zeta-chain/ethermint/x/evm/keeper"
+	"github.com/zeta-chain/ethermint/x/evm/statedb"
+
+	"github.com/zeta-chain/node/cmd/zetacored/config"
+	"github.com/zeta-chain/node/pkg/chains"
+	"github.com/zeta-chain/node/precompiles/bank"
+	precompiletypes "github.com/zeta-chain/node/precompiles/types"
+	"github.com/zeta-chain/node/testutil/keeper"
+	"github.com/zeta-chain/node/testutil/sample"
+	emissionstypes "github.com/zeta-chain/node/x/emissions/types"
+	fungiblekeeper "github.com/zeta-chain/node/x/fungible/keeper"
+	fungibletypes "github.com/zeta-chain/node/x/fungible/types"
+)
+
+func Test_Methods(t *testing.T) {
+	t.Run("should fail when trying to run claimRewards as read only method", func(t *testing.T) {
+		ts := setupChain(t)
+		methodID := ts.distributionABI.Methods[ClaimRewardsMethodName]
+		ts.mockVMContract.Input = packInputArgs(t, methodID, ts.validator.OperatorAddress)
+
+		success, err := ts.run(t, true)
+		require.ErrorIs(t, precompiletypes.ErrWriteMethod{Method: ClaimRewardsMethodName}, err)
+		require.Empty(t, success)
+	})
+
+	t.Run("should fail when trying to run withdrawEmission as read only method", func(t *testing.T) {
+		ts := setupChain(t)
+		methodID := ts.distributionABI.Methods[WithdrawEmissionMethodName]
+		ts.mockVMContract.Input = packInputArgs(t, methodID, big.NewInt(1))
+
+		success, err := ts.run(t, true)
+		require.ErrorIs(t, precompiletypes.ErrWriteMethod{Method: WithdrawEmissionMethodName}, err)
+		require.Empty(t, success)
+	})
+
+	t.Run("should fail to claim rewards with an invalid validator", func(t *testing.T) {
+		ts := setupChain(t)
+		ts.mockVMContract.CallerAddress = ts.delegator
+		methodID := ts.distributionABI.Methods[ClaimRewardsMethodName]
+		ts.mockVMContract.Input = packInputArgs(t, methodID, "invalid")
+
+		success, err := ts.run(t, false)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "invalid address invalid")
+
+		res, err := methodID.Outputs.Unpack(success)
+		require.NoError(t, err)
+		require.False(t, res[0].(bool))
+	})
+
+	t.Run("should fail to claim rewards without delegation", func(t *testing.T) {
+		ts := setupChain(t)
+		ts.mockVMContract.CallerAddress = sample.EthAddress()
+		methodID := ts.distributionABI.Methods[ClaimRewardsMethodName]
+		ts.mockVMContract.Input = packInputArgs(t, methodID, ts.validator.OperatorAddress)
+
+		success, err := ts.run(t, false)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "WithdrawDelegationRewards")
+
+		res, err := methodID.Outputs.Unpack(success)
+		require.NoError(t, err)
+		require.False(t, res[0].(bool))
+	})
+
+	t.Run("should get the rewards of a delegator", func(t *testing.T) {
+		ts := setupChain(t)
+		ts.allocateRewards(t, big.NewInt(4200), big.NewInt(2100))
+
+		methodID := ts.distributionABI.Methods[GetRewardsMethodName]
+		ts.mockVMContract.Input = packInputArgs(t, methodID, ts.delegator)
+
+		res, err := ts.run(t, true)
+		require.NoError(t, err)
+
+		var rewards []Reward
+		require.NoError(t, methodID.Outputs.Copy(&rewards, mustUnpack(t, methodID, res)))
+		require.Len(t, rewards, 2)
+
+		for _, reward := range rewards {
+			switch reward.Denom {
+			case config.BaseDenom:
+				require.Equal(t, common.Address{}, reward.Zrc20)
+				require.Equal(t, int64(4200), reward.Amount.Int64())
+			case precompiletypes.ZRC20ToCosmosDenom(ts.zrc20Address):
+				require.Equal(t, ts.zrc20Address, reward.Zrc20)
+				require.Equal(t, int64(2100), reward.Amount.Int64())
+			default:
+				t.Fatalf("unexpected reward denom %s", reward.Denom)
+			}
+		}
+	})
+
+	t.Run("should get no rewards for an address without delegation", func(t *testing.T) {
+		ts := setupChain(t)
+
+		methodID := ts.distributionABI.Methods[GetRewardsMethodName]
+		ts.mockVMContract.Input = packInputArgs(t, methodID, sample.EthAddress())
+
+		res, err := ts.run(t, true)
+		require.NoError(t, err)
+
+		var rewards []Reward
+		require.NoError(t, methodID.Outputs.Copy(&rewards, mustUnpack(t, methodID, res)))
+		require.Empty(t, rewards)
+	})
+
+	t.Run("should claim rewards and unlock ZRC20 rewards", func(t *testing.T) {
+		ts := setupChain(t)
+		ts.allocateRewards(t, big.NewInt(4200), big.NewInt(2100))
+
+		ts.mockVMContract.CallerAddress = ts.delegator
+		methodID := ts.distributionABI.Methods[ClaimRewardsMethodName]
+		ts.mockVMContract.Input = packInputArgs(t, methodID, ts.validator.OperatorAddress)
+
+		success, err := ts.run(t, false)
+		require.NoError(t, err)
+
+		res, err := methodID.Outputs.Unpack(success)
+		require.NoError(t, err)
+		require.True(t, res[0].(bool))
+
+		// A log is emitted for each reward denomination.
+		logs := ts.stateDB.Logs()
+		require.Len(t, logs, 2)
+		for _, log := range logs {
+			require.Equal(t, ContractAddress, log.Address)
+			require.Equal(t, ts.distributionABI.Events[ClaimRewardsEventName].ID, log.Topics[0])
+			require.Equal(t, common.BytesToHash(ts.delegator.Bytes()), log.Topics[1])
+		}
+
+		// Native rewards are kept as cosmos coins.
+		delegatorAddr := sdk.AccAddress(ts.delegator.Bytes())
+		balance := ts.sdkKeepers.BankKeeper.GetBalance(ts.ctx, delegatorAddr, config.BaseDenom)
+		require.Equal(t, int64(4200), balance.Amount.Int64())
+
+		// ZRC20 rewards are unlocked from the bank precompile and the cosmos coins are burnt.
+		zrc20Denom := precompiletypes.ZRC20ToCosmosDenom(ts.zrc20Address)
+		balance = ts.sdkKeepers.BankKeeper.GetBalance(ts.ctx, delegatorAddr, zrc20Denom)
+		require.True(t, balance.Amount.IsZero())
+		require.True(t, ts.sdkKeepers.BankKeeper.GetSupply(ts.ctx, zrc20Denom).Amount.IsZero())
+
+		zrc20Balance, err := ts.fungibleKeeper.ZRC20BalanceOf(ts.ctx, ts.zrc20Address, ts.delegator)
+		require.NoError(t, err)
+		require.Equal(t, int64(2100), zrc20Balance.Int64())
+
+		lockedBalance, err := ts.fungibleKeeper.ZRC20BalanceOf(ts.ctx, ts.zrc20Address, bank.ContractAddress)
+		require.NoError(t, err)
+		require.Zero(t, lockedBalance.Sign())
+
+		// The rewards have been claimed.
+		ts.mockVMContract.Input = packInputArgs(t, ts.distributionABI.Methods[GetRewardsMethodName], ts.delegator)
+		resRewards, err := ts.run(t, true)
+		require.NoError(t, err)
+
+		var rewards []Reward
+		require.NoError(
+			t,
+			ts.distributionABI.Methods[GetRewardsMethodName].Outputs.Copy(
+				&rewards,
+				mustUnpack(t, ts.distributionABI.Methods[GetRewardsMethodName], resRewards),
+			),
+		)
+		require.Empty(t, rewards)
+	})
+
+	t.Run("should claim ZETA rewards when called from a contract", func(t *testing.T) {
+		ts := setupChain(t)
+		ts.allocateRewards(t, big.NewInt(4200), big.NewInt(2100))
+
+		ts.mockVMContract.CallerAddress = ts.delegator
+		methodID := ts.distributionABI.Methods[ClaimRewardsMethodName]
+		ts.mockVMContract.Input = packInputArgs(t, methodID, ts.validator.OperatorAddress)
+
+		// the contract receives 100 ZETA in the same call before claiming its rewards
+		delegatorAddr := sdk.AccAddress(ts.delegator.Bytes())
+		_, err := ts.runFromContract(t, big.NewInt(100))
+		require.NoError(t, err)
+
+		// the ZETA rewards are not overwritten by the contract balance when the state is committed
+		balance := ts.sdkKeepers.BankKeeper.GetBalance(ts.ctx, delegatorAddr, config.BaseDenom)
+		require.Equal(t, int64(4300), balance.Amount.Int64())
+
+		zrc20Balance, err := ts.fungibleKeeper.ZRC20BalanceOf(ts.ctx, ts.zrc20Address, ts.delegator)
+		require.NoError(t, err)
+		require.Equal(t, int64(2100), zrc20Balance.Int64())
+	})
+
+	t.Run("should fail to withdraw emission without withdrawable emission", func(t *testing.T) {
+		ts := setupChain(t)
+		ts.fundObserverRewardsPool(t, big.NewInt(1000))
+
+		ts.mockVMContract.CallerAddress = ts.delegator
+		methodID := ts.distributionABI.Methods[WithdrawEmissionMethodName]
+		ts.mockVMContract.Input = packInputArgs(t, methodID, big.NewInt(100))
+
+		success, err := ts.run(t, false)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "WithdrawEmission")
+
+		res, err := methodID.Outputs.Unpack(success)
+		require.NoError(t, err)
+		require.False(t, res[0].(bool))
+	})
+
+	t.Run("should fail to withdraw 0 emission", func(t *testing.T) {
+		ts := setupChain(t)
+
+		ts.mockVMContract.CallerAddress = ts.delegator
+		methodID := ts.distributionABI.Methods[WithdrawEmissionMethodName]
+		ts.mockVMContract.Input = packInputArgs(t, methodID, big.NewInt(0))
+
+		_, err := ts.run(t, false)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "invalid token amount: 0")
+	})
+
+	t.Run("should withdraw emission", func(t *testing.T) {
+		ts := setupChain(t)
+		ts.fundObserverRewardsPool(t, big.NewInt(1000))
+
+		withdrawer := sdk.AccAddress(ts.delegator.Bytes())
+		ts.zetaKeepers.EmissionsKeeper.AddObserverEmission(ts.ctx, withdrawer.String(), sdkmath.NewInt(1000))
+
+		ts.mockVMContract.CallerAddress = ts.delegator
+		methodID := ts.distributionABI.Methods[WithdrawEmissionMethodName]
+		ts.mockVMContract.Input = packInputArgs(t, methodID, big.NewInt(400))
+
+		success, err := ts.run(t, false)
+		require.NoError(t, err)
+
+		res, err := methodID.Outputs.Unpack(success)
+		require.NoError(t, err)
+		require.True(t, res[0].(bool))
+
+		logs := ts.stateDB.Logs()
+		require.Len(t, logs, 1)
+		require.Equal(t, ts.distributionABI.Events[WithdrawEmissionEventName].ID, logs[0].Topics[0])
+
+		balance := ts.sdkKeepers.BankKeeper.GetBalance(ts.ctx, withdrawer, config.BaseDenom)
+		require.Equal(t, int64(400), balance.Amount.Int64())
+
+		emission, found := ts.zetaKeepers.EmissionsKeeper.GetWithdrawableEmission(ts.ctx, withdrawer.String())
+		require.True(t, found)
+		require.Equal(t, int64(600), emission.Amount.Int64())
+	})
+
+	t.Run("should withdraw emission when called from a contract", func(t *testing.T) {
+		ts := setupChain(t)
+		ts.fundObserverRewardsPool(t, big.NewInt(1000))
+
+		withdrawer := sdk.AccAddress(ts.delegator.Bytes())
+		ts.zetaKeepers.EmissionsKeeper.AddObserverEmission(ts.ctx, withdrawer.String(), sdkmath.NewInt(1000))
+
+		ts.mockVMContract.CallerAddress = ts.delegator
+		methodID := ts.distributionABI.Methods[WithdrawEmissionMethodName]
+		ts.mockVMContract.Input = packInputArgs(t, methodID, big.NewInt(400))
+
+		// the contract receives 100 ZETA in the same call before withdrawing its emission
+		_, err := ts.runFromContract(t, big.NewInt(100))
+		require.NoError(t, err)
+
+		// the withdrawn emission is not overwritten by the contract balance when the state is committed
+		balance := ts.sdkKeepers.BankKeeper.GetBalance(ts.ctx, withdrawer, config.BaseDenom)
+		require.Equal(t, int64(500), balance.Amount.Int64())
+
+		emission, found := ts.zetaKeepers.EmissionsKeeper.GetWithdrawableEmission(ts.ctx, withdrawer.String())
+		require.True(t, found)
+		require.Equal(t, int64(600), emission.Amount.Int64())
+	})
+}
+
+type testSuite struct {
+	ctx                  sdk.Context
+	fungibleKeeper       *fungiblekeeper.Keeper
+	sdkKeepers           keeper.SDKKeepers
+	zetaKeepers          keeper.ZetaKeepers
+	distributionContract *Contract
+	distributionABI      abi.ABI
+	stateDB              *statedb.StateDB
+	mockVMContract       *vm.Contract
+	zrc20Address         common.Address
+	validator            stakingtypes.Validator
+	delegator            common.Address
+}
+
+func setupChain(t *testing.T) *testSuite {
+	// Initialize basic parameters to mock the chain.
+	fungibleKeeper, ctx, sdkKeepers, zetaKeepers := keeper.FungibleKeeper(t)
+	chainID := getValidChainID(t)
+
+	// Make sure the account store is initialized.
+	// This is completely needed for accounts to be created in the state.
+	fungibleKeeper.GetAuthKeeper().GetModuleAccount(ctx, fungibletypes.ModuleName)
+	fungibleKeeper.GetAuthKeeper().GetModuleAccount(ctx, distrtypes.ModuleName)
+	fungibleKeeper.GetAuthKeeper().GetModuleAccount(ctx, emissionstypes.UndistributedObserverRewardsPool)
+
+	// Deploy system contracts in order to deploy a ZRC20 token.
+	deploySystemContracts(t, ctx, fungibleKeeper, *sdkKeepers.EvmKeeper)
+	zrc20Address := setupGasCoin(t, ctx, fungibleKeeper, sdkKeepers.EvmKeeper, chainID, "ZRC20", "ZRC20")
+
+	// Keepers and chain configuration.
+	var encoding ethermint.EncodingConfig
+	appCodec := encoding.Codec
+	gasConfig := storetypes.TransientGasConfig()
+
+	// Create the distribution contract.
+	contract := NewIDistributionContract(
+		ctx,
+		sdkKeepers.DistributionKeeper,
+		*zetaKeepers.EmissionsKeeper,
+		*fungibleKeeper,
+		sdkKeepers.BankKeeper,
+		appCodec,
+		gasConfig,
+	)
+	require.NotNil(t, contract, "NewIDistributionContract() should not return a nil contract")
+
+	abi := contract.Abi()
+	require.NotNil(t, abi, "contract ABI should not be nil")
+
+	// Delegate to a validator to get delegation rewards.
+	// Delegations don't earn rewards in the block they are created, move to the next block.
+	validator, delegator := setupDelegation(t, ctx, sdkKeepers)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	mockVMContract := vm.NewContract(
+		contractRef{address: common.Address{}},
+		contractRef{address: ContractAddress},
+		big.NewInt(0),
+		0,
+	)
+
+	return &testSuite{
+		ctx,
+		fungibleKeeper,
+		sdkKeepers,
+		zetaKeepers,
+		contract,
+		abi,
+		nil,
+		mockVMContract,
+		zrc20Address,
+		validator,
+		delegator,
+	}
+}
+
+// run runs the distribution contract with a new EVM state on top of the current context.
+// The caller is the transaction origin, the state is committed if the execution succeeds.
+func (ts *testSuite) run(t *testing.T, readOnly bool) ([]byte, error) {
+	ts.stateDB = statedb.New(ts.ctx, ts.sdkKeepers.EvmKeeper, statedb.TxConfig{})
+	return ts.runWithState(t, ts.mockVMContract.CallerAddress, readOnly)
+}
+
+// runFromContract runs the distribution contract as if it was called by a smart contract.
+// The balance of the calling contract is updated in the EVM state before the call, like a contract receiving value,
+// so the account of the caller is dirty when the state is committed.
+func (ts *testSuite) runFromContract(t *testing.T, callerValue *big.Int) ([]byte, error) {
+	ts.stateDB = statedb.New(ts.ctx, ts.sdkKeepers.EvmKeeper, statedb.TxConfig{})
+	ts.stateDB.AddBalance(ts.mockVMContract.CallerAddress, callerValue)
+	return ts.runWithState(t, sample.EthAddress(), false)
+}
+
+// runWithState runs the distribution contract on the current EVM state with the given transaction origin.
+func (ts *testSuite) runWithState(t *testing.T, origin common.Address, readOnly bool) ([]byte, error) {
+	mockEVM := vm.NewEVM(
+		vm.BlockContext{},
+		vm.TxContext{Origin: origin},
+		ts.stateDB,
+		&params.ChainConfig{},
+		vm.Config{},
+	)
+
+	res, err := ts.distributionContract.Run(mockEVM, ts.mockVMContract, readOnly)
+	if err == nil {
+		require.NoError(t, ts.stateDB.Commit())
+	}
+	return res, err
+}
+
+// setupDelegation creates a validator and a delegator holding all the shares of the validator.
+func setupDelegation(
+	t *testing.T,
+	ctx sdk.Context,
+	sdkKeepers keeper.SDKKeepers,
+) (stakingtypes.Validator, common.Address) {
+	distrKeeper := sdkKeepers.DistributionKeeper
+	distrKeeper.SetParams(ctx, distrtypes.DefaultParams())
+	distrKeeper.SetFeePool(ctx, distrtypes.InitialFeePool())
+
+	// Use a copy of the staking keeper to not set the distribution hooks on the shared keeper.
+	stakingKeeper := sdkKeepers.StakingKeeper
+	stakingKeeper.SetHooks(distrKeeper.Hooks())
+
+	stakingParams := stakingKeeper.GetParams(ctx)
+	stakingParams.BondDenom = config.BaseDenom
+	require.NoError(t, stakingKeeper.SetParams(ctx, stakingParams))
+
+	validator := sample.Validator(t, rand.New(rand.NewSource(1)))
+	stakingKeeper.SetValidator(ctx, validator)
+	require.NoError(t, stakingKeeper.SetValidatorByConsAddr(ctx, validator))
+	require.NoError(t, distrKeeper.Hooks().AfterValidatorCreated(ctx, validator.GetOperator()))
+
+	delegator := sample.EthAddress()
+	delegatorAddr := sdk.AccAddress(delegator.Bytes())
+	coins := sample.Coins()
+	require.NoError(t, sdkKeepers.BankKeeper.MintCoins(ctx, fungibletypes.ModuleName, coins))
+	require.NoError(
+		t,
+		sdkKeepers.BankKeeper.SendCoinsFromModuleToAccount(ctx, fungibletypes.ModuleName, delegatorAddr, coins),
+	)
+
+	_, err := stakingKeeper.Delegate(
+		ctx,
+		delegatorAddr,
+		coins.AmountOf(config.BaseDenom),
+		stakingtypes.Unbonded,
+		validator,
+		true,
+	)
+	require.NoError(t, err)
+
+	validator, found := stakingKeeper.GetValidator(ctx, validator.GetOperator())
+	require.True(t, found)
+
+	return validator, delegator
+}
+
+// allocateRewards allocates ZETA and ZRC20 rewards to the validator of the test suite.
+// The ZRC20 rewards are locked in the bank precompile as done by the staking precompile.
+func (ts *testSuite) allocateRewards(t *testing.T, zetaAmount, zrc20Amount *big.Int) {
+	zrc20Denom := precompiletypes.ZRC20ToCosmosDenom(ts.zrc20Address)
+	coins := sdk.NewCoins(
+		sdk.NewCoin(config.BaseDenom, sdkmath.NewIntFromBigInt(zetaAmount)),
+		sdk.NewCoin(zrc20Denom, sdkmath.NewIntFromBigInt(zrc20Amount)),
+	)
+
+	// The bank precompile account is created when the bank contract is instantiated.
+	bankAccAddress := sdk.AccAddress(bank.ContractAddress.Bytes())
+	ts.fungibleKeeper.GetAuthKeeper().SetAccount(ts.ctx, authtypes.NewBaseAccount(bankAccAddress, nil, 0, 0))
+
+	_, err := ts.fungibleKeeper.DepositZRC20(ts.ctx, ts.zrc20Address, bank.ContractAddress, zrc20Amount)
+	require.NoError(t, err)
+
+	require.NoError(t, ts.sdkKeepers.BankKeeper.MintCoins(ts.ctx, fungibletypes.ModuleName, coins))
+	require.NoError(
+		t,
+		ts.sdkKeepers.BankKeeper.SendCoinsFromModuleToModule(
+			ts.ctx,
+			fungibletypes.ModuleName,
+			distrtypes.ModuleName,
+			coins,
+		),
+	)
+
+	ts.sdkKeepers.DistributionKeeper.AllocateTokensToValidator(ts.ctx, ts.validator, sdk.NewDecCoinsFromCoins(coins...))
+}
+
+// fundObserverRewardsPool funds the undistributed observer rewards pool of the emissions module.
+func (ts *testSuite) fundObserverRewardsPool(t *testing.T, amount *big.Int) {
+	coins := sdk.NewCoins(sdk.NewCoin(config.BaseDenom, sdkmath.NewIntFromBigInt(amount)))
+	require.NoError(t, ts.sdkKeepers.BankKeeper.MintCoins(ts.ctx, fungibletypes.ModuleName, coins))
+	require.NoError(
+		t,
+		ts.sdkKeepers.BankKeeper.SendCoinsFromModuleToModule(
+			ts.ctx,
+			fungibletypes.ModuleName,
+			emissionstypes.UndistributedObserverRewardsPool,
+			coins,
+		),
+	)
+}
+
+// setupGasCoin is a helper function to setup the gas coin for testing
+func setupGasCoin(
+	t *testing.T,
+	ctx sdk.Context,
+	k *fungiblekeeper.Keeper,
+	evmk *evmkeeper.Keeper,
+	chainID int64,
+	assetName string,
+	symbol string,
+) (zrc20 common.Address) {
+	addr, err := k.SetupChainGasCoinAndPool(
+		ctx,
+		chainID,
+		assetName,
+		symbol,
+		8,
+		nil,
+	)
+	require.NoError(t, err)
+	assertContractDeployment(t, *evmk, ctx, addr)
+	return addr
+}
+
+// get a valid chain id independently of the build flag
+func getValidChainID(t *testing.T) int64 {
+	list := chains.DefaultChainsList()
+	require.NotEmpty(t, list)
+	require.NotNil(t, list[0])
+	return list[0].ChainId
+}
+
+// require that a contract has been deployed by checking stored code is non-empty.
+func assertContractDeployment(t *testing.T, k evmkeeper.Keeper, ctx sdk.Context, contractAddress common.Address) {
+	acc := k.GetAccount(ctx, contractAddress)
+	require.NotNil(t, acc)
+	code := k.GetCode(ctx, common.BytesToHash(acc.CodeHash))
+	require.NotEmpty(t, code)
+}
+
+// deploySystemContracts deploys the system contracts and returns their addresses.
+func deploySystemContracts(
+	t *testing.T,
+	ctx sdk.Context,
+	k *fungiblekeeper.Keeper,
+	evmk evmkeeper.Keeper,
+) (wzeta, uniswapV2Factory, uniswapV2Router, connector, systemContract common.Address) {
+	var err error
+
+	wzeta, err = k.DeployWZETA(ctx)
+	require.NoError(t, err)
+	require.NotEmpty(t, wzeta)
+	assertContractDeployment(t, evmk, ctx, wzeta)
+
+	uniswapV2Factory, err = k.DeployUniswapV2Factory(ctx)
+	require.NoError(t, err)
+	require.NotEmpty(t, uniswapV2Factory)
+	assertContractDeployment(t, evmk, ctx, uniswapV2Factory)
+
+	uniswapV2Router, err = k.DeployUniswapV2Router02(ctx, uniswapV2Factory, wzeta)
+	require.NoError(t, err)
+	require.NotEmpty(t, uniswapV2Router)
+	assertContractDeployment(t, evmk, ctx, uniswapV2Router)
+
+	connector, err = k.DeployConnectorZEVM(ctx, wzeta)
+	require.NoError(t, err)
+	require.NotEmpty(t, connector)
+	assertContractDeployment(t, evmk, ctx, connector)
+
+	systemContract, err = k.DeploySystemContract(ctx, wzeta, uniswapV2Factory, uniswapV2Router)
+	require.NoError(t, err)
+	require.NotEmpty(t, systemContract)
+	assertContractDeployment(t, evmk, ctx, systemContract)
+
+	return
+}
+
+func packInputArgs(t *testing.T, methodID abi.Method, args ...interface{}) []byte {
+	input, err := methodID.Inputs.Pack(args...)
+	require.NoError(t, err)
+	return append(methodID.ID, input...)
+}
+
+func mustUnpack(t *testing.T, method abi.Method, data []byte) []interface{} {
+	res, err := method.Outputs.Unpack(data)
+	require.NoError(t, err)
+	return res
+}
+
+type contractRef struct {
+	address common.Address
+}
+
+func (c contractRef) Address() common.Address {
+	return c.address
+}
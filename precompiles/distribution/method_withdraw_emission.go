@@ -0,0 +1,72 @@
+package distribution
+
+import (
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	precompiletypes "github.com/zeta-chain/node/precompiles/types"
+	emissionskeeper "github.com/zeta-chain/node/x/emissions/keeper"
+	emissionstypes "github.com/zeta-chain/node/x/emissions/types"
+)
+
+// withdrawEmission withdraws the observer emission of the caller.
+// The withdrawer is the direct caller of the precompile, the withdrawn ZETA is sent to its account.
+// The withdrawal follows the same rules as MsgWithdrawEmission from the emissions module.
+// Call this function using solidity with the following signature:
+// From IDistribution.sol: function withdrawEmission(uint256 amount) external returns (bool success);
+func (c *Contract) withdrawEmission(
+	ctx sdk.Context,
+	evm *vm.EVM,
+	contract *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 1 {
+		return nil, &precompiletypes.ErrInvalidNumberOfArgs{
+			Got:    len(args),
+			Expect: 1,
+		}
+	}
+
+	amount, ok := args[0].(*big.Int)
+	if !ok || amount == nil || amount.Sign() <= 0 {
+		return nil, &precompiletypes.ErrInvalidAmount{
+			Got: amount.String(),
+		}
+	}
+
+	withdrawer := contract.CallerAddress
+
+	msgServer := emissionskeeper.NewMsgServerImpl(c.emissionsKeeper)
+	_, err := msgServer.WithdrawEmission(sdk.WrapSDKContext(ctx), &emissionstypes.MsgWithdrawEmission{
+		Creator: sdk.AccAddress(withdrawer.Bytes()).String(),
+		Amount:  sdkmath.NewIntFromBigInt(amount),
+	})
+	if err != nil {
+		return nil, &precompiletypes.ErrUnexpected{
+			When: "WithdrawEmission",
+			Got:  err.Error(),
+		}
+	}
+
+	// if caller is not the same as origin it means call is coming through smart contract,
+	// and because state of smart contract calling precompile might be updated as well
+	// manually increase amount in stateDB, so it is properly reflected in bank module
+	stateDB := evm.StateDB.(precompiletypes.ExtStateDB)
+	if contract.CallerAddress != evm.Origin {
+		stateDB.AddBalance(withdrawer, amount)
+	}
+
+	if err := c.addWithdrawEmissionLog(ctx, stateDB, withdrawer, amount); err != nil {
+		return nil, &precompiletypes.ErrUnexpected{
+			When: "AddWithdrawEmissionLog",
+			Got:  err.Error(),
+		}
+	}
+
+	return method.Outputs.Pack(true)
+}
@@ -5,6 +5,7 @@ import (
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/vm"
@@ -12,8 +13,12 @@ import (
 	evmkeeper "github.com/zeta-chain/ethermint/x/evm/keeper"
 
 	"github.com/zeta-chain/node/precompiles/bank"
+	"github.com/zeta-chain/node/precompiles/crosschain"
+	"github.com/zeta-chain/node/precompiles/distribution"
 	"github.com/zeta-chain/node/precompiles/prototype"
 	"github.com/zeta-chain/node/precompiles/staking"
+	crosschainkeeper "github.com/zeta-chain/node/x/crosschain/keeper"
+	emissionskeeper "github.com/zeta-chain/node/x/emissions/keeper"
 	fungiblekeeper "github.com/zeta-chain/node/x/fungible/keeper"
 )
 
@@ -21,9 +26,11 @@ import (
 // This is useful for listing and reading from other packages, such as BlockedAddrs() function.
 // Setting to false a contract here will disable it, not being included in the blockchain.
 var EnabledStatefulContracts = map[common.Address]bool{
-	prototype.ContractAddress: true,
-	staking.ContractAddress:   true,
-	bank.ContractAddress:      true,
+	prototype.ContractAddress:    true,
+	staking.ContractAddress:      true,
+	bank.ContractAddress:         true,
+	distribution.ContractAddress: true,
+	crosschain.ContractAddress:   true,
 }
 
 // StatefulContracts returns all the registered precompiled contracts.
@@ -31,6 +38,9 @@ func StatefulContracts(
 	fungibleKeeper *fungiblekeeper.Keeper,
 	stakingKeeper *stakingkeeper.Keeper,
 	bankKeeper bankkeeper.Keeper,
+	distributionKeeper distrkeeper.Keeper,
+	emissionsKeeper emissionskeeper.Keeper,
+	crosschainKeeper *crosschainkeeper.Keeper,
 	cdc codec.Codec,
 	gasConfig storetypes.GasConfig,
 ) (precompiledContracts []evmkeeper.CustomContractFn) {
@@ -66,5 +76,31 @@ func StatefulContracts(
 		precompiledContracts = append(precompiledContracts, bankContract)
 	}
 
+	if EnabledStatefulContracts[distribution.ContractAddress] {
+		distributionContract := func(ctx sdktypes.Context, _ ethparams.Rules) vm.PrecompiledContract {
+			return distribution.NewIDistributionContract(
+				ctx,
+				distributionKeeper,
+				emissionsKeeper,
+				*fungibleKeeper,
+				bankKeeper,
+				cdc,
+				gasConfig,
+			)
+		}
+
+		// Append the distribution contract to the precompiledContracts slice.
+		precompiledContracts = append(precompiledContracts, distributionContract)
+	}
+
+	if EnabledStatefulContracts[crosschain.ContractAddress] {
+		crosschainContract := func(_ sdktypes.Context, _ ethparams.Rules) vm.PrecompiledContract {
+			return crosschain.NewICrosschainContract(crosschainKeeper, cdc, gasConfig)
+		}
+
+		// Append the crosschain contract to the precompiledContracts slice.
+		precompiledContracts = append(precompiledContracts, crosschainContract)
+	}
+
 	return precompiledContracts
 }
@@ -11,7 +11,8 @@ import (
 )
 
 func Test_StatefulContracts(t *testing.T) {
-	k, ctx, sdkk, _ := keeper.FungibleKeeper(t)
+	k, ctx, sdkk, zk := keeper.FungibleKeeper(t)
+	crosschainKeeper, _, _, _ := keeper.CrosschainKeeper(t)
 	gasConfig := storetypes.TransientGasConfig()
 
 	var encoding ethermint.EncodingConfig
@@ -25,7 +26,16 @@ func Test_StatefulContracts(t *testing.T) {
 	}
 
 	// StatefulContracts() should return all the enabled contracts.
-	contracts := StatefulContracts(k, &sdkk.StakingKeeper, sdkk.BankKeeper, appCodec, gasConfig)
+	contracts := StatefulContracts(
+		k,
+		&sdkk.StakingKeeper,
+		sdkk.BankKeeper,
+		sdkk.DistributionKeeper,
+		*zk.EmissionsKeeper,
+		crosschainKeeper,
+		appCodec,
+		gasConfig,
+	)
 	require.NotNil(t, contracts, "StatefulContracts() should not return a nil slice")
 	require.Len(t, contracts, expectedContracts, "StatefulContracts() should return all the enabled contracts")
 
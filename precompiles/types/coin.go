@@ -2,6 +2,7 @@ package types
 
 import (
 	"math/big"
+	"strings"
 
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -16,6 +17,21 @@ func ZRC20ToCosmosDenom(ZRC20Address common.Address) string {
 	return config.ZRC20DenomPrefix + ZRC20Address.String()
 }
 
+// CosmosDenomToZRC20 returns the ZRC20 address of a cosmos coin denomination "zrc20/{ZRC20Address}".
+// It returns false if the denomination is not a ZRC20 denomination.
+func CosmosDenomToZRC20(denom string) (common.Address, bool) {
+	if !strings.HasPrefix(denom, config.ZRC20DenomPrefix) {
+		return common.Address{}, false
+	}
+
+	address := strings.TrimPrefix(denom, config.ZRC20DenomPrefix)
+	if !common.IsHexAddress(address) {
+		return common.Address{}, false
+	}
+
+	return common.HexToAddress(address), true
+}
+
 func CreateCoinSet(zrc20address common.Address, amount *big.Int) (sdk.Coins, error) {
 	defer func() {
 		if r := recover(); r != nil {
@@ -15,6 +15,20 @@ func Test_ZRC20ToCosmosDenom(t *testing.T) {
 	require.Equal(t, expected, denom, "denom should be %s, got %s", expected, denom)
 }
 
+func Test_CosmosDenomToZRC20(t *testing.T) {
+	address := common.BigToAddress(big.NewInt(12345))
+
+	zrc20, ok := CosmosDenomToZRC20(ZRC20ToCosmosDenom(address))
+	require.True(t, ok)
+	require.Equal(t, address, zrc20)
+
+	_, ok = CosmosDenomToZRC20("azeta")
+	require.False(t, ok)
+
+	_, ok = CosmosDenomToZRC20("zrc20/invalid")
+	require.False(t, ok)
+}
+
 func Test_createCoinSet(t *testing.T) {
 	tokenAddr := common.HexToAddress("0x0000000000000000000000000000000000003039")
 	tokenDenom := ZRC20ToCosmosDenom(tokenAddr)
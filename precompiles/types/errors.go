@@ -118,3 +118,16 @@ type ErrUnexpected struct {
 func (e ErrUnexpected) Error() string {
 	return fmt.Sprintf("unexpected error in %s: %s", e.When, e.Got)
 }
+
+/*
+	Query related errors
+*/
+
+type ErrNotFound struct {
+	What string
+	Key  string
+}
+
+func (e ErrNotFound) Error() string {
+	return fmt.Sprintf("%s not found: %s", e.What, e.Key)
+}
@@ -113,3 +113,14 @@ func Test_ErrWriteMethod(t *testing.T) {
 	require.Equal(t, expect, got)
 	require.ErrorIs(t, ErrWriteMethod{"foo"}, e)
 }
+
+func Test_ErrNotFound(t *testing.T) {
+	e := ErrNotFound{
+		What: "foo",
+		Key:  "bar",
+	}
+	got := e.Error()
+	expect := "foo not found: bar"
+	require.Equal(t, expect, got)
+	require.ErrorIs(t, ErrNotFound{"foo", "bar"}, e)
+}
@@ -22,6 +22,8 @@ import (
 	"github.com/zeta-chain/node/testutil/sample"
 	authoritykeeper "github.com/zeta-chain/node/x/authority/keeper"
 	authoritytypes "github.com/zeta-chain/node/x/authority/types"
+	emissionskeeper "github.com/zeta-chain/node/x/emissions/keeper"
+	emissionstypes "github.com/zeta-chain/node/x/emissions/types"
 	fungiblemodule "github.com/zeta-chain/node/x/fungible"
 	"github.com/zeta-chain/node/x/fungible/keeper"
 	"github.com/zeta-chain/node/x/fungible/types"
@@ -119,10 +121,24 @@ func FungibleKeeperWithMocks(
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
+	// Create emissions keeper
+	emissionsKeeperTmp := emissionskeeper.NewKeeper(
+		cdc,
+		keys[emissionstypes.StoreKey],
+		memKeys[emissionstypes.MemStoreKey],
+		authtypes.FeeCollectorName,
+		sdkKeepers.BankKeeper,
+		sdkKeepers.StakingKeeper,
+		observerKeeperTmp,
+		sdkKeepers.AuthKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
 	zetaKeepers := ZetaKeepers{
 		ObserverKeeper:    observerKeeperTmp,
 		AuthorityKeeper:   &authorityKeeperTmp,
 		LightclientKeeper: &lightclientKeeperTmp,
+		EmissionsKeeper:   emissionsKeeperTmp,
 	}
 	var observerKeeper types.ObserverKeeper = observerKeeperTmp
 	var authorityKeeper types.AuthorityKeeper = authorityKeeperTmp
@@ -104,6 +104,7 @@ type SDKKeepers struct {
 	BankKeeper           bankkeeper.Keeper
 	StakingKeeper        stakingkeeper.Keeper
 	SlashingKeeper       slashingkeeper.Keeper
+	DistributionKeeper   distrkeeper.Keeper
 	FeeMarketKeeper      feemarketkeeper.Keeper
 	EvmKeeper            *evmkeeper.Keeper
 	CapabilityKeeper     *capabilitykeeper.Keeper
@@ -465,6 +466,15 @@ func NewSDKKeepersWithKeys(
 		stakingKeeper,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
+	distrKeeper := distrkeeper.NewKeeper(
+		cdc,
+		keys[distrtypes.StoreKey],
+		authKeeper,
+		bankKeeper,
+		&stakingKeeper,
+		authtypes.FeeCollectorName,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
 	capabilityKeeper := capabilitykeeper.NewKeeper(
 		cdc,
 		keys[capabilitytypes.StoreKey],
@@ -472,14 +482,15 @@ func NewSDKKeepersWithKeys(
 	)
 
 	return SDKKeepers{
-		ParamsKeeper:     paramsKeeper,
-		AuthKeeper:       authKeeper,
-		BankKeeper:       bankKeeper,
-		StakingKeeper:    stakingKeeper,
-		FeeMarketKeeper:  feeMarketKeeper,
-		EvmKeeper:        evmKeeper,
-		SlashingKeeper:   slashingKeeper,
-		CapabilityKeeper: capabilityKeeper,
+		ParamsKeeper:       paramsKeeper,
+		AuthKeeper:         authKeeper,
+		BankKeeper:         bankKeeper,
+		StakingKeeper:      stakingKeeper,
+		FeeMarketKeeper:    feeMarketKeeper,
+		EvmKeeper:          evmKeeper,
+		SlashingKeeper:     slashingKeeper,
+		DistributionKeeper: distrKeeper,
+		CapabilityKeeper:   capabilityKeeper,
 	}
 }
 
@@ -588,6 +599,7 @@ func NewSDKKeepers(
 		consensusKeeper,
 	)
 	slashingKeeper := SlashingKeeper(cdc, db, ss, stakingKeeper)
+	distrKeeper := DistributionKeeper(cdc, db, ss, authKeeper, bankKeeper, &stakingKeeper)
 
 	ibcKeeper := IBCKeeper(cdc, db, ss, paramsKeeper, stakingKeeper, UpgradeKeeper(cdc, db, ss), *capabilityKeeper)
 	transferKeeper := TransferKeeper(
@@ -603,17 +615,18 @@ func NewSDKKeepers(
 	)
 
 	return SDKKeepers{
-		CapabilityKeeper: capabilityKeeper,
-		ParamsKeeper:     paramsKeeper,
-		AuthKeeper:       authKeeper,
-		BankKeeper:       bankKeeper,
-		StakingKeeper:    stakingKeeper,
-		FeeMarketKeeper:  feeMarketKeeper,
-		EvmKeeper:        evmKeeper,
-		SlashingKeeper:   slashingKeeper,
-		IBCKeeper:        ibcKeeper,
-		TransferKeeper:   transferKeeper,
-		IBCRouter:        ibcRouter,
+		CapabilityKeeper:   capabilityKeeper,
+		ParamsKeeper:       paramsKeeper,
+		AuthKeeper:         authKeeper,
+		BankKeeper:         bankKeeper,
+		StakingKeeper:      stakingKeeper,
+		FeeMarketKeeper:    feeMarketKeeper,
+		EvmKeeper:          evmKeeper,
+		SlashingKeeper:     slashingKeeper,
+		DistributionKeeper: distrKeeper,
+		IBCKeeper:          ibcKeeper,
+		TransferKeeper:     transferKeeper,
+		IBCRouter:          ibcRouter,
 	}
 }
 
@@ -45,6 +45,45 @@ func (_m *CrosschainLightclientKeeper) VerifyProof(ctx types.Context, proof *pro
 	return r0, r1
 }
 
+// VerifyReceiptProof provides a mock function with given fields: ctx, proof, chainID, blockHash, txIndex
+func (_m *CrosschainLightclientKeeper) VerifyReceiptProof(ctx types.Context, proof *proofs.Proof, chainID int64, blockHash string, txIndex int64) ([]byte, []byte, error) {
+	ret := _m.Called(ctx, proof, chainID, blockHash, txIndex)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VerifyReceiptProof")
+	}
+
+	var r0 []byte
+	var r1 []byte
+	var r2 error
+	if rf, ok := ret.Get(0).(func(types.Context, *proofs.Proof, int64, string, int64) ([]byte, []byte, error)); ok {
+		return rf(ctx, proof, chainID, blockHash, txIndex)
+	}
+	if rf, ok := ret.Get(0).(func(types.Context, *proofs.Proof, int64, string, int64) []byte); ok {
+		r0 = rf(ctx, proof, chainID, blockHash, txIndex)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(types.Context, *proofs.Proof, int64, string, int64) []byte); ok {
+		r1 = rf(ctx, proof, chainID, blockHash, txIndex)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(types.Context, *proofs.Proof, int64, string, int64) error); ok {
+		r2 = rf(ctx, proof, chainID, blockHash, txIndex)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // NewCrosschainLightclientKeeper creates a new instance of CrosschainLightclientKeeper. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewCrosschainLightclientKeeper(t interface {
@@ -763,6 +763,48 @@ func (_m *CrosschainObserverKeeper) SetTssAndUpdateNonce(ctx types.Context, tss
 	_m.Called(ctx, tss)
 }
 
+// VoteOnBallot provides a mock function with given fields: ctx, chain, ballotIndex, observationType, voter, voteType
+func (_m *CrosschainObserverKeeper) VoteOnBallot(ctx types.Context, chain chains.Chain, ballotIndex string, observationType observertypes.ObservationType, voter string, voteType observertypes.VoteType) (observertypes.Ballot, bool, bool, error) {
+	ret := _m.Called(ctx, chain, ballotIndex, observationType, voter, voteType)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VoteOnBallot")
+	}
+
+	var r0 observertypes.Ballot
+	var r1 bool
+	var r2 bool
+	var r3 error
+	if rf, ok := ret.Get(0).(func(types.Context, chains.Chain, string, observertypes.ObservationType, string, observertypes.VoteType) (observertypes.Ballot, bool, bool, error)); ok {
+		return rf(ctx, chain, ballotIndex, observationType, voter, voteType)
+	}
+	if rf, ok := ret.Get(0).(func(types.Context, chains.Chain, string, observertypes.ObservationType, string, observertypes.VoteType) observertypes.Ballot); ok {
+		r0 = rf(ctx, chain, ballotIndex, observationType, voter, voteType)
+	} else {
+		r0 = ret.Get(0).(observertypes.Ballot)
+	}
+
+	if rf, ok := ret.Get(1).(func(types.Context, chains.Chain, string, observertypes.ObservationType, string, observertypes.VoteType) bool); ok {
+		r1 = rf(ctx, chain, ballotIndex, observationType, voter, voteType)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	if rf, ok := ret.Get(2).(func(types.Context, chains.Chain, string, observertypes.ObservationType, string, observertypes.VoteType) bool); ok {
+		r2 = rf(ctx, chain, ballotIndex, observationType, voter, voteType)
+	} else {
+		r2 = ret.Get(2).(bool)
+	}
+
+	if rf, ok := ret.Get(3).(func(types.Context, chains.Chain, string, observertypes.ObservationType, string, observertypes.VoteType) error); ok {
+		r3 = rf(ctx, chain, ballotIndex, observationType, voter, voteType)
+	} else {
+		r3 = ret.Error(3)
+	}
+
+	return r0, r1, r2, r3
+}
+
 // VoteOnInboundBallot provides a mock function with given fields: ctx, senderChainID, receiverChainID, coinType, voter, ballotIndex, inboundHash
 func (_m *CrosschainObserverKeeper) VoteOnInboundBallot(ctx types.Context, senderChainID int64, receiverChainID int64, coinType coin.CoinType, voter string, ballotIndex string, inboundHash string) (bool, bool, error) {
 	ret := _m.Called(ctx, senderChainID, receiverChainID, coinType, voter, ballotIndex, inboundHash)
@@ -71,6 +71,29 @@ func (_m *EmissionObserverKeeper) GetMaturedBallots(ctx types.Context, maturityB
 	return r0, r1
 }
 
+// PruneBallotListsUntil provides a mock function with given fields: ctx, height, archive
+func (_m *EmissionObserverKeeper) PruneBallotListsUntil(ctx types.Context, height int64, archive bool) int {
+	ret := _m.Called(ctx, height, archive)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PruneBallotListsUntil")
+	}
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func(types.Context, int64, bool) int); ok {
+		r0 = rf(ctx, height, archive)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	return r0
+}
+
+// UpdateObserversLiveness provides a mock function with given fields: ctx, height
+func (_m *EmissionObserverKeeper) UpdateObserversLiveness(ctx types.Context, height int64) {
+	_m.Called(ctx, height)
+}
+
 // NewEmissionObserverKeeper creates a new instance of EmissionObserverKeeper. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewEmissionObserverKeeper(t interface {
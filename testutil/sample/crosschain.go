@@ -50,6 +50,30 @@ func RateLimiterFlags() types.RateLimiterFlags {
 				Rate:  sdk.NewDec(r.Int63()),
 			},
 		},
+		ChainRates: []types.ChainRate{
+			{
+				ChainId: chains.Ethereum.ChainId,
+				Rate:    sdk.NewUint(r.Uint64()),
+			},
+			{
+				ChainId: chains.BitcoinMainnet.ChainId,
+				Rate:    sdk.NewUint(r.Uint64()),
+			},
+		},
+		SenderRate: sdk.NewUint(r.Uint64()),
+		AssetCaps: []types.AssetCap{
+			{
+				Zrc20: EthAddress().Hex(),
+				Cap:   sdk.NewUint(r.Uint64()),
+			},
+		},
+		DelayBlocks: r.Int63n(1000) + 1,
+		DelayThresholds: []types.DelayThreshold{
+			{
+				Zrc20:     EthAddress().Hex(),
+				Threshold: sdk.NewUint(r.Uint64()),
+			},
+		},
 	}
 }
 
@@ -80,6 +104,15 @@ func OutboundTracker(t *testing.T, index string) types.OutboundTracker {
 	}
 }
 
+func DelayedCctx(t *testing.T, index string) types.DelayedCctx {
+	r := newRandFromStringSeed(t, index)
+
+	return types.DelayedCctx{
+		CctxIndex:     GetCctxIndexFromString(index),
+		ReleaseHeight: r.Int63(),
+	}
+}
+
 func InboundTracker(t *testing.T, index string) types.InboundTracker {
 	r := newRandFromStringSeed(t, index)
 
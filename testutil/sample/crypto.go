@@ -20,6 +20,7 @@ import (
 	ethcrypto "github.com/ethereum/go-ethereum/crypto"
 	"github.com/gagliardetto/solana-go"
 	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/protocol-contracts/v2/pkg/gatewayevm.sol"
 
 	"github.com/zeta-chain/node/pkg/cosmos"
 	"github.com/zeta-chain/node/pkg/crypto"
@@ -158,6 +159,44 @@ func EthTx(t *testing.T, chainID int64, to ethcommon.Address, nonce uint64) (*et
 	return tx, txBytes
 }
 
+// EthReceipt returns a sample ethereum receipt with the given status and logs and the receipt bytes
+func EthReceipt(t *testing.T, status uint64, logs ...*ethtypes.Log) (*ethtypes.Receipt, []byte) {
+	receipt := &ethtypes.Receipt{
+		Type:              ethtypes.DynamicFeeTxType,
+		Status:            status,
+		CumulativeGasUsed: 21000,
+		Logs:              logs,
+	}
+	receipt.Bloom = ethtypes.CreateBloom(ethtypes.Receipts{receipt})
+
+	receiptBytes, err := receipt.MarshalBinary()
+	require.NoError(t, err)
+
+	return receipt, receiptBytes
+}
+
+// EthGatewayLog returns a sample log of the given gateway EVM event emitted from the gateway address
+// the indexed sender and receiver are sample addresses, args are the non-indexed fields of the event
+func EthGatewayLog(t *testing.T, gatewayAddr ethcommon.Address, eventName string, args ...any) *ethtypes.Log {
+	gatewayABI, err := gatewayevm.GatewayEVMMetaData.GetAbi()
+	require.NoError(t, err)
+	event, ok := gatewayABI.Events[eventName]
+	require.True(t, ok, "unknown gateway event %s", eventName)
+
+	data, err := event.Inputs.NonIndexed().Pack(args...)
+	require.NoError(t, err)
+
+	return &ethtypes.Log{
+		Address: gatewayAddr,
+		Topics: []ethcommon.Hash{
+			event.ID,
+			ethcommon.BytesToHash(EthAddress().Bytes()),
+			ethcommon.BytesToHash(EthAddress().Bytes()),
+		},
+		Data: data,
+	}
+}
+
 // EthTxSigned returns a sample signed ethereum transaction with the address of the sender
 func EthTxSigned(
 	t *testing.T,
@@ -3,6 +3,7 @@ package sample
 import (
 	"testing"
 
+	sdkmath "cosmossdk.io/math"
 	ethcommon "github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/rlp"
@@ -34,6 +35,16 @@ func ChainState(chainID int64) lightclienttypes.ChainState {
 	}
 }
 
+func BlockHeaderState(blockHash []byte) lightclienttypes.BlockHeaderState {
+	return lightclienttypes.BlockHeaderState{
+		BlockHash:      blockHash,
+		ChainId:        42,
+		Height:         42,
+		CumulativeWork: sdkmath.NewUint(42),
+		Orphaned:       false,
+	}
+}
+
 func HeaderSupportedChains() []lightclienttypes.HeaderSupportedChain {
 	return []lightclienttypes.HeaderSupportedChain{
 		{
@@ -85,3 +96,47 @@ func Proof(t *testing.T) (*proofs.Proof, proofs.BlockHeader, string, int64, int6
 	txHash := txs[txIndex].Hash()
 	return ethProof, blockHeader, header.Hash().Hex(), int64(txIndex), chainID, txHash
 }
+
+// ReceiptProof generates a transaction and receipt proof and block header
+// returns the proof, block header, block hash, tx index, chain id, and tx hash
+func ReceiptProof(t *testing.T) (*proofs.Proof, proofs.BlockHeader, string, int64, int64, ethcommon.Hash) {
+	header, err := testdata.ReadEthHeader()
+	require.NoError(t, err)
+	b, err := rlp.EncodeToBytes(&header)
+	require.NoError(t, err)
+
+	var txs ethtypes.Transactions
+	var receipts ethtypes.Receipts
+	for i := 0; i < testdata.TxsCount; i++ {
+		tx, err := testdata.ReadEthTx(i)
+		require.NoError(t, err)
+		txs = append(txs, &tx)
+
+		receipt, err := testdata.ReadEthReceipt(i)
+		require.NoError(t, err)
+		receipts = append(receipts, &receipt)
+	}
+	txsTree := ethereum.NewTrie(txs)
+	receiptsTree := ethereum.NewTrie(receipts)
+
+	// choose 2 as the index of the tx to prove
+	txIndex := 2
+	txProof, err := txsTree.GenerateProof(txIndex)
+	require.NoError(t, err)
+	receiptProof, err := receiptsTree.GenerateProof(txIndex)
+	require.NoError(t, err)
+
+	chainID := chains.Sepolia.ChainId
+	blockHeader := proofs.BlockHeader{
+		Height:     header.Number.Int64(),
+		Hash:       header.Hash().Bytes(),
+		ParentHash: header.ParentHash.Bytes(),
+		ChainId:    chainID,
+		Header:     proofs.NewEthereumHeader(b),
+	}
+	txHash := txs[txIndex].Hash()
+	return proofs.NewEthereumReceiptProof(
+		txProof,
+		receiptProof,
+	), blockHeader, header.Hash().Hex(), int64(txIndex), chainID, txHash
+}
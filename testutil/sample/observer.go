@@ -255,6 +255,26 @@ func NonceToCCTX(t *testing.T, seed string) types.NonceToCctx {
 	}
 }
 
+func LivenessParams() types.LivenessParams {
+	return types.LivenessParams{
+		Enabled:            true,
+		WindowSize:         100,
+		MinVoteRatio:       sdk.NewDecWithPrec(8, 1),
+		JailDurationBlocks: 1000,
+		MaxJailedObservers: 2,
+	}
+}
+
+func ObserverLiveness(t *testing.T, index string) types.ObserverLiveness {
+	r := newRandFromStringSeed(t, index)
+
+	liveness := types.NewObserverLiveness(AccAddress())
+	for i := 0; i < 10; i++ {
+		liveness.RecordBallot(r.Intn(2) == 0, 100)
+	}
+	return liveness
+}
+
 func GasPriceIncreaseFlags() types.GasPriceIncreaseFlags {
 	return types.GasPriceIncreaseFlags{
 		EpochLength:             1,
@@ -2,6 +2,7 @@ package sample
 
 import (
 	"crypto/rand"
+	"math/big"
 	"reflect"
 	"testing"
 	"time"
@@ -151,6 +152,92 @@ func TONWithdrawalProps(t *testing.T, acc ton.AccountID, w toncontracts.Withdraw
 	}
 }
 
+func TONJettonDeposit(t *testing.T, acc ton.AccountID, d toncontracts.JettonDeposit) ton.Transaction {
+	return TONTransaction(t, TONJettonDepositProps(t, acc, d))
+}
+
+// TONJettonDepositProps returns transfer_notification sent to the gateway by its jetton wallet.
+func TONJettonDepositProps(t *testing.T, acc ton.AccountID, d toncontracts.JettonDeposit) TONTransactionProps {
+	body, err := d.AsBody()
+	require.NoError(t, err)
+
+	return TONTransactionProps{
+		Account: acc,
+		Input: &tlb.Message{
+			Info: internalMessageInfo(&intMsgInfo{
+				Bounce: true,
+				Src:    d.Jetton.ToMsgAddress(),
+				Dest:   acc.ToMsgAddress(),
+				Value:  tlb.CurrencyCollection{Grams: tlb.Grams(tonSampleTxFee)},
+			}),
+			Body: tlb.EitherRef[tlb.Any]{Value: tlb.Any(*body)},
+		},
+	}
+}
+
+func TONJettonWithdrawal(t *testing.T, acc ton.AccountID, w toncontracts.JettonWithdrawal) ton.Transaction {
+	return TONTransaction(t, TONJettonWithdrawalProps(t, acc, w))
+}
+
+// TONJettonWithdrawalProps returns jetton withdrawal where the gateway sends transfer to its jetton wallet.
+func TONJettonWithdrawalProps(t *testing.T, acc ton.AccountID, w toncontracts.JettonWithdrawal) TONTransactionProps {
+	body, err := w.AsBody()
+	require.NoError(t, err)
+
+	transferBody := jettonTransferMock(t, w.Amount, w.Recipient, acc)
+
+	return TONTransactionProps{
+		Account: acc,
+		Input: &tlb.Message{
+			Info: externalMessageInfo(acc),
+			Body: tlb.EitherRef[tlb.Any]{Value: tlb.Any(*body)},
+		},
+		Output: &tlb.Message{
+			Info: internalMessageInfo(&intMsgInfo{
+				IhrDisabled: true,
+				Bounce:      true,
+				Src:         acc.ToMsgAddress(),
+				Dest:        w.Jetton.ToMsgAddress(),
+				Value:       tlb.CurrencyCollection{Grams: tlb.Coins(w.ForwardFee.Uint64())},
+			}),
+			Body: tlb.EitherRef[tlb.Any]{IsRight: true, Value: tlb.Any(*transferBody)},
+		},
+	}
+}
+
+func TONWithdrawalAndCall(t *testing.T, acc ton.AccountID, w toncontracts.WithdrawalAndCall) ton.Transaction {
+	return TONTransaction(t, TONWithdrawalAndCallProps(t, acc, w))
+}
+
+func TONWithdrawalAndCallProps(
+	t *testing.T,
+	acc ton.AccountID,
+	w toncontracts.WithdrawalAndCall,
+) TONTransactionProps {
+	body, err := w.AsBody()
+	require.NoError(t, err)
+
+	callData, err := toncontracts.MarshalSnakeCell(w.CallData)
+	require.NoError(t, err)
+
+	return TONTransactionProps{
+		Account: acc,
+		Input: &tlb.Message{
+			Info: externalMessageInfo(acc),
+			Body: tlb.EitherRef[tlb.Any]{Value: tlb.Any(*body)},
+		},
+		Output: &tlb.Message{
+			Info: internalMessageInfo(&intMsgInfo{
+				IhrDisabled: true,
+				Src:         acc.ToMsgAddress(),
+				Dest:        w.Recipient.ToMsgAddress(),
+				Value:       tlb.CurrencyCollection{Grams: tlb.Coins(w.Amount.Add(w.ForwardFee).Uint64())},
+			}),
+			Body: tlb.EitherRef[tlb.Any]{IsRight: true, Value: tlb.Any(*callData)},
+		},
+	}
+}
+
 // TONTransaction creates a sample TON transaction.
 func TONTransaction(t *testing.T, p TONTransactionProps) ton.Transaction {
 	require.False(t, p.Account.IsZero(), "account address is empty")
@@ -183,7 +270,7 @@ func TONTransaction(t *testing.T, p TONTransactionProps) ton.Transaction {
 	if p.Output != nil {
 		outputs = tlb.NewHashmapE(
 			[]tlb.Uint15{0},
-			[]tlb.Ref[tlb.Message]{{*p.Output}},
+			[]tlb.Ref[tlb.Message]{{Value: *p.Output}},
 		)
 	}
 
@@ -192,6 +279,13 @@ func TONTransaction(t *testing.T, p TONTransactionProps) ton.Transaction {
 		OutMsgs tlb.HashmapE[tlb.Uint15, tlb.Ref[tlb.Message]]
 	}
 
+	// Successful ordinary transaction
+	var descr tlb.TransactionDescr
+	descr.SumType = "TransOrd"
+	descr.TransOrd.ComputePh.SumType = "TrPhaseComputeVm"
+	descr.TransOrd.ComputePh.TrPhaseComputeVm.Success = true
+	descr.TransOrd.ComputePh.TrPhaseComputeVm.Vm.GasUsed = tlb.VarUInteger7(*new(big.Int).SetUint64(p.GasUsed))
+
 	tx := ton.Transaction{
 		BlockID: p.BlockID,
 		Transaction: tlb.Transaction{
@@ -201,6 +295,7 @@ func TONTransaction(t *testing.T, p TONTransactionProps) ton.Transaction {
 			OutMsgCnt:   tlb.Uint15(len(outputs.Keys())),
 			TotalFees:   tlb.CurrencyCollection{Grams: tlb.Grams(p.TotalTONFees)},
 			Msgs:        messages{InMsg: input, OutMsgs: outputs},
+			Description: descr,
 		},
 	}
 
@@ -281,6 +376,25 @@ func depositLogMock(t *testing.T, depositAmount, txFee uint64) *boc.Cell {
 	return b
 }
 
+func jettonTransferMock(t *testing.T, amount math.Uint, recipient, responseDestination ton.AccountID) *boc.Cell {
+	// transfer#0f8a7ea5 query_id:uint64 amount:(VarUInteger 16) destination:MsgAddress
+	//   response_destination:MsgAddress custom_payload:(Maybe ^Cell)
+	//   forward_ton_amount:(VarUInteger 16) forward_payload:(Either Cell ^Cell)
+
+	b := boc.NewCell()
+
+	require.NoError(t, b.WriteUint(uint64(toncontracts.OpJettonTransfer), 32))
+	require.NoError(t, b.WriteUint(0, 64))
+	require.NoError(t, tlb.VarUInteger16(*amount.BigInt()).MarshalTLB(b, nil))
+	require.NoError(t, tlb.Marshal(b, recipient.ToMsgAddress()))
+	require.NoError(t, tlb.Marshal(b, responseDestination.ToMsgAddress()))
+	require.NoError(t, b.WriteBit(false))
+	require.NoError(t, tlb.VarUInteger16{}.MarshalTLB(b, nil))
+	require.NoError(t, b.WriteBit(false))
+
+	return b
+}
+
 // well, tlb.Transaction has unexported field `hash` that we need to set OUTSIDE tlb package.
 // It's a hack, but it works for testing purposes.
 func setTXHash(tx *tlb.Transaction, hash [32]byte) {
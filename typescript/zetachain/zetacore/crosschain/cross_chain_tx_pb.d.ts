@@ -52,6 +52,13 @@ export declare enum CctxStatus {
    * @generated from enum value: Aborted = 6;
    */
   Aborted = 6,
+
+  /**
+   * large outbound held back before being scheduled
+   *
+   * @generated from enum value: PendingDelay = 7;
+   */
+  PendingDelay = 7,
 }
 
 /**
@@ -0,0 +1,39 @@
+// @generated by protoc-gen-es v1.3.0 with parameter "target=dts"
+// @generated from file zetachain/zetacore/crosschain/delayed_cctx.proto (package zetachain.zetacore.crosschain, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import { Message, proto3 } from "@bufbuild/protobuf";
+
+/**
+ * DelayedCctx is a cctx held in PendingDelay until the release height
+ *
+ * @generated from message zetachain.zetacore.crosschain.DelayedCctx
+ */
+export declare class DelayedCctx extends Message<DelayedCctx> {
+  /**
+   * @generated from field: string cctx_index = 1;
+   */
+  cctxIndex: string;
+
+  /**
+   * @generated from field: int64 release_height = 2;
+   */
+  releaseHeight: bigint;
+
+  constructor(data?: PartialMessage<DelayedCctx>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.DelayedCctx";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DelayedCctx;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DelayedCctx;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DelayedCctx;
+
+  static equals(a: DelayedCctx | PlainMessage<DelayedCctx> | undefined, b: DelayedCctx | PlainMessage<DelayedCctx> | undefined): boolean;
+}
+
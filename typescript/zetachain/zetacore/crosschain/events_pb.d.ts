@@ -427,3 +427,32 @@ export declare class EventERC20CustodyPausing extends Message<EventERC20CustodyP
   static equals(a: EventERC20CustodyPausing | PlainMessage<EventERC20CustodyPausing> | undefined, b: EventERC20CustodyPausing | PlainMessage<EventERC20CustodyPausing> | undefined): boolean;
 }
 
+/**
+ * @generated from message zetachain.zetacore.crosschain.EventUTXOConsolidation
+ */
+export declare class EventUTXOConsolidation extends Message<EventUTXOConsolidation> {
+  /**
+   * @generated from field: int64 chain_id = 1;
+   */
+  chainId: bigint;
+
+  /**
+   * @generated from field: string cctx_index = 2;
+   */
+  cctxIndex: string;
+
+  constructor(data?: PartialMessage<EventUTXOConsolidation>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.EventUTXOConsolidation";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): EventUTXOConsolidation;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): EventUTXOConsolidation;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): EventUTXOConsolidation;
+
+  static equals(a: EventUTXOConsolidation | PlainMessage<EventUTXOConsolidation> | undefined, b: EventUTXOConsolidation | PlainMessage<EventUTXOConsolidation> | undefined): boolean;
+}
+
@@ -12,6 +12,7 @@ import type { LastBlockHeight } from "./last_block_height_pb.js";
 import type { InboundHashToCctx } from "./inbound_hash_to_cctx_pb.js";
 import type { InboundTracker } from "./inbound_tracker_pb.js";
 import type { RateLimiterFlags } from "./rate_limiter_flags_pb.js";
+import type { DelayedCctx } from "./delayed_cctx_pb.js";
 
 /**
  * GenesisState defines the crosschain module's genesis state.
@@ -64,6 +65,11 @@ export declare class GenesisState extends Message<GenesisState> {
    */
   rateLimiterFlags?: RateLimiterFlags;
 
+  /**
+   * @generated from field: repeated zetachain.zetacore.crosschain.DelayedCctx delayed_cctx_list = 18;
+   */
+  delayedCctxList: DelayedCctx[];
+
   constructor(data?: PartialMessage<GenesisState>);
 
   static readonly runtime: typeof proto3;
@@ -1,4 +1,5 @@
 export * from "./cross_chain_tx_pb";
+export * from "./delayed_cctx_pb";
 export * from "./events_pb";
 export * from "./gas_price_pb";
 export * from "./genesis_pb";
@@ -12,7 +12,7 @@ import type { InboundHashToCctx } from "./inbound_hash_to_cctx_pb.js";
 import type { CrossChainTx } from "./cross_chain_tx_pb.js";
 import type { GasPrice } from "./gas_price_pb.js";
 import type { LastBlockHeight } from "./last_block_height_pb.js";
-import type { RateLimiterFlags } from "./rate_limiter_flags_pb.js";
+import type { AssetQuotaUsage, ChainQuotaUsage, RateLimiterFlags, SenderQuotaUsage } from "./rate_limiter_flags_pb.js";
 
 /**
  * @generated from message zetachain.zetacore.crosschain.QueryZetaAccountingRequest
@@ -939,6 +939,13 @@ export declare class QueryRateLimiterInputResponse extends Message<QueryRateLimi
    */
   lowestPendingCctxHeight: bigint;
 
+  /**
+   * true if pending cctxs are held back by a chain or asset quota
+   *
+   * @generated from field: bool quota_exceeded = 8;
+   */
+  quotaExceeded: boolean;
+
   constructor(data?: PartialMessage<QueryRateLimiterInputResponse>);
 
   static readonly runtime: typeof proto3;
@@ -1007,6 +1014,13 @@ export declare class QueryListPendingCctxWithinRateLimitResponse extends Message
    */
   rateLimitExceeded: boolean;
 
+  /**
+   * true if pending cctxs are held back by a chain or asset quota
+   *
+   * @generated from field: bool quota_exceeded = 6;
+   */
+  quotaExceeded: boolean;
+
   constructor(data?: PartialMessage<QueryListPendingCctxWithinRateLimitResponse>);
 
   static readonly runtime: typeof proto3;
@@ -1022,6 +1036,69 @@ export declare class QueryListPendingCctxWithinRateLimitResponse extends Message
   static equals(a: QueryListPendingCctxWithinRateLimitResponse | PlainMessage<QueryListPendingCctxWithinRateLimitResponse> | undefined, b: QueryListPendingCctxWithinRateLimitResponse | PlainMessage<QueryListPendingCctxWithinRateLimitResponse> | undefined): boolean;
 }
 
+/**
+ * @generated from message zetachain.zetacore.crosschain.QueryRateLimiterQuotaUsageRequest
+ */
+export declare class QueryRateLimiterQuotaUsageRequest extends Message<QueryRateLimiterQuotaUsageRequest> {
+  constructor(data?: PartialMessage<QueryRateLimiterQuotaUsageRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.QueryRateLimiterQuotaUsageRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryRateLimiterQuotaUsageRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryRateLimiterQuotaUsageRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryRateLimiterQuotaUsageRequest;
+
+  static equals(a: QueryRateLimiterQuotaUsageRequest | PlainMessage<QueryRateLimiterQuotaUsageRequest> | undefined, b: QueryRateLimiterQuotaUsageRequest | PlainMessage<QueryRateLimiterQuotaUsageRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.crosschain.QueryRateLimiterQuotaUsageResponse
+ */
+export declare class QueryRateLimiterQuotaUsageResponse extends Message<QueryRateLimiterQuotaUsageResponse> {
+  /**
+   * @generated from field: int64 height = 1;
+   */
+  height: bigint;
+
+  /**
+   * @generated from field: int64 window = 2;
+   */
+  window: bigint;
+
+  /**
+   * @generated from field: repeated zetachain.zetacore.crosschain.ChainQuotaUsage chain_usages = 3;
+   */
+  chainUsages: ChainQuotaUsage[];
+
+  /**
+   * @generated from field: repeated zetachain.zetacore.crosschain.SenderQuotaUsage sender_usages = 4;
+   */
+  senderUsages: SenderQuotaUsage[];
+
+  /**
+   * @generated from field: repeated zetachain.zetacore.crosschain.AssetQuotaUsage asset_usages = 5;
+   */
+  assetUsages: AssetQuotaUsage[];
+
+  constructor(data?: PartialMessage<QueryRateLimiterQuotaUsageResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.QueryRateLimiterQuotaUsageResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryRateLimiterQuotaUsageResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryRateLimiterQuotaUsageResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryRateLimiterQuotaUsageResponse;
+
+  static equals(a: QueryRateLimiterQuotaUsageResponse | PlainMessage<QueryRateLimiterQuotaUsageResponse> | undefined, b: QueryRateLimiterQuotaUsageResponse | PlainMessage<QueryRateLimiterQuotaUsageResponse> | undefined): boolean;
+}
+
 /**
  * @generated from message zetachain.zetacore.crosschain.QueryLastZetaHeightRequest
  */
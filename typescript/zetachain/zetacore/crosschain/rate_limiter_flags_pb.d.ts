@@ -37,6 +37,44 @@ export declare class RateLimiterFlags extends Message<RateLimiterFlags> {
    */
   conversions: Conversion[];
 
+  /**
+   * optional rates in azeta per block for withdrawals to a given chain
+   *
+   * @generated from field: repeated zetachain.zetacore.crosschain.ChainRate chain_rates = 5;
+   */
+  chainRates: ChainRate[];
+
+  /**
+   * optional rate in azeta per block for the withdrawals of each sender,
+   * enforced when the withdrawals are created, zero to disable
+   *
+   * @generated from field: string sender_rate = 6;
+   */
+  senderRate: string;
+
+  /**
+   * optional absolute caps in token units per window for a given zrc20
+   *
+   * @generated from field: repeated zetachain.zetacore.crosschain.AssetCap asset_caps = 7;
+   */
+  assetCaps: AssetCap[];
+
+  /**
+   * number of blocks the outbounds above a delay threshold are held in
+   * PendingDelay before being scheduled, zero to disable
+   *
+   * @generated from field: int64 delay_blocks = 8;
+   */
+  delayBlocks: bigint;
+
+  /**
+   * optional thresholds in token units above which the outbounds of a given
+   * zrc20 are delayed
+   *
+   * @generated from field: repeated zetachain.zetacore.crosschain.DelayThreshold delay_thresholds = 9;
+   */
+  delayThresholds: DelayThreshold[];
+
   constructor(data?: PartialMessage<RateLimiterFlags>);
 
   static readonly runtime: typeof proto3;
@@ -52,6 +90,99 @@ export declare class RateLimiterFlags extends Message<RateLimiterFlags> {
   static equals(a: RateLimiterFlags | PlainMessage<RateLimiterFlags> | undefined, b: RateLimiterFlags | PlainMessage<RateLimiterFlags> | undefined): boolean;
 }
 
+/**
+ * @generated from message zetachain.zetacore.crosschain.ChainRate
+ */
+export declare class ChainRate extends Message<ChainRate> {
+  /**
+   * @generated from field: int64 chain_id = 1;
+   */
+  chainId: bigint;
+
+  /**
+   * rate in azeta per block
+   *
+   * @generated from field: string rate = 2;
+   */
+  rate: string;
+
+  constructor(data?: PartialMessage<ChainRate>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.ChainRate";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ChainRate;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ChainRate;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ChainRate;
+
+  static equals(a: ChainRate | PlainMessage<ChainRate> | undefined, b: ChainRate | PlainMessage<ChainRate> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.crosschain.AssetCap
+ */
+export declare class AssetCap extends Message<AssetCap> {
+  /**
+   * @generated from field: string zrc20 = 1;
+   */
+  zrc20: string;
+
+  /**
+   * cap in token units per window
+   *
+   * @generated from field: string cap = 2;
+   */
+  cap: string;
+
+  constructor(data?: PartialMessage<AssetCap>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.AssetCap";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AssetCap;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AssetCap;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AssetCap;
+
+  static equals(a: AssetCap | PlainMessage<AssetCap> | undefined, b: AssetCap | PlainMessage<AssetCap> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.crosschain.DelayThreshold
+ */
+export declare class DelayThreshold extends Message<DelayThreshold> {
+  /**
+   * @generated from field: string zrc20 = 1;
+   */
+  zrc20: string;
+
+  /**
+   * threshold in token units
+   *
+   * @generated from field: string threshold = 2;
+   */
+  threshold: string;
+
+  constructor(data?: PartialMessage<DelayThreshold>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.DelayThreshold";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DelayThreshold;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DelayThreshold;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DelayThreshold;
+
+  static equals(a: DelayThreshold | PlainMessage<DelayThreshold> | undefined, b: DelayThreshold | PlainMessage<DelayThreshold> | undefined): boolean;
+}
+
 /**
  * @generated from message zetachain.zetacore.crosschain.Conversion
  */
@@ -125,3 +256,113 @@ export declare class AssetRate extends Message<AssetRate> {
   static equals(a: AssetRate | PlainMessage<AssetRate> | undefined, b: AssetRate | PlainMessage<AssetRate> | undefined): boolean;
 }
 
+/**
+ * ChainQuotaUsage is the value withdrawn to a chain within the window in azeta
+ *
+ * @generated from message zetachain.zetacore.crosschain.ChainQuotaUsage
+ */
+export declare class ChainQuotaUsage extends Message<ChainQuotaUsage> {
+  /**
+   * @generated from field: int64 chain_id = 1;
+   */
+  chainId: bigint;
+
+  /**
+   * @generated from field: string used = 2;
+   */
+  used: string;
+
+  /**
+   * @generated from field: string limit = 3;
+   */
+  limit: string;
+
+  constructor(data?: PartialMessage<ChainQuotaUsage>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.ChainQuotaUsage";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ChainQuotaUsage;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ChainQuotaUsage;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ChainQuotaUsage;
+
+  static equals(a: ChainQuotaUsage | PlainMessage<ChainQuotaUsage> | undefined, b: ChainQuotaUsage | PlainMessage<ChainQuotaUsage> | undefined): boolean;
+}
+
+/**
+ * SenderQuotaUsage is the value withdrawn by a sender within its current
+ * window in azeta
+ *
+ * @generated from message zetachain.zetacore.crosschain.SenderQuotaUsage
+ */
+export declare class SenderQuotaUsage extends Message<SenderQuotaUsage> {
+  /**
+   * @generated from field: string sender = 1;
+   */
+  sender: string;
+
+  /**
+   * @generated from field: string used = 2;
+   */
+  used: string;
+
+  /**
+   * @generated from field: string limit = 3;
+   */
+  limit: string;
+
+  constructor(data?: PartialMessage<SenderQuotaUsage>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.SenderQuotaUsage";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SenderQuotaUsage;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SenderQuotaUsage;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SenderQuotaUsage;
+
+  static equals(a: SenderQuotaUsage | PlainMessage<SenderQuotaUsage> | undefined, b: SenderQuotaUsage | PlainMessage<SenderQuotaUsage> | undefined): boolean;
+}
+
+/**
+ * AssetQuotaUsage is the amount of a zrc20 withdrawn within the window in token
+ * units
+ *
+ * @generated from message zetachain.zetacore.crosschain.AssetQuotaUsage
+ */
+export declare class AssetQuotaUsage extends Message<AssetQuotaUsage> {
+  /**
+   * @generated from field: string zrc20 = 1;
+   */
+  zrc20: string;
+
+  /**
+   * @generated from field: string used = 2;
+   */
+  used: string;
+
+  /**
+   * @generated from field: string cap = 3;
+   */
+  cap: string;
+
+  constructor(data?: PartialMessage<AssetQuotaUsage>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.AssetQuotaUsage";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AssetQuotaUsage;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AssetQuotaUsage;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AssetQuotaUsage;
+
+  static equals(a: AssetQuotaUsage | PlainMessage<AssetQuotaUsage> | undefined, b: AssetQuotaUsage | PlainMessage<AssetQuotaUsage> | undefined): boolean;
+}
+
@@ -758,6 +758,54 @@ export declare class MsgAbortStuckCCTXResponse extends Message<MsgAbortStuckCCTX
   static equals(a: MsgAbortStuckCCTXResponse | PlainMessage<MsgAbortStuckCCTXResponse> | undefined, b: MsgAbortStuckCCTXResponse | PlainMessage<MsgAbortStuckCCTXResponse> | undefined): boolean;
 }
 
+/**
+ * @generated from message zetachain.zetacore.crosschain.MsgCancelDelayedCCTX
+ */
+export declare class MsgCancelDelayedCCTX extends Message<MsgCancelDelayedCCTX> {
+  /**
+   * @generated from field: string creator = 1;
+   */
+  creator: string;
+
+  /**
+   * @generated from field: string cctx_index = 2;
+   */
+  cctxIndex: string;
+
+  constructor(data?: PartialMessage<MsgCancelDelayedCCTX>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.MsgCancelDelayedCCTX";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MsgCancelDelayedCCTX;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MsgCancelDelayedCCTX;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MsgCancelDelayedCCTX;
+
+  static equals(a: MsgCancelDelayedCCTX | PlainMessage<MsgCancelDelayedCCTX> | undefined, b: MsgCancelDelayedCCTX | PlainMessage<MsgCancelDelayedCCTX> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.crosschain.MsgCancelDelayedCCTXResponse
+ */
+export declare class MsgCancelDelayedCCTXResponse extends Message<MsgCancelDelayedCCTXResponse> {
+  constructor(data?: PartialMessage<MsgCancelDelayedCCTXResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.MsgCancelDelayedCCTXResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MsgCancelDelayedCCTXResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MsgCancelDelayedCCTXResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MsgCancelDelayedCCTXResponse;
+
+  static equals(a: MsgCancelDelayedCCTXResponse | PlainMessage<MsgCancelDelayedCCTXResponse> | undefined, b: MsgCancelDelayedCCTXResponse | PlainMessage<MsgCancelDelayedCCTXResponse> | undefined): boolean;
+}
+
 /**
  * @generated from message zetachain.zetacore.crosschain.MsgRefundAbortedCCTX
  */
@@ -990,3 +1038,70 @@ export declare class MsgUpdateERC20CustodyPauseStatusResponse extends Message<Ms
   static equals(a: MsgUpdateERC20CustodyPauseStatusResponse | PlainMessage<MsgUpdateERC20CustodyPauseStatusResponse> | undefined, b: MsgUpdateERC20CustodyPauseStatusResponse | PlainMessage<MsgUpdateERC20CustodyPauseStatusResponse> | undefined): boolean;
 }
 
+/**
+ * @generated from message zetachain.zetacore.crosschain.MsgConsolidateUTXOs
+ */
+export declare class MsgConsolidateUTXOs extends Message<MsgConsolidateUTXOs> {
+  /**
+   * @generated from field: string creator = 1;
+   */
+  creator: string;
+
+  /**
+   * @generated from field: int64 chain_id = 2;
+   */
+  chainId: bigint;
+
+  /**
+   * number of UTXOs of the TSS observed by the voter
+   *
+   * @generated from field: uint64 utxo_count = 3;
+   */
+  utxoCount: bigint;
+
+  /**
+   * number of dust UTXOs among them
+   *
+   * @generated from field: uint64 dust_count = 4;
+   */
+  dustCount: bigint;
+
+  constructor(data?: PartialMessage<MsgConsolidateUTXOs>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.MsgConsolidateUTXOs";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MsgConsolidateUTXOs;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MsgConsolidateUTXOs;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MsgConsolidateUTXOs;
+
+  static equals(a: MsgConsolidateUTXOs | PlainMessage<MsgConsolidateUTXOs> | undefined, b: MsgConsolidateUTXOs | PlainMessage<MsgConsolidateUTXOs> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.crosschain.MsgConsolidateUTXOsResponse
+ */
+export declare class MsgConsolidateUTXOsResponse extends Message<MsgConsolidateUTXOsResponse> {
+  /**
+   * @generated from field: string cctx_index = 1;
+   */
+  cctxIndex: string;
+
+  constructor(data?: PartialMessage<MsgConsolidateUTXOsResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.MsgConsolidateUTXOsResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MsgConsolidateUTXOsResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MsgConsolidateUTXOsResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MsgConsolidateUTXOsResponse;
+
+  static equals(a: MsgConsolidateUTXOsResponse | PlainMessage<MsgConsolidateUTXOsResponse> | undefined, b: MsgConsolidateUTXOsResponse | PlainMessage<MsgConsolidateUTXOsResponse> | undefined): boolean;
+}
+
@@ -15,6 +15,8 @@ import { Message, proto3 } from "@bufbuild/protobuf";
  *    ObserverSlashAmount:         100000000000000000,
  *    BallotMaturityBlocks:        100,
  *    BlockRewardAmount:           9620949074074074074.074070733466756687,
+ *    BallotRetentionBlocks:       0,
+ *    EmitBallotArchiveEvents:     false,
  *
  * @generated from message zetachain.zetacore.emissions.Params
  */
@@ -49,6 +51,21 @@ export declare class Params extends Message<Params> {
    */
   blockRewardAmount: string;
 
+  /**
+   * number of blocks ballots are kept in the state after their
+   * rewards have been distributed at maturity
+   *
+   * @generated from field: int64 ballot_retention_blocks = 12;
+   */
+  ballotRetentionBlocks: bigint;
+
+  /**
+   * emit an event containing the full ballot when it is pruned
+   *
+   * @generated from field: bool emit_ballot_archive_events = 13;
+   */
+  emitBallotArchiveEvents: boolean;
+
   constructor(data?: PartialMessage<Params>);
 
   static readonly runtime: typeof proto3;
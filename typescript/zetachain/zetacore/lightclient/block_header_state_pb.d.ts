@@ -0,0 +1,83 @@
+// @generated by protoc-gen-es v1.3.0 with parameter "target=dts"
+// @generated from file zetachain/zetacore/lightclient/block_header_state.proto (package zetachain.zetacore.lightclient, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import { Message, proto3 } from "@bufbuild/protobuf";
+
+/**
+ * BlockHeaderState defines the fork-choice state of a block header
+ *
+ * @generated from message zetachain.zetacore.lightclient.BlockHeaderState
+ */
+export declare class BlockHeaderState extends Message<BlockHeaderState> {
+  /**
+   * @generated from field: bytes block_hash = 1;
+   */
+  blockHash: Uint8Array;
+
+  /**
+   * @generated from field: int64 chain_id = 2;
+   */
+  chainId: bigint;
+
+  /**
+   * @generated from field: int64 height = 3;
+   */
+  height: bigint;
+
+  /**
+   * cumulative_work is the total work of the branch ending with the block
+   * header
+   *
+   * @generated from field: string cumulative_work = 4;
+   */
+  cumulativeWork: string;
+
+  /**
+   * orphaned is true if the block header is not part of the canonical chain
+   *
+   * @generated from field: bool orphaned = 5;
+   */
+  orphaned: boolean;
+
+  /**
+   * bitcoin only: timestamp of the first block of the difficulty period of
+   * the block header, 0 if the first block of the period is not stored
+   *
+   * @generated from field: int64 period_start_timestamp = 6;
+   */
+  periodStartTimestamp: bigint;
+
+  /**
+   * bitcoin only: difficulty bits of the first block of the difficulty period
+   * of the block header
+   *
+   * @generated from field: uint32 period_start_bits = 7;
+   */
+  periodStartBits: number;
+
+  /**
+   * bitcoin only: difficulty bits of the last block of the difficulty period
+   * up to the block header that doesn't use the minimum difficulty
+   *
+   * @generated from field: uint32 last_bits = 8;
+   */
+  lastBits: number;
+
+  constructor(data?: PartialMessage<BlockHeaderState>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.lightclient.BlockHeaderState";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): BlockHeaderState;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): BlockHeaderState;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): BlockHeaderState;
+
+  static equals(a: BlockHeaderState | PlainMessage<BlockHeaderState> | undefined, b: BlockHeaderState | PlainMessage<BlockHeaderState> | undefined): boolean;
+}
+
@@ -23,6 +23,15 @@ export declare class HeaderSupportedChain extends Message<HeaderSupportedChain>
    */
   enabled: boolean;
 
+  /**
+   * header_retention is the number of blocks below the canonical tip for which
+   * the block headers are kept, older headers are pruned
+   * 0 means the block headers are never pruned
+   *
+   * @generated from field: int64 header_retention = 3;
+   */
+  headerRetention: bigint;
+
   constructor(data?: PartialMessage<HeaderSupportedChain>);
 
   static readonly runtime: typeof proto3;
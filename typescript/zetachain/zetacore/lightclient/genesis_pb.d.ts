@@ -8,6 +8,7 @@ import { Message, proto3 } from "@bufbuild/protobuf";
 import type { BlockHeader } from "../pkg/proofs/proofs_pb.js";
 import type { ChainState } from "./chain_state_pb.js";
 import type { BlockHeaderVerification } from "./block_header_verification_pb.js";
+import type { BlockHeaderState } from "./block_header_state_pb.js";
 
 /**
  * GenesisState defines the lightclient module's genesis state.
@@ -30,6 +31,11 @@ export declare class GenesisState extends Message<GenesisState> {
    */
   blockHeaderVerification?: BlockHeaderVerification;
 
+  /**
+   * @generated from field: repeated zetachain.zetacore.lightclient.BlockHeaderState block_header_states = 4;
+   */
+  blockHeaderStates: BlockHeaderState[];
+
   constructor(data?: PartialMessage<GenesisState>);
 
   static readonly runtime: typeof proto3;
@@ -1,3 +1,4 @@
+export * from "./block_header_state_pb";
 export * from "./block_header_verification_pb";
 export * from "./chain_state_pb";
 export * from "./genesis_pb";
@@ -8,6 +8,7 @@ import { Message, proto3 } from "@bufbuild/protobuf";
 import type { PageRequest, PageResponse } from "../../../cosmos/base/query/v1beta1/pagination_pb.js";
 import type { BlockHeader, Proof } from "../pkg/proofs/proofs_pb.js";
 import type { ChainState } from "./chain_state_pb.js";
+import type { BlockHeaderState } from "./block_header_state_pb.js";
 import type { HeaderSupportedChain } from "./block_header_verification_pb.js";
 
 /**
@@ -212,6 +213,59 @@ export declare class QueryGetChainStateResponse extends Message<QueryGetChainSta
   static equals(a: QueryGetChainStateResponse | PlainMessage<QueryGetChainStateResponse> | undefined, b: QueryGetChainStateResponse | PlainMessage<QueryGetChainStateResponse> | undefined): boolean;
 }
 
+/**
+ * @generated from message zetachain.zetacore.lightclient.QueryCanonicalTipRequest
+ */
+export declare class QueryCanonicalTipRequest extends Message<QueryCanonicalTipRequest> {
+  /**
+   * @generated from field: int64 chain_id = 1;
+   */
+  chainId: bigint;
+
+  constructor(data?: PartialMessage<QueryCanonicalTipRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.lightclient.QueryCanonicalTipRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryCanonicalTipRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryCanonicalTipRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryCanonicalTipRequest;
+
+  static equals(a: QueryCanonicalTipRequest | PlainMessage<QueryCanonicalTipRequest> | undefined, b: QueryCanonicalTipRequest | PlainMessage<QueryCanonicalTipRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.lightclient.QueryCanonicalTipResponse
+ */
+export declare class QueryCanonicalTipResponse extends Message<QueryCanonicalTipResponse> {
+  /**
+   * @generated from field: zetachain.zetacore.pkg.proofs.BlockHeader block_header = 1;
+   */
+  blockHeader?: BlockHeader;
+
+  /**
+   * @generated from field: zetachain.zetacore.lightclient.BlockHeaderState block_header_state = 2;
+   */
+  blockHeaderState?: BlockHeaderState;
+
+  constructor(data?: PartialMessage<QueryCanonicalTipResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.lightclient.QueryCanonicalTipResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryCanonicalTipResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryCanonicalTipResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryCanonicalTipResponse;
+
+  static equals(a: QueryCanonicalTipResponse | PlainMessage<QueryCanonicalTipResponse> | undefined, b: QueryCanonicalTipResponse | PlainMessage<QueryCanonicalTipResponse> | undefined): boolean;
+}
+
 /**
  * @generated from message zetachain.zetacore.lightclient.QueryProveRequest
  */
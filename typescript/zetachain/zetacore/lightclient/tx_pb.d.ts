@@ -102,3 +102,56 @@ export declare class MsgDisableHeaderVerificationResponse extends Message<MsgDis
   static equals(a: MsgDisableHeaderVerificationResponse | PlainMessage<MsgDisableHeaderVerificationResponse> | undefined, b: MsgDisableHeaderVerificationResponse | PlainMessage<MsgDisableHeaderVerificationResponse> | undefined): boolean;
 }
 
+/**
+ * @generated from message zetachain.zetacore.lightclient.MsgUpdateHeaderRetention
+ */
+export declare class MsgUpdateHeaderRetention extends Message<MsgUpdateHeaderRetention> {
+  /**
+   * @generated from field: string creator = 1;
+   */
+  creator: string;
+
+  /**
+   * @generated from field: int64 chain_id = 2;
+   */
+  chainId: bigint;
+
+  /**
+   * @generated from field: int64 header_retention = 3;
+   */
+  headerRetention: bigint;
+
+  constructor(data?: PartialMessage<MsgUpdateHeaderRetention>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.lightclient.MsgUpdateHeaderRetention";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MsgUpdateHeaderRetention;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MsgUpdateHeaderRetention;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MsgUpdateHeaderRetention;
+
+  static equals(a: MsgUpdateHeaderRetention | PlainMessage<MsgUpdateHeaderRetention> | undefined, b: MsgUpdateHeaderRetention | PlainMessage<MsgUpdateHeaderRetention> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.lightclient.MsgUpdateHeaderRetentionResponse
+ */
+export declare class MsgUpdateHeaderRetentionResponse extends Message<MsgUpdateHeaderRetentionResponse> {
+  constructor(data?: PartialMessage<MsgUpdateHeaderRetentionResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.lightclient.MsgUpdateHeaderRetentionResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MsgUpdateHeaderRetentionResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MsgUpdateHeaderRetentionResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MsgUpdateHeaderRetentionResponse;
+
+  static equals(a: MsgUpdateHeaderRetentionResponse | PlainMessage<MsgUpdateHeaderRetentionResponse> | undefined, b: MsgUpdateHeaderRetentionResponse | PlainMessage<MsgUpdateHeaderRetentionResponse> | undefined): boolean;
+}
+
@@ -98,6 +98,14 @@ export declare class Ballot extends Message<Ballot> {
    */
   ballotCreationHeight: bigint;
 
+  /**
+   * voting power of each voter of the voter list, empty if the votes are not
+   * weighted
+   *
+   * @generated from field: repeated string voting_power = 9;
+   */
+  votingPower: string[];
+
   constructor(data?: PartialMessage<Ballot>);
 
   static readonly runtime: typeof proto3;
@@ -113,6 +121,47 @@ export declare class Ballot extends Message<Ballot> {
   static equals(a: Ballot | PlainMessage<Ballot> | undefined, b: Ballot | PlainMessage<Ballot> | undefined): boolean;
 }
 
+/**
+ * BallotTally is the voting power of each vote type of a ballot
+ *
+ * @generated from message zetachain.zetacore.observer.BallotTally
+ */
+export declare class BallotTally extends Message<BallotTally> {
+  /**
+   * @generated from field: string success_power = 1;
+   */
+  successPower: string;
+
+  /**
+   * @generated from field: string failure_power = 2;
+   */
+  failurePower: string;
+
+  /**
+   * @generated from field: string not_voted_power = 3;
+   */
+  notVotedPower: string;
+
+  /**
+   * @generated from field: string total_power = 4;
+   */
+  totalPower: string;
+
+  constructor(data?: PartialMessage<BallotTally>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.BallotTally";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): BallotTally;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): BallotTally;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): BallotTally;
+
+  static equals(a: BallotTally | PlainMessage<BallotTally> | undefined, b: BallotTally | PlainMessage<BallotTally> | undefined): boolean;
+}
+
 /**
  * @generated from message zetachain.zetacore.observer.BallotListForHeight
  */
@@ -56,6 +56,80 @@ export declare class GasPriceIncreaseFlags extends Message<GasPriceIncreaseFlags
   static equals(a: GasPriceIncreaseFlags | PlainMessage<GasPriceIncreaseFlags> | undefined, b: GasPriceIncreaseFlags | PlainMessage<GasPriceIncreaseFlags> | undefined): boolean;
 }
 
+/**
+ * ChainPauseFlags contains the pause switches of the inbounds from and the
+ * outbounds to a connected chain
+ *
+ * @generated from message zetachain.zetacore.observer.ChainPauseFlags
+ */
+export declare class ChainPauseFlags extends Message<ChainPauseFlags> {
+  /**
+   * @generated from field: int64 chainId = 1;
+   */
+  chainId: bigint;
+
+  /**
+   * @generated from field: bool isInboundPaused = 2;
+   */
+  isInboundPaused: boolean;
+
+  /**
+   * @generated from field: bool isOutboundPaused = 3;
+   */
+  isOutboundPaused: boolean;
+
+  constructor(data?: PartialMessage<ChainPauseFlags>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.ChainPauseFlags";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ChainPauseFlags;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ChainPauseFlags;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ChainPauseFlags;
+
+  static equals(a: ChainPauseFlags | PlainMessage<ChainPauseFlags> | undefined, b: ChainPauseFlags | PlainMessage<ChainPauseFlags> | undefined): boolean;
+}
+
+/**
+ * ZRC20PauseFlags contains the pause switches of the deposits (inbounds) and
+ * the withdrawals (outbounds) of a ZRC20 token
+ *
+ * @generated from message zetachain.zetacore.observer.ZRC20PauseFlags
+ */
+export declare class ZRC20PauseFlags extends Message<ZRC20PauseFlags> {
+  /**
+   * @generated from field: string zrc20Address = 1;
+   */
+  zrc20Address: string;
+
+  /**
+   * @generated from field: bool isInboundPaused = 2;
+   */
+  isInboundPaused: boolean;
+
+  /**
+   * @generated from field: bool isOutboundPaused = 3;
+   */
+  isOutboundPaused: boolean;
+
+  constructor(data?: PartialMessage<ZRC20PauseFlags>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.ZRC20PauseFlags";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ZRC20PauseFlags;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ZRC20PauseFlags;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ZRC20PauseFlags;
+
+  static equals(a: ZRC20PauseFlags | PlainMessage<ZRC20PauseFlags> | undefined, b: ZRC20PauseFlags | PlainMessage<ZRC20PauseFlags> | undefined): boolean;
+}
+
 /**
  * @generated from message zetachain.zetacore.observer.CrosschainFlags
  */
@@ -75,6 +149,20 @@ export declare class CrosschainFlags extends Message<CrosschainFlags> {
    */
   gasPriceIncreaseFlags?: GasPriceIncreaseFlags;
 
+  /**
+   * Connected chains with paused inbound and/or outbound
+   *
+   * @generated from field: repeated zetachain.zetacore.observer.ChainPauseFlags pausedChains = 4;
+   */
+  pausedChains: ChainPauseFlags[];
+
+  /**
+   * ZRC20 tokens with paused inbound and/or outbound
+   *
+   * @generated from field: repeated zetachain.zetacore.observer.ZRC20PauseFlags pausedZRC20Tokens = 5;
+   */
+  pausedZRC20Tokens: ZRC20PauseFlags[];
+
   constructor(data?: PartialMessage<CrosschainFlags>);
 
   static readonly runtime: typeof proto3;
@@ -6,6 +6,7 @@
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
 import { Message, proto3 } from "@bufbuild/protobuf";
 import type { GasPriceIncreaseFlags } from "./crosschain_flags_pb.js";
+import type { Ballot } from "./ballot_pb.js";
 
 /**
  * @generated from message zetachain.zetacore.observer.EventBallotCreated
@@ -197,6 +198,94 @@ export declare class EventCCTXEnabled extends Message<EventCCTXEnabled> {
   static equals(a: EventCCTXEnabled | PlainMessage<EventCCTXEnabled> | undefined, b: EventCCTXEnabled | PlainMessage<EventCCTXEnabled> | undefined): boolean;
 }
 
+/**
+ * @generated from message zetachain.zetacore.observer.EventCCTXPaused
+ */
+export declare class EventCCTXPaused extends Message<EventCCTXPaused> {
+  /**
+   * @generated from field: string msg_type_url = 1;
+   */
+  msgTypeUrl: string;
+
+  /**
+   * @generated from field: repeated int64 chain_ids = 2;
+   */
+  chainIds: bigint[];
+
+  /**
+   * @generated from field: repeated string zrc20_addresses = 3;
+   */
+  zrc20Addresses: string[];
+
+  /**
+   * @generated from field: bool pause_inbound = 4;
+   */
+  pauseInbound: boolean;
+
+  /**
+   * @generated from field: bool pause_outbound = 5;
+   */
+  pauseOutbound: boolean;
+
+  constructor(data?: PartialMessage<EventCCTXPaused>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.EventCCTXPaused";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): EventCCTXPaused;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): EventCCTXPaused;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): EventCCTXPaused;
+
+  static equals(a: EventCCTXPaused | PlainMessage<EventCCTXPaused> | undefined, b: EventCCTXPaused | PlainMessage<EventCCTXPaused> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.observer.EventCCTXUnpaused
+ */
+export declare class EventCCTXUnpaused extends Message<EventCCTXUnpaused> {
+  /**
+   * @generated from field: string msg_type_url = 1;
+   */
+  msgTypeUrl: string;
+
+  /**
+   * @generated from field: repeated int64 chain_ids = 2;
+   */
+  chainIds: bigint[];
+
+  /**
+   * @generated from field: repeated string zrc20_addresses = 3;
+   */
+  zrc20Addresses: string[];
+
+  /**
+   * @generated from field: bool unpause_inbound = 4;
+   */
+  unpauseInbound: boolean;
+
+  /**
+   * @generated from field: bool unpause_outbound = 5;
+   */
+  unpauseOutbound: boolean;
+
+  constructor(data?: PartialMessage<EventCCTXUnpaused>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.EventCCTXUnpaused";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): EventCCTXUnpaused;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): EventCCTXUnpaused;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): EventCCTXUnpaused;
+
+  static equals(a: EventCCTXUnpaused | PlainMessage<EventCCTXUnpaused> | undefined, b: EventCCTXUnpaused | PlainMessage<EventCCTXUnpaused> | undefined): boolean;
+}
+
 /**
  * @generated from message zetachain.zetacore.observer.EventGasPriceIncreaseFlagsUpdated
  */
@@ -226,3 +315,101 @@ export declare class EventGasPriceIncreaseFlagsUpdated extends Message<EventGasP
   static equals(a: EventGasPriceIncreaseFlagsUpdated | PlainMessage<EventGasPriceIncreaseFlagsUpdated> | undefined, b: EventGasPriceIncreaseFlagsUpdated | PlainMessage<EventGasPriceIncreaseFlagsUpdated> | undefined): boolean;
 }
 
+/**
+ * EventBallotArchived is emitted for each finalized ballot pruned from the
+ * state when ballot archive events are enabled in the emissions params
+ *
+ * @generated from message zetachain.zetacore.observer.EventBallotArchived
+ */
+export declare class EventBallotArchived extends Message<EventBallotArchived> {
+  /**
+   * @generated from field: zetachain.zetacore.observer.Ballot ballot = 1;
+   */
+  ballot?: Ballot;
+
+  constructor(data?: PartialMessage<EventBallotArchived>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.EventBallotArchived";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): EventBallotArchived;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): EventBallotArchived;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): EventBallotArchived;
+
+  static equals(a: EventBallotArchived | PlainMessage<EventBallotArchived> | undefined, b: EventBallotArchived | PlainMessage<EventBallotArchived> | undefined): boolean;
+}
+
+/**
+ * EventObserverJailed is emitted when an observer is jailed for missing too
+ * many ballots in the liveness window
+ *
+ * @generated from message zetachain.zetacore.observer.EventObserverJailed
+ */
+export declare class EventObserverJailed extends Message<EventObserverJailed> {
+  /**
+   * @generated from field: string observer_address = 1;
+   */
+  observerAddress: string;
+
+  /**
+   * @generated from field: uint64 missed_ballots = 2;
+   */
+  missedBallots: bigint;
+
+  /**
+   * @generated from field: uint64 window_size = 3;
+   */
+  windowSize: bigint;
+
+  /**
+   * @generated from field: int64 jailed_until = 4;
+   */
+  jailedUntil: bigint;
+
+  constructor(data?: PartialMessage<EventObserverJailed>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.EventObserverJailed";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): EventObserverJailed;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): EventObserverJailed;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): EventObserverJailed;
+
+  static equals(a: EventObserverJailed | PlainMessage<EventObserverJailed> | undefined, b: EventObserverJailed | PlainMessage<EventObserverJailed> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.observer.EventObserverUnjailed
+ */
+export declare class EventObserverUnjailed extends Message<EventObserverUnjailed> {
+  /**
+   * @generated from field: string msg_type_url = 1;
+   */
+  msgTypeUrl: string;
+
+  /**
+   * @generated from field: string observer_address = 2;
+   */
+  observerAddress: string;
+
+  constructor(data?: PartialMessage<EventObserverUnjailed>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.EventObserverUnjailed";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): EventObserverUnjailed;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): EventObserverUnjailed;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): EventObserverUnjailed;
+
+  static equals(a: EventObserverUnjailed | PlainMessage<EventObserverUnjailed> | undefined, b: EventObserverUnjailed | PlainMessage<EventObserverUnjailed> | undefined): boolean;
+}
+
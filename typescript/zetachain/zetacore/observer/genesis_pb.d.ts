@@ -17,6 +17,7 @@ import type { Blame } from "./blame_pb.js";
 import type { PendingNonces } from "./pending_nonces_pb.js";
 import type { ChainNonces } from "./chain_nonces_pb.js";
 import type { NonceToCctx } from "./nonce_to_cctx_pb.js";
+import type { LivenessParams, ObserverLiveness } from "./liveness_pb.js";
 
 /**
  * @generated from message zetachain.zetacore.observer.GenesisState
@@ -99,6 +100,16 @@ export declare class GenesisState extends Message<GenesisState> {
    */
   nonceToCctx: NonceToCctx[];
 
+  /**
+   * @generated from field: zetachain.zetacore.observer.LivenessParams liveness_params = 16;
+   */
+  livenessParams?: LivenessParams;
+
+  /**
+   * @generated from field: repeated zetachain.zetacore.observer.ObserverLiveness observers_liveness = 17;
+   */
+  observersLiveness: ObserverLiveness[];
+
   constructor(data?: PartialMessage<GenesisState>);
 
   static readonly runtime: typeof proto3;
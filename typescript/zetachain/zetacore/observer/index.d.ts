@@ -5,6 +5,7 @@ export * from "./crosschain_flags_pb";
 export * from "./events_pb";
 export * from "./genesis_pb";
 export * from "./keygen_pb";
+export * from "./liveness_pb";
 export * from "./node_account_pb";
 export * from "./nonce_to_cctx_pb";
 export * from "./observer_pb";
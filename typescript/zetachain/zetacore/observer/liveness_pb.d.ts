@@ -0,0 +1,126 @@
+// @generated by protoc-gen-es v1.3.0 with parameter "target=dts"
+// @generated from file zetachain/zetacore/observer/liveness.proto (package zetachain.zetacore.observer, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import { Message, proto3 } from "@bufbuild/protobuf";
+
+/**
+ * LivenessParams defines how the participation of the observers in the ballots
+ * is tracked and when an observer missing too many ballots is jailed
+ *
+ * @generated from message zetachain.zetacore.observer.LivenessParams
+ */
+export declare class LivenessParams extends Message<LivenessParams> {
+  /**
+   * enable the participation tracking and the automatic jailing
+   *
+   * @generated from field: bool enabled = 1;
+   */
+  enabled: boolean;
+
+  /**
+   * number of ballots in the sliding window used to compute the liveness
+   *
+   * @generated from field: uint64 window_size = 2;
+   */
+  windowSize: bigint;
+
+  /**
+   * minimum ratio of ballots voted in the window, the observer is jailed below
+   *
+   * @generated from field: string min_vote_ratio = 3;
+   */
+  minVoteRatio: string;
+
+  /**
+   * number of blocks a jailed observer must wait before unjailing
+   *
+   * @generated from field: int64 jail_duration_blocks = 4;
+   */
+  jailDurationBlocks: bigint;
+
+  /**
+   * maximum number of observers jailed at the same time
+   *
+   * @generated from field: uint64 max_jailed_observers = 5;
+   */
+  maxJailedObservers: bigint;
+
+  constructor(data?: PartialMessage<LivenessParams>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.LivenessParams";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): LivenessParams;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): LivenessParams;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): LivenessParams;
+
+  static equals(a: LivenessParams | PlainMessage<LivenessParams> | undefined, b: LivenessParams | PlainMessage<LivenessParams> | undefined): boolean;
+}
+
+/**
+ * ObserverLiveness records the participation of an observer in the last
+ * finalized ballots
+ *
+ * @generated from message zetachain.zetacore.observer.ObserverLiveness
+ */
+export declare class ObserverLiveness extends Message<ObserverLiveness> {
+  /**
+   * @generated from field: string observer_address = 1;
+   */
+  observerAddress: string;
+
+  /**
+   * sliding window of the last ballots, true if the observer missed the ballot
+   * the window is a ring buffer indexed by the number of recorded ballots
+   *
+   * @generated from field: repeated bool missed_ballots = 2;
+   */
+  missedBallots: boolean[];
+
+  /**
+   * total number of ballots recorded
+   *
+   * @generated from field: uint64 recorded_ballots = 3;
+   */
+  recordedBallots: bigint;
+
+  /**
+   * number of missed ballots in the window
+   *
+   * @generated from field: uint64 missed_ballots_counter = 4;
+   */
+  missedBallotsCounter: bigint;
+
+  /**
+   * @generated from field: bool jailed = 5;
+   */
+  jailed: boolean;
+
+  /**
+   * height from which the observer can be unjailed
+   *
+   * @generated from field: int64 jailed_until = 6;
+   */
+  jailedUntil: bigint;
+
+  constructor(data?: PartialMessage<ObserverLiveness>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.ObserverLiveness";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ObserverLiveness;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ObserverLiveness;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ObserverLiveness;
+
+  static equals(a: ObserverLiveness | PlainMessage<ObserverLiveness> | undefined, b: ObserverLiveness | PlainMessage<ObserverLiveness> | undefined): boolean;
+}
+
@@ -5,6 +5,7 @@
 
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
 import { Message, proto3 } from "@bufbuild/protobuf";
+import type { CoinType } from "../pkg/coin/coin_pb.js";
 
 /**
  * @generated from message zetachain.zetacore.observer.ChainParamsList
@@ -30,6 +31,51 @@ export declare class ChainParamsList extends Message<ChainParamsList> {
   static equals(a: ChainParamsList | PlainMessage<ChainParamsList> | undefined, b: ChainParamsList | PlainMessage<ChainParamsList> | undefined): boolean;
 }
 
+/**
+ * ConfirmationTier defines the number of confirmations required for the
+ * inbounds and outbounds of an asset with an amount greater than or equal to
+ * the threshold
+ *
+ * @generated from message zetachain.zetacore.observer.ConfirmationTier
+ */
+export declare class ConfirmationTier extends Message<ConfirmationTier> {
+  /**
+   * @generated from field: zetachain.zetacore.pkg.coin.CoinType coin_type = 1;
+   */
+  coinType: CoinType;
+
+  /**
+   * asset address, only used for the ERC20 coin type
+   *
+   * @generated from field: string asset = 2;
+   */
+  asset: string;
+
+  /**
+   * @generated from field: string amount_threshold = 3;
+   */
+  amountThreshold: string;
+
+  /**
+   * @generated from field: uint64 confirmation_count = 4;
+   */
+  confirmationCount: bigint;
+
+  constructor(data?: PartialMessage<ConfirmationTier>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.ConfirmationTier";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ConfirmationTier;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ConfirmationTier;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ConfirmationTier;
+
+  static equals(a: ConfirmationTier | PlainMessage<ConfirmationTier> | undefined, b: ConfirmationTier | PlainMessage<ConfirmationTier> | undefined): boolean;
+}
+
 /**
  * @generated from message zetachain.zetacore.observer.ChainParams
  */
@@ -109,6 +155,47 @@ export declare class ChainParams extends Message<ChainParams> {
    */
   gatewayAddress: string;
 
+  /**
+   * confirmation counts for larger amounts, confirmation_count is used for
+   * amounts below the lowest threshold
+   *
+   * @generated from field: repeated zetachain.zetacore.observer.ConfirmationTier confirmation_tiers = 18;
+   */
+  confirmationTiers: ConfirmationTier[];
+
+  /**
+   * if true, the votes of the ballots are weighted by the bonded stake of the
+   * observers at the ballot creation
+   *
+   * @generated from field: bool weighted_voting = 19;
+   */
+  weightedVoting: boolean;
+
+  /**
+   * bitcoin only: the consolidation of the TSS UTXOs is scheduled when their
+   * number reaches this threshold, 0 disables the trigger
+   *
+   * @generated from field: uint64 utxo_consolidation_threshold = 20;
+   */
+  utxoConsolidationThreshold: bigint;
+
+  /**
+   * bitcoin only: the consolidation of the TSS UTXOs is scheduled when the
+   * percentage of dust UTXOs reaches this threshold, 0 disables the trigger
+   *
+   * @generated from field: uint32 utxo_dust_ratio_threshold = 21;
+   */
+  utxoDustRatioThreshold: number;
+
+  /**
+   * bitcoin only: the consolidation of the TSS UTXOs is scheduled only when
+   * the fee rate (in sat/vB) is lower than or equal to this value, 0 means no
+   * limit
+   *
+   * @generated from field: uint64 utxo_consolidation_max_fee_rate = 22;
+   */
+  utxoConsolidationMaxFeeRate: bigint;
+
   constructor(data?: PartialMessage<ChainParams>);
 
   static readonly runtime: typeof proto3;
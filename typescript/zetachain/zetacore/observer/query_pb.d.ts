@@ -5,20 +5,197 @@
 
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
 import { Message, proto3 } from "@bufbuild/protobuf";
+import type { LivenessParams, ObserverLiveness } from "./liveness_pb.js";
+import type { PageRequest, PageResponse } from "../../../cosmos/base/query/v1beta1/pagination_pb.js";
 import type { TssFundMigratorInfo } from "./tss_funds_migrator_pb.js";
 import type { ChainNonces } from "./chain_nonces_pb.js";
-import type { PageRequest, PageResponse } from "../../../cosmos/base/query/v1beta1/pagination_pb.js";
 import type { PendingNonces } from "./pending_nonces_pb.js";
 import type { TSS } from "./tss_pb.js";
-import type { BallotStatus, VoteType } from "./ballot_pb.js";
+import type { BallotStatus, BallotTally, VoteType } from "./ballot_pb.js";
 import type { LastObserverCount, ObservationType } from "./observer_pb.js";
 import type { Chain } from "../pkg/chains/chains_pb.js";
 import type { ChainParams, ChainParamsList } from "./params_pb.js";
 import type { NodeAccount } from "./node_account_pb.js";
-import type { CrosschainFlags } from "./crosschain_flags_pb.js";
+import type { ChainPauseFlags, CrosschainFlags, ZRC20PauseFlags } from "./crosschain_flags_pb.js";
 import type { Keygen } from "./keygen_pb.js";
 import type { Blame } from "./blame_pb.js";
 
+/**
+ * @generated from message zetachain.zetacore.observer.QueryLivenessParamsRequest
+ */
+export declare class QueryLivenessParamsRequest extends Message<QueryLivenessParamsRequest> {
+  constructor(data?: PartialMessage<QueryLivenessParamsRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.QueryLivenessParamsRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryLivenessParamsRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryLivenessParamsRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryLivenessParamsRequest;
+
+  static equals(a: QueryLivenessParamsRequest | PlainMessage<QueryLivenessParamsRequest> | undefined, b: QueryLivenessParamsRequest | PlainMessage<QueryLivenessParamsRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.observer.QueryLivenessParamsResponse
+ */
+export declare class QueryLivenessParamsResponse extends Message<QueryLivenessParamsResponse> {
+  /**
+   * @generated from field: zetachain.zetacore.observer.LivenessParams liveness_params = 1;
+   */
+  livenessParams?: LivenessParams;
+
+  constructor(data?: PartialMessage<QueryLivenessParamsResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.QueryLivenessParamsResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryLivenessParamsResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryLivenessParamsResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryLivenessParamsResponse;
+
+  static equals(a: QueryLivenessParamsResponse | PlainMessage<QueryLivenessParamsResponse> | undefined, b: QueryLivenessParamsResponse | PlainMessage<QueryLivenessParamsResponse> | undefined): boolean;
+}
+
+/**
+ * ObserverLivenessScore is the liveness of an observer with its score, the
+ * ratio of ballots voted in the window
+ *
+ * @generated from message zetachain.zetacore.observer.ObserverLivenessScore
+ */
+export declare class ObserverLivenessScore extends Message<ObserverLivenessScore> {
+  /**
+   * @generated from field: zetachain.zetacore.observer.ObserverLiveness liveness = 1;
+   */
+  liveness?: ObserverLiveness;
+
+  /**
+   * @generated from field: string score = 2;
+   */
+  score: string;
+
+  constructor(data?: PartialMessage<ObserverLivenessScore>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.ObserverLivenessScore";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ObserverLivenessScore;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ObserverLivenessScore;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ObserverLivenessScore;
+
+  static equals(a: ObserverLivenessScore | PlainMessage<ObserverLivenessScore> | undefined, b: ObserverLivenessScore | PlainMessage<ObserverLivenessScore> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.observer.QueryObserverLivenessRequest
+ */
+export declare class QueryObserverLivenessRequest extends Message<QueryObserverLivenessRequest> {
+  /**
+   * @generated from field: string observer_address = 1;
+   */
+  observerAddress: string;
+
+  constructor(data?: PartialMessage<QueryObserverLivenessRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.QueryObserverLivenessRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryObserverLivenessRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryObserverLivenessRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryObserverLivenessRequest;
+
+  static equals(a: QueryObserverLivenessRequest | PlainMessage<QueryObserverLivenessRequest> | undefined, b: QueryObserverLivenessRequest | PlainMessage<QueryObserverLivenessRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.observer.QueryObserverLivenessResponse
+ */
+export declare class QueryObserverLivenessResponse extends Message<QueryObserverLivenessResponse> {
+  /**
+   * @generated from field: zetachain.zetacore.observer.ObserverLivenessScore liveness = 1;
+   */
+  liveness?: ObserverLivenessScore;
+
+  constructor(data?: PartialMessage<QueryObserverLivenessResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.QueryObserverLivenessResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryObserverLivenessResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryObserverLivenessResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryObserverLivenessResponse;
+
+  static equals(a: QueryObserverLivenessResponse | PlainMessage<QueryObserverLivenessResponse> | undefined, b: QueryObserverLivenessResponse | PlainMessage<QueryObserverLivenessResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.observer.QueryAllObserverLivenessRequest
+ */
+export declare class QueryAllObserverLivenessRequest extends Message<QueryAllObserverLivenessRequest> {
+  /**
+   * @generated from field: cosmos.base.query.v1beta1.PageRequest pagination = 1;
+   */
+  pagination?: PageRequest;
+
+  constructor(data?: PartialMessage<QueryAllObserverLivenessRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.QueryAllObserverLivenessRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryAllObserverLivenessRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryAllObserverLivenessRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryAllObserverLivenessRequest;
+
+  static equals(a: QueryAllObserverLivenessRequest | PlainMessage<QueryAllObserverLivenessRequest> | undefined, b: QueryAllObserverLivenessRequest | PlainMessage<QueryAllObserverLivenessRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.observer.QueryAllObserverLivenessResponse
+ */
+export declare class QueryAllObserverLivenessResponse extends Message<QueryAllObserverLivenessResponse> {
+  /**
+   * @generated from field: repeated zetachain.zetacore.observer.ObserverLivenessScore liveness = 1;
+   */
+  liveness: ObserverLivenessScore[];
+
+  /**
+   * @generated from field: cosmos.base.query.v1beta1.PageResponse pagination = 2;
+   */
+  pagination?: PageResponse;
+
+  constructor(data?: PartialMessage<QueryAllObserverLivenessResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.QueryAllObserverLivenessResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryAllObserverLivenessResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryAllObserverLivenessResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryAllObserverLivenessResponse;
+
+  static equals(a: QueryAllObserverLivenessResponse | PlainMessage<QueryAllObserverLivenessResponse> | undefined, b: QueryAllObserverLivenessResponse | PlainMessage<QueryAllObserverLivenessResponse> | undefined): boolean;
+}
+
 /**
  * @generated from message zetachain.zetacore.observer.QueryTssFundsMigratorInfoAllRequest
  */
@@ -393,6 +570,11 @@ export declare class QueryGetTssAddressResponse extends Message<QueryGetTssAddre
    */
   btc: string;
 
+  /**
+   * @generated from field: string btc_taproot = 3;
+   */
+  btcTaproot: string;
+
   constructor(data?: PartialMessage<QueryGetTssAddressResponse>);
 
   static readonly runtime: typeof proto3;
@@ -451,6 +633,11 @@ export declare class QueryGetTssAddressByFinalizedHeightResponse extends Message
    */
   btc: string;
 
+  /**
+   * @generated from field: string btc_taproot = 3;
+   */
+  btcTaproot: string;
+
   constructor(data?: PartialMessage<QueryGetTssAddressByFinalizedHeightResponse>);
 
   static readonly runtime: typeof proto3;
@@ -610,6 +797,11 @@ export declare class VoterList extends Message<VoterList> {
    */
   voteType: VoteType;
 
+  /**
+   * @generated from field: string voting_power = 3;
+   */
+  votingPower: string;
+
   constructor(data?: PartialMessage<VoterList>);
 
   static readonly runtime: typeof proto3;
@@ -649,6 +841,11 @@ export declare class QueryBallotByIdentifierResponse extends Message<QueryBallot
    */
   ballotStatus: BallotStatus;
 
+  /**
+   * @generated from field: zetachain.zetacore.observer.BallotTally tally = 5;
+   */
+  tally?: BallotTally;
+
   constructor(data?: PartialMessage<QueryBallotByIdentifierResponse>);
 
   static readonly runtime: typeof proto3;
@@ -985,6 +1182,54 @@ export declare class QueryGetCrosschainFlagsResponse extends Message<QueryGetCro
   static equals(a: QueryGetCrosschainFlagsResponse | PlainMessage<QueryGetCrosschainFlagsResponse> | undefined, b: QueryGetCrosschainFlagsResponse | PlainMessage<QueryGetCrosschainFlagsResponse> | undefined): boolean;
 }
 
+/**
+ * @generated from message zetachain.zetacore.observer.QueryPausedCCTXRequest
+ */
+export declare class QueryPausedCCTXRequest extends Message<QueryPausedCCTXRequest> {
+  constructor(data?: PartialMessage<QueryPausedCCTXRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.QueryPausedCCTXRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryPausedCCTXRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryPausedCCTXRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryPausedCCTXRequest;
+
+  static equals(a: QueryPausedCCTXRequest | PlainMessage<QueryPausedCCTXRequest> | undefined, b: QueryPausedCCTXRequest | PlainMessage<QueryPausedCCTXRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.observer.QueryPausedCCTXResponse
+ */
+export declare class QueryPausedCCTXResponse extends Message<QueryPausedCCTXResponse> {
+  /**
+   * @generated from field: repeated zetachain.zetacore.observer.ChainPauseFlags paused_chains = 1;
+   */
+  pausedChains: ChainPauseFlags[];
+
+  /**
+   * @generated from field: repeated zetachain.zetacore.observer.ZRC20PauseFlags paused_zrc20_tokens = 2;
+   */
+  pausedZrc20Tokens: ZRC20PauseFlags[];
+
+  constructor(data?: PartialMessage<QueryPausedCCTXResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.QueryPausedCCTXResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryPausedCCTXResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryPausedCCTXResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryPausedCCTXResponse;
+
+  static equals(a: QueryPausedCCTXResponse | PlainMessage<QueryPausedCCTXResponse> | undefined, b: QueryPausedCCTXResponse | PlainMessage<QueryPausedCCTXResponse> | undefined): boolean;
+}
+
 /**
  * @generated from message zetachain.zetacore.observer.QueryGetKeygenRequest
  */
@@ -11,6 +11,7 @@ import type { ChainParams } from "./params_pb.js";
 import type { Blame } from "./blame_pb.js";
 import type { ReceiveStatus } from "../pkg/chains/chains_pb.js";
 import type { GasPriceIncreaseFlags } from "./crosschain_flags_pb.js";
+import type { LivenessParams } from "./liveness_pb.js";
 
 /**
  * @generated from message zetachain.zetacore.observer.MsgUpdateObserver
@@ -683,3 +684,225 @@ export declare class MsgUpdateGasPriceIncreaseFlagsResponse extends Message<MsgU
   static equals(a: MsgUpdateGasPriceIncreaseFlagsResponse | PlainMessage<MsgUpdateGasPriceIncreaseFlagsResponse> | undefined, b: MsgUpdateGasPriceIncreaseFlagsResponse | PlainMessage<MsgUpdateGasPriceIncreaseFlagsResponse> | undefined): boolean;
 }
 
+/**
+ * @generated from message zetachain.zetacore.observer.MsgPauseCCTX
+ */
+export declare class MsgPauseCCTX extends Message<MsgPauseCCTX> {
+  /**
+   * @generated from field: string creator = 1;
+   */
+  creator: string;
+
+  /**
+   * @generated from field: repeated int64 chain_ids = 2;
+   */
+  chainIds: bigint[];
+
+  /**
+   * @generated from field: repeated string zrc20_addresses = 3;
+   */
+  zrc20Addresses: string[];
+
+  /**
+   * @generated from field: bool pause_inbound = 4;
+   */
+  pauseInbound: boolean;
+
+  /**
+   * @generated from field: bool pause_outbound = 5;
+   */
+  pauseOutbound: boolean;
+
+  constructor(data?: PartialMessage<MsgPauseCCTX>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.MsgPauseCCTX";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MsgPauseCCTX;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MsgPauseCCTX;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MsgPauseCCTX;
+
+  static equals(a: MsgPauseCCTX | PlainMessage<MsgPauseCCTX> | undefined, b: MsgPauseCCTX | PlainMessage<MsgPauseCCTX> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.observer.MsgPauseCCTXResponse
+ */
+export declare class MsgPauseCCTXResponse extends Message<MsgPauseCCTXResponse> {
+  constructor(data?: PartialMessage<MsgPauseCCTXResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.MsgPauseCCTXResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MsgPauseCCTXResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MsgPauseCCTXResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MsgPauseCCTXResponse;
+
+  static equals(a: MsgPauseCCTXResponse | PlainMessage<MsgPauseCCTXResponse> | undefined, b: MsgPauseCCTXResponse | PlainMessage<MsgPauseCCTXResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.observer.MsgUnpauseCCTX
+ */
+export declare class MsgUnpauseCCTX extends Message<MsgUnpauseCCTX> {
+  /**
+   * @generated from field: string creator = 1;
+   */
+  creator: string;
+
+  /**
+   * @generated from field: repeated int64 chain_ids = 2;
+   */
+  chainIds: bigint[];
+
+  /**
+   * @generated from field: repeated string zrc20_addresses = 3;
+   */
+  zrc20Addresses: string[];
+
+  /**
+   * @generated from field: bool unpause_inbound = 4;
+   */
+  unpauseInbound: boolean;
+
+  /**
+   * @generated from field: bool unpause_outbound = 5;
+   */
+  unpauseOutbound: boolean;
+
+  constructor(data?: PartialMessage<MsgUnpauseCCTX>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.MsgUnpauseCCTX";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MsgUnpauseCCTX;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MsgUnpauseCCTX;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MsgUnpauseCCTX;
+
+  static equals(a: MsgUnpauseCCTX | PlainMessage<MsgUnpauseCCTX> | undefined, b: MsgUnpauseCCTX | PlainMessage<MsgUnpauseCCTX> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.observer.MsgUnpauseCCTXResponse
+ */
+export declare class MsgUnpauseCCTXResponse extends Message<MsgUnpauseCCTXResponse> {
+  constructor(data?: PartialMessage<MsgUnpauseCCTXResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.MsgUnpauseCCTXResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MsgUnpauseCCTXResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MsgUnpauseCCTXResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MsgUnpauseCCTXResponse;
+
+  static equals(a: MsgUnpauseCCTXResponse | PlainMessage<MsgUnpauseCCTXResponse> | undefined, b: MsgUnpauseCCTXResponse | PlainMessage<MsgUnpauseCCTXResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.observer.MsgUpdateLivenessParams
+ */
+export declare class MsgUpdateLivenessParams extends Message<MsgUpdateLivenessParams> {
+  /**
+   * @generated from field: string creator = 1;
+   */
+  creator: string;
+
+  /**
+   * @generated from field: zetachain.zetacore.observer.LivenessParams liveness_params = 2;
+   */
+  livenessParams?: LivenessParams;
+
+  constructor(data?: PartialMessage<MsgUpdateLivenessParams>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.MsgUpdateLivenessParams";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MsgUpdateLivenessParams;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MsgUpdateLivenessParams;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MsgUpdateLivenessParams;
+
+  static equals(a: MsgUpdateLivenessParams | PlainMessage<MsgUpdateLivenessParams> | undefined, b: MsgUpdateLivenessParams | PlainMessage<MsgUpdateLivenessParams> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.observer.MsgUpdateLivenessParamsResponse
+ */
+export declare class MsgUpdateLivenessParamsResponse extends Message<MsgUpdateLivenessParamsResponse> {
+  constructor(data?: PartialMessage<MsgUpdateLivenessParamsResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.MsgUpdateLivenessParamsResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MsgUpdateLivenessParamsResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MsgUpdateLivenessParamsResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MsgUpdateLivenessParamsResponse;
+
+  static equals(a: MsgUpdateLivenessParamsResponse | PlainMessage<MsgUpdateLivenessParamsResponse> | undefined, b: MsgUpdateLivenessParamsResponse | PlainMessage<MsgUpdateLivenessParamsResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.observer.MsgUnjailObserver
+ */
+export declare class MsgUnjailObserver extends Message<MsgUnjailObserver> {
+  /**
+   * @generated from field: string creator = 1;
+   */
+  creator: string;
+
+  /**
+   * @generated from field: string observer_address = 2;
+   */
+  observerAddress: string;
+
+  constructor(data?: PartialMessage<MsgUnjailObserver>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.MsgUnjailObserver";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MsgUnjailObserver;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MsgUnjailObserver;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MsgUnjailObserver;
+
+  static equals(a: MsgUnjailObserver | PlainMessage<MsgUnjailObserver> | undefined, b: MsgUnjailObserver | PlainMessage<MsgUnjailObserver> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.observer.MsgUnjailObserverResponse
+ */
+export declare class MsgUnjailObserverResponse extends Message<MsgUnjailObserverResponse> {
+  constructor(data?: PartialMessage<MsgUnjailObserverResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.MsgUnjailObserverResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MsgUnjailObserverResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MsgUnjailObserverResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MsgUnjailObserverResponse;
+
+  static equals(a: MsgUnjailObserverResponse | PlainMessage<MsgUnjailObserverResponse> | undefined, b: MsgUnjailObserverResponse | PlainMessage<MsgUnjailObserverResponse> | undefined): boolean;
+}
+
@@ -35,3 +35,35 @@ export declare class Proof extends Message<Proof> {
   static equals(a: Proof | PlainMessage<Proof> | undefined, b: Proof | PlainMessage<Proof> | undefined): boolean;
 }
 
+/**
+ * ReceiptProof proves the inclusion of a transaction and its receipt at the
+ * same index of a block, against the transactions and receipts roots
+ *
+ * @generated from message zetachain.zetacore.pkg.proofs.ethereum.ReceiptProof
+ */
+export declare class ReceiptProof extends Message<ReceiptProof> {
+  /**
+   * @generated from field: zetachain.zetacore.pkg.proofs.ethereum.Proof tx_proof = 1;
+   */
+  txProof?: Proof;
+
+  /**
+   * @generated from field: zetachain.zetacore.pkg.proofs.ethereum.Proof receipt_proof = 2;
+   */
+  receiptProof?: Proof;
+
+  constructor(data?: PartialMessage<ReceiptProof>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.pkg.proofs.ethereum.ReceiptProof";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ReceiptProof;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ReceiptProof;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ReceiptProof;
+
+  static equals(a: ReceiptProof | PlainMessage<ReceiptProof> | undefined, b: ReceiptProof | PlainMessage<ReceiptProof> | undefined): boolean;
+}
+
@@ -5,7 +5,7 @@
 
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
 import { Message, proto3 } from "@bufbuild/protobuf";
-import type { Proof as Proof$1 } from "./ethereum/ethereum_pb.js";
+import type { Proof as Proof$1, ReceiptProof } from "./ethereum/ethereum_pb.js";
 import type { Proof as Proof$2 } from "./bitcoin/bitcoin_pb.js";
 
 /**
@@ -113,6 +113,12 @@ export declare class Proof extends Message<Proof> {
      */
     value: Proof$2;
     case: "bitcoinProof";
+  } | {
+    /**
+     * @generated from field: zetachain.zetacore.pkg.proofs.ethereum.ReceiptProof ethereum_receipt_proof = 3;
+     */
+    value: ReceiptProof;
+    case: "ethereumReceiptProof";
   } | { case: undefined; value?: undefined };
 
   constructor(data?: PartialMessage<Proof>);
@@ -0,0 +1,62 @@
+package v3
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/zeta-chain/node/x/authority/types"
+)
+
+type authorityKeeper interface {
+	GetAuthorizationList(ctx sdk.Context) (val types.AuthorizationList, found bool)
+	SetAuthorizationList(ctx sdk.Context, list types.AuthorizationList)
+}
+
+// NewAuthorizations are the authorizations of the messages added after the consensus version 2
+var NewAuthorizations = []types.Authorization{
+	{
+		MsgUrl:           "/zetachain.zetacore.observer.MsgPauseCCTX",
+		AuthorizedPolicy: types.PolicyType_groupEmergency,
+	},
+	{
+		MsgUrl:           "/zetachain.zetacore.observer.MsgUnpauseCCTX",
+		AuthorizedPolicy: types.PolicyType_groupOperational,
+	},
+	{
+		MsgUrl:           "/zetachain.zetacore.observer.MsgUpdateLivenessParams",
+		AuthorizedPolicy: types.PolicyType_groupOperational,
+	},
+	{
+		MsgUrl:           "/zetachain.zetacore.observer.MsgUnjailObserver",
+		AuthorizedPolicy: types.PolicyType_groupOperational,
+	},
+	{
+		MsgUrl:           "/zetachain.zetacore.crosschain.MsgCancelDelayedCCTX",
+		AuthorizedPolicy: types.PolicyType_groupEmergency,
+	},
+	{
+		MsgUrl:           "/zetachain.zetacore.lightclient.MsgUpdateHeaderRetention",
+		AuthorizedPolicy: types.PolicyType_groupAdmin,
+	},
+}
+
+// MigrateStore migrates the authority module state from the consensus version 2 to 3
+// The authorizations of the new messages are added to the existing authorization list
+func MigrateStore(
+	ctx sdk.Context,
+	keeper authorityKeeper,
+) error {
+	list, found := keeper.GetAuthorizationList(ctx)
+	if !found {
+		return types.ErrAuthorizationListNotFound
+	}
+
+	for _, authorization := range NewAuthorizations {
+		list.SetAuthorization(authorization)
+	}
+	if err := list.Validate(); err != nil {
+		return err
+	}
+
+	keeper.SetAuthorizationList(ctx, list)
+	return nil
+}
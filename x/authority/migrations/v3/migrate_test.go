@@ -0,0 +1,50 @@
+package v3_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/zeta-chain/node/testutil/keeper"
+	v3 "github.com/zeta-chain/node/x/authority/migrations/v3"
+	"github.com/zeta-chain/node/x/authority/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	t.Run("add new authorizations to the authorization list", func(t *testing.T) {
+		k, ctx := keepertest.AuthorityKeeper(t)
+
+		// set the authorization list of the consensus version 2
+		list := types.DefaultAuthorizationsList()
+		for _, authorization := range v3.NewAuthorizations {
+			list.RemoveAuthorization(authorization.MsgUrl)
+		}
+		k.SetAuthorizationList(ctx, list)
+
+		err := v3.MigrateStore(ctx, *k)
+		require.NoError(t, err)
+
+		list, found := k.GetAuthorizationList(ctx)
+		require.True(t, found)
+		require.ElementsMatch(t, types.DefaultAuthorizationsList().Authorizations, list.Authorizations)
+	})
+
+	t.Run("keep authorizations already added", func(t *testing.T) {
+		k, ctx := keepertest.AuthorityKeeper(t)
+		k.SetAuthorizationList(ctx, types.DefaultAuthorizationsList())
+
+		err := v3.MigrateStore(ctx, *k)
+		require.NoError(t, err)
+
+		list, found := k.GetAuthorizationList(ctx)
+		require.True(t, found)
+		require.Equal(t, types.DefaultAuthorizationsList(), list)
+	})
+
+	t.Run("fail if authorization list is not found", func(t *testing.T) {
+		k, ctx := keepertest.AuthorityKeeper(t)
+
+		err := v3.MigrateStore(ctx, *k)
+		require.ErrorIs(t, err, types.ErrAuthorizationListNotFound)
+	})
+}
@@ -19,7 +19,10 @@ var (
 		"/zetachain.zetacore.observer.MsgResetChainNonces",
 		"/zetachain.zetacore.observer.MsgUpdateChainParams",
 		"/zetachain.zetacore.observer.MsgEnableCCTX",
+		"/zetachain.zetacore.observer.MsgUnpauseCCTX",
 		"/zetachain.zetacore.observer.MsgUpdateGasPriceIncreaseFlags",
+		"/zetachain.zetacore.observer.MsgUpdateLivenessParams",
+		"/zetachain.zetacore.observer.MsgUnjailObserver",
 	}
 	// AdminPolicyMessages keeps track of the message URLs that can, by default, only be executed by admin policy address
 	AdminPolicyMessages = []string{
@@ -41,15 +44,18 @@ var (
 		"/zetachain.zetacore.authority.MsgUpdateChainInfo",
 		"/zetachain.zetacore.authority.MsgRemoveChainInfo",
 		"/zetachain.zetacore.lightclient.MsgEnableHeaderVerification",
+		"/zetachain.zetacore.lightclient.MsgUpdateHeaderRetention",
 	}
 	// EmergencyPolicyMessages keeps track of the message URLs that can, by default, only be executed by emergency policy address
 	EmergencyPolicyMessages = []string{
 		"/zetachain.zetacore.crosschain.MsgAddInboundTracker",
 		"/zetachain.zetacore.crosschain.MsgAddOutboundTracker",
 		"/zetachain.zetacore.crosschain.MsgRemoveOutboundTracker",
+		"/zetachain.zetacore.crosschain.MsgCancelDelayedCCTX",
 		"/zetachain.zetacore.fungible.MsgPauseZRC20",
 		"/zetachain.zetacore.observer.MsgUpdateKeygen",
 		"/zetachain.zetacore.observer.MsgDisableCCTX",
+		"/zetachain.zetacore.observer.MsgPauseCCTX",
 		"/zetachain.zetacore.lightclient.MsgDisableHeaderVerification",
 	}
 )
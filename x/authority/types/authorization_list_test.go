@@ -398,7 +398,10 @@ func TestDefaultAuthorizationsList(t *testing.T) {
 			sdk.MsgTypeURL(&observertypes.MsgResetChainNonces{}),
 			sdk.MsgTypeURL(&observertypes.MsgUpdateChainParams{}),
 			sdk.MsgTypeURL(&observertypes.MsgEnableCCTX{}),
+			sdk.MsgTypeURL(&observertypes.MsgUnpauseCCTX{}),
 			sdk.MsgTypeURL(&observertypes.MsgUpdateGasPriceIncreaseFlags{}),
+			sdk.MsgTypeURL(&observertypes.MsgUpdateLivenessParams{}),
+			sdk.MsgTypeURL(&observertypes.MsgUnjailObserver{}),
 		}
 
 		// EmergencyPolicyMessageList is a list of messages that can be authorized by the emergency policy
@@ -406,9 +409,11 @@ func TestDefaultAuthorizationsList(t *testing.T) {
 			sdk.MsgTypeURL(&crosschaintypes.MsgAddInboundTracker{}),
 			sdk.MsgTypeURL(&crosschaintypes.MsgAddOutboundTracker{}),
 			sdk.MsgTypeURL(&crosschaintypes.MsgRemoveOutboundTracker{}),
+			sdk.MsgTypeURL(&crosschaintypes.MsgCancelDelayedCCTX{}),
 			sdk.MsgTypeURL(&fungibletypes.MsgPauseZRC20{}),
 			sdk.MsgTypeURL(&observertypes.MsgUpdateKeygen{}),
 			sdk.MsgTypeURL(&observertypes.MsgDisableCCTX{}),
+			sdk.MsgTypeURL(&observertypes.MsgPauseCCTX{}),
 			sdk.MsgTypeURL(&lightclienttypes.MsgDisableHeaderVerification{}),
 		}
 
@@ -432,6 +437,7 @@ func TestDefaultAuthorizationsList(t *testing.T) {
 			sdk.MsgTypeURL(&types.MsgUpdateChainInfo{}),
 			sdk.MsgTypeURL(&types.MsgRemoveChainInfo{}),
 			sdk.MsgTypeURL(&lightclienttypes.MsgEnableHeaderVerification{}),
+			sdk.MsgTypeURL(&lightclienttypes.MsgUpdateHeaderRetention{}),
 		}
 		defaultList := types.DefaultAuthorizationsList()
 		for _, msgUrl := range OperationalPolicyMessageList {
@@ -41,6 +41,7 @@ func GetQueryCmd(_ string) *cobra.Command {
 		CmdListPendingCCTXWithinRateLimit(),
 
 		CmdShowUpdateRateLimiterFlags(),
+		CmdShowRateLimiterQuotaUsage(),
 	)
 
 	return cmd
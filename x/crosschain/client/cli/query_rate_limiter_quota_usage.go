@@ -0,0 +1,37 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/zeta-chain/node/x/crosschain/types"
+)
+
+func CmdShowRateLimiterQuotaUsage() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-rate-limiter-quota-usage",
+		Short: "shows the usage of the chain, sender and asset quotas of the rate limiter",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.RateLimiterQuotaUsage(
+				context.Background(),
+				&types.QueryRateLimiterQuotaUsageRequest{},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
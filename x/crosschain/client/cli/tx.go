@@ -31,6 +31,8 @@ func GetTxCmd() *cobra.Command {
 		CmdWhitelistERC20(),
 		CmdAbortStuckCCTX(),
 		CmdRefundAborted(),
+		CmdCancelDelayedCCTX(),
+		CmdConsolidateUTXOs(),
 	)
 
 	return cmd
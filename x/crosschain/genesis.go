@@ -56,6 +56,11 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	}
 
 	k.SetRateLimiterFlags(ctx, genState.RateLimiterFlags)
+
+	// Set all the delayed cctxs
+	for _, elem := range genState.DelayedCctxList {
+		k.SetDelayedCctx(ctx, elem)
+	}
 }
 
 // ExportGenesis returns the crosschain module's exported genesis.
@@ -97,6 +102,7 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	if found {
 		genesis.RateLimiterFlags = rateLimiterFlags
 	}
+	genesis.DelayedCctxList = k.GetAllDelayedCctx(ctx)
 
 	return &genesis
 }
@@ -51,6 +51,11 @@ func TestGenesis(t *testing.T) {
 			sample.InboundHashToCctx(t, "0x2"),
 		},
 		RateLimiterFlags: sample.RateLimiterFlags(),
+		DelayedCctxList: []types.DelayedCctx{
+			sample.DelayedCctx(t, "0"),
+			sample.DelayedCctx(t, "1"),
+			sample.DelayedCctx(t, "2"),
+		},
 	}
 
 	// Init and export
@@ -9,6 +9,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	zetachains "github.com/zeta-chain/node/pkg/chains"
+	"github.com/zeta-chain/node/pkg/coin"
+	"github.com/zeta-chain/node/pkg/gas"
 	mathpkg "github.com/zeta-chain/node/pkg/math"
 	"github.com/zeta-chain/node/x/crosschain/types"
 	observertypes "github.com/zeta-chain/node/x/observer/types"
@@ -17,6 +19,10 @@ import (
 const (
 	// RemainingFeesToStabilityPoolPercent is the percentage of remaining fees used to fund the gas stability pool
 	RemainingFeesToStabilityPoolPercent = 95
+
+	// microLamportsPerLamport is the number of micro-lamports in a lamport
+	// the Solana priority fee is expressed in micro-lamports per compute unit
+	microLamportsPerLamport = 1_000_000
 )
 
 // CheckAndUpdateCctxGasPriceFunc is a function type for checking and updating the gas price of a cctx
@@ -27,12 +33,51 @@ type CheckAndUpdateCctxGasPriceFunc func(
 	flags observertypes.GasPriceIncreaseFlags,
 ) (math.Uint, math.Uint, error)
 
+// CheckAndUpdateCctxGasPriceFuncs contains the functions updating the gas price of a cctx for each chain family
+// A nil function disables the gas price increase for the chain family
+type CheckAndUpdateCctxGasPriceFuncs struct {
+	EVM     CheckAndUpdateCctxGasPriceFunc
+	Bitcoin CheckAndUpdateCctxGasPriceFunc
+	Solana  CheckAndUpdateCctxGasPriceFunc
+	TON     CheckAndUpdateCctxGasPriceFunc
+}
+
+// DefaultCheckAndUpdateCctxGasPriceFuncs are the gas price update functions used in the begin blocker
+// bitcoin outbounds are bumped by the signer through replace-by-fee (RBF) or child-pays-for-parent (CPFP)
+var DefaultCheckAndUpdateCctxGasPriceFuncs = CheckAndUpdateCctxGasPriceFuncs{
+	EVM:     CheckAndUpdateCctxGasPrice,
+	Bitcoin: CheckAndUpdateCctxGasPriceBitcoin,
+	Solana:  CheckAndUpdateCctxPriorityFeeSolana,
+	TON:     CheckAndUpdateCctxGasPriceTON,
+}
+
+// forChain returns the gas price update function for the given chain, nil if not supported
+func (f CheckAndUpdateCctxGasPriceFuncs) forChain(
+	chainID int64,
+	additionalChains []zetachains.Chain,
+) CheckAndUpdateCctxGasPriceFunc {
+	switch {
+	case zetachains.IsZetaChain(chainID, additionalChains):
+		return nil
+	case zetachains.IsEVMChain(chainID, additionalChains):
+		return f.EVM
+	case zetachains.IsBitcoinChain(chainID, additionalChains):
+		return f.Bitcoin
+	case zetachains.IsSolanaChain(chainID, additionalChains):
+		return f.Solana
+	case zetachains.IsTONChain(chainID, additionalChains):
+		return f.TON
+	default:
+		return nil
+	}
+}
+
 // IterateAndUpdateCctxGasPrice iterates through all cctx and updates the gas price if pending for too long
 // The function returns the number of cctxs updated and the gas price increase flags used
 func (k Keeper) IterateAndUpdateCctxGasPrice(
 	ctx sdk.Context,
 	chains []zetachains.Chain,
-	updateFunc CheckAndUpdateCctxGasPriceFunc,
+	updateFuncs CheckAndUpdateCctxGasPriceFuncs,
 ) (int, observertypes.GasPriceIncreaseFlags) {
 	// fetch the gas price increase flags or use default
 	gasPriceIncreaseFlags := observertypes.DefaultGasPriceIncreaseFlags
@@ -52,8 +97,8 @@ func (k Keeper) IterateAndUpdateCctxGasPrice(
 
 IterateChains:
 	for _, chain := range chains {
-		// support only external evm chains
-		if zetachains.IsEVMChain(chain.ChainId, additionalChains) && !zetachains.IsZetaChain(chain.ChainId, additionalChains) {
+		// each chain family has its own update function, chains without one are skipped
+		if updateFunc := updateFuncs.forChain(chain.ChainId, additionalChains); updateFunc != nil {
 			res, err := k.ListPendingCctx(sdk.UnwrapSDKContext(ctx), &types.QueryListPendingCctxRequest{
 				ChainId: chain.ChainId,
 				Limit:   gasPriceIncreaseFlags.MaxPendingCctxs,
@@ -175,3 +220,117 @@ func CheckAndUpdateCctxGasPrice(
 
 	return gasPriceIncrease, additionalFees, nil
 }
+
+// CheckAndUpdateCctxPriorityFeeSolana checks if the retry interval is reached and updates the priority fee if so
+// The Solana gas price is fixed, the signer sets the priority fee as the compute unit price of the outbound
+// The function returns the priority fee increase and the additional fees paid from the gas stability pool
+func CheckAndUpdateCctxPriorityFeeSolana(
+	ctx sdk.Context,
+	k Keeper,
+	cctx types.CrossChainTx,
+	flags observertypes.GasPriceIncreaseFlags,
+) (math.Uint, math.Uint, error) {
+	params := cctx.GetCurrentOutboundParam()
+
+	// skip if gas limit is not set
+	if params.CallOptions == nil || params.CallOptions.GasLimit == 0 {
+		return math.ZeroUint(), math.ZeroUint(), nil
+	}
+
+	// skip if retry interval is not reached
+	lastUpdated := time.Unix(cctx.CctxStatus.LastUpdateTimestamp, 0)
+	if ctx.BlockTime().Before(lastUpdated.Add(flags.RetryInterval)) {
+		return math.ZeroUint(), math.ZeroUint(), nil
+	}
+
+	// compute priority fee increase
+	chainID := params.ReceiverChainId
+	_, medianPriorityFee, isFound := k.GetMedianGasValues(ctx, chainID)
+	if !isFound {
+		return math.ZeroUint(), math.ZeroUint(), cosmoserrors.Wrap(
+			types.ErrUnableToGetGasPrice,
+			fmt.Sprintf("cannot get gas price for chain %d", chainID),
+		)
+	}
+	priorityFeeIncrease := medianPriorityFee.MulUint64(uint64(flags.GasPriceIncreasePercent)).QuoUint64(100)
+
+	// nothing to bump if the network has no priority fee
+	if priorityFeeIncrease.IsZero() {
+		return math.ZeroUint(), math.ZeroUint(), nil
+	}
+
+	// compute new priority fee, an empty priority fee is considered as zero
+	currentPriorityFee := uint64(0)
+	if params.GasPriorityFee != "" {
+		var err error
+		if currentPriorityFee, err = params.GetGasPriorityFeeUInt64(); err != nil {
+			return math.ZeroUint(), math.ZeroUint(), err
+		}
+	}
+	newPriorityFee := math.NewUint(currentPriorityFee).Add(priorityFeeIncrease)
+
+	// check limit -- use default limit if not set
+	gasPriceIncreaseMax := flags.GasPriceIncreaseMax
+	if gasPriceIncreaseMax == 0 {
+		gasPriceIncreaseMax = observertypes.DefaultGasPriceIncreaseFlags.GasPriceIncreaseMax
+	}
+	limit := medianPriorityFee.MulUint64(uint64(gasPriceIncreaseMax)).QuoUint64(100)
+	if newPriorityFee.GT(limit) {
+		return math.ZeroUint(), math.ZeroUint(), nil
+	}
+
+	// withdraw additional fees from the gas stability pool
+	// the priority fee is paid in micro-lamports per compute unit, the fees are rounded up to the next lamport
+	gasLimit := math.NewUint(params.CallOptions.GasLimit)
+	additionalFees := gasLimit.Mul(priorityFeeIncrease).
+		AddUint64(microLamportsPerLamport - 1).
+		QuoUint64(microLamportsPerLamport)
+	if err := k.fungibleKeeper.WithdrawFromGasStabilityPool(ctx, chainID, additionalFees.BigInt()); err != nil {
+		return math.ZeroUint(), math.ZeroUint(), cosmoserrors.Wrap(
+			types.ErrNotEnoughFunds,
+			fmt.Sprintf("cannot withdraw %s from gas stability pool, error: %s", additionalFees.String(), err.Error()),
+		)
+	}
+
+	// set new priority fee
+	params.GasPriorityFee = newPriorityFee.String()
+	k.SetCrossChainTx(ctx, cctx)
+
+	return priorityFeeIncrease, additionalFees, nil
+}
+
+// CheckAndUpdateCctxGasPriceTON checks if the retry interval is reached and updates the gas price if so
+// The gas price is used by the signer to compute the forward fee attached to jetton withdrawals and calls,
+// plain TON withdrawals are processed by the gateway with a fixed fee and are not updated
+// The function returns the gas price increase and the additional fees paid from the gas stability pool
+func CheckAndUpdateCctxGasPriceTON(
+	ctx sdk.Context,
+	k Keeper,
+	cctx types.CrossChainTx,
+	flags observertypes.GasPriceIncreaseFlags,
+) (math.Uint, math.Uint, error) {
+	if cctx.InboundParams == nil ||
+		(cctx.InboundParams.CoinType == coin.CoinType_Gas && !cctx.InboundParams.IsCrossChainCall) {
+		return math.ZeroUint(), math.ZeroUint(), nil
+	}
+
+	return CheckAndUpdateCctxGasPrice(ctx, k, cctx, flags)
+}
+
+// CheckAndUpdateCctxGasPriceBitcoin checks if the retry interval is reached and updates the gas price if so
+// The gas limit of Bitcoin outbounds is their size in vBytes, the additional fees are the size times the increase.
+// Cctxs with a gas limit larger than the maximum outbound size don't carry a size estimate (e.g. TSS migration)
+// and are not updated
+func CheckAndUpdateCctxGasPriceBitcoin(
+	ctx sdk.Context,
+	k Keeper,
+	cctx types.CrossChainTx,
+	flags observertypes.GasPriceIncreaseFlags,
+) (math.Uint, math.Uint, error) {
+	params := cctx.GetCurrentOutboundParam()
+	if params.CallOptions == nil || params.CallOptions.GasLimit > gas.BTCOutboundBytesMax {
+		return math.ZeroUint(), math.ZeroUint(), nil
+	}
+
+	return CheckAndUpdateCctxGasPrice(ctx, k, cctx, flags)
+}
@@ -6,10 +6,12 @@ import (
 	"time"
 
 	"cosmossdk.io/math"
+	"github.com/btcsuite/btcd/chaincfg"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
 	"github.com/zeta-chain/node/pkg/chains"
+	"github.com/zeta-chain/node/pkg/coin"
 	testkeeper "github.com/zeta-chain/node/testutil/keeper"
 	"github.com/zeta-chain/node/testutil/sample"
 	"github.com/zeta-chain/node/x/crosschain/keeper"
@@ -40,12 +42,20 @@ func TestKeeper_IterateAndUpdateCctxGasPrice(t *testing.T) {
 		updateFuncMap[cctx.Index] = struct{}{}
 		return math.NewUint(10), math.NewUint(10), nil
 	}
+	updateFuncs := keeper.CheckAndUpdateCctxGasPriceFuncs{
+		EVM:     updateFunc,
+		Bitcoin: updateFunc,
+		Solana:  updateFunc,
+		TON:     updateFunc,
+	}
 
-	// add some evm and non-evm chains
+	// add some evm, bitcoin, solana, ton and zeta chains
 	supportedChains := []chains.Chain{
 		{ChainId: chains.Ethereum.ChainId},
 		{ChainId: chains.BitcoinMainnet.ChainId},
 		{ChainId: chains.BscMainnet.ChainId},
+		{ChainId: chains.SolanaMainnet.ChainId},
+		{ChainId: chains.TONMainnet.ChainId},
 		{ChainId: chains.ZetaChainMainnet.ChainId},
 	}
 
@@ -56,6 +66,8 @@ func TestKeeper_IterateAndUpdateCctxGasPrice(t *testing.T) {
 	createCctxWithNonceRange(t, ctx, *k, 20, 25, chains.BitcoinMainnet.ChainId, tss, zk)
 	createCctxWithNonceRange(t, ctx, *k, 30, 35, chains.BscMainnet.ChainId, tss, zk)
 	createCctxWithNonceRange(t, ctx, *k, 40, 45, chains.ZetaChainMainnet.ChainId, tss, zk)
+	createCctxWithNonceRange(t, ctx, *k, 50, 52, chains.SolanaMainnet.ChainId, tss, zk)
+	createCctxWithNonceRange(t, ctx, *k, 60, 62, chains.TONMainnet.ChainId, tss, zk)
 
 	// set a cctx where the update function should fail to test that the next cctx are not updated but the next chains are
 	failMap[sample.GetCctxIndexFromString("1-12")] = struct{}{}
@@ -63,7 +75,7 @@ func TestKeeper_IterateAndUpdateCctxGasPrice(t *testing.T) {
 	// test that the default crosschain flags are used when not set and the epoch length is not reached
 	ctx = ctx.WithBlockHeight(observertypes.DefaultCrosschainFlags().GasPriceIncreaseFlags.EpochLength + 1)
 
-	cctxCount, flags := k.IterateAndUpdateCctxGasPrice(ctx, supportedChains, updateFunc)
+	cctxCount, flags := k.IterateAndUpdateCctxGasPrice(ctx, supportedChains, updateFuncs)
 	require.Equal(t, 0, cctxCount)
 	require.Equal(t, *observertypes.DefaultCrosschainFlags().GasPriceIncreaseFlags, flags)
 
@@ -79,28 +91,49 @@ func TestKeeper_IterateAndUpdateCctxGasPrice(t *testing.T) {
 	crosschainFlags.GasPriceIncreaseFlags = &customFlags
 	zk.ObserverKeeper.SetCrosschainFlags(ctx, *crosschainFlags)
 
-	cctxCount, flags = k.IterateAndUpdateCctxGasPrice(ctx, supportedChains, updateFunc)
+	cctxCount, flags = k.IterateAndUpdateCctxGasPrice(ctx, supportedChains, updateFuncs)
 	require.Equal(t, 0, cctxCount)
 	require.Equal(t, customFlags, flags)
 
 	// test that cctx are iterated and updated when the epoch length is reached
 	ctx = ctx.WithBlockHeight(observertypes.DefaultCrosschainFlags().GasPriceIncreaseFlags.EpochLength * 2)
-	cctxCount, flags = k.IterateAndUpdateCctxGasPrice(ctx, supportedChains, updateFunc)
+	cctxCount, flags = k.IterateAndUpdateCctxGasPrice(ctx, supportedChains, updateFuncs)
 
-	// 2 eth + 5 bsc = 7
-	require.Equal(t, 7, cctxCount)
+	// 2 eth + 5 btc + 5 bsc + 2 sol + 2 ton = 16
+	require.Equal(t, 16, cctxCount)
 	require.Equal(t, customFlags, flags)
 
 	// check that the update function was called with the cctx index
-	require.Equal(t, 7, len(updateFuncMap))
+	require.Equal(t, 16, len(updateFuncMap))
 	require.Contains(t, updateFuncMap, sample.GetCctxIndexFromString("1-10"))
 	require.Contains(t, updateFuncMap, sample.GetCctxIndexFromString("1-11"))
 
+	require.Contains(t, updateFuncMap, sample.GetCctxIndexFromString("8332-20"))
+	require.Contains(t, updateFuncMap, sample.GetCctxIndexFromString("8332-21"))
+	require.Contains(t, updateFuncMap, sample.GetCctxIndexFromString("8332-22"))
+	require.Contains(t, updateFuncMap, sample.GetCctxIndexFromString("8332-23"))
+	require.Contains(t, updateFuncMap, sample.GetCctxIndexFromString("8332-24"))
+
 	require.Contains(t, updateFuncMap, sample.GetCctxIndexFromString("56-30"))
 	require.Contains(t, updateFuncMap, sample.GetCctxIndexFromString("56-31"))
 	require.Contains(t, updateFuncMap, sample.GetCctxIndexFromString("56-32"))
 	require.Contains(t, updateFuncMap, sample.GetCctxIndexFromString("56-33"))
 	require.Contains(t, updateFuncMap, sample.GetCctxIndexFromString("56-34"))
+
+	require.Contains(t, updateFuncMap, sample.GetCctxIndexFromString("900-50"))
+	require.Contains(t, updateFuncMap, sample.GetCctxIndexFromString("900-51"))
+
+	require.Contains(t, updateFuncMap, sample.GetCctxIndexFromString("2015140-60"))
+	require.Contains(t, updateFuncMap, sample.GetCctxIndexFromString("2015140-61"))
+
+	// chain families without update function are skipped
+	updateFuncMap = make(map[string]struct{})
+	cctxCount, _ = k.IterateAndUpdateCctxGasPrice(ctx, supportedChains, keeper.CheckAndUpdateCctxGasPriceFuncs{
+		Solana: updateFunc,
+	})
+	require.Equal(t, 2, cctxCount)
+	require.Contains(t, updateFuncMap, sample.GetCctxIndexFromString("900-50"))
+	require.Contains(t, updateFuncMap, sample.GetCctxIndexFromString("900-51"))
 }
 
 func TestCheckAndUpdateCctxGasPrice(t *testing.T) {
@@ -449,3 +482,367 @@ func TestCheckAndUpdateCctxGasPrice(t *testing.T) {
 		})
 	}
 }
+
+func TestCheckAndUpdateCctxPriorityFeeSolana(t *testing.T) {
+	sampleTimestamp := time.Now()
+	retryIntervalReached := sampleTimestamp.Add(observertypes.DefaultGasPriceIncreaseFlags.RetryInterval + time.Second)
+	retryIntervalNotReached := sampleTimestamp.Add(
+		observertypes.DefaultGasPriceIncreaseFlags.RetryInterval - time.Second,
+	)
+	chainID := chains.SolanaMainnet.ChainId
+
+	newCctx := func(priorityFee string, gasLimit uint64) types.CrossChainTx {
+		return types.CrossChainTx{
+			Index: sample.GetCctxIndexFromString("900-1"),
+			CctxStatus: &types.Status{
+				CreatedTimestamp:    sampleTimestamp.Unix(),
+				LastUpdateTimestamp: sampleTimestamp.Unix(),
+			},
+			OutboundParams: []*types.OutboundParams{
+				{
+					ReceiverChainId: chainID,
+					CallOptions: &types.CallOptions{
+						GasLimit: gasLimit,
+					},
+					GasPrice:       "1",
+					GasPriorityFee: priorityFee,
+				},
+			},
+		}
+	}
+
+	tt := []struct {
+		name                                   string
+		cctx                                   types.CrossChainTx
+		flags                                  observertypes.GasPriceIncreaseFlags
+		blockTimestamp                         time.Time
+		medianPriorityFee                      uint64
+		withdrawFromGasStabilityPoolReturn     error
+		expectWithdrawFromGasStabilityPoolCall bool
+		expectedPriorityFeeIncrease            math.Uint
+		expectedAdditionalFees                 math.Uint
+		expectedPriorityFee                    string
+		isError                                bool
+	}{
+		{
+			name:                                   "can update priority fee when retry interval is reached",
+			cctx:                                   newCctx("20000", 100000),
+			flags:                                  observertypes.DefaultGasPriceIncreaseFlags,
+			blockTimestamp:                         retryIntervalReached,
+			medianPriorityFee:                      20000,
+			expectWithdrawFromGasStabilityPoolCall: true,
+			expectedPriorityFeeIncrease:            math.NewUint(20000), // 100% medianPriorityFee
+			expectedAdditionalFees:                 math.NewUint(2000),  // gasLimit * increase / 1e6
+			expectedPriorityFee:                    "40000",
+		},
+		{
+			name:                                   "additional fees are rounded up to the next lamport",
+			cctx:                                   newCctx("", 100001),
+			flags:                                  observertypes.DefaultGasPriceIncreaseFlags,
+			blockTimestamp:                         retryIntervalReached,
+			medianPriorityFee:                      10,
+			expectWithdrawFromGasStabilityPoolCall: true,
+			expectedPriorityFeeIncrease:            math.NewUint(10),
+			expectedAdditionalFees:                 math.NewUint(2), // 1000010 micro-lamports
+			expectedPriorityFee:                    "10",
+		},
+		{
+			name: "skip if max limit reached",
+			cctx: newCctx("20000", 100000),
+			flags: observertypes.GasPriceIncreaseFlags{
+				EpochLength:             100,
+				RetryInterval:           time.Minute * 10,
+				GasPriceIncreasePercent: 200, // Increase priority fee to 20000+20000*2 = 60000
+				GasPriceIncreaseMax:     250, // Max priority fee is 20000*2.5 = 50000
+			},
+			blockTimestamp:              retryIntervalReached,
+			medianPriorityFee:           20000,
+			expectedPriorityFeeIncrease: math.NewUint(0),
+			expectedAdditionalFees:      math.NewUint(0),
+		},
+		{
+			name:                        "skip if median priority fee is zero",
+			cctx:                        newCctx("0", 100000),
+			flags:                       observertypes.DefaultGasPriceIncreaseFlags,
+			blockTimestamp:              retryIntervalReached,
+			medianPriorityFee:           0,
+			expectedPriorityFeeIncrease: math.NewUint(0),
+			expectedAdditionalFees:      math.NewUint(0),
+		},
+		{
+			name:                        "skip if gas limit is not set",
+			cctx:                        newCctx("20000", 0),
+			flags:                       observertypes.DefaultGasPriceIncreaseFlags,
+			blockTimestamp:              retryIntervalReached,
+			medianPriorityFee:           20000,
+			expectedPriorityFeeIncrease: math.NewUint(0),
+			expectedAdditionalFees:      math.NewUint(0),
+		},
+		{
+			name:                        "skip if retry interval is not reached",
+			cctx:                        newCctx("20000", 100000),
+			flags:                       observertypes.DefaultGasPriceIncreaseFlags,
+			blockTimestamp:              retryIntervalNotReached,
+			medianPriorityFee:           20000,
+			expectedPriorityFeeIncrease: math.NewUint(0),
+			expectedAdditionalFees:      math.NewUint(0),
+		},
+		{
+			name:                                   "returns error if can't withdraw from gas stability pool",
+			cctx:                                   newCctx("20000", 100000),
+			flags:                                  observertypes.DefaultGasPriceIncreaseFlags,
+			blockTimestamp:                         retryIntervalReached,
+			medianPriorityFee:                      20000,
+			expectWithdrawFromGasStabilityPoolCall: true,
+			expectedAdditionalFees:                 math.NewUint(2000),
+			withdrawFromGasStabilityPoolReturn:     errors.New("withdraw error"),
+			isError:                                true,
+		},
+	}
+	for _, tc := range tt {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			k, ctx := testkeeper.CrosschainKeeperAllMocks(t)
+			fungibleMock := testkeeper.GetCrosschainFungibleMock(t, k)
+
+			k.SetGasPrice(ctx, types.GasPrice{
+				ChainId:      chainID,
+				Prices:       []uint64{1},
+				PriorityFees: []uint64{tc.medianPriorityFee},
+				MedianIndex:  0,
+			})
+			ctx = ctx.WithBlockTime(tc.blockTimestamp)
+
+			if tc.expectWithdrawFromGasStabilityPoolCall {
+				fungibleMock.On(
+					"WithdrawFromGasStabilityPool", ctx, chainID, tc.expectedAdditionalFees.BigInt(),
+				).Return(tc.withdrawFromGasStabilityPoolReturn)
+			}
+
+			priorityFeeIncrease, feesPaid, err := keeper.CheckAndUpdateCctxPriorityFeeSolana(ctx, *k, tc.cctx, tc.flags)
+			if tc.isError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, priorityFeeIncrease.Equal(tc.expectedPriorityFeeIncrease), priorityFeeIncrease.String())
+			require.True(t, feesPaid.Equal(tc.expectedAdditionalFees), feesPaid.String())
+
+			// check cctx, the gas price is unchanged
+			cctx, found := k.GetCrossChainTx(ctx, tc.cctx.Index)
+			if tc.expectedPriorityFeeIncrease.IsZero() {
+				require.False(t, found)
+				return
+			}
+			require.True(t, found)
+			require.Equal(t, tc.expectedPriorityFee, cctx.GetCurrentOutboundParam().GasPriorityFee)
+			require.Equal(t, "1", cctx.GetCurrentOutboundParam().GasPrice)
+		})
+	}
+}
+
+func TestCheckAndUpdateCctxGasPriceTON(t *testing.T) {
+	sampleTimestamp := time.Now()
+	chainID := chains.TONMainnet.ChainId
+
+	newCctx := func(coinType coin.CoinType, isCall bool) types.CrossChainTx {
+		return types.CrossChainTx{
+			Index: sample.GetCctxIndexFromString("2015140-1"),
+			CctxStatus: &types.Status{
+				CreatedTimestamp:    sampleTimestamp.Unix(),
+				LastUpdateTimestamp: sampleTimestamp.Unix(),
+			},
+			InboundParams: &types.InboundParams{
+				CoinType:         coinType,
+				IsCrossChainCall: isCall,
+			},
+			OutboundParams: []*types.OutboundParams{
+				{
+					ReceiverChainId: chainID,
+					CallOptions: &types.CallOptions{
+						GasLimit: 100000,
+					},
+					GasPrice: "400",
+				},
+			},
+		}
+	}
+
+	for _, tc := range []struct {
+		name           string
+		cctx           types.CrossChainTx
+		expectIncrease bool
+	}{
+		{
+			name: "skip gas withdrawal",
+			cctx: newCctx(coin.CoinType_Gas, false),
+		},
+		{
+			name:           "update gas withdrawal and call",
+			cctx:           newCctx(coin.CoinType_Gas, true),
+			expectIncrease: true,
+		},
+		{
+			name:           "update no asset call",
+			cctx:           newCctx(coin.CoinType_NoAssetCall, false),
+			expectIncrease: true,
+		},
+		{
+			name:           "update jetton withdrawal",
+			cctx:           newCctx(coin.CoinType_ERC20, false),
+			expectIncrease: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			k, ctx := testkeeper.CrosschainKeeperAllMocks(t)
+			fungibleMock := testkeeper.GetCrosschainFungibleMock(t, k)
+
+			k.SetGasPrice(ctx, types.GasPrice{
+				ChainId:      chainID,
+				Prices:       []uint64{400},
+				PriorityFees: []uint64{0},
+				MedianIndex:  0,
+			})
+			ctx = ctx.WithBlockTime(
+				sampleTimestamp.Add(observertypes.DefaultGasPriceIncreaseFlags.RetryInterval + time.Second),
+			)
+
+			if tc.expectIncrease {
+				fungibleMock.On(
+					"WithdrawFromGasStabilityPool", ctx, chainID, math.NewUint(40_000_000).BigInt(),
+				).Return(nil)
+			}
+
+			gasPriceIncrease, feesPaid, err := keeper.CheckAndUpdateCctxGasPriceTON(
+				ctx,
+				*k,
+				tc.cctx,
+				observertypes.DefaultGasPriceIncreaseFlags,
+			)
+			require.NoError(t, err)
+
+			if !tc.expectIncrease {
+				require.True(t, gasPriceIncrease.IsZero())
+				require.True(t, feesPaid.IsZero())
+				return
+			}
+
+			// 100% median gas price, gasLimit * increase
+			require.True(t, gasPriceIncrease.Equal(math.NewUint(400)), gasPriceIncrease.String())
+			require.True(t, feesPaid.Equal(math.NewUint(40_000_000)), feesPaid.String())
+
+			cctx, found := k.GetCrossChainTx(ctx, tc.cctx.Index)
+			require.True(t, found)
+			require.Equal(t, "800", cctx.GetCurrentOutboundParam().GasPrice)
+		})
+	}
+}
+
+func TestCheckAndUpdateCctxGasPriceBitcoin(t *testing.T) {
+	sampleTimestamp := time.Now()
+	chainID := chains.BitcoinMainnet.ChainId
+
+	withdrawal := types.CrossChainTx{
+		Index: sample.GetCctxIndexFromString("btc-withdrawal"),
+		CctxStatus: &types.Status{
+			CreatedTimestamp:    sampleTimestamp.Unix(),
+			LastUpdateTimestamp: sampleTimestamp.Unix(),
+		},
+		OutboundParams: []*types.OutboundParams{
+			{
+				ReceiverChainId: chainID,
+				CallOptions: &types.CallOptions{
+					GasLimit: 254,
+				},
+				GasPrice: "10",
+			},
+		},
+	}
+
+	consolidation := types.ConsolidateUTXOsCmdCCTX(
+		sample.AccAddress(),
+		sample.BtcAddressP2WPKH(t, &chaincfg.MainNetParams),
+		chainID,
+		"10",
+		"0",
+		sample.PubKeyString(),
+		1,
+	)
+
+	migration := withdrawal
+	migration.Index = sample.GetCctxIndexFromString("btc-migration")
+	migration.OutboundParams = []*types.OutboundParams{
+		{
+			ReceiverChainId: chainID,
+			CallOptions: &types.CallOptions{
+				GasLimit: 1_000_000,
+			},
+			GasPrice: "10",
+		},
+	}
+
+	for _, tc := range []struct {
+		name         string
+		cctx         types.CrossChainTx
+		expectedFees math.Uint
+	}{
+		{
+			name:         "update withdrawal",
+			cctx:         withdrawal,
+			expectedFees: math.NewUint(2540),
+		},
+		{
+			name:         "update consolidation with its maximum size",
+			cctx:         consolidation,
+			expectedFees: math.NewUint(15430),
+		},
+		{
+			name:         "skip cctx without size estimate",
+			cctx:         migration,
+			expectedFees: math.ZeroUint(),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			k, ctx := testkeeper.CrosschainKeeperAllMocks(t)
+			fungibleMock := testkeeper.GetCrosschainFungibleMock(t, k)
+
+			k.SetGasPrice(ctx, types.GasPrice{
+				ChainId:      chainID,
+				Prices:       []uint64{10},
+				PriorityFees: []uint64{0},
+				MedianIndex:  0,
+			})
+			ctx = ctx.WithBlockTime(
+				sampleTimestamp.Add(observertypes.DefaultGasPriceIncreaseFlags.RetryInterval + time.Second),
+			)
+
+			if !tc.expectedFees.IsZero() {
+				fungibleMock.On(
+					"WithdrawFromGasStabilityPool", ctx, chainID, tc.expectedFees.BigInt(),
+				).Return(nil)
+			}
+
+			gasPriceIncrease, feesPaid, err := keeper.CheckAndUpdateCctxGasPriceBitcoin(
+				ctx,
+				*k,
+				tc.cctx,
+				observertypes.DefaultGasPriceIncreaseFlags,
+			)
+			require.NoError(t, err)
+			require.True(t, feesPaid.Equal(tc.expectedFees), feesPaid.String())
+			fungibleMock.AssertExpectations(t)
+
+			if tc.expectedFees.IsZero() {
+				require.True(t, gasPriceIncrease.IsZero())
+				return
+			}
+
+			// 100% median gas price, size in vBytes * increase
+			require.True(t, gasPriceIncrease.Equal(math.NewUint(10)), gasPriceIncrease.String())
+
+			cctx, found := k.GetCrossChainTx(ctx, tc.cctx.Index)
+			require.True(t, found)
+			require.Equal(t, "20", cctx.GetCurrentOutboundParam().GasPrice)
+		})
+	}
+}
@@ -26,6 +26,9 @@ InitiateOutbound updates the store so observers can use the PendingCCTX query:
 
   - If preprocessing of outbound is successful, the CCTX status is changed to PendingOutbound.
 
+  - If the outbound amount is above the delay threshold of the asset, the CCTX status is changed to PendingDelay
+    and the outbound is scheduled once the delay is over.
+
   - if preprocessing of outbound, such as paying the gas fee for the destination fails, the state is reverted to aborted
 
     We do not return an error from this function, as all changes need to be persisted to the state.
@@ -41,6 +44,7 @@ func (c CCTXGatewayObservers) InitiateOutbound(
 	outboundReceiverChainID := config.CCTX.GetCurrentOutboundParam().ReceiverChainId
 	// TODO (https://github.com/zeta-chain/node/issues/1010): workaround for this bug
 	noEthereumTxEvent := false
+	delayed := false
 	if chains.IsZetaChain(
 		config.CCTX.InboundParams.SenderChainId,
 		c.crosschainKeeper.GetAuthorityKeeper().GetAdditionalChainList(ctx),
@@ -71,6 +75,13 @@ func (c CCTXGatewayObservers) InitiateOutbound(
 			config.CCTX.GetCurrentOutboundParam().GasPriorityFee = priorityFee.String()
 			config.CCTX.GetCurrentOutboundParam().Amount = config.CCTX.InboundParams.Amount
 		}
+
+		// large outbounds are held back, the outbound info is set once the delay is over
+		if delayBlocks, ok := c.crosschainKeeper.GetOutboundDelay(tmpCtx, *config.CCTX); ok {
+			c.crosschainKeeper.DelayOutbound(tmpCtx, config.CCTX, delayBlocks)
+			delayed = true
+			return nil
+		}
 		return c.crosschainKeeper.SetObserverOutboundInfo(tmpCtx, outboundReceiverChainID, config.CCTX)
 	}()
 	if err != nil {
@@ -79,5 +90,8 @@ func (c CCTXGatewayObservers) InitiateOutbound(
 		return types.CctxStatus_Aborted, err
 	}
 	commit()
+	if delayed {
+		return types.CctxStatus_PendingDelay, nil
+	}
 	return types.CctxStatus_PendingOutbound, nil
 }
@@ -4,6 +4,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/zeta-chain/node/pkg/chains"
+	"github.com/zeta-chain/node/pkg/coin"
 	"github.com/zeta-chain/node/pkg/crypto"
 	"github.com/zeta-chain/node/x/crosschain/types"
 	observertypes "github.com/zeta-chain/node/x/observer/types"
@@ -31,6 +32,12 @@ func (k Keeper) ValidateInbound(
 		return nil, observertypes.ErrInboundDisabled
 	}
 
+	// Do not process if the inbound of the sender chain or of the zrc20 token deposited is paused
+	err = k.CheckIfInboundPaused(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
 	// create a new CCTX from the inbound message. The status of the new CCTX is set to PendingInbound.
 	cctx, err := types.NewCCTX(ctx, *msg, tss.TssPubkey)
 	if err != nil {
@@ -47,6 +54,14 @@ func (k Keeper) ValidateInbound(
 		return nil, err
 	}
 
+	// charge the withdrawal to the sender quota of the rate limiter, the withdrawals above the quota are rejected
+	if cctx.CctxStatus.Status == types.CctxStatus_PendingOutbound ||
+		cctx.CctxStatus.Status == types.CctxStatus_PendingDelay {
+		if err := k.ChargeSenderRateLimit(ctx, &cctx); err != nil {
+			return nil, err
+		}
+	}
+
 	inCctxIndex, ok := ctx.Value(InCCTXIndexKey).(string)
 	if ok {
 		cctx.InboundParams.ObservedHash = inCctxIndex
@@ -56,6 +71,116 @@ func (k Keeper) ValidateInbound(
 	return &cctx, nil
 }
 
+// CheckIfInboundPaused returns an error if the inbound of the sender chain or the inbound of the zrc20 token deposited
+// is paused. The outbound pauses are not checked: the inbound is final on the sender chain and won't be voted again,
+// the cctx is created and its outbound is held back until the receiver chain or the zrc20 token is unpaused.
+func (k Keeper) CheckIfInboundPaused(ctx sdk.Context, msg *types.MsgVoteInbound) error {
+	flags, found := k.zetaObserverKeeper.GetCrosschainFlags(ctx)
+	if !found {
+		return nil
+	}
+
+	if flags.IsChainInboundPaused(msg.SenderChainId) {
+		return observertypes.ErrInboundDisabled.Wrapf("inbound from chain %d is paused", msg.SenderChainId)
+	}
+
+	// no need to resolve the zrc20 token if none of them is paused
+	if len(flags.PausedZRC20Tokens) == 0 {
+		return nil
+	}
+
+	if zrc20, found := k.getZRC20FromAsset(ctx, msg.CoinType, msg.Asset, msg.SenderChainId); found &&
+		flags.IsZRC20InboundPaused(zrc20) {
+		return observertypes.ErrInboundDisabled.Wrapf("inbound of zrc20 %s is paused", zrc20)
+	}
+
+	return nil
+}
+
+// holdPausedOutbounds removes from the pending cctxs to schedule the ones to a chain whose outbound is paused or
+// transferring a zrc20 token whose outbound is paused. The cctxs are held back until the chain or the token is
+// unpaused, the following cctxs of the same chain are held back too as the outbounds of a chain are signed in nonce
+// order.
+func (k Keeper) holdPausedOutbounds(ctx sdk.Context, cctxs []*types.CrossChainTx) []*types.CrossChainTx {
+	return holdCctxsFromNonces(cctxs, k.getPausedOutboundNonces(ctx, cctxs))
+}
+
+// getPausedOutboundNonces returns the lowest nonce of each chain among the given cctxs to a chain whose outbound is
+// paused or transferring a zrc20 token whose outbound is paused
+func (k Keeper) getPausedOutboundNonces(ctx sdk.Context, cctxLists ...[]*types.CrossChainTx) map[int64]uint64 {
+	pausedNonces := make(map[int64]uint64)
+	flags, found := k.zetaObserverKeeper.GetCrosschainFlags(ctx)
+	if !found || (len(flags.PausedChains) == 0 && len(flags.PausedZRC20Tokens) == 0) {
+		return pausedNonces
+	}
+
+	for _, cctxs := range cctxLists {
+		for _, cctx := range cctxs {
+			params := cctx.GetCurrentOutboundParam()
+			if !flags.IsChainOutboundPaused(params.ReceiverChainId) && !k.isZRC20OutboundPaused(ctx, flags, cctx) {
+				continue
+			}
+			if nonce, paused := pausedNonces[params.ReceiverChainId]; !paused || params.TssNonce < nonce {
+				pausedNonces[params.ReceiverChainId] = params.TssNonce
+			}
+		}
+	}
+	return pausedNonces
+}
+
+// isZRC20OutboundPaused returns true if the outbound of the zrc20 token transferred by the cctx is paused
+func (k Keeper) isZRC20OutboundPaused(
+	ctx sdk.Context,
+	flags observertypes.CrosschainFlags,
+	cctx *types.CrossChainTx,
+) bool {
+	if len(flags.PausedZRC20Tokens) == 0 {
+		return false
+	}
+	zrc20, found := k.getZRC20FromAsset(
+		ctx,
+		cctx.InboundParams.CoinType,
+		cctx.InboundParams.Asset,
+		cctx.GetCurrentOutboundParam().ReceiverChainId,
+	)
+	return found && flags.IsZRC20OutboundPaused(zrc20)
+}
+
+// holdCctxsFromNonces removes the cctxs whose nonce is greater than or equal to the held nonce of their chain
+func holdCctxsFromNonces(cctxs []*types.CrossChainTx, heldNonces map[int64]uint64) []*types.CrossChainTx {
+	if len(heldNonces) == 0 {
+		return cctxs
+	}
+	scheduled := make([]*types.CrossChainTx, 0, len(cctxs))
+	for _, cctx := range cctxs {
+		params := cctx.GetCurrentOutboundParam()
+		if nonce, held := heldNonces[params.ReceiverChainId]; held && params.TssNonce >= nonce {
+			continue
+		}
+		scheduled = append(scheduled, cctx)
+	}
+	return scheduled
+}
+
+// getZRC20FromAsset returns the zrc20 address representing the asset of the given coin type on the chain
+func (k Keeper) getZRC20FromAsset(
+	ctx sdk.Context,
+	coinType coin.CoinType,
+	asset string,
+	chainID int64,
+) (string, bool) {
+	switch coinType {
+	case coin.CoinType_Gas:
+		foreignCoin, found := k.fungibleKeeper.GetGasCoinForForeignCoin(ctx, chainID)
+		return foreignCoin.Zrc20ContractAddress, found
+	case coin.CoinType_ERC20:
+		foreignCoin, found := k.fungibleKeeper.GetForeignCoinFromAsset(ctx, asset, chainID)
+		return foreignCoin.Zrc20ContractAddress, found
+	default:
+		return "", false
+	}
+}
+
 // CheckIfTSSMigrationTransfer checks if the sender is a TSS address and returns an error if it is.
 // If the sender is an older TSS address, this means that it is a migration transfer, and we do not need to treat this as a deposit and process the CCTX
 func (k Keeper) CheckIfTSSMigrationTransfer(ctx sdk.Context, msg *types.MsgVoteInbound) error {
@@ -12,6 +12,7 @@ import (
 	keepertest "github.com/zeta-chain/node/testutil/keeper"
 	"github.com/zeta-chain/node/testutil/sample"
 	"github.com/zeta-chain/node/x/crosschain/types"
+	fungibletypes "github.com/zeta-chain/node/x/fungible/types"
 	observerTypes "github.com/zeta-chain/node/x/observer/types"
 )
 
@@ -51,6 +52,8 @@ func TestKeeper_ValidateInbound(t *testing.T) {
 		observerMock.On("GetTSS", mock.Anything).Return(tss, true)
 		// setup Mocks for IsInboundEnabled
 		observerMock.On("IsInboundEnabled", ctx).Return(true)
+		// setup Mocks for CheckIfInboundPaused
+		observerMock.On("GetCrosschainFlags", ctx).Return(observerTypes.CrosschainFlags{}, false)
 		// setup mocks for Initiate Outbound
 		observerMock.On("GetChainNonces", mock.Anything, mock.Anything).
 			Return(observerTypes.ChainNonces{Nonce: 1}, true)
@@ -186,6 +189,8 @@ func TestKeeper_ValidateInbound(t *testing.T) {
 		observerMock.On("GetTSS", mock.Anything).Return(tss, true)
 		// setup Mocks for IsInboundEnabled
 		observerMock.On("IsInboundEnabled", ctx).Return(true)
+		// setup Mocks for CheckIfInboundPaused
+		observerMock.On("GetCrosschainFlags", ctx).Return(observerTypes.CrosschainFlags{}, false)
 		// setup mocks for Initiate Outbound
 		observerMock.On("GetChainNonces", mock.Anything, mock.Anything).
 			Return(observerTypes.ChainNonces{Nonce: 1}, false)
@@ -349,6 +354,59 @@ func TestKeeper_ValidateInbound(t *testing.T) {
 		_, err := k.ValidateInbound(ctx, &msg, false)
 		require.ErrorIs(t, err, observerTypes.ErrSupportedChains)
 	})
+
+	t.Run("should create the cctx of an inbound to a paused chain and schedule it once unpaused", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		chain := chains.Goerli
+		tss := sample.Tss()
+		zk.ObserverKeeper.SetTSS(ctx, tss)
+		zk.ObserverKeeper.SetChainParamsList(ctx, observerTypes.ChainParamsList{
+			ChainParams: []*observerTypes.ChainParams{sample.ChainParamsSupported(chain.ChainId)},
+		})
+		zk.ObserverKeeper.SetChainNonces(ctx, observerTypes.ChainNonces{ChainId: chain.ChainId})
+		zk.ObserverKeeper.SetPendingNonces(ctx, observerTypes.PendingNonces{ChainId: chain.ChainId, Tss: tss.TssPubkey})
+		k.SetGasPrice(ctx, types.GasPrice{ChainId: chain.ChainId, Prices: []uint64{100}})
+
+		// the outbounds to the chain are paused when the inbound is finalized
+		flags := *observerTypes.DefaultCrosschainFlags()
+		flags.PauseChain(chain.ChainId, false, true)
+		zk.ObserverKeeper.SetCrosschainFlags(ctx, flags)
+
+		msg := types.MsgVoteInbound{
+			Creator:            sample.AccAddress(),
+			Sender:             sample.EthAddress().String(),
+			SenderChainId:      chain.ChainId,
+			Receiver:           sample.EthAddress().String(),
+			ReceiverChain:      chain.ChainId,
+			Amount:             sdkmath.NewUint(42),
+			InboundHash:        sample.Hash().String(),
+			InboundBlockHeight: 420,
+			CallOptions:        &types.CallOptions{GasLimit: 100},
+			CoinType:           coin.CoinType_ERC20,
+			TxOrigin:           sample.EthAddress().String(),
+			Asset:              sample.EthAddress().String(),
+			EventIndex:         1,
+		}
+
+		cctx, err := k.ValidateInbound(ctx, &msg, false)
+		require.NoError(t, err)
+		require.Equal(t, types.CctxStatus_PendingOutbound, cctx.CctxStatus.Status)
+
+		// the outbound is held back while the chain is paused
+		res, err := k.ListPendingCctx(ctx, &types.QueryListPendingCctxRequest{ChainId: chain.ChainId})
+		require.NoError(t, err)
+		require.Empty(t, res.CrossChainTx)
+		require.EqualValues(t, 1, res.TotalPending)
+
+		// the outbound is scheduled once the chain is unpaused
+		flags.UnpauseChain(chain.ChainId, false, true)
+		zk.ObserverKeeper.SetCrosschainFlags(ctx, flags)
+
+		res, err = k.ListPendingCctx(ctx, &types.QueryListPendingCctxRequest{ChainId: chain.ChainId})
+		require.NoError(t, err)
+		require.Len(t, res.CrossChainTx, 1)
+		require.Equal(t, cctx.Index, res.CrossChainTx[0].Index)
+	})
 }
 func TestKeeper_CheckMigration(t *testing.T) {
 	t.Run("Do not return error if sender is not a TSS address for evm chain", func(t *testing.T) {
@@ -603,3 +661,122 @@ func TestKeeper_CheckMigration(t *testing.T) {
 		require.NoError(t, err)
 	})
 }
+
+func TestKeeper_CheckIfInboundPaused(t *testing.T) {
+	zrc20 := sample.EthAddress().Hex()
+	asset := sample.EthAddress().Hex()
+
+	tests := []struct {
+		name     string
+		flags    func() (observerTypes.CrosschainFlags, bool)
+		mockCoin bool
+		msg      types.MsgVoteInbound
+		err      error
+	}{
+		{
+			name: "not paused if crosschain flags not found",
+			flags: func() (observerTypes.CrosschainFlags, bool) {
+				return observerTypes.CrosschainFlags{}, false
+			},
+			msg: types.MsgVoteInbound{SenderChainId: chains.Ethereum.ChainId, ReceiverChain: chains.ZetaChainMainnet.ChainId},
+		},
+		{
+			name: "paused if inbound of sender chain is paused",
+			flags: func() (observerTypes.CrosschainFlags, bool) {
+				flags := observerTypes.CrosschainFlags{}
+				flags.PauseChain(chains.Ethereum.ChainId, true, false)
+				return flags, true
+			},
+			msg: types.MsgVoteInbound{SenderChainId: chains.Ethereum.ChainId, ReceiverChain: chains.ZetaChainMainnet.ChainId},
+			err: observerTypes.ErrInboundDisabled,
+		},
+		{
+			name: "not paused if outbound of receiver chain is paused",
+			flags: func() (observerTypes.CrosschainFlags, bool) {
+				flags := observerTypes.CrosschainFlags{}
+				flags.PauseChain(chains.Ethereum.ChainId, false, true)
+				return flags, true
+			},
+			msg: types.MsgVoteInbound{SenderChainId: chains.ZetaChainMainnet.ChainId, ReceiverChain: chains.Ethereum.ChainId},
+		},
+		{
+			name: "not paused if only outbound of sender chain is paused",
+			flags: func() (observerTypes.CrosschainFlags, bool) {
+				flags := observerTypes.CrosschainFlags{}
+				flags.PauseChain(chains.Ethereum.ChainId, false, true)
+				return flags, true
+			},
+			msg: types.MsgVoteInbound{SenderChainId: chains.Ethereum.ChainId, ReceiverChain: chains.ZetaChainMainnet.ChainId},
+		},
+		{
+			name: "paused if inbound of zrc20 is paused",
+			flags: func() (observerTypes.CrosschainFlags, bool) {
+				flags := observerTypes.CrosschainFlags{}
+				flags.PauseZRC20(zrc20, true, false)
+				return flags, true
+			},
+			mockCoin: true,
+			msg: types.MsgVoteInbound{
+				SenderChainId: chains.Ethereum.ChainId,
+				ReceiverChain: chains.ZetaChainMainnet.ChainId,
+				CoinType:      coin.CoinType_ERC20,
+				Asset:         asset,
+			},
+			err: observerTypes.ErrInboundDisabled,
+		},
+		{
+			name: "not paused if outbound of zrc20 is paused",
+			flags: func() (observerTypes.CrosschainFlags, bool) {
+				flags := observerTypes.CrosschainFlags{}
+				flags.PauseZRC20(zrc20, false, true)
+				return flags, true
+			},
+			mockCoin: true,
+			msg: types.MsgVoteInbound{
+				SenderChainId: chains.Ethereum.ChainId,
+				ReceiverChain: chains.BscMainnet.ChainId,
+				CoinType:      coin.CoinType_ERC20,
+				Asset:         asset,
+			},
+		},
+		{
+			name: "not paused if zrc20 is not resolved for non-token coin type",
+			flags: func() (observerTypes.CrosschainFlags, bool) {
+				flags := observerTypes.CrosschainFlags{}
+				flags.PauseZRC20(zrc20, true, true)
+				return flags, true
+			},
+			msg: types.MsgVoteInbound{
+				SenderChainId: chains.Ethereum.ChainId,
+				ReceiverChain: chains.ZetaChainMainnet.ChainId,
+				CoinType:      coin.CoinType_NoAssetCall,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k, ctx, _, _ := keepertest.CrosschainKeeperWithMocks(t,
+				keepertest.CrosschainMockOptions{
+					UseObserverMock: true,
+					UseFungibleMock: true,
+				})
+			observerMock := keepertest.GetCrosschainObserverMock(t, k)
+			fungibleMock := keepertest.GetCrosschainFungibleMock(t, k)
+
+			flags, found := tt.flags()
+			observerMock.On("GetCrosschainFlags", ctx).Return(flags, found)
+			if tt.mockCoin {
+				fungibleMock.On("GetForeignCoinFromAsset", ctx, asset, mock.Anything).
+					Return(fungibletypes.ForeignCoins{Zrc20ContractAddress: zrc20}, true)
+			}
+
+			err := k.CheckIfInboundPaused(ctx, &tt.msg)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -162,7 +162,7 @@ func (k Keeper) processFailedOutboundOnExternalChain(
 	inputAmount math.Uint,
 ) error {
 	switch oldStatus {
-	case types.CctxStatus_PendingOutbound:
+	case types.CctxStatus_PendingOutbound, types.CctxStatus_PendingDelay:
 		if _, found := k.zetaObserverKeeper.GetSupportedChainFromChainID(ctx, cctx.InboundParams.SenderChainId); !found {
 			return observertypes.ErrSupportedChains
 		}
@@ -326,7 +326,7 @@ func (k Keeper) processFailedOutboundV2(ctx sdk.Context, cctx *types.CrossChainT
 	}
 
 	switch cctx.CctxStatus.Status {
-	case types.CctxStatus_PendingOutbound:
+	case types.CctxStatus_PendingOutbound, types.CctxStatus_PendingDelay:
 
 		//  get the chain ID of the connected chain
 		chainID := cctx.GetCurrentOutboundParam().ReceiverChainId
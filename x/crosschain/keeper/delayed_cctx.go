@@ -0,0 +1,162 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/zeta-chain/node/pkg/coin"
+	"github.com/zeta-chain/node/x/crosschain/types"
+)
+
+// SetDelayedCctx set a specific delayed cctx in the store from its index
+// the delayed cctx is also added to the release height index
+func (k Keeper) SetDelayedCctx(ctx sdk.Context, delayedCctx types.DelayedCctx) {
+	// remove the previous release height of the cctx from the index
+	k.RemoveDelayedCctx(ctx, delayedCctx.CctxIndex)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DelayedCctxKeyPrefix))
+	b := k.cdc.MustMarshal(&delayedCctx)
+	store.Set(types.KeyPrefix(delayedCctx.CctxIndex), b)
+
+	k.getDelayedCctxReleaseStore(ctx).Set(
+		types.DelayedCctxReleaseIndex(delayedCctx.ReleaseHeight, delayedCctx.CctxIndex),
+		[]byte{1},
+	)
+}
+
+// GetDelayedCctx returns a delayed cctx from its index
+func (k Keeper) GetDelayedCctx(ctx sdk.Context, cctxIndex string) (val types.DelayedCctx, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DelayedCctxKeyPrefix))
+
+	b := store.Get(types.KeyPrefix(cctxIndex))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveDelayedCctx removes a delayed cctx from the store and from the release height index
+func (k Keeper) RemoveDelayedCctx(ctx sdk.Context, cctxIndex string) {
+	delayedCctx, found := k.GetDelayedCctx(ctx, cctxIndex)
+	if !found {
+		return
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DelayedCctxKeyPrefix))
+	store.Delete(types.KeyPrefix(cctxIndex))
+	k.getDelayedCctxReleaseStore(ctx).Delete(types.DelayedCctxReleaseIndex(delayedCctx.ReleaseHeight, cctxIndex))
+}
+
+// GetReleasableDelayedCctxs returns the indexes of the delayed cctxs whose release height is reached
+// the cctxs are returned by increasing release height, at most limit indexes are returned
+func (k Keeper) GetReleasableDelayedCctxs(ctx sdk.Context, limit int) []string {
+	store := k.getDelayedCctxReleaseStore(ctx)
+	// #nosec G115 always positive
+	iterator := store.Iterator(nil, sdk.Uint64ToBigEndian(uint64(ctx.BlockHeight()+1)))
+	defer iterator.Close()
+
+	var cctxIndexes []string
+	for ; iterator.Valid() && len(cctxIndexes) < limit; iterator.Next() {
+		cctxIndexes = append(cctxIndexes, string(iterator.Key()[8:]))
+	}
+	return cctxIndexes
+}
+
+// GetAllDelayedCctx returns all delayed cctxs
+func (k Keeper) GetAllDelayedCctx(ctx sdk.Context) (list []types.DelayedCctx) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DelayedCctxKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.DelayedCctx
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetOutboundDelay returns the number of blocks the outbound of the cctx must be delayed
+// the outbound is delayed if its amount is above the delay threshold of the zrc20 in the rate limiter flags
+func (k Keeper) GetOutboundDelay(ctx sdk.Context, cctx types.CrossChainTx) (int64, bool) {
+	// admin commands are never delayed
+	if cctx.InboundParams.CoinType == coin.CoinType_Cmd {
+		return 0, false
+	}
+
+	flags, found := k.GetRateLimiterFlags(ctx)
+	if !found || flags.DelayBlocks <= 0 || len(flags.DelayThresholds) == 0 {
+		return 0, false
+	}
+
+	zrc20, found := k.getZRC20FromAsset(
+		ctx,
+		cctx.InboundParams.CoinType,
+		cctx.InboundParams.Asset,
+		cctx.GetCurrentOutboundParam().ReceiverChainId,
+	)
+	if !found {
+		return 0, false
+	}
+
+	threshold, found := flags.GetDelayThreshold(zrc20)
+	if !found || cctx.GetCurrentOutboundParam().Amount.LTE(threshold) {
+		return 0, false
+	}
+	return flags.DelayBlocks, true
+}
+
+// DelayOutbound holds back the outbound of the cctx for the given number of blocks
+// no nonce is assigned to the outbound so it is not scheduled by the observers until released
+func (k Keeper) DelayOutbound(ctx sdk.Context, cctx *types.CrossChainTx, delayBlocks int64) {
+	releaseHeight := ctx.BlockHeight() + delayBlocks
+	cctx.SetPendingDelay(fmt.Sprintf("outbound delayed until block %d", releaseHeight))
+	k.SetDelayedCctx(ctx, types.DelayedCctx{
+		CctxIndex:     cctx.Index,
+		ReleaseHeight: releaseHeight,
+	})
+}
+
+// ReleaseDelayedCctxs schedules the outbounds of the delayed cctxs whose release height is reached
+// the cctx is aborted if the outbound info can't be set
+// At most MaxReleasedDelayedCctxs cctxs are released per block, the remaining ones are released in the next blocks
+func (k Keeper) ReleaseDelayedCctxs(ctx sdk.Context) {
+	tss, found := k.zetaObserverKeeper.GetTSS(ctx)
+	if !found {
+		return
+	}
+
+	for _, cctxIndex := range k.GetReleasableDelayedCctxs(ctx, types.MaxReleasedDelayedCctxs) {
+		k.RemoveDelayedCctx(ctx, cctxIndex)
+
+		cctx, found := k.GetCrossChainTx(ctx, cctxIndex)
+		if !found || cctx.CctxStatus.Status != types.CctxStatus_PendingDelay {
+			continue
+		}
+
+		tmpCtx, commit := ctx.CacheContext()
+		err := k.SetObserverOutboundInfo(tmpCtx, cctx.GetCurrentOutboundParam().ReceiverChainId, &cctx)
+		if err != nil {
+			ctx.Logger().Error("ReleaseDelayedCctxs: setting outbound info failed",
+				"cctxIndex", cctx.Index,
+				"err", err.Error(),
+			)
+			cctx.SetAbort("internal error", err.Error())
+		} else {
+			commit()
+			cctx.SetPendingOutbound("outbound delay is over")
+		}
+		k.SetCctxAndNonceToCctxAndInboundHashToCctx(ctx, cctx, tss.TssPubkey)
+	}
+}
+
+// getDelayedCctxReleaseStore returns the store of the index of the delayed cctxs by release height
+func (k Keeper) getDelayedCctxReleaseStore(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DelayedCctxReleaseKeyPrefix))
+}
@@ -0,0 +1,346 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/node/pkg/chains"
+	"github.com/zeta-chain/node/pkg/coin"
+	keepertest "github.com/zeta-chain/node/testutil/keeper"
+	"github.com/zeta-chain/node/testutil/sample"
+	"github.com/zeta-chain/node/x/crosschain/keeper"
+	"github.com/zeta-chain/node/x/crosschain/types"
+	observertypes "github.com/zeta-chain/node/x/observer/types"
+)
+
+func createNDelayedCctx(keeper *keeper.Keeper, ctx sdk.Context, n int) []types.DelayedCctx {
+	items := make([]types.DelayedCctx, n)
+	for i := range items {
+		items[i].CctxIndex = sample.GetCctxIndexFromString(fmt.Sprintf("%d", i))
+		items[i].ReleaseHeight = int64(i)
+
+		keeper.SetDelayedCctx(ctx, items[i])
+	}
+	return items
+}
+
+// createDelayTestCctx creates a pending inbound cctx withdrawing the given amount of the asset to the chain
+func createDelayTestCctx(
+	t *testing.T,
+	index string,
+	chainID int64,
+	coinType coin.CoinType,
+	asset string,
+	amount uint64,
+) *types.CrossChainTx {
+	cctx := sample.CrossChainTx(t, index)
+	cctx.CctxStatus = &types.Status{Status: types.CctxStatus_PendingInbound}
+	cctx.InboundParams.SenderChainId = chains.ZetaChainMainnet.ChainId
+	cctx.InboundParams.CoinType = coinType
+	cctx.InboundParams.Asset = asset
+	cctx.InboundParams.Amount = sdkmath.NewUint(amount)
+	cctx.OutboundParams = cctx.OutboundParams[:1]
+	cctx.GetCurrentOutboundParam().ReceiverChainId = chainID
+	cctx.GetCurrentOutboundParam().CoinType = coinType
+	cctx.GetCurrentOutboundParam().Amount = sdkmath.NewUint(amount)
+	cctx.GetCurrentOutboundParam().TssNonce = 0
+	return cctx
+}
+
+// setDelayTestFlags sets rate limiter flags delaying outbounds of ETH and USDT above 1000 units
+func setDelayTestFlags(ctx sdk.Context, k *keeper.Keeper, zrc20ETH, zrc20USDT string, delayBlocks int64) {
+	k.SetRateLimiterFlags(ctx, types.RateLimiterFlags{
+		Window:      100,
+		Rate:        sdkmath.NewUint(1000),
+		SenderRate:  sdkmath.ZeroUint(),
+		DelayBlocks: delayBlocks,
+		DelayThresholds: []types.DelayThreshold{
+			{
+				Zrc20:     zrc20ETH,
+				Threshold: sdkmath.NewUint(1000),
+			},
+			{
+				Zrc20:     zrc20USDT,
+				Threshold: sdkmath.NewUint(1000),
+			},
+		},
+	})
+}
+
+// setOutboundInfoForChain sets the observer values required to schedule an outbound on the chain
+func setOutboundInfoForChain(ctx sdk.Context, zk keepertest.ZetaKeepers, tss observertypes.TSS, chainID int64) {
+	zk.ObserverKeeper.SetTSS(ctx, tss)
+	zk.ObserverKeeper.SetChainParamsList(ctx, observertypes.ChainParamsList{
+		ChainParams: []*observertypes.ChainParams{sample.ChainParamsSupported(chainID)},
+	})
+	zk.ObserverKeeper.SetChainNonces(ctx, observertypes.ChainNonces{ChainId: chainID, Nonce: 42})
+	zk.ObserverKeeper.SetPendingNonces(ctx, observertypes.PendingNonces{
+		ChainId:   chainID,
+		NonceLow:  42,
+		NonceHigh: 42,
+		Tss:       tss.TssPubkey,
+	})
+}
+
+func TestKeeper_DelayedCctx(t *testing.T) {
+	t.Run("should get delayed cctxs", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		items := createNDelayedCctx(k, ctx, 10)
+		for _, item := range items {
+			rst, found := k.GetDelayedCctx(ctx, item.CctxIndex)
+			require.True(t, found)
+			require.Equal(t, item, rst)
+		}
+		require.ElementsMatch(t, items, k.GetAllDelayedCctx(ctx))
+	})
+
+	t.Run("should remove delayed cctxs", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		items := createNDelayedCctx(k, ctx, 10)
+		for _, item := range items {
+			k.RemoveDelayedCctx(ctx, item.CctxIndex)
+			_, found := k.GetDelayedCctx(ctx, item.CctxIndex)
+			require.False(t, found)
+		}
+		require.Empty(t, k.GetAllDelayedCctx(ctx))
+		require.Empty(t, k.GetReleasableDelayedCctxs(ctx.WithBlockHeight(1000), 100))
+	})
+
+	t.Run("should get releasable delayed cctxs by increasing release height", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		k.SetDelayedCctx(ctx, types.DelayedCctx{CctxIndex: "a", ReleaseHeight: 30})
+		k.SetDelayedCctx(ctx, types.DelayedCctx{CctxIndex: "b", ReleaseHeight: 10})
+		k.SetDelayedCctx(ctx, types.DelayedCctx{CctxIndex: "c", ReleaseHeight: 20})
+		k.SetDelayedCctx(ctx, types.DelayedCctx{CctxIndex: "d", ReleaseHeight: 31})
+
+		// updating the release height of a cctx updates the index
+		k.SetDelayedCctx(ctx, types.DelayedCctx{CctxIndex: "e", ReleaseHeight: 5})
+		k.SetDelayedCctx(ctx, types.DelayedCctx{CctxIndex: "e", ReleaseHeight: 40})
+
+		ctx = ctx.WithBlockHeight(30)
+		require.Equal(t, []string{"b", "c", "a"}, k.GetReleasableDelayedCctxs(ctx, 100))
+		require.Equal(t, []string{"b", "c"}, k.GetReleasableDelayedCctxs(ctx, 2))
+	})
+}
+
+func TestKeeper_GetOutboundDelay(t *testing.T) {
+	ethChainID := getValidEthChainID()
+	zrc20ETH := sample.EthAddress().Hex()
+	zrc20BTC := sample.EthAddress().Hex()
+	zrc20USDT := sample.EthAddress().Hex()
+	assetUSDT := sample.EthAddress().Hex()
+
+	tt := []struct {
+		name          string
+		delayBlocks   int64
+		cctx          *types.CrossChainTx
+		expectedDelay int64
+		expectedFound bool
+	}{
+		{
+			name:          "should delay gas outbound above threshold",
+			delayBlocks:   100,
+			cctx:          createDelayTestCctx(t, "0", ethChainID, coin.CoinType_Gas, "", 1001),
+			expectedDelay: 100,
+			expectedFound: true,
+		},
+		{
+			name:          "should delay erc20 outbound above threshold",
+			delayBlocks:   100,
+			cctx:          createDelayTestCctx(t, "1", ethChainID, coin.CoinType_ERC20, assetUSDT, 1001),
+			expectedDelay: 100,
+			expectedFound: true,
+		},
+		{
+			name:        "should not delay outbound equal to threshold",
+			delayBlocks: 100,
+			cctx:        createDelayTestCctx(t, "2", ethChainID, coin.CoinType_Gas, "", 1000),
+		},
+		{
+			name:        "should not delay outbound of asset without threshold",
+			delayBlocks: 100,
+			cctx:        createDelayTestCctx(t, "3", getValidBtcChainID(), coin.CoinType_Gas, "", 1e6),
+		},
+		{
+			name:        "should not delay outbound of unknown asset",
+			delayBlocks: 100,
+			cctx:        createDelayTestCctx(t, "4", ethChainID, coin.CoinType_ERC20, sample.EthAddress().Hex(), 1e6),
+		},
+		{
+			name:        "should not delay admin commands",
+			delayBlocks: 100,
+			cctx:        createDelayTestCctx(t, "5", ethChainID, coin.CoinType_Cmd, "", 1e6),
+		},
+		{
+			name:        "should not delay outbound if delay is disabled",
+			delayBlocks: 0,
+			cctx:        createDelayTestCctx(t, "6", ethChainID, coin.CoinType_Gas, "", 1e6),
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+			setupForeignCoins(t, ctx, zk, zrc20ETH, zrc20BTC, zrc20USDT, assetUSDT)
+			setDelayTestFlags(ctx, k, zrc20ETH, zrc20USDT, tc.delayBlocks)
+
+			delay, found := k.GetOutboundDelay(ctx, *tc.cctx)
+			require.Equal(t, tc.expectedFound, found)
+			require.Equal(t, tc.expectedDelay, delay)
+		})
+	}
+
+	t.Run("should not delay outbound if flags are not set", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		setupForeignCoins(t, ctx, zk, zrc20ETH, zrc20BTC, zrc20USDT, assetUSDT)
+
+		_, found := k.GetOutboundDelay(ctx, *createDelayTestCctx(t, "0", ethChainID, coin.CoinType_Gas, "", 1e6))
+		require.False(t, found)
+	})
+}
+
+func TestKeeper_InitiateOutboundWithDelay(t *testing.T) {
+	ethChainID := getValidEthChainID()
+	zrc20ETH := sample.EthAddress().Hex()
+	zrc20USDT := sample.EthAddress().Hex()
+	tss := sample.Tss()
+
+	t.Run("should hold back outbound above the delay threshold", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		ctx = ctx.WithBlockHeight(10)
+		setupForeignCoins(t, ctx, zk, zrc20ETH, sample.EthAddress().Hex(), zrc20USDT, sample.EthAddress().Hex())
+		setDelayTestFlags(ctx, k, zrc20ETH, zrc20USDT, 100)
+		setOutboundInfoForChain(ctx, zk, tss, ethChainID)
+		k.SetGasPrice(ctx, sample.GasPriceWithChainID(t, ethChainID))
+
+		cctx := createDelayTestCctx(t, "0", ethChainID, coin.CoinType_Gas, "", 1001)
+		newStatus, err := k.InitiateOutbound(ctx, keeper.InitiateOutboundConfig{CCTX: cctx})
+		require.NoError(t, err)
+		require.Equal(t, types.CctxStatus_PendingDelay, newStatus)
+		require.Equal(t, types.CctxStatus_PendingDelay, cctx.CctxStatus.Status)
+
+		// no nonce is assigned to the delayed outbound
+		chainNonces, found := zk.ObserverKeeper.GetChainNonces(ctx, ethChainID)
+		require.True(t, found)
+		require.EqualValues(t, 42, chainNonces.Nonce)
+
+		delayedCctx, found := k.GetDelayedCctx(ctx, cctx.Index)
+		require.True(t, found)
+		require.EqualValues(t, 110, delayedCctx.ReleaseHeight)
+	})
+
+	t.Run("should schedule outbound below the delay threshold", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		setupForeignCoins(t, ctx, zk, zrc20ETH, sample.EthAddress().Hex(), zrc20USDT, sample.EthAddress().Hex())
+		setDelayTestFlags(ctx, k, zrc20ETH, zrc20USDT, 100)
+		setOutboundInfoForChain(ctx, zk, tss, ethChainID)
+		k.SetGasPrice(ctx, sample.GasPriceWithChainID(t, ethChainID))
+
+		cctx := createDelayTestCctx(t, "0", ethChainID, coin.CoinType_Gas, "", 1000)
+		newStatus, err := k.InitiateOutbound(ctx, keeper.InitiateOutboundConfig{CCTX: cctx})
+		require.NoError(t, err)
+		require.Equal(t, types.CctxStatus_PendingOutbound, newStatus)
+		require.EqualValues(t, 42, cctx.GetCurrentOutboundParam().TssNonce)
+
+		_, found := k.GetDelayedCctx(ctx, cctx.Index)
+		require.False(t, found)
+	})
+}
+
+func TestKeeper_ReleaseDelayedCctxs(t *testing.T) {
+	ethChainID := getValidEthChainID()
+	tss := sample.Tss()
+
+	// setDelayedCctx sets a delayed cctx released at the given height
+	setDelayedCctx := func(
+		t *testing.T,
+		ctx sdk.Context,
+		k *keeper.Keeper,
+		index string,
+		releaseHeight int64,
+	) *types.CrossChainTx {
+		cctx := createDelayTestCctx(t, index, ethChainID, coin.CoinType_Gas, "", 1e6)
+		cctx.SetPendingOutbound("")
+		cctx.SetPendingDelay("")
+		k.SetCrossChainTx(ctx, *cctx)
+		k.SetDelayedCctx(ctx, types.DelayedCctx{
+			CctxIndex:     cctx.Index,
+			ReleaseHeight: releaseHeight,
+		})
+		return cctx
+	}
+
+	t.Run("should release delayed cctxs whose delay is over", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		ctx = ctx.WithBlockHeight(100)
+		setOutboundInfoForChain(ctx, zk, tss, ethChainID)
+
+		released := setDelayedCctx(t, ctx, k, "0", 100)
+		held := setDelayedCctx(t, ctx, k, "1", 101)
+
+		k.ReleaseDelayedCctxs(ctx)
+
+		// the released cctx is scheduled with the next nonce
+		cctx, found := k.GetCrossChainTx(ctx, released.Index)
+		require.True(t, found)
+		require.Equal(t, types.CctxStatus_PendingOutbound, cctx.CctxStatus.Status)
+		require.EqualValues(t, 42, cctx.GetCurrentOutboundParam().TssNonce)
+		nonceToCctx, found := zk.ObserverKeeper.GetNonceToCctx(ctx, tss.TssPubkey, ethChainID, 42)
+		require.True(t, found)
+		require.Equal(t, released.Index, nonceToCctx.CctxIndex)
+		_, found = k.GetDelayedCctx(ctx, released.Index)
+		require.False(t, found)
+
+		// the other cctx is still delayed
+		cctx, found = k.GetCrossChainTx(ctx, held.Index)
+		require.True(t, found)
+		require.Equal(t, types.CctxStatus_PendingDelay, cctx.CctxStatus.Status)
+		_, found = k.GetDelayedCctx(ctx, held.Index)
+		require.True(t, found)
+	})
+
+	t.Run("should abort delayed cctx if the outbound can't be scheduled", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		ctx = ctx.WithBlockHeight(100)
+		setOutboundInfoForChain(ctx, zk, tss, ethChainID)
+
+		// nonce mismatch
+		zk.ObserverKeeper.SetChainNonces(ctx, observertypes.ChainNonces{ChainId: ethChainID, Nonce: 43})
+		delayed := setDelayedCctx(t, ctx, k, "0", 100)
+
+		k.ReleaseDelayedCctxs(ctx)
+
+		cctx, found := k.GetCrossChainTx(ctx, delayed.Index)
+		require.True(t, found)
+		require.Equal(t, types.CctxStatus_Aborted, cctx.CctxStatus.Status)
+		_, found = k.GetDelayedCctx(ctx, delayed.Index)
+		require.False(t, found)
+
+		// pending nonces are untouched
+		pendingNonces, found := zk.ObserverKeeper.GetPendingNonces(ctx, tss.TssPubkey, ethChainID)
+		require.True(t, found)
+		require.EqualValues(t, 42, pendingNonces.NonceHigh)
+	})
+
+	t.Run("should remove entry of cctx no longer delayed", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		ctx = ctx.WithBlockHeight(100)
+		setOutboundInfoForChain(ctx, zk, tss, ethChainID)
+
+		delayed := setDelayedCctx(t, ctx, k, "0", 100)
+		delayed.SetAbort("", "")
+		k.SetCrossChainTx(ctx, *delayed)
+
+		k.ReleaseDelayedCctxs(ctx)
+
+		cctx, found := k.GetCrossChainTx(ctx, delayed.Index)
+		require.True(t, found)
+		require.Equal(t, types.CctxStatus_Aborted, cctx.CctxStatus.Status)
+		_, found = k.GetDelayedCctx(ctx, delayed.Index)
+		require.False(t, found)
+	})
+}
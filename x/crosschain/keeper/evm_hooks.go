@@ -307,12 +307,19 @@ func (k Keeper) ProcessZetaSentEvent(
 // It verifies event information for BTC chains and returns an error if the event is invalid
 func (k Keeper) ValidateZrc20WithdrawEvent(ctx sdk.Context, event *zrc20.ZRC20Withdrawal, chainID int64) error {
 	// The event was parsed; that means the user has deposited tokens to the contract.
-	return k.validateZRC20Withdrawal(ctx, chainID, event.Value, event.To)
+	return k.validateZRC20Withdrawal(ctx, chainID, event.Value, event.To, false)
 }
 
 // validateZRC20Withdrawal validates the data of a ZRC20 Withdrawal event (version 1 or 2)
 // it checks if the withdrawal amount is valid and the destination address is supported depending on the chain
-func (k Keeper) validateZRC20Withdrawal(ctx sdk.Context, chainID int64, value *big.Int, to []byte) error {
+// isCrossChainCall is true if the event is a call or a withdraw and call to a contract on the destination chain
+func (k Keeper) validateZRC20Withdrawal(
+	ctx sdk.Context,
+	chainID int64,
+	value *big.Int,
+	to []byte,
+	isCrossChainCall bool,
+) error {
 	additionalChains := k.GetAuthorityKeeper().GetAdditionalChainList(ctx)
 	if chains.IsBitcoinChain(chainID, additionalChains) {
 		if value.Cmp(big.NewInt(constant.BTCWithdrawalDustAmount)) < 0 {
@@ -331,7 +338,8 @@ func (k Keeper) validateZRC20Withdrawal(ctx sdk.Context, chainID int64, value *b
 			return errorsmod.Wrapf(types.ErrInvalidAddress, "unsupported address %s", string(to))
 		}
 	} else if chains.IsSolanaChain(chainID, additionalChains) {
-		if value.Cmp(big.NewInt(constant.SolanaWalletRentExempt)) < 0 {
+		// the rent exempt amount is only required to fund a recipient wallet, a called program already exists
+		if !isCrossChainCall && value.Cmp(big.NewInt(constant.SolanaWalletRentExempt)) < 0 {
 			return errorsmod.Wrapf(
 				types.ErrInvalidWithdrawalAmount,
 				"withdraw amount %s is less than rent exempt %d",
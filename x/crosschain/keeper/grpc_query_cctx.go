@@ -176,6 +176,9 @@ func (k Keeper) ListPendingCctx(
 		cctxs = append(cctxs, cctx)
 	}
 
+	// the cctxs with a paused zrc20 outbound are not scheduled
+	cctxs = k.holdPausedOutbounds(ctx, cctxs)
+
 	return &types.QueryListPendingCctxResponse{
 		CrossChainTx: cctxs,
 		TotalPending: totalPending,
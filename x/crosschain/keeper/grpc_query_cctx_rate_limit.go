@@ -79,11 +79,12 @@ func (k Keeper) RateLimiterInput(
 		chains.FilterExternalChains,
 	)
 
-	_, assetRates, found := k.GetRateLimiterAssetRateList(ctx)
+	rateLimitFlags, assetRates, found := k.GetRateLimiterAssetRateList(ctx)
 	if !found {
 		return nil, status.Error(codes.Internal, "asset rates not found")
 	}
 	gasAssetRateMap, erc20AssetRateMap := types.BuildAssetRateMapFromList(assetRates)
+	quotas := types.NewRateLimiterQuotas(rateLimitFlags, k.GetRateLimiterAssetCaps(ctx, rateLimitFlags), req.Window)
 
 	// query pending nonces of each foreign chain and get the lowest height of the pending cctxs
 	lowestPendingCctxHeight := int64(0)
@@ -112,7 +113,6 @@ func (k Keeper) RateLimiterInput(
 	// define a few variables to be used in the query loops
 	totalPending := uint64(0)
 	pastCctxsValue := sdk.NewInt(0)
-	pendingCctxsValue := sdk.NewInt(0)
 	cctxsMissed := make([]*types.CrossChainTx, 0)
 	cctxsPending := make([]*types.CrossChainTx, 0)
 
@@ -146,9 +146,9 @@ func (k Keeper) RateLimiterInput(
 
 			// sum up the cctxs' value if the cctx is outgoing, within the window and in the past
 			if inWindow && isOutgoing && isPast {
-				pastCctxsValue = pastCctxsValue.Add(
-					types.ConvertCctxValueToAzeta(chain.ChainId, cctx, gasAssetRateMap, erc20AssetRateMap),
-				)
+				value := types.ConvertCctxValueToAzeta(chain.ChainId, cctx, gasAssetRateMap, erc20AssetRateMap)
+				pastCctxsValue = pastCctxsValue.Add(value)
+				quotas.Add(chain.ChainId, cctx, value)
 			}
 
 			// add cctx to corresponding list
@@ -158,19 +158,50 @@ func (k Keeper) RateLimiterInput(
 					cctxsMissed = append(cctxsMissed, cctx)
 				} else {
 					cctxsPending = append(cctxsPending, cctx)
-					// sum up non-past pending cctxs' value
-					pendingCctxsValue = pendingCctxsValue.Add(types.ConvertCctxValueToAzeta(chain.ChainId, cctx, gasAssetRateMap, erc20AssetRateMap))
 				}
 			}
 		}
 	}
 
+	// the cctxs with a paused zrc20 outbound are not scheduled
+	pausedNonces := k.getPausedOutboundNonces(ctx, cctxsMissed, cctxsPending)
+	cctxsMissed = holdCctxsFromNonces(cctxsMissed, pausedNonces)
+	cctxsPending = holdCctxsFromNonces(cctxsPending, pausedNonces)
+
 	// sort the missed cctxs order by height (can sort by other criteria, for unit testability)
 	SortCctxsByHeightAndChainID(cctxsMissed)
 
 	// sort the pending cctxs order by height (first come first serve)
 	SortCctxsByHeightAndChainID(cctxsPending)
 
+	// hold back the pending cctxs exceeding a chain or asset quota
+	// the following cctxs of the same chain are held back too to keep the nonce order
+	quotaExceeded := false
+	heldChains := make(map[int64]bool)
+	pendingCctxsValue := sdk.NewInt(0)
+	cctxsWithinQuotas := make([]*types.CrossChainTx, 0, len(cctxsPending))
+	for _, cctx := range cctxsPending {
+		chainID := cctx.GetCurrentOutboundParam().ReceiverChainId
+		if heldChains[chainID] {
+			continue
+		}
+
+		value := types.ConvertCctxValueToAzeta(chainID, cctx, gasAssetRateMap, erc20AssetRateMap)
+		if isCCTXOutgoing(cctx) {
+			if quotas.Exceeded(chainID, cctx, value) {
+				heldChains[chainID] = true
+				quotaExceeded = true
+				continue
+			}
+			quotas.Add(chainID, cctx, value)
+		}
+
+		// sum up non-past pending cctxs' value
+		pendingCctxsValue = pendingCctxsValue.Add(value)
+		cctxsWithinQuotas = append(cctxsWithinQuotas, cctx)
+	}
+	cctxsPending = cctxsWithinQuotas
+
 	// we take all the missed cctxs (won't be a lot) for simplicity of the query, but we only take a `limit` number of pending cctxs
 	if maxCCTXsReached(cctxsPending) {
 		cctxsPending = cctxsPending[:limit]
@@ -184,6 +215,7 @@ func (k Keeper) RateLimiterInput(
 		PastCctxsValue:          pastCctxsValue.String(),
 		PendingCctxsValue:       pendingCctxsValue.String(),
 		LowestPendingCctxHeight: lowestPendingCctxHeight,
+		QuotaExceeded:           quotaExceeded,
 	}, nil
 }
 
@@ -206,6 +238,7 @@ func (k Keeper) ListPendingCctxWithinRateLimit(
 
 	// define a few variables to be used in the query loops
 	limitExceeded := false
+	quotaExceeded := false
 	totalPending := uint64(0)
 	totalWithdrawInAzeta := sdkmath.NewInt(0)
 	cctxs := make([]*types.CrossChainTx, 0)
@@ -260,6 +293,11 @@ func (k Keeper) ListPendingCctxWithinRateLimit(
 	blockLimitInAzeta := sdkmath.NewIntFromBigInt(rateLimitFlags.Rate.BigInt())
 	windowLimitInAzeta := blockLimitInAzeta.Mul(sdkmath.NewInt(rateLimitFlags.Window))
 	gasAssetRateMap, erc20AssetRateMap := types.BuildAssetRateMapFromList(assetRates)
+	quotas := types.NewRateLimiterQuotas(
+		rateLimitFlags,
+		k.GetRateLimiterAssetCaps(ctx, rateLimitFlags),
+		rateLimitFlags.Window,
+	)
 
 	// the criteria to stop adding cctxs to the rpc response
 	maxCCTXsReached := func(cctxs []*types.CrossChainTx) bool {
@@ -344,6 +382,16 @@ func (k Keeper) ListPendingCctxWithinRateLimit(
 			if nonce < endNonce && !inWindow {
 				break
 			}
+
+			// the past cctxs within the window count towards the quotas
+			if inWindow && isOutgoing {
+				quotas.Add(
+					chain.ChainId,
+					cctx,
+					types.ConvertCctxValueToAzeta(chain.ChainId, cctx, gasAssetRateMap, erc20AssetRateMap),
+				)
+			}
+
 			// sum up the cctxs' value if the cctx is outgoing and within the window
 			if inWindow && isOutgoing &&
 				types.RateLimitExceeded(
@@ -379,6 +427,7 @@ func (k Keeper) ListPendingCctxWithinRateLimit(
 		totalPending += uint64(pendingNonces.NonceHigh - pendingNonces.NonceLow)
 
 		// query the pending cctxs in range [NonceLow, NonceHigh)
+		quotaHeld := false
 		for nonce := pendingNonces.NonceLow; nonce < pendingNonces.NonceHigh; nonce++ {
 			cctx, err := getCctxByChainIDAndNonce(k, ctx, tss.TssPubkey, chain.ChainId, nonce)
 			if err != nil {
@@ -386,6 +435,21 @@ func (k Keeper) ListPendingCctxWithinRateLimit(
 			}
 			isOutgoing := isCCTXOutgoing(cctx)
 
+			// hold back the cctx if it exceeds a chain or asset quota
+			// the following cctxs of the chain are held back too to keep the nonce order
+			if quotaHeld {
+				continue
+			}
+			if isOutgoing {
+				value := types.ConvertCctxValueToAzeta(chain.ChainId, cctx, gasAssetRateMap, erc20AssetRateMap)
+				if quotas.Exceeded(chain.ChainId, cctx, value) {
+					quotaHeld = true
+					quotaExceeded = true
+					continue
+				}
+				quotas.Add(chain.ChainId, cctx, value)
+			}
+
 			// skip the cctx if rate limit is exceeded but still accumulate the total withdraw value
 			if isOutgoing && types.RateLimitExceeded(
 				chain.ChainId,
@@ -411,6 +475,9 @@ func (k Keeper) ListPendingCctxWithinRateLimit(
 		cctxs = cctxs[:missedPending]
 	}
 
+	// the cctxs with a paused zrc20 outbound are not scheduled
+	cctxs = k.holdPausedOutbounds(ctx, cctxs)
+
 	// sort the cctxs by chain ID and nonce (lower nonce holds higher priority for scheduling)
 	sort.Slice(cctxs, func(i, j int) bool {
 		if cctxs[i].GetCurrentOutboundParam().ReceiverChainId == cctxs[j].GetCurrentOutboundParam().ReceiverChainId {
@@ -425,5 +492,6 @@ func (k Keeper) ListPendingCctxWithinRateLimit(
 		CurrentWithdrawWindow: withdrawWindow,
 		CurrentWithdrawRate:   totalWithdrawInAzeta.Quo(sdk.NewInt(withdrawWindow)).String(),
 		RateLimitExceeded:     limitExceeded,
+		QuotaExceeded:         quotaExceeded,
 	}, nil
 }
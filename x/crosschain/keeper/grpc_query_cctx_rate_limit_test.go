@@ -182,6 +182,24 @@ func TestKeeper_RateLimiterInput(t *testing.T) {
 		types.CctxStatus_PendingOutbound,
 	)
 
+	// create rate limiter flags with a chain quota of 2.1 ZETA/block (1050 ZETA in window 500) for Eth chain
+	ethQuotaFlags := createTestRateLimiterFlags(
+		500,
+		math.NewUint(10*1e18),
+		zrc20ETH,
+		zrc20BTC,
+		zrc20USDT,
+		"2500",
+		"50000",
+		"0.8",
+	)
+	ethQuotaFlags.ChainRates = []types.ChainRate{
+		{
+			ChainId: ethChainID,
+			Rate:    math.NewUint(21e17),
+		},
+	}
+
 	// define test cases
 	tests := []struct {
 		name           string
@@ -209,6 +227,7 @@ func TestKeeper_RateLimiterInput(t *testing.T) {
 		expectedPastCctxsValue          string
 		expectedPendingCctxsValue       string
 		expectedLowestPendingCctxHeight int64
+		expectedQuotaExceeded           bool
 	}{
 		{
 			name: "can retrieve all pending cctxs",
@@ -432,6 +451,43 @@ func TestKeeper_RateLimiterInput(t *testing.T) {
 			expectedPendingCctxsValue:       sdk.NewInt(300).Mul(sdk.NewInt(1e18)).String(),  // 100 * (2.5 + 0.5) ZETA
 			expectedLowestPendingCctxHeight: 1100,
 		},
+		{
+			name:            "should hold back pending cctxs exceeding the chain quota",
+			rateLimitFlags:  ethQuotaFlags,
+			ethMinedCctxs:   ethMinedCctxs,
+			ethPendingCctxs: ethPendingCctxs,
+			ethPendingNonces: observertypes.PendingNonces{
+				ChainId:   ethChainID,
+				NonceLow:  1099,
+				NonceHigh: 1199,
+				Tss:       tss.TssPubkey,
+			},
+			btcMinedCctxs:   btcMinedCctxs,
+			btcPendingCctxs: btcPendingCctxs,
+			btcPendingNonces: observertypes.PendingNonces{
+				ChainId:   btcChainID,
+				NonceLow:  1099,
+				NonceHigh: 1199,
+				Tss:       tss.TssPubkey,
+			},
+			currentHeight: 1199,
+			queryLimit:    0, // use default MaxPendingCctxs
+
+			// expected results
+			expectedHeight: 1199,
+			expectedCctxsMissed: keeper.SortCctxsByHeightAndChainID(
+				append(append([]*types.CrossChainTx{}, ethPendingCctxs[0:100]...), btcPendingCctxs[0:100]...),
+			),
+			// Eth chain has used 1000 ZETA of its quota, only 20 more Eth cctxs (2.5 ZETA each) fit in the quota
+			expectedCctxsPending: keeper.SortCctxsByHeightAndChainID(
+				append(append([]*types.CrossChainTx{}, ethPendingCctxs[100:120]...), btcPendingCctxs[100:200]...),
+			),
+			expectedTotalPending:            400,
+			expectedPastCctxsValue:          sdk.NewInt(1200).Mul(sdk.NewInt(1e18)).String(), // 400 * (2.5 + 0.5) ZETA
+			expectedPendingCctxsValue:       sdk.NewInt(100).Mul(sdk.NewInt(1e18)).String(),  // 20 * 2.5 + 100 * 0.5 ZETA
+			expectedLowestPendingCctxHeight: 1100,
+			expectedQuotaExceeded:           true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -486,6 +542,7 @@ func TestKeeper_RateLimiterInput(t *testing.T) {
 			require.Equal(t, tt.expectedPastCctxsValue, res.PastCctxsValue)
 			require.Equal(t, tt.expectedPendingCctxsValue, res.PendingCctxsValue)
 			require.Equal(t, tt.expectedLowestPendingCctxHeight, res.LowestPendingCctxHeight)
+			require.Equal(t, tt.expectedQuotaExceeded, res.QuotaExceeded)
 		})
 	}
 }
@@ -640,6 +697,24 @@ func TestKeeper_ListPendingCctxWithinRateLimit(t *testing.T) {
 		types.CctxStatus_PendingOutbound,
 	)
 
+	// create rate limiter flags with a chain quota of 2.1 ZETA/block (1050 ZETA in window 500) for Eth chain
+	ethQuotaFlags := createTestRateLimiterFlags(
+		500,
+		math.NewUint(10*1e18),
+		zrc20ETH,
+		zrc20BTC,
+		zrc20USDT,
+		"2500",
+		"50000",
+		"0.8",
+	)
+	ethQuotaFlags.ChainRates = []types.ChainRate{
+		{
+			ChainId: ethChainID,
+			Rate:    math.NewUint(21e17),
+		},
+	}
+
 	// define test cases
 	tests := []struct {
 		name           string
@@ -666,6 +741,7 @@ func TestKeeper_ListPendingCctxWithinRateLimit(t *testing.T) {
 		expectedWithdrawWindow int64
 		expectedWithdrawRate   string
 		rateLimitExceeded      bool
+		quotaExceeded          bool
 	}{
 		{
 			name:            "should use fallback query if rate limiter is disabled",
@@ -995,6 +1071,37 @@ func TestKeeper_ListPendingCctxWithinRateLimit(t *testing.T) {
 			expectedWithdrawRate:   sdk.NewInt(3e18).String(), // 3 ZETA, (2.5 + 0.5) per block
 			rateLimitExceeded:      true,
 		},
+		{
+			name:            "should hold back pending cctxs exceeding the chain quota in forward loop",
+			rateLimitFlags:  ethQuotaFlags,
+			ethMinedCctxs:   ethMinedCctxs,
+			ethPendingCctxs: ethPendingCctxs,
+			ethPendingNonces: observertypes.PendingNonces{
+				ChainId:   ethChainID,
+				NonceLow:  1099,
+				NonceHigh: 1199,
+				Tss:       tss.TssPubkey,
+			},
+			btcMinedCctxs:   btcMinedCctxs,
+			btcPendingCctxs: btcPendingCctxs,
+			btcPendingNonces: observertypes.PendingNonces{
+				ChainId:   btcChainID,
+				NonceLow:  1099,
+				NonceHigh: 1199,
+				Tss:       tss.TssPubkey,
+			},
+			currentHeight: 1199,
+			queryLimit:    keeper.MaxPendingCctxs,
+			// Eth chain has used 1000 ZETA of its quota, only 20 more Eth cctxs (2.5 ZETA each) fit in the quota
+			expectedCctxs: append(
+				append([]*types.CrossChainTx{}, ethPendingCctxs[0:120]...),
+				btcPendingCctxs...),
+			expectedTotalPending:   400,
+			expectedWithdrawWindow: 500,                        // the sliding window
+			expectedWithdrawRate:   sdk.NewInt(26e17).String(), // (1050 + 250) / 500 = 2.6 ZETA per block
+			rateLimitExceeded:      false,
+			quotaExceeded:          true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -1047,6 +1154,7 @@ func TestKeeper_ListPendingCctxWithinRateLimit(t *testing.T) {
 				require.Equal(t, tt.expectedWithdrawWindow, res.CurrentWithdrawWindow)
 				require.Equal(t, tt.expectedWithdrawRate, res.CurrentWithdrawRate)
 				require.Equal(t, tt.rateLimitExceeded, res.RateLimitExceeded)
+				require.Equal(t, tt.quotaExceeded, res.QuotaExceeded)
 			}
 		})
 	}
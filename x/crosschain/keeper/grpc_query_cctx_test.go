@@ -8,6 +8,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/stretchr/testify/require"
 
+	"github.com/zeta-chain/node/pkg/coin"
 	keepertest "github.com/zeta-chain/node/testutil/keeper"
 	"github.com/zeta-chain/node/testutil/sample"
 	"github.com/zeta-chain/node/x/crosschain/keeper"
@@ -157,6 +158,46 @@ func TestKeeper_CctxListPending(t *testing.T) {
 		require.EqualValues(t, uint64(1002), res.TotalPending)
 	})
 
+	t.Run("should hold pending cctxs from the first cctx with paused zrc20 outbound", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		chainID := getValidEthChainID()
+		tss := sample.Tss()
+		zk.ObserverKeeper.SetTSS(ctx, tss)
+		cctxs := createCctxWithNonceRange(t, ctx, *k, 1000, 1010, chainID, tss, zk)
+
+		// the cctx of nonce 1005 withdraws the paused gas token of the chain
+		zrc20 := sample.EthAddress().Hex()
+		gasCoin := sample.ForeignCoins(t, zrc20)
+		gasCoin.ForeignChainId = chainID
+		gasCoin.CoinType = coin.CoinType_Gas
+		zk.FungibleKeeper.SetForeignCoins(ctx, gasCoin)
+		for i, cctx := range cctxs {
+			cctx.InboundParams.CoinType = coin.CoinType_Zeta
+			if i == 5 {
+				cctx.InboundParams.CoinType = coin.CoinType_Gas
+			}
+			cctx.GetCurrentOutboundParam().ReceiverChainId = chainID
+			cctx.GetCurrentOutboundParam().TssNonce = uint64(1000 + i)
+			k.SetCrossChainTx(ctx, *cctx)
+		}
+		flags := *observertypes.DefaultCrosschainFlags()
+		flags.PauseZRC20(zrc20, false, true)
+		zk.ObserverKeeper.SetCrosschainFlags(ctx, flags)
+
+		res, err := k.ListPendingCctx(ctx, &types.QueryListPendingCctxRequest{ChainId: chainID})
+		require.NoError(t, err)
+		require.EqualValues(t, cctxs[0:5], res.CrossChainTx)
+		require.EqualValues(t, uint64(10), res.TotalPending)
+
+		// all the pending cctxs are scheduled once the zrc20 is unpaused
+		flags.UnpauseZRC20(zrc20, false, true)
+		zk.ObserverKeeper.SetCrosschainFlags(ctx, flags)
+
+		res, err = k.ListPendingCctx(ctx, &types.QueryListPendingCctxRequest{ChainId: chainID})
+		require.NoError(t, err)
+		require.EqualValues(t, cctxs, res.CrossChainTx)
+	})
+
 	t.Run("error if some before low nonce are missing", func(t *testing.T) {
 		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
 		chainID := getValidEthChainID()
@@ -0,0 +1,126 @@
+package keeper
+
+import (
+	"context"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/zeta-chain/node/pkg/chains"
+	"github.com/zeta-chain/node/x/crosschain/types"
+	observertypes "github.com/zeta-chain/node/x/observer/types"
+)
+
+// RateLimiterQuotaUsage queries the usage of the chain, sender and asset quotas of the rate limiter
+// The chain and asset usages include all outgoing cctxs observed within the current window, pending or not,
+// the sender usages are the values withdrawn by the senders within their current window
+func (k Keeper) RateLimiterQuotaUsage(
+	c context.Context,
+	req *types.QueryRateLimiterQuotaUsageRequest,
+) (*types.QueryRateLimiterQuotaUsageResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	rateLimitFlags, assetRates, found := k.GetRateLimiterAssetRateList(ctx)
+	if !found {
+		return nil, status.Error(codes.Internal, "rate limiter flags not found")
+	}
+	if rateLimitFlags.Window <= 0 {
+		return nil, status.Error(codes.FailedPrecondition, "window must be positive")
+	}
+
+	// get current height and tss
+	height := ctx.BlockHeight()
+	if height <= 0 {
+		return nil, status.Error(codes.OutOfRange, "height out of range")
+	}
+	tss, found := k.zetaObserverKeeper.GetTSS(ctx)
+	if !found {
+		return nil, observertypes.ErrTssNotFound
+	}
+
+	// calculate the rate limiter sliding window left boundary (inclusive)
+	leftWindowBoundary := height - rateLimitFlags.Window + 1
+	if leftWindowBoundary < 1 {
+		leftWindowBoundary = 1
+	}
+
+	gasAssetRateMap, erc20AssetRateMap := types.BuildAssetRateMapFromList(assetRates)
+	quotas := types.NewRateLimiterQuotas(
+		rateLimitFlags,
+		k.GetRateLimiterAssetCaps(ctx, rateLimitFlags),
+		rateLimitFlags.Window,
+	)
+	additionalChains := k.GetAuthorityKeeper().GetAdditionalChainList(ctx)
+	externalSupportedChains := chains.FilterChains(
+		k.GetObserverKeeper().GetSupportedChains(ctx),
+		chains.FilterExternalChains,
+	)
+
+	// query backwards the cctxs of each foreign chain until the left window boundary
+	for _, chain := range externalSupportedChains {
+		pendingNonces, found := k.GetObserverKeeper().GetPendingNonces(ctx, tss.TssPubkey, chain.ChainId)
+		if !found {
+			return nil, status.Error(codes.Internal, "pending nonces not found")
+		}
+
+		// go back at least 1000 nonces before `NonceLow` to pick up missed pending cctxs like the rate limiter does
+		endNonce := pendingNonces.NonceLow - MaxLookbackNonce
+		for nonce := pendingNonces.NonceHigh - 1; nonce >= 0; nonce-- {
+			cctx, err := getCctxByChainIDAndNonce(k, ctx, tss.TssPubkey, chain.ChainId, nonce)
+			if err != nil {
+				return nil, err
+			}
+
+			// #nosec G115 checked positive
+			inWindow := cctx.InboundParams.ObservedExternalHeight >= uint64(leftWindowBoundary)
+			if nonce < endNonce && !inWindow {
+				break
+			}
+
+			// reverted incoming cctx has an external `SenderChainId` and should not be counted
+			if inWindow && chains.IsZetaChain(cctx.InboundParams.SenderChainId, additionalChains) {
+				quotas.Add(
+					chain.ChainId,
+					cctx,
+					types.ConvertCctxValueToAzeta(chain.ChainId, cctx, gasAssetRateMap, erc20AssetRateMap),
+				)
+			}
+		}
+	}
+
+	return &types.QueryRateLimiterQuotaUsageResponse{
+		Height:       height,
+		Window:       rateLimitFlags.Window,
+		ChainUsages:  quotas.ChainUsages(),
+		SenderUsages: k.getSenderQuotaUsages(ctx, rateLimitFlags),
+		AssetUsages:  quotas.AssetUsages(),
+	}, nil
+}
+
+// getSenderQuotaUsages returns the usage of the sender quota of the senders whose window is not over, sorted by sender
+// no usage is returned if the sender quota is disabled
+func (k Keeper) getSenderQuotaUsages(ctx sdk.Context, flags types.RateLimiterFlags) []types.SenderQuotaUsage {
+	usages := make([]types.SenderQuotaUsage, 0)
+	if flags.SenderRate.IsNil() || flags.SenderRate.IsZero() {
+		return usages
+	}
+
+	// #nosec G115 checked positive
+	limit := flags.SenderRate.MulUint64(uint64(flags.Window))
+	for _, usage := range k.GetAllRateLimiterSenderUsages(ctx) {
+		if usage.WindowStart+flags.Window <= ctx.BlockHeight() {
+			continue
+		}
+		usages = append(usages, types.SenderQuotaUsage{
+			Sender: usage.Sender,
+			Used:   sdkmath.NewUintFromBigInt(usage.Used.BigInt()),
+			Limit:  limit,
+		})
+	}
+	return usages
+}
@@ -0,0 +1,226 @@
+package keeper_test
+
+import (
+	"strings"
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/node/pkg/chains"
+	"github.com/zeta-chain/node/pkg/coin"
+	keepertest "github.com/zeta-chain/node/testutil/keeper"
+	"github.com/zeta-chain/node/testutil/sample"
+	"github.com/zeta-chain/node/x/crosschain/types"
+	observertypes "github.com/zeta-chain/node/x/observer/types"
+)
+
+func TestKeeper_RateLimiterQuotaUsage(t *testing.T) {
+	// create sample TSS and sender
+	tss := sample.Tss()
+	zetaChainID := chains.ZetaChainMainnet.ChainId
+	sender := sample.EthAddress().Hex()
+
+	// create sample zrc20 addresses for ETH, BTC, USDT
+	zrc20ETH := sample.EthAddress().Hex()
+	zrc20BTC := sample.EthAddress().Hex()
+	zrc20USDT := sample.EthAddress().Hex()
+
+	// create Eth and Btc chain 999 mined and 200 pending cctxs from the same sender
+	ethCctxs := append(
+		sample.CustomCctxsInBlockRange(
+			t,
+			1,
+			999,
+			zetaChainID,
+			ethChainID,
+			coin.CoinType_Gas,
+			"",
+			uint64(1e15),
+			types.CctxStatus_OutboundMined,
+		),
+		sample.CustomCctxsInBlockRange(
+			t,
+			1000,
+			1199,
+			zetaChainID,
+			ethChainID,
+			coin.CoinType_Gas,
+			"",
+			uint64(1e15),
+			types.CctxStatus_PendingOutbound,
+		)...,
+	)
+	btcCctxs := append(
+		sample.CustomCctxsInBlockRange(
+			t,
+			1,
+			999,
+			zetaChainID,
+			btcChainID,
+			coin.CoinType_Gas,
+			"",
+			1000,
+			types.CctxStatus_OutboundMined,
+		),
+		sample.CustomCctxsInBlockRange(
+			t,
+			1000,
+			1199,
+			zetaChainID,
+			btcChainID,
+			coin.CoinType_Gas,
+			"",
+			1000,
+			types.CctxStatus_PendingOutbound,
+		)...,
+	)
+	for _, cctx := range append(append([]*types.CrossChainTx{}, ethCctxs...), btcCctxs...) {
+		cctx.InboundParams.Sender = sender
+	}
+
+	// create rate limiter flags with chain, sender and asset quotas
+	flags := createTestRateLimiterFlags(
+		500,
+		math.NewUint(10*1e18),
+		zrc20ETH,
+		zrc20BTC,
+		zrc20USDT,
+		"2500",
+		"50000",
+		"0.8",
+	)
+	flags.ChainRates = []types.ChainRate{
+		{
+			ChainId: ethChainID,
+			Rate:    math.NewUint(3).Mul(math.NewUint(1e18)),
+		},
+	}
+	flags.SenderRate = math.NewUint(4).Mul(math.NewUint(1e18))
+	flags.AssetCaps = []types.AssetCap{
+		{
+			Zrc20: zrc20ETH,
+			Cap:   math.NewUint(1).Mul(math.NewUint(1e18)),
+		},
+	}
+
+	t.Run("should return the usage of the quotas within the window", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		zk.ObserverKeeper.SetTSS(ctx, tss)
+		setupForeignCoins(t, ctx, zk, zrc20ETH, zrc20BTC, zrc20USDT, sample.EthAddress().Hex())
+		k.SetRateLimiterFlags(ctx, *flags)
+
+		// set cctxs and pending nonces
+		setCctxsInKeeper(ctx, *k, zk, tss, ethCctxs)
+		zk.ObserverKeeper.SetPendingNonces(ctx, observertypes.PendingNonces{
+			ChainId:   ethChainID,
+			NonceLow:  1099,
+			NonceHigh: 1199,
+			Tss:       tss.TssPubkey,
+		})
+		setCctxsInKeeper(ctx, *k, zk, tss, btcCctxs)
+		zk.ObserverKeeper.SetPendingNonces(ctx, observertypes.PendingNonces{
+			ChainId:   btcChainID,
+			NonceLow:  1099,
+			NonceHigh: 1199,
+			Tss:       tss.TssPubkey,
+		})
+		zk.ObserverKeeper.SetPendingNonces(ctx, observertypes.PendingNonces{
+			ChainId: solanaChainID,
+			Tss:     tss.TssPubkey,
+		})
+		ctx = ctx.WithBlockHeight(1199)
+
+		// set the sender usages, the window of the second sender is over
+		k.SetRateLimiterSenderUsage(ctx, types.SenderUsage{
+			Sender:      strings.ToLower(sender),
+			WindowStart: 1000,
+			Used:        math.NewInt(1500).Mul(math.NewInt(1e18)),
+		})
+		k.SetRateLimiterSenderUsage(ctx, types.SenderUsage{
+			Sender:      strings.ToLower(sample.EthAddress().Hex()),
+			WindowStart: 600,
+			Used:        math.NewInt(1e18),
+		})
+
+		res, err := k.RateLimiterQuotaUsage(ctx, &types.QueryRateLimiterQuotaUsageRequest{})
+		require.NoError(t, err)
+
+		// the cctxs in height range [700, 1199] are counted, pending or not, the senders in their window are returned
+		require.Equal(t, &types.QueryRateLimiterQuotaUsageResponse{
+			Height: 1199,
+			Window: 500,
+			ChainUsages: []types.ChainQuotaUsage{
+				{
+					ChainId: ethChainID,
+					Used:    math.NewUint(1250).Mul(math.NewUint(1e18)), // 500 * 2.5 ZETA
+					Limit:   math.NewUint(1500).Mul(math.NewUint(1e18)), // 500 * 3 ZETA
+				},
+			},
+			SenderUsages: []types.SenderQuotaUsage{
+				{
+					Sender: strings.ToLower(sender),
+					Used:   math.NewUint(1500).Mul(math.NewUint(1e18)),
+					Limit:  math.NewUint(2000).Mul(math.NewUint(1e18)), // 500 * 4 ZETA
+				},
+			},
+			AssetUsages: []types.AssetQuotaUsage{
+				{
+					Zrc20: zrc20ETH,
+					Used:  math.NewUint(5e17), // 500 * 0.001 ETH
+					Cap:   math.NewUint(1).Mul(math.NewUint(1e18)),
+				},
+			},
+		}, res)
+	})
+}
+
+func TestKeeper_RateLimiterQuotaUsage_Errors(t *testing.T) {
+	t.Run("should fail for empty req", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		_, err := k.RateLimiterQuotaUsage(ctx, nil)
+		require.ErrorContains(t, err, "invalid request")
+	})
+
+	t.Run("rate limiter flags not found", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		_, err := k.RateLimiterQuotaUsage(ctx, &types.QueryRateLimiterQuotaUsageRequest{})
+		require.ErrorContains(t, err, "rate limiter flags not found")
+	})
+
+	t.Run("window must be positive", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		k.SetRateLimiterFlags(ctx, types.RateLimiterFlags{Window: 0, Rate: sdk.NewUint(1)})
+		_, err := k.RateLimiterQuotaUsage(ctx, &types.QueryRateLimiterQuotaUsageRequest{})
+		require.ErrorContains(t, err, "window must be positive")
+	})
+
+	t.Run("height out of range", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		k.SetRateLimiterFlags(ctx, sample.RateLimiterFlags())
+
+		// set current height to 0
+		ctx = ctx.WithBlockHeight(0)
+		_, err := k.RateLimiterQuotaUsage(ctx, &types.QueryRateLimiterQuotaUsageRequest{})
+		require.ErrorContains(t, err, "height out of range")
+	})
+
+	t.Run("tss not found", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		k.SetRateLimiterFlags(ctx, sample.RateLimiterFlags())
+
+		// no TSS set
+		_, err := k.RateLimiterQuotaUsage(ctx, &types.QueryRateLimiterQuotaUsageRequest{})
+		require.ErrorContains(t, err, "tss not found")
+	})
+
+	t.Run("pending nonces not found", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		k.SetRateLimiterFlags(ctx, sample.RateLimiterFlags())
+		zk.ObserverKeeper.SetTSS(ctx, sample.Tss())
+
+		_, err := k.RateLimiterQuotaUsage(ctx, &types.QueryRateLimiterQuotaUsageRequest{})
+		require.ErrorContains(t, err, "pending nonces not found")
+	})
+}
@@ -58,6 +58,11 @@ func (k msgServer) AddInboundTracker(
 
 // verifyProofAndInboundBody verifies the proof and inbound tx body
 func verifyProofAndInboundBody(ctx sdk.Context, k msgServer, msg *types.MsgAddInboundTracker) error {
+	// the contract-based deposits are verified from the gateway logs of the proven receipt
+	if msg.Proof.GetEthereumReceiptProof() != nil {
+		return verifyReceiptProofAndInboundLogs(ctx, k, msg)
+	}
+
 	txBytes, err := k.GetLightclientKeeper().VerifyProof(ctx, msg.Proof, msg.ChainId, msg.BlockHash, msg.TxIndex)
 	if err != nil {
 		return types.ErrProofVerificationFail.Wrap(err.Error())
@@ -84,3 +89,29 @@ func verifyProofAndInboundBody(ctx sdk.Context, k msgServer, msg *types.MsgAddIn
 
 	return nil
 }
+
+// verifyReceiptProofAndInboundLogs verifies the receipt proof and the inbound gateway logs of the receipt
+func verifyReceiptProofAndInboundLogs(ctx sdk.Context, k msgServer, msg *types.MsgAddInboundTracker) error {
+	txBytes, receiptBytes, err := k.GetLightclientKeeper().VerifyReceiptProof(
+		ctx,
+		msg.Proof,
+		msg.ChainId,
+		msg.BlockHash,
+		msg.TxIndex,
+	)
+	if err != nil {
+		return types.ErrProofVerificationFail.Wrap(err.Error())
+	}
+
+	// get chain params to verify the gateway logs
+	chainParams, found := k.GetObserverKeeper().GetChainParamsByChainID(ctx, msg.ChainId)
+	if !found || chainParams == nil {
+		return types.ErrUnsupportedChain.Wrapf("chain params not found for chain %d", msg.ChainId)
+	}
+
+	if err := types.VerifyInboundReceipt(*msg, txBytes, receiptBytes, *chainParams); err != nil {
+		return types.ErrTxBodyVerificationFail.Wrap(err.Error())
+	}
+
+	return nil
+}
@@ -2,10 +2,13 @@ package keeper_test
 
 import (
 	"errors"
+	"math/big"
 	"testing"
 
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/protocol-contracts/v2/pkg/gatewayevm.sol"
 
 	"github.com/zeta-chain/node/pkg/chains"
 	"github.com/zeta-chain/node/pkg/coin"
@@ -342,4 +345,133 @@ func TestMsgServer_AddToInboundTracker(t *testing.T) {
 		_, found := k.GetInboundTracker(ctx, chainID, txHash)
 		require.True(t, found)
 	})
+
+	t.Run("fail if receipt proof is provided but not verified", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeperWithMocks(t, keepertest.CrosschainMockOptions{
+			UseAuthorityMock:   true,
+			UseLightclientMock: true,
+			UseObserverMock:    true,
+		})
+		msgServer := keeper.NewMsgServerImpl(*k)
+
+		authorityMock := keepertest.GetCrosschainAuthorityMock(t, k)
+		observerMock := keepertest.GetCrosschainObserverMock(t, k)
+		lightclientMock := keepertest.GetCrosschainLightclientMock(t, k)
+
+		chainID := getValidEthChainID()
+
+		observerMock.On("GetSupportedChainFromChainID", mock.Anything, mock.Anything).Return(chains.Chain{}, true)
+		observerMock.On("IsNonTombstonedObserver", mock.Anything, mock.Anything).Return(false)
+		lightclientMock.On("VerifyReceiptProof", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return(nil, nil, errors.New("error"))
+
+		setSupportedChain(ctx, zk, chainID)
+
+		msg := types.MsgAddInboundTracker{
+			Creator:   sample.AccAddress(),
+			ChainId:   chainID,
+			TxHash:    sample.Hash().Hex(),
+			CoinType:  coin.CoinType_ERC20,
+			Proof:     proofs.NewEthereumReceiptProof(nil, nil),
+			BlockHash: "",
+			TxIndex:   0,
+		}
+		keepertest.MockCheckAuthorization(&authorityMock.Mock, &msg, authoritytypes.ErrUnauthorized)
+		_, err := msgServer.AddInboundTracker(ctx, &msg)
+		require.ErrorIs(t, err, types.ErrProofVerificationFail)
+	})
+
+	t.Run("fail if receipt proof is provided but gateway logs can't be verified", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeperWithMocks(t, keepertest.CrosschainMockOptions{
+			UseAuthorityMock:   true,
+			UseLightclientMock: true,
+			UseObserverMock:    true,
+		})
+		msgServer := keeper.NewMsgServerImpl(*k)
+
+		chainID := chains.Ethereum.ChainId
+		gateway := sample.EthAddress()
+		chainParams := sample.ChainParams(chainID)
+		chainParams.GatewayAddress = gateway.Hex()
+		ethTx, ethTxBytes := sample.EthTx(t, chainID, gateway, 42)
+
+		// a transaction emitting no gateway event
+		_, receiptBytes := sample.EthReceipt(t, ethtypes.ReceiptStatusSuccessful)
+
+		authorityMock := keepertest.GetCrosschainAuthorityMock(t, k)
+		observerMock := keepertest.GetCrosschainObserverMock(t, k)
+		lightclientMock := keepertest.GetCrosschainLightclientMock(t, k)
+
+		observerMock.On("GetSupportedChainFromChainID", mock.Anything, mock.Anything).Return(chains.Chain{}, true)
+		observerMock.On("IsNonTombstonedObserver", mock.Anything, mock.Anything).Return(false)
+		observerMock.On("GetChainParamsByChainID", mock.Anything, mock.Anything).Return(chainParams, true)
+		lightclientMock.On("VerifyReceiptProof", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return(ethTxBytes, receiptBytes, nil)
+
+		msg := types.MsgAddInboundTracker{
+			Creator:   sample.AccAddress(),
+			ChainId:   chainID,
+			TxHash:    ethTx.Hash().Hex(),
+			CoinType:  coin.CoinType_ERC20,
+			Proof:     proofs.NewEthereumReceiptProof(nil, nil),
+			BlockHash: "",
+			TxIndex:   0,
+		}
+		keepertest.MockCheckAuthorization(&authorityMock.Mock, &msg, authoritytypes.ErrUnauthorized)
+		_, err := msgServer.AddInboundTracker(ctx, &msg)
+		require.ErrorIs(t, err, types.ErrTxBodyVerificationFail)
+	})
+
+	t.Run("can add a in tx tracker with a receipt proof", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeperWithMocks(t, keepertest.CrosschainMockOptions{
+			UseAuthorityMock:   true,
+			UseLightclientMock: true,
+			UseObserverMock:    true,
+		})
+		msgServer := keeper.NewMsgServerImpl(*k)
+
+		chainID := chains.Ethereum.ChainId
+		gateway := sample.EthAddress()
+		chainParams := sample.ChainParams(chainID)
+		chainParams.GatewayAddress = gateway.Hex()
+		ethTx, ethTxBytes := sample.EthTx(t, chainID, gateway, 42)
+		txHash := ethTx.Hash().Hex()
+
+		// an ERC20 deposit through the gateway
+		depositLog := sample.EthGatewayLog(
+			t,
+			gateway,
+			"Deposited",
+			big.NewInt(42),
+			sample.EthAddress(),
+			[]byte{},
+			gatewayevm.RevertOptions{OnRevertGasLimit: big.NewInt(0)},
+		)
+		_, receiptBytes := sample.EthReceipt(t, ethtypes.ReceiptStatusSuccessful, depositLog)
+
+		authorityMock := keepertest.GetCrosschainAuthorityMock(t, k)
+		observerMock := keepertest.GetCrosschainObserverMock(t, k)
+		lightclientMock := keepertest.GetCrosschainLightclientMock(t, k)
+
+		observerMock.On("GetSupportedChainFromChainID", mock.Anything, mock.Anything).Return(chains.Chain{}, true)
+		observerMock.On("IsNonTombstonedObserver", mock.Anything, mock.Anything).Return(false)
+		observerMock.On("GetChainParamsByChainID", mock.Anything, mock.Anything).Return(chainParams, true)
+		lightclientMock.On("VerifyReceiptProof", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return(ethTxBytes, receiptBytes, nil)
+
+		msg := types.MsgAddInboundTracker{
+			Creator:   sample.AccAddress(),
+			ChainId:   chainID,
+			TxHash:    txHash,
+			CoinType:  coin.CoinType_ERC20,
+			Proof:     proofs.NewEthereumReceiptProof(nil, nil),
+			BlockHash: "",
+			TxIndex:   0,
+		}
+		keepertest.MockCheckAuthorization(&authorityMock.Mock, &msg, authoritytypes.ErrUnauthorized)
+		_, err := msgServer.AddInboundTracker(ctx, &msg)
+		require.NoError(t, err)
+		_, found := k.GetInboundTracker(ctx, chainID, txHash)
+		require.True(t, found)
+	})
 }
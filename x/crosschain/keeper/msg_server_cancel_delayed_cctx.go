@@ -0,0 +1,105 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+
+	"github.com/zeta-chain/node/pkg/chains"
+	"github.com/zeta-chain/node/pkg/coin"
+	authoritytypes "github.com/zeta-chain/node/x/authority/types"
+	"github.com/zeta-chain/node/x/crosschain/types"
+	observertypes "github.com/zeta-chain/node/x/observer/types"
+)
+
+// CancelDelayedCCTX cancels the outbound of a CCTX held back by the outbound delay
+// The CCTX is processed as a failed outbound, the funds are refunded to the revert address
+// The ZRC20 withdrawals from zEVM of protocol version 1 are not reverted as failed outbounds,
+// the withdrawn amount is refunded to the tx origin on ZetaChain instead
+// Authorized: emergency policy group
+func (k msgServer) CancelDelayedCCTX(
+	goCtx context.Context,
+	msg *types.MsgCancelDelayedCCTX,
+) (*types.MsgCancelDelayedCCTXResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// check if authorized
+	err := k.GetAuthorityKeeper().CheckAuthorization(ctx, msg)
+	if err != nil {
+		return nil, errors.Wrap(authoritytypes.ErrUnauthorized, err.Error())
+	}
+
+	// check if the cctx exists and is delayed
+	cctx, found := k.GetCrossChainTx(ctx, msg.CctxIndex)
+	if !found {
+		return nil, types.ErrCannotFindCctx
+	}
+	if cctx.CctxStatus.Status != types.CctxStatus_PendingDelay {
+		return nil, types.ErrStatusNotPendingDelay
+	}
+
+	tss, found := k.zetaObserverKeeper.GetTSS(ctx)
+	if !found {
+		return nil, observertypes.ErrTssNotFound
+	}
+
+	// process the cctx as a failed outbound, the cctx is aborted if the revert can't be processed
+	tmpCtx, commit := ctx.CacheContext()
+	if k.isZEVMZRC20WithdrawalV1(ctx, cctx) {
+		err = k.refundCancelledZRC20Withdrawal(tmpCtx, &cctx)
+	} else {
+		err = k.processFailedOutboundObservers(tmpCtx, &cctx, "")
+	}
+	if err != nil {
+		cctx.SetAbort("", errors.Wrap(err, "cancel delayed cctx").Error())
+	} else {
+		commit()
+	}
+
+	k.RemoveDelayedCctx(ctx, cctx.Index)
+	k.SetCctxAndNonceToCctxAndInboundHashToCctx(ctx, cctx, tss.TssPubkey)
+
+	return &types.MsgCancelDelayedCCTXResponse{}, nil
+}
+
+// isZEVMZRC20WithdrawalV1 returns true if the cctx is a withdrawal of a gas or ERC20 ZRC20 from zEVM of protocol
+// version 1, the failed outbounds of these withdrawals are aborted instead of reverted
+func (k Keeper) isZEVMZRC20WithdrawalV1(ctx sdk.Context, cctx types.CrossChainTx) bool {
+	return cctx.ProtocolContractVersion == types.ProtocolContractVersion_V1 &&
+		chains.IsZetaChain(cctx.InboundParams.SenderChainId, k.GetAuthorityKeeper().GetAdditionalChainList(ctx)) &&
+		(cctx.InboundParams.CoinType == coin.CoinType_Gas || cctx.InboundParams.CoinType == coin.CoinType_ERC20)
+}
+
+// refundCancelledZRC20Withdrawal refunds the ZRC20 amount burnt by a cancelled withdrawal to the tx origin
+// and sets the cctx as reverted
+func (k Keeper) refundCancelledZRC20Withdrawal(ctx sdk.Context, cctx *types.CrossChainTx) error {
+	outbound := cctx.GetCurrentOutboundParam()
+	zrc20, found := k.getZRC20FromAsset(
+		ctx,
+		cctx.InboundParams.CoinType,
+		cctx.InboundParams.Asset,
+		outbound.ReceiverChainId,
+	)
+	if !found {
+		return types.ErrForeignCoinNotFound
+	}
+	if !ethcommon.IsHexAddress(cctx.InboundParams.TxOrigin) {
+		return errors.Wrapf(types.ErrInvalidAddress, "invalid tx origin %s", cctx.InboundParams.TxOrigin)
+	}
+
+	_, err := k.fungibleKeeper.DepositZRC20(
+		ctx,
+		ethcommon.HexToAddress(zrc20),
+		ethcommon.HexToAddress(cctx.InboundParams.TxOrigin),
+		outbound.Amount.BigInt(),
+	)
+	if err != nil {
+		return errors.Wrap(err, "failed to refund zrc20 on ZetaChain")
+	}
+
+	outbound.TxFinalizationStatus = types.TxFinalizationStatus_Executed
+	cctx.SetReverted("", "delayed outbound cancelled, amount refunded to tx origin")
+	return nil
+}
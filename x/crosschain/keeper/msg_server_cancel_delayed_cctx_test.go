@@ -0,0 +1,202 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/node/pkg/chains"
+	"github.com/zeta-chain/node/pkg/coin"
+	keepertest "github.com/zeta-chain/node/testutil/keeper"
+	"github.com/zeta-chain/node/testutil/sample"
+	authoritytypes "github.com/zeta-chain/node/x/authority/types"
+	crosschainkeeper "github.com/zeta-chain/node/x/crosschain/keeper"
+	crosschaintypes "github.com/zeta-chain/node/x/crosschain/types"
+	fungibletypes "github.com/zeta-chain/node/x/fungible/types"
+)
+
+func TestMsgServer_CancelDelayedCCTX(t *testing.T) {
+	t.Run("can cancel a delayed cctx and create a revert tx", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeperWithMocks(t, keepertest.CrosschainMockOptions{
+			UseAuthorityMock: true,
+			UseFungibleMock:  true,
+			UseObserverMock:  true,
+		})
+
+		msgServer := crosschainkeeper.NewMsgServerImpl(*k)
+		admin := sample.AccAddress()
+		authorityMock := keepertest.GetCrosschainAuthorityMock(t, k)
+		fungibleMock := keepertest.GetCrosschainFungibleMock(t, k)
+		observerMock := keepertest.GetCrosschainObserverMock(t, k)
+		senderChain := getValidEthChain()
+		asset := ""
+
+		// create a delayed cctx
+		cctx := GetERC20Cctx(t, sample.EthAddress(), senderChain, asset, big.NewInt(42))
+		cctx.CctxStatus.Status = crosschaintypes.CctxStatus_PendingDelay
+		k.SetCrossChainTx(ctx, *cctx)
+		k.SetDelayedCctx(ctx, crosschaintypes.DelayedCctx{CctxIndex: cctx.Index, ReleaseHeight: 100})
+
+		// mock the creation of the revert tx
+		keepertest.MockGetChainListEmpty(&authorityMock.Mock)
+		keepertest.MockGetRevertGasLimitForERC20(fungibleMock, asset, senderChain, 100)
+		keepertest.MockPayGasAndUpdateCCTX(fungibleMock, observerMock, ctx, *k, senderChain, asset)
+		_ = keepertest.MockUpdateNonce(observerMock, senderChain)
+		observerMock.On("SetNonceToCctx", mock.Anything, mock.Anything).Return().Once()
+
+		// cancel the cctx
+		msg := crosschaintypes.NewMsgCancelDelayedCCTX(admin, cctx.Index)
+		keepertest.MockCheckAuthorization(&authorityMock.Mock, msg, nil)
+		_, err := msgServer.CancelDelayedCCTX(ctx, msg)
+		require.NoError(t, err)
+
+		cctxFound, found := k.GetCrossChainTx(ctx, cctx.Index)
+		require.True(t, found)
+		require.Equal(t, crosschaintypes.CctxStatus_PendingRevert, cctxFound.CctxStatus.Status)
+		require.Len(t, cctxFound.OutboundParams, 2)
+		require.Equal(t, senderChain.ChainId, cctxFound.GetCurrentOutboundParam().ReceiverChainId)
+		_, found = k.GetDelayedCctx(ctx, cctx.Index)
+		require.False(t, found)
+	})
+
+	t.Run("can cancel a delayed zrc20 withdrawal and refund the tx origin", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeperWithMocks(t, keepertest.CrosschainMockOptions{
+			UseAuthorityMock: true,
+			UseFungibleMock:  true,
+		})
+
+		msgServer := crosschainkeeper.NewMsgServerImpl(*k)
+		admin := sample.AccAddress()
+		authorityMock := keepertest.GetCrosschainAuthorityMock(t, k)
+		fungibleMock := keepertest.GetCrosschainFungibleMock(t, k)
+		zk.ObserverKeeper.SetTSS(ctx, sample.Tss())
+		zrc20 := sample.EthAddress()
+		txOrigin := sample.EthAddress()
+
+		// create a delayed withdrawal of gas from ZetaChain
+		cctx := sample.CrossChainTx(t, "cctx_index")
+		cctx.CctxStatus = &crosschaintypes.Status{Status: crosschaintypes.CctxStatus_PendingDelay}
+		cctx.ProtocolContractVersion = crosschaintypes.ProtocolContractVersion_V1
+		cctx.InboundParams.SenderChainId = chains.ZetaChainMainnet.ChainId
+		cctx.InboundParams.CoinType = coin.CoinType_Gas
+		cctx.InboundParams.TxOrigin = txOrigin.Hex()
+		k.SetCrossChainTx(ctx, *cctx)
+		k.SetDelayedCctx(ctx, crosschaintypes.DelayedCctx{CctxIndex: cctx.Index, ReleaseHeight: 100})
+
+		// mock the refund of the zrc20
+		keepertest.MockGetChainListEmpty(&authorityMock.Mock)
+		fungibleMock.On("GetGasCoinForForeignCoin", mock.Anything, cctx.GetCurrentOutboundParam().ReceiverChainId).
+			Return(fungibletypes.ForeignCoins{Zrc20ContractAddress: zrc20.Hex()}, true)
+		fungibleMock.On("DepositZRC20", mock.Anything, zrc20, txOrigin, cctx.GetCurrentOutboundParam().Amount.BigInt()).
+			Return(nil, nil).Once()
+
+		// cancel the cctx
+		msg := crosschaintypes.NewMsgCancelDelayedCCTX(admin, cctx.Index)
+		keepertest.MockCheckAuthorization(&authorityMock.Mock, msg, nil)
+		_, err := msgServer.CancelDelayedCCTX(ctx, msg)
+		require.NoError(t, err)
+
+		cctxFound, found := k.GetCrossChainTx(ctx, cctx.Index)
+		require.True(t, found)
+		require.Equal(t, crosschaintypes.CctxStatus_Reverted, cctxFound.CctxStatus.Status)
+		require.Equal(
+			t,
+			crosschaintypes.TxFinalizationStatus_Executed,
+			cctxFound.GetCurrentOutboundParam().TxFinalizationStatus,
+		)
+		_, found = k.GetDelayedCctx(ctx, cctx.Index)
+		require.False(t, found)
+		fungibleMock.AssertExpectations(t)
+	})
+
+	t.Run("can cancel a delayed zrc20 withdrawal and abort it if it can't be refunded", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeperWithMocks(t, keepertest.CrosschainMockOptions{
+			UseAuthorityMock: true,
+		})
+
+		msgServer := crosschainkeeper.NewMsgServerImpl(*k)
+		admin := sample.AccAddress()
+		authorityMock := keepertest.GetCrosschainAuthorityMock(t, k)
+		zk.ObserverKeeper.SetTSS(ctx, sample.Tss())
+
+		// create a delayed withdrawal of gas from ZetaChain
+		cctx := sample.CrossChainTx(t, "cctx_index")
+		cctx.CctxStatus = &crosschaintypes.Status{Status: crosschaintypes.CctxStatus_PendingDelay}
+		cctx.ProtocolContractVersion = crosschaintypes.ProtocolContractVersion_V1
+		cctx.InboundParams.SenderChainId = chains.ZetaChainMainnet.ChainId
+		cctx.InboundParams.CoinType = coin.CoinType_Gas
+		k.SetCrossChainTx(ctx, *cctx)
+		k.SetDelayedCctx(ctx, crosschaintypes.DelayedCctx{CctxIndex: cctx.Index, ReleaseHeight: 100})
+
+		// cancel the cctx
+		keepertest.MockGetChainListEmpty(&authorityMock.Mock)
+		msg := crosschaintypes.NewMsgCancelDelayedCCTX(admin, cctx.Index)
+		keepertest.MockCheckAuthorization(&authorityMock.Mock, msg, nil)
+		_, err := msgServer.CancelDelayedCCTX(ctx, msg)
+		require.NoError(t, err)
+
+		cctxFound, found := k.GetCrossChainTx(ctx, cctx.Index)
+		require.True(t, found)
+		require.Equal(t, crosschaintypes.CctxStatus_Aborted, cctxFound.CctxStatus.Status)
+		_, found = k.GetDelayedCctx(ctx, cctx.Index)
+		require.False(t, found)
+	})
+
+	t.Run("cannot cancel a cctx that is not delayed", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeperWithMocks(t, keepertest.CrosschainMockOptions{
+			UseAuthorityMock: true,
+		})
+
+		msgServer := crosschainkeeper.NewMsgServerImpl(*k)
+		admin := sample.AccAddress()
+		authorityMock := keepertest.GetCrosschainAuthorityMock(t, k)
+
+		// create a pending outbound cctx
+		cctx := sample.CrossChainTx(t, "cctx_index")
+		cctx.CctxStatus = &crosschaintypes.Status{Status: crosschaintypes.CctxStatus_PendingOutbound}
+		k.SetCrossChainTx(ctx, *cctx)
+
+		msg := crosschaintypes.NewMsgCancelDelayedCCTX(admin, cctx.Index)
+		keepertest.MockCheckAuthorization(&authorityMock.Mock, msg, nil)
+		_, err := msgServer.CancelDelayedCCTX(ctx, msg)
+		require.ErrorIs(t, err, crosschaintypes.ErrStatusNotPendingDelay)
+
+		cctxFound, found := k.GetCrossChainTx(ctx, cctx.Index)
+		require.True(t, found)
+		require.Equal(t, crosschaintypes.CctxStatus_PendingOutbound, cctxFound.CctxStatus.Status)
+	})
+
+	t.Run("cannot cancel a cctx if not authorized", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeperWithMocks(t, keepertest.CrosschainMockOptions{
+			UseAuthorityMock: true,
+		})
+
+		msgServer := crosschainkeeper.NewMsgServerImpl(*k)
+		authorityMock := keepertest.GetCrosschainAuthorityMock(t, k)
+
+		cctx := sample.CrossChainTx(t, "cctx_index")
+		cctx.CctxStatus = &crosschaintypes.Status{Status: crosschaintypes.CctxStatus_PendingDelay}
+		k.SetCrossChainTx(ctx, *cctx)
+
+		msg := crosschaintypes.NewMsgCancelDelayedCCTX(sample.AccAddress(), cctx.Index)
+		keepertest.MockCheckAuthorization(&authorityMock.Mock, msg, authoritytypes.ErrUnauthorized)
+		_, err := msgServer.CancelDelayedCCTX(ctx, msg)
+		require.ErrorIs(t, err, authoritytypes.ErrUnauthorized)
+	})
+
+	t.Run("cannot cancel a cctx if not found", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeperWithMocks(t, keepertest.CrosschainMockOptions{
+			UseAuthorityMock: true,
+		})
+
+		msgServer := crosschainkeeper.NewMsgServerImpl(*k)
+		authorityMock := keepertest.GetCrosschainAuthorityMock(t, k)
+
+		msg := crosschaintypes.NewMsgCancelDelayedCCTX(sample.AccAddress(), sample.GetCctxIndexFromString("cctx_index"))
+		keepertest.MockCheckAuthorization(&authorityMock.Mock, msg, nil)
+		_, err := msgServer.CancelDelayedCCTX(ctx, msg)
+		require.ErrorIs(t, err, crosschaintypes.ErrCannotFindCctx)
+	})
+}
@@ -0,0 +1,170 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/zeta-chain/node/pkg/chains"
+	"github.com/zeta-chain/node/pkg/crypto"
+	"github.com/zeta-chain/node/x/crosschain/types"
+	observertypes "github.com/zeta-chain/node/x/observer/types"
+)
+
+// ConsolidateUTXOs votes for the consolidation of the UTXOs of the TSS on a Bitcoin chain into a single output
+// The observers vote with the UTXOs they observe when the UTXO count or dust ratio goes over the chain params,
+// the votes not meeting the thresholds of the chain params are rejected. The ballot of a consolidation round is
+// identified by the TSS and the last consolidation of the chain. The cmd cctx of the consolidation is created once
+// the ballot is finalized, only one consolidation can be pending at a time for a chain, the index of the pending one
+// is returned otherwise
+//
+// Only observer validators are authorized to broadcast this message.
+func (k msgServer) ConsolidateUTXOs(
+	goCtx context.Context,
+	msg *types.MsgConsolidateUTXOs,
+) (*types.MsgConsolidateUTXOsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// check if authorized
+	if ok := k.GetObserverKeeper().IsNonTombstonedObserver(ctx, msg.Creator); !ok {
+		return nil, observertypes.ErrNotObserver
+	}
+
+	// check the chain is a supported Bitcoin chain with consolidation enabled
+	chain, found := k.GetObserverKeeper().GetSupportedChainFromChainID(ctx, msg.ChainId)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrUnsupportedChain, "chain id %d", msg.ChainId)
+	}
+	if !chain.IsBitcoinChain() {
+		return nil, errorsmod.Wrapf(types.ErrUTXOConsolidation, "chain %d is not a Bitcoin chain", msg.ChainId)
+	}
+	params, found := k.GetObserverKeeper().GetChainParamsByChainID(ctx, msg.ChainId)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrInvalidChainID, "chain params not found for chain id (%d)", msg.ChainId)
+	}
+	if !params.IsUTXOConsolidationEnabled() {
+		return nil, errorsmod.Wrapf(types.ErrUTXOConsolidation, "consolidation not enabled for chain %d", msg.ChainId)
+	}
+
+	// check the UTXOs observed meet the consolidation thresholds
+	if !params.IsUTXOConsolidationNeeded(msg.UtxoCount, msg.DustCount) {
+		return nil, errorsmod.Wrapf(
+			types.ErrUTXOConsolidation,
+			"consolidation not needed for %d UTXOs with %d dust UTXOs on chain %d",
+			msg.UtxoCount,
+			msg.DustCount,
+			msg.ChainId,
+		)
+	}
+
+	// get the current TSS
+	tss, found := k.GetObserverKeeper().GetTSS(ctx)
+	if !found {
+		return nil, errorsmod.Wrap(types.ErrCannotFindTSSKeys, "cannot find current TSS")
+	}
+
+	// return the pending consolidation if any, the observers can vote for it concurrently
+	lastConsolidation, _ := k.GetLastUTXOConsolidation(ctx, msg.ChainId)
+	if k.isPendingUTXOConsolidation(ctx, tss.TssPubkey, lastConsolidation) {
+		return &types.MsgConsolidateUTXOsResponse{
+			CctxIndex: lastConsolidation,
+		}, nil
+	}
+
+	// check the fee rate is within the consolidation window
+	medianGasPrice, priorityFee, isFound := k.GetMedianGasValues(ctx, msg.ChainId)
+	if !isFound {
+		return nil, errorsmod.Wrapf(
+			types.ErrUnableToGetGasPrice,
+			"median gas price not found for chain id (%d)",
+			msg.ChainId,
+		)
+	}
+	if params.UtxoConsolidationMaxFeeRate > 0 &&
+		medianGasPrice.GT(math.NewUint(params.UtxoConsolidationMaxFeeRate)) {
+		return nil, errorsmod.Wrapf(
+			types.ErrUTXOConsolidation,
+			"gas price %s above the consolidation max fee rate %d",
+			medianGasPrice,
+			params.UtxoConsolidationMaxFeeRate,
+		)
+	}
+
+	// vote on the consolidation round following the last consolidation
+	_, isFinalized, _, err := k.GetObserverKeeper().VoteOnBallot(
+		ctx,
+		chain,
+		msg.Digest(tss.TssPubkey, lastConsolidation),
+		observertypes.ObservationType_OutboundTx,
+		msg.Creator,
+		observertypes.VoteType_SuccessObservation,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if !isFinalized {
+		// return nil here to add vote to ballot and commit state
+		return &types.MsgConsolidateUTXOsResponse{}, nil
+	}
+
+	// the consolidated output is paid to the TSS address
+	bitcoinParams, err := chains.BitcoinNetParamsFromChainID(msg.ChainId)
+	if err != nil {
+		return nil, errorsmod.Wrapf(types.ErrUTXOConsolidation, "unable to get network params: %s", err.Error())
+	}
+	tssAddress, err := crypto.GetTssAddrBTC(tss.TssPubkey, bitcoinParams)
+	if err != nil {
+		return nil, errorsmod.Wrapf(types.ErrUTXOConsolidation, "unable to get TSS address: %s", err.Error())
+	}
+
+	// get the current TSS nonce allow to set a unique index for the CCTX
+	chainNonce, found := k.GetObserverKeeper().GetChainNonces(ctx, msg.ChainId)
+	if !found {
+		return nil, errorsmod.Wrap(types.ErrInvalidChainID, "cannot find current chain nonce")
+	}
+	currentNonce := chainNonce.Nonce
+
+	// create the CCTX that allows to sign the consolidation
+	cctx := types.ConsolidateUTXOsCmdCCTX(
+		msg.Creator,
+		tssAddress,
+		msg.ChainId,
+		medianGasPrice.String(),
+		priorityFee.String(),
+		tss.TssPubkey,
+		currentNonce,
+	)
+
+	// save the cctx
+	err = k.SetObserverOutboundInfo(ctx, msg.ChainId, &cctx)
+	if err != nil {
+		return nil, err
+	}
+	k.SetCctxAndNonceToCctxAndInboundHashToCctx(ctx, cctx, tss.TssPubkey)
+	k.SetLastUTXOConsolidation(ctx, msg.ChainId, cctx.Index)
+
+	err = ctx.EventManager().EmitTypedEvent(
+		&types.EventUTXOConsolidation{
+			ChainId:   msg.ChainId,
+			CctxIndex: cctx.Index,
+		},
+	)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "failed to emit event")
+	}
+
+	return &types.MsgConsolidateUTXOsResponse{
+		CctxIndex: cctx.Index,
+	}, nil
+}
+
+// isPendingUTXOConsolidation returns true if the consolidation cctx is a pending outbound of the TSS
+func (k Keeper) isPendingUTXOConsolidation(ctx sdk.Context, tssPubkey string, cctxIndex string) bool {
+	if cctxIndex == "" {
+		return false
+	}
+	cctx, found := k.GetCrossChainTx(ctx, cctxIndex)
+	return found && IsPending(&cctx) && cctx.GetCurrentOutboundParam().TssPubkey == tssPubkey
+}
@@ -0,0 +1,344 @@
+package keeper_test
+
+import (
+	"math/rand"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/node/pkg/coin"
+	"github.com/zeta-chain/node/pkg/constant"
+	keepertest "github.com/zeta-chain/node/testutil/keeper"
+	"github.com/zeta-chain/node/testutil/sample"
+	"github.com/zeta-chain/node/x/crosschain/keeper"
+	"github.com/zeta-chain/node/x/crosschain/types"
+	observertypes "github.com/zeta-chain/node/x/observer/types"
+)
+
+// setConsolidationState sets the observer state necessary to create a consolidation cctx for the chain
+// it returns the TSS of the consolidation
+func setConsolidationState(
+	t *testing.T,
+	k *keeper.Keeper,
+	ctx sdk.Context,
+	zk keepertest.ZetaKeepers,
+	chainID int64,
+	consolidationThreshold uint64,
+) observertypes.TSS {
+	tss := sample.Tss()
+	chainParams := sample.ChainParamsSupported(chainID)
+	chainParams.UtxoConsolidationThreshold = consolidationThreshold
+
+	zk.ObserverKeeper.SetChainNonces(ctx, observertypes.ChainNonces{ChainId: chainID})
+	zk.ObserverKeeper.SetPendingNonces(ctx, observertypes.PendingNonces{ChainId: chainID, Tss: tss.TssPubkey})
+	zk.ObserverKeeper.SetTSS(ctx, tss)
+	zk.ObserverKeeper.SetChainParamsList(ctx, observertypes.ChainParamsList{
+		ChainParams: []*observertypes.ChainParams{chainParams},
+	})
+	k.SetGasPrice(ctx, sample.GasPriceWithChainID(t, chainID))
+	return tss
+}
+
+func TestKeeper_ConsolidateUTXOs(t *testing.T) {
+	t.Run("can create CCTX to consolidate UTXOs once the ballot is finalized", func(t *testing.T) {
+		// ARRANGE
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		msgServer := keeper.NewMsgServerImpl(*k)
+		observers := setObservers(t, k, ctx, zk)
+		chainID := getValidBtcChainID()
+		tss := setConsolidationState(t, k, ctx, zk, chainID, 100)
+
+		msg := types.NewMsgConsolidateUTXOs(observers[0], chainID, 100, 0)
+
+		// ACT
+		res, err := msgServer.ConsolidateUTXOs(sdk.WrapSDKContext(ctx), msg)
+
+		// ASSERT
+		require.NoError(t, err)
+
+		cctx, found := k.GetCrossChainTx(ctx, res.CctxIndex)
+		require.True(t, found)
+		require.True(t, cctx.IsUTXOConsolidation())
+		require.Equal(t, coin.CoinType_Cmd, cctx.InboundParams.CoinType)
+		require.Equal(t, constant.CmdConsolidateUTXOs, cctx.RelayedMessage)
+		require.Len(t, cctx.OutboundParams, 1)
+		require.EqualValues(t, 0, cctx.GetCurrentOutboundParam().TssNonce)
+		require.True(t, cctx.GetCurrentOutboundParam().Amount.IsZero())
+
+		// nonce is consumed
+		chainNonce, found := zk.ObserverKeeper.GetChainNonces(ctx, chainID)
+		require.True(t, found)
+		require.EqualValues(t, 1, chainNonce.Nonce)
+
+		// the consolidation is the last one of the chain
+		lastConsolidation, found := k.GetLastUTXOConsolidation(ctx, chainID)
+		require.True(t, found)
+		require.Equal(t, res.CctxIndex, lastConsolidation)
+
+		// ballot is finalized
+		ballot, found := zk.ObserverKeeper.GetBallot(ctx, msg.Digest(tss.TssPubkey, ""))
+		require.True(t, found)
+		require.Equal(t, observertypes.BallotStatus_BallotFinalized_SuccessObservation, ballot.BallotStatus)
+	})
+
+	t.Run("should not create CCTX until the ballot is finalized", func(t *testing.T) {
+		// ARRANGE
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		msgServer := keeper.NewMsgServerImpl(*k)
+		observers := setObservers(t, k, ctx, zk)
+		chainID := getValidBtcChainID()
+		tss := setConsolidationState(t, k, ctx, zk, chainID, 100)
+
+		// the consolidation requires the votes of all observers
+		zk.ObserverKeeper.SetObserverSet(ctx, observertypes.ObserverSet{
+			ObserverList: append(observers, sample.AccAddress()),
+		})
+		chainParams, found := zk.ObserverKeeper.GetChainParamsByChainID(ctx, chainID)
+		require.True(t, found)
+		chainParams.BallotThreshold = sdk.OneDec()
+		zk.ObserverKeeper.SetChainParamsList(ctx, observertypes.ChainParamsList{
+			ChainParams: []*observertypes.ChainParams{chainParams},
+		})
+
+		msg := types.NewMsgConsolidateUTXOs(observers[0], chainID, 100, 0)
+
+		// ACT
+		res, err := msgServer.ConsolidateUTXOs(sdk.WrapSDKContext(ctx), msg)
+
+		// ASSERT
+		require.NoError(t, err)
+		require.Empty(t, res.CctxIndex)
+
+		// vote is added to the ballot
+		ballot, found := zk.ObserverKeeper.GetBallot(ctx, msg.Digest(tss.TssPubkey, ""))
+		require.True(t, found)
+		require.True(t, ballot.HasVoted(observers[0]))
+		require.Equal(t, observertypes.BallotStatus_BallotInProgress, ballot.BallotStatus)
+
+		// nonce is not consumed
+		chainNonce, found := zk.ObserverKeeper.GetChainNonces(ctx, chainID)
+		require.True(t, found)
+		require.EqualValues(t, 0, chainNonce.Nonce)
+	})
+
+	t.Run("should return the pending consolidation if any", func(t *testing.T) {
+		// ARRANGE
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		msgServer := keeper.NewMsgServerImpl(*k)
+		observers := setObservers(t, k, ctx, zk)
+		chainID := getValidBtcChainID()
+		setConsolidationState(t, k, ctx, zk, chainID, 100)
+
+		res, err := msgServer.ConsolidateUTXOs(
+			sdk.WrapSDKContext(ctx),
+			types.NewMsgConsolidateUTXOs(observers[0], chainID, 100, 0),
+		)
+		require.NoError(t, err)
+
+		// ACT
+		resPending, err := msgServer.ConsolidateUTXOs(
+			sdk.WrapSDKContext(ctx),
+			types.NewMsgConsolidateUTXOs(observers[0], chainID, 100, 0),
+		)
+
+		// ASSERT
+		require.NoError(t, err)
+		require.Equal(t, res.CctxIndex, resPending.CctxIndex)
+
+		// no new nonce is consumed
+		chainNonce, found := zk.ObserverKeeper.GetChainNonces(ctx, chainID)
+		require.True(t, found)
+		require.EqualValues(t, 1, chainNonce.Nonce)
+	})
+
+	t.Run("should add the votes to the same ballot if outbounds are scheduled between the votes", func(t *testing.T) {
+		// ARRANGE
+		k, ctx, sdkk, zk := keepertest.CrosschainKeeper(t)
+		msgServer := keeper.NewMsgServerImpl(*k)
+		chainID := getValidBtcChainID()
+		tss := setConsolidationState(t, k, ctx, zk, chainID, 100)
+
+		// the consolidation requires the votes of two observers
+		r := rand.New(rand.NewSource(42))
+		sdkk.StakingKeeper.SetValidator(ctx, sample.Validator(t, r))
+		sdkk.StakingKeeper.SetValidator(ctx, sample.Validator(t, r))
+		observers := setObservers(t, k, ctx, zk)
+		require.Len(t, observers, 2)
+		chainParams, found := zk.ObserverKeeper.GetChainParamsByChainID(ctx, chainID)
+		require.True(t, found)
+		chainParams.BallotThreshold = sdk.OneDec()
+		zk.ObserverKeeper.SetChainParamsList(ctx, observertypes.ChainParamsList{
+			ChainParams: []*observertypes.ChainParams{chainParams},
+		})
+
+		res, err := msgServer.ConsolidateUTXOs(
+			sdk.WrapSDKContext(ctx),
+			types.NewMsgConsolidateUTXOs(observers[0], chainID, 100, 0),
+		)
+		require.NoError(t, err)
+		require.Empty(t, res.CctxIndex)
+
+		// withdrawals are scheduled before the second vote
+		zk.ObserverKeeper.SetChainNonces(ctx, observertypes.ChainNonces{ChainId: chainID, Nonce: 3})
+		zk.ObserverKeeper.SetPendingNonces(ctx, observertypes.PendingNonces{
+			ChainId:   chainID,
+			Tss:       tss.TssPubkey,
+			NonceHigh: 3,
+		})
+
+		// ACT
+		msg := types.NewMsgConsolidateUTXOs(observers[1], chainID, 120, 10)
+		res, err = msgServer.ConsolidateUTXOs(sdk.WrapSDKContext(ctx), msg)
+
+		// ASSERT
+		require.NoError(t, err)
+		require.NotEmpty(t, res.CctxIndex)
+
+		cctx, found := k.GetCrossChainTx(ctx, res.CctxIndex)
+		require.True(t, found)
+		require.EqualValues(t, 3, cctx.GetCurrentOutboundParam().TssNonce)
+
+		ballot, found := zk.ObserverKeeper.GetBallot(ctx, msg.Digest(tss.TssPubkey, ""))
+		require.True(t, found)
+		require.True(t, ballot.HasVoted(observers[0]))
+		require.True(t, ballot.HasVoted(observers[1]))
+		require.Equal(t, observertypes.BallotStatus_BallotFinalized_SuccessObservation, ballot.BallotStatus)
+	})
+
+	t.Run("should start a new consolidation round once the last consolidation is processed", func(t *testing.T) {
+		// ARRANGE
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		msgServer := keeper.NewMsgServerImpl(*k)
+		observers := setObservers(t, k, ctx, zk)
+		chainID := getValidBtcChainID()
+		tss := setConsolidationState(t, k, ctx, zk, chainID, 100)
+
+		res, err := msgServer.ConsolidateUTXOs(
+			sdk.WrapSDKContext(ctx),
+			types.NewMsgConsolidateUTXOs(observers[0], chainID, 100, 0),
+		)
+		require.NoError(t, err)
+
+		cctx, found := k.GetCrossChainTx(ctx, res.CctxIndex)
+		require.True(t, found)
+		cctx.CctxStatus.Status = types.CctxStatus_OutboundMined
+		k.SetCrossChainTx(ctx, cctx)
+
+		// ACT
+		msg := types.NewMsgConsolidateUTXOs(observers[0], chainID, 100, 0)
+		resNext, err := msgServer.ConsolidateUTXOs(sdk.WrapSDKContext(ctx), msg)
+
+		// ASSERT
+		require.NoError(t, err)
+		require.NotEmpty(t, resNext.CctxIndex)
+		require.NotEqual(t, res.CctxIndex, resNext.CctxIndex)
+
+		ballot, found := zk.ObserverKeeper.GetBallot(ctx, msg.Digest(tss.TssPubkey, res.CctxIndex))
+		require.True(t, found)
+		require.Equal(t, observertypes.BallotStatus_BallotFinalized_SuccessObservation, ballot.BallotStatus)
+
+		lastConsolidation, found := k.GetLastUTXOConsolidation(ctx, chainID)
+		require.True(t, found)
+		require.Equal(t, resNext.CctxIndex, lastConsolidation)
+	})
+
+	t.Run("should fail if not observer", func(t *testing.T) {
+		// ARRANGE
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		msgServer := keeper.NewMsgServerImpl(*k)
+		chainID := getValidBtcChainID()
+		setConsolidationState(t, k, ctx, zk, chainID, 100)
+
+		// ACT
+		_, err := msgServer.ConsolidateUTXOs(
+			sdk.WrapSDKContext(ctx),
+			types.NewMsgConsolidateUTXOs(sample.AccAddress(), chainID, 100, 0),
+		)
+
+		// ASSERT
+		require.ErrorIs(t, err, observertypes.ErrNotObserver)
+	})
+
+	t.Run("should fail if not a Bitcoin chain", func(t *testing.T) {
+		// ARRANGE
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		msgServer := keeper.NewMsgServerImpl(*k)
+		observers := setObservers(t, k, ctx, zk)
+		chainID := getValidEthChainID()
+		setConsolidationState(t, k, ctx, zk, chainID, 0)
+
+		// ACT
+		_, err := msgServer.ConsolidateUTXOs(
+			sdk.WrapSDKContext(ctx),
+			types.NewMsgConsolidateUTXOs(observers[0], chainID, 100, 0),
+		)
+
+		// ASSERT
+		require.ErrorIs(t, err, types.ErrUTXOConsolidation)
+	})
+
+	t.Run("should fail if consolidation not enabled", func(t *testing.T) {
+		// ARRANGE
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		msgServer := keeper.NewMsgServerImpl(*k)
+		observers := setObservers(t, k, ctx, zk)
+		chainID := getValidBtcChainID()
+		setConsolidationState(t, k, ctx, zk, chainID, 0)
+
+		// ACT
+		_, err := msgServer.ConsolidateUTXOs(
+			sdk.WrapSDKContext(ctx),
+			types.NewMsgConsolidateUTXOs(observers[0], chainID, 100, 0),
+		)
+
+		// ASSERT
+		require.ErrorIs(t, err, types.ErrUTXOConsolidation)
+	})
+
+	t.Run("should fail if the UTXOs don't meet the consolidation thresholds", func(t *testing.T) {
+		// ARRANGE
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		msgServer := keeper.NewMsgServerImpl(*k)
+		observers := setObservers(t, k, ctx, zk)
+		chainID := getValidBtcChainID()
+		tss := setConsolidationState(t, k, ctx, zk, chainID, 100)
+
+		msg := types.NewMsgConsolidateUTXOs(observers[0], chainID, 99, 0)
+
+		// ACT
+		_, err := msgServer.ConsolidateUTXOs(sdk.WrapSDKContext(ctx), msg)
+
+		// ASSERT
+		require.ErrorIs(t, err, types.ErrUTXOConsolidation)
+		_, found := zk.ObserverKeeper.GetBallot(ctx, msg.Digest(tss.TssPubkey, ""))
+		require.False(t, found)
+	})
+
+	t.Run("should fail if the gas price is above the consolidation max fee rate", func(t *testing.T) {
+		// ARRANGE
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		msgServer := keeper.NewMsgServerImpl(*k)
+		observers := setObservers(t, k, ctx, zk)
+		chainID := getValidBtcChainID()
+		tss := setConsolidationState(t, k, ctx, zk, chainID, 100)
+
+		chainParams, found := zk.ObserverKeeper.GetChainParamsByChainID(ctx, chainID)
+		require.True(t, found)
+		chainParams.UtxoConsolidationMaxFeeRate = 1
+		zk.ObserverKeeper.SetChainParamsList(ctx, observertypes.ChainParamsList{
+			ChainParams: []*observertypes.ChainParams{chainParams},
+		})
+		k.SetGasPrice(ctx, types.GasPrice{ChainId: chainID, Prices: []uint64{2}, BlockNums: []uint64{1}})
+
+		msg := types.NewMsgConsolidateUTXOs(observers[0], chainID, 100, 0)
+
+		// ACT
+		_, err := msgServer.ConsolidateUTXOs(sdk.WrapSDKContext(ctx), msg)
+
+		// ASSERT
+		require.ErrorIs(t, err, types.ErrUTXOConsolidation)
+		_, found = zk.ObserverKeeper.GetBallot(ctx, msg.Digest(tss.TssPubkey, ""))
+		require.False(t, found)
+	})
+}
@@ -56,3 +56,23 @@ func (k Keeper) GetRateLimiterAssetRateList(
 	}
 	return flags, assetRates, true
 }
+
+// GetRateLimiterAssetCaps returns the asset caps of the rate limiter flags resolved to their foreign assets
+func (k Keeper) GetRateLimiterAssetCaps(ctx sdk.Context, flags types.RateLimiterFlags) []types.ForeignAssetCap {
+	assetCaps := make([]types.ForeignAssetCap, 0, len(flags.AssetCaps))
+	for _, assetCap := range flags.AssetCaps {
+		fCoin, found := k.fungibleKeeper.GetForeignCoins(ctx, assetCap.Zrc20)
+		if !found {
+			continue
+		}
+
+		assetCaps = append(assetCaps, types.ForeignAssetCap{
+			Zrc20:    assetCap.Zrc20,
+			ChainID:  fCoin.ForeignChainId,
+			Asset:    strings.ToLower(fCoin.Asset),
+			CoinType: fCoin.CoinType,
+			Cap:      assetCap.Cap,
+		})
+	}
+	return assetCaps
+}
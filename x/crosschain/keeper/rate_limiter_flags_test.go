@@ -1,8 +1,10 @@
 package keeper_test
 
 import (
+	"strings"
 	"testing"
 
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
@@ -67,7 +69,8 @@ func TestKeeper_GetRateLimiterAssetRateList(t *testing.T) {
 	zrc20ERC20Addr1 := sample.EthAddress().Hex()
 	zrc20ERC20Addr2 := sample.EthAddress().Hex()
 	testflags := types.RateLimiterFlags{
-		Rate: sdk.NewUint(100),
+		Rate:       sdk.NewUint(100),
+		SenderRate: sdkmath.ZeroUint(),
 		Conversions: []types.Conversion{
 			{
 				Zrc20: zrc20GasAddr,
@@ -135,3 +138,51 @@ func TestKeeper_GetRateLimiterAssetRateList(t *testing.T) {
 	require.Equal(t, testflags, flags)
 	require.EqualValues(t, []types.AssetRate{gasAssetRate, erc20AssetRate1, erc20AssetRate2}, assetRates)
 }
+
+func TestKeeper_GetRateLimiterAssetCaps(t *testing.T) {
+	k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+
+	// create foreign coins
+	zrc20ETH := sample.EthAddress().Hex()
+	zrc20BTC := sample.EthAddress().Hex()
+	zrc20USDT := sample.EthAddress().Hex()
+	assetUSDT := sample.EthAddress().Hex()
+	setupForeignCoins(t, ctx, zk, zrc20ETH, zrc20BTC, zrc20USDT, assetUSDT)
+
+	flags := types.RateLimiterFlags{
+		AssetCaps: []types.AssetCap{
+			{
+				Zrc20: zrc20ETH,
+				Cap:   sdk.NewUint(1000),
+			},
+			{
+				Zrc20: zrc20USDT,
+				Cap:   sdk.NewUint(2000),
+			},
+			{
+				// no foreign coin for this zrc20
+				Zrc20: sample.EthAddress().Hex(),
+				Cap:   sdk.NewUint(3000),
+			},
+		},
+	}
+
+	// unknown zrc20 should be skipped
+	assetCaps := k.GetRateLimiterAssetCaps(ctx, flags)
+	require.Equal(t, []types.ForeignAssetCap{
+		{
+			Zrc20:    zrc20ETH,
+			ChainID:  chains.GoerliLocalnet.ChainId,
+			Asset:    "",
+			CoinType: coin.CoinType_Gas,
+			Cap:      sdk.NewUint(1000),
+		},
+		{
+			Zrc20:    zrc20USDT,
+			ChainID:  chains.GoerliLocalnet.ChainId,
+			Asset:    strings.ToLower(assetUSDT),
+			CoinType: coin.CoinType_ERC20,
+			Cap:      sdk.NewUint(2000),
+		},
+	}, assetCaps)
+}
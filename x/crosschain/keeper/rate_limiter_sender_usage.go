@@ -0,0 +1,108 @@
+package keeper
+
+import (
+	"strings"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/zeta-chain/node/pkg/chains"
+	"github.com/zeta-chain/node/x/crosschain/types"
+)
+
+// SetRateLimiterSenderUsage sets the value withdrawn by a sender in its current window
+// The value is stored as the big endian window start height followed by the withdrawn amount
+func (k Keeper) SetRateLimiterSenderUsage(ctx sdk.Context, usage types.SenderUsage) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RateLimiterSenderUsageKeyPrefix))
+	used, err := usage.Used.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	// #nosec G115 always positive
+	store.Set([]byte(usage.Sender), append(sdk.Uint64ToBigEndian(uint64(usage.WindowStart)), used...))
+}
+
+// GetRateLimiterSenderUsage returns the value withdrawn by a sender in its current window
+func (k Keeper) GetRateLimiterSenderUsage(ctx sdk.Context, sender string) (types.SenderUsage, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RateLimiterSenderUsageKeyPrefix))
+	b := store.Get([]byte(sender))
+	if b == nil {
+		return types.SenderUsage{}, false
+	}
+	return unmarshalSenderUsage(sender, b), true
+}
+
+// GetAllRateLimiterSenderUsages returns the value withdrawn by each sender in its current window sorted by sender
+func (k Keeper) GetAllRateLimiterSenderUsages(ctx sdk.Context) (list []types.SenderUsage) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RateLimiterSenderUsageKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		list = append(list, unmarshalSenderUsage(string(iterator.Key()), iterator.Value()))
+	}
+	return list
+}
+
+// ChargeSenderRateLimit adds the value of a withdrawal cctx to the value withdrawn by its sender in the current window
+// It returns an error if the value withdrawn by the sender would exceed the sender quota of the rate limiter.
+// The sender quota is enforced when the cctx is created so the withdrawals above the quota are rejected instead of
+// holding back the outbounds of the receiver chain. The window of a sender starts at its first withdrawal following
+// the end of its previous window.
+func (k Keeper) ChargeSenderRateLimit(ctx sdk.Context, cctx *types.CrossChainTx) error {
+	flags, assetRates, found := k.GetRateLimiterAssetRateList(ctx)
+	if !found || !flags.Enabled || flags.Window <= 0 || flags.SenderRate.IsNil() || flags.SenderRate.IsZero() {
+		return nil
+	}
+
+	// only the withdrawals from zetachain to external chains are charged
+	additionalChains := k.GetAuthorityKeeper().GetAdditionalChainList(ctx)
+	receiverChainID := cctx.GetCurrentOutboundParam().ReceiverChainId
+	if !chains.IsZetaChain(cctx.InboundParams.SenderChainId, additionalChains) ||
+		chains.IsZetaChain(receiverChainID, additionalChains) {
+		return nil
+	}
+
+	gasAssetRateMap, erc20AssetRateMap := types.BuildAssetRateMapFromList(assetRates)
+	value := types.ConvertCctxValueToAzeta(receiverChainID, cctx, gasAssetRateMap, erc20AssetRateMap)
+	if !value.IsPositive() {
+		return nil
+	}
+
+	// start a new window if the previous window of the sender is over
+	sender := strings.ToLower(cctx.InboundParams.Sender)
+	height := ctx.BlockHeight()
+	usage, found := k.GetRateLimiterSenderUsage(ctx, sender)
+	if !found || usage.WindowStart+flags.Window <= height {
+		usage = types.SenderUsage{Sender: sender, WindowStart: height, Used: sdkmath.ZeroInt()}
+	}
+
+	limit := sdkmath.NewIntFromBigInt(flags.SenderRate.BigInt()).Mul(sdkmath.NewInt(flags.Window))
+	usage.Used = usage.Used.Add(value)
+	if usage.Used.GT(limit) {
+		return types.ErrSenderRateLimitExceeded.Wrapf(
+			"sender %s withdrew %s azeta in the window, limit %s",
+			sender,
+			usage.Used,
+			limit,
+		)
+	}
+
+	k.SetRateLimiterSenderUsage(ctx, usage)
+	return nil
+}
+
+// unmarshalSenderUsage decodes the value withdrawn by a sender from its store value
+func unmarshalSenderUsage(sender string, b []byte) types.SenderUsage {
+	used := sdkmath.ZeroInt()
+	if err := used.Unmarshal(b[8:]); err != nil {
+		panic(err)
+	}
+	return types.SenderUsage{
+		Sender: sender,
+		// #nosec G115 always in range
+		WindowStart: int64(sdk.BigEndianToUint64(b[:8])),
+		Used:        used,
+	}
+}
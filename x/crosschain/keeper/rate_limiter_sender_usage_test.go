@@ -0,0 +1,128 @@
+package keeper_test
+
+import (
+	"strings"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/node/pkg/chains"
+	"github.com/zeta-chain/node/pkg/coin"
+	keepertest "github.com/zeta-chain/node/testutil/keeper"
+	"github.com/zeta-chain/node/testutil/sample"
+	"github.com/zeta-chain/node/x/crosschain/types"
+)
+
+func TestKeeper_RateLimiterSenderUsage(t *testing.T) {
+	t.Run("should set and get sender usages", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		_, found := k.GetRateLimiterSenderUsage(ctx, "sender1")
+		require.False(t, found)
+
+		usage1 := types.SenderUsage{Sender: "sender1", WindowStart: 10, Used: sdkmath.NewInt(100)}
+		usage2 := types.SenderUsage{Sender: "sender2", WindowStart: 20, Used: sdkmath.NewInt(200)}
+		k.SetRateLimiterSenderUsage(ctx, usage2)
+		k.SetRateLimiterSenderUsage(ctx, usage1)
+
+		usage, found := k.GetRateLimiterSenderUsage(ctx, "sender1")
+		require.True(t, found)
+		require.Equal(t, usage1, usage)
+		require.Equal(t, []types.SenderUsage{usage1, usage2}, k.GetAllRateLimiterSenderUsages(ctx))
+	})
+}
+
+func TestKeeper_ChargeSenderRateLimit(t *testing.T) {
+	sender := sample.EthAddress().Hex()
+
+	// createWithdrawal creates a ZETA withdrawal cctx of the sender to Ethereum
+	createWithdrawal := func(t *testing.T, amount uint64) *types.CrossChainTx {
+		cctx := sample.CrossChainTx(t, sample.ZetaIndex(t))
+		cctx.InboundParams.Sender = sender
+		cctx.InboundParams.SenderChainId = chains.ZetaChainMainnet.ChainId
+		cctx.InboundParams.CoinType = coin.CoinType_Zeta
+		cctx.GetCurrentOutboundParam().ReceiverChainId = chains.Ethereum.ChainId
+		cctx.GetCurrentOutboundParam().Amount = sdkmath.NewUint(amount)
+		return cctx
+	}
+
+	// the sender can withdraw 100 azeta in a window of 10 blocks
+	flags := types.RateLimiterFlags{
+		Enabled:    true,
+		Window:     10,
+		Rate:       sdkmath.NewUint(1000),
+		SenderRate: sdkmath.NewUint(10),
+	}
+
+	t.Run("should charge withdrawals within the sender quota", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		k.SetRateLimiterFlags(ctx, flags)
+		ctx = ctx.WithBlockHeight(100)
+
+		require.NoError(t, k.ChargeSenderRateLimit(ctx, createWithdrawal(t, 60)))
+		require.NoError(t, k.ChargeSenderRateLimit(ctx.WithBlockHeight(109), createWithdrawal(t, 40)))
+
+		usage, found := k.GetRateLimiterSenderUsage(ctx, strings.ToLower(sender))
+		require.True(t, found)
+		require.EqualValues(t, 100, usage.WindowStart)
+		require.Equal(t, sdkmath.NewInt(100), usage.Used)
+	})
+
+	t.Run("should reject withdrawals exceeding the sender quota", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		k.SetRateLimiterFlags(ctx, flags)
+		ctx = ctx.WithBlockHeight(100)
+		require.NoError(t, k.ChargeSenderRateLimit(ctx, createWithdrawal(t, 60)))
+
+		err := k.ChargeSenderRateLimit(ctx, createWithdrawal(t, 41))
+		require.ErrorIs(t, err, types.ErrSenderRateLimitExceeded)
+
+		// the rejected withdrawal is not charged
+		usage, found := k.GetRateLimiterSenderUsage(ctx, strings.ToLower(sender))
+		require.True(t, found)
+		require.Equal(t, sdkmath.NewInt(60), usage.Used)
+	})
+
+	t.Run("should start a new window once the window of the sender is over", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		k.SetRateLimiterFlags(ctx, flags)
+		require.NoError(t, k.ChargeSenderRateLimit(ctx.WithBlockHeight(100), createWithdrawal(t, 100)))
+
+		require.NoError(t, k.ChargeSenderRateLimit(ctx.WithBlockHeight(110), createWithdrawal(t, 100)))
+
+		usage, found := k.GetRateLimiterSenderUsage(ctx, strings.ToLower(sender))
+		require.True(t, found)
+		require.EqualValues(t, 110, usage.WindowStart)
+		require.Equal(t, sdkmath.NewInt(100), usage.Used)
+	})
+
+	t.Run("should not charge if the sender quota is disabled", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		noSenderFlags := flags
+		noSenderFlags.SenderRate = sdkmath.ZeroUint()
+		k.SetRateLimiterFlags(ctx, noSenderFlags)
+
+		require.NoError(t, k.ChargeSenderRateLimit(ctx, createWithdrawal(t, 1000)))
+		require.Empty(t, k.GetAllRateLimiterSenderUsages(ctx))
+	})
+
+	t.Run("should not charge if the rate limiter is disabled", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		disabledFlags := flags
+		disabledFlags.Enabled = false
+		k.SetRateLimiterFlags(ctx, disabledFlags)
+
+		require.NoError(t, k.ChargeSenderRateLimit(ctx, createWithdrawal(t, 1000)))
+		require.Empty(t, k.GetAllRateLimiterSenderUsages(ctx))
+	})
+
+	t.Run("should not charge inbounds from external chains", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		k.SetRateLimiterFlags(ctx, flags)
+		cctx := createWithdrawal(t, 1000)
+		cctx.InboundParams.SenderChainId = chains.BitcoinMainnet.ChainId
+
+		require.NoError(t, k.ChargeSenderRateLimit(ctx, cctx))
+		require.Empty(t, k.GetAllRateLimiterSenderUsages(ctx))
+	})
+}
@@ -0,0 +1,26 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/zeta-chain/node/x/crosschain/types"
+)
+
+// SetLastUTXOConsolidation sets the index of the last UTXO consolidation cctx of a chain
+func (k Keeper) SetLastUTXOConsolidation(ctx sdk.Context, chainID int64, cctxIndex string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.LastUTXOConsolidationKeyPrefix))
+	// #nosec G115 always positive
+	store.Set(sdk.Uint64ToBigEndian(uint64(chainID)), []byte(cctxIndex))
+}
+
+// GetLastUTXOConsolidation returns the index of the last UTXO consolidation cctx of a chain
+func (k Keeper) GetLastUTXOConsolidation(ctx sdk.Context, chainID int64) (string, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.LastUTXOConsolidationKeyPrefix))
+	// #nosec G115 always positive
+	b := store.Get(sdk.Uint64ToBigEndian(uint64(chainID)))
+	if b == nil {
+		return "", false
+	}
+	return string(b), true
+}
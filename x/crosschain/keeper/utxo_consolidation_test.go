@@ -0,0 +1,29 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/node/pkg/chains"
+	keepertest "github.com/zeta-chain/node/testutil/keeper"
+	"github.com/zeta-chain/node/testutil/sample"
+)
+
+func TestKeeper_LastUTXOConsolidation(t *testing.T) {
+	t.Run("should set and get the last consolidation of each chain", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		_, found := k.GetLastUTXOConsolidation(ctx, chains.BitcoinMainnet.ChainId)
+		require.False(t, found)
+
+		index := sample.GetCctxIndexFromString("consolidation")
+		k.SetLastUTXOConsolidation(ctx, chains.BitcoinMainnet.ChainId, index)
+
+		lastConsolidation, found := k.GetLastUTXOConsolidation(ctx, chains.BitcoinMainnet.ChainId)
+		require.True(t, found)
+		require.Equal(t, index, lastConsolidation)
+
+		_, found = k.GetLastUTXOConsolidation(ctx, chains.BitcoinTestnet.ChainId)
+		require.False(t, found)
+	})
+}
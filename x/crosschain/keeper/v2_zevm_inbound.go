@@ -75,7 +75,8 @@ func (k Keeper) ProcessZEVMInboundV2(
 		}
 
 		// validate data of the withdrawal event
-		if err := k.validateZRC20Withdrawal(ctx, foreignCoin.ForeignChainId, value, receiver); err != nil {
+		isCrossChainCall := callEvent != nil || withdrawalAndCallEvent != nil
+		if err := k.validateZRC20Withdrawal(ctx, foreignCoin.ForeignChainId, value, receiver, isCrossChainCall); err != nil {
 			return err
 		}
 
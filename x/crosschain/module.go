@@ -172,7 +172,10 @@ func (am AppModule) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {
 
 	// iterate and update gas price for cctx that are pending for too long
 	// error is logged in the function
-	am.keeper.IterateAndUpdateCctxGasPrice(ctx, supportedChains, keeper.CheckAndUpdateCctxGasPrice)
+	am.keeper.IterateAndUpdateCctxGasPrice(ctx, supportedChains, keeper.DefaultCheckAndUpdateCctxGasPriceFuncs)
+
+	// schedule the outbounds of the delayed cctxs whose delay is over
+	am.keeper.ReleaseDelayedCctxs(ctx)
 }
 
 // EndBlock executes all ABCI EndBlock logic respective to the crosschain module. It
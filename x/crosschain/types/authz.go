@@ -13,6 +13,7 @@ func GetAllAuthzZetaclientTxTypes() []string {
 		sdk.MsgTypeURL(&MsgVoteInbound{}),
 		sdk.MsgTypeURL(&MsgVoteOutbound{}),
 		sdk.MsgTypeURL(&MsgAddOutboundTracker{}),
+		sdk.MsgTypeURL(&MsgConsolidateUTXOs{}),
 		sdk.MsgTypeURL(&observertypes.MsgVoteTSS{}),
 		sdk.MsgTypeURL(&observertypes.MsgVoteBlame{}),
 		sdk.MsgTypeURL(&observertypes.MsgVoteBlockHeader{}),
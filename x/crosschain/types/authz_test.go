@@ -13,6 +13,7 @@ func TestGetAllAuthzZetaclientTxTypes(t *testing.T) {
 		"/zetachain.zetacore.crosschain.MsgVoteInbound",
 		"/zetachain.zetacore.crosschain.MsgVoteOutbound",
 		"/zetachain.zetacore.crosschain.MsgAddOutboundTracker",
+		"/zetachain.zetacore.crosschain.MsgConsolidateUTXOs",
 		"/zetachain.zetacore.observer.MsgVoteTSS",
 		"/zetachain.zetacore.observer.MsgVoteBlame",
 		"/zetachain.zetacore.observer.MsgVoteBlockHeader"},
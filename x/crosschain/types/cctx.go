@@ -185,6 +185,11 @@ func (m CrossChainTx) SetPendingOutbound(statusMsg string) {
 	m.CctxStatus.UpdateStatusAndErrorMessages(CctxStatus_PendingOutbound, statusMsg, "")
 }
 
+// SetPendingDelay sets the CCTX status to PendingDelay with the given status message.
+func (m CrossChainTx) SetPendingDelay(statusMsg string) {
+	m.CctxStatus.UpdateStatusAndErrorMessages(CctxStatus_PendingDelay, statusMsg, "")
+}
+
 // SetOutboundMined sets the CCTX status to OutboundMined with the given error message.
 func (m CrossChainTx) SetOutboundMined(statusMsg string) {
 	m.CctxStatus.UpdateStatusAndErrorMessages(CctxStatus_OutboundMined, statusMsg, "")
@@ -126,6 +126,52 @@ func GetERC20CustodyPausingCmdCCTXIndexString(
 	return fmt.Sprintf("%s-%s-%d-%d", constant.CmdUpdateERC20CustodyPauseStatus, tssPubKey, nonce, chainID)
 }
 
+// ConsolidateUTXOsCmdCCTX returns a CCTX allowing to consolidate the Bitcoin UTXOs of the TSS into a single output
+// paid to the TSS address itself, the gas limit is the size of the consolidation with the maximum number of inputs
+func ConsolidateUTXOsCmdCCTX(
+	creator string,
+	tssAddress string,
+	chainID int64,
+	gasPrice string,
+	priorityFee string,
+	tssPubKey string,
+	currentNonce uint64,
+) CrossChainTx {
+	indexString := GetConsolidateUTXOsCmdCCTXIndexString(tssPubKey, currentNonce, chainID)
+	hash := crypto.Keccak256Hash([]byte(indexString))
+
+	return newCmdCCTX(
+		creator,
+		hash.Hex(),
+		constant.CmdConsolidateUTXOs,
+		creator,
+		hash.Hex(),
+		tssAddress,
+		chainID,
+		sdkmath.NewUint(0),
+		gas.BTCOutboundBytesMax,
+		gasPrice,
+		priorityFee,
+		tssPubKey,
+	)
+}
+
+// GetConsolidateUTXOsCmdCCTXIndexString returns the index string of the CCTX for consolidating the Bitcoin UTXOs of the TSS
+func GetConsolidateUTXOsCmdCCTXIndexString(
+	tssPubKey string,
+	nonce uint64,
+	chainID int64,
+) string {
+	return fmt.Sprintf("%s-%s-%d-%d", constant.CmdConsolidateUTXOs, tssPubKey, nonce, chainID)
+}
+
+// IsUTXOConsolidation returns true if the CCTX is an admin cmd CCTX consolidating the Bitcoin UTXOs of the TSS
+func (m CrossChainTx) IsUTXOConsolidation() bool {
+	return m.InboundParams != nil &&
+		m.InboundParams.CoinType == coin.CoinType_Cmd &&
+		m.RelayedMessage == constant.CmdConsolidateUTXOs
+}
+
 // WhitelistERC20CmdCCTX returns a CCTX allowing to whitelist an ERC20 token on an external chain
 func WhitelistERC20CmdCCTX(
 	creator string,
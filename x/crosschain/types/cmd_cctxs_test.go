@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	sdkmath "cosmossdk.io/math"
+	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/stretchr/testify/require"
 	"github.com/zeta-chain/node/pkg/chains"
 	"github.com/zeta-chain/node/pkg/coin"
@@ -272,6 +273,80 @@ func TestGetERC20CustodyPausingCmdCCTXIndecString(t *testing.T) {
 	})
 }
 
+func TestConsolidateUTXOsCmdCCTX(t *testing.T) {
+	t.Run("returns a new CCTX to consolidate the TSS UTXOs", func(t *testing.T) {
+		// ARRANGE
+		creator := sample.AccAddress()
+		tssAddress := sample.BtcAddressP2WPKH(t, &chaincfg.TestNet3Params)
+		chainID := chains.BitcoinTestnet.ChainId
+		gasPrice := "10"
+		priorityFee := "0"
+		tssPubKey := sample.PubKeyString()
+		currentNonce := uint64(1)
+
+		// ACT
+		cctx := types.ConsolidateUTXOsCmdCCTX(
+			creator,
+			tssAddress,
+			chainID,
+			gasPrice,
+			priorityFee,
+			tssPubKey,
+			currentNonce,
+		)
+		cctxDifferentNonce := types.ConsolidateUTXOsCmdCCTX(
+			creator,
+			tssAddress,
+			chainID,
+			gasPrice,
+			priorityFee,
+			tssPubKey,
+			currentNonce+1,
+		)
+
+		// ASSERT
+		require.NotEmpty(t, cctx.Index)
+		require.EqualValues(t, creator, cctx.Creator)
+		require.EqualValues(t, types.CctxStatus_PendingOutbound, cctx.CctxStatus.Status)
+		require.EqualValues(t, constant.CmdConsolidateUTXOs, cctx.RelayedMessage)
+		require.EqualValues(t, coin.CoinType_Cmd, cctx.InboundParams.CoinType)
+		require.Len(t, cctx.OutboundParams, 1)
+		require.EqualValues(t, tssAddress, cctx.OutboundParams[0].Receiver)
+		require.EqualValues(t, chainID, cctx.OutboundParams[0].ReceiverChainId)
+		require.EqualValues(t, sdkmath.NewUint(0), cctx.OutboundParams[0].Amount)
+		require.EqualValues(t, gasPrice, cctx.OutboundParams[0].GasPrice)
+		require.EqualValues(t, gas.BTCOutboundBytesMax, cctx.OutboundParams[0].CallOptions.GasLimit)
+		require.EqualValues(t, tssPubKey, cctx.OutboundParams[0].TssPubkey)
+		require.True(t, cctx.IsUTXOConsolidation())
+
+		// check nonce produces unique index
+		require.NotEqual(t, cctx.Index, cctxDifferentNonce.Index)
+	})
+}
+
+func TestCrossChainTx_IsUTXOConsolidation(t *testing.T) {
+	t.Run("returns false for other cmd CCTXs", func(t *testing.T) {
+		cctx := types.UpdateERC20CustodyPauseStatusCmdCCTX(
+			sample.AccAddress(),
+			sample.EthAddress().String(),
+			42,
+			true,
+			"100000",
+			"100000",
+			sample.PubKeyString(),
+			1,
+		)
+		require.False(t, cctx.IsUTXOConsolidation())
+	})
+
+	t.Run("returns false for non cmd CCTXs", func(t *testing.T) {
+		cctx := sample.CrossChainTx(t, "index")
+		cctx.InboundParams.CoinType = coin.CoinType_Gas
+		cctx.RelayedMessage = constant.CmdConsolidateUTXOs
+		require.False(t, cctx.IsUTXOConsolidation())
+	})
+}
+
 func TestWhitelistERC20CmdCCTX(t *testing.T) {
 	t.Run("returns a new CCTX for whitelisting ERC20 tokens", func(t *testing.T) {
 		// ARRANGE
@@ -19,6 +19,8 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUpdateTssAddress{}, "crosschain/UpdateTssAddress", nil)
 	cdc.RegisterConcrete(&MsgAbortStuckCCTX{}, "crosschain/AbortStuckCCTX", nil)
 	cdc.RegisterConcrete(&MsgUpdateRateLimiterFlags{}, "crosschain/UpdateRateLimiterFlags", nil)
+	cdc.RegisterConcrete(&MsgCancelDelayedCCTX{}, "crosschain/CancelDelayedCCTX", nil)
+	cdc.RegisterConcrete(&MsgConsolidateUTXOs{}, "crosschain/ConsolidateUTXOs", nil)
 
 	// legacy messages defined for backward compatibility
 	cdc.RegisterConcrete(&MsgAddToInTxTracker{}, "crosschain/AddToInTxTracker", nil)
@@ -42,6 +44,8 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgUpdateTssAddress{},
 		&MsgAbortStuckCCTX{},
 		&MsgUpdateRateLimiterFlags{},
+		&MsgCancelDelayedCCTX{},
+		&MsgConsolidateUTXOs{},
 
 		// legacy messages defined for backward compatibility
 		&MsgAddToInTxTracker{},
@@ -34,6 +34,7 @@ const (
 	CctxStatus_PendingRevert   CctxStatus = 4
 	CctxStatus_Reverted        CctxStatus = 5
 	CctxStatus_Aborted         CctxStatus = 6
+	CctxStatus_PendingDelay    CctxStatus = 7
 )
 
 var CctxStatus_name = map[int32]string{
@@ -43,6 +44,7 @@ var CctxStatus_name = map[int32]string{
 	4: "PendingRevert",
 	5: "Reverted",
 	6: "Aborted",
+	7: "PendingDelay",
 }
 
 var CctxStatus_value = map[string]int32{
@@ -52,6 +54,7 @@ var CctxStatus_value = map[string]int32{
 	"PendingRevert":   4,
 	"Reverted":        5,
 	"Aborted":         6,
+	"PendingDelay":    7,
 }
 
 func (x CctxStatus) String() string {
@@ -775,94 +778,94 @@ func init() {
 }
 
 var fileDescriptor_d4c1966807fb5cb2 = []byte{
-	// 1378 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0x4d, 0x6f, 0x1b, 0x37,
-	0x13, 0xf6, 0xda, 0xb2, 0x2c, 0x8d, 0x3e, 0xbc, 0xa6, 0x15, 0x67, 0xe3, 0x17, 0x51, 0xf4, 0xaa,
-	0x75, 0xa2, 0xb8, 0xb5, 0x84, 0x28, 0x40, 0x51, 0xf4, 0x66, 0x1b, 0x71, 0xe2, 0xb6, 0x89, 0x8d,
-	0x8d, 0x63, 0x20, 0x39, 0x74, 0x4b, 0xed, 0xd2, 0x12, 0x61, 0x69, 0xa9, 0x2e, 0x29, 0x43, 0x0a,
-	0x7a, 0xeb, 0xb9, 0x40, 0xff, 0x42, 0x81, 0x1e, 0xfa, 0x53, 0x72, 0xcc, 0xb1, 0xe8, 0x21, 0x0d,
-	0x92, 0x7f, 0xd0, 0x5f, 0x50, 0xf0, 0x4b, 0x1f, 0x81, 0x6b, 0xa7, 0x69, 0x4f, 0x22, 0x9f, 0x21,
-	0x9f, 0x99, 0x1d, 0xce, 0x33, 0xa4, 0xa0, 0xf9, 0x9c, 0x08, 0x1c, 0x76, 0x30, 0x8d, 0x1b, 0x6a,
-	0xc4, 0x12, 0xd2, 0x08, 0x13, 0xc6, 0xb9, 0xc6, 0xd4, 0x30, 0x50, 0xe3, 0x40, 0x0c, 0xeb, 0xfd,
-	0x84, 0x09, 0x86, 0xae, 0x8f, 0xf7, 0xd4, 0xed, 0x9e, 0xfa, 0x64, 0xcf, 0x7a, 0xa9, 0xcd, 0xda,
-	0x4c, 0xad, 0x6c, 0xc8, 0x91, 0xde, 0xb4, 0x7e, 0xf3, 0x1c, 0x47, 0xfd, 0xd3, 0x76, 0x23, 0x64,
-	0xd2, 0x0d, 0xa3, 0xb1, 0x5e, 0x57, 0xfd, 0x23, 0x05, 0x85, 0xfd, 0xb8, 0xc5, 0x06, 0x71, 0x74,
-	0x88, 0x13, 0xdc, 0xe3, 0x68, 0x0d, 0xd2, 0x9c, 0xc4, 0x11, 0x49, 0x3c, 0xa7, 0xe2, 0xd4, 0xb2,
-	0xbe, 0x99, 0xa1, 0x9b, 0xb0, 0xac, 0x47, 0x26, 0x3e, 0x1a, 0x79, 0xf3, 0x15, 0xa7, 0xb6, 0xe0,
-	0x17, 0x34, 0xbc, 0x2b, 0xd1, 0xfd, 0x08, 0xfd, 0x0f, 0xb2, 0x62, 0x18, 0xb0, 0x84, 0xb6, 0x69,
-	0xec, 0x2d, 0x28, 0x8a, 0x8c, 0x18, 0x1e, 0xa8, 0x39, 0xda, 0x81, 0xac, 0x74, 0x1e, 0x88, 0x51,
-	0x9f, 0x78, 0xa9, 0x8a, 0x53, 0x2b, 0x36, 0x37, 0xea, 0xe7, 0x7c, 0x5f, 0xff, 0xb4, 0x5d, 0x57,
-	0x51, 0xee, 0x32, 0x1a, 0x1f, 0x8d, 0xfa, 0xc4, 0xcf, 0x84, 0x66, 0x84, 0x4a, 0xb0, 0x88, 0x39,
-	0x27, 0xc2, 0x5b, 0x54, 0xe4, 0x7a, 0x82, 0xee, 0x43, 0x1a, 0xf7, 0xd8, 0x20, 0x16, 0x5e, 0x5a,
-	0xc2, 0x3b, 0x8d, 0x17, 0xaf, 0x6e, 0xcc, 0xfd, 0xfe, 0xea, 0xc6, 0xad, 0x36, 0x15, 0x9d, 0x41,
-	0xab, 0x1e, 0xb2, 0x5e, 0x23, 0x64, 0xbc, 0xc7, 0xb8, 0xf9, 0xd9, 0xe2, 0xd1, 0x69, 0x43, 0xc6,
-	0xc1, 0xeb, 0x4f, 0x68, 0x2c, 0x7c, 0xb3, 0x1d, 0x7d, 0x04, 0x05, 0xd6, 0xe2, 0x24, 0x39, 0x23,
-	0x51, 0xd0, 0xc1, 0xbc, 0xe3, 0x2d, 0x29, 0x37, 0x79, 0x0b, 0x3e, 0xc0, 0xbc, 0x83, 0x3e, 0x07,
-	0x6f, 0xbc, 0x88, 0x0c, 0x05, 0x49, 0x62, 0xdc, 0x0d, 0x3a, 0x84, 0xb6, 0x3b, 0xc2, 0xcb, 0x54,
-	0x9c, 0x5a, 0xca, 0x5f, 0xb3, 0xf6, 0x7b, 0xc6, 0xfc, 0x40, 0x59, 0xd1, 0xff, 0x21, 0xdf, 0xc2,
-	0xdd, 0x2e, 0x13, 0x01, 0x8d, 0x23, 0x32, 0xf4, 0xb2, 0x8a, 0x3d, 0xa7, 0xb1, 0x7d, 0x09, 0xa1,
-	0x26, 0x5c, 0x39, 0xa1, 0x31, 0xee, 0xd2, 0xe7, 0x24, 0x0a, 0x64, 0x4a, 0x2c, 0x33, 0x28, 0xe6,
-	0xd5, 0xb1, 0xf1, 0x19, 0x11, 0xd8, 0xd0, 0x52, 0x58, 0x13, 0xc3, 0xc0, 0x58, 0xb0, 0xa0, 0x2c,
-	0x0e, 0xb8, 0xc0, 0x62, 0xc0, 0xbd, 0x9c, 0xca, 0xf2, 0xdd, 0xfa, 0x85, 0x55, 0x54, 0x3f, 0x1a,
-	0xee, 0x4d, 0xed, 0x7d, 0xac, 0xb6, 0xfa, 0x25, 0x71, 0x0e, 0x8a, 0xb6, 0x60, 0x95, 0xf2, 0x60,
-	0xba, 0x54, 0x43, 0xdc, 0xed, 0x7a, 0xf9, 0x8a, 0x53, 0xcb, 0xf8, 0x2e, 0xe5, 0xbb, 0xd2, 0xa2,
-	0xaa, 0x61, 0x17, 0x77, 0xbb, 0xd5, 0xef, 0xa0, 0x28, 0xe3, 0xdc, 0x0e, 0x43, 0x99, 0x5e, 0x1a,
-	0xb7, 0x51, 0x00, 0xab, 0xb8, 0xc5, 0x12, 0x61, 0xbf, 0xce, 0x9c, 0x9b, 0xf3, 0x61, 0xe7, 0xb6,
-	0x62, 0xb8, 0x94, 0x13, 0xc5, 0x54, 0x3d, 0x86, 0x9c, 0x74, 0x7d, 0xd0, 0x97, 0x51, 0x73, 0x59,
-	0x91, 0x6d, 0xcc, 0x83, 0x2e, 0xed, 0x51, 0xed, 0x25, 0xe5, 0x67, 0xda, 0x98, 0x7f, 0x2d, 0xe7,
-	0x68, 0x13, 0x56, 0x28, 0x0f, 0x70, 0xd2, 0xa2, 0x22, 0xc1, 0xc9, 0x48, 0x7f, 0xcb, 0xbc, 0xfa,
-	0x96, 0x65, 0xca, 0xb7, 0x2d, 0xae, 0x3e, 0xe5, 0x75, 0x1a, 0x8a, 0x07, 0x03, 0x31, 0xad, 0x96,
-	0x75, 0xc8, 0x24, 0x24, 0x24, 0xf4, 0x6c, 0xac, 0x97, 0xf1, 0x1c, 0xdd, 0x06, 0xd7, 0x8e, 0x75,
-	0xa2, 0xf6, 0xad, 0x64, 0x96, 0x2d, 0x6e, 0x45, 0x33, 0xa3, 0x8b, 0x85, 0x0f, 0xd3, 0xc5, 0x44,
-	0x01, 0xa9, 0x7f, 0xa7, 0x00, 0xa9, 0x60, 0xce, 0x83, 0x98, 0xc5, 0x21, 0x51, 0x22, 0x4b, 0xf9,
-	0x19, 0xc1, 0xf9, 0x23, 0x39, 0x9f, 0x4d, 0x66, 0xfa, 0x9d, 0x64, 0x1a, 0x63, 0x3f, 0xa1, 0x21,
-	0x31, 0xba, 0x91, 0xc6, 0x43, 0x39, 0x47, 0x35, 0x70, 0x8d, 0x91, 0x25, 0x54, 0x8c, 0x82, 0x13,
-	0x42, 0xbc, 0xab, 0x6a, 0x4d, 0x51, 0xaf, 0x51, 0xf0, 0x1e, 0x21, 0x08, 0x41, 0x4a, 0x29, 0x2f,
-	0xa3, 0xac, 0x6a, 0xfc, 0x3e, 0xba, 0xb9, 0x48, 0x94, 0x70, 0xa1, 0x28, 0xaf, 0x81, 0x0c, 0x33,
-	0x18, 0x70, 0x12, 0x79, 0x25, 0xb5, 0x72, 0xa9, 0x8d, 0xf9, 0x13, 0x4e, 0x22, 0xf4, 0x0d, 0xac,
-	0x92, 0x93, 0x13, 0x12, 0x0a, 0x7a, 0x46, 0x82, 0xc9, 0xc7, 0x5d, 0x51, 0x29, 0xae, 0x9b, 0x14,
-	0xdf, 0x7c, 0x8f, 0x14, 0xef, 0xcb, 0x5a, 0x1d, 0x53, 0xdd, 0xb7, 0x59, 0xa9, 0xbf, 0xcb, 0xaf,
-	0x33, 0xbb, 0xa6, 0xa2, 0x98, 0x59, 0xaf, 0x53, 0x7c, 0x1d, 0x40, 0x1e, 0x4e, 0x7f, 0xd0, 0x3a,
-	0x25, 0x23, 0x25, 0xee, 0xac, 0x2f, 0x8f, 0xeb, 0x50, 0x01, 0x17, 0xf4, 0x81, 0xfc, 0x7f, 0xdd,
-	0x07, 0x1e, 0x42, 0x5e, 0x8a, 0x25, 0x60, 0x5a, 0x66, 0x9e, 0x57, 0x71, 0x6a, 0xb9, 0xe6, 0xe6,
-	0x25, 0x0e, 0xa6, 0x84, 0xe9, 0xe7, 0xc2, 0xc9, 0xe4, 0xcb, 0x54, 0xa6, 0xe0, 0x96, 0xaa, 0x3f,
-	0xcf, 0x43, 0xda, 0xf0, 0x6f, 0x43, 0xda, 0x84, 0xee, 0xa8, 0xd0, 0x6f, 0x5f, 0xc6, 0x1c, 0x8a,
-	0xa1, 0x09, 0xd8, 0x6c, 0x44, 0x1b, 0x50, 0xd4, 0xa3, 0xa0, 0x47, 0x38, 0xc7, 0x6d, 0xa2, 0xf4,
-	0x97, 0xf5, 0x0b, 0x1a, 0x7d, 0xa8, 0x41, 0xd9, 0xf2, 0x49, 0x92, 0xb0, 0x64, 0xbc, 0x2a, 0xad,
-	0x5b, 0xbe, 0x02, 0xed, 0xa2, 0x3b, 0x50, 0xea, 0x62, 0x2e, 0x9e, 0xf4, 0x23, 0x2c, 0x48, 0x20,
-	0x68, 0x8f, 0x70, 0x81, 0x7b, 0x7d, 0xa5, 0xd6, 0x05, 0x7f, 0x75, 0x62, 0x3b, 0xb2, 0x26, 0x54,
-	0x03, 0xd9, 0x42, 0x64, 0x7b, 0xf2, 0xc9, 0xc9, 0x20, 0x8e, 0x48, 0xa4, 0xa4, 0xa9, 0x3b, 0xcb,
-	0x34, 0x8c, 0x3e, 0x81, 0x95, 0x30, 0x21, 0x58, 0xb6, 0xc4, 0x09, 0xf3, 0xa2, 0x62, 0x76, 0x8d,
-	0x61, 0x4c, 0x5b, 0xfd, 0x61, 0x1e, 0x0a, 0x3e, 0x39, 0x23, 0x89, 0xb0, 0x1d, 0x6e, 0x03, 0x8a,
-	0x89, 0x02, 0x02, 0x1c, 0x45, 0x09, 0xe1, 0xdc, 0xf4, 0xa2, 0x82, 0x46, 0xb7, 0x35, 0x88, 0x3e,
-	0x86, 0xa2, 0x3e, 0xb1, 0x38, 0xd0, 0x06, 0xd3, 0xe8, 0xd4, 0x39, 0x1e, 0xc4, 0x9a, 0x53, 0x66,
-	0x43, 0xb5, 0xd4, 0x31, 0x97, 0xbe, 0xc4, 0xf3, 0x0a, 0xb4, 0x54, 0x13, 0x8f, 0x36, 0x67, 0xf2,
-	0xcb, 0xf2, 0xd6, 0xa3, 0x4d, 0xda, 0x53, 0xd9, 0x02, 0xd5, 0xb2, 0x49, 0x69, 0x2f, 0x7e, 0x58,
-	0x77, 0x32, 0xfe, 0xac, 0x10, 0xaa, 0x3f, 0x2e, 0x42, 0x7e, 0x72, 0xd5, 0x1c, 0x0d, 0x91, 0x07,
-	0x4b, 0x2a, 0x55, 0xcc, 0x76, 0x62, 0x3b, 0x95, 0x2f, 0x06, 0xdd, 0x34, 0xf4, 0xe9, 0xeb, 0x09,
-	0xfa, 0x16, 0xb2, 0xea, 0xfa, 0x39, 0x21, 0x84, 0x9b, 0xa0, 0x76, 0xff, 0x61, 0x50, 0x7f, 0xbe,
-	0xba, 0xe1, 0x8e, 0x70, 0xaf, 0xfb, 0x45, 0x75, 0xcc, 0x54, 0xf5, 0x33, 0x72, 0xbc, 0x47, 0x08,
-	0x47, 0xb7, 0x60, 0x39, 0x21, 0x5d, 0x3c, 0x22, 0xd1, 0x3b, 0x95, 0x55, 0x34, 0xb0, 0x4d, 0xd3,
-	0x1e, 0xe4, 0xc2, 0x50, 0x0c, 0xad, 0x54, 0x33, 0x4a, 0x49, 0x1b, 0x97, 0xd4, 0xbb, 0xa9, 0x75,
-	0x08, 0xc7, 0x75, 0x8f, 0x1e, 0x43, 0x91, 0xea, 0xc7, 0x5c, 0xd0, 0x57, 0xf7, 0x93, 0x6a, 0x93,
-	0xb9, 0xe6, 0xa7, 0x97, 0x50, 0xcd, 0xbc, 0x00, 0xfd, 0x02, 0x9d, 0x79, 0x10, 0x1e, 0xc3, 0x32,
-	0x33, 0x97, 0x9e, 0x65, 0x85, 0xca, 0x42, 0x2d, 0xd7, 0xdc, 0xba, 0x84, 0x75, 0xf6, 0xaa, 0xf4,
-	0x8b, 0x6c, 0xf6, 0xea, 0x4c, 0xe0, 0x9a, 0x7a, 0x83, 0x86, 0xac, 0x1b, 0x84, 0x2c, 0x16, 0x09,
-	0x0e, 0x45, 0x70, 0x46, 0x12, 0x4e, 0x59, 0x6c, 0x5e, 0x2d, 0x9f, 0x5d, 0xe2, 0xe1, 0xd0, 0xec,
-	0xdf, 0x35, 0xdb, 0x8f, 0xf5, 0x6e, 0xff, 0x6a, 0xff, 0x7c, 0x03, 0x7a, 0x3a, 0x2e, 0x5b, 0xdb,
-	0xb5, 0xf2, 0xef, 0x95, 0xa0, 0x19, 0xb9, 0xed, 0xa4, 0x64, 0x99, 0xd8, 0x52, 0x37, 0xe0, 0xe6,
-	0xf7, 0x00, 0x93, 0x0e, 0x84, 0x10, 0x14, 0x0f, 0x49, 0x1c, 0xd1, 0xb8, 0x6d, 0x72, 0xeb, 0xce,
-	0xa1, 0x55, 0x58, 0x36, 0x98, 0xcd, 0x8c, 0xeb, 0xa0, 0x15, 0x28, 0xd8, 0xd9, 0x43, 0x1a, 0x93,
-	0xc8, 0x5d, 0x90, 0x90, 0x59, 0xa7, 0xdd, 0xba, 0x29, 0x94, 0x87, 0x8c, 0x1e, 0x93, 0xc8, 0x5d,
-	0x44, 0x39, 0x58, 0xda, 0xd6, 0x8f, 0x1e, 0x37, 0xbd, 0x9e, 0xfa, 0xf5, 0x97, 0xb2, 0xb3, 0xf9,
-	0x15, 0x94, 0xce, 0x6b, 0xdd, 0xc8, 0x85, 0xfc, 0x23, 0x26, 0xf6, 0xec, 0x8b, 0xd1, 0x9d, 0x43,
-	0x05, 0xc8, 0x4e, 0xa6, 0x8e, 0x64, 0xbe, 0x37, 0x24, 0xe1, 0x40, 0x92, 0xcd, 0x1b, 0xb2, 0x06,
-	0x5c, 0xfd, 0x9b, 0xcc, 0xa2, 0x34, 0xcc, 0x1f, 0xdf, 0x71, 0xe7, 0xd4, 0x6f, 0xd3, 0x75, 0xf4,
-	0x86, 0x9d, 0xfb, 0x2f, 0xde, 0x94, 0x9d, 0x97, 0x6f, 0xca, 0xce, 0xeb, 0x37, 0x65, 0xe7, 0xa7,
-	0xb7, 0xe5, 0xb9, 0x97, 0x6f, 0xcb, 0x73, 0xbf, 0xbd, 0x2d, 0xcf, 0x3d, 0xdb, 0x9a, 0x52, 0x92,
-	0x4c, 0xec, 0x96, 0xfe, 0x4f, 0x12, 0xb3, 0x88, 0x34, 0x86, 0xd3, 0x7f, 0x7d, 0x94, 0xa8, 0x5a,
-	0x69, 0x75, 0x70, 0x77, 0xff, 0x0a, 0x00, 0x00, 0xff, 0xff, 0xa1, 0xdc, 0x72, 0x48, 0x28, 0x0d,
-	0x00, 0x00,
+	// 1382 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xcd, 0x6e, 0x1b, 0xb7,
+	0x16, 0xf6, 0xd8, 0xb2, 0x2c, 0x1d, 0xfd, 0x78, 0x4c, 0x2b, 0xce, 0xc4, 0x17, 0x51, 0x74, 0x75,
+	0xaf, 0x13, 0xc5, 0xad, 0x25, 0x44, 0x01, 0x8a, 0xa2, 0x3b, 0xdb, 0x8d, 0x13, 0xb7, 0x4d, 0x6c,
+	0x4c, 0x1c, 0x03, 0xc9, 0xa2, 0x53, 0x6a, 0x86, 0x96, 0x08, 0x4b, 0x43, 0x75, 0x48, 0x19, 0x52,
+	0xb6, 0x5d, 0xb7, 0xe8, 0x2b, 0x14, 0xe8, 0xa2, 0x8f, 0x92, 0x65, 0x96, 0x45, 0x17, 0x69, 0x90,
+	0xbc, 0x41, 0x9f, 0xa0, 0xe0, 0x9f, 0x7e, 0x02, 0xd7, 0x4e, 0xd3, 0xae, 0xc4, 0xf3, 0x1d, 0xf2,
+	0x3b, 0xe4, 0xe1, 0xf9, 0xce, 0x50, 0xd0, 0x7c, 0x4e, 0x04, 0x0e, 0x3b, 0x98, 0xc6, 0x0d, 0x35,
+	0x62, 0x09, 0x69, 0x84, 0x09, 0xe3, 0x5c, 0x63, 0x6a, 0x18, 0xa8, 0x71, 0x20, 0x86, 0xf5, 0x7e,
+	0xc2, 0x04, 0x43, 0xd7, 0xc7, 0x6b, 0xea, 0x76, 0x4d, 0x7d, 0xb2, 0x66, 0xbd, 0xd4, 0x66, 0x6d,
+	0xa6, 0x66, 0x36, 0xe4, 0x48, 0x2f, 0x5a, 0xbf, 0x79, 0x4e, 0xa0, 0xfe, 0x69, 0xbb, 0x11, 0x32,
+	0x19, 0x86, 0xd1, 0x58, 0xcf, 0xab, 0xfe, 0x9e, 0x82, 0xc2, 0x7e, 0xdc, 0x62, 0x83, 0x38, 0x3a,
+	0xc4, 0x09, 0xee, 0x71, 0xb4, 0x06, 0x69, 0x4e, 0xe2, 0x88, 0x24, 0x9e, 0x53, 0x71, 0x6a, 0x59,
+	0xdf, 0x58, 0xe8, 0x26, 0x2c, 0xeb, 0x91, 0xd9, 0x1f, 0x8d, 0xbc, 0xf9, 0x8a, 0x53, 0x5b, 0xf0,
+	0x0b, 0x1a, 0xde, 0x95, 0xe8, 0x7e, 0x84, 0xfe, 0x03, 0x59, 0x31, 0x0c, 0x58, 0x42, 0xdb, 0x34,
+	0xf6, 0x16, 0x14, 0x45, 0x46, 0x0c, 0x0f, 0x94, 0x8d, 0x76, 0x20, 0x2b, 0x83, 0x07, 0x62, 0xd4,
+	0x27, 0x5e, 0xaa, 0xe2, 0xd4, 0x8a, 0xcd, 0x8d, 0xfa, 0x39, 0xe7, 0xeb, 0x9f, 0xb6, 0xeb, 0x6a,
+	0x97, 0xbb, 0x8c, 0xc6, 0x47, 0xa3, 0x3e, 0xf1, 0x33, 0xa1, 0x19, 0xa1, 0x12, 0x2c, 0x62, 0xce,
+	0x89, 0xf0, 0x16, 0x15, 0xb9, 0x36, 0xd0, 0x7d, 0x48, 0xe3, 0x1e, 0x1b, 0xc4, 0xc2, 0x4b, 0x4b,
+	0x78, 0xa7, 0xf1, 0xe2, 0xd5, 0x8d, 0xb9, 0xdf, 0x5e, 0xdd, 0xb8, 0xd5, 0xa6, 0xa2, 0x33, 0x68,
+	0xd5, 0x43, 0xd6, 0x6b, 0x84, 0x8c, 0xf7, 0x18, 0x37, 0x3f, 0x5b, 0x3c, 0x3a, 0x6d, 0xc8, 0x7d,
+	0xf0, 0xfa, 0x13, 0x1a, 0x0b, 0xdf, 0x2c, 0x47, 0xff, 0x83, 0x02, 0x6b, 0x71, 0x92, 0x9c, 0x91,
+	0x28, 0xe8, 0x60, 0xde, 0xf1, 0x96, 0x54, 0x98, 0xbc, 0x05, 0x1f, 0x60, 0xde, 0x41, 0x9f, 0x82,
+	0x37, 0x9e, 0x44, 0x86, 0x82, 0x24, 0x31, 0xee, 0x06, 0x1d, 0x42, 0xdb, 0x1d, 0xe1, 0x65, 0x2a,
+	0x4e, 0x2d, 0xe5, 0xaf, 0x59, 0xff, 0x3d, 0xe3, 0x7e, 0xa0, 0xbc, 0xe8, 0xbf, 0x90, 0x6f, 0xe1,
+	0x6e, 0x97, 0x89, 0x80, 0xc6, 0x11, 0x19, 0x7a, 0x59, 0xc5, 0x9e, 0xd3, 0xd8, 0xbe, 0x84, 0x50,
+	0x13, 0xae, 0x9c, 0xd0, 0x18, 0x77, 0xe9, 0x73, 0x12, 0x05, 0x32, 0x25, 0x96, 0x19, 0x14, 0xf3,
+	0xea, 0xd8, 0xf9, 0x8c, 0x08, 0x6c, 0x68, 0x29, 0xac, 0x89, 0x61, 0x60, 0x3c, 0x58, 0x50, 0x16,
+	0x07, 0x5c, 0x60, 0x31, 0xe0, 0x5e, 0x4e, 0x65, 0xf9, 0x6e, 0xfd, 0xc2, 0x2a, 0xaa, 0x1f, 0x0d,
+	0xf7, 0xa6, 0xd6, 0x3e, 0x56, 0x4b, 0xfd, 0x92, 0x38, 0x07, 0x45, 0x5b, 0xb0, 0x4a, 0x79, 0x30,
+	0x5d, 0xaa, 0x21, 0xee, 0x76, 0xbd, 0x7c, 0xc5, 0xa9, 0x65, 0x7c, 0x97, 0xf2, 0x5d, 0xe9, 0x51,
+	0xd5, 0xb0, 0x8b, 0xbb, 0xdd, 0xea, 0xb7, 0x50, 0x94, 0xfb, 0xdc, 0x0e, 0x43, 0x99, 0x5e, 0x1a,
+	0xb7, 0x51, 0x00, 0xab, 0xb8, 0xc5, 0x12, 0x61, 0x4f, 0x67, 0xee, 0xcd, 0xf9, 0xb0, 0x7b, 0x5b,
+	0x31, 0x5c, 0x2a, 0x88, 0x62, 0xaa, 0x1e, 0x43, 0x4e, 0x86, 0x3e, 0xe8, 0xcb, 0x5d, 0x73, 0x59,
+	0x91, 0x6d, 0xcc, 0x83, 0x2e, 0xed, 0x51, 0x1d, 0x25, 0xe5, 0x67, 0xda, 0x98, 0x7f, 0x25, 0x6d,
+	0xb4, 0x09, 0x2b, 0x94, 0x07, 0x38, 0x69, 0x51, 0x91, 0xe0, 0x64, 0xa4, 0xcf, 0x32, 0xaf, 0xce,
+	0xb2, 0x4c, 0xf9, 0xb6, 0xc5, 0xd5, 0x51, 0x5e, 0xa7, 0xa1, 0x78, 0x30, 0x10, 0xd3, 0x6a, 0x59,
+	0x87, 0x4c, 0x42, 0x42, 0x42, 0xcf, 0xc6, 0x7a, 0x19, 0xdb, 0xe8, 0x36, 0xb8, 0x76, 0xac, 0x13,
+	0xb5, 0x6f, 0x25, 0xb3, 0x6c, 0x71, 0x2b, 0x9a, 0x19, 0x5d, 0x2c, 0x7c, 0x98, 0x2e, 0x26, 0x0a,
+	0x48, 0xfd, 0x33, 0x05, 0x48, 0x05, 0x73, 0x1e, 0xc4, 0x2c, 0x0e, 0x89, 0x12, 0x59, 0xca, 0xcf,
+	0x08, 0xce, 0x1f, 0x49, 0x7b, 0x36, 0x99, 0xe9, 0x77, 0x92, 0x69, 0x9c, 0xfd, 0x84, 0x86, 0xc4,
+	0xe8, 0x46, 0x3a, 0x0f, 0xa5, 0x8d, 0x6a, 0xe0, 0x1a, 0x27, 0x4b, 0xa8, 0x18, 0x05, 0x27, 0x84,
+	0x78, 0x57, 0xd5, 0x9c, 0xa2, 0x9e, 0xa3, 0xe0, 0x3d, 0x42, 0x10, 0x82, 0x94, 0x52, 0x5e, 0x46,
+	0x79, 0xd5, 0xf8, 0x7d, 0x74, 0x73, 0x91, 0x28, 0xe1, 0x42, 0x51, 0x5e, 0x03, 0xb9, 0xcd, 0x60,
+	0xc0, 0x49, 0xe4, 0x95, 0xd4, 0xcc, 0xa5, 0x36, 0xe6, 0x4f, 0x38, 0x89, 0xd0, 0xd7, 0xb0, 0x4a,
+	0x4e, 0x4e, 0x48, 0x28, 0xe8, 0x19, 0x09, 0x26, 0x87, 0xbb, 0xa2, 0x52, 0x5c, 0x37, 0x29, 0xbe,
+	0xf9, 0x1e, 0x29, 0xde, 0x97, 0xb5, 0x3a, 0xa6, 0xba, 0x6f, 0xb3, 0x52, 0x7f, 0x97, 0x5f, 0x67,
+	0x76, 0x4d, 0xed, 0x62, 0x66, 0xbe, 0x4e, 0xf1, 0x75, 0x00, 0x79, 0x39, 0xfd, 0x41, 0xeb, 0x94,
+	0x8c, 0x94, 0xb8, 0xb3, 0xbe, 0xbc, 0xae, 0x43, 0x05, 0x5c, 0xd0, 0x07, 0xf2, 0xff, 0x76, 0x1f,
+	0x78, 0x08, 0x79, 0x29, 0x96, 0x80, 0x69, 0x99, 0x79, 0x5e, 0xc5, 0xa9, 0xe5, 0x9a, 0x9b, 0x97,
+	0x04, 0x98, 0x12, 0xa6, 0x9f, 0x0b, 0x27, 0xc6, 0x17, 0xa9, 0x4c, 0xc1, 0x2d, 0x55, 0x7f, 0x9a,
+	0x87, 0xb4, 0xe1, 0xdf, 0x86, 0xb4, 0xd9, 0xba, 0xa3, 0xb6, 0x7e, 0xfb, 0x32, 0xe6, 0x50, 0x0c,
+	0xcd, 0x86, 0xcd, 0x42, 0xb4, 0x01, 0x45, 0x3d, 0x0a, 0x7a, 0x84, 0x73, 0xdc, 0x26, 0x4a, 0x7f,
+	0x59, 0xbf, 0xa0, 0xd1, 0x87, 0x1a, 0x94, 0x2d, 0x9f, 0x24, 0x09, 0x4b, 0xc6, 0xb3, 0xd2, 0xba,
+	0xe5, 0x2b, 0xd0, 0x4e, 0xba, 0x03, 0xa5, 0x2e, 0xe6, 0xe2, 0x49, 0x3f, 0xc2, 0x82, 0x04, 0x82,
+	0xf6, 0x08, 0x17, 0xb8, 0xd7, 0x57, 0x6a, 0x5d, 0xf0, 0x57, 0x27, 0xbe, 0x23, 0xeb, 0x42, 0x35,
+	0x90, 0x2d, 0x44, 0xb6, 0x27, 0x9f, 0x9c, 0x0c, 0xe2, 0x88, 0x44, 0x5e, 0x6a, 0xdc, 0x59, 0xa6,
+	0x61, 0xf4, 0x11, 0xac, 0x84, 0x09, 0xc1, 0xb2, 0x25, 0x4e, 0x98, 0x17, 0x15, 0xb3, 0x6b, 0x1c,
+	0x63, 0xda, 0xea, 0x77, 0xf3, 0x50, 0xf0, 0xc9, 0x19, 0x49, 0x84, 0xed, 0x70, 0x1b, 0x50, 0x4c,
+	0x14, 0x10, 0xe0, 0x28, 0x4a, 0x08, 0xe7, 0xa6, 0x17, 0x15, 0x34, 0xba, 0xad, 0x41, 0xf4, 0x7f,
+	0x28, 0xea, 0x1b, 0x8b, 0x03, 0xed, 0x30, 0x8d, 0x4e, 0xdd, 0xe3, 0x41, 0xac, 0x39, 0x65, 0x36,
+	0x54, 0x4b, 0x1d, 0x73, 0xe9, 0x8f, 0x78, 0x5e, 0x81, 0x96, 0x6a, 0x12, 0xd1, 0xe6, 0x4c, 0x9e,
+	0x2c, 0x6f, 0x23, 0xda, 0xa4, 0x3d, 0x05, 0x57, 0x03, 0x53, 0xa5, 0xbd, 0xf8, 0x61, 0xdd, 0xc9,
+	0xc4, 0xb3, 0x42, 0xa8, 0x7e, 0xbf, 0x08, 0xf9, 0xc9, 0xa7, 0xe6, 0x68, 0x88, 0x3c, 0x58, 0x52,
+	0xa9, 0x62, 0xb6, 0x13, 0x5b, 0x53, 0xbe, 0x18, 0x74, 0xd3, 0xd0, 0xb7, 0xaf, 0x0d, 0xf4, 0x0d,
+	0x64, 0xd5, 0xe7, 0xe7, 0x84, 0x10, 0x6e, 0x36, 0xb5, 0xfb, 0x37, 0x37, 0xf5, 0xc7, 0xab, 0x1b,
+	0xee, 0x08, 0xf7, 0xba, 0x9f, 0x55, 0xc7, 0x4c, 0x55, 0x3f, 0x23, 0xc7, 0x7b, 0x84, 0x70, 0x74,
+	0x0b, 0x96, 0x13, 0xd2, 0xc5, 0x23, 0x12, 0xbd, 0x53, 0x59, 0x45, 0x03, 0xdb, 0x34, 0xed, 0x41,
+	0x2e, 0x0c, 0xc5, 0xd0, 0x4a, 0x35, 0xa3, 0x94, 0xb4, 0x71, 0x49, 0xbd, 0x9b, 0x5a, 0x87, 0x70,
+	0x5c, 0xf7, 0xe8, 0x31, 0x14, 0xa9, 0x7e, 0xcc, 0x05, 0x7d, 0xf5, 0x7d, 0x52, 0x6d, 0x32, 0xd7,
+	0xfc, 0xf8, 0x12, 0xaa, 0x99, 0x17, 0xa0, 0x5f, 0xa0, 0xd3, 0x26, 0x3a, 0x86, 0x65, 0x36, 0x10,
+	0x33, 0xac, 0x50, 0x59, 0xa8, 0xe5, 0x9a, 0x5b, 0x97, 0xb0, 0xce, 0x7e, 0x2a, 0xfd, 0x22, 0x9b,
+	0xb1, 0x51, 0x02, 0xd7, 0xd4, 0x1b, 0x34, 0x64, 0xdd, 0x20, 0x64, 0xb1, 0x48, 0x70, 0x28, 0x82,
+	0x33, 0x92, 0x70, 0xca, 0x62, 0xf3, 0x6a, 0xf9, 0xe4, 0x92, 0x08, 0x87, 0x66, 0xfd, 0xae, 0x59,
+	0x7e, 0xac, 0x57, 0xfb, 0x57, 0xfb, 0xe7, 0x3b, 0xd0, 0xd3, 0x71, 0xd9, 0xda, 0xae, 0x95, 0x7f,
+	0xaf, 0x04, 0xcd, 0xc8, 0x6d, 0x27, 0x25, 0xcb, 0xc4, 0x96, 0xba, 0x01, 0x37, 0x7f, 0x70, 0x00,
+	0x26, 0x2d, 0x08, 0x21, 0x28, 0x1e, 0x92, 0x38, 0xa2, 0x71, 0xdb, 0x24, 0xd7, 0x9d, 0x43, 0xab,
+	0xb0, 0x6c, 0x30, 0x9b, 0x1a, 0xd7, 0x41, 0x2b, 0x50, 0xb0, 0xd6, 0x43, 0x1a, 0x93, 0xc8, 0x5d,
+	0x90, 0x90, 0x99, 0xa7, 0xe3, 0xba, 0x29, 0x94, 0x87, 0x8c, 0x1e, 0x93, 0xc8, 0x5d, 0x44, 0x39,
+	0x58, 0xda, 0xd6, 0xaf, 0x1e, 0x37, 0x8d, 0x5c, 0xc8, 0x9b, 0xd9, 0x9f, 0xcb, 0xaa, 0x72, 0x97,
+	0xd6, 0x53, 0xbf, 0xfc, 0x5c, 0x76, 0x36, 0xbf, 0x84, 0xd2, 0x79, 0xdd, 0x5c, 0xce, 0x7f, 0xc4,
+	0xc4, 0x9e, 0x7d, 0x44, 0xba, 0x73, 0xa8, 0x00, 0xd9, 0x89, 0xe9, 0xc8, 0x58, 0xf7, 0x86, 0x24,
+	0x1c, 0x48, 0xfa, 0x79, 0x43, 0xd6, 0x80, 0xab, 0x7f, 0x91, 0x6c, 0x94, 0x86, 0xf9, 0xe3, 0x3b,
+	0xee, 0x9c, 0xfa, 0x6d, 0xba, 0x8e, 0x5e, 0xb0, 0x73, 0xff, 0xc5, 0x9b, 0xb2, 0xf3, 0xf2, 0x4d,
+	0xd9, 0x79, 0xfd, 0xa6, 0xec, 0xfc, 0xf8, 0xb6, 0x3c, 0xf7, 0xf2, 0x6d, 0x79, 0xee, 0xd7, 0xb7,
+	0xe5, 0xb9, 0x67, 0x5b, 0x53, 0xe2, 0x92, 0xb9, 0xde, 0xd2, 0x7f, 0x53, 0x62, 0x16, 0x91, 0xc6,
+	0x70, 0xfa, 0xdf, 0x90, 0xd2, 0x59, 0x2b, 0xad, 0xee, 0xf2, 0xee, 0x9f, 0x03, 0x00, 0xec, 0xbe,
+	0xac, 0x6d, 0x3b, 0x0d, 0x00, 0x00,
 }
 
 func (m *InboundParams) Marshal() (dAtA []byte, err error) {
@@ -0,0 +1,16 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxReleasedDelayedCctxs is the maximum number of delayed cctxs released in a block
+// this bounds the gas consumed by the release in BeginBlock, the remaining cctxs are released in the next blocks
+const MaxReleasedDelayedCctxs = 100
+
+// DelayedCctxReleaseIndex returns the key of a delayed cctx in the release height index
+// the height is encoded in big endian so the delayed cctxs are iterated by increasing release height
+func DelayedCctxReleaseIndex(releaseHeight int64, cctxIndex string) []byte {
+	// #nosec G115 always positive
+	return append(sdk.Uint64ToBigEndian(uint64(releaseHeight)), []byte(cctxIndex)...)
+}
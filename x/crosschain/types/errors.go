@@ -58,4 +58,7 @@ var (
 	ErrValidatingInbound       = errorsmod.Register(ModuleName, 1157, "unable to validate inbound")
 	ErrInvalidGasLimit         = errorsmod.Register(ModuleName, 1158, "invalid gas limit")
 	ErrUnableToSetOutboundInfo = errorsmod.Register(ModuleName, 1159, "unable to set outbound info")
+	ErrStatusNotPendingDelay   = errorsmod.Register(ModuleName, 1160, "status not pending delay")
+	ErrUTXOConsolidation       = errorsmod.Register(ModuleName, 1161, "unable to consolidate UTXOs")
+	ErrSenderRateLimitExceeded = errorsmod.Register(ModuleName, 1162, "sender rate limit exceeded")
 )
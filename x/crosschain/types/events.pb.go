@@ -747,6 +747,58 @@ func (m *EventERC20CustodyPausing) GetCctxIndex() string {
 	return ""
 }
 
+type EventUTXOConsolidation struct {
+	ChainId   int64  `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	CctxIndex string `protobuf:"bytes,2,opt,name=cctx_index,json=cctxIndex,proto3" json:"cctx_index,omitempty"`
+}
+
+func (m *EventUTXOConsolidation) Reset()         { *m = EventUTXOConsolidation{} }
+func (m *EventUTXOConsolidation) String() string { return proto.CompactTextString(m) }
+func (*EventUTXOConsolidation) ProtoMessage()    {}
+func (*EventUTXOConsolidation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd08b628129fa2e1, []int{9}
+}
+func (m *EventUTXOConsolidation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventUTXOConsolidation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventUTXOConsolidation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventUTXOConsolidation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventUTXOConsolidation.Merge(m, src)
+}
+func (m *EventUTXOConsolidation) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventUTXOConsolidation) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventUTXOConsolidation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventUTXOConsolidation proto.InternalMessageInfo
+
+func (m *EventUTXOConsolidation) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *EventUTXOConsolidation) GetCctxIndex() string {
+	if m != nil {
+		return m.CctxIndex
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*EventInboundFinalized)(nil), "zetachain.zetacore.crosschain.EventInboundFinalized")
 	proto.RegisterType((*EventZrcWithdrawCreated)(nil), "zetachain.zetacore.crosschain.EventZrcWithdrawCreated")
@@ -757,6 +809,7 @@ func init() {
 	proto.RegisterType((*EventERC20Whitelist)(nil), "zetachain.zetacore.crosschain.EventERC20Whitelist")
 	proto.RegisterType((*EventERC20CustodyFundsMigration)(nil), "zetachain.zetacore.crosschain.EventERC20CustodyFundsMigration")
 	proto.RegisterType((*EventERC20CustodyPausing)(nil), "zetachain.zetacore.crosschain.EventERC20CustodyPausing")
+	proto.RegisterType((*EventUTXOConsolidation)(nil), "zetachain.zetacore.crosschain.EventUTXOConsolidation")
 }
 
 func init() {
@@ -764,57 +817,58 @@ func init() {
 }
 
 var fileDescriptor_dd08b628129fa2e1 = []byte{
-	// 793 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x95, 0xdd, 0x8e, 0x1b, 0x35,
-	0x14, 0xc7, 0x77, 0x9a, 0x6f, 0x37, 0x09, 0x30, 0x0d, 0x30, 0xac, 0xb4, 0xa1, 0x0d, 0x42, 0x20,
-	0x44, 0x93, 0x55, 0x79, 0x02, 0x1a, 0x75, 0xdb, 0x5c, 0x54, 0xad, 0xd2, 0xa2, 0xa2, 0xbd, 0xb1,
-	0x9c, 0xf1, 0x61, 0xc6, 0x30, 0xb1, 0x23, 0xdb, 0xb3, 0x49, 0xf6, 0x29, 0x10, 0xef, 0xc1, 0x0d,
-	0x12, 0x77, 0x3c, 0x00, 0x97, 0x7b, 0xc9, 0x25, 0xda, 0xbc, 0x08, 0xf2, 0xc7, 0x64, 0x93, 0xc9,
-	0x0a, 0x2e, 0x10, 0x48, 0xbd, 0x9b, 0xf3, 0x3f, 0x67, 0x7c, 0x7e, 0xfe, 0x9f, 0xf1, 0x18, 0x7d,
-	0x71, 0x09, 0x9a, 0xc4, 0x29, 0x61, 0x7c, 0x64, 0x9f, 0x84, 0x84, 0x51, 0x2c, 0x85, 0x52, 0x4e,
-	0x83, 0x0b, 0xe0, 0x5a, 0x0d, 0x17, 0x52, 0x68, 0x11, 0x9e, 0x6c, 0x6b, 0x87, 0x45, 0xed, 0xf0,
-	0xa6, 0xf6, 0xb8, 0x97, 0x88, 0x44, 0xd8, 0xca, 0x91, 0x79, 0x72, 0x2f, 0x0d, 0x36, 0x15, 0xf4,
-	0xfe, 0x13, 0xb3, 0xca, 0x84, 0xcf, 0x44, 0xce, 0xe9, 0x19, 0xe3, 0x24, 0x63, 0x97, 0x40, 0xc3,
-	0xfb, 0xa8, 0x3d, 0x57, 0x09, 0xd6, 0xeb, 0x05, 0xe0, 0x5c, 0x66, 0x51, 0x70, 0x3f, 0xf8, 0xbc,
-	0x35, 0x45, 0x73, 0x95, 0xbc, 0x5e, 0x2f, 0xe0, 0x1b, 0x99, 0x85, 0x27, 0x08, 0xc5, 0xb1, 0x5e,
-	0x61, 0xc6, 0x29, 0xac, 0xa2, 0x3b, 0x36, 0xdf, 0x32, 0xca, 0xc4, 0x08, 0xe1, 0x07, 0xa8, 0xae,
-	0x80, 0x53, 0x90, 0x51, 0xc5, 0xa6, 0x7c, 0x14, 0x7e, 0x84, 0x9a, 0x7a, 0x85, 0x85, 0x4c, 0x18,
-	0x8f, 0xaa, 0x36, 0xd3, 0xd0, 0xab, 0x17, 0x26, 0x0c, 0x7b, 0xa8, 0x46, 0x94, 0x02, 0x1d, 0xd5,
-	0xac, 0xee, 0x82, 0xf0, 0x01, 0x6a, 0x33, 0x47, 0x87, 0x53, 0xa2, 0xd2, 0xa8, 0x6e, 0x93, 0x77,
-	0xbd, 0xf6, 0x8c, 0xa8, 0x34, 0x3c, 0x45, 0xbd, 0xa2, 0x64, 0x96, 0x89, 0xf8, 0x07, 0x9c, 0x02,
-	0x4b, 0x52, 0x1d, 0x35, 0x6c, 0x69, 0xe8, 0x73, 0x8f, 0x4d, 0xea, 0x99, 0xcd, 0x84, 0xc7, 0xa8,
-	0x29, 0x21, 0x06, 0x76, 0x01, 0x32, 0x6a, 0xda, 0xaa, 0x6d, 0x1c, 0x7e, 0x8a, 0xba, 0xc5, 0x33,
-	0xb6, 0xe6, 0x45, 0x2d, 0x5b, 0xd1, 0x29, 0xd4, 0xb1, 0x11, 0xcd, 0x06, 0xc9, 0x5c, 0xe4, 0x5c,
-	0x47, 0xc8, 0x6d, 0xd0, 0x45, 0xe1, 0x67, 0xe8, 0x1d, 0x09, 0x19, 0x59, 0x03, 0xc5, 0x73, 0x50,
-	0x8a, 0x24, 0x10, 0xdd, 0xb5, 0x05, 0x5d, 0x2f, 0x3f, 0x77, 0xaa, 0x31, 0x90, 0xc3, 0x12, 0x2b,
-	0x4d, 0x74, 0xae, 0xa2, 0xb6, 0x33, 0x90, 0xc3, 0xf2, 0x95, 0x15, 0x0c, 0x86, 0x4b, 0x6d, 0x97,
-	0xe9, 0x38, 0x0c, 0xa7, 0x16, 0xab, 0x3c, 0x40, 0x6d, 0xe7, 0xac, 0x67, 0xed, 0x3a, 0x7b, 0x9c,
-	0x66, 0x49, 0x07, 0xbf, 0xdc, 0x41, 0x1f, 0xda, 0x29, 0x9f, 0xcb, 0xf8, 0x0d, 0xd3, 0x29, 0x95,
-	0x64, 0x39, 0x96, 0x40, 0xf4, 0x7f, 0x39, 0xe7, 0x32, 0x57, 0xf5, 0x80, 0xeb, 0x60, 0xb2, 0xb5,
-	0xc3, 0xc9, 0xee, 0xce, 0xa9, 0xfe, 0x8f, 0x73, 0x6a, 0xfc, 0xfd, 0x9c, 0x9a, 0x7b, 0x73, 0xda,
-	0xb7, 0xbf, 0x55, 0xb2, 0x7f, 0xf0, 0x6b, 0x80, 0x22, 0x67, 0x1a, 0x68, 0xf2, 0x7f, 0xba, 0xb6,
-	0x67, 0x49, 0xf5, 0xd0, 0x92, 0x7d, 0xee, 0x5a, 0x99, 0xfb, 0xb7, 0x00, 0xf5, 0x2c, 0xf7, 0x8b,
-	0x5c, 0xbb, 0x33, 0x4d, 0x58, 0x96, 0x4b, 0xf8, 0xf7, 0xcc, 0x27, 0x08, 0x89, 0x8c, 0x16, 0x8d,
-	0x1d, 0x77, 0x4b, 0x64, 0xd4, 0x7f, 0xaf, 0xfb, 0x5c, 0xd5, 0x5b, 0x3e, 0xe7, 0x0b, 0x92, 0xe5,
-	0x80, 0xfd, 0x74, 0xa8, 0x47, 0xef, 0x58, 0x75, 0xea, 0xc5, 0x43, 0xfc, 0x57, 0x79, 0x1c, 0x83,
-	0x52, 0x6f, 0x09, 0xfe, 0x4f, 0x01, 0x3a, 0xb6, 0xf8, 0xe3, 0xf1, 0xeb, 0x6f, 0x9f, 0x12, 0xf5,
-	0x52, 0xb2, 0x18, 0x26, 0x3c, 0x96, 0x40, 0x14, 0xd0, 0x12, 0x62, 0x50, 0x46, 0xfc, 0x12, 0x85,
-	0x09, 0x51, 0x78, 0x61, 0x5e, 0xc2, 0xcc, 0xbf, 0xe5, 0x77, 0xf2, 0x6e, 0x52, 0x5a, 0xcd, 0xfc,
-	0x68, 0x08, 0xa5, 0x4c, 0x33, 0xc1, 0x49, 0x86, 0xbf, 0x03, 0x28, 0x76, 0xd5, 0xbd, 0x91, 0xcf,
-	0x00, 0xd4, 0x20, 0x43, 0xf7, 0x2c, 0xd3, 0x93, 0xe9, 0xf8, 0xd1, 0xe9, 0x9b, 0x94, 0x69, 0xc8,
-	0x98, 0xd2, 0xe6, 0xaf, 0xb9, 0x2c, 0x02, 0x7c, 0x80, 0x15, 0x6e, 0x73, 0xe3, 0x2d, 0xdf, 0x27,
-	0xa8, 0x73, 0x29, 0xe3, 0x47, 0xa7, 0x98, 0x50, 0x2a, 0x41, 0x29, 0x8f, 0xd6, 0xb6, 0xe2, 0xd7,
-	0x4e, 0x1b, 0xfc, 0x1c, 0xa0, 0x8f, 0x6f, 0xda, 0x8d, 0x73, 0xa5, 0x05, 0x5d, 0x9f, 0xe5, 0x9c,
-	0xaa, 0xe7, 0x2c, 0x91, 0xc4, 0x70, 0x85, 0x43, 0x74, 0xcf, 0x98, 0x1d, 0xbb, 0xe4, 0x76, 0x39,
-	0xd7, 0xf9, 0x3d, 0x0e, 0x4b, 0xff, 0x9a, 0x5f, 0xd3, 0x34, 0x86, 0xdb, 0x1a, 0xc3, 0x4e, 0xe3,
-	0x9d, 0x83, 0x5e, 0x29, 0x1f, 0xf4, 0x9d, 0xdd, 0x55, 0x4b, 0xa6, 0x0f, 0xbe, 0xf7, 0xe7, 0x7c,
-	0x17, 0xf7, 0x25, 0xc9, 0x15, 0xe3, 0x89, 0xb9, 0xac, 0xec, 0x9f, 0x05, 0x33, 0x6a, 0xe1, 0x2a,
-	0xd3, 0x86, 0x8d, 0x27, 0xd4, 0x5c, 0x56, 0x0b, 0x92, 0xfb, 0xf1, 0x34, 0xa7, 0x2e, 0x28, 0xf5,
-	0xaa, 0x94, 0x7a, 0x3d, 0x7e, 0xfa, 0xfb, 0x75, 0x3f, 0xb8, 0xba, 0xee, 0x07, 0x7f, 0x5e, 0xf7,
-	0x83, 0x1f, 0x37, 0xfd, 0xa3, 0xab, 0x4d, 0xff, 0xe8, 0x8f, 0x4d, 0xff, 0xe8, 0xfc, 0x61, 0xc2,
-	0x74, 0x9a, 0xcf, 0x86, 0xb1, 0x98, 0xdb, 0xbb, 0xfe, 0xa1, 0xbb, 0xe2, 0xb9, 0xa0, 0x30, 0x5a,
-	0xed, 0x5e, 0xfa, 0xe6, 0x00, 0xa8, 0x59, 0xdd, 0xde, 0xdf, 0x5f, 0xfd, 0x15, 0x00, 0x00, 0xff,
-	0xff, 0xde, 0x67, 0x9e, 0x8b, 0x22, 0x08, 0x00, 0x00,
+	// 813 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x96, 0xdd, 0x8e, 0x1b, 0x35,
+	0x14, 0xc7, 0x77, 0x9a, 0x6f, 0x37, 0x09, 0x30, 0x0d, 0x65, 0x58, 0x69, 0x43, 0x1b, 0x84, 0x40,
+	0x88, 0x26, 0xab, 0xf2, 0x04, 0x74, 0xd4, 0x6d, 0x73, 0x51, 0x6d, 0x95, 0x6e, 0xd5, 0xaa, 0x37,
+	0x96, 0x33, 0x3e, 0xcc, 0x18, 0x26, 0x76, 0x64, 0x7b, 0x36, 0xc9, 0x3e, 0x05, 0xe2, 0x3d, 0xb8,
+	0x41, 0xe2, 0x8e, 0x07, 0xe0, 0x72, 0x2f, 0xb9, 0x44, 0x9b, 0x17, 0x41, 0xb6, 0x67, 0xb2, 0xc9,
+	0x64, 0xb5, 0x5c, 0x20, 0x90, 0x7a, 0x37, 0xe7, 0x7f, 0xce, 0xf8, 0xfc, 0xfc, 0x3f, 0xf3, 0x61,
+	0xf4, 0xf5, 0x05, 0x68, 0x12, 0x25, 0x84, 0xf1, 0x91, 0xbd, 0x12, 0x12, 0x46, 0x91, 0x14, 0x4a,
+	0x39, 0x0d, 0xce, 0x81, 0x6b, 0x35, 0x9c, 0x4b, 0xa1, 0x85, 0x7f, 0xb4, 0xa9, 0x1d, 0x16, 0xb5,
+	0xc3, 0xeb, 0xda, 0xc3, 0x5e, 0x2c, 0x62, 0x61, 0x2b, 0x47, 0xe6, 0xca, 0xdd, 0x34, 0x58, 0x57,
+	0xd0, 0xc7, 0x4f, 0xcd, 0x2a, 0x63, 0x3e, 0x15, 0x19, 0xa7, 0x27, 0x8c, 0x93, 0x94, 0x5d, 0x00,
+	0xf5, 0x1f, 0xa0, 0xf6, 0x4c, 0xc5, 0x58, 0xaf, 0xe6, 0x80, 0x33, 0x99, 0x06, 0xde, 0x03, 0xef,
+	0xab, 0xd6, 0x04, 0xcd, 0x54, 0x7c, 0xb6, 0x9a, 0xc3, 0x6b, 0x99, 0xfa, 0x47, 0x08, 0x45, 0x91,
+	0x5e, 0x62, 0xc6, 0x29, 0x2c, 0x83, 0x3b, 0x36, 0xdf, 0x32, 0xca, 0xd8, 0x08, 0xfe, 0x7d, 0x54,
+	0x57, 0xc0, 0x29, 0xc8, 0xa0, 0x62, 0x53, 0x79, 0xe4, 0x7f, 0x8a, 0x9a, 0x7a, 0x89, 0x85, 0x8c,
+	0x19, 0x0f, 0xaa, 0x36, 0xd3, 0xd0, 0xcb, 0x53, 0x13, 0xfa, 0x3d, 0x54, 0x23, 0x4a, 0x81, 0x0e,
+	0x6a, 0x56, 0x77, 0x81, 0xff, 0x10, 0xb5, 0x99, 0xa3, 0xc3, 0x09, 0x51, 0x49, 0x50, 0xb7, 0xc9,
+	0xbb, 0xb9, 0xf6, 0x9c, 0xa8, 0xc4, 0x3f, 0x46, 0xbd, 0xa2, 0x64, 0x9a, 0x8a, 0xe8, 0x47, 0x9c,
+	0x00, 0x8b, 0x13, 0x1d, 0x34, 0x6c, 0xa9, 0x9f, 0xe7, 0x9e, 0x98, 0xd4, 0x73, 0x9b, 0xf1, 0x0f,
+	0x51, 0x53, 0x42, 0x04, 0xec, 0x1c, 0x64, 0xd0, 0xb4, 0x55, 0x9b, 0xd8, 0xff, 0x02, 0x75, 0x8b,
+	0x6b, 0x6c, 0xcd, 0x0b, 0x5a, 0xb6, 0xa2, 0x53, 0xa8, 0xa1, 0x11, 0xcd, 0x06, 0xc9, 0x4c, 0x64,
+	0x5c, 0x07, 0xc8, 0x6d, 0xd0, 0x45, 0xfe, 0x97, 0xe8, 0x03, 0x09, 0x29, 0x59, 0x01, 0xc5, 0x33,
+	0x50, 0x8a, 0xc4, 0x10, 0xdc, 0xb5, 0x05, 0xdd, 0x5c, 0x7e, 0xe1, 0x54, 0x63, 0x20, 0x87, 0x05,
+	0x56, 0x9a, 0xe8, 0x4c, 0x05, 0x6d, 0x67, 0x20, 0x87, 0xc5, 0x2b, 0x2b, 0x18, 0x0c, 0x97, 0xda,
+	0x2c, 0xd3, 0x71, 0x18, 0x4e, 0x2d, 0x56, 0x79, 0x88, 0xda, 0xce, 0xd9, 0x9c, 0xb5, 0xeb, 0xec,
+	0x71, 0x9a, 0x25, 0x1d, 0xfc, 0x7a, 0x07, 0x7d, 0x62, 0xa7, 0xfc, 0x4e, 0x46, 0x6f, 0x98, 0x4e,
+	0xa8, 0x24, 0x8b, 0x50, 0x02, 0xd1, 0xff, 0xe5, 0x9c, 0xcb, 0x5c, 0xd5, 0x3d, 0xae, 0xbd, 0xc9,
+	0xd6, 0xf6, 0x27, 0xbb, 0x3d, 0xa7, 0xfa, 0x3f, 0xce, 0xa9, 0x71, 0xfb, 0x9c, 0x9a, 0x3b, 0x73,
+	0xda, 0xb5, 0xbf, 0x55, 0xb2, 0x7f, 0xf0, 0x9b, 0x87, 0x02, 0x67, 0x1a, 0x68, 0xf2, 0x7f, 0xba,
+	0xb6, 0x63, 0x49, 0x75, 0xdf, 0x92, 0x5d, 0xee, 0x5a, 0x99, 0xfb, 0x77, 0x0f, 0xf5, 0x2c, 0xf7,
+	0x69, 0xa6, 0xdd, 0x3b, 0x4d, 0x58, 0x9a, 0x49, 0xf8, 0xf7, 0xcc, 0x47, 0x08, 0x89, 0x94, 0x16,
+	0x8d, 0x1d, 0x77, 0x4b, 0xa4, 0x34, 0x7f, 0x5e, 0x77, 0xb9, 0xaa, 0x37, 0x3c, 0xce, 0xe7, 0x24,
+	0xcd, 0x00, 0xe7, 0xd3, 0xa1, 0x39, 0x7a, 0xc7, 0xaa, 0x93, 0x5c, 0xdc, 0xc7, 0x7f, 0x95, 0x45,
+	0x11, 0x28, 0xf5, 0x9e, 0xe0, 0xff, 0xec, 0xa1, 0x43, 0x8b, 0x1f, 0x86, 0x67, 0x6f, 0x9f, 0x11,
+	0xf5, 0x52, 0xb2, 0x08, 0xc6, 0x3c, 0x92, 0x40, 0x14, 0xd0, 0x12, 0xa2, 0x57, 0x46, 0xfc, 0x06,
+	0xf9, 0x31, 0x51, 0x78, 0x6e, 0x6e, 0xc2, 0x2c, 0xbf, 0x2b, 0xdf, 0xc9, 0x87, 0x71, 0x69, 0x35,
+	0xf3, 0xa1, 0x21, 0x94, 0x32, 0xcd, 0x04, 0x27, 0x29, 0xfe, 0x1e, 0xa0, 0xd8, 0x55, 0xf7, 0x5a,
+	0x3e, 0x01, 0x50, 0x83, 0x14, 0xdd, 0xb3, 0x4c, 0x4f, 0x27, 0xe1, 0xe3, 0xe3, 0x37, 0x09, 0xd3,
+	0x90, 0x32, 0xa5, 0xcd, 0x57, 0x73, 0x51, 0x04, 0x78, 0x0f, 0xcb, 0xdf, 0xe4, 0xc2, 0x0d, 0xdf,
+	0xe7, 0xa8, 0x73, 0x21, 0xa3, 0xc7, 0xc7, 0x98, 0x50, 0x2a, 0x41, 0xa9, 0x1c, 0xad, 0x6d, 0xc5,
+	0xef, 0x9c, 0x36, 0xf8, 0xc5, 0x43, 0x9f, 0x5d, 0xb7, 0x0b, 0x33, 0xa5, 0x05, 0x5d, 0x9d, 0x64,
+	0x9c, 0xaa, 0x17, 0x2c, 0x96, 0xc4, 0x70, 0xf9, 0x43, 0x74, 0xcf, 0x98, 0x1d, 0xb9, 0xe4, 0x66,
+	0x39, 0xd7, 0xf9, 0x23, 0x0e, 0x8b, 0xfc, 0xb6, 0x7c, 0x4d, 0xd3, 0x18, 0x6e, 0x6a, 0x0c, 0x5b,
+	0x8d, 0xb7, 0x5e, 0xf4, 0x4a, 0xf9, 0x45, 0xdf, 0xda, 0x5d, 0xb5, 0x64, 0xfa, 0xe0, 0x07, 0x14,
+	0xec, 0xe1, 0xbe, 0x24, 0x99, 0x62, 0x3c, 0x36, 0x3f, 0x2b, 0xfb, 0x65, 0xc1, 0x8c, 0x5a, 0xb8,
+	0xca, 0xa4, 0x61, 0xe3, 0x31, 0x35, 0x3f, 0xab, 0x39, 0xc9, 0xf2, 0xf1, 0x34, 0x27, 0x2e, 0x28,
+	0xf5, 0xaa, 0x94, 0x7b, 0x4d, 0xd0, 0x7d, 0xdb, 0xeb, 0xf5, 0xd9, 0xdb, 0xd3, 0x50, 0x70, 0x25,
+	0x52, 0x46, 0x9d, 0x23, 0xb7, 0x74, 0xba, 0xfd, 0xb9, 0x7e, 0xf2, 0xec, 0x8f, 0xab, 0xbe, 0x77,
+	0x79, 0xd5, 0xf7, 0xfe, 0xba, 0xea, 0x7b, 0x3f, 0xad, 0xfb, 0x07, 0x97, 0xeb, 0xfe, 0xc1, 0x9f,
+	0xeb, 0xfe, 0xc1, 0xbb, 0x47, 0x31, 0xd3, 0x49, 0x36, 0x1d, 0x46, 0x62, 0x66, 0xcf, 0x0f, 0x8f,
+	0xdc, 0xb1, 0x81, 0x0b, 0x0a, 0xa3, 0xe5, 0xf6, 0x41, 0xc2, 0xbc, 0x54, 0x6a, 0x5a, 0xb7, 0x67,
+	0x82, 0x6f, 0xff, 0x1e, 0x00, 0xc3, 0x36, 0xae, 0x58, 0x76, 0x08, 0x00, 0x00,
 }
 
 func (m *EventInboundFinalized) Marshal() (dAtA []byte, err error) {
@@ -1375,6 +1429,41 @@ func (m *EventERC20CustodyPausing) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *EventUTXOConsolidation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventUTXOConsolidation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventUTXOConsolidation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CctxIndex) > 0 {
+		i -= len(m.CctxIndex)
+		copy(dAtA[i:], m.CctxIndex)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.CctxIndex)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvents(v)
 	base := offset
@@ -1665,6 +1754,22 @@ func (m *EventERC20CustodyPausing) Size() (n int) {
 	return n
 }
 
+func (m *EventUTXOConsolidation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovEvents(uint64(m.ChainId))
+	}
+	l = len(m.CctxIndex)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
 func sovEvents(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3696,6 +3801,107 @@ func (m *EventERC20CustodyPausing) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventUTXOConsolidation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventUTXOConsolidation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventUTXOConsolidation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CctxIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CctxIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvents(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
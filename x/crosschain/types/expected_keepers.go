@@ -60,6 +60,14 @@ type ObserverKeeper interface {
 		observationType observertypes.ObservationType,
 	) (ballot observertypes.Ballot, isNew bool, err error)
 	AddBallotToList(ctx sdk.Context, ballot observertypes.Ballot)
+	VoteOnBallot(
+		ctx sdk.Context,
+		chain chains.Chain,
+		ballotIndex string,
+		observationType observertypes.ObservationType,
+		voter string,
+		voteType observertypes.VoteType,
+	) (ballot observertypes.Ballot, isFinalized bool, isNew bool, err error)
 	CheckIfTssPubkeyHasBeenGenerated(ctx sdk.Context, tssPubkey string) (observertypes.TSS, bool)
 	GetAllTSS(ctx sdk.Context) (list []observertypes.TSS)
 	GetTSS(ctx sdk.Context) (val observertypes.TSS, found bool)
@@ -226,6 +234,13 @@ type AuthorityKeeper interface {
 
 type LightclientKeeper interface {
 	VerifyProof(ctx sdk.Context, proof *proofs.Proof, chainID int64, blockHash string, txIndex int64) ([]byte, error)
+	VerifyReceiptProof(
+		ctx sdk.Context,
+		proof *proofs.Proof,
+		chainID int64,
+		blockHash string,
+		txIndex int64,
+	) ([]byte, []byte, error)
 }
 
 type IBCCrosschainKeeper interface {
@@ -50,6 +50,16 @@ func (gs GenesisState) Validate() error {
 		gasPriceIndexMap[elem.Index] = true
 	}
 
+	// Check for duplicated index in delayedCctx
+	delayedCctxIndexMap := make(map[string]bool)
+
+	for _, elem := range gs.DelayedCctxList {
+		if _, ok := delayedCctxIndexMap[elem.CctxIndex]; ok {
+			return fmt.Errorf("duplicated index for delayedCctx")
+		}
+		delayedCctxIndexMap[elem.CctxIndex] = true
+	}
+
 	return gs.RateLimiterFlags.Validate()
 }
 
@@ -34,6 +34,7 @@ type GenesisState struct {
 	ZetaAccounting        ZetaAccounting      `protobuf:"bytes,12,opt,name=zeta_accounting,json=zetaAccounting,proto3" json:"zeta_accounting"`
 	FinalizedInbounds     []string            `protobuf:"bytes,16,rep,name=FinalizedInbounds,proto3" json:"FinalizedInbounds,omitempty"`
 	RateLimiterFlags      RateLimiterFlags    `protobuf:"bytes,17,opt,name=rate_limiter_flags,json=rateLimiterFlags,proto3" json:"rate_limiter_flags"`
+	DelayedCctxList       []DelayedCctx       `protobuf:"bytes,18,rep,name=delayed_cctx_list,json=delayedCctxList,proto3" json:"delayed_cctx_list"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -132,6 +133,13 @@ func (m *GenesisState) GetRateLimiterFlags() RateLimiterFlags {
 	return RateLimiterFlags{}
 }
 
+func (m *GenesisState) GetDelayedCctxList() []DelayedCctx {
+	if m != nil {
+		return m.DelayedCctxList
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "zetachain.zetacore.crosschain.GenesisState")
 }
@@ -141,40 +149,42 @@ func init() {
 }
 
 var fileDescriptor_547615497292ea23 = []byte{
-	// 515 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x41, 0x6f, 0xd3, 0x30,
-	0x14, 0xc7, 0x5b, 0x06, 0x83, 0x79, 0x05, 0x36, 0x6f, 0x48, 0x51, 0x25, 0x42, 0xc5, 0x85, 0x49,
-	0xa3, 0x09, 0xda, 0x00, 0x71, 0x65, 0x95, 0xd6, 0x21, 0x2a, 0x01, 0xa1, 0xa7, 0x09, 0xc9, 0xb8,
-	0xae, 0x97, 0x58, 0xcb, 0xe2, 0x2a, 0x7e, 0x95, 0x4a, 0x3f, 0x05, 0x9f, 0x88, 0xf3, 0x8e, 0x3b,
-	0x72, 0x42, 0xa8, 0xfd, 0x22, 0xc8, 0x8e, 0x57, 0x9a, 0xb6, 0x4a, 0x7a, 0x7b, 0x7a, 0x79, 0xff,
-	0xff, 0xef, 0x29, 0x7f, 0x3f, 0x74, 0x38, 0xe6, 0x40, 0x59, 0x44, 0x45, 0xe2, 0x9b, 0x4a, 0xa6,
-	0xdc, 0x67, 0xa9, 0x54, 0x2a, 0xeb, 0x85, 0x3c, 0xe1, 0x4a, 0x28, 0x6f, 0x90, 0x4a, 0x90, 0xf8,
-	0xe9, 0x6c, 0xd8, 0xbb, 0x1d, 0xf6, 0xfe, 0x0f, 0xd7, 0x8f, 0x8a, 0xbd, 0x4c, 0x49, 0x4c, 0x4d,
-	0x60, 0x94, 0x59, 0xd6, 0x9b, 0x25, 0x7c, 0xaa, 0xc8, 0x20, 0x15, 0x8c, 0xdb, 0xf1, 0x77, 0xc5,
-	0xe3, 0x22, 0xe9, 0xc9, 0x61, 0xd2, 0x27, 0x11, 0x55, 0x11, 0x01, 0x49, 0x18, 0x9b, 0x81, 0x8e,
-	0xd7, 0x53, 0x42, 0x4a, 0xd9, 0x25, 0x4f, 0xad, 0xe8, 0x4d, 0xb1, 0x28, 0xa6, 0x0a, 0x48, 0x2f,
-	0x96, 0xec, 0x92, 0x44, 0x5c, 0x84, 0x11, 0x58, 0xd9, 0xeb, 0x62, 0x99, 0x1c, 0xc2, 0x2a, 0xd8,
-	0xdb, 0x62, 0x55, 0x4a, 0x81, 0x93, 0x58, 0x5c, 0x09, 0xe0, 0x29, 0xb9, 0x88, 0x69, 0x68, 0x53,
-	0xa9, 0xef, 0x87, 0x32, 0x94, 0xa6, 0xf4, 0x75, 0x95, 0x75, 0x9f, 0xff, 0xda, 0x44, 0xb5, 0x76,
-	0x96, 0xde, 0x57, 0xa0, 0xc0, 0xf1, 0x05, 0xda, 0xbb, 0x05, 0x77, 0x33, 0x6e, 0x47, 0x28, 0x70,
-	0xee, 0x34, 0x36, 0x0e, 0xb6, 0x8f, 0x3c, 0xaf, 0x30, 0x5a, 0xef, 0x53, 0x5e, 0x79, 0x72, 0xf7,
-	0xfa, 0xcf, 0xb3, 0x4a, 0xb0, 0xca, 0x10, 0x7f, 0x44, 0xb5, 0x90, 0xaa, 0xcf, 0x3a, 0x34, 0x03,
-	0xb8, 0x67, 0x00, 0x2f, 0x4a, 0x00, 0x6d, 0x2b, 0x09, 0x72, 0x62, 0xfc, 0x05, 0x3d, 0x6c, 0xe9,
-	0xa1, 0x96, 0x1e, 0xea, 0x8e, 0x94, 0x73, 0xdf, 0xb8, 0x1d, 0x96, 0xb8, 0xcd, 0x6b, 0x82, 0xbc,
-	0x03, 0xfe, 0x8e, 0xf6, 0x74, 0x6e, 0x27, 0x3a, 0xb6, 0x33, 0x93, 0x9a, 0x59, 0xf3, 0xc1, 0x5a,
-	0xff, 0xa1, 0x93, 0x57, 0x06, 0xab, 0xac, 0x70, 0x8c, 0x9e, 0xd8, 0xe7, 0x74, 0x46, 0x55, 0xd4,
-	0x95, 0x2d, 0x06, 0x23, 0xc3, 0xd8, 0x32, 0x8c, 0x57, 0x25, 0x8c, 0x0f, 0x8b, 0x5a, 0xfb, 0xb7,
-	0x57, 0x9b, 0x62, 0x8e, 0xf6, 0x17, 0x1e, 0x2f, 0x89, 0x35, 0x6c, 0xdb, 0xc0, 0x9a, 0xeb, 0xc1,
-	0xf2, 0xb9, 0x62, 0x91, 0x2c, 0xc5, 0xfa, 0x0d, 0x3d, 0xd6, 0x7a, 0x42, 0x19, 0x93, 0xc3, 0x04,
-	0x44, 0x12, 0x3a, 0xb5, 0x46, 0x75, 0x0d, 0xc2, 0x39, 0x07, 0xfa, 0x7e, 0x26, 0xb2, 0x84, 0x47,
-	0xe3, 0x5c, 0x17, 0xbf, 0x44, 0xbb, 0xa7, 0x22, 0xa1, 0xb1, 0x18, 0xf3, 0xbe, 0x5d, 0x49, 0x39,
-	0x3b, 0x8d, 0x8d, 0x83, 0xad, 0x60, 0xf9, 0x03, 0x66, 0x08, 0x2f, 0x5f, 0x83, 0xb3, 0x6b, 0xd6,
-	0xf1, 0x4b, 0xd6, 0x09, 0x28, 0xf0, 0x4e, 0xa6, 0x3b, 0xd5, 0x32, 0xbb, 0xd0, 0x4e, 0xba, 0xd8,
-	0x6f, 0x5f, 0x4f, 0xdc, 0xea, 0xcd, 0xc4, 0xad, 0xfe, 0x9d, 0xb8, 0xd5, 0x9f, 0x53, 0xb7, 0x72,
-	0x33, 0x75, 0x2b, 0xbf, 0xa7, 0x6e, 0xe5, 0xbc, 0x19, 0x0a, 0x88, 0x86, 0x3d, 0x8f, 0xc9, 0x2b,
-	0x73, 0xa9, 0xcd, 0xec, 0x40, 0x13, 0xd9, 0xe7, 0xfe, 0x68, 0xfe, 0x64, 0xe1, 0xc7, 0x80, 0xab,
-	0xde, 0xa6, 0x39, 0xc8, 0xe3, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xde, 0x67, 0x86, 0x4a, 0x6b,
-	0x05, 0x00, 0x00,
+	// 550 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0x41, 0x6f, 0xd3, 0x30,
+	0x14, 0xc7, 0x5b, 0x06, 0x8c, 0x79, 0x85, 0xad, 0xde, 0x90, 0xaa, 0x4a, 0x84, 0x8a, 0x0b, 0x13,
+	0xa3, 0xc9, 0xb4, 0x01, 0xe2, 0x4a, 0x8b, 0xd6, 0x21, 0x2a, 0x01, 0xa1, 0xa7, 0x69, 0x92, 0x71,
+	0x1d, 0x2f, 0xb1, 0x96, 0xc5, 0x55, 0xec, 0x4a, 0x5d, 0x3f, 0x05, 0x1f, 0x80, 0x0f, 0xb4, 0xe3,
+	0x8e, 0x9c, 0x10, 0x6a, 0xbf, 0xc8, 0x64, 0xc7, 0xeb, 0x92, 0xb6, 0x4a, 0x7a, 0x7b, 0x7a, 0x7e,
+	0xbf, 0xf7, 0x7f, 0x7a, 0x7f, 0xdb, 0x60, 0x7f, 0x4c, 0x25, 0x26, 0x01, 0x66, 0x91, 0xa3, 0x23,
+	0x1e, 0x53, 0x87, 0xc4, 0x5c, 0x88, 0x24, 0xe7, 0xd3, 0x88, 0x0a, 0x26, 0xec, 0x41, 0xcc, 0x25,
+	0x87, 0x2f, 0x66, 0xc5, 0xf6, 0x5d, 0xb1, 0x7d, 0x5f, 0x5c, 0x3f, 0xcc, 0xef, 0xa5, 0x43, 0xa4,
+	0x63, 0x24, 0x47, 0x49, 0xcb, 0xfa, 0x41, 0x3e, 0xe3, 0xd1, 0x10, 0x5f, 0x51, 0x0f, 0x11, 0x32,
+	0x23, 0x9a, 0x05, 0x13, 0x63, 0x81, 0x06, 0x31, 0x23, 0xd4, 0x94, 0x7f, 0xcc, 0x2f, 0x67, 0x51,
+	0x9f, 0x0f, 0x23, 0x0f, 0x05, 0x58, 0x04, 0x48, 0xf2, 0xb4, 0xd0, 0xd1, 0x6a, 0xa4, 0x8c, 0x31,
+	0xb9, 0xa0, 0xb1, 0x81, 0xde, 0xe7, 0x43, 0x21, 0x16, 0x12, 0xf5, 0x43, 0x4e, 0x2e, 0x50, 0x40,
+	0x99, 0x1f, 0x48, 0x83, 0xbd, 0xcb, 0xc7, 0xf8, 0x50, 0x2e, 0x13, 0xfb, 0x90, 0x4f, 0xc5, 0x58,
+	0x52, 0x14, 0xb2, 0x4b, 0x26, 0x69, 0x8c, 0xce, 0x43, 0xec, 0x1b, 0x1f, 0xeb, 0xbb, 0x3e, 0xf7,
+	0xb9, 0x0e, 0x1d, 0x15, 0x25, 0xd9, 0x57, 0x7f, 0xd6, 0x41, 0xa5, 0x93, 0xf8, 0xfd, 0x53, 0x62,
+	0x49, 0xe1, 0x39, 0xd8, 0xb9, 0x13, 0xee, 0x25, 0xba, 0x5d, 0x26, 0x64, 0xed, 0x41, 0x63, 0x6d,
+	0x6f, 0xf3, 0xd0, 0xb6, 0x73, 0x2f, 0x83, 0xfd, 0x2d, 0x4b, 0xb6, 0x1e, 0x5e, 0xff, 0x7b, 0x59,
+	0x72, 0x97, 0x35, 0x84, 0x5f, 0x41, 0xc5, 0xc7, 0xe2, 0xbb, 0x32, 0x4d, 0x0b, 0x3c, 0xd2, 0x02,
+	0xaf, 0x0b, 0x04, 0x3a, 0x06, 0x71, 0x33, 0x30, 0xfc, 0x01, 0x9e, 0xb6, 0x55, 0x51, 0x5b, 0x15,
+	0xf5, 0x46, 0xa2, 0xb6, 0xae, 0xbb, 0xed, 0x17, 0x74, 0x4b, 0x33, 0x6e, 0xb6, 0x03, 0xfc, 0x05,
+	0x76, 0x94, 0x6f, 0x2d, 0x65, 0xdb, 0x89, 0x76, 0x4d, 0x8f, 0xf9, 0x64, 0xa5, 0x3d, 0x74, 0xb3,
+	0xa4, 0xbb, 0xac, 0x15, 0x0c, 0xc1, 0x73, 0x73, 0x9d, 0x4e, 0xb0, 0x08, 0x7a, 0xbc, 0x4d, 0xe4,
+	0x48, 0x6b, 0x6c, 0x68, 0x8d, 0x83, 0x02, 0x8d, 0x2f, 0xf3, 0xac, 0xd9, 0xf6, 0xf2, 0xa6, 0x90,
+	0x82, 0xdd, 0xb9, 0xcb, 0x8b, 0x42, 0x25, 0xb6, 0xa9, 0xc5, 0x9a, 0xab, 0x89, 0x65, 0x7d, 0x85,
+	0x2c, 0x5a, 0xb0, 0xf5, 0x0c, 0x6c, 0x29, 0x1e, 0x61, 0x42, 0xf8, 0x30, 0x92, 0x2c, 0xf2, 0x6b,
+	0x95, 0x46, 0x79, 0x05, 0x85, 0x53, 0x2a, 0xf1, 0xa7, 0x19, 0x64, 0x14, 0x9e, 0x8d, 0x33, 0x59,
+	0xf8, 0x16, 0x54, 0x8f, 0x59, 0x84, 0x43, 0x36, 0xa6, 0x9e, 0x19, 0x49, 0xd4, 0xb6, 0x1b, 0x6b,
+	0x7b, 0x1b, 0xee, 0xe2, 0x01, 0x24, 0x00, 0x2e, 0xbe, 0x86, 0x5a, 0x55, 0x8f, 0xe3, 0x14, 0x8c,
+	0xe3, 0x62, 0x49, 0xbb, 0x09, 0x77, 0xac, 0x30, 0x33, 0xd0, 0x76, 0x3c, 0x97, 0x87, 0x67, 0xa0,
+	0x9a, 0xfe, 0xaf, 0x92, 0xa5, 0x42, 0xbd, 0xd4, 0x37, 0x05, 0x1a, 0x9f, 0x13, 0x2e, 0xe5, 0xdd,
+	0x96, 0x77, 0x9f, 0x52, 0xeb, 0x6c, 0x75, 0xae, 0x27, 0x56, 0xf9, 0x66, 0x62, 0x95, 0xff, 0x4f,
+	0xac, 0xf2, 0xef, 0xa9, 0x55, 0xba, 0x99, 0x5a, 0xa5, 0xbf, 0x53, 0xab, 0x74, 0xda, 0xf4, 0x99,
+	0x0c, 0x86, 0x7d, 0x9b, 0xf0, 0x4b, 0xfd, 0x0f, 0x34, 0x93, 0xe7, 0x1f, 0x71, 0x8f, 0x3a, 0xa3,
+	0xf4, 0x87, 0x20, 0xaf, 0x06, 0x54, 0xf4, 0x1f, 0xeb, 0xe7, 0x7e, 0x74, 0x3b, 0x00, 0xcd, 0x17,
+	0xd5, 0xd3, 0xfb, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -197,6 +207,22 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DelayedCctxList) > 0 {
+		for iNdEx := len(m.DelayedCctxList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelayedCctxList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
 	{
 		size, err := m.RateLimiterFlags.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -380,6 +406,12 @@ func (m *GenesisState) Size() (n int) {
 	}
 	l = m.RateLimiterFlags.Size()
 	n += 2 + l + sovGenesis(uint64(l))
+	if len(m.DelayedCctxList) > 0 {
+		for _, e := range m.DelayedCctxList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -720,6 +752,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelayedCctxList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelayedCctxList = append(m.DelayedCctxList, DelayedCctx{})
+			if err := m.DelayedCctxList[len(m.DelayedCctxList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
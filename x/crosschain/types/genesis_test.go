@@ -45,6 +45,10 @@ func TestGenesisState_Validate(t *testing.T) {
 					sample.GasPrice(t, "2"),
 				},
 				RateLimiterFlags: sample.RateLimiterFlags(),
+				DelayedCctxList: []types.DelayedCctx{
+					sample.DelayedCctx(t, "0"),
+					sample.DelayedCctx(t, "1"),
+				},
 			},
 			valid: true,
 		},
@@ -105,6 +109,16 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "duplicated delayedCctxList",
+			genState: &types.GenesisState{
+				DelayedCctxList: []types.DelayedCctx{
+					sample.DelayedCctx(t, "0"),
+					sample.DelayedCctx(t, "0"),
+				},
+			},
+			valid: false,
+		},
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
 			err := tc.genState.Validate()
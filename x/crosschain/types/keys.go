@@ -60,6 +60,18 @@ const (
 	ZetaAccountingKey = "ZetaAccounting-value-"
 
 	RateLimiterFlagsKey = "RateLimiterFlags-value-"
+
+	// RateLimiterSenderUsageKeyPrefix is the prefix to retrieve the value withdrawn by each sender in its window
+	RateLimiterSenderUsageKeyPrefix = "RateLimiterSenderUsage-value-"
+
+	// DelayedCctxKeyPrefix is the prefix to retrieve all DelayedCctx
+	DelayedCctxKeyPrefix = "DelayedCctx-value-"
+
+	// DelayedCctxReleaseKeyPrefix is the prefix of the index of the delayed cctxs by release height
+	DelayedCctxReleaseKeyPrefix = "DelayedCctxRelease-value-"
+
+	// LastUTXOConsolidationKeyPrefix is the prefix to retrieve the index of the last UTXO consolidation of each chain
+	LastUTXOConsolidationKeyPrefix = "LastUTXOConsolidation-value-"
 )
 
 // OutboundTrackerKey returns the store key to retrieve a OutboundTracker from the index fields
@@ -0,0 +1,50 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgCancelDelayedCCTX = "CancelDelayedCCTX"
+
+var _ sdk.Msg = &MsgCancelDelayedCCTX{}
+
+func NewMsgCancelDelayedCCTX(creator string, cctxIndex string) *MsgCancelDelayedCCTX {
+	return &MsgCancelDelayedCCTX{
+		Creator:   creator,
+		CctxIndex: cctxIndex,
+	}
+}
+
+func (msg *MsgCancelDelayedCCTX) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgCancelDelayedCCTX) Type() string {
+	return TypeMsgCancelDelayedCCTX
+}
+
+func (msg *MsgCancelDelayedCCTX) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgCancelDelayedCCTX) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgCancelDelayedCCTX) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if len(msg.CctxIndex) != CCTXIndexLength {
+		return ErrInvalidIndexValue
+	}
+	return nil
+}
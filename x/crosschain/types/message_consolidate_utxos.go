@@ -0,0 +1,77 @@
+package types
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+const TypeMsgConsolidateUTXOs = "ConsolidateUTXOs"
+
+var _ sdk.Msg = &MsgConsolidateUTXOs{}
+
+func NewMsgConsolidateUTXOs(creator string, chainID int64, utxoCount, dustCount uint64) *MsgConsolidateUTXOs {
+	return &MsgConsolidateUTXOs{
+		Creator:   creator,
+		ChainId:   chainID,
+		UtxoCount: utxoCount,
+		DustCount: dustCount,
+	}
+}
+
+func (msg *MsgConsolidateUTXOs) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgConsolidateUTXOs) Type() string {
+	return TypeMsgConsolidateUTXOs
+}
+
+func (msg *MsgConsolidateUTXOs) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgConsolidateUTXOs) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgConsolidateUTXOs) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.ChainId < 0 {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidChainID, "chain id (%d)", msg.ChainId)
+	}
+	if msg.DustCount > msg.UtxoCount {
+		return errorsmod.Wrapf(
+			sdkerrors.ErrInvalidRequest,
+			"dust count (%d) greater than UTXO count (%d)",
+			msg.DustCount,
+			msg.UtxoCount,
+		)
+	}
+	return nil
+}
+
+// Digest returns the index of the ballot of the consolidation round of the TSS following the last consolidation
+// The UTXOs observed are not part of the digest so the votes of all observers are added to the same ballot, the
+// round only changes when a consolidation is created so the votes don't depend on the outbounds scheduled meanwhile
+func (msg *MsgConsolidateUTXOs) Digest(tssPubkey string, lastConsolidation string) string {
+	hash := crypto.Keccak256Hash([]byte(fmt.Sprintf(
+		"%s-%d-%s-%s",
+		TypeMsgConsolidateUTXOs,
+		msg.ChainId,
+		tssPubkey,
+		lastConsolidation,
+	)))
+	return hash.Hex()
+}
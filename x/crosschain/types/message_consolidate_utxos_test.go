@@ -0,0 +1,119 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/node/pkg/chains"
+	"github.com/zeta-chain/node/pkg/sdkconfig"
+	"github.com/zeta-chain/node/testutil/sample"
+	"github.com/zeta-chain/node/x/crosschain/types"
+)
+
+func TestMsgConsolidateUTXOs_ValidateBasic(t *testing.T) {
+	sdkconfig.SetDefault(false)
+	tests := []struct {
+		name  string
+		msg   *types.MsgConsolidateUTXOs
+		error bool
+	}{
+		{
+			name:  "invalid creator",
+			msg:   types.NewMsgConsolidateUTXOs("invalid address", chains.BitcoinMainnet.ChainId, 100, 10),
+			error: true,
+		},
+		{
+			name:  "invalid chain id",
+			msg:   types.NewMsgConsolidateUTXOs(sample.AccAddress(), -1, 100, 10),
+			error: true,
+		},
+		{
+			name:  "dust count greater than UTXO count",
+			msg:   types.NewMsgConsolidateUTXOs(sample.AccAddress(), chains.BitcoinMainnet.ChainId, 10, 11),
+			error: true,
+		},
+		{
+			name: "valid msg",
+			msg:  types.NewMsgConsolidateUTXOs(sample.AccAddress(), chains.BitcoinMainnet.ChainId, 100, 10),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.error {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgConsolidateUTXOs_Digest(t *testing.T) {
+	tssPubkey := sample.PubKeyString()
+	lastConsolidation := sample.GetCctxIndexFromString("consolidation")
+	msg := types.NewMsgConsolidateUTXOs(sample.AccAddress(), chains.BitcoinMainnet.ChainId, 100, 10)
+	digest := msg.Digest(tssPubkey, lastConsolidation)
+
+	// the voter and the UTXOs observed don't change the ballot
+	other := types.NewMsgConsolidateUTXOs(sample.AccAddress(), chains.BitcoinMainnet.ChainId, 120, 0)
+	require.Equal(t, digest, other.Digest(tssPubkey, lastConsolidation))
+
+	// the TSS, the last consolidation and the chain change the ballot
+	require.NotEqual(t, digest, msg.Digest(sample.PubKeyString(), lastConsolidation))
+	require.NotEqual(t, digest, msg.Digest(tssPubkey, ""))
+	other = types.NewMsgConsolidateUTXOs(sample.AccAddress(), chains.BitcoinTestnet.ChainId, 100, 10)
+	require.NotEqual(t, digest, other.Digest(tssPubkey, lastConsolidation))
+}
+
+func TestMsgConsolidateUTXOs_GetSigners(t *testing.T) {
+	signer := sample.AccAddress()
+	tests := []struct {
+		name   string
+		msg    types.MsgConsolidateUTXOs
+		panics bool
+	}{
+		{
+			name:   "valid signer",
+			msg:    types.MsgConsolidateUTXOs{Creator: signer, ChainId: chains.BitcoinMainnet.ChainId},
+			panics: false,
+		},
+		{
+			name:   "invalid signer",
+			msg:    types.MsgConsolidateUTXOs{Creator: "invalid_address", ChainId: chains.BitcoinMainnet.ChainId},
+			panics: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !tt.panics {
+				signers := tt.msg.GetSigners()
+				require.Equal(t, []sdk.AccAddress{sdk.MustAccAddressFromBech32(signer)}, signers)
+			} else {
+				require.Panics(t, func() {
+					tt.msg.GetSigners()
+				})
+			}
+		})
+	}
+}
+
+func TestMsgConsolidateUTXOs_Type(t *testing.T) {
+	msg := types.NewMsgConsolidateUTXOs(sample.AccAddress(), chains.BitcoinMainnet.ChainId, 100, 10)
+	require.Equal(t, types.TypeMsgConsolidateUTXOs, msg.Type())
+}
+
+func TestMsgConsolidateUTXOs_Route(t *testing.T) {
+	msg := types.NewMsgConsolidateUTXOs(sample.AccAddress(), chains.BitcoinMainnet.ChainId, 100, 10)
+	require.Equal(t, types.RouterKey, msg.Route())
+}
+
+func TestMsgConsolidateUTXOs_GetSignBytes(t *testing.T) {
+	msg := types.NewMsgConsolidateUTXOs(sample.AccAddress(), chains.BitcoinMainnet.ChainId, 100, 10)
+	require.NotPanics(t, func() {
+		msg.GetSignBytes()
+	})
+}
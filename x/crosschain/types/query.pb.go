@@ -1648,6 +1648,8 @@ type QueryRateLimiterInputResponse struct {
 	PastCctxsValue          string          `protobuf:"bytes,5,opt,name=past_cctxs_value,json=pastCctxsValue,proto3" json:"past_cctxs_value,omitempty"`
 	PendingCctxsValue       string          `protobuf:"bytes,6,opt,name=pending_cctxs_value,json=pendingCctxsValue,proto3" json:"pending_cctxs_value,omitempty"`
 	LowestPendingCctxHeight int64           `protobuf:"varint,7,opt,name=lowest_pending_cctx_height,json=lowestPendingCctxHeight,proto3" json:"lowest_pending_cctx_height,omitempty"`
+	// true if pending cctxs are held back by a chain or asset quota
+	QuotaExceeded bool `protobuf:"varint,8,opt,name=quota_exceeded,json=quotaExceeded,proto3" json:"quota_exceeded,omitempty"`
 }
 
 func (m *QueryRateLimiterInputResponse) Reset()         { *m = QueryRateLimiterInputResponse{} }
@@ -1732,6 +1734,13 @@ func (m *QueryRateLimiterInputResponse) GetLowestPendingCctxHeight() int64 {
 	return 0
 }
 
+func (m *QueryRateLimiterInputResponse) GetQuotaExceeded() bool {
+	if m != nil {
+		return m.QuotaExceeded
+	}
+	return false
+}
+
 type QueryListPendingCctxWithinRateLimitRequest struct {
 	Limit uint32 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
 }
@@ -1786,6 +1795,8 @@ type QueryListPendingCctxWithinRateLimitResponse struct {
 	CurrentWithdrawWindow int64           `protobuf:"varint,3,opt,name=current_withdraw_window,json=currentWithdrawWindow,proto3" json:"current_withdraw_window,omitempty"`
 	CurrentWithdrawRate   string          `protobuf:"bytes,4,opt,name=current_withdraw_rate,json=currentWithdrawRate,proto3" json:"current_withdraw_rate,omitempty"`
 	RateLimitExceeded     bool            `protobuf:"varint,5,opt,name=rate_limit_exceeded,json=rateLimitExceeded,proto3" json:"rate_limit_exceeded,omitempty"`
+	// true if pending cctxs are held back by a chain or asset quota
+	QuotaExceeded bool `protobuf:"varint,6,opt,name=quota_exceeded,json=quotaExceeded,proto3" json:"quota_exceeded,omitempty"`
 }
 
 func (m *QueryListPendingCctxWithinRateLimitResponse) Reset() {
@@ -1860,6 +1871,125 @@ func (m *QueryListPendingCctxWithinRateLimitResponse) GetRateLimitExceeded() boo
 	return false
 }
 
+func (m *QueryListPendingCctxWithinRateLimitResponse) GetQuotaExceeded() bool {
+	if m != nil {
+		return m.QuotaExceeded
+	}
+	return false
+}
+
+type QueryRateLimiterQuotaUsageRequest struct {
+}
+
+func (m *QueryRateLimiterQuotaUsageRequest) Reset()         { *m = QueryRateLimiterQuotaUsageRequest{} }
+func (m *QueryRateLimiterQuotaUsageRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRateLimiterQuotaUsageRequest) ProtoMessage()    {}
+func (*QueryRateLimiterQuotaUsageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d00cb546ea76908b, []int{37}
+}
+func (m *QueryRateLimiterQuotaUsageRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRateLimiterQuotaUsageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRateLimiterQuotaUsageRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRateLimiterQuotaUsageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRateLimiterQuotaUsageRequest.Merge(m, src)
+}
+func (m *QueryRateLimiterQuotaUsageRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRateLimiterQuotaUsageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRateLimiterQuotaUsageRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRateLimiterQuotaUsageRequest proto.InternalMessageInfo
+
+type QueryRateLimiterQuotaUsageResponse struct {
+	Height       int64              `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Window       int64              `protobuf:"varint,2,opt,name=window,proto3" json:"window,omitempty"`
+	ChainUsages  []ChainQuotaUsage  `protobuf:"bytes,3,rep,name=chain_usages,json=chainUsages,proto3" json:"chain_usages"`
+	SenderUsages []SenderQuotaUsage `protobuf:"bytes,4,rep,name=sender_usages,json=senderUsages,proto3" json:"sender_usages"`
+	AssetUsages  []AssetQuotaUsage  `protobuf:"bytes,5,rep,name=asset_usages,json=assetUsages,proto3" json:"asset_usages"`
+}
+
+func (m *QueryRateLimiterQuotaUsageResponse) Reset()         { *m = QueryRateLimiterQuotaUsageResponse{} }
+func (m *QueryRateLimiterQuotaUsageResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRateLimiterQuotaUsageResponse) ProtoMessage()    {}
+func (*QueryRateLimiterQuotaUsageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d00cb546ea76908b, []int{38}
+}
+func (m *QueryRateLimiterQuotaUsageResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRateLimiterQuotaUsageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRateLimiterQuotaUsageResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRateLimiterQuotaUsageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRateLimiterQuotaUsageResponse.Merge(m, src)
+}
+func (m *QueryRateLimiterQuotaUsageResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRateLimiterQuotaUsageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRateLimiterQuotaUsageResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRateLimiterQuotaUsageResponse proto.InternalMessageInfo
+
+func (m *QueryRateLimiterQuotaUsageResponse) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *QueryRateLimiterQuotaUsageResponse) GetWindow() int64 {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
+func (m *QueryRateLimiterQuotaUsageResponse) GetChainUsages() []ChainQuotaUsage {
+	if m != nil {
+		return m.ChainUsages
+	}
+	return nil
+}
+
+func (m *QueryRateLimiterQuotaUsageResponse) GetSenderUsages() []SenderQuotaUsage {
+	if m != nil {
+		return m.SenderUsages
+	}
+	return nil
+}
+
+func (m *QueryRateLimiterQuotaUsageResponse) GetAssetUsages() []AssetQuotaUsage {
+	if m != nil {
+		return m.AssetUsages
+	}
+	return nil
+}
+
 type QueryLastZetaHeightRequest struct {
 }
 
@@ -1867,7 +1997,7 @@ func (m *QueryLastZetaHeightRequest) Reset()         { *m = QueryLastZetaHeightR
 func (m *QueryLastZetaHeightRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryLastZetaHeightRequest) ProtoMessage()    {}
 func (*QueryLastZetaHeightRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d00cb546ea76908b, []int{37}
+	return fileDescriptor_d00cb546ea76908b, []int{39}
 }
 func (m *QueryLastZetaHeightRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1904,7 +2034,7 @@ func (m *QueryLastZetaHeightResponse) Reset()         { *m = QueryLastZetaHeight
 func (m *QueryLastZetaHeightResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryLastZetaHeightResponse) ProtoMessage()    {}
 func (*QueryLastZetaHeightResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d00cb546ea76908b, []int{38}
+	return fileDescriptor_d00cb546ea76908b, []int{40}
 }
 func (m *QueryLastZetaHeightResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1949,7 +2079,7 @@ func (m *QueryConvertGasToZetaRequest) Reset()         { *m = QueryConvertGasToZ
 func (m *QueryConvertGasToZetaRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryConvertGasToZetaRequest) ProtoMessage()    {}
 func (*QueryConvertGasToZetaRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d00cb546ea76908b, []int{39}
+	return fileDescriptor_d00cb546ea76908b, []int{41}
 }
 func (m *QueryConvertGasToZetaRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2002,7 +2132,7 @@ func (m *QueryConvertGasToZetaResponse) Reset()         { *m = QueryConvertGasTo
 func (m *QueryConvertGasToZetaResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryConvertGasToZetaResponse) ProtoMessage()    {}
 func (*QueryConvertGasToZetaResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d00cb546ea76908b, []int{40}
+	return fileDescriptor_d00cb546ea76908b, []int{42}
 }
 func (m *QueryConvertGasToZetaResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2059,7 +2189,7 @@ func (m *QueryMessagePassingProtocolFeeRequest) Reset()         { *m = QueryMess
 func (m *QueryMessagePassingProtocolFeeRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryMessagePassingProtocolFeeRequest) ProtoMessage()    {}
 func (*QueryMessagePassingProtocolFeeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d00cb546ea76908b, []int{41}
+	return fileDescriptor_d00cb546ea76908b, []int{43}
 }
 func (m *QueryMessagePassingProtocolFeeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2098,7 +2228,7 @@ func (m *QueryMessagePassingProtocolFeeResponse) Reset() {
 func (m *QueryMessagePassingProtocolFeeResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryMessagePassingProtocolFeeResponse) ProtoMessage()    {}
 func (*QueryMessagePassingProtocolFeeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d00cb546ea76908b, []int{42}
+	return fileDescriptor_d00cb546ea76908b, []int{44}
 }
 func (m *QueryMessagePassingProtocolFeeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2141,7 +2271,7 @@ func (m *QueryRateLimiterFlagsRequest) Reset()         { *m = QueryRateLimiterFl
 func (m *QueryRateLimiterFlagsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryRateLimiterFlagsRequest) ProtoMessage()    {}
 func (*QueryRateLimiterFlagsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d00cb546ea76908b, []int{43}
+	return fileDescriptor_d00cb546ea76908b, []int{45}
 }
 func (m *QueryRateLimiterFlagsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2178,7 +2308,7 @@ func (m *QueryRateLimiterFlagsResponse) Reset()         { *m = QueryRateLimiterF
 func (m *QueryRateLimiterFlagsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryRateLimiterFlagsResponse) ProtoMessage()    {}
 func (*QueryRateLimiterFlagsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d00cb546ea76908b, []int{44}
+	return fileDescriptor_d00cb546ea76908b, []int{46}
 }
 func (m *QueryRateLimiterFlagsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2223,7 +2353,7 @@ func (m *QueryInboundTrackerRequest) Reset()         { *m = QueryInboundTrackerR
 func (m *QueryInboundTrackerRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryInboundTrackerRequest) ProtoMessage()    {}
 func (*QueryInboundTrackerRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d00cb546ea76908b, []int{45}
+	return fileDescriptor_d00cb546ea76908b, []int{47}
 }
 func (m *QueryInboundTrackerRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2274,7 +2404,7 @@ func (m *QueryInboundTrackerResponse) Reset()         { *m = QueryInboundTracker
 func (m *QueryInboundTrackerResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryInboundTrackerResponse) ProtoMessage()    {}
 func (*QueryInboundTrackerResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d00cb546ea76908b, []int{46}
+	return fileDescriptor_d00cb546ea76908b, []int{48}
 }
 func (m *QueryInboundTrackerResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2348,6 +2478,8 @@ func init() {
 	proto.RegisterType((*QueryRateLimiterInputResponse)(nil), "zetachain.zetacore.crosschain.QueryRateLimiterInputResponse")
 	proto.RegisterType((*QueryListPendingCctxWithinRateLimitRequest)(nil), "zetachain.zetacore.crosschain.QueryListPendingCctxWithinRateLimitRequest")
 	proto.RegisterType((*QueryListPendingCctxWithinRateLimitResponse)(nil), "zetachain.zetacore.crosschain.QueryListPendingCctxWithinRateLimitResponse")
+	proto.RegisterType((*QueryRateLimiterQuotaUsageRequest)(nil), "zetachain.zetacore.crosschain.QueryRateLimiterQuotaUsageRequest")
+	proto.RegisterType((*QueryRateLimiterQuotaUsageResponse)(nil), "zetachain.zetacore.crosschain.QueryRateLimiterQuotaUsageResponse")
 	proto.RegisterType((*QueryLastZetaHeightRequest)(nil), "zetachain.zetacore.crosschain.QueryLastZetaHeightRequest")
 	proto.RegisterType((*QueryLastZetaHeightResponse)(nil), "zetachain.zetacore.crosschain.QueryLastZetaHeightResponse")
 	proto.RegisterType((*QueryConvertGasToZetaRequest)(nil), "zetachain.zetacore.crosschain.QueryConvertGasToZetaRequest")
@@ -2365,154 +2497,162 @@ func init() {
 }
 
 var fileDescriptor_d00cb546ea76908b = []byte{
-	// 2350 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5a, 0xcb, 0x6f, 0x1c, 0x49,
-	0x19, 0x4f, 0x79, 0xf2, 0x2c, 0x27, 0xf6, 0xba, 0xe2, 0x24, 0xde, 0xd9, 0xc4, 0xc9, 0x76, 0x36,
-	0xb1, 0x37, 0xc1, 0x33, 0x89, 0x1d, 0x3b, 0xcf, 0xdd, 0xc4, 0x8f, 0xc4, 0x31, 0x72, 0x12, 0xef,
-	0xc8, 0x22, 0x28, 0x3c, 0x5a, 0xe5, 0x9e, 0xda, 0x99, 0x66, 0xdb, 0xdd, 0xb3, 0xd3, 0x35, 0xf1,
-	0x64, 0x2d, 0x1f, 0x58, 0x89, 0x03, 0x37, 0xa4, 0x15, 0xe2, 0xc2, 0x11, 0x04, 0x07, 0x0e, 0x1c,
-	0xd0, 0x5e, 0x10, 0x48, 0xbc, 0x23, 0x16, 0xa4, 0xb0, 0x48, 0x08, 0x71, 0x40, 0x4b, 0x82, 0xe0,
-	0xce, 0x5f, 0x80, 0xba, 0xfa, 0xeb, 0x99, 0x7e, 0x77, 0xcd, 0x78, 0x22, 0x79, 0x4f, 0xee, 0xee,
-	0xaa, 0xef, 0xab, 0xdf, 0xef, 0xfb, 0xea, 0xf1, 0xd5, 0x6f, 0x8c, 0xdf, 0xfc, 0x80, 0x71, 0xaa,
-	0x55, 0xa9, 0x6e, 0x16, 0xc5, 0x93, 0x55, 0x67, 0x45, 0xad, 0x6e, 0xd9, 0xb6, 0xfb, 0xed, 0xfd,
-	0x06, 0xab, 0x3f, 0x29, 0xd4, 0xea, 0x16, 0xb7, 0xc8, 0x89, 0x56, 0xd7, 0x82, 0xd7, 0xb5, 0xd0,
-	0xee, 0x9a, 0x3f, 0xa7, 0x59, 0xf6, 0xba, 0x65, 0x17, 0xd7, 0xa8, 0xcd, 0x5c, 0xbb, 0xe2, 0xe3,
-	0x8b, 0x6b, 0x8c, 0xd3, 0x8b, 0xc5, 0x1a, 0xad, 0xe8, 0x26, 0xe5, 0xba, 0x65, 0xba, 0xae, 0xf2,
-	0x93, 0xe9, 0xa3, 0x8a, 0x47, 0x55, 0x3c, 0xab, 0xbc, 0x09, 0x36, 0x13, 0xe9, 0x36, 0x15, 0x6a,
-	0xab, 0xb5, 0xba, 0xae, 0x31, 0xe8, 0x7e, 0x25, 0xbd, 0xbb, 0x6e, 0xae, 0x59, 0x0d, 0xb3, 0xac,
-	0x56, 0xa9, 0x5d, 0x55, 0xb9, 0xa5, 0x6a, 0x5a, 0x6b, 0xa0, 0x29, 0x39, 0x4b, 0x5e, 0xa7, 0xda,
-	0x7b, 0xac, 0x0e, 0x46, 0xd3, 0xe9, 0x46, 0x06, 0xb5, 0xb9, 0xba, 0x66, 0x58, 0xda, 0x7b, 0x6a,
-	0x95, 0xe9, 0x95, 0x2a, 0x07, 0xb3, 0x4b, 0xe9, 0x66, 0x56, 0x83, 0xc7, 0x0d, 0x36, 0x93, 0x6e,
-	0x55, 0xa7, 0x9c, 0xa9, 0x86, 0xbe, 0xae, 0x73, 0x56, 0x57, 0xdf, 0x35, 0x68, 0xc5, 0x06, 0xbb,
-	0xe1, 0x8a, 0x55, 0xb1, 0xc4, 0x63, 0xd1, 0x79, 0x82, 0xaf, 0xc7, 0x2b, 0x96, 0x55, 0x31, 0x58,
-	0x91, 0xd6, 0xf4, 0x22, 0x35, 0x4d, 0x8b, 0x8b, 0x4c, 0x81, 0x8d, 0x72, 0x1c, 0xe7, 0xdf, 0x71,
-	0x92, 0xf9, 0x88, 0x71, 0x3a, 0xab, 0x69, 0x56, 0xc3, 0xe4, 0xba, 0x59, 0x29, 0xb1, 0xf7, 0x1b,
-	0xcc, 0xe6, 0xca, 0x3d, 0xfc, 0x5a, 0x6c, 0xab, 0x5d, 0xb3, 0x4c, 0x9b, 0x91, 0x02, 0x3e, 0x4c,
-	0xd7, 0xac, 0x3a, 0x67, 0x65, 0xd5, 0x01, 0xaa, 0xd2, 0x75, 0xa7, 0xc7, 0x08, 0x3a, 0x85, 0xc6,
-	0x0f, 0x94, 0x86, 0xa0, 0x49, 0xd8, 0x8a, 0x06, 0x65, 0x05, 0x8f, 0x0a, 0x77, 0x8b, 0x8c, 0x3f,
-	0x00, 0xea, 0xab, 0x2e, 0x73, 0x18, 0x90, 0x8c, 0xe0, 0x7d, 0x82, 0xe4, 0xd2, 0x82, 0xf0, 0x92,
-	0x2b, 0x79, 0xaf, 0x64, 0x18, 0xef, 0x31, 0x2d, 0x53, 0x63, 0x23, 0x7d, 0xa7, 0xd0, 0xf8, 0xee,
-	0x92, 0xfb, 0xa2, 0x7c, 0x13, 0xe1, 0x93, 0x89, 0x2e, 0x01, 0xe5, 0xd7, 0xf1, 0xa0, 0x15, 0x6c,
-	0x12, 0xbe, 0xfb, 0x27, 0x0b, 0x85, 0xd4, 0x29, 0x5f, 0x08, 0x39, 0x9c, 0xdb, 0xfd, 0xf4, 0x9f,
-	0x27, 0x77, 0x95, 0xc2, 0xce, 0x94, 0x2a, 0xb0, 0x9a, 0x35, 0x8c, 0x04, 0x56, 0x77, 0x30, 0x6e,
-	0xaf, 0x11, 0x18, 0xfc, 0x6c, 0xc1, 0x5d, 0x50, 0x05, 0x67, 0x41, 0x15, 0xdc, 0x85, 0x08, 0x0b,
-	0xaa, 0xb0, 0x42, 0x2b, 0x0c, 0x6c, 0x4b, 0x3e, 0x4b, 0xe5, 0x8f, 0x1e, 0xdb, 0xb8, 0xa1, 0xd2,
-	0xd8, 0xe6, 0x7a, 0xc6, 0x96, 0x2c, 0x06, 0xb8, 0xf4, 0x09, 0x2e, 0x63, 0x99, 0x5c, 0x5c, 0x70,
-	0x01, 0x32, 0xdf, 0x42, 0xf8, 0x4c, 0x02, 0x99, 0xb9, 0x27, 0xf3, 0x0e, 0x24, 0x2f, 0x7c, 0xc3,
-	0x78, 0x8f, 0x80, 0x08, 0x53, 0xc2, 0x7d, 0x09, 0x05, 0xb5, 0xaf, 0xeb, 0xa0, 0xfe, 0x05, 0xe1,
-	0xb3, 0x59, 0x38, 0x3e, 0x6f, 0xb1, 0xfd, 0x36, 0xc2, 0x6f, 0x78, 0x9c, 0x96, 0xcc, 0x94, 0xd0,
-	0xbe, 0x8a, 0xf7, 0xbb, 0xfb, 0xb0, 0x5e, 0x0e, 0x2e, 0xb8, 0x72, 0xcf, 0xe2, 0xfb, 0x67, 0x5f,
-	0x9e, 0x13, 0xb0, 0x40, 0x78, 0xbf, 0x82, 0x07, 0x74, 0x33, 0x26, 0xba, 0x13, 0x19, 0xd1, 0x0d,
-	0x79, 0x75, 0x83, 0x1b, 0x72, 0xd5, 0xbb, 0xd8, 0xfa, 0x96, 0x7b, 0x70, 0x60, 0xbb, 0xd7, 0xcb,
-	0xfd, 0x0f, 0xbe, 0xe5, 0x1e, 0x19, 0xea, 0x73, 0x15, 0xb3, 0x05, 0x7c, 0xca, 0xdb, 0xa5, 0x61,
-	0xe0, 0xbb, 0xd4, 0xae, 0xae, 0x5a, 0xf3, 0x1a, 0x6f, 0x7a, 0x51, 0x3b, 0x85, 0xfb, 0xf5, 0x76,
-	0x1b, 0x1c, 0x22, 0xfe, 0x4f, 0xce, 0xac, 0x7e, 0x3d, 0xc5, 0x0d, 0x44, 0xa4, 0x8c, 0x87, 0xf4,
-	0x70, 0x23, 0x24, 0xe1, 0x82, 0x5c, 0x50, 0xda, 0x76, 0x10, 0x97, 0xa8, 0x43, 0xe5, 0x36, 0x40,
-	0x89, 0x98, 0x2c, 0x50, 0x4e, 0xe5, 0x29, 0x6d, 0x61, 0x25, 0xcd, 0x0d, 0x50, 0x7a, 0x88, 0x0f,
-	0xcd, 0x3b, 0x28, 0xc5, 0x72, 0x59, 0x6d, 0xda, 0x90, 0xe3, 0xf3, 0x19, 0x74, 0xfc, 0x36, 0xc0,
-	0x24, 0xe8, 0x47, 0xf9, 0x06, 0xe4, 0xa5, 0x3d, 0xc1, 0xa2, 0x79, 0xe9, 0xd5, 0x6c, 0xfe, 0xd4,
-	0xcb, 0x5e, 0xfc, 0x60, 0xe9, 0xd9, 0xcb, 0xf5, 0x34, 0x7b, 0xbd, 0x9b, 0xd8, 0x45, 0x7c, 0xcc,
-	0x9b, 0x91, 0x8b, 0xd4, 0x5e, 0x71, 0x0a, 0x54, 0xdf, 0xa9, 0xa5, 0x9b, 0x65, 0xd6, 0x84, 0xb4,
-	0xbb, 0x2f, 0x8a, 0x8a, 0x47, 0xa2, 0x06, 0xc0, 0x7d, 0x1e, 0xef, 0xf7, 0xbe, 0x41, 0x9c, 0xc7,
-	0x32, 0x28, 0xb7, 0x5c, 0xb4, 0x0c, 0x15, 0x0a, 0x88, 0x66, 0x0d, 0x23, 0x8c, 0xa8, 0x57, 0x99,
-	0xfc, 0x31, 0x02, 0x12, 0x81, 0x31, 0x62, 0x49, 0xe4, 0xba, 0x22, 0xd1, 0xbb, 0xfc, 0xcc, 0xb4,
-	0x2b, 0xce, 0x65, 0x6a, 0xf3, 0x39, 0xa7, 0x44, 0xbf, 0x2b, 0x2a, 0xf4, 0xf4, 0x34, 0x6d, 0xb6,
-	0xcb, 0xca, 0x88, 0x1d, 0x10, 0xfd, 0x32, 0x1e, 0x0c, 0x35, 0x49, 0x96, 0x95, 0x61, 0x87, 0x61,
-	0x37, 0xfe, 0x13, 0x26, 0x01, 0x74, 0xaf, 0x32, 0xf9, 0x5b, 0xdf, 0x09, 0xd3, 0x11, 0xcf, 0x5c,
-	0x0f, 0x78, 0xf6, 0x2e, 0xcb, 0xe7, 0xf1, 0x61, 0x2f, 0x5b, 0xfe, 0x9d, 0x2b, 0x3e, 0xb5, 0xcb,
-	0x70, 0xe3, 0x81, 0xce, 0x73, 0x4f, 0xee, 0x3b, 0x37, 0x89, 0x6e, 0x2f, 0x20, 0x15, 0x3c, 0x1c,
-	0x1c, 0x1a, 0xa2, 0xf6, 0x00, 0x1f, 0xf4, 0x6f, 0xb5, 0x90, 0xa3, 0x4e, 0x76, 0xec, 0x52, 0xc0,
-	0x81, 0xf2, 0x35, 0xe0, 0x38, 0x6b, 0x18, 0x2f, 0x63, 0x77, 0xfe, 0x29, 0x02, 0x22, 0x2d, 0xff,
-	0x89, 0x44, 0x72, 0xdb, 0x22, 0xd2, 0xbb, 0xac, 0xdf, 0x87, 0xcb, 0xe9, 0xb2, 0x6e, 0xf3, 0x15,
-	0x66, 0x96, 0x75, 0xb3, 0xe2, 0x8f, 0x4c, 0x4a, 0x69, 0x3b, 0x8c, 0xf7, 0x88, 0xfb, 0xb3, 0x18,
-	0xfd, 0x50, 0xc9, 0x7d, 0x51, 0x3e, 0x42, 0xf8, 0x78, 0xbc, 0xc3, 0x97, 0x15, 0x0a, 0x05, 0x1f,
-	0xe4, 0x16, 0xa7, 0x06, 0x0c, 0x06, 0x33, 0x2b, 0xf0, 0x4d, 0x59, 0x06, 0x50, 0x25, 0xca, 0xd9,
-	0xb2, 0x7b, 0xe9, 0x5f, 0x32, 0x6b, 0x0d, 0xff, 0xfe, 0xe5, 0x72, 0x41, 0x3e, 0x2e, 0xe4, 0x28,
-	0xde, 0xbb, 0xa1, 0x9b, 0x65, 0x6b, 0x43, 0xf8, 0xcc, 0x95, 0xe0, 0x4d, 0xf9, 0x6e, 0x0e, 0x9f,
-	0x48, 0x70, 0x07, 0x24, 0x8f, 0xe2, 0xbd, 0xd5, 0xf6, 0x6e, 0x96, 0x2b, 0xc1, 0x1b, 0xb9, 0x8f,
-	0x0f, 0x6a, 0x1a, 0x6f, 0xda, 0xea, 0xba, 0x6e, 0xdb, 0xac, 0x3c, 0xd2, 0xd7, 0x39, 0xf9, 0x7e,
-	0xe1, 0xe0, 0x9e, 0xb0, 0x27, 0x2b, 0xf8, 0x90, 0xeb, 0xaf, 0x06, 0xe4, 0x73, 0x5d, 0x44, 0x53,
-	0x78, 0x80, 0x48, 0x91, 0xd3, 0xf8, 0x90, 0x88, 0x5c, 0xcb, 0xe3, 0xee, 0x68, 0x38, 0xc9, 0x38,
-	0x7e, 0xa5, 0x46, 0x6d, 0xae, 0xba, 0x63, 0x3f, 0xa6, 0x46, 0x83, 0x8d, 0xec, 0x11, 0xdb, 0xc3,
-	0x80, 0xf3, 0xdd, 0xc9, 0xb7, 0xfd, 0x25, 0xe7, 0x2b, 0x29, 0xe0, 0xc3, 0xe0, 0x28, 0xd0, 0x79,
-	0xaf, 0x2b, 0x6e, 0xd4, 0xda, 0xf3, 0x03, 0xfa, 0x5f, 0xc7, 0x79, 0xc3, 0xda, 0x60, 0x36, 0x57,
-	0xfd, 0x66, 0xa0, 0x07, 0x8d, 0xec, 0x13, 0xc1, 0x3c, 0xe6, 0xf6, 0xf0, 0x4d, 0x2e, 0xd8, 0xf2,
-	0xe7, 0xf0, 0xb9, 0xb8, 0xa9, 0xf7, 0x50, 0xe7, 0x55, 0xdd, 0x6c, 0xe5, 0x2a, 0x35, 0xe7, 0xca,
-	0xaf, 0xfa, 0xf0, 0x79, 0x29, 0x27, 0x90, 0xe9, 0x77, 0xf0, 0x40, 0x50, 0x89, 0xeb, 0x6a, 0x42,
-	0x6b, 0xfe, 0x09, 0x1d, 0x49, 0x41, 0xcc, 0x8c, 0x26, 0x33, 0xf8, 0x98, 0xd6, 0xa8, 0xd7, 0x99,
-	0xc9, 0xd5, 0x0d, 0x9d, 0x57, 0xcb, 0x75, 0xba, 0xa1, 0xc2, 0x64, 0xcd, 0x89, 0x28, 0x1d, 0x81,
-	0xe6, 0x87, 0xd0, 0xfa, 0x50, 0x34, 0x92, 0x49, 0x7c, 0x24, 0x62, 0x57, 0xa7, 0x9c, 0x89, 0x3c,
-	0x1f, 0x28, 0x1d, 0x0e, 0x59, 0x39, 0x84, 0x9d, 0x24, 0xb6, 0xe5, 0x32, 0x95, 0x35, 0x35, 0xc6,
-	0xca, 0xac, 0x2c, 0x32, 0xbe, 0xbf, 0x34, 0x54, 0xf7, 0x62, 0x72, 0x1b, 0x1a, 0x5a, 0x72, 0x98,
-	0x73, 0x54, 0x3d, 0x62, 0x9c, 0x06, 0x8e, 0x5d, 0x65, 0xda, 0xdb, 0x71, 0x42, 0xad, 0xed, 0xa5,
-	0x73, 0x37, 0xb0, 0x74, 0x20, 0xb9, 0xab, 0xb0, 0x84, 0xe7, 0x2d, 0xf3, 0x31, 0xab, 0x3b, 0x75,
-	0xdf, 0xaa, 0xe5, 0x98, 0x47, 0xce, 0x9c, 0xc8, 0x46, 0x95, 0xc7, 0xfb, 0x2b, 0xd4, 0x5e, 0x6e,
-	0xed, 0x55, 0x07, 0x4a, 0xad, 0x77, 0xe5, 0x87, 0x08, 0x96, 0x72, 0xd4, 0x2d, 0xe0, 0xf9, 0x02,
-	0x1e, 0xf2, 0x14, 0x86, 0x45, 0x6a, 0x2f, 0x99, 0x4e, 0xa3, 0x27, 0xce, 0x45, 0x1a, 0x9c, 0xde,
-	0x42, 0x12, 0xd4, 0x2c, 0xe3, 0x0e, 0x63, 0xd0, 0xbb, 0x0f, 0x66, 0x7b, 0xb8, 0x81, 0x8c, 0xe3,
-	0x41, 0xe7, 0xaf, 0xbf, 0x2a, 0xc8, 0x89, 0x5c, 0x87, 0x3f, 0x2b, 0x63, 0x70, 0xfd, 0xbf, 0xc7,
-	0x6c, 0x9b, 0x56, 0xd8, 0x0a, 0xb5, 0x6d, 0xdd, 0xac, 0xac, 0xb4, 0x3d, 0x7a, 0xd1, 0xbd, 0x03,
-	0x3a, 0x4c, 0x4a, 0x47, 0x20, 0x76, 0x1c, 0x1f, 0x78, 0xb7, 0x05, 0xd1, 0x25, 0xd4, 0xfe, 0xa0,
-	0x8c, 0x46, 0x77, 0xcc, 0x3b, 0x06, 0xad, 0x78, 0xd7, 0x73, 0xe5, 0x43, 0x14, 0xdd, 0x03, 0xa1,
-	0x03, 0xf8, 0xa7, 0xf8, 0x95, 0x7a, 0xa8, 0x0d, 0x8e, 0xd6, 0x62, 0xc6, 0xda, 0x08, 0xbb, 0x84,
-	0x2b, 0x48, 0xc4, 0x9d, 0xb2, 0x02, 0x13, 0x2d, 0x78, 0x0f, 0x97, 0x38, 0xbb, 0x8e, 0xe1, 0x7d,
-	0xce, 0xae, 0xe2, 0xdc, 0x27, 0xdd, 0xe4, 0xec, 0xe5, 0x4d, 0x71, 0x95, 0xdc, 0x84, 0xc9, 0x19,
-	0xf6, 0x08, 0x9c, 0xbe, 0x8a, 0x07, 0x43, 0xd2, 0x36, 0x50, 0xea, 0x85, 0x52, 0x30, 0xf9, 0x83,
-	0x49, 0xbc, 0x47, 0x8c, 0x4e, 0x3e, 0x45, 0x78, 0x30, 0x24, 0x77, 0x91, 0xb7, 0x32, 0x86, 0x48,
-	0x17, 0x85, 0xf3, 0x6f, 0x77, 0x6b, 0xee, 0x52, 0x57, 0x6e, 0x7d, 0xf8, 0xd7, 0x7f, 0x7f, 0xd4,
-	0x77, 0x8d, 0x5c, 0x11, 0x72, 0xfa, 0x84, 0xef, 0x47, 0x88, 0xa0, 0x0c, 0x0f, 0x76, 0xc5, 0x4d,
-	0x28, 0xf9, 0xb6, 0x8a, 0x9b, 0xa2, 0xc8, 0xdb, 0x22, 0xbf, 0x41, 0x98, 0x84, 0xbc, 0xcf, 0x1a,
-	0x86, 0x1c, 0xaf, 0x44, 0x59, 0x58, 0x8e, 0x57, 0xb2, 0xd4, 0xab, 0x14, 0x04, 0xaf, 0x71, 0x72,
-	0x56, 0x8e, 0x17, 0xf9, 0x2f, 0xc2, 0xaf, 0x46, 0x59, 0x80, 0x0a, 0x47, 0x16, 0xba, 0x43, 0x13,
-	0x14, 0x14, 0xf3, 0xb7, 0xb7, 0xe9, 0x05, 0xa8, 0xbd, 0x25, 0xa8, 0x5d, 0x26, 0xd3, 0x72, 0xd4,
-	0xc0, 0x1c, 0x32, 0xb7, 0x45, 0xfe, 0x83, 0xf0, 0x48, 0x70, 0xde, 0xfa, 0x88, 0xce, 0x4b, 0x42,
-	0x4c, 0x13, 0x4e, 0xf3, 0x0b, 0xdb, 0x73, 0x02, 0x34, 0x6f, 0x0a, 0x9a, 0x57, 0xc9, 0xe5, 0x04,
-	0x9a, 0xba, 0x99, 0xcc, 0x52, 0xd5, 0xcb, 0x5b, 0xe4, 0xd7, 0x08, 0x0f, 0x45, 0x88, 0x4a, 0xcf,
-	0xcb, 0x78, 0xfd, 0x52, 0x7a, 0x5e, 0x26, 0x68, 0x92, 0x99, 0xf3, 0x32, 0xc8, 0xca, 0x26, 0x9f,
-	0x20, 0x3c, 0x10, 0xf4, 0x45, 0xae, 0xca, 0x40, 0x88, 0xdd, 0x3b, 0xf3, 0xd7, 0xba, 0x31, 0x05,
-	0xe4, 0x73, 0x02, 0xf9, 0x0d, 0x72, 0x4d, 0x0a, 0xb9, 0x2f, 0x11, 0xc5, 0x4d, 0xd8, 0x94, 0xb7,
-	0xc8, 0xdf, 0xda, 0x29, 0xf1, 0x29, 0x4e, 0x37, 0x25, 0xf7, 0xb0, 0x24, 0x19, 0x2e, 0x7f, 0xab,
-	0x7b, 0x07, 0x40, 0xee, 0x6d, 0x41, 0xee, 0x0a, 0x99, 0x49, 0x27, 0xd7, 0xb6, 0x2c, 0x6e, 0xfa,
-	0x3e, 0x6d, 0x91, 0xcf, 0x10, 0x3e, 0x12, 0xab, 0x53, 0x92, 0x5b, 0x1d, 0x84, 0x3c, 0x56, 0x29,
-	0xcd, 0xcf, 0x6e, 0xc3, 0x43, 0x67, 0xb9, 0x0b, 0x5a, 0x87, 0x28, 0x7e, 0x82, 0xf0, 0x70, 0x64,
-	0x14, 0x67, 0x45, 0xdd, 0xec, 0x6c, 0x49, 0x74, 0x99, 0xbe, 0x34, 0x65, 0x54, 0xb9, 0x20, 0xf8,
-	0x9d, 0x23, 0xe3, 0xb2, 0xfc, 0xc8, 0x4f, 0x50, 0x5b, 0x8b, 0x23, 0x33, 0x92, 0xf3, 0x27, 0x24,
-	0x1a, 0xe6, 0x2f, 0x77, 0x6c, 0x07, 0x78, 0x8b, 0x02, 0xef, 0x9b, 0x64, 0x2c, 0x01, 0x6f, 0x05,
-	0x0c, 0x9c, 0x14, 0x94, 0x59, 0x73, 0x8b, 0xfc, 0x08, 0xe1, 0x7e, 0xcf, 0x8b, 0x13, 0xf3, 0x19,
-	0xc9, 0x90, 0x75, 0x85, 0x38, 0x46, 0xba, 0x54, 0xc6, 0x04, 0xe2, 0xd7, 0xc9, 0xc9, 0x0c, 0xc4,
-	0xe4, 0x97, 0x08, 0xbf, 0x12, 0xae, 0xba, 0xc9, 0x75, 0x99, 0x61, 0x13, 0xae, 0x00, 0xf9, 0x1b,
-	0xdd, 0x19, 0x4b, 0x86, 0x5a, 0x0b, 0x63, 0xfd, 0x3d, 0xc2, 0xfd, 0xbe, 0xc2, 0x5a, 0xee, 0xec,
-	0xcf, 0x2a, 0xe0, 0xe5, 0xce, 0xfe, 0xcc, 0xea, 0x5e, 0x39, 0x27, 0xd8, 0xbc, 0x41, 0x94, 0x04,
-	0x36, 0xbe, 0xcb, 0x08, 0x79, 0x8a, 0x22, 0xea, 0xa4, 0x74, 0xb5, 0x19, 0xaf, 0xad, 0x4a, 0x57,
-	0x9b, 0x09, 0x7a, 0xa9, 0x32, 0x23, 0xe0, 0x5f, 0x20, 0x85, 0x04, 0xf8, 0x46, 0xd0, 0xae, 0x35,
-	0xfd, 0x9d, 0x1a, 0x33, 0xe4, 0xb3, 0x93, 0xb3, 0x7c, 0x3b, 0x6c, 0x92, 0xd5, 0xdf, 0xcc, 0xb3,
-	0x3c, 0xc4, 0x86, 0x7c, 0x1f, 0xe1, 0xdd, 0x62, 0xf3, 0x99, 0x94, 0x0c, 0xa3, 0x7f, 0x93, 0x9c,
-	0xea, 0xc8, 0x06, 0x10, 0x9e, 0x17, 0x08, 0xcf, 0x90, 0xd3, 0x49, 0x93, 0x1f, 0x4e, 0x32, 0x11,
-	0xe4, 0x9f, 0x21, 0xdc, 0xef, 0x53, 0x7d, 0xe5, 0xea, 0x8c, 0x58, 0xa5, 0xb8, 0x3b, 0xb0, 0xd3,
-	0x02, 0x6c, 0x91, 0x4c, 0xa4, 0x82, 0x8d, 0xdc, 0x3f, 0xbe, 0x87, 0xf0, 0x3e, 0xef, 0x28, 0x9a,
-	0x94, 0xcc, 0x68, 0xc7, 0x81, 0x0d, 0x29, 0xbf, 0xca, 0x69, 0x81, 0xf5, 0x04, 0x79, 0x2d, 0x05,
-	0x2b, 0xf9, 0xd8, 0x59, 0x80, 0x41, 0xbd, 0x89, 0x48, 0x55, 0x60, 0xf1, 0xaa, 0x6d, 0xfe, 0x7a,
-	0x57, 0xb6, 0xb2, 0x3b, 0x87, 0x0f, 0xe4, 0xff, 0x10, 0x1e, 0x4d, 0x17, 0xca, 0xc8, 0x52, 0x17,
-	0x58, 0xe2, 0x15, 0xbb, 0xfc, 0x17, 0x7b, 0xe1, 0x0a, 0x58, 0x5e, 0x15, 0x2c, 0xa7, 0xc8, 0xc5,
-	0x6c, 0x96, 0x61, 0x46, 0x1f, 0x23, 0x3c, 0x10, 0xfc, 0x5f, 0x2e, 0xb9, 0x15, 0x10, 0xfb, 0xdf,
-	0x61, 0x72, 0x95, 0x76, 0xfc, 0xbf, 0x8e, 0x29, 0x13, 0x82, 0xc4, 0x18, 0x39, 0x93, 0x40, 0xe2,
-	0x83, 0x20, 0x4a, 0x07, 0x78, 0x50, 0x75, 0x93, 0x03, 0x1e, 0xab, 0xe3, 0xc9, 0x01, 0x8f, 0x17,
-	0xf9, 0x32, 0x81, 0x1b, 0x41, 0x94, 0x4e, 0xa9, 0x10, 0x16, 0x85, 0xe4, 0x4a, 0x85, 0x04, 0xf9,
-	0x4a, 0xae, 0x54, 0x48, 0x92, 0xb6, 0x32, 0x4b, 0x85, 0xb0, 0x50, 0x15, 0x26, 0x20, 0x7e, 0x2c,
-	0xe8, 0x98, 0x80, 0xff, 0x17, 0x8b, 0x8e, 0x09, 0x04, 0x7e, 0x9f, 0xe8, 0x84, 0x80, 0x8b, 0xf5,
-	0x4f, 0x08, 0x1f, 0x7c, 0xd0, 0xe0, 0xab, 0xcd, 0x1d, 0xa2, 0x46, 0x49, 0x48, 0x1b, 0x2d, 0xac,
-	0x31, 0x47, 0xc1, 0x2f, 0x5c, 0x7d, 0xad, 0xd5, 0x65, 0x07, 0xe8, 0x50, 0x59, 0x27, 0xb0, 0x9f,
-	0x11, 0xf9, 0x17, 0xc2, 0x47, 0x43, 0xf8, 0x77, 0xa4, 0x02, 0x75, 0x4d, 0x90, 0xba, 0x44, 0x26,
-	0x25, 0x48, 0x85, 0xe5, 0x27, 0xf7, 0xa6, 0x1c, 0x47, 0x71, 0x07, 0x69, 0x4f, 0x37, 0x04, 0xc1,
-	0x19, 0x72, 0x29, 0xf1, 0x3e, 0x99, 0xc0, 0x4f, 0x08, 0x4f, 0x3f, 0x17, 0x9a, 0x4d, 0x57, 0xb3,
-	0xf0, 0x25, 0xa9, 0x4e, 0x59, 0x87, 0xbf, 0x8f, 0x0f, 0x79, 0x06, 0xe8, 0x77, 0x96, 0x40, 0x73,
-	0x5d, 0x30, 0x98, 0x26, 0x53, 0x29, 0x0c, 0x12, 0xd5, 0x99, 0x7f, 0x20, 0x4c, 0x82, 0x94, 0x76,
-	0x8e, 0x34, 0x93, 0x2d, 0x73, 0x86, 0x71, 0x87, 0xc8, 0xfd, 0x4e, 0x68, 0x6a, 0xfe, 0x4e, 0x3b,
-	0x44, 0x94, 0xc9, 0xaa, 0x06, 0x82, 0xcc, 0xe6, 0x16, 0x9f, 0x3e, 0x1f, 0x45, 0xcf, 0x9e, 0x8f,
-	0xa2, 0xcf, 0x9e, 0x8f, 0xa2, 0xef, 0xbc, 0x18, 0xdd, 0xf5, 0xec, 0xc5, 0xe8, 0xae, 0xbf, 0xbf,
-	0x18, 0xdd, 0xf5, 0x68, 0xa2, 0xa2, 0xf3, 0x6a, 0x63, 0xad, 0xa0, 0x59, 0xeb, 0x7e, 0x57, 0xa6,
-	0x55, 0x66, 0xc5, 0xa6, 0xdf, 0x23, 0x7f, 0x52, 0x63, 0xf6, 0xda, 0x5e, 0x71, 0x09, 0x9e, 0xfa,
-	0x7f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x4b, 0xf0, 0x93, 0x1b, 0xe0, 0x31, 0x00, 0x00,
+	// 2480 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5a, 0x4b, 0x6c, 0x14, 0xd9,
+	0x15, 0xe5, 0xb9, 0xb1, 0x81, 0xeb, 0x0f, 0xf0, 0x30, 0xd0, 0x53, 0x80, 0xf1, 0x14, 0x03, 0xf6,
+	0xc0, 0xb8, 0x1b, 0x6c, 0x30, 0x60, 0x18, 0x83, 0x3f, 0xd8, 0x38, 0x32, 0x60, 0x3a, 0x4e, 0x1c,
+	0x91, 0x4f, 0xab, 0x5c, 0xfd, 0xa6, 0xbb, 0x32, 0xed, 0xaa, 0xa6, 0xab, 0x1a, 0x37, 0x63, 0x79,
+	0x91, 0x91, 0xb2, 0xc8, 0x2e, 0xd2, 0x2c, 0xb2, 0xc9, 0x36, 0x4a, 0x16, 0x59, 0x64, 0x11, 0xcd,
+	0x26, 0x9f, 0x45, 0xbe, 0x28, 0x93, 0x48, 0x84, 0x48, 0x51, 0x94, 0x45, 0x34, 0x81, 0x28, 0xc9,
+	0x3a, 0xbb, 0xec, 0x46, 0xf5, 0xea, 0x56, 0x77, 0xfd, 0xbb, 0xba, 0xdc, 0x48, 0x9e, 0x95, 0xbb,
+	0xde, 0x7b, 0xf7, 0xbc, 0x7b, 0xee, 0x7d, 0x9f, 0x5b, 0xa7, 0x0c, 0x6f, 0x7f, 0xc0, 0x0c, 0x49,
+	0x2e, 0x49, 0x8a, 0x9a, 0xe5, 0xbf, 0xb4, 0x2a, 0xcb, 0xca, 0x55, 0x4d, 0xd7, 0xad, 0xb6, 0xc7,
+	0x35, 0x56, 0x7d, 0x9a, 0xa9, 0x54, 0x35, 0x43, 0xa3, 0xa7, 0x1a, 0x43, 0x33, 0xf6, 0xd0, 0x4c,
+	0x73, 0xa8, 0x70, 0x5e, 0xd6, 0xf4, 0x0d, 0x4d, 0xcf, 0xae, 0x4b, 0x3a, 0xb3, 0xec, 0xb2, 0x4f,
+	0x2e, 0xad, 0x33, 0x43, 0xba, 0x94, 0xad, 0x48, 0x45, 0x45, 0x95, 0x0c, 0x45, 0x53, 0x2d, 0x28,
+	0x61, 0x3c, 0x7a, 0x56, 0xfe, 0x33, 0xcf, 0x7f, 0xe7, 0x8d, 0x3a, 0xda, 0x8c, 0x45, 0xdb, 0x14,
+	0x25, 0x3d, 0x5f, 0xa9, 0x2a, 0x32, 0xc3, 0xe1, 0xd7, 0xa2, 0x87, 0x2b, 0xea, 0xba, 0x56, 0x53,
+	0x0b, 0xf9, 0x92, 0xa4, 0x97, 0xf2, 0x86, 0x96, 0x97, 0xe5, 0xc6, 0x44, 0x13, 0xf1, 0x2c, 0x8d,
+	0xaa, 0x24, 0xbf, 0xcf, 0xaa, 0x68, 0x74, 0x25, 0xda, 0xa8, 0x2c, 0xe9, 0x46, 0x7e, 0xbd, 0xac,
+	0xc9, 0xef, 0xe7, 0x4b, 0x4c, 0x29, 0x96, 0x0c, 0x34, 0xbb, 0x1c, 0x6d, 0xa6, 0xd5, 0x8c, 0xa0,
+	0xc9, 0x26, 0xa3, 0xad, 0xaa, 0x92, 0xc1, 0xf2, 0x65, 0x65, 0x43, 0x31, 0x58, 0x35, 0xff, 0x5e,
+	0x59, 0x2a, 0xea, 0x68, 0x37, 0x58, 0xd4, 0x8a, 0x1a, 0xff, 0x99, 0x35, 0x7f, 0x61, 0xeb, 0xc9,
+	0xa2, 0xa6, 0x15, 0xcb, 0x2c, 0x2b, 0x55, 0x94, 0xac, 0xa4, 0xaa, 0x9a, 0xc1, 0x33, 0x85, 0x36,
+	0xe2, 0x49, 0x10, 0x1e, 0x9a, 0xc9, 0x7c, 0xc4, 0x0c, 0x69, 0x46, 0x96, 0xb5, 0x9a, 0x6a, 0x28,
+	0x6a, 0x31, 0xc7, 0x1e, 0xd7, 0x98, 0x6e, 0x88, 0xf7, 0xe0, 0x44, 0x60, 0xaf, 0x5e, 0xd1, 0x54,
+	0x9d, 0xd1, 0x0c, 0x1c, 0x91, 0xd6, 0xb5, 0xaa, 0xc1, 0x0a, 0x79, 0xd3, 0xd1, 0xbc, 0xb4, 0x61,
+	0x8e, 0x48, 0x93, 0x61, 0x32, 0x7a, 0x20, 0x77, 0x18, 0xbb, 0xb8, 0x2d, 0xef, 0x10, 0x57, 0x60,
+	0x88, 0xc3, 0x2d, 0x32, 0xe3, 0x01, 0x52, 0x5f, 0xb5, 0x98, 0xe3, 0x84, 0x34, 0x0d, 0xfb, 0x38,
+	0xc9, 0xa5, 0x79, 0x8e, 0x92, 0xca, 0xd9, 0x8f, 0x74, 0x10, 0xba, 0x55, 0x4d, 0x95, 0x59, 0xba,
+	0x6b, 0x98, 0x8c, 0xee, 0xcd, 0x59, 0x0f, 0xe2, 0xb7, 0x08, 0x9c, 0x0e, 0x85, 0x44, 0x2f, 0xbf,
+	0x01, 0x07, 0x35, 0x77, 0x17, 0xc7, 0xee, 0x1d, 0xcf, 0x64, 0x22, 0x97, 0x7c, 0xc6, 0x03, 0x38,
+	0xbb, 0xf7, 0xd9, 0x3f, 0x4e, 0xef, 0xc9, 0x79, 0xc1, 0xc4, 0x12, 0xb2, 0x9a, 0x29, 0x97, 0x43,
+	0x58, 0x2d, 0x00, 0x34, 0xf7, 0x08, 0x4e, 0x7e, 0x2e, 0x63, 0x6d, 0xa8, 0x8c, 0xb9, 0xa1, 0x32,
+	0xd6, 0x46, 0xc4, 0x0d, 0x95, 0x59, 0x91, 0x8a, 0x0c, 0x6d, 0x73, 0x0e, 0x4b, 0xf1, 0x0f, 0x36,
+	0xdb, 0xa0, 0xa9, 0xa2, 0xd8, 0xa6, 0x3a, 0xc6, 0x96, 0x2e, 0xba, 0xb8, 0x74, 0x71, 0x2e, 0x23,
+	0x2d, 0xb9, 0x58, 0xce, 0xb9, 0xc8, 0x7c, 0x9b, 0xc0, 0xd9, 0x10, 0x32, 0xb3, 0x4f, 0xe7, 0x4c,
+	0x97, 0xec, 0xf0, 0x0d, 0x42, 0x37, 0x77, 0x11, 0x97, 0x84, 0xf5, 0x40, 0x17, 0x02, 0x1c, 0x49,
+	0x12, 0xd4, 0x3f, 0x13, 0x38, 0xd7, 0xca, 0x8f, 0xcf, 0x5b, 0x6c, 0xbf, 0x43, 0xe0, 0x2d, 0x9b,
+	0xd3, 0x92, 0x1a, 0x11, 0xda, 0x37, 0x60, 0xbf, 0x75, 0x0e, 0x2b, 0x05, 0xf7, 0x86, 0x2b, 0x74,
+	0x2c, 0xbe, 0x7f, 0x72, 0xe4, 0x39, 0xc4, 0x17, 0x0c, 0xef, 0x57, 0x61, 0x40, 0x51, 0x03, 0xa2,
+	0x3b, 0xd6, 0x22, 0xba, 0x4b, 0x6a, 0x40, 0x70, 0x3d, 0x50, 0x9d, 0x8b, 0xad, 0x63, 0xbb, 0xbb,
+	0x27, 0xd6, 0x3b, 0xbd, 0xdd, 0x7f, 0xef, 0xd8, 0xee, 0xbe, 0xa9, 0x3e, 0x57, 0x31, 0x9b, 0x87,
+	0x61, 0xfb, 0x94, 0xc6, 0x89, 0xef, 0x4a, 0x7a, 0x69, 0x55, 0x9b, 0x93, 0x8d, 0xba, 0x1d, 0xb5,
+	0x61, 0xe8, 0x55, 0x9a, 0x7d, 0x78, 0x89, 0x38, 0x9b, 0xcc, 0x55, 0xfd, 0x66, 0x04, 0x0c, 0x46,
+	0xa4, 0x00, 0x87, 0x15, 0x6f, 0x27, 0x26, 0xe1, 0x62, 0xbc, 0xa0, 0x34, 0xed, 0x30, 0x2e, 0x7e,
+	0x40, 0xf1, 0x0e, 0xba, 0xe2, 0x33, 0x99, 0x97, 0x0c, 0x29, 0x3e, 0xa5, 0x6d, 0x10, 0xa3, 0x60,
+	0x90, 0xd2, 0x1a, 0xf4, 0xcf, 0x99, 0x5e, 0xf2, 0xed, 0xb2, 0x5a, 0xd7, 0x31, 0xc7, 0x17, 0x5a,
+	0xd0, 0x71, 0xda, 0x20, 0x13, 0x37, 0x8e, 0xf8, 0x4d, 0x18, 0xf6, 0x2c, 0x30, 0x7f, 0x5e, 0x3a,
+	0xb5, 0x9a, 0x5f, 0xd8, 0xd9, 0x0b, 0x9e, 0x2c, 0x3a, 0x7b, 0xa9, 0x8e, 0x66, 0xaf, 0x73, 0x0b,
+	0x3b, 0x0b, 0xc7, 0xed, 0x15, 0xb9, 0x28, 0xe9, 0x2b, 0x55, 0x45, 0x66, 0x8e, 0x5b, 0x4b, 0x51,
+	0x0b, 0xac, 0x8e, 0x69, 0xb7, 0x1e, 0xc4, 0x3c, 0xa4, 0xfd, 0x06, 0xc8, 0x7d, 0x0e, 0xf6, 0xdb,
+	0x6d, 0x18, 0xe7, 0x91, 0x16, 0x94, 0x1b, 0x10, 0x0d, 0x43, 0x51, 0x42, 0x8f, 0x66, 0xca, 0x65,
+	0xaf, 0x47, 0x9d, 0xca, 0xe4, 0x8f, 0x08, 0xa4, 0xfd, 0x73, 0x04, 0x92, 0x48, 0x25, 0x22, 0xd1,
+	0xb9, 0xfc, 0x4c, 0x36, 0x2b, 0xce, 0x65, 0x49, 0x37, 0x66, 0xcd, 0x12, 0xfd, 0x2e, 0xaf, 0xd0,
+	0xa3, 0xd3, 0xb4, 0x05, 0xa7, 0x43, 0xed, 0x90, 0xe8, 0x57, 0xe0, 0xa0, 0xa7, 0x2b, 0x66, 0x59,
+	0xe9, 0x05, 0xf4, 0xc2, 0x38, 0x6f, 0x98, 0x10, 0xa7, 0x3b, 0x95, 0xc9, 0xdf, 0x38, 0x6e, 0x98,
+	0xb6, 0x78, 0xa6, 0x3a, 0xc0, 0xb3, 0x73, 0x59, 0xbe, 0x00, 0x47, 0xec, 0x6c, 0x39, 0x4f, 0xae,
+	0xe0, 0xd4, 0x2e, 0x83, 0xe0, 0x1c, 0x3c, 0xfb, 0xf4, 0xbe, 0xa6, 0xca, 0x2c, 0xe9, 0x0b, 0x48,
+	0x11, 0x06, 0xdd, 0x53, 0x63, 0xd4, 0x1e, 0x40, 0x9f, 0xf3, 0xa8, 0xc5, 0x1c, 0xb5, 0x73, 0x62,
+	0xe7, 0x5c, 0x00, 0xe2, 0xd7, 0x91, 0xe3, 0x4c, 0xb9, 0xfc, 0x3a, 0x4e, 0xe7, 0x9f, 0x10, 0x18,
+	0x74, 0xe3, 0x87, 0x12, 0x49, 0xed, 0x88, 0x48, 0xe7, 0xb2, 0x7e, 0x1f, 0x5f, 0x4e, 0x97, 0x15,
+	0xdd, 0x58, 0x61, 0x6a, 0x41, 0x51, 0x8b, 0xce, 0xc8, 0x44, 0x94, 0xb6, 0x83, 0xd0, 0xcd, 0xdf,
+	0x9f, 0xf9, 0xec, 0xfd, 0x39, 0xeb, 0x41, 0xfc, 0x88, 0xc0, 0xc9, 0x60, 0xc0, 0xd7, 0x15, 0x0a,
+	0x11, 0xfa, 0x0c, 0xcd, 0x90, 0xca, 0x38, 0x19, 0xae, 0x2c, 0x57, 0x9b, 0xb8, 0x8c, 0x4e, 0xe5,
+	0x24, 0x83, 0x2d, 0x5b, 0x2f, 0xfd, 0x4b, 0x6a, 0xa5, 0xe6, 0x3c, 0xbf, 0x2c, 0x2e, 0xc4, 0xc1,
+	0x85, 0x1e, 0x83, 0x9e, 0x4d, 0x45, 0x2d, 0x68, 0x9b, 0x1c, 0x33, 0x95, 0xc3, 0x27, 0xf1, 0xe7,
+	0x29, 0x38, 0x15, 0x02, 0x87, 0x24, 0x8f, 0x41, 0x4f, 0xa9, 0x79, 0x9a, 0xa5, 0x72, 0xf8, 0x44,
+	0xef, 0x43, 0x9f, 0x29, 0xa2, 0xe8, 0xf9, 0x0d, 0x45, 0xd7, 0x59, 0x21, 0xdd, 0xd5, 0x3e, 0xf9,
+	0x5e, 0x0e, 0x70, 0x8f, 0xdb, 0xd3, 0x15, 0xe8, 0xb7, 0xf0, 0x2a, 0x48, 0x3e, 0x95, 0x20, 0x9a,
+	0x1c, 0x01, 0x23, 0x45, 0xcf, 0x40, 0x3f, 0x8f, 0x5c, 0x03, 0x71, 0xaf, 0x3f, 0x9c, 0x74, 0x14,
+	0x0e, 0x55, 0x4c, 0xb1, 0xc6, 0x9a, 0xfb, 0x89, 0x54, 0xae, 0xb1, 0x74, 0x37, 0x3f, 0x1e, 0x06,
+	0xcc, 0x76, 0x33, 0xdf, 0xfa, 0x97, 0xcd, 0x56, 0x53, 0xdc, 0x40, 0x20, 0xd7, 0xe0, 0x1e, 0x4b,
+	0xdc, 0xa8, 0x34, 0xd7, 0x07, 0x8e, 0xbf, 0x01, 0x42, 0x59, 0xdb, 0x64, 0xba, 0x91, 0x77, 0x9a,
+	0xa1, 0x1e, 0x94, 0xde, 0xc7, 0x83, 0x79, 0xdc, 0x1a, 0xe1, 0x58, 0x5c, 0x78, 0x14, 0x9e, 0x85,
+	0x81, 0xc7, 0x35, 0xcd, 0x90, 0xf2, 0xac, 0x2e, 0x33, 0x56, 0x60, 0x85, 0xf4, 0xfe, 0x61, 0x32,
+	0xba, 0x3f, 0xd7, 0xcf, 0x5b, 0xef, 0x60, 0xa3, 0x38, 0x0b, 0xe7, 0x83, 0x56, 0xe8, 0x9a, 0x62,
+	0x94, 0x14, 0xb5, 0x91, 0xd2, 0xc8, 0xa5, 0x21, 0xfe, 0xb7, 0x0b, 0x2e, 0xc4, 0x02, 0xc1, 0x05,
+	0xf1, 0x10, 0x06, 0xdc, 0x82, 0x5d, 0xa2, 0x75, 0x2f, 0x3b, 0x9e, 0xfc, 0x99, 0x0a, 0x58, 0xf8,
+	0x74, 0x12, 0x8e, 0xcb, 0xb5, 0x6a, 0x95, 0xa9, 0x46, 0x7e, 0x53, 0x31, 0x4a, 0x85, 0xaa, 0xb4,
+	0x99, 0xc7, 0x35, 0x9d, 0xe2, 0xc1, 0x3c, 0x8a, 0xdd, 0x6b, 0xd8, 0xbb, 0xc6, 0x3b, 0xe9, 0x38,
+	0x1c, 0xf5, 0xd9, 0x55, 0x25, 0x83, 0xf1, 0xe5, 0x70, 0x20, 0x77, 0xc4, 0x63, 0x65, 0x12, 0x36,
+	0x73, 0xdd, 0x54, 0xd5, 0x9a, 0x39, 0xe8, 0xe6, 0x39, 0x38, 0x5c, 0xb5, 0x63, 0x62, 0xe7, 0x21,
+	0x20, 0x5d, 0x3d, 0x41, 0xe9, 0x3a, 0x83, 0x15, 0xaf, 0x63, 0xb3, 0x3d, 0x34, 0x07, 0x7c, 0x49,
+	0x6f, 0x9e, 0xc2, 0xe2, 0xcb, 0x2e, 0x10, 0xa3, 0x46, 0xb5, 0xd8, 0x97, 0x21, 0x3b, 0x9d, 0xae,
+	0x41, 0x9f, 0x95, 0xb0, 0x9a, 0x09, 0xa3, 0xa7, 0x53, 0xb1, 0xee, 0x6c, 0x9e, 0xa1, 0xe6, 0xec,
+	0x58, 0x41, 0xf7, 0xf2, 0x4e, 0xde, 0xa2, 0xd3, 0x47, 0xd0, 0xaf, 0x33, 0xb5, 0xc0, 0xaa, 0x36,
+	0xf2, 0x5e, 0x8e, 0x9c, 0x6d, 0x81, 0xfc, 0x45, 0x6e, 0xe3, 0x83, 0xee, 0xb3, 0xb0, 0x10, 0x7b,
+	0x0d, 0xfa, 0x24, 0x5d, 0x67, 0x86, 0x0d, 0xdd, 0x1d, 0xcb, 0xe9, 0x19, 0xd3, 0xc4, 0xef, 0x34,
+	0x47, 0xb2, 0x80, 0x1b, 0x32, 0xa7, 0x59, 0x82, 0x98, 0x82, 0xa4, 0xab, 0x9c, 0x12, 0xaf, 0xc0,
+	0x89, 0xc0, 0xde, 0x66, 0xe8, 0xef, 0xba, 0x42, 0x6f, 0x3d, 0x89, 0xab, 0x78, 0x34, 0xcf, 0x69,
+	0xea, 0x13, 0x56, 0x35, 0xeb, 0xf9, 0x55, 0xcd, 0x34, 0xf7, 0xd5, 0x12, 0xbe, 0x0b, 0x48, 0x80,
+	0xfd, 0x45, 0x49, 0x5f, 0x6e, 0xdc, 0x41, 0x07, 0x72, 0x8d, 0x67, 0xf1, 0x07, 0x04, 0x4e, 0x85,
+	0xc0, 0xa2, 0x3f, 0xef, 0xc0, 0x61, 0x5b, 0x39, 0x5a, 0x94, 0xf4, 0x25, 0xd5, 0xec, 0xb4, 0x45,
+	0x57, 0x5f, 0x87, 0x39, 0x9a, 0x4b, 0xbd, 0xb2, 0x56, 0x5e, 0x60, 0x0c, 0x47, 0x77, 0xe1, 0x29,
+	0xe6, 0xed, 0xa0, 0xa3, 0x70, 0xd0, 0xfc, 0xeb, 0xac, 0xf6, 0x52, 0x7c, 0x73, 0x7a, 0x9b, 0xc5,
+	0x11, 0x94, 0x75, 0xee, 0x31, 0xdd, 0x8c, 0xf1, 0x8a, 0xa4, 0xeb, 0x8a, 0x5a, 0x5c, 0x69, 0x22,
+	0xda, 0xd1, 0x5d, 0x80, 0x73, 0xad, 0x06, 0x22, 0xb1, 0x93, 0x70, 0xe0, 0x3d, 0xc6, 0x5c, 0x84,
+	0x9a, 0x0d, 0xe2, 0x90, 0xff, 0x26, 0x5c, 0x30, 0xd5, 0x6f, 0x7b, 0x9e, 0x0f, 0x09, 0x9c, 0x0a,
+	0x19, 0x80, 0xf8, 0x12, 0x1c, 0xaa, 0x7a, 0xfa, 0xb0, 0x64, 0x6a, 0xb5, 0x7a, 0xbd, 0x90, 0xb8,
+	0xc6, 0x7c, 0x70, 0xe2, 0x0a, 0x2e, 0x34, 0xb7, 0xbe, 0x12, 0xa3, 0x26, 0x39, 0x0e, 0xfb, 0xcc,
+	0xdb, 0xc2, 0xd4, 0x09, 0xac, 0xe4, 0xf4, 0x18, 0x75, 0x2e, 0x11, 0x6c, 0xc1, 0x89, 0x40, 0x44,
+	0xe4, 0xf4, 0x35, 0x38, 0xe8, 0xf9, 0x64, 0x81, 0x94, 0x3a, 0xa1, 0x00, 0x8d, 0xff, 0x7f, 0x02,
+	0xba, 0xf9, 0xec, 0xf4, 0x05, 0x81, 0x83, 0x1e, 0x19, 0x93, 0xbe, 0xdb, 0x62, 0x8a, 0x68, 0xb1,
+	0x5f, 0x98, 0x4e, 0x6a, 0x6e, 0x51, 0x17, 0x6f, 0x7f, 0xf8, 0x97, 0x7f, 0x7d, 0xd4, 0x35, 0x45,
+	0xaf, 0xf1, 0xcf, 0x24, 0x63, 0x8e, 0x8f, 0x4b, 0xee, 0xcf, 0x2b, 0x68, 0x97, 0xdd, 0xc2, 0x52,
+	0x7e, 0x3b, 0xbb, 0xc5, 0x8b, 0xf7, 0x6d, 0xfa, 0x6b, 0x02, 0xd4, 0x83, 0x3e, 0x53, 0x2e, 0xc7,
+	0xe3, 0x15, 0x2a, 0xf7, 0x0b, 0xd3, 0x49, 0xcd, 0x91, 0x57, 0x86, 0xf3, 0x1a, 0xa5, 0xe7, 0xe2,
+	0xf1, 0xa2, 0xff, 0x21, 0xf0, 0x86, 0x9f, 0x05, 0xaa, 0xab, 0x74, 0x3e, 0x99, 0x37, 0x6e, 0xa1,
+	0x58, 0xb8, 0xb3, 0x43, 0x14, 0xa4, 0xf6, 0x2e, 0xa7, 0x76, 0x95, 0x5e, 0x89, 0x47, 0x0d, 0xcd,
+	0x31, 0x73, 0xdb, 0xf4, 0xdf, 0x04, 0xd2, 0x4b, 0x6a, 0x08, 0xd1, 0xb9, 0x98, 0x2e, 0x46, 0x09,
+	0xe2, 0xc2, 0xfc, 0xce, 0x40, 0x90, 0xe6, 0x2d, 0x4e, 0xf3, 0x3a, 0xbd, 0x1a, 0x42, 0x53, 0x51,
+	0xc3, 0x59, 0xe6, 0x95, 0xc2, 0x36, 0xfd, 0x15, 0x81, 0xc3, 0x4b, 0x6a, 0xd2, 0x75, 0x19, 0xac,
+	0x4b, 0x0b, 0xd3, 0x49, 0xcd, 0x63, 0xae, 0x4b, 0x37, 0x2b, 0x9d, 0x7e, 0x42, 0x60, 0xc0, 0x8d,
+	0x45, 0xaf, 0xc7, 0x71, 0x21, 0xf0, 0xec, 0x14, 0xa6, 0x92, 0x98, 0xa2, 0xe7, 0xb3, 0xdc, 0xf3,
+	0x9b, 0x74, 0x2a, 0x96, 0xe7, 0x8e, 0x44, 0x64, 0xb7, 0xf0, 0x50, 0xde, 0xa6, 0x7f, 0x6d, 0xa6,
+	0xc4, 0xa1, 0x24, 0xde, 0x8a, 0x79, 0x86, 0x85, 0xc9, 0xab, 0xc2, 0xed, 0xe4, 0x00, 0x48, 0x6e,
+	0x9a, 0x93, 0xbb, 0x46, 0x27, 0xa3, 0xc9, 0x35, 0x2d, 0xb3, 0x5b, 0x8e, 0xa6, 0x6d, 0xfa, 0x29,
+	0x81, 0xa3, 0x81, 0xfa, 0x33, 0xbd, 0xdd, 0x46, 0xc8, 0x03, 0x15, 0x70, 0x61, 0x66, 0x07, 0x08,
+	0xed, 0xe5, 0xce, 0x6d, 0xed, 0xa1, 0xf8, 0x09, 0x81, 0x41, 0xdf, 0x2c, 0xe6, 0x8e, 0xba, 0xd5,
+	0xde, 0x96, 0x48, 0x98, 0xbe, 0x28, 0xc5, 0x5b, 0xbc, 0xc8, 0xf9, 0x9d, 0xa7, 0xa3, 0x71, 0xf9,
+	0xd1, 0x1f, 0x93, 0xa6, 0xc6, 0x4a, 0x27, 0x63, 0xae, 0x1f, 0x8f, 0x18, 0x2c, 0x5c, 0x6d, 0xdb,
+	0x0e, 0xfd, 0xcd, 0x72, 0x7f, 0xdf, 0xa6, 0x23, 0x21, 0xfe, 0x16, 0xd1, 0xc0, 0x4c, 0x41, 0x81,
+	0xd5, 0xb7, 0xe9, 0x0f, 0x09, 0xf4, 0xda, 0x28, 0x66, 0xcc, 0x27, 0x63, 0x86, 0x2c, 0x91, 0xc7,
+	0x01, 0x92, 0xb4, 0x38, 0xc2, 0x3d, 0x7e, 0x93, 0x9e, 0x6e, 0xe1, 0x31, 0xfd, 0x25, 0x81, 0x43,
+	0xde, 0xaa, 0x9b, 0xde, 0x88, 0x33, 0x6d, 0xc8, 0x2b, 0x80, 0x70, 0x33, 0x99, 0x71, 0xcc, 0x50,
+	0xcb, 0x5e, 0x5f, 0x7f, 0x47, 0xa0, 0xd7, 0x51, 0x58, 0xc7, 0xbb, 0xfb, 0x5b, 0x15, 0xf0, 0xc2,
+	0x9d, 0x1d, 0xa2, 0x20, 0x9b, 0xf3, 0x9c, 0xcd, 0x5b, 0x54, 0x0c, 0x61, 0xe3, 0x78, 0x19, 0xa1,
+	0xcf, 0x88, 0x4f, 0x75, 0x8e, 0x5d, 0x6d, 0x06, 0x6b, 0xe6, 0xc2, 0x74, 0x52, 0x73, 0x74, 0x7f,
+	0x92, 0xbb, 0x7f, 0x91, 0x66, 0x42, 0xdc, 0x2f, 0xbb, 0xed, 0x1a, 0xcb, 0xdf, 0xac, 0x31, 0x3d,
+	0x98, 0xed, 0xdc, 0xe5, 0x3b, 0x61, 0x13, 0xae, 0xea, 0xb7, 0xbc, 0xcb, 0x3d, 0x6c, 0xe8, 0xf7,
+	0x09, 0xec, 0xe5, 0x87, 0xcf, 0x78, 0xcc, 0x30, 0x3a, 0x0f, 0xc9, 0x89, 0xb6, 0x6c, 0xd0, 0xc3,
+	0x0b, 0xdc, 0xc3, 0xb3, 0xf4, 0x4c, 0xd8, 0xe2, 0xc7, 0x9b, 0x8c, 0x07, 0xf9, 0xa7, 0x04, 0x7a,
+	0x1d, 0x6a, 0x3e, 0xbd, 0xde, 0xc6, 0x8c, 0xee, 0x2f, 0x00, 0xc9, 0x9c, 0xbd, 0xc2, 0x9d, 0xcd,
+	0xd2, 0xb1, 0x48, 0x67, 0x7d, 0xef, 0x1f, 0xdf, 0x23, 0xb0, 0xcf, 0xbe, 0x8a, 0xc6, 0x63, 0x66,
+	0xb4, 0xed, 0xc0, 0x7a, 0x14, 0x7d, 0xf1, 0x0c, 0xf7, 0xf5, 0x14, 0x3d, 0x11, 0xe1, 0x2b, 0xfd,
+	0xd8, 0xdc, 0x80, 0x6e, 0x81, 0x90, 0xc6, 0xaa, 0xc0, 0x82, 0xd5, 0x78, 0xe1, 0x46, 0x22, 0xdb,
+	0xb8, 0x27, 0x87, 0xc3, 0xc9, 0xff, 0x11, 0x18, 0x8a, 0x56, 0x36, 0xe9, 0x52, 0x02, 0x5f, 0x82,
+	0x25, 0x56, 0xe1, 0x0b, 0x9d, 0x80, 0x42, 0x96, 0xd7, 0x39, 0xcb, 0x09, 0x7a, 0xa9, 0x35, 0x4b,
+	0x2f, 0xa3, 0x8f, 0x09, 0x0c, 0xb8, 0xff, 0x47, 0x2f, 0xde, 0x0e, 0x08, 0xfc, 0xaf, 0x3f, 0x61,
+	0x2a, 0x89, 0x29, 0x92, 0x18, 0xe3, 0x24, 0x46, 0xe8, 0xd9, 0x10, 0x12, 0x1f, 0xb8, 0xbd, 0x34,
+	0x1d, 0x77, 0xab, 0x6e, 0xf1, 0x1c, 0x0f, 0xd4, 0xf1, 0x84, 0xa9, 0x24, 0xa6, 0x31, 0x1d, 0x2f,
+	0xbb, 0xbd, 0x34, 0x4b, 0x05, 0xaf, 0x28, 0x14, 0xaf, 0x54, 0x08, 0x91, 0xaf, 0x84, 0x9b, 0xc9,
+	0x8c, 0x63, 0x96, 0x0a, 0x5e, 0xa1, 0xca, 0x4b, 0x80, 0x7f, 0x04, 0x6a, 0x9b, 0x80, 0xf3, 0x4b,
+	0x94, 0x70, 0x33, 0x99, 0x71, 0xfb, 0x04, 0x2c, 0x5f, 0x5f, 0x10, 0x38, 0x1a, 0x28, 0x99, 0xc7,
+	0x7b, 0x6d, 0x89, 0xd2, 0xe4, 0x85, 0x99, 0x1d, 0x20, 0x20, 0x9f, 0xcb, 0x9c, 0x4f, 0x86, 0xbe,
+	0xd3, 0x9a, 0x8f, 0xc3, 0xf5, 0x3f, 0x12, 0xe8, 0x7b, 0x50, 0x33, 0x56, 0xeb, 0xbb, 0x44, 0x62,
+	0x8b, 0xa1, 0xd7, 0x34, 0x7c, 0x0d, 0xb8, 0xdf, 0x7e, 0x61, 0x89, 0x86, 0x8d, 0x21, 0xbb, 0x40,
+	0x5c, 0x6b, 0x55, 0x56, 0x38, 0x19, 0xd1, 0x7f, 0x12, 0x38, 0xe6, 0xf1, 0x7f, 0x57, 0xca, 0x6a,
+	0x53, 0x9c, 0xd4, 0x65, 0x3a, 0x1e, 0x83, 0x94, 0x57, 0x53, 0xb3, 0x5e, 0xff, 0x57, 0xeb, 0xbb,
+	0x5a, 0x50, 0xbb, 0xc9, 0x09, 0x4e, 0xd2, 0xcb, 0xa1, 0x2f, 0xc9, 0x21, 0xfc, 0xb8, 0x9a, 0xf6,
+	0x33, 0x2e, 0x44, 0x25, 0x5a, 0x85, 0xaf, 0x49, 0x4a, 0x6b, 0x55, 0xd1, 0x38, 0xf8, 0xd0, 0xe7,
+	0xe8, 0xfd, 0xee, 0x52, 0x9d, 0x6e, 0x70, 0x06, 0x57, 0xe8, 0x44, 0x04, 0x83, 0x50, 0xc9, 0xe9,
+	0xef, 0x04, 0xa8, 0x9b, 0xd2, 0xee, 0xd1, 0x9b, 0x5a, 0x6b, 0xb7, 0x5e, 0xbf, 0x3d, 0xe4, 0x7e,
+	0xcb, 0x85, 0x42, 0xe7, 0xa0, 0x5d, 0xa2, 0x34, 0xb5, 0x2a, 0x71, 0xdc, 0xcc, 0x66, 0x17, 0x9f,
+	0xbd, 0x1c, 0x22, 0xcf, 0x5f, 0x0e, 0x91, 0x4f, 0x5f, 0x0e, 0x91, 0xef, 0xbe, 0x1a, 0xda, 0xf3,
+	0xfc, 0xd5, 0xd0, 0x9e, 0xbf, 0xbd, 0x1a, 0xda, 0xf3, 0x68, 0xac, 0xa8, 0x18, 0xa5, 0xda, 0x7a,
+	0x46, 0xd6, 0x36, 0x9c, 0x50, 0xaa, 0x56, 0x60, 0xd9, 0xba, 0x13, 0xd1, 0x78, 0x5a, 0x61, 0xfa,
+	0x7a, 0x0f, 0x7f, 0xb3, 0x9f, 0xf8, 0x6c, 0x00, 0x4a, 0x66, 0xb4, 0xe3, 0x8d, 0x34, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2568,6 +2708,9 @@ type QueryClient interface {
 	RateLimiterFlags(ctx context.Context, in *QueryRateLimiterFlagsRequest, opts ...grpc.CallOption) (*QueryRateLimiterFlagsResponse, error)
 	// Queries the input data of rate limiter.
 	RateLimiterInput(ctx context.Context, in *QueryRateLimiterInputRequest, opts ...grpc.CallOption) (*QueryRateLimiterInputResponse, error)
+	// Queries the usage of the chain, sender and asset quotas of the rate
+	// limiter within the current window.
+	RateLimiterQuotaUsage(ctx context.Context, in *QueryRateLimiterQuotaUsageRequest, opts ...grpc.CallOption) (*QueryRateLimiterQuotaUsageResponse, error)
 	// Deprecated(v17): use OutboundTracker
 	OutTxTracker(ctx context.Context, in *QueryGetOutboundTrackerRequest, opts ...grpc.CallOption) (*QueryGetOutboundTrackerResponse, error)
 	// Deprecated(v17): use OutboundTrackerAll
@@ -2810,6 +2953,15 @@ func (c *queryClient) RateLimiterInput(ctx context.Context, in *QueryRateLimiter
 	return out, nil
 }
 
+func (c *queryClient) RateLimiterQuotaUsage(ctx context.Context, in *QueryRateLimiterQuotaUsageRequest, opts ...grpc.CallOption) (*QueryRateLimiterQuotaUsageResponse, error) {
+	out := new(QueryRateLimiterQuotaUsageResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/RateLimiterQuotaUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) OutTxTracker(ctx context.Context, in *QueryGetOutboundTrackerRequest, opts ...grpc.CallOption) (*QueryGetOutboundTrackerResponse, error) {
 	out := new(QueryGetOutboundTrackerResponse)
 	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/OutTxTracker", in, out, opts...)
@@ -2925,6 +3077,9 @@ type QueryServer interface {
 	RateLimiterFlags(context.Context, *QueryRateLimiterFlagsRequest) (*QueryRateLimiterFlagsResponse, error)
 	// Queries the input data of rate limiter.
 	RateLimiterInput(context.Context, *QueryRateLimiterInputRequest) (*QueryRateLimiterInputResponse, error)
+	// Queries the usage of the chain, sender and asset quotas of the rate
+	// limiter within the current window.
+	RateLimiterQuotaUsage(context.Context, *QueryRateLimiterQuotaUsageRequest) (*QueryRateLimiterQuotaUsageResponse, error)
 	// Deprecated(v17): use OutboundTracker
 	OutTxTracker(context.Context, *QueryGetOutboundTrackerRequest) (*QueryGetOutboundTrackerResponse, error)
 	// Deprecated(v17): use OutboundTrackerAll
@@ -3019,6 +3174,9 @@ func (*UnimplementedQueryServer) RateLimiterFlags(ctx context.Context, req *Quer
 func (*UnimplementedQueryServer) RateLimiterInput(ctx context.Context, req *QueryRateLimiterInputRequest) (*QueryRateLimiterInputResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RateLimiterInput not implemented")
 }
+func (*UnimplementedQueryServer) RateLimiterQuotaUsage(ctx context.Context, req *QueryRateLimiterQuotaUsageRequest) (*QueryRateLimiterQuotaUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RateLimiterQuotaUsage not implemented")
+}
 func (*UnimplementedQueryServer) OutTxTracker(ctx context.Context, req *QueryGetOutboundTrackerRequest) (*QueryGetOutboundTrackerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method OutTxTracker not implemented")
 }
@@ -3480,6 +3638,24 @@ func _Query_RateLimiterInput_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_RateLimiterQuotaUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRateLimiterQuotaUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RateLimiterQuotaUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Query/RateLimiterQuotaUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RateLimiterQuotaUsage(ctx, req.(*QueryRateLimiterQuotaUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_OutTxTracker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryGetOutboundTrackerRequest)
 	if err := dec(in); err != nil {
@@ -3724,6 +3900,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RateLimiterInput",
 			Handler:    _Query_RateLimiterInput_Handler,
 		},
+		{
+			MethodName: "RateLimiterQuotaUsage",
+			Handler:    _Query_RateLimiterQuotaUsage_Handler,
+		},
 		{
 			MethodName: "OutTxTracker",
 			Handler:    _Query_OutTxTracker_Handler,
@@ -5048,6 +5228,16 @@ func (m *QueryRateLimiterInputResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	_ = i
 	var l int
 	_ = l
+	if m.QuotaExceeded {
+		i--
+		if m.QuotaExceeded {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
 	if m.LowestPendingCctxHeight != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.LowestPendingCctxHeight))
 		i--
@@ -5156,6 +5346,16 @@ func (m *QueryListPendingCctxWithinRateLimitResponse) MarshalToSizedBuffer(dAtA
 	_ = i
 	var l int
 	_ = l
+	if m.QuotaExceeded {
+		i--
+		if m.QuotaExceeded {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
 	if m.RateLimitExceeded {
 		i--
 		if m.RateLimitExceeded {
@@ -5200,6 +5400,104 @@ func (m *QueryListPendingCctxWithinRateLimitResponse) MarshalToSizedBuffer(dAtA
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryRateLimiterQuotaUsageRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRateLimiterQuotaUsageRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRateLimiterQuotaUsageRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRateLimiterQuotaUsageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRateLimiterQuotaUsageResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRateLimiterQuotaUsageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AssetUsages) > 0 {
+		for iNdEx := len(m.AssetUsages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AssetUsages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.SenderUsages) > 0 {
+		for iNdEx := len(m.SenderUsages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SenderUsages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ChainUsages) > 0 {
+		for iNdEx := len(m.ChainUsages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChainUsages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Window != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Window))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryLastZetaHeightRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -6054,6 +6352,9 @@ func (m *QueryRateLimiterInputResponse) Size() (n int) {
 	if m.LowestPendingCctxHeight != 0 {
 		n += 1 + sovQuery(uint64(m.LowestPendingCctxHeight))
 	}
+	if m.QuotaExceeded {
+		n += 2
+	}
 	return n
 }
 
@@ -6094,6 +6395,51 @@ func (m *QueryListPendingCctxWithinRateLimitResponse) Size() (n int) {
 	if m.RateLimitExceeded {
 		n += 2
 	}
+	if m.QuotaExceeded {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryRateLimiterQuotaUsageRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryRateLimiterQuotaUsageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	if m.Window != 0 {
+		n += 1 + sovQuery(uint64(m.Window))
+	}
+	if len(m.ChainUsages) > 0 {
+		for _, e := range m.ChainUsages {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.SenderUsages) > 0 {
+		for _, e := range m.SenderUsages {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.AssetUsages) > 0 {
+		for _, e := range m.AssetUsages {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -9638,8 +9984,28 @@ func (m *QueryRateLimiterInputResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuotaExceeded", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.QuotaExceeded = bool(v != 0)
+		default:
+			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
 			if err != nil {
 				return err
@@ -9881,6 +10247,266 @@ func (m *QueryListPendingCctxWithinRateLimitResponse) Unmarshal(dAtA []byte) err
 				}
 			}
 			m.RateLimitExceeded = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuotaExceeded", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.QuotaExceeded = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRateLimiterQuotaUsageRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRateLimiterQuotaUsageRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRateLimiterQuotaUsageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRateLimiterQuotaUsageResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRateLimiterQuotaUsageResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRateLimiterQuotaUsageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			m.Window = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Window |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainUsages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainUsages = append(m.ChainUsages, ChainQuotaUsage{})
+			if err := m.ChainUsages[len(m.ChainUsages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SenderUsages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SenderUsages = append(m.SenderUsages, SenderQuotaUsage{})
+			if err := m.SenderUsages[len(m.SenderUsages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetUsages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetUsages = append(m.AssetUsages, AssetQuotaUsage{})
+			if err := m.AssetUsages[len(m.AssetUsages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -37,9 +37,76 @@ func (r RateLimiterFlags) Validate() error {
 		}
 	}
 
+	seenChains := make(map[int64]bool)
+	for _, chainRate := range r.ChainRates {
+		// check no duplicated chain rate
+		if seenChains[chainRate.ChainId] {
+			return fmt.Errorf("duplicated chain rate: %d", chainRate.ChainId)
+		}
+		seenChains[chainRate.ChainId] = true
+
+		if chainRate.Rate.IsNil() {
+			return fmt.Errorf("rate is nil for chain: %d", chainRate.ChainId)
+		}
+	}
+
+	seenCaps := make(map[string]bool)
+	for _, assetCap := range r.AssetCaps {
+		// check no duplicated asset cap
+		if seenCaps[assetCap.Zrc20] {
+			return fmt.Errorf("duplicated asset cap: %s", assetCap.Zrc20)
+		}
+		seenCaps[assetCap.Zrc20] = true
+
+		if assetCap.Cap.IsNil() {
+			return fmt.Errorf("cap is nil for asset: %s", assetCap.Zrc20)
+		}
+
+		// check address is valid
+		if !ethcommon.IsHexAddress(assetCap.Zrc20) {
+			return fmt.Errorf("invalid zrc20 address (%s)", assetCap.Zrc20)
+		}
+	}
+
+	// delay must not be negative
+	if r.DelayBlocks < 0 {
+		return fmt.Errorf("delay blocks must be positive: %d", r.DelayBlocks)
+	}
+
+	seenThresholds := make(map[string]bool)
+	for _, delayThreshold := range r.DelayThresholds {
+		// check no duplicated delay threshold
+		if seenThresholds[delayThreshold.Zrc20] {
+			return fmt.Errorf("duplicated delay threshold: %s", delayThreshold.Zrc20)
+		}
+		seenThresholds[delayThreshold.Zrc20] = true
+
+		if delayThreshold.Threshold.IsNil() {
+			return fmt.Errorf("threshold is nil for asset: %s", delayThreshold.Zrc20)
+		}
+
+		// check address is valid
+		if !ethcommon.IsHexAddress(delayThreshold.Zrc20) {
+			return fmt.Errorf("invalid zrc20 address (%s)", delayThreshold.Zrc20)
+		}
+	}
+
 	return nil
 }
 
+// GetDelayThreshold returns the delay threshold for the given zrc20
+func (r RateLimiterFlags) GetDelayThreshold(zrc20 string) (sdkmath.Uint, bool) {
+	if r.DelayBlocks <= 0 {
+		return sdkmath.Uint{}, false
+	}
+	for _, delayThreshold := range r.DelayThresholds {
+		if strings.EqualFold(delayThreshold.Zrc20, zrc20) {
+			return delayThreshold.Threshold, true
+		}
+	}
+	return sdkmath.Uint{}, false
+}
+
 // GetConversionRate returns the conversion rate for the given zrc20
 func (r RateLimiterFlags) GetConversionRate(zrc20 string) (sdk.Dec, bool) {
 	for _, conversion := range r.Conversions {
@@ -33,6 +33,19 @@ type RateLimiterFlags struct {
 	Rate github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,3,opt,name=rate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"rate"`
 	// conversion in azeta per token
 	Conversions []Conversion `protobuf:"bytes,4,rep,name=conversions,proto3" json:"conversions"`
+	// optional rates in azeta per block for withdrawals to a given chain
+	ChainRates []ChainRate `protobuf:"bytes,5,rep,name=chain_rates,json=chainRates,proto3" json:"chain_rates"`
+	// optional rate in azeta per block for the withdrawals of each sender,
+	// enforced when the withdrawals are created, zero to disable
+	SenderRate github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,6,opt,name=sender_rate,json=senderRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"sender_rate"`
+	// optional absolute caps in token units per window for a given zrc20
+	AssetCaps []AssetCap `protobuf:"bytes,7,rep,name=asset_caps,json=assetCaps,proto3" json:"asset_caps"`
+	// number of blocks the outbounds above a delay threshold are held in
+	// PendingDelay before being scheduled, zero to disable
+	DelayBlocks int64 `protobuf:"varint,8,opt,name=delay_blocks,json=delayBlocks,proto3" json:"delay_blocks,omitempty"`
+	// optional thresholds in token units above which the outbounds of a given
+	// zrc20 are delayed
+	DelayThresholds []DelayThreshold `protobuf:"bytes,9,rep,name=delay_thresholds,json=delayThresholds,proto3" json:"delay_thresholds"`
 }
 
 func (m *RateLimiterFlags) Reset()         { *m = RateLimiterFlags{} }
@@ -89,6 +102,172 @@ func (m *RateLimiterFlags) GetConversions() []Conversion {
 	return nil
 }
 
+func (m *RateLimiterFlags) GetChainRates() []ChainRate {
+	if m != nil {
+		return m.ChainRates
+	}
+	return nil
+}
+
+func (m *RateLimiterFlags) GetAssetCaps() []AssetCap {
+	if m != nil {
+		return m.AssetCaps
+	}
+	return nil
+}
+
+func (m *RateLimiterFlags) GetDelayBlocks() int64 {
+	if m != nil {
+		return m.DelayBlocks
+	}
+	return 0
+}
+
+func (m *RateLimiterFlags) GetDelayThresholds() []DelayThreshold {
+	if m != nil {
+		return m.DelayThresholds
+	}
+	return nil
+}
+
+type ChainRate struct {
+	ChainId int64 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// rate in azeta per block
+	Rate github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,2,opt,name=rate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"rate"`
+}
+
+func (m *ChainRate) Reset()         { *m = ChainRate{} }
+func (m *ChainRate) String() string { return proto.CompactTextString(m) }
+func (*ChainRate) ProtoMessage()    {}
+func (*ChainRate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9c435f4c2dabc0eb, []int{1}
+}
+func (m *ChainRate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChainRate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChainRate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChainRate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChainRate.Merge(m, src)
+}
+func (m *ChainRate) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChainRate) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChainRate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChainRate proto.InternalMessageInfo
+
+func (m *ChainRate) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+type AssetCap struct {
+	Zrc20 string `protobuf:"bytes,1,opt,name=zrc20,proto3" json:"zrc20,omitempty"`
+	// cap in token units per window
+	Cap github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,2,opt,name=cap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"cap"`
+}
+
+func (m *AssetCap) Reset()         { *m = AssetCap{} }
+func (m *AssetCap) String() string { return proto.CompactTextString(m) }
+func (*AssetCap) ProtoMessage()    {}
+func (*AssetCap) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9c435f4c2dabc0eb, []int{2}
+}
+func (m *AssetCap) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AssetCap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AssetCap.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AssetCap) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AssetCap.Merge(m, src)
+}
+func (m *AssetCap) XXX_Size() int {
+	return m.Size()
+}
+func (m *AssetCap) XXX_DiscardUnknown() {
+	xxx_messageInfo_AssetCap.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AssetCap proto.InternalMessageInfo
+
+func (m *AssetCap) GetZrc20() string {
+	if m != nil {
+		return m.Zrc20
+	}
+	return ""
+}
+
+type DelayThreshold struct {
+	Zrc20 string `protobuf:"bytes,1,opt,name=zrc20,proto3" json:"zrc20,omitempty"`
+	// threshold in token units
+	Threshold github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,2,opt,name=threshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"threshold"`
+}
+
+func (m *DelayThreshold) Reset()         { *m = DelayThreshold{} }
+func (m *DelayThreshold) String() string { return proto.CompactTextString(m) }
+func (*DelayThreshold) ProtoMessage()    {}
+func (*DelayThreshold) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9c435f4c2dabc0eb, []int{3}
+}
+func (m *DelayThreshold) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelayThreshold) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelayThreshold.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DelayThreshold) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelayThreshold.Merge(m, src)
+}
+func (m *DelayThreshold) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelayThreshold) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelayThreshold.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelayThreshold proto.InternalMessageInfo
+
+func (m *DelayThreshold) GetZrc20() string {
+	if m != nil {
+		return m.Zrc20
+	}
+	return ""
+}
+
 type Conversion struct {
 	Zrc20 string                                 `protobuf:"bytes,1,opt,name=zrc20,proto3" json:"zrc20,omitempty"`
 	Rate  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=rate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"rate"`
@@ -98,7 +277,7 @@ func (m *Conversion) Reset()         { *m = Conversion{} }
 func (m *Conversion) String() string { return proto.CompactTextString(m) }
 func (*Conversion) ProtoMessage()    {}
 func (*Conversion) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9c435f4c2dabc0eb, []int{1}
+	return fileDescriptor_9c435f4c2dabc0eb, []int{4}
 }
 func (m *Conversion) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -146,7 +325,7 @@ func (m *AssetRate) Reset()         { *m = AssetRate{} }
 func (m *AssetRate) String() string { return proto.CompactTextString(m) }
 func (*AssetRate) ProtoMessage()    {}
 func (*AssetRate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9c435f4c2dabc0eb, []int{2}
+	return fileDescriptor_9c435f4c2dabc0eb, []int{5}
 }
 func (m *AssetRate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -203,10 +382,159 @@ func (m *AssetRate) GetCoinType() coin.CoinType {
 	return coin.CoinType_Zeta
 }
 
+// ChainQuotaUsage is the value withdrawn to a chain within the window in azeta
+type ChainQuotaUsage struct {
+	ChainId int64                                   `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Used    github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,2,opt,name=used,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"used"`
+	Limit   github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,3,opt,name=limit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"limit"`
+}
+
+func (m *ChainQuotaUsage) Reset()         { *m = ChainQuotaUsage{} }
+func (m *ChainQuotaUsage) String() string { return proto.CompactTextString(m) }
+func (*ChainQuotaUsage) ProtoMessage()    {}
+func (*ChainQuotaUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9c435f4c2dabc0eb, []int{6}
+}
+func (m *ChainQuotaUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChainQuotaUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChainQuotaUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChainQuotaUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChainQuotaUsage.Merge(m, src)
+}
+func (m *ChainQuotaUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChainQuotaUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChainQuotaUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChainQuotaUsage proto.InternalMessageInfo
+
+func (m *ChainQuotaUsage) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+// SenderQuotaUsage is the value withdrawn by a sender within its current
+// window in azeta
+type SenderQuotaUsage struct {
+	Sender string                                  `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Used   github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,2,opt,name=used,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"used"`
+	Limit  github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,3,opt,name=limit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"limit"`
+}
+
+func (m *SenderQuotaUsage) Reset()         { *m = SenderQuotaUsage{} }
+func (m *SenderQuotaUsage) String() string { return proto.CompactTextString(m) }
+func (*SenderQuotaUsage) ProtoMessage()    {}
+func (*SenderQuotaUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9c435f4c2dabc0eb, []int{7}
+}
+func (m *SenderQuotaUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SenderQuotaUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SenderQuotaUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SenderQuotaUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SenderQuotaUsage.Merge(m, src)
+}
+func (m *SenderQuotaUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *SenderQuotaUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_SenderQuotaUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SenderQuotaUsage proto.InternalMessageInfo
+
+func (m *SenderQuotaUsage) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+// AssetQuotaUsage is the amount of a zrc20 withdrawn within the window in token
+// units
+type AssetQuotaUsage struct {
+	Zrc20 string                                  `protobuf:"bytes,1,opt,name=zrc20,proto3" json:"zrc20,omitempty"`
+	Used  github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,2,opt,name=used,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"used"`
+	Cap   github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,3,opt,name=cap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"cap"`
+}
+
+func (m *AssetQuotaUsage) Reset()         { *m = AssetQuotaUsage{} }
+func (m *AssetQuotaUsage) String() string { return proto.CompactTextString(m) }
+func (*AssetQuotaUsage) ProtoMessage()    {}
+func (*AssetQuotaUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9c435f4c2dabc0eb, []int{8}
+}
+func (m *AssetQuotaUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AssetQuotaUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AssetQuotaUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AssetQuotaUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AssetQuotaUsage.Merge(m, src)
+}
+func (m *AssetQuotaUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *AssetQuotaUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_AssetQuotaUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AssetQuotaUsage proto.InternalMessageInfo
+
+func (m *AssetQuotaUsage) GetZrc20() string {
+	if m != nil {
+		return m.Zrc20
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*RateLimiterFlags)(nil), "zetachain.zetacore.crosschain.RateLimiterFlags")
+	proto.RegisterType((*ChainRate)(nil), "zetachain.zetacore.crosschain.ChainRate")
+	proto.RegisterType((*AssetCap)(nil), "zetachain.zetacore.crosschain.AssetCap")
+	proto.RegisterType((*DelayThreshold)(nil), "zetachain.zetacore.crosschain.DelayThreshold")
 	proto.RegisterType((*Conversion)(nil), "zetachain.zetacore.crosschain.Conversion")
 	proto.RegisterType((*AssetRate)(nil), "zetachain.zetacore.crosschain.AssetRate")
+	proto.RegisterType((*ChainQuotaUsage)(nil), "zetachain.zetacore.crosschain.ChainQuotaUsage")
+	proto.RegisterType((*SenderQuotaUsage)(nil), "zetachain.zetacore.crosschain.SenderQuotaUsage")
+	proto.RegisterType((*AssetQuotaUsage)(nil), "zetachain.zetacore.crosschain.AssetQuotaUsage")
 }
 
 func init() {
@@ -214,35 +542,51 @@ func init() {
 }
 
 var fileDescriptor_9c435f4c2dabc0eb = []byte{
-	// 437 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x52, 0x41, 0x8b, 0x13, 0x31,
-	0x14, 0x6e, 0xb6, 0xdd, 0xb5, 0xcd, 0xa2, 0x48, 0x58, 0x64, 0x28, 0x38, 0x3b, 0x14, 0x5c, 0xc7,
-	0x43, 0x13, 0xa9, 0xe0, 0xdd, 0xa9, 0x28, 0x82, 0x17, 0x83, 0x5e, 0xbc, 0x94, 0x34, 0x93, 0x9d,
-	0x0d, 0xed, 0x24, 0xc3, 0x24, 0xba, 0xee, 0xfe, 0x0a, 0x7f, 0xd6, 0x1e, 0xf7, 0x28, 0x22, 0xab,
-	0xb4, 0x7f, 0x44, 0x92, 0x4c, 0x67, 0x7b, 0x28, 0x22, 0x7b, 0x69, 0xdf, 0x37, 0xbc, 0xef, 0x7d,
-	0xdf, 0xfb, 0xf2, 0xe0, 0xcb, 0x4b, 0x61, 0x19, 0x3f, 0x63, 0x52, 0x11, 0x5f, 0xe9, 0x5a, 0x10,
-	0x5e, 0x6b, 0x63, 0xc2, 0xb7, 0x9a, 0x59, 0x31, 0x5b, 0xca, 0x52, 0x5a, 0x51, 0xcf, 0x4e, 0x97,
-	0xac, 0x30, 0xb8, 0xaa, 0xb5, 0xd5, 0xe8, 0x71, 0xcb, 0xc3, 0x1b, 0x1e, 0xbe, 0xe5, 0x0d, 0x8f,
-	0x0a, 0x5d, 0x68, 0xdf, 0x49, 0x5c, 0x15, 0x48, 0xc3, 0x93, 0x1d, 0x62, 0xd5, 0xa2, 0x20, 0x5c,
-	0x4b, 0xe5, 0x7f, 0x42, 0xdf, 0xe8, 0x17, 0x80, 0x0f, 0x29, 0xb3, 0xe2, 0x7d, 0x10, 0x7e, 0xe3,
-	0x74, 0x51, 0x04, 0xef, 0x09, 0xc5, 0xe6, 0x4b, 0x91, 0x47, 0x20, 0x01, 0x69, 0x9f, 0x6e, 0x20,
-	0x7a, 0x04, 0x0f, 0xce, 0xa5, 0xca, 0xf5, 0x79, 0xb4, 0x97, 0x80, 0xb4, 0x4b, 0x1b, 0x84, 0xa6,
-	0xb0, 0xe7, 0xfc, 0x47, 0xdd, 0x04, 0xa4, 0x83, 0x8c, 0x5c, 0xdd, 0x1c, 0x77, 0x7e, 0xde, 0x1c,
-	0x3f, 0x2d, 0xa4, 0x3d, 0xfb, 0x32, 0xc7, 0x5c, 0x97, 0x84, 0x6b, 0x53, 0x6a, 0xd3, 0xfc, 0x8d,
-	0x4d, 0xbe, 0x20, 0xf6, 0xa2, 0x12, 0x06, 0x7f, 0x92, 0xca, 0x52, 0x4f, 0x46, 0x1f, 0xe0, 0x21,
-	0xd7, 0xea, 0xab, 0xa8, 0x8d, 0xd4, 0xca, 0x44, 0xbd, 0xa4, 0x9b, 0x1e, 0x4e, 0x9e, 0xe1, 0x7f,
-	0xae, 0x8f, 0xa7, 0x2d, 0x23, 0xeb, 0x39, 0x59, 0xba, 0x3d, 0x63, 0x74, 0x0a, 0xe1, 0x6d, 0x03,
-	0x3a, 0x82, 0xfb, 0x97, 0x35, 0x9f, 0x3c, 0xf7, 0x5b, 0x0d, 0x68, 0x00, 0x28, 0x6b, 0xbc, 0xef,
-	0x79, 0xef, 0xb8, 0xf1, 0x7e, 0xf2, 0x1f, 0xde, 0x5f, 0x0b, 0x1e, 0xac, 0x8f, 0x7e, 0x03, 0x38,
-	0x78, 0x65, 0x8c, 0xb0, 0x2e, 0x4b, 0x97, 0x9f, 0x37, 0xf7, 0x2e, 0xe4, 0xd7, 0xa5, 0x1b, 0xe8,
-	0x1c, 0x30, 0xd7, 0x16, 0xc4, 0x68, 0x00, 0x68, 0x08, 0xfb, 0xb9, 0xe0, 0xb2, 0x64, 0x4b, 0xe3,
-	0x13, 0xbc, 0x4f, 0x5b, 0x8c, 0x32, 0x38, 0x70, 0xcf, 0x35, 0x73, 0x8a, 0x51, 0x2f, 0x01, 0xe9,
-	0x83, 0xc9, 0x93, 0x5d, 0x91, 0x54, 0x8b, 0x02, 0xfb, 0x77, 0x9d, 0x6a, 0xa9, 0x3e, 0x5e, 0x54,
-	0x82, 0xf6, 0x79, 0x53, 0xb5, 0x1b, 0xee, 0xdf, 0x7d, 0xc3, 0xec, 0xed, 0xd5, 0x2a, 0x06, 0xd7,
-	0xab, 0x18, 0xfc, 0x59, 0xc5, 0xe0, 0xfb, 0x3a, 0xee, 0x5c, 0xaf, 0xe3, 0xce, 0x8f, 0x75, 0xdc,
-	0xf9, 0x3c, 0xde, 0x9a, 0xe3, 0xec, 0x8c, 0xc3, 0xd9, 0x29, 0x9d, 0x0b, 0xf2, 0x6d, 0xfb, 0xc2,
-	0xfd, 0xc8, 0xf9, 0x81, 0x3f, 0xbc, 0x17, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xc7, 0x00, 0x01,
-	0xd6, 0x0f, 0x03, 0x00, 0x00,
+	// 690 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x55, 0x4f, 0x4f, 0x13, 0x41,
+	0x14, 0xef, 0xd2, 0x3f, 0x74, 0x5f, 0x15, 0xc8, 0x84, 0x90, 0x95, 0xc4, 0x52, 0x9b, 0x08, 0xf5,
+	0xd0, 0x5d, 0x83, 0x89, 0x77, 0x5a, 0xd4, 0x98, 0x60, 0x94, 0x15, 0x2e, 0x1e, 0x6c, 0xa6, 0xb3,
+	0x43, 0xbb, 0xe9, 0x76, 0x67, 0xb3, 0xb3, 0x15, 0xe1, 0x53, 0xf8, 0x4d, 0xbc, 0x19, 0x3f, 0x02,
+	0x47, 0x8e, 0xc6, 0x03, 0x1a, 0xf8, 0x22, 0x66, 0xde, 0x6c, 0x97, 0x62, 0xc0, 0x9a, 0xe2, 0xc1,
+	0xcb, 0xee, 0xbc, 0xc9, 0xfc, 0x7e, 0xbf, 0x37, 0xef, 0xfd, 0x66, 0x06, 0x9e, 0x1e, 0xf3, 0x84,
+	0xb2, 0x3e, 0xf5, 0x43, 0x07, 0x47, 0x22, 0xe6, 0x0e, 0x8b, 0x85, 0x94, 0x7a, 0x2e, 0xa6, 0x09,
+	0xef, 0x04, 0xfe, 0xd0, 0x4f, 0x78, 0xdc, 0x39, 0x08, 0x68, 0x4f, 0xda, 0x51, 0x2c, 0x12, 0x41,
+	0xee, 0x67, 0x38, 0x7b, 0x8c, 0xb3, 0x2f, 0x71, 0xab, 0xcb, 0x3d, 0xd1, 0x13, 0xb8, 0xd2, 0x51,
+	0x23, 0x0d, 0x5a, 0x5d, 0xbf, 0x46, 0x2c, 0x1a, 0xf4, 0x1c, 0x26, 0xfc, 0x10, 0x3f, 0x7a, 0x5d,
+	0xfd, 0xb4, 0x00, 0x4b, 0x2e, 0x4d, 0xf8, 0x8e, 0x16, 0x7e, 0xae, 0x74, 0x89, 0x05, 0xf3, 0x3c,
+	0xa4, 0xdd, 0x80, 0x7b, 0x96, 0x51, 0x33, 0x1a, 0x65, 0x77, 0x1c, 0x92, 0x15, 0x28, 0x1d, 0xfa,
+	0xa1, 0x27, 0x0e, 0xad, 0xb9, 0x9a, 0xd1, 0xc8, 0xbb, 0x69, 0x44, 0xda, 0x50, 0x50, 0xf9, 0x5b,
+	0xf9, 0x9a, 0xd1, 0x30, 0x5b, 0xce, 0xc9, 0xd9, 0x5a, 0xee, 0xfb, 0xd9, 0xda, 0x46, 0xcf, 0x4f,
+	0xfa, 0xa3, 0xae, 0xcd, 0xc4, 0xd0, 0x61, 0x42, 0x0e, 0x85, 0x4c, 0x7f, 0x4d, 0xe9, 0x0d, 0x9c,
+	0xe4, 0x28, 0xe2, 0xd2, 0xde, 0xf7, 0xc3, 0xc4, 0x45, 0x30, 0xd9, 0x85, 0x0a, 0x13, 0xe1, 0x07,
+	0x1e, 0x4b, 0x5f, 0x84, 0xd2, 0x2a, 0xd4, 0xf2, 0x8d, 0xca, 0xe6, 0x23, 0xfb, 0x8f, 0xdb, 0xb7,
+	0xdb, 0x19, 0xa2, 0x55, 0x50, 0xb2, 0xee, 0x24, 0x07, 0x79, 0x0d, 0x15, 0x5c, 0xd6, 0x51, 0x02,
+	0xd2, 0x2a, 0x22, 0x65, 0x63, 0x1a, 0xa5, 0xfa, 0xaa, 0xa2, 0xa4, 0x8c, 0xc0, 0xc6, 0x13, 0x92,
+	0xbc, 0x81, 0x8a, 0xe4, 0xa1, 0xc7, 0x63, 0x64, 0xb4, 0x4a, 0xb3, 0xed, 0x17, 0x34, 0x87, 0xa2,
+	0x24, 0x3b, 0x00, 0x54, 0x4a, 0x9e, 0x74, 0x18, 0x8d, 0xa4, 0x35, 0x8f, 0x19, 0x6e, 0x4c, 0xc9,
+	0x70, 0x4b, 0x01, 0xda, 0x34, 0x4a, 0x13, 0x34, 0x69, 0x1a, 0x4b, 0xf2, 0x00, 0xee, 0x78, 0x3c,
+	0xa0, 0x47, 0x9d, 0x6e, 0x20, 0xd8, 0x40, 0x5a, 0x65, 0x6c, 0x53, 0x05, 0xe7, 0x5a, 0x38, 0x45,
+	0xde, 0xc3, 0x92, 0x5e, 0x92, 0xf4, 0x63, 0x2e, 0xfb, 0x22, 0xf0, 0xa4, 0x65, 0xa2, 0x6c, 0x73,
+	0x8a, 0xec, 0xb6, 0x82, 0xed, 0x8d, 0x51, 0xa9, 0xf8, 0xa2, 0x77, 0x65, 0x56, 0xd6, 0x07, 0x60,
+	0x66, 0x15, 0x24, 0xf7, 0xa0, 0xac, 0x1b, 0xe0, 0x6b, 0x2f, 0xe5, 0xdd, 0x79, 0x8c, 0x5f, 0x7a,
+	0x99, 0x67, 0xe6, 0x6e, 0xe1, 0x99, 0x3a, 0x83, 0xf2, 0xb8, 0x18, 0x64, 0x19, 0x8a, 0xc7, 0x31,
+	0xdb, 0x7c, 0x8c, 0x42, 0xa6, 0xab, 0x03, 0xb2, 0x05, 0x79, 0x46, 0xa3, 0x59, 0x55, 0x14, 0xb6,
+	0x3e, 0x82, 0x85, 0xab, 0x5b, 0xbf, 0x41, 0xea, 0x15, 0x98, 0x59, 0x4d, 0x67, 0x15, 0xbc, 0x64,
+	0xa8, 0x1f, 0x00, 0x5c, 0xba, 0xfb, 0x06, 0xc9, 0xd6, 0x95, 0x22, 0xda, 0xa9, 0xda, 0xfa, 0x5f,
+	0xa8, 0x6d, 0x73, 0x96, 0xd6, 0xf0, 0x87, 0x01, 0x26, 0x16, 0x11, 0x3b, 0x66, 0xc1, 0xb8, 0x43,
+	0xbf, 0x37, 0x6c, 0x19, 0x8a, 0x68, 0x34, 0x2d, 0xe6, 0xea, 0x80, 0xac, 0x42, 0xd9, 0xe3, 0xcc,
+	0x1f, 0xd2, 0x40, 0xe2, 0xf1, 0xbf, 0xeb, 0x66, 0x31, 0x69, 0x81, 0xa9, 0xee, 0x9a, 0x8e, 0x52,
+	0xb4, 0x0a, 0x35, 0xa3, 0xb1, 0xb0, 0xf9, 0xf0, 0x3a, 0x8f, 0x45, 0x83, 0x9e, 0xad, 0x16, 0xda,
+	0x6d, 0xe1, 0x87, 0x7b, 0x47, 0x11, 0x77, 0xcb, 0x2c, 0x1d, 0x65, 0x3b, 0x2c, 0xde, 0x62, 0x87,
+	0x5f, 0x0d, 0x58, 0x44, 0x4f, 0xee, 0x8e, 0x44, 0x42, 0xf7, 0x25, 0xed, 0x4d, 0x73, 0xe6, 0x48,
+	0xf2, 0x99, 0x5b, 0x88, 0x60, 0xf2, 0x0c, 0x8a, 0x78, 0x9b, 0xcf, 0x7a, 0x27, 0x6a, 0x74, 0xfd,
+	0x8b, 0x01, 0x4b, 0x6f, 0xf1, 0xb6, 0x98, 0xc8, 0x7d, 0x05, 0x4a, 0xfa, 0x06, 0x49, 0xcd, 0x90,
+	0x46, 0xff, 0x55, 0xe2, 0x9f, 0x0d, 0x58, 0x44, 0x57, 0x4d, 0xe4, 0x7d, 0xbd, 0x87, 0xff, 0x49,
+	0xd6, 0xe9, 0x31, 0xcf, 0xcf, 0x7e, 0xcc, 0x5b, 0x2f, 0x4e, 0xce, 0xab, 0xc6, 0xe9, 0x79, 0xd5,
+	0xf8, 0x79, 0x5e, 0x35, 0x3e, 0x5d, 0x54, 0x73, 0xa7, 0x17, 0xd5, 0xdc, 0xb7, 0x8b, 0x6a, 0xee,
+	0x5d, 0x73, 0x82, 0x47, 0x99, 0xb6, 0xa9, 0x5f, 0xd6, 0x50, 0x78, 0xdc, 0xf9, 0x38, 0xf9, 0x88,
+	0x23, 0x65, 0xb7, 0x84, 0x6f, 0xeb, 0x93, 0x5f, 0x03, 0x00, 0xe6, 0xc2, 0x32, 0x3c, 0xf2, 0x07,
+	0x00, 0x00,
 }
 
 func (m *RateLimiterFlags) Marshal() (dAtA []byte, err error) {
@@ -265,6 +609,63 @@ func (m *RateLimiterFlags) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DelayThresholds) > 0 {
+		for iNdEx := len(m.DelayThresholds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelayThresholds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRateLimiterFlags(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.DelayBlocks != 0 {
+		i = encodeVarintRateLimiterFlags(dAtA, i, uint64(m.DelayBlocks))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.AssetCaps) > 0 {
+		for iNdEx := len(m.AssetCaps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AssetCaps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRateLimiterFlags(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	{
+		size := m.SenderRate.Size()
+		i -= size
+		if _, err := m.SenderRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRateLimiterFlags(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.ChainRates) > 0 {
+		for iNdEx := len(m.ChainRates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChainRates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRateLimiterFlags(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.Conversions) > 0 {
 		for iNdEx := len(m.Conversions) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -307,7 +708,7 @@ func (m *RateLimiterFlags) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Conversion) Marshal() (dAtA []byte, err error) {
+func (m *ChainRate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -317,12 +718,12 @@ func (m *Conversion) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Conversion) MarshalTo(dAtA []byte) (int, error) {
+func (m *ChainRate) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Conversion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ChainRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -337,17 +738,15 @@ func (m *Conversion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x12
-	if len(m.Zrc20) > 0 {
-		i -= len(m.Zrc20)
-		copy(dAtA[i:], m.Zrc20)
-		i = encodeVarintRateLimiterFlags(dAtA, i, uint64(len(m.Zrc20)))
+	if m.ChainId != 0 {
+		i = encodeVarintRateLimiterFlags(dAtA, i, uint64(m.ChainId))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *AssetRate) Marshal() (dAtA []byte, err error) {
+func (m *AssetCap) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -357,35 +756,155 @@ func (m *AssetRate) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AssetRate) MarshalTo(dAtA []byte) (int, error) {
+func (m *AssetCap) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AssetRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AssetCap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size := m.Rate.Size()
+		size := m.Cap.Size()
 		i -= size
-		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.Cap.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintRateLimiterFlags(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x2a
-	if m.CoinType != 0 {
-		i = encodeVarintRateLimiterFlags(dAtA, i, uint64(m.CoinType))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.Decimals != 0 {
-		i = encodeVarintRateLimiterFlags(dAtA, i, uint64(m.Decimals))
+	dAtA[i] = 0x12
+	if len(m.Zrc20) > 0 {
+		i -= len(m.Zrc20)
+		copy(dAtA[i:], m.Zrc20)
+		i = encodeVarintRateLimiterFlags(dAtA, i, uint64(len(m.Zrc20)))
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelayThreshold) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelayThreshold) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelayThreshold) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Threshold.Size()
+		i -= size
+		if _, err := m.Threshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRateLimiterFlags(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Zrc20) > 0 {
+		i -= len(m.Zrc20)
+		copy(dAtA[i:], m.Zrc20)
+		i = encodeVarintRateLimiterFlags(dAtA, i, uint64(len(m.Zrc20)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Conversion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Conversion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Conversion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRateLimiterFlags(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Zrc20) > 0 {
+		i -= len(m.Zrc20)
+		copy(dAtA[i:], m.Zrc20)
+		i = encodeVarintRateLimiterFlags(dAtA, i, uint64(len(m.Zrc20)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AssetRate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AssetRate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AssetRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRateLimiterFlags(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.CoinType != 0 {
+		i = encodeVarintRateLimiterFlags(dAtA, i, uint64(m.CoinType))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Decimals != 0 {
+		i = encodeVarintRateLimiterFlags(dAtA, i, uint64(m.Decimals))
+		i--
+		dAtA[i] = 0x18
 	}
 	if len(m.Asset) > 0 {
 		i -= len(m.Asset)
@@ -402,6 +921,154 @@ func (m *AssetRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ChainQuotaUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChainQuotaUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChainQuotaUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Limit.Size()
+		i -= size
+		if _, err := m.Limit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRateLimiterFlags(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Used.Size()
+		i -= size
+		if _, err := m.Used.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRateLimiterFlags(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.ChainId != 0 {
+		i = encodeVarintRateLimiterFlags(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SenderQuotaUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SenderQuotaUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SenderQuotaUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Limit.Size()
+		i -= size
+		if _, err := m.Limit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRateLimiterFlags(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Used.Size()
+		i -= size
+		if _, err := m.Used.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRateLimiterFlags(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintRateLimiterFlags(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AssetQuotaUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AssetQuotaUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AssetQuotaUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Cap.Size()
+		i -= size
+		if _, err := m.Cap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRateLimiterFlags(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Used.Size()
+		i -= size
+		if _, err := m.Used.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRateLimiterFlags(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Zrc20) > 0 {
+		i -= len(m.Zrc20)
+		copy(dAtA[i:], m.Zrc20)
+		i = encodeVarintRateLimiterFlags(dAtA, i, uint64(len(m.Zrc20)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintRateLimiterFlags(dAtA []byte, offset int, v uint64) int {
 	offset -= sovRateLimiterFlags(v)
 	base := offset
@@ -433,55 +1100,1108 @@ func (m *RateLimiterFlags) Size() (n int) {
 			n += 1 + l + sovRateLimiterFlags(uint64(l))
 		}
 	}
-	return n
-}
-
-func (m *Conversion) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Zrc20)
-	if l > 0 {
-		n += 1 + l + sovRateLimiterFlags(uint64(l))
-	}
-	l = m.Rate.Size()
-	n += 1 + l + sovRateLimiterFlags(uint64(l))
-	return n
-}
+	if len(m.ChainRates) > 0 {
+		for _, e := range m.ChainRates {
+			l = e.Size()
+			n += 1 + l + sovRateLimiterFlags(uint64(l))
+		}
+	}
+	l = m.SenderRate.Size()
+	n += 1 + l + sovRateLimiterFlags(uint64(l))
+	if len(m.AssetCaps) > 0 {
+		for _, e := range m.AssetCaps {
+			l = e.Size()
+			n += 1 + l + sovRateLimiterFlags(uint64(l))
+		}
+	}
+	if m.DelayBlocks != 0 {
+		n += 1 + sovRateLimiterFlags(uint64(m.DelayBlocks))
+	}
+	if len(m.DelayThresholds) > 0 {
+		for _, e := range m.DelayThresholds {
+			l = e.Size()
+			n += 1 + l + sovRateLimiterFlags(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ChainRate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovRateLimiterFlags(uint64(m.ChainId))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovRateLimiterFlags(uint64(l))
+	return n
+}
+
+func (m *AssetCap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Zrc20)
+	if l > 0 {
+		n += 1 + l + sovRateLimiterFlags(uint64(l))
+	}
+	l = m.Cap.Size()
+	n += 1 + l + sovRateLimiterFlags(uint64(l))
+	return n
+}
+
+func (m *DelayThreshold) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Zrc20)
+	if l > 0 {
+		n += 1 + l + sovRateLimiterFlags(uint64(l))
+	}
+	l = m.Threshold.Size()
+	n += 1 + l + sovRateLimiterFlags(uint64(l))
+	return n
+}
+
+func (m *Conversion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Zrc20)
+	if l > 0 {
+		n += 1 + l + sovRateLimiterFlags(uint64(l))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovRateLimiterFlags(uint64(l))
+	return n
+}
+
+func (m *AssetRate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovRateLimiterFlags(uint64(m.ChainId))
+	}
+	l = len(m.Asset)
+	if l > 0 {
+		n += 1 + l + sovRateLimiterFlags(uint64(l))
+	}
+	if m.Decimals != 0 {
+		n += 1 + sovRateLimiterFlags(uint64(m.Decimals))
+	}
+	if m.CoinType != 0 {
+		n += 1 + sovRateLimiterFlags(uint64(m.CoinType))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovRateLimiterFlags(uint64(l))
+	return n
+}
+
+func (m *ChainQuotaUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovRateLimiterFlags(uint64(m.ChainId))
+	}
+	l = m.Used.Size()
+	n += 1 + l + sovRateLimiterFlags(uint64(l))
+	l = m.Limit.Size()
+	n += 1 + l + sovRateLimiterFlags(uint64(l))
+	return n
+}
+
+func (m *SenderQuotaUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovRateLimiterFlags(uint64(l))
+	}
+	l = m.Used.Size()
+	n += 1 + l + sovRateLimiterFlags(uint64(l))
+	l = m.Limit.Size()
+	n += 1 + l + sovRateLimiterFlags(uint64(l))
+	return n
+}
+
+func (m *AssetQuotaUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Zrc20)
+	if l > 0 {
+		n += 1 + l + sovRateLimiterFlags(uint64(l))
+	}
+	l = m.Used.Size()
+	n += 1 + l + sovRateLimiterFlags(uint64(l))
+	l = m.Cap.Size()
+	n += 1 + l + sovRateLimiterFlags(uint64(l))
+	return n
+}
+
+func sovRateLimiterFlags(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRateLimiterFlags(x uint64) (n int) {
+	return sovRateLimiterFlags(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RateLimiterFlags) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRateLimiterFlags
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateLimiterFlags: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateLimiterFlags: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimiterFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			m.Window = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimiterFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Window |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimiterFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Conversions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimiterFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Conversions = append(m.Conversions, Conversion{})
+			if err := m.Conversions[len(m.Conversions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainRates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimiterFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainRates = append(m.ChainRates, ChainRate{})
+			if err := m.ChainRates[len(m.ChainRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SenderRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimiterFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SenderRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetCaps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimiterFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetCaps = append(m.AssetCaps, AssetCap{})
+			if err := m.AssetCaps[len(m.AssetCaps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelayBlocks", wireType)
+			}
+			m.DelayBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimiterFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DelayBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelayThresholds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimiterFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelayThresholds = append(m.DelayThresholds, DelayThreshold{})
+			if err := m.DelayThresholds[len(m.DelayThresholds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRateLimiterFlags(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChainRate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRateLimiterFlags
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChainRate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChainRate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimiterFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimiterFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRateLimiterFlags(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AssetCap) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRateLimiterFlags
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AssetCap: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AssetCap: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Zrc20", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimiterFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Zrc20 = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimiterFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Cap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRateLimiterFlags(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelayThreshold) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRateLimiterFlags
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelayThreshold: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelayThreshold: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Zrc20", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimiterFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Zrc20 = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimiterFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Threshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRateLimiterFlags(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Conversion) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRateLimiterFlags
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Conversion: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Conversion: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Zrc20", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimiterFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Zrc20 = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimiterFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRateLimiterFlags(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AssetRate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRateLimiterFlags
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AssetRate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AssetRate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimiterFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimiterFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Asset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			}
+			m.Decimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimiterFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decimals |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoinType", wireType)
+			}
+			m.CoinType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimiterFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CoinType |= coin.CoinType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimiterFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRateLimiterFlags(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *AssetRate) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ChainId != 0 {
-		n += 1 + sovRateLimiterFlags(uint64(m.ChainId))
-	}
-	l = len(m.Asset)
-	if l > 0 {
-		n += 1 + l + sovRateLimiterFlags(uint64(l))
-	}
-	if m.Decimals != 0 {
-		n += 1 + sovRateLimiterFlags(uint64(m.Decimals))
-	}
-	if m.CoinType != 0 {
-		n += 1 + sovRateLimiterFlags(uint64(m.CoinType))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	l = m.Rate.Size()
-	n += 1 + l + sovRateLimiterFlags(uint64(l))
-	return n
-}
-
-func sovRateLimiterFlags(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozRateLimiterFlags(x uint64) (n int) {
-	return sovRateLimiterFlags(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *RateLimiterFlags) Unmarshal(dAtA []byte) error {
+func (m *ChainQuotaUsage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -504,17 +2224,17 @@ func (m *RateLimiterFlags) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RateLimiterFlags: wiretype end group for non-group")
+			return fmt.Errorf("proto: ChainQuotaUsage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RateLimiterFlags: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ChainQuotaUsage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
-			var v int
+			m.ChainId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRateLimiterFlags
@@ -524,34 +2244,14 @@ func (m *RateLimiterFlags) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.ChainId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Enabled = bool(v != 0)
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
-			}
-			m.Window = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRateLimiterFlags
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Window |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Used", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -579,15 +2279,15 @@ func (m *RateLimiterFlags) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Used.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Conversions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRateLimiterFlags
@@ -597,23 +2297,23 @@ func (m *RateLimiterFlags) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRateLimiterFlags
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRateLimiterFlags
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Conversions = append(m.Conversions, Conversion{})
-			if err := m.Conversions[len(m.Conversions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Limit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -638,7 +2338,7 @@ func (m *RateLimiterFlags) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Conversion) Unmarshal(dAtA []byte) error {
+func (m *SenderQuotaUsage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -661,15 +2361,15 @@ func (m *Conversion) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Conversion: wiretype end group for non-group")
+			return fmt.Errorf("proto: SenderQuotaUsage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Conversion: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SenderQuotaUsage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Zrc20", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -697,11 +2397,11 @@ func (m *Conversion) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Zrc20 = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Used", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -729,7 +2429,41 @@ func (m *Conversion) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Used.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimiterFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Limit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -754,7 +2488,7 @@ func (m *Conversion) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AssetRate) Unmarshal(dAtA []byte) error {
+func (m *AssetQuotaUsage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -777,34 +2511,15 @@ func (m *AssetRate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AssetRate: wiretype end group for non-group")
+			return fmt.Errorf("proto: AssetQuotaUsage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AssetRate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AssetQuotaUsage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
-			}
-			m.ChainId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRateLimiterFlags
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ChainId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Zrc20", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -832,13 +2547,13 @@ func (m *AssetRate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Asset = string(dAtA[iNdEx:postIndex])
+			m.Zrc20 = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Used", wireType)
 			}
-			m.Decimals = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRateLimiterFlags
@@ -848,33 +2563,29 @@ func (m *AssetRate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Decimals |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CoinType", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRateLimiterFlags
 			}
-			m.CoinType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRateLimiterFlags
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CoinType |= coin.CoinType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRateLimiterFlags
 			}
-		case 5:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Used.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cap", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -902,7 +2613,7 @@ func (m *AssetRate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Cap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -103,6 +103,192 @@ func TestRateLimiterFlags_Validate(t *testing.T) {
 			},
 			isErr: true,
 		},
+		{
+			name: "valid chain rates, sender rate and asset caps",
+			flags: types.RateLimiterFlags{
+				Enabled: true,
+				Window:  42,
+				Rate:    sdk.NewUint(42),
+				ChainRates: []types.ChainRate{
+					{
+						ChainId: chains.Ethereum.ChainId,
+						Rate:    sdk.NewUint(21),
+					},
+					{
+						ChainId: chains.BitcoinMainnet.ChainId,
+						Rate:    sdk.NewUint(21),
+					},
+				},
+				SenderRate: sdk.NewUint(10),
+				AssetCaps: []types.AssetCap{
+					{
+						Zrc20: sample.EthAddress().String(),
+						Cap:   sdk.NewUint(1000),
+					},
+				},
+			},
+		},
+		{
+			name: "duplicated chain rate",
+			flags: types.RateLimiterFlags{
+				Enabled: true,
+				Window:  42,
+				Rate:    sdk.NewUint(42),
+				ChainRates: []types.ChainRate{
+					{
+						ChainId: chains.Ethereum.ChainId,
+						Rate:    sdk.NewUint(21),
+					},
+					{
+						ChainId: chains.Ethereum.ChainId,
+						Rate:    sdk.NewUint(10),
+					},
+				},
+			},
+			isErr: true,
+		},
+		{
+			name: "nil chain rate",
+			flags: types.RateLimiterFlags{
+				Enabled: true,
+				Window:  42,
+				Rate:    sdk.NewUint(42),
+				ChainRates: []types.ChainRate{
+					{
+						ChainId: chains.Ethereum.ChainId,
+					},
+				},
+			},
+			isErr: true,
+		},
+		{
+			name: "duplicated asset cap",
+			flags: types.RateLimiterFlags{
+				Enabled: true,
+				Window:  42,
+				Rate:    sdk.NewUint(42),
+				AssetCaps: []types.AssetCap{
+					{
+						Zrc20: duplicatedAddress,
+						Cap:   sdk.NewUint(1000),
+					},
+					{
+						Zrc20: duplicatedAddress,
+						Cap:   sdk.NewUint(2000),
+					},
+				},
+			},
+			isErr: true,
+		},
+		{
+			name: "nil asset cap",
+			flags: types.RateLimiterFlags{
+				Enabled: true,
+				Window:  42,
+				Rate:    sdk.NewUint(42),
+				AssetCaps: []types.AssetCap{
+					{
+						Zrc20: sample.EthAddress().String(),
+					},
+				},
+			},
+			isErr: true,
+		},
+		{
+			name: "invalid asset cap zrc20 address",
+			flags: types.RateLimiterFlags{
+				Enabled: true,
+				Window:  42,
+				Rate:    sdk.NewUint(42),
+				AssetCaps: []types.AssetCap{
+					{
+						Zrc20: "invalid",
+						Cap:   sdk.NewUint(1000),
+					},
+				},
+			},
+			isErr: true,
+		},
+		{
+			name: "valid delay thresholds",
+			flags: types.RateLimiterFlags{
+				Enabled:     true,
+				Window:      42,
+				Rate:        sdk.NewUint(42),
+				DelayBlocks: 100,
+				DelayThresholds: []types.DelayThreshold{
+					{
+						Zrc20:     sample.EthAddress().String(),
+						Threshold: sdk.NewUint(1000),
+					},
+					{
+						Zrc20:     sample.EthAddress().String(),
+						Threshold: sdk.NewUint(2000),
+					},
+				},
+			},
+		},
+		{
+			name: "negative delay blocks",
+			flags: types.RateLimiterFlags{
+				Enabled:     true,
+				Window:      42,
+				Rate:        sdk.NewUint(42),
+				DelayBlocks: -1,
+			},
+			isErr: true,
+		},
+		{
+			name: "duplicated delay threshold",
+			flags: types.RateLimiterFlags{
+				Enabled:     true,
+				Window:      42,
+				Rate:        sdk.NewUint(42),
+				DelayBlocks: 100,
+				DelayThresholds: []types.DelayThreshold{
+					{
+						Zrc20:     duplicatedAddress,
+						Threshold: sdk.NewUint(1000),
+					},
+					{
+						Zrc20:     duplicatedAddress,
+						Threshold: sdk.NewUint(2000),
+					},
+				},
+			},
+			isErr: true,
+		},
+		{
+			name: "nil delay threshold",
+			flags: types.RateLimiterFlags{
+				Enabled:     true,
+				Window:      42,
+				Rate:        sdk.NewUint(42),
+				DelayBlocks: 100,
+				DelayThresholds: []types.DelayThreshold{
+					{
+						Zrc20: sample.EthAddress().String(),
+					},
+				},
+			},
+			isErr: true,
+		},
+		{
+			name: "invalid delay threshold zrc20 address",
+			flags: types.RateLimiterFlags{
+				Enabled:     true,
+				Window:      42,
+				Rate:        sdk.NewUint(42),
+				DelayBlocks: 100,
+				DelayThresholds: []types.DelayThreshold{
+					{
+						Zrc20:     "invalid",
+						Threshold: sdk.NewUint(1000),
+					},
+				},
+			},
+			isErr: true,
+		},
 		{
 			name: "negative window",
 			flags: types.RateLimiterFlags{
@@ -191,6 +377,41 @@ func TestRateLimiterFlags_GetConversionRate(t *testing.T) {
 	}
 }
 
+func TestRateLimiterFlags_GetDelayThreshold(t *testing.T) {
+	address := sample.EthAddress().Hex()
+	flags := types.RateLimiterFlags{
+		DelayBlocks: 100,
+		DelayThresholds: []types.DelayThreshold{
+			{
+				Zrc20:     sample.EthAddress().Hex(),
+				Threshold: sdk.NewUint(1000),
+			},
+			{
+				Zrc20:     address,
+				Threshold: sdk.NewUint(2000),
+			},
+		},
+	}
+
+	t.Run("should find threshold regardless of the case of the zrc20", func(t *testing.T) {
+		threshold, found := flags.GetDelayThreshold(strings.ToLower(address))
+		require.True(t, found)
+		require.Equal(t, sdk.NewUint(2000), threshold)
+	})
+
+	t.Run("should not find threshold of unknown zrc20", func(t *testing.T) {
+		_, found := flags.GetDelayThreshold(sample.EthAddress().Hex())
+		require.False(t, found)
+	})
+
+	t.Run("should not find threshold if delay is disabled", func(t *testing.T) {
+		disabledFlags := flags
+		disabledFlags.DelayBlocks = 0
+		_, found := disabledFlags.GetDelayThreshold(address)
+		require.False(t, found)
+	})
+}
+
 func TestBuildAssetRateMapFromList(t *testing.T) {
 	// define asset rate list
 	assetRates := []types.AssetRate{
@@ -0,0 +1,152 @@
+package types
+
+import (
+	"sort"
+	"strings"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/zeta-chain/node/pkg/coin"
+)
+
+// ForeignAssetCap is an asset cap of the rate limiter resolved to the foreign asset of the zrc20
+type ForeignAssetCap struct {
+	Zrc20    string
+	ChainID  int64
+	Asset    string
+	CoinType coin.CoinType
+	Cap      sdkmath.Uint
+}
+
+// SenderUsage is the value in azeta withdrawn by a sender since the start height of its current window
+type SenderUsage struct {
+	Sender      string
+	WindowStart int64
+	Used        sdkmath.Int
+}
+
+// RateLimiterQuotas tracks the usage of the optional chain and asset quotas of the rate limiter
+// The chain quotas are rates in azeta per block applied over the window,
+// the asset caps are absolute amounts in token units for the whole window
+// The sender quota is not tracked here as it is enforced when the cctxs are created
+type RateLimiterQuotas struct {
+	chainLimits map[int64]sdkmath.Int
+	assetCaps   map[int64]map[string]ForeignAssetCap
+
+	chainUsage map[int64]sdkmath.Int
+	assetUsage map[string]sdkmath.Int
+}
+
+// NewRateLimiterQuotas creates the quotas for the given window from the rate limiter flags and the resolved asset caps
+func NewRateLimiterQuotas(flags RateLimiterFlags, assetCaps []ForeignAssetCap, window int64) *RateLimiterQuotas {
+	q := &RateLimiterQuotas{
+		chainLimits: make(map[int64]sdkmath.Int),
+		assetCaps:   make(map[int64]map[string]ForeignAssetCap),
+		chainUsage:  make(map[int64]sdkmath.Int),
+		assetUsage:  make(map[string]sdkmath.Int),
+	}
+
+	for _, chainRate := range flags.ChainRates {
+		q.chainLimits[chainRate.ChainId] = sdkmath.NewIntFromBigInt(chainRate.Rate.BigInt()).Mul(sdkmath.NewInt(window))
+	}
+	for _, assetCap := range assetCaps {
+		if _, found := q.assetCaps[assetCap.ChainID]; !found {
+			q.assetCaps[assetCap.ChainID] = make(map[string]ForeignAssetCap)
+		}
+		q.assetCaps[assetCap.ChainID][assetCapKey(assetCap.CoinType, assetCap.Asset)] = assetCap
+	}
+
+	return q
+}
+
+// Add records the cctx in the usage of the quotas
+func (q *RateLimiterQuotas) Add(chainID int64, cctx *CrossChainTx, valueInAzeta sdkmath.Int) {
+	q.chainUsage[chainID] = q.usedByChain(chainID).Add(valueInAzeta)
+
+	if assetCap, found := q.assetCap(chainID, cctx); found {
+		q.assetUsage[assetCap.Zrc20] = q.usedByAsset(assetCap.Zrc20).Add(cctxAmount(cctx))
+	}
+}
+
+// Exceeded returns true if adding the cctx would exceed one of the quotas
+func (q *RateLimiterQuotas) Exceeded(chainID int64, cctx *CrossChainTx, valueInAzeta sdkmath.Int) bool {
+	if limit, found := q.chainLimits[chainID]; found && q.usedByChain(chainID).Add(valueInAzeta).GT(limit) {
+		return true
+	}
+
+	if assetCap, found := q.assetCap(chainID, cctx); found {
+		capInt := sdkmath.NewIntFromBigInt(assetCap.Cap.BigInt())
+		if q.usedByAsset(assetCap.Zrc20).Add(cctxAmount(cctx)).GT(capInt) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ChainUsages returns the usage of the chain quotas sorted by chain id
+func (q *RateLimiterQuotas) ChainUsages() []ChainQuotaUsage {
+	usages := make([]ChainQuotaUsage, 0, len(q.chainLimits))
+	for chainID, limit := range q.chainLimits {
+		usages = append(usages, ChainQuotaUsage{
+			ChainId: chainID,
+			Used:    sdkmath.NewUintFromBigInt(q.usedByChain(chainID).BigInt()),
+			Limit:   sdkmath.NewUintFromBigInt(limit.BigInt()),
+		})
+	}
+	sort.Slice(usages, func(i, j int) bool { return usages[i].ChainId < usages[j].ChainId })
+	return usages
+}
+
+// AssetUsages returns the usage of the asset caps sorted by zrc20
+func (q *RateLimiterQuotas) AssetUsages() []AssetQuotaUsage {
+	usages := make([]AssetQuotaUsage, 0)
+	for _, caps := range q.assetCaps {
+		for _, assetCap := range caps {
+			usages = append(usages, AssetQuotaUsage{
+				Zrc20: assetCap.Zrc20,
+				Used:  sdkmath.NewUintFromBigInt(q.usedByAsset(assetCap.Zrc20).BigInt()),
+				Cap:   assetCap.Cap,
+			})
+		}
+	}
+	sort.Slice(usages, func(i, j int) bool { return usages[i].Zrc20 < usages[j].Zrc20 })
+	return usages
+}
+
+func (q *RateLimiterQuotas) usedByChain(chainID int64) sdkmath.Int {
+	if used, found := q.chainUsage[chainID]; found {
+		return used
+	}
+	return sdkmath.ZeroInt()
+}
+
+func (q *RateLimiterQuotas) usedByAsset(zrc20 string) sdkmath.Int {
+	if used, found := q.assetUsage[zrc20]; found {
+		return used
+	}
+	return sdkmath.ZeroInt()
+}
+
+// assetCap returns the asset cap applying to the cctx if any
+func (q *RateLimiterQuotas) assetCap(chainID int64, cctx *CrossChainTx) (ForeignAssetCap, bool) {
+	caps, found := q.assetCaps[chainID]
+	if !found {
+		return ForeignAssetCap{}, false
+	}
+	assetCap, found := caps[assetCapKey(cctx.InboundParams.CoinType, cctx.InboundParams.Asset)]
+	return assetCap, found
+}
+
+// assetCapKey returns the key of an asset cap, the gas asset has no address
+func assetCapKey(coinType coin.CoinType, asset string) string {
+	if coinType == coin.CoinType_Gas {
+		return coin.CoinType_Gas.String()
+	}
+	return strings.ToLower(asset)
+}
+
+// cctxAmount returns the amount of the cctx in token units
+func cctxAmount(cctx *CrossChainTx) sdkmath.Int {
+	return sdkmath.NewIntFromBigInt(cctx.GetCurrentOutboundParam().Amount.BigInt())
+}
@@ -0,0 +1,148 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/node/pkg/chains"
+	"github.com/zeta-chain/node/pkg/coin"
+	"github.com/zeta-chain/node/testutil/sample"
+	"github.com/zeta-chain/node/x/crosschain/types"
+)
+
+// createQuotaTestCctx creates a cctx to the given chain with the given sender, coin type, asset and amount
+func createQuotaTestCctx(
+	t *testing.T,
+	index string,
+	chainID int64,
+	sender string,
+	coinType coin.CoinType,
+	asset string,
+	amount uint64,
+) *types.CrossChainTx {
+	cctx := sample.CrossChainTx(t, index)
+	cctx.InboundParams.Sender = sender
+	cctx.InboundParams.CoinType = coinType
+	cctx.InboundParams.Asset = asset
+	cctx.GetCurrentOutboundParam().ReceiverChainId = chainID
+	cctx.GetCurrentOutboundParam().Amount = sdk.NewUint(amount)
+	return cctx
+}
+
+func TestRateLimiterQuotas(t *testing.T) {
+	ethChainID := chains.Ethereum.ChainId
+	btcChainID := chains.BitcoinMainnet.ChainId
+	zrc20ETH := sample.EthAddress().Hex()
+	zrc20USDT := sample.EthAddress().Hex()
+	assetUSDT := sample.EthAddress().Hex()
+	sender1 := sample.EthAddress().Hex()
+	sender2 := sample.EthAddress().Hex()
+
+	flags := types.RateLimiterFlags{
+		Enabled: true,
+		Window:  10,
+		Rate:    sdk.NewUint(100),
+		ChainRates: []types.ChainRate{
+			{
+				ChainId: ethChainID,
+				Rate:    sdk.NewUint(10), // 100 azeta in the window
+			},
+		},
+		SenderRate: sdk.NewUint(5), // not tracked by the quotas
+	}
+	assetCaps := []types.ForeignAssetCap{
+		{
+			Zrc20:    zrc20ETH,
+			ChainID:  ethChainID,
+			CoinType: coin.CoinType_Gas,
+			Cap:      sdk.NewUint(1000),
+		},
+		{
+			Zrc20:    zrc20USDT,
+			ChainID:  ethChainID,
+			Asset:    assetUSDT,
+			CoinType: coin.CoinType_ERC20,
+			Cap:      sdk.NewUint(500),
+		},
+	}
+
+	t.Run("should not exceed quotas when empty", func(t *testing.T) {
+		quotas := types.NewRateLimiterQuotas(flags, assetCaps, flags.Window)
+		cctx := createQuotaTestCctx(t, "1", ethChainID, sender1, coin.CoinType_Gas, "", 1000)
+		require.False(t, quotas.Exceeded(ethChainID, cctx, math.NewInt(50)))
+	})
+
+	t.Run("should exceed chain quota regardless of the sender quota", func(t *testing.T) {
+		quotas := types.NewRateLimiterQuotas(flags, assetCaps, flags.Window)
+		quotas.Add(ethChainID, createQuotaTestCctx(t, "1", ethChainID, sender1, coin.CoinType_Zeta, "", 50), math.NewInt(50))
+		quotas.Add(ethChainID, createQuotaTestCctx(t, "2", ethChainID, sender2, coin.CoinType_Zeta, "", 40), math.NewInt(40))
+
+		// a third sender still exceeds the chain quota
+		cctx := createQuotaTestCctx(t, "3", ethChainID, sample.EthAddress().Hex(), coin.CoinType_Zeta, "", 11)
+		require.False(t, quotas.Exceeded(ethChainID, cctx, math.NewInt(10)))
+		require.True(t, quotas.Exceeded(ethChainID, cctx, math.NewInt(11)))
+
+		// the chain quota does not apply to other chains
+		cctx = createQuotaTestCctx(t, "4", btcChainID, sample.EthAddress().Hex(), coin.CoinType_Gas, "", 11)
+		require.False(t, quotas.Exceeded(btcChainID, cctx, math.NewInt(11)))
+	})
+
+	t.Run("should exceed asset caps in token units", func(t *testing.T) {
+		quotas := types.NewRateLimiterQuotas(flags, assetCaps, flags.Window)
+		quotas.Add(ethChainID, createQuotaTestCctx(t, "1", ethChainID, sender1, coin.CoinType_Gas, "", 900), math.ZeroInt())
+		quotas.Add(
+			ethChainID,
+			createQuotaTestCctx(t, "2", ethChainID, sender2, coin.CoinType_ERC20, assetUSDT, 500),
+			math.ZeroInt(),
+		)
+
+		// gas asset cap
+		cctx := createQuotaTestCctx(t, "3", ethChainID, sender1, coin.CoinType_Gas, "", 101)
+		require.True(t, quotas.Exceeded(ethChainID, cctx, math.ZeroInt()))
+		cctx = createQuotaTestCctx(t, "4", ethChainID, sender1, coin.CoinType_Gas, "", 100)
+		require.False(t, quotas.Exceeded(ethChainID, cctx, math.ZeroInt()))
+
+		// erc20 asset cap, the asset is matched case insensitively
+		cctx = createQuotaTestCctx(t, "5", ethChainID, sender1, coin.CoinType_ERC20, strings.ToLower(assetUSDT), 1)
+		require.True(t, quotas.Exceeded(ethChainID, cctx, math.ZeroInt()))
+
+		// uncapped asset
+		cctx = createQuotaTestCctx(t, "6", ethChainID, sender1, coin.CoinType_ERC20, sample.EthAddress().Hex(), 1e6)
+		require.False(t, quotas.Exceeded(ethChainID, cctx, math.ZeroInt()))
+	})
+
+	t.Run("should return sorted usages", func(t *testing.T) {
+		quotas := types.NewRateLimiterQuotas(flags, assetCaps, flags.Window)
+		quotas.Add(ethChainID, createQuotaTestCctx(t, "1", ethChainID, sender1, coin.CoinType_Gas, "", 300), math.NewInt(30))
+		quotas.Add(btcChainID, createQuotaTestCctx(t, "2", btcChainID, sender2, coin.CoinType_Gas, "", 7), math.NewInt(20))
+		quotas.Add(ethChainID, createQuotaTestCctx(t, "3", ethChainID, sender2, coin.CoinType_Gas, "", 200), math.NewInt(5))
+
+		require.Equal(t, []types.ChainQuotaUsage{
+			{
+				ChainId: ethChainID,
+				Used:    sdk.NewUint(35),
+				Limit:   sdk.NewUint(100),
+			},
+		}, quotas.ChainUsages())
+
+		assetUsages := quotas.AssetUsages()
+		require.Len(t, assetUsages, 2)
+		require.Less(t, assetUsages[0].Zrc20, assetUsages[1].Zrc20)
+		for _, usage := range assetUsages {
+			switch usage.Zrc20 {
+			case zrc20ETH:
+				require.Equal(t, sdk.NewUint(500), usage.Used)
+				require.Equal(t, sdk.NewUint(1000), usage.Cap)
+			case zrc20USDT:
+				require.Equal(t, math.ZeroUint(), usage.Used)
+				require.Equal(t, sdk.NewUint(500), usage.Cap)
+			default:
+				t.Fatalf("unexpected zrc20 %s", usage.Zrc20)
+			}
+		}
+	})
+}
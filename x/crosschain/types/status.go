@@ -77,6 +77,14 @@ func stateTransitionMap() map[CctxStatus][]CctxStatus {
 		CctxStatus_PendingRevert,
 		CctxStatus_OutboundMined,
 		CctxStatus_Reverted,
+		CctxStatus_PendingDelay, // large outbound held back before the nonce is assigned
+	}
+
+	stateTransitionMap[CctxStatus_PendingDelay] = []CctxStatus{
+		CctxStatus_PendingOutbound, // delay is over
+		CctxStatus_PendingRevert,   // cancelled, should refund
+		CctxStatus_Reverted,        // cancelled, refunded on ZetaChain
+		CctxStatus_Aborted,
 	}
 
 	stateTransitionMap[CctxStatus_PendingRevert] = []CctxStatus{
@@ -65,6 +65,27 @@ func TestStatus_ValidateTransition(t *testing.T) {
 			true,
 		},
 		{"Valid - PendingOutbound to Reverted", types.CctxStatus_PendingOutbound, types.CctxStatus_Reverted, true},
+		{
+			"Valid - PendingOutbound to PendingDelay",
+			types.CctxStatus_PendingOutbound,
+			types.CctxStatus_PendingDelay,
+			true,
+		},
+
+		{
+			"Valid - PendingDelay to PendingOutbound",
+			types.CctxStatus_PendingDelay,
+			types.CctxStatus_PendingOutbound,
+			true,
+		},
+		{
+			"Valid - PendingDelay to PendingRevert",
+			types.CctxStatus_PendingDelay,
+			types.CctxStatus_PendingRevert,
+			true,
+		},
+		{"Valid - PendingDelay to Reverted", types.CctxStatus_PendingDelay, types.CctxStatus_Reverted, true},
+		{"Valid - PendingDelay to Aborted", types.CctxStatus_PendingDelay, types.CctxStatus_Aborted, true},
 
 		{"Valid - PendingRevert to Aborted", types.CctxStatus_PendingRevert, types.CctxStatus_Aborted, true},
 		{
@@ -96,6 +117,19 @@ func TestStatus_ValidateTransition(t *testing.T) {
 			false,
 		},
 
+		{
+			"Invalid - PendingDelay to OutboundMined",
+			types.CctxStatus_PendingDelay,
+			types.CctxStatus_OutboundMined,
+			false,
+		},
+		{
+			"Invalid - PendingDelay to PendingDelay",
+			types.CctxStatus_PendingDelay,
+			types.CctxStatus_PendingDelay,
+			false,
+		},
+
 		{
 			"Invalid - PendingRevert to PendingInbound",
 			types.CctxStatus_PendingRevert,
@@ -994,9 +994,9 @@ type MsgVoteInbound struct {
 	SenderChainId int64  `protobuf:"varint,3,opt,name=sender_chain_id,json=senderChainId,proto3" json:"sender_chain_id,omitempty"`
 	Receiver      string `protobuf:"bytes,4,opt,name=receiver,proto3" json:"receiver,omitempty"`
 	ReceiverChain int64  `protobuf:"varint,5,opt,name=receiver_chain,json=receiverChain,proto3" json:"receiver_chain,omitempty"`
-	// string zeta_burnt = 6;
+	//  string zeta_burnt = 6;
 	Amount github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,6,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"amount"`
-	// string mMint = 7;
+	//  string mMint = 7;
 	Message            string `protobuf:"bytes,8,opt,name=message,proto3" json:"message,omitempty"`
 	InboundHash        string `protobuf:"bytes,9,opt,name=inbound_hash,json=inboundHash,proto3" json:"inbound_hash,omitempty"`
 	InboundBlockHeight uint64 `protobuf:"varint,10,opt,name=inbound_block_height,json=inboundBlockHeight,proto3" json:"inbound_block_height,omitempty"`
@@ -1292,6 +1292,94 @@ func (m *MsgAbortStuckCCTXResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgAbortStuckCCTXResponse proto.InternalMessageInfo
 
+type MsgCancelDelayedCCTX struct {
+	Creator   string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	CctxIndex string `protobuf:"bytes,2,opt,name=cctx_index,json=cctxIndex,proto3" json:"cctx_index,omitempty"`
+}
+
+func (m *MsgCancelDelayedCCTX) Reset()         { *m = MsgCancelDelayedCCTX{} }
+func (m *MsgCancelDelayedCCTX) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelDelayedCCTX) ProtoMessage()    {}
+func (*MsgCancelDelayedCCTX) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15f0860550897740, []int{20}
+}
+func (m *MsgCancelDelayedCCTX) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelDelayedCCTX) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelDelayedCCTX.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelDelayedCCTX) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelDelayedCCTX.Merge(m, src)
+}
+func (m *MsgCancelDelayedCCTX) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelDelayedCCTX) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelDelayedCCTX.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelDelayedCCTX proto.InternalMessageInfo
+
+func (m *MsgCancelDelayedCCTX) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgCancelDelayedCCTX) GetCctxIndex() string {
+	if m != nil {
+		return m.CctxIndex
+	}
+	return ""
+}
+
+type MsgCancelDelayedCCTXResponse struct {
+}
+
+func (m *MsgCancelDelayedCCTXResponse) Reset()         { *m = MsgCancelDelayedCCTXResponse{} }
+func (m *MsgCancelDelayedCCTXResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelDelayedCCTXResponse) ProtoMessage()    {}
+func (*MsgCancelDelayedCCTXResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15f0860550897740, []int{21}
+}
+func (m *MsgCancelDelayedCCTXResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelDelayedCCTXResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelDelayedCCTXResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelDelayedCCTXResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelDelayedCCTXResponse.Merge(m, src)
+}
+func (m *MsgCancelDelayedCCTXResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelDelayedCCTXResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelDelayedCCTXResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelDelayedCCTXResponse proto.InternalMessageInfo
+
 type MsgRefundAbortedCCTX struct {
 	Creator       string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
 	CctxIndex     string `protobuf:"bytes,2,opt,name=cctx_index,json=cctxIndex,proto3" json:"cctx_index,omitempty"`
@@ -1302,7 +1390,7 @@ func (m *MsgRefundAbortedCCTX) Reset()         { *m = MsgRefundAbortedCCTX{} }
 func (m *MsgRefundAbortedCCTX) String() string { return proto.CompactTextString(m) }
 func (*MsgRefundAbortedCCTX) ProtoMessage()    {}
 func (*MsgRefundAbortedCCTX) Descriptor() ([]byte, []int) {
-	return fileDescriptor_15f0860550897740, []int{20}
+	return fileDescriptor_15f0860550897740, []int{22}
 }
 func (m *MsgRefundAbortedCCTX) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1359,7 +1447,7 @@ func (m *MsgRefundAbortedCCTXResponse) Reset()         { *m = MsgRefundAbortedCC
 func (m *MsgRefundAbortedCCTXResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgRefundAbortedCCTXResponse) ProtoMessage()    {}
 func (*MsgRefundAbortedCCTXResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_15f0860550897740, []int{21}
+	return fileDescriptor_15f0860550897740, []int{23}
 }
 func (m *MsgRefundAbortedCCTXResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1397,7 +1485,7 @@ func (m *MsgUpdateRateLimiterFlags) Reset()         { *m = MsgUpdateRateLimiterF
 func (m *MsgUpdateRateLimiterFlags) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateRateLimiterFlags) ProtoMessage()    {}
 func (*MsgUpdateRateLimiterFlags) Descriptor() ([]byte, []int) {
-	return fileDescriptor_15f0860550897740, []int{22}
+	return fileDescriptor_15f0860550897740, []int{24}
 }
 func (m *MsgUpdateRateLimiterFlags) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1447,7 +1535,7 @@ func (m *MsgUpdateRateLimiterFlagsResponse) Reset()         { *m = MsgUpdateRate
 func (m *MsgUpdateRateLimiterFlagsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateRateLimiterFlagsResponse) ProtoMessage()    {}
 func (*MsgUpdateRateLimiterFlagsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_15f0860550897740, []int{23}
+	return fileDescriptor_15f0860550897740, []int{25}
 }
 func (m *MsgUpdateRateLimiterFlagsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1488,7 +1576,7 @@ func (m *MsgMigrateERC20CustodyFunds) Reset()         { *m = MsgMigrateERC20Cust
 func (m *MsgMigrateERC20CustodyFunds) String() string { return proto.CompactTextString(m) }
 func (*MsgMigrateERC20CustodyFunds) ProtoMessage()    {}
 func (*MsgMigrateERC20CustodyFunds) Descriptor() ([]byte, []int) {
-	return fileDescriptor_15f0860550897740, []int{24}
+	return fileDescriptor_15f0860550897740, []int{26}
 }
 func (m *MsgMigrateERC20CustodyFunds) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1553,7 +1641,7 @@ func (m *MsgMigrateERC20CustodyFundsResponse) Reset()         { *m = MsgMigrateE
 func (m *MsgMigrateERC20CustodyFundsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgMigrateERC20CustodyFundsResponse) ProtoMessage()    {}
 func (*MsgMigrateERC20CustodyFundsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_15f0860550897740, []int{25}
+	return fileDescriptor_15f0860550897740, []int{27}
 }
 func (m *MsgMigrateERC20CustodyFundsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1601,7 +1689,7 @@ func (m *MsgUpdateERC20CustodyPauseStatus) Reset()         { *m = MsgUpdateERC20
 func (m *MsgUpdateERC20CustodyPauseStatus) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateERC20CustodyPauseStatus) ProtoMessage()    {}
 func (*MsgUpdateERC20CustodyPauseStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_15f0860550897740, []int{26}
+	return fileDescriptor_15f0860550897740, []int{28}
 }
 func (m *MsgUpdateERC20CustodyPauseStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1661,7 +1749,7 @@ func (m *MsgUpdateERC20CustodyPauseStatusResponse) Reset() {
 func (m *MsgUpdateERC20CustodyPauseStatusResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateERC20CustodyPauseStatusResponse) ProtoMessage()    {}
 func (*MsgUpdateERC20CustodyPauseStatusResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_15f0860550897740, []int{27}
+	return fileDescriptor_15f0860550897740, []int{29}
 }
 func (m *MsgUpdateERC20CustodyPauseStatusResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1697,6 +1785,120 @@ func (m *MsgUpdateERC20CustodyPauseStatusResponse) GetCctxIndex() string {
 	return ""
 }
 
+type MsgConsolidateUTXOs struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ChainId int64  `protobuf:"varint,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// number of UTXOs of the TSS observed by the voter
+	UtxoCount uint64 `protobuf:"varint,3,opt,name=utxo_count,json=utxoCount,proto3" json:"utxo_count,omitempty"`
+	// number of dust UTXOs among them
+	DustCount uint64 `protobuf:"varint,4,opt,name=dust_count,json=dustCount,proto3" json:"dust_count,omitempty"`
+}
+
+func (m *MsgConsolidateUTXOs) Reset()         { *m = MsgConsolidateUTXOs{} }
+func (m *MsgConsolidateUTXOs) String() string { return proto.CompactTextString(m) }
+func (*MsgConsolidateUTXOs) ProtoMessage()    {}
+func (*MsgConsolidateUTXOs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15f0860550897740, []int{30}
+}
+func (m *MsgConsolidateUTXOs) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgConsolidateUTXOs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgConsolidateUTXOs.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgConsolidateUTXOs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgConsolidateUTXOs.Merge(m, src)
+}
+func (m *MsgConsolidateUTXOs) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgConsolidateUTXOs) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgConsolidateUTXOs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgConsolidateUTXOs proto.InternalMessageInfo
+
+func (m *MsgConsolidateUTXOs) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgConsolidateUTXOs) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *MsgConsolidateUTXOs) GetUtxoCount() uint64 {
+	if m != nil {
+		return m.UtxoCount
+	}
+	return 0
+}
+
+func (m *MsgConsolidateUTXOs) GetDustCount() uint64 {
+	if m != nil {
+		return m.DustCount
+	}
+	return 0
+}
+
+type MsgConsolidateUTXOsResponse struct {
+	CctxIndex string `protobuf:"bytes,1,opt,name=cctx_index,json=cctxIndex,proto3" json:"cctx_index,omitempty"`
+}
+
+func (m *MsgConsolidateUTXOsResponse) Reset()         { *m = MsgConsolidateUTXOsResponse{} }
+func (m *MsgConsolidateUTXOsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgConsolidateUTXOsResponse) ProtoMessage()    {}
+func (*MsgConsolidateUTXOsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15f0860550897740, []int{31}
+}
+func (m *MsgConsolidateUTXOsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgConsolidateUTXOsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgConsolidateUTXOsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgConsolidateUTXOsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgConsolidateUTXOsResponse.Merge(m, src)
+}
+func (m *MsgConsolidateUTXOsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgConsolidateUTXOsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgConsolidateUTXOsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgConsolidateUTXOsResponse proto.InternalMessageInfo
+
+func (m *MsgConsolidateUTXOsResponse) GetCctxIndex() string {
+	if m != nil {
+		return m.CctxIndex
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*MsgMigrateTssFunds)(nil), "zetachain.zetacore.crosschain.MsgMigrateTssFunds")
 	proto.RegisterType((*MsgMigrateTssFundsResponse)(nil), "zetachain.zetacore.crosschain.MsgMigrateTssFundsResponse")
@@ -1718,6 +1920,8 @@ func init() {
 	proto.RegisterType((*MsgVoteInboundResponse)(nil), "zetachain.zetacore.crosschain.MsgVoteInboundResponse")
 	proto.RegisterType((*MsgAbortStuckCCTX)(nil), "zetachain.zetacore.crosschain.MsgAbortStuckCCTX")
 	proto.RegisterType((*MsgAbortStuckCCTXResponse)(nil), "zetachain.zetacore.crosschain.MsgAbortStuckCCTXResponse")
+	proto.RegisterType((*MsgCancelDelayedCCTX)(nil), "zetachain.zetacore.crosschain.MsgCancelDelayedCCTX")
+	proto.RegisterType((*MsgCancelDelayedCCTXResponse)(nil), "zetachain.zetacore.crosschain.MsgCancelDelayedCCTXResponse")
 	proto.RegisterType((*MsgRefundAbortedCCTX)(nil), "zetachain.zetacore.crosschain.MsgRefundAbortedCCTX")
 	proto.RegisterType((*MsgRefundAbortedCCTXResponse)(nil), "zetachain.zetacore.crosschain.MsgRefundAbortedCCTXResponse")
 	proto.RegisterType((*MsgUpdateRateLimiterFlags)(nil), "zetachain.zetacore.crosschain.MsgUpdateRateLimiterFlags")
@@ -1726,6 +1930,8 @@ func init() {
 	proto.RegisterType((*MsgMigrateERC20CustodyFundsResponse)(nil), "zetachain.zetacore.crosschain.MsgMigrateERC20CustodyFundsResponse")
 	proto.RegisterType((*MsgUpdateERC20CustodyPauseStatus)(nil), "zetachain.zetacore.crosschain.MsgUpdateERC20CustodyPauseStatus")
 	proto.RegisterType((*MsgUpdateERC20CustodyPauseStatusResponse)(nil), "zetachain.zetacore.crosschain.MsgUpdateERC20CustodyPauseStatusResponse")
+	proto.RegisterType((*MsgConsolidateUTXOs)(nil), "zetachain.zetacore.crosschain.MsgConsolidateUTXOs")
+	proto.RegisterType((*MsgConsolidateUTXOsResponse)(nil), "zetachain.zetacore.crosschain.MsgConsolidateUTXOsResponse")
 }
 
 func init() {
@@ -1733,122 +1939,128 @@ func init() {
 }
 
 var fileDescriptor_15f0860550897740 = []byte{
-	// 1840 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0x4b, 0x6f, 0x1b, 0xc9,
-	0x11, 0xf6, 0xd8, 0x12, 0x4d, 0x16, 0x25, 0x59, 0x6e, 0xcb, 0x36, 0x35, 0x5a, 0xc9, 0x32, 0x1d,
-	0x3b, 0xc2, 0xc2, 0x22, 0x1d, 0x7a, 0xe3, 0x6c, 0xbc, 0x41, 0x36, 0x16, 0x77, 0xad, 0x15, 0x60,
-	0xda, 0xc2, 0xac, 0xbc, 0x79, 0x5c, 0x06, 0xc3, 0x99, 0xd6, 0x68, 0x20, 0x72, 0x9a, 0x98, 0x6e,
-	0x72, 0x29, 0x23, 0x40, 0x82, 0x00, 0x01, 0x72, 0x4c, 0x82, 0x9c, 0xf6, 0x90, 0x5b, 0x0e, 0xf9,
-	0x13, 0x39, 0xef, 0xd1, 0xc8, 0x29, 0xc8, 0xc1, 0x08, 0xec, 0x43, 0xae, 0x49, 0xae, 0xb9, 0x04,
-	0x5d, 0xdd, 0x33, 0x22, 0x87, 0x4f, 0x51, 0x08, 0xf6, 0x22, 0x76, 0x57, 0xd7, 0x57, 0x5d, 0x55,
-	0x5d, 0xdd, 0x55, 0x35, 0x82, 0x7b, 0xaf, 0xa8, 0x70, 0xdc, 0x23, 0x27, 0x08, 0xcb, 0x38, 0x62,
-	0x11, 0x2d, 0xbb, 0x11, 0xe3, 0x5c, 0xd1, 0x44, 0xb7, 0xd4, 0x8a, 0x98, 0x60, 0x64, 0x3d, 0xe1,
-	0x2b, 0xc5, 0x7c, 0xa5, 0x53, 0x3e, 0x73, 0xc5, 0x67, 0x3e, 0x43, 0xce, 0xb2, 0x1c, 0x29, 0x90,
-	0xf9, 0xfe, 0x10, 0xe1, 0xad, 0x63, 0xbf, 0x8c, 0x24, 0xae, 0x7f, 0x34, 0xef, 0xbd, 0x51, 0xbc,
-	0x2c, 0x08, 0xf1, 0xcf, 0x04, 0x99, 0xad, 0x88, 0xb1, 0x43, 0xae, 0x7f, 0x34, 0xef, 0xa3, 0xf1,
-	0xc6, 0x45, 0x8e, 0xa0, 0x76, 0x23, 0x68, 0x06, 0x82, 0x46, 0xf6, 0x61, 0xc3, 0xf1, 0x63, 0x5c,
-	0x65, 0x3c, 0x0e, 0x87, 0x36, 0x8e, 0xed, 0xd8, 0x41, 0xc5, 0xdf, 0x1b, 0x40, 0x6a, 0xdc, 0xaf,
-	0x05, 0xbe, 0x14, 0x7b, 0xc0, 0xf9, 0xd3, 0x76, 0xe8, 0x71, 0x52, 0x80, 0xcb, 0x6e, 0x44, 0x1d,
-	0xc1, 0xa2, 0x82, 0xb1, 0x69, 0x6c, 0xe5, 0xac, 0x78, 0x4a, 0x56, 0x21, 0xab, 0x44, 0x04, 0x5e,
-	0xe1, 0xe2, 0xa6, 0xb1, 0x75, 0xc9, 0xba, 0x8c, 0xf3, 0x3d, 0x8f, 0xec, 0x42, 0xc6, 0x69, 0xb2,
-	0x76, 0x28, 0x0a, 0x97, 0x24, 0x66, 0xa7, 0xfc, 0xf5, 0x9b, 0x5b, 0x17, 0xfe, 0xfe, 0xe6, 0xd6,
-	0xb7, 0xfd, 0x40, 0x1c, 0xb5, 0xeb, 0x25, 0x97, 0x35, 0xcb, 0x2e, 0xe3, 0x4d, 0xc6, 0xf5, 0xcf,
-	0x36, 0xf7, 0x8e, 0xcb, 0xe2, 0xa4, 0x45, 0x79, 0xe9, 0x65, 0x10, 0x0a, 0x4b, 0xc3, 0x8b, 0xef,
-	0x81, 0x39, 0xa8, 0x93, 0x45, 0x79, 0x8b, 0x85, 0x9c, 0x16, 0x9f, 0xc3, 0xb5, 0x1a, 0xf7, 0x5f,
-	0xb6, 0x3c, 0xb5, 0xf8, 0xc4, 0xf3, 0x22, 0xca, 0xc7, 0xa9, 0xbc, 0x0e, 0x20, 0x38, 0xb7, 0x5b,
-	0xed, 0xfa, 0x31, 0x3d, 0x41, 0xa5, 0x73, 0x56, 0x4e, 0x70, 0xbe, 0x8f, 0x84, 0xe2, 0x3a, 0xac,
-	0x0d, 0x91, 0x97, 0x6c, 0xf7, 0xc7, 0x8b, 0xb0, 0x52, 0xe3, 0xfe, 0x13, 0xcf, 0xdb, 0x0b, 0xeb,
-	0xac, 0x1d, 0x7a, 0x07, 0x91, 0xe3, 0x1e, 0xd3, 0x68, 0x36, 0x1f, 0xdd, 0x84, 0xcb, 0xa2, 0x6b,
-	0x1f, 0x39, 0xfc, 0x48, 0x39, 0xc9, 0xca, 0x88, 0xee, 0x67, 0x0e, 0x3f, 0x22, 0x3b, 0x90, 0x93,
-	0xe1, 0x62, 0x4b, 0x77, 0x14, 0xe6, 0x36, 0x8d, 0xad, 0xa5, 0xca, 0xdd, 0xd2, 0x90, 0xe8, 0x6d,
-	0x1d, 0xfb, 0x25, 0x8c, 0xab, 0x2a, 0x0b, 0xc2, 0x83, 0x93, 0x16, 0xb5, 0xb2, 0xae, 0x1e, 0x91,
-	0xc7, 0x30, 0x8f, 0x81, 0x54, 0x98, 0xdf, 0x34, 0xb6, 0xf2, 0x95, 0x6f, 0x8d, 0xc2, 0xeb, 0x68,
-	0xdb, 0x97, 0x3f, 0x96, 0x82, 0x48, 0x27, 0xd5, 0x1b, 0xcc, 0x3d, 0x56, 0xba, 0x65, 0x94, 0x93,
-	0x90, 0x82, 0xea, 0xad, 0x42, 0x56, 0x74, 0xed, 0x20, 0xf4, 0x68, 0xb7, 0x70, 0x59, 0x99, 0x24,
-	0xba, 0x7b, 0x72, 0x5a, 0xdc, 0x80, 0xf7, 0x86, 0xf9, 0x27, 0x71, 0xe0, 0x5f, 0x0d, 0xb8, 0x5a,
-	0xe3, 0xfe, 0x8f, 0x8f, 0x02, 0x41, 0x1b, 0x01, 0x17, 0x9f, 0x5a, 0xd5, 0xca, 0x83, 0x31, 0xde,
-	0xbb, 0x03, 0x8b, 0x34, 0x72, 0x2b, 0x0f, 0x6c, 0x47, 0x9d, 0x84, 0x3e, 0xb1, 0x05, 0x24, 0xc6,
-	0xa7, 0xdd, 0xeb, 0xe2, 0x4b, 0xfd, 0x2e, 0x26, 0x30, 0x17, 0x3a, 0x4d, 0xe5, 0xc4, 0x9c, 0x85,
-	0x63, 0x72, 0x03, 0x32, 0xfc, 0xa4, 0x59, 0x67, 0x0d, 0x74, 0x4d, 0xce, 0xd2, 0x33, 0x62, 0x42,
-	0xd6, 0xa3, 0x6e, 0xd0, 0x74, 0x1a, 0x1c, 0x6d, 0x5e, 0xb4, 0x92, 0x39, 0x59, 0x83, 0x9c, 0xef,
-	0x70, 0x75, 0xd3, 0xb4, 0xcd, 0x59, 0xdf, 0xe1, 0xcf, 0xe4, 0xbc, 0x68, 0xc3, 0xea, 0x80, 0x4d,
-	0xb1, 0xc5, 0xd2, 0x82, 0x57, 0x7d, 0x16, 0x28, 0x0b, 0x17, 0x5e, 0xf5, 0x5a, 0xb0, 0x0e, 0xe0,
-	0xba, 0x89, 0x4f, 0x75, 0x54, 0x4a, 0x8a, 0xf2, 0xea, 0xbf, 0x0d, 0xb8, 0xae, 0xdc, 0xfa, 0xa2,
-	0x2d, 0xce, 0x1f, 0x77, 0x2b, 0x30, 0x1f, 0xb2, 0xd0, 0xa5, 0xe8, 0xac, 0x39, 0x4b, 0x4d, 0x7a,
-	0xa3, 0x71, 0xae, 0x2f, 0x1a, 0xbf, 0x99, 0x48, 0xfa, 0x21, 0xac, 0x0f, 0x35, 0x39, 0x71, 0xec,
-	0x3a, 0x40, 0xc0, 0xed, 0x88, 0x36, 0x59, 0x87, 0x7a, 0x68, 0x7d, 0xd6, 0xca, 0x05, 0xdc, 0x52,
-	0x84, 0x22, 0x85, 0x42, 0x8d, 0xfb, 0x6a, 0xf6, 0xff, 0xf3, 0x5a, 0xb1, 0x08, 0x9b, 0xa3, 0xb6,
-	0x49, 0x82, 0xfe, 0x2f, 0x06, 0x5c, 0xa9, 0x71, 0xff, 0x0b, 0x26, 0xe8, 0xae, 0xc3, 0xf7, 0xa3,
-	0xc0, 0xa5, 0x33, 0xab, 0xd0, 0x92, 0xe8, 0x58, 0x05, 0x9c, 0x90, 0xdb, 0xb0, 0xd0, 0x8a, 0x02,
-	0x16, 0x05, 0xe2, 0xc4, 0x3e, 0xa4, 0x14, 0xbd, 0x3c, 0x67, 0xe5, 0x63, 0xda, 0x53, 0x8a, 0x2c,
-	0xea, 0x18, 0xc2, 0x76, 0xb3, 0x4e, 0x23, 0x3c, 0xe0, 0x39, 0x2b, 0x8f, 0xb4, 0xe7, 0x48, 0x22,
-	0x26, 0x64, 0x78, 0xbb, 0xd5, 0x6a, 0x9c, 0xa8, 0x5b, 0xb1, 0x73, 0xb1, 0x60, 0x58, 0x9a, 0x52,
-	0x5c, 0x85, 0x9b, 0x29, 0xfd, 0x13, 0xdb, 0xfe, 0x94, 0x49, 0x6c, 0x8b, 0xcd, 0x1f, 0x63, 0xdb,
-	0x1a, 0x60, 0x54, 0xab, 0x68, 0x50, 0x61, 0x9e, 0x95, 0x04, 0x0c, 0x86, 0x0f, 0xe0, 0x06, 0xab,
-	0x73, 0x1a, 0x75, 0xa8, 0x67, 0x33, 0x2d, 0xab, 0xf7, 0x75, 0x5c, 0x89, 0x57, 0xe3, 0x8d, 0x10,
-	0x55, 0x85, 0x8d, 0x41, 0x94, 0x8e, 0x39, 0x1a, 0xf8, 0x47, 0x42, 0x1b, 0xbb, 0x96, 0x46, 0xef,
-	0x60, 0x14, 0x22, 0x0b, 0xf9, 0x08, 0xcc, 0x41, 0x21, 0xf2, 0xc2, 0xb7, 0x39, 0xf5, 0x0a, 0x80,
-	0x02, 0x6e, 0xa6, 0x05, 0xec, 0x3a, 0xfc, 0x25, 0xa7, 0x1e, 0xf9, 0xa5, 0x01, 0x77, 0x07, 0xd1,
-	0xf4, 0xf0, 0x90, 0xba, 0x22, 0xe8, 0x50, 0x94, 0xa3, 0x8e, 0x2d, 0x8f, 0x9e, 0x2d, 0xe9, 0x54,
-	0x78, 0x6f, 0x8a, 0x54, 0xb8, 0x17, 0x0a, 0xeb, 0x76, 0x7a, 0xe3, 0x4f, 0x63, 0xd1, 0x49, 0x34,
-	0xed, 0x4f, 0xd6, 0x40, 0x3d, 0x5d, 0x0b, 0x68, 0xca, 0x58, 0x89, 0xf8, 0xa6, 0x11, 0x06, 0x4b,
-	0x1d, 0xa7, 0xd1, 0xa6, 0x76, 0x44, 0x5d, 0x1a, 0xc8, 0x1b, 0xa6, 0xc2, 0xe2, 0xb3, 0x33, 0xe6,
-	0xf1, 0xff, 0xbc, 0xb9, 0x75, 0xfd, 0xc4, 0x69, 0x36, 0x1e, 0x17, 0xfb, 0xc5, 0x15, 0xad, 0x45,
-	0x24, 0x58, 0x7a, 0x4e, 0x3e, 0x81, 0x0c, 0x17, 0x8e, 0x68, 0xab, 0xb7, 0x77, 0xa9, 0x72, 0x7f,
-	0x64, 0xc2, 0x53, 0x25, 0x97, 0x06, 0x7e, 0x8e, 0x18, 0x4b, 0x63, 0xc9, 0x5d, 0x58, 0x4a, 0xec,
-	0x47, 0x46, 0xfd, 0xac, 0x2c, 0xc6, 0xd4, 0xaa, 0x24, 0x92, 0xfb, 0x40, 0x12, 0x36, 0x59, 0x0e,
-	0xa8, 0x8b, 0x9d, 0x45, 0xe7, 0x2c, 0xc7, 0x2b, 0x07, 0x9c, 0x3f, 0xc7, 0x97, 0xb1, 0x2f, 0x1d,
-	0xe7, 0x66, 0x4a, 0xc7, 0x3d, 0x57, 0x28, 0xf6, 0x79, 0x72, 0x85, 0xfe, 0x99, 0x81, 0x25, 0xbd,
-	0xa6, 0xb3, 0xe6, 0x98, 0x1b, 0x24, 0x93, 0x17, 0x0d, 0x3d, 0x1a, 0xe9, 0xeb, 0xa3, 0x67, 0xe4,
-	0x1e, 0x5c, 0x51, 0x23, 0x3b, 0x95, 0x0a, 0x17, 0x15, 0xb9, 0xaa, 0x9f, 0x10, 0x13, 0xb2, 0xfa,
-	0x08, 0x22, 0xfd, 0xcc, 0x27, 0x73, 0xe9, 0xbc, 0x78, 0xac, 0x9d, 0x37, 0xaf, 0x44, 0xc4, 0x54,
-	0xe5, 0xbc, 0xd3, 0xd2, 0x2e, 0x73, 0xae, 0xd2, 0x4e, 0x5a, 0xd9, 0xa4, 0x9c, 0x3b, 0xbe, 0x72,
-	0x7d, 0xce, 0x8a, 0xa7, 0xf2, 0xbd, 0x0a, 0xc2, 0x9e, 0x07, 0x20, 0x87, 0xcb, 0x79, 0x4d, 0xc3,
-	0x7b, 0xff, 0x00, 0x56, 0x62, 0x96, 0xbe, 0xdb, 0xae, 0x2e, 0x2b, 0xd1, 0x6b, 0xbd, 0x97, 0xbc,
-	0x2f, 0x87, 0xe7, 0x91, 0x2d, 0xc9, 0xe1, 0xfd, 0x67, 0xbc, 0x30, 0x5b, 0xc9, 0xb5, 0x06, 0x39,
-	0xd1, 0xb5, 0x59, 0x14, 0xf8, 0x41, 0x58, 0x58, 0x54, 0xce, 0x15, 0xdd, 0x17, 0x38, 0x97, 0x6f,
-	0xb7, 0xc3, 0x39, 0x15, 0x85, 0x25, 0x5c, 0x50, 0x13, 0x72, 0x0b, 0xf2, 0xb4, 0x43, 0x43, 0xa1,
-	0x73, 0xe0, 0x15, 0xd4, 0x0a, 0x90, 0x84, 0x69, 0x90, 0x44, 0xb0, 0x8a, 0xc5, 0xb9, 0xcb, 0x1a,
-	0xb6, 0xcb, 0x42, 0x11, 0x39, 0xae, 0xb0, 0x3b, 0x34, 0xe2, 0x01, 0x0b, 0x0b, 0xcb, 0xa8, 0xe7,
-	0xa3, 0xd2, 0xd8, 0xc6, 0x46, 0x26, 0x64, 0xc4, 0x57, 0x35, 0xfc, 0x0b, 0x85, 0xb6, 0x6e, 0xb6,
-	0x86, 0x2f, 0x90, 0x9f, 0xca, 0x38, 0xe8, 0xd0, 0x48, 0xd8, 0xac, 0x25, 0x02, 0x16, 0xf2, 0xc2,
-	0x55, 0xcc, 0xfc, 0xf7, 0x27, 0x6c, 0x64, 0x21, 0xe8, 0x85, 0xc2, 0xec, 0xcc, 0xc9, 0xb0, 0x90,
-	0xb1, 0xd3, 0x43, 0x24, 0x35, 0x58, 0x70, 0x9d, 0x46, 0x23, 0x11, 0x4c, 0x50, 0xf0, 0xfb, 0x13,
-	0x04, 0x57, 0x9d, 0x46, 0x43, 0x4b, 0xb0, 0xf2, 0xee, 0xe9, 0x84, 0x6c, 0xc3, 0xb5, 0x80, 0xdb,
-	0xbd, 0xcd, 0x8c, 0x5c, 0x2d, 0x5c, 0xc3, 0x62, 0x60, 0x39, 0xe0, 0x55, 0xb9, 0x82, 0x51, 0x2b,
-	0x45, 0x14, 0x0b, 0x70, 0xa3, 0xff, 0xa2, 0x25, 0x77, 0xf0, 0x19, 0x96, 0xa5, 0x4f, 0xea, 0x2c,
-	0x12, 0x9f, 0x8b, 0xb6, 0x7b, 0x5c, 0xad, 0x1e, 0xfc, 0x64, 0x7c, 0x17, 0x31, 0xae, 0x5e, 0x5b,
-	0xc3, 0x82, 0xb0, 0x5f, 0x5a, 0xb2, 0x55, 0x07, 0x5b, 0x08, 0x8b, 0x1e, 0xb6, 0x43, 0x0f, 0x59,
-	0xa8, 0x77, 0xae, 0xdd, 0xd4, 0xb5, 0x95, 0xd2, 0x92, 0x12, 0x53, 0xe5, 0xcb, 0x45, 0x45, 0xd5,
-	0x35, 0xa6, 0x2e, 0xcd, 0x07, 0xf6, 0x4d, 0xf4, 0xfa, 0xca, 0x40, 0xad, 0x55, 0xef, 0x63, 0x39,
-	0x82, 0x3e, 0x53, 0x6d, 0xe5, 0x53, 0xd9, 0x55, 0x8e, 0xd1, 0xce, 0x05, 0x32, 0xd8, 0x85, 0xa2,
-	0x96, 0xf9, 0x4a, 0x79, 0x52, 0xc4, 0xa4, 0xb6, 0xd1, 0x41, 0xb3, 0x1c, 0xa5, 0xe8, 0xc5, 0x3b,
-	0x70, 0x7b, 0xa4, 0x6e, 0x89, 0x05, 0xff, 0x32, 0xb0, 0x7b, 0xd3, 0xbd, 0x22, 0x96, 0xe1, 0xd5,
-	0x36, 0x17, 0xcc, 0x3b, 0x39, 0x47, 0x23, 0x5b, 0x82, 0x6b, 0x21, 0xfd, 0xd2, 0x76, 0x95, 0xa0,
-	0x94, 0x8b, 0xaf, 0x86, 0xf4, 0x4b, 0xbd, 0x45, 0x5c, 0xca, 0x0f, 0x74, 0x2c, 0x73, 0x43, 0x3a,
-	0x96, 0xd3, 0x27, 0x74, 0xfe, 0x7c, 0xdd, 0xf1, 0x27, 0x70, 0x67, 0x8c, 0xc5, 0xbd, 0xb5, 0x72,
-	0x4f, 0x04, 0x19, 0xe9, 0x78, 0x6d, 0x62, 0x11, 0xab, 0xbc, 0xdb, 0x2b, 0x64, 0xdf, 0x69, 0x73,
-	0x9d, 0x61, 0x67, 0x2f, 0x58, 0xa5, 0x0c, 0x74, 0x57, 0xd6, 0x52, 0x93, 0xe2, 0x1e, 0x6c, 0x4d,
-	0xda, 0x6e, 0x4a, 0xcd, 0x2b, 0xff, 0x5d, 0x82, 0x4b, 0x35, 0xee, 0x93, 0xdf, 0x18, 0x40, 0x86,
-	0xb4, 0x47, 0x1f, 0x4c, 0x88, 0xbf, 0xa1, 0x1d, 0x86, 0xf9, 0x83, 0x59, 0x50, 0x89, 0xc6, 0xbf,
-	0x36, 0xe0, 0xea, 0xe0, 0x07, 0x82, 0x87, 0x53, 0xc9, 0xec, 0x07, 0x99, 0x1f, 0xcd, 0x00, 0x4a,
-	0xf4, 0xf8, 0x9d, 0x01, 0xd7, 0x87, 0xb7, 0x3f, 0xdf, 0x9b, 0x2c, 0x76, 0x28, 0xd0, 0xfc, 0x78,
-	0x46, 0x60, 0xa2, 0x53, 0x07, 0x16, 0xfa, 0xba, 0xa0, 0xd2, 0x64, 0x81, 0xbd, 0xfc, 0xe6, 0xa3,
-	0xb3, 0xf1, 0xa7, 0xf7, 0x4d, 0x3a, 0x94, 0x29, 0xf7, 0x8d, 0xf9, 0xa7, 0xdd, 0x37, 0x5d, 0xda,
-	0x11, 0x0e, 0xf9, 0xde, 0xb2, 0x6e, 0x7b, 0x3a, 0x31, 0x9a, 0xdd, 0xfc, 0xee, 0x99, 0xd8, 0x93,
-	0x4d, 0x7f, 0x0e, 0x4b, 0xa9, 0xef, 0x2b, 0x0f, 0x26, 0x0b, 0xea, 0x47, 0x98, 0x1f, 0x9e, 0x15,
-	0x91, 0xec, 0xfe, 0x2b, 0x03, 0x96, 0x07, 0xbe, 0xc7, 0x55, 0x26, 0x8b, 0x4b, 0x63, 0xcc, 0xc7,
-	0x67, 0xc7, 0x24, 0x4a, 0xfc, 0x02, 0xae, 0xa4, 0xbf, 0x62, 0x7e, 0x67, 0xb2, 0xb8, 0x14, 0xc4,
-	0xfc, 0xfe, 0x99, 0x21, 0xbd, 0x67, 0x90, 0x2a, 0x26, 0xa6, 0x38, 0x83, 0x7e, 0xc4, 0x34, 0x67,
-	0x30, 0xbc, 0xc4, 0xc0, 0x27, 0x68, 0xb0, 0xc0, 0x78, 0x38, 0xcd, 0xed, 0x4d, 0x81, 0xa6, 0x79,
-	0x82, 0x46, 0x96, 0x14, 0xe4, 0x0f, 0x06, 0xdc, 0x18, 0x51, 0x4f, 0x7c, 0x38, 0xed, 0xe9, 0xa6,
-	0x91, 0xe6, 0x8f, 0x66, 0x45, 0x26, 0x6a, 0x7d, 0x65, 0x40, 0x61, 0x64, 0x91, 0xf0, 0x78, 0xea,
-	0x43, 0x1f, 0xc0, 0x9a, 0x3b, 0xb3, 0x63, 0x13, 0xe5, 0xfe, 0x6c, 0xc0, 0xfa, 0xf8, 0x4c, 0xfc,
-	0xf1, 0xb4, 0x0e, 0x18, 0x21, 0xc0, 0xdc, 0x3d, 0xa7, 0x80, 0x58, 0xd7, 0x9d, 0xdd, 0xaf, 0xdf,
-	0x6e, 0x18, 0xaf, 0xdf, 0x6e, 0x18, 0xff, 0x78, 0xbb, 0x61, 0xfc, 0xf6, 0xdd, 0xc6, 0x85, 0xd7,
-	0xef, 0x36, 0x2e, 0xfc, 0xed, 0xdd, 0xc6, 0x85, 0x9f, 0x6d, 0xf7, 0x14, 0x32, 0x72, 0x8b, 0x6d,
-	0xf5, 0x6f, 0x87, 0x90, 0x79, 0xb4, 0xdc, 0xed, 0xfb, 0xef, 0x8c, 0xac, 0x69, 0xea, 0x19, 0x6c,
-	0x45, 0x1e, 0xfe, 0x2f, 0x00, 0x00, 0xff, 0xff, 0x5c, 0xd9, 0x42, 0xd4, 0xcb, 0x19, 0x00, 0x00,
+	// 1934 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0xcd, 0x6f, 0xdb, 0xc8,
+	0x15, 0x0f, 0x13, 0x45, 0x91, 0x9e, 0x6c, 0xc7, 0x66, 0x9c, 0x44, 0xa6, 0xd7, 0x8e, 0xa3, 0x34,
+	0xa9, 0xb1, 0x88, 0xa5, 0x54, 0xd9, 0xa6, 0xa9, 0xb3, 0xe8, 0x36, 0x56, 0x36, 0x5e, 0x03, 0x51,
+	0x6c, 0x70, 0x9d, 0xed, 0xb6, 0x17, 0x82, 0x22, 0xc7, 0x34, 0x61, 0x89, 0x23, 0x70, 0x86, 0x5a,
+	0x29, 0x28, 0xd0, 0xa2, 0x40, 0x81, 0x1c, 0xdb, 0xa2, 0xa7, 0x3d, 0xf4, 0xd6, 0x43, 0xff, 0x89,
+	0x9e, 0xf7, 0xb8, 0xe8, 0xa9, 0xe8, 0x21, 0x28, 0x92, 0x43, 0xaf, 0x6d, 0xff, 0x82, 0x62, 0x3e,
+	0x38, 0x96, 0x48, 0x59, 0x5f, 0x46, 0xb1, 0x17, 0x8b, 0xf3, 0x66, 0x7e, 0xbf, 0xf7, 0xc1, 0x37,
+	0x33, 0xef, 0xd1, 0x70, 0xef, 0x35, 0xa2, 0xb6, 0x73, 0x6c, 0xfb, 0x41, 0x85, 0x3f, 0xe1, 0x10,
+	0x55, 0x9c, 0x10, 0x13, 0x22, 0x64, 0xb4, 0x5b, 0x6e, 0x87, 0x98, 0x62, 0x7d, 0x4d, 0xad, 0x2b,
+	0xc7, 0xeb, 0xca, 0xa7, 0xeb, 0x8c, 0x65, 0x0f, 0x7b, 0x98, 0xaf, 0xac, 0xb0, 0x27, 0x01, 0x32,
+	0x3e, 0x1c, 0x42, 0xde, 0x3e, 0xf1, 0x2a, 0x5c, 0x44, 0xe4, 0x8f, 0x5c, 0x7b, 0xef, 0xac, 0xb5,
+	0xd8, 0x0f, 0xf8, 0x9f, 0x31, 0x9c, 0xed, 0x10, 0xe3, 0x23, 0x22, 0x7f, 0xe4, 0xda, 0x47, 0xa3,
+	0x9d, 0x0b, 0x6d, 0x8a, 0xac, 0xa6, 0xdf, 0xf2, 0x29, 0x0a, 0xad, 0xa3, 0xa6, 0xed, 0xc5, 0xb8,
+	0xea, 0x68, 0x1c, 0x7f, 0xb4, 0xf8, 0xb3, 0x15, 0x07, 0xa8, 0xf4, 0x07, 0x0d, 0xf4, 0x3a, 0xf1,
+	0xea, 0xbe, 0xc7, 0x68, 0x0f, 0x09, 0x79, 0x1e, 0x05, 0x2e, 0xd1, 0x8b, 0x70, 0xc5, 0x09, 0x91,
+	0x4d, 0x71, 0x58, 0xd4, 0x36, 0xb4, 0xcd, 0xbc, 0x19, 0x0f, 0xf5, 0x15, 0xc8, 0x09, 0x0a, 0xdf,
+	0x2d, 0x5e, 0xdc, 0xd0, 0x36, 0x2f, 0x99, 0x57, 0xf8, 0x78, 0xcf, 0xd5, 0x77, 0x21, 0x6b, 0xb7,
+	0x70, 0x14, 0xd0, 0xe2, 0x25, 0x86, 0xd9, 0xa9, 0x7c, 0xf3, 0xf6, 0xd6, 0x85, 0x7f, 0xbc, 0xbd,
+	0xf5, 0x7d, 0xcf, 0xa7, 0xc7, 0x51, 0xa3, 0xec, 0xe0, 0x56, 0xc5, 0xc1, 0xa4, 0x85, 0x89, 0xfc,
+	0xd9, 0x22, 0xee, 0x49, 0x85, 0xf6, 0xda, 0x88, 0x94, 0x5f, 0xf9, 0x01, 0x35, 0x25, 0xbc, 0xf4,
+	0x01, 0x18, 0x69, 0x9b, 0x4c, 0x44, 0xda, 0x38, 0x20, 0xa8, 0xf4, 0x12, 0xae, 0xd5, 0x89, 0xf7,
+	0xaa, 0xed, 0x8a, 0xc9, 0xa7, 0xae, 0x1b, 0x22, 0x32, 0xca, 0xe4, 0x35, 0x00, 0x4a, 0x88, 0xd5,
+	0x8e, 0x1a, 0x27, 0xa8, 0xc7, 0x8d, 0xce, 0x9b, 0x79, 0x4a, 0xc8, 0x01, 0x17, 0x94, 0xd6, 0x60,
+	0x75, 0x08, 0x9f, 0x52, 0xf7, 0xa7, 0x8b, 0xb0, 0x5c, 0x27, 0xde, 0x53, 0xd7, 0xdd, 0x0b, 0x1a,
+	0x38, 0x0a, 0xdc, 0xc3, 0xd0, 0x76, 0x4e, 0x50, 0x38, 0x5b, 0x8c, 0x6e, 0xc2, 0x15, 0xda, 0xb5,
+	0x8e, 0x6d, 0x72, 0x2c, 0x82, 0x64, 0x66, 0x69, 0xf7, 0x33, 0x9b, 0x1c, 0xeb, 0x3b, 0x90, 0x67,
+	0xe9, 0x62, 0xb1, 0x70, 0x14, 0x33, 0x1b, 0xda, 0xe6, 0x42, 0xf5, 0x6e, 0x79, 0x48, 0xf6, 0xb6,
+	0x4f, 0xbc, 0x32, 0xcf, 0xab, 0x1a, 0xf6, 0x83, 0xc3, 0x5e, 0x1b, 0x99, 0x39, 0x47, 0x3e, 0xe9,
+	0xdb, 0x70, 0x99, 0x27, 0x52, 0xf1, 0xf2, 0x86, 0xb6, 0x59, 0xa8, 0x7e, 0xef, 0x2c, 0xbc, 0xcc,
+	0xb6, 0x03, 0xf6, 0x63, 0x0a, 0x08, 0x0b, 0x52, 0xa3, 0x89, 0x9d, 0x13, 0x61, 0x5b, 0x56, 0x04,
+	0x89, 0x4b, 0xb8, 0x79, 0x2b, 0x90, 0xa3, 0x5d, 0xcb, 0x0f, 0x5c, 0xd4, 0x2d, 0x5e, 0x11, 0x2e,
+	0xd1, 0xee, 0x1e, 0x1b, 0x96, 0xd6, 0xe1, 0x83, 0x61, 0xf1, 0x51, 0x01, 0xfc, 0x9b, 0x06, 0x4b,
+	0x75, 0xe2, 0xfd, 0xec, 0xd8, 0xa7, 0xa8, 0xe9, 0x13, 0xfa, 0xa9, 0x59, 0xab, 0x3e, 0x18, 0x11,
+	0xbd, 0x3b, 0x30, 0x8f, 0x42, 0xa7, 0xfa, 0xc0, 0xb2, 0xc5, 0x9b, 0x90, 0x6f, 0x6c, 0x8e, 0x0b,
+	0xe3, 0xb7, 0xdd, 0x1f, 0xe2, 0x4b, 0x83, 0x21, 0xd6, 0x21, 0x13, 0xd8, 0x2d, 0x11, 0xc4, 0xbc,
+	0xc9, 0x9f, 0xf5, 0x1b, 0x90, 0x25, 0xbd, 0x56, 0x03, 0x37, 0x79, 0x68, 0xf2, 0xa6, 0x1c, 0xe9,
+	0x06, 0xe4, 0x5c, 0xe4, 0xf8, 0x2d, 0xbb, 0x49, 0xb8, 0xcf, 0xf3, 0xa6, 0x1a, 0xeb, 0xab, 0x90,
+	0xf7, 0x6c, 0x22, 0x76, 0x9a, 0xf4, 0x39, 0xe7, 0xd9, 0xe4, 0x05, 0x1b, 0x97, 0x2c, 0x58, 0x49,
+	0xf9, 0x14, 0x7b, 0xcc, 0x3c, 0x78, 0x3d, 0xe0, 0x81, 0xf0, 0x70, 0xee, 0x75, 0xbf, 0x07, 0x6b,
+	0x00, 0x8e, 0xa3, 0x62, 0x2a, 0xb3, 0xd2, 0x71, 0xe2, 0xa8, 0xfe, 0x47, 0x83, 0xeb, 0x22, 0xac,
+	0xfb, 0x11, 0x3d, 0x7f, 0xde, 0x2d, 0xc3, 0xe5, 0x00, 0x07, 0x0e, 0xe2, 0xc1, 0xca, 0x98, 0x62,
+	0xd0, 0x9f, 0x8d, 0x99, 0x81, 0x6c, 0xfc, 0x6e, 0x32, 0xe9, 0x27, 0xb0, 0x36, 0xd4, 0x65, 0x15,
+	0xd8, 0x35, 0x00, 0x9f, 0x58, 0x21, 0x6a, 0xe1, 0x0e, 0x72, 0xb9, 0xf7, 0x39, 0x33, 0xef, 0x13,
+	0x53, 0x08, 0x4a, 0x08, 0x8a, 0x75, 0xe2, 0x89, 0xd1, 0xff, 0x2f, 0x6a, 0xa5, 0x12, 0x6c, 0x9c,
+	0xa5, 0x46, 0x25, 0xfd, 0x5f, 0x35, 0xb8, 0x5a, 0x27, 0xde, 0x17, 0x98, 0xa2, 0x5d, 0x9b, 0x1c,
+	0x84, 0xbe, 0x83, 0x66, 0x36, 0xa1, 0x1d, 0xfa, 0xa7, 0x26, 0xf0, 0x81, 0x7e, 0x1b, 0xe6, 0xda,
+	0xa1, 0x8f, 0x43, 0x9f, 0xf6, 0xac, 0x23, 0x84, 0x78, 0x94, 0x33, 0x66, 0x21, 0x96, 0x3d, 0x47,
+	0x7c, 0x89, 0x78, 0x0d, 0x41, 0xd4, 0x6a, 0xa0, 0x90, 0xbf, 0xe0, 0x8c, 0x59, 0xe0, 0xb2, 0x97,
+	0x5c, 0xa4, 0x1b, 0x90, 0x25, 0x51, 0xbb, 0xdd, 0xec, 0x89, 0x5d, 0xb1, 0x73, 0xb1, 0xa8, 0x99,
+	0x52, 0x52, 0x5a, 0x81, 0x9b, 0x09, 0xfb, 0x95, 0x6f, 0x7f, 0xce, 0x2a, 0xdf, 0x62, 0xf7, 0x47,
+	0xf8, 0xb6, 0x0a, 0x3c, 0xab, 0x45, 0x36, 0x88, 0x34, 0xcf, 0x31, 0x01, 0x4f, 0x86, 0x8f, 0xe0,
+	0x06, 0x6e, 0x10, 0x14, 0x76, 0x90, 0x6b, 0x61, 0xc9, 0xd5, 0x7f, 0x3a, 0x2e, 0xc7, 0xb3, 0xb1,
+	0x22, 0x8e, 0xaa, 0xc1, 0x7a, 0x1a, 0x25, 0x73, 0x0e, 0xf9, 0xde, 0x31, 0x95, 0xce, 0xae, 0x26,
+	0xd1, 0x3b, 0x3c, 0x0b, 0xf9, 0x12, 0xfd, 0x09, 0x18, 0x69, 0x12, 0xb6, 0xe1, 0x23, 0x82, 0xdc,
+	0x22, 0x70, 0x82, 0x9b, 0x49, 0x82, 0x5d, 0x9b, 0xbc, 0x22, 0xc8, 0xd5, 0x7f, 0xad, 0xc1, 0xdd,
+	0x34, 0x1a, 0x1d, 0x1d, 0x21, 0x87, 0xfa, 0x1d, 0xc4, 0x79, 0xc4, 0x6b, 0x2b, 0xf0, 0xc8, 0x96,
+	0xe5, 0x55, 0x78, 0x6f, 0x82, 0xab, 0x70, 0x2f, 0xa0, 0xe6, 0xed, 0xa4, 0xe2, 0x4f, 0x63, 0x6a,
+	0x95, 0x4d, 0x07, 0xe3, 0x2d, 0x10, 0x47, 0xd7, 0x1c, 0x77, 0x65, 0x24, 0x23, 0x3f, 0xd3, 0x74,
+	0x0c, 0x0b, 0x1d, 0xbb, 0x19, 0x21, 0x2b, 0x44, 0x0e, 0xf2, 0xd9, 0x0e, 0x13, 0x69, 0xf1, 0xd9,
+	0x94, 0xf7, 0xf8, 0x7f, 0xdf, 0xde, 0xba, 0xde, 0xb3, 0x5b, 0xcd, 0xed, 0xd2, 0x20, 0x5d, 0xc9,
+	0x9c, 0xe7, 0x02, 0x53, 0x8e, 0xf5, 0x67, 0x90, 0x25, 0xd4, 0xa6, 0x91, 0x38, 0x7b, 0x17, 0xaa,
+	0xf7, 0xcf, 0xbc, 0xf0, 0x44, 0xc9, 0x25, 0x81, 0x9f, 0x73, 0x8c, 0x29, 0xb1, 0xfa, 0x5d, 0x58,
+	0x50, 0xfe, 0xf3, 0x85, 0xf2, 0x58, 0x99, 0x8f, 0xa5, 0x35, 0x26, 0xd4, 0xef, 0x83, 0xae, 0x96,
+	0xb1, 0x72, 0x40, 0x6c, 0xec, 0x1c, 0x0f, 0xce, 0x62, 0x3c, 0x73, 0x48, 0xc8, 0x4b, 0x26, 0x1f,
+	0xbc, 0x8e, 0xf3, 0x33, 0x5d, 0xc7, 0x7d, 0x5b, 0x28, 0x8e, 0xb9, 0xda, 0x42, 0xff, 0xca, 0xc2,
+	0x82, 0x9c, 0xdb, 0x0b, 0xc6, 0xed, 0x20, 0x76, 0x79, 0xa1, 0xc0, 0x45, 0xa1, 0xdc, 0x3e, 0x72,
+	0xa4, 0xdf, 0x83, 0xab, 0xe2, 0xc9, 0x4a, 0x5c, 0x85, 0xf3, 0x42, 0x5c, 0x93, 0x47, 0x88, 0x01,
+	0x39, 0xf9, 0x0a, 0x42, 0x79, 0xcc, 0xab, 0x31, 0x0b, 0x5e, 0xfc, 0x2c, 0x83, 0x77, 0x59, 0x50,
+	0xc4, 0x52, 0x11, 0xbc, 0xd3, 0xd2, 0x2e, 0x7b, 0xae, 0xd2, 0x8e, 0x79, 0xd9, 0x42, 0x84, 0xd8,
+	0x9e, 0x08, 0x7d, 0xde, 0x8c, 0x87, 0xec, 0xbc, 0xf2, 0x83, 0xbe, 0x03, 0x20, 0xcf, 0xa7, 0x0b,
+	0x7e, 0x70, 0xba, 0xef, 0x1f, 0xc0, 0xb2, 0x1f, 0x0c, 0xd9, 0xed, 0x62, 0xb3, 0xea, 0x7e, 0x90,
+	0xda, 0xe4, 0x03, 0x77, 0x78, 0x81, 0x2f, 0x53, 0x77, 0xf8, 0xe0, 0x3b, 0x9e, 0x9b, 0xad, 0xe4,
+	0x5a, 0x85, 0x3c, 0xed, 0x5a, 0x38, 0xf4, 0x3d, 0x3f, 0x28, 0xce, 0x8b, 0xe0, 0xd2, 0xee, 0x3e,
+	0x1f, 0xb3, 0xb3, 0xdb, 0x26, 0x04, 0xd1, 0xe2, 0x02, 0x9f, 0x10, 0x03, 0xfd, 0x16, 0x14, 0x50,
+	0x07, 0x05, 0x54, 0xde, 0x81, 0x57, 0xb9, 0x55, 0xc0, 0x45, 0xfc, 0x1a, 0xd4, 0x43, 0x58, 0xe1,
+	0xc5, 0xb9, 0x83, 0x9b, 0x96, 0x83, 0x03, 0x1a, 0xda, 0x0e, 0xb5, 0x3a, 0x28, 0x24, 0x3e, 0x0e,
+	0x8a, 0x8b, 0xdc, 0xce, 0x47, 0xe5, 0x91, 0x8d, 0x4d, 0xf9, 0x40, 0xe2, 0x6b, 0x12, 0xfe, 0x85,
+	0x40, 0x9b, 0x37, 0xdb, 0xc3, 0x27, 0xf4, 0x9f, 0xb3, 0x3c, 0xe8, 0xa0, 0x90, 0x5a, 0xb8, 0x4d,
+	0x7d, 0x1c, 0x90, 0xe2, 0x12, 0xbf, 0xf9, 0xef, 0x8f, 0x51, 0x64, 0x72, 0xd0, 0xbe, 0xc0, 0xec,
+	0x64, 0x58, 0x5a, 0xb0, 0xdc, 0xe9, 0x13, 0xea, 0x75, 0x98, 0x73, 0xec, 0x66, 0x53, 0x11, 0xeb,
+	0x9c, 0xf8, 0xc3, 0x31, 0xc4, 0x35, 0xbb, 0xd9, 0x94, 0x0c, 0x66, 0xc1, 0x39, 0x1d, 0xe8, 0x5b,
+	0x70, 0xcd, 0x27, 0x56, 0x7f, 0x33, 0xc3, 0x66, 0x8b, 0xd7, 0x78, 0x31, 0xb0, 0xe8, 0x93, 0x1a,
+	0x9b, 0xe1, 0x59, 0xcb, 0x28, 0x4a, 0x45, 0xb8, 0x31, 0xb8, 0xd1, 0xd4, 0x1e, 0x7c, 0xc1, 0xcb,
+	0xd2, 0xa7, 0x0d, 0x1c, 0xd2, 0xcf, 0x69, 0xe4, 0x9c, 0xd4, 0x6a, 0x87, 0x5f, 0x8e, 0xee, 0x22,
+	0x46, 0xd5, 0x6b, 0xab, 0xb0, 0x92, 0x62, 0x53, 0xaa, 0xf6, 0x79, 0x0b, 0x51, 0xb3, 0x03, 0x07,
+	0x35, 0x9f, 0xa1, 0xa6, 0xdd, 0x43, 0xee, 0xf9, 0xb4, 0x89, 0x9a, 0x3b, 0x45, 0xa8, 0x14, 0x76,
+	0xb8, 0x42, 0x13, 0x1d, 0x45, 0x81, 0xcb, 0x6d, 0x3a, 0xa7, 0x42, 0x71, 0x4e, 0x30, 0x36, 0x55,
+	0xd3, 0x8a, 0x0b, 0x7a, 0x5e, 0x48, 0x65, 0x51, 0x2b, 0xed, 0x4a, 0xe9, 0x55, 0x76, 0x7d, 0xad,
+	0xc1, 0x8a, 0x6a, 0xb6, 0x4c, 0x9b, 0xa2, 0x17, 0xa2, 0x8f, 0x7d, 0xce, 0xda, 0xd8, 0x11, 0xd6,
+	0x39, 0xa0, 0xa7, 0xdb, 0x5e, 0x6e, 0x65, 0xa1, 0x5a, 0x19, 0x97, 0xa2, 0x09, 0x35, 0x32, 0x4b,
+	0x17, 0xc3, 0x84, 0xbc, 0x74, 0x07, 0x6e, 0x9f, 0x69, 0x9b, 0xf2, 0xe0, 0xdf, 0x1a, 0xac, 0x9e,
+	0x36, 0xa7, 0xbc, 0xee, 0xaf, 0x45, 0x84, 0x62, 0xb7, 0x77, 0x8e, 0xce, 0xb9, 0x0c, 0xd7, 0x02,
+	0xf4, 0x95, 0xe5, 0x08, 0xa2, 0x44, 0x88, 0x97, 0x02, 0xf4, 0x95, 0x54, 0x11, 0xf7, 0x0e, 0xa9,
+	0x16, 0x29, 0x33, 0xa4, 0x45, 0x3a, 0x3d, 0xb3, 0x2f, 0x9f, 0xaf, 0x1d, 0x7f, 0x06, 0x77, 0x46,
+	0x78, 0xdc, 0x5f, 0x9c, 0xf7, 0x65, 0x90, 0x96, 0x4c, 0xd9, 0x16, 0x6c, 0xa8, 0xe8, 0xf6, 0x93,
+	0x1c, 0xd8, 0x11, 0x91, 0x57, 0xfa, 0xec, 0x15, 0x32, 0xe3, 0xe0, 0xe1, 0xca, 0x99, 0x62, 0x50,
+	0xda, 0x83, 0xcd, 0x71, 0xea, 0x26, 0xb5, 0xfc, 0x8d, 0xc6, 0xbf, 0x38, 0xd4, 0x70, 0x40, 0x70,
+	0xd3, 0x67, 0x84, 0xaf, 0x0e, 0xbf, 0xdc, 0x9f, 0xd1, 0xda, 0x35, 0x80, 0x88, 0x76, 0xb1, 0xe5,
+	0xa8, 0x0f, 0x25, 0x19, 0x33, 0xcf, 0x24, 0x35, 0x26, 0x60, 0xd3, 0x6e, 0x44, 0xa8, 0x9c, 0x16,
+	0x65, 0x6c, 0x9e, 0x49, 0xf8, 0x74, 0xe9, 0x63, 0x58, 0x1d, 0x62, 0xc9, 0x84, 0x8e, 0x54, 0xdf,
+	0x2c, 0xc1, 0xa5, 0x3a, 0xf1, 0xf4, 0x37, 0x1a, 0xe8, 0x43, 0x1a, 0xcb, 0x8f, 0xc6, 0x6c, 0xa4,
+	0xa1, 0xbd, 0x99, 0xf1, 0xf1, 0x2c, 0x28, 0x65, 0xf1, 0x6f, 0x35, 0x58, 0x4a, 0x7f, 0x5a, 0x79,
+	0x38, 0x11, 0xe7, 0x20, 0xc8, 0x78, 0x32, 0x03, 0x48, 0xd9, 0xf1, 0x7b, 0x0d, 0xae, 0x0f, 0x6f,
+	0x1c, 0x7f, 0x34, 0x9e, 0x76, 0x28, 0xd0, 0xf8, 0x64, 0x46, 0xa0, 0xb2, 0xa9, 0x03, 0x73, 0x03,
+	0xfd, 0x63, 0x79, 0x3c, 0x61, 0xff, 0x7a, 0xe3, 0xd1, 0x74, 0xeb, 0x93, 0x7a, 0x55, 0x6f, 0x37,
+	0xa1, 0xde, 0x78, 0xbd, 0xf1, 0x68, 0xba, 0xf5, 0x4a, 0x2f, 0x81, 0x42, 0x7f, 0x41, 0xbc, 0x35,
+	0x19, 0x8d, 0x5c, 0x6e, 0xfc, 0x70, 0xaa, 0xe5, 0x4a, 0xe9, 0x2f, 0x61, 0x21, 0xf1, 0x65, 0xea,
+	0xc1, 0x78, 0xa2, 0x41, 0x84, 0xf1, 0x78, 0x5a, 0x84, 0xd2, 0xfe, 0x1b, 0x0d, 0x16, 0x53, 0x5f,
+	0x32, 0xab, 0xe3, 0xe9, 0x92, 0x18, 0x63, 0x7b, 0x7a, 0x8c, 0x32, 0xe2, 0x57, 0x70, 0x35, 0xf9,
+	0xfd, 0xf7, 0x07, 0xe3, 0xe9, 0x12, 0x10, 0xe3, 0xc7, 0x53, 0x43, 0xfa, 0xdf, 0x41, 0xa2, 0x0c,
+	0x9b, 0xe0, 0x1d, 0x0c, 0x22, 0x8c, 0xc7, 0xd3, 0x22, 0x06, 0x8e, 0xa0, 0x74, 0xa5, 0xf4, 0x70,
+	0x92, 0xdd, 0x9b, 0x00, 0x19, 0x4f, 0x66, 0x00, 0x0d, 0xd8, 0x91, 0x2e, 0x11, 0x27, 0xb0, 0x23,
+	0x05, 0x32, 0x9e, 0xcc, 0x00, 0x52, 0x76, 0xfc, 0x51, 0x83, 0x1b, 0x67, 0x14, 0x68, 0x8f, 0x27,
+	0xcd, 0xb2, 0x24, 0xd2, 0xf8, 0xe9, 0xac, 0x48, 0x65, 0xd6, 0xd7, 0x1a, 0x14, 0xcf, 0xac, 0xba,
+	0xb6, 0x27, 0x4e, 0xbe, 0x14, 0xd6, 0xd8, 0x99, 0x1d, 0xab, 0x8c, 0xfb, 0x8b, 0x06, 0x6b, 0xa3,
+	0x4b, 0x9b, 0x4f, 0x26, 0x0d, 0xc0, 0x19, 0x04, 0xc6, 0xee, 0x39, 0x09, 0x06, 0xce, 0x9c, 0x54,
+	0x2d, 0x33, 0xc1, 0x99, 0x93, 0xc4, 0x18, 0xdb, 0xd3, 0x63, 0x62, 0x23, 0x76, 0x76, 0xbf, 0x79,
+	0xb7, 0xae, 0x7d, 0xfb, 0x6e, 0x5d, 0xfb, 0xe7, 0xbb, 0x75, 0xed, 0x77, 0xef, 0xd7, 0x2f, 0x7c,
+	0xfb, 0x7e, 0xfd, 0xc2, 0xdf, 0xdf, 0xaf, 0x5f, 0xf8, 0xc5, 0x56, 0x5f, 0x79, 0xca, 0x58, 0xb7,
+	0xc4, 0x7f, 0xaf, 0x02, 0xec, 0xa2, 0x4a, 0x77, 0xe0, 0x9f, 0x7c, 0xac, 0x52, 0x6d, 0x64, 0x79,
+	0x47, 0xfb, 0xf0, 0x7f, 0x03, 0x00, 0x59, 0xfe, 0xb6, 0x72, 0x12, 0x1c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1874,9 +2086,11 @@ type MsgClient interface {
 	MigrateTssFunds(ctx context.Context, in *MsgMigrateTssFunds, opts ...grpc.CallOption) (*MsgMigrateTssFundsResponse, error)
 	AbortStuckCCTX(ctx context.Context, in *MsgAbortStuckCCTX, opts ...grpc.CallOption) (*MsgAbortStuckCCTXResponse, error)
 	RefundAbortedCCTX(ctx context.Context, in *MsgRefundAbortedCCTX, opts ...grpc.CallOption) (*MsgRefundAbortedCCTXResponse, error)
+	CancelDelayedCCTX(ctx context.Context, in *MsgCancelDelayedCCTX, opts ...grpc.CallOption) (*MsgCancelDelayedCCTXResponse, error)
 	UpdateRateLimiterFlags(ctx context.Context, in *MsgUpdateRateLimiterFlags, opts ...grpc.CallOption) (*MsgUpdateRateLimiterFlagsResponse, error)
 	MigrateERC20CustodyFunds(ctx context.Context, in *MsgMigrateERC20CustodyFunds, opts ...grpc.CallOption) (*MsgMigrateERC20CustodyFundsResponse, error)
 	UpdateERC20CustodyPauseStatus(ctx context.Context, in *MsgUpdateERC20CustodyPauseStatus, opts ...grpc.CallOption) (*MsgUpdateERC20CustodyPauseStatusResponse, error)
+	ConsolidateUTXOs(ctx context.Context, in *MsgConsolidateUTXOs, opts ...grpc.CallOption) (*MsgConsolidateUTXOsResponse, error)
 }
 
 type msgClient struct {
@@ -1986,6 +2200,15 @@ func (c *msgClient) RefundAbortedCCTX(ctx context.Context, in *MsgRefundAbortedC
 	return out, nil
 }
 
+func (c *msgClient) CancelDelayedCCTX(ctx context.Context, in *MsgCancelDelayedCCTX, opts ...grpc.CallOption) (*MsgCancelDelayedCCTXResponse, error) {
+	out := new(MsgCancelDelayedCCTXResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Msg/CancelDelayedCCTX", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) UpdateRateLimiterFlags(ctx context.Context, in *MsgUpdateRateLimiterFlags, opts ...grpc.CallOption) (*MsgUpdateRateLimiterFlagsResponse, error) {
 	out := new(MsgUpdateRateLimiterFlagsResponse)
 	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Msg/UpdateRateLimiterFlags", in, out, opts...)
@@ -2013,6 +2236,15 @@ func (c *msgClient) UpdateERC20CustodyPauseStatus(ctx context.Context, in *MsgUp
 	return out, nil
 }
 
+func (c *msgClient) ConsolidateUTXOs(ctx context.Context, in *MsgConsolidateUTXOs, opts ...grpc.CallOption) (*MsgConsolidateUTXOsResponse, error) {
+	out := new(MsgConsolidateUTXOsResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Msg/ConsolidateUTXOs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	AddOutboundTracker(context.Context, *MsgAddOutboundTracker) (*MsgAddOutboundTrackerResponse, error)
@@ -2026,9 +2258,11 @@ type MsgServer interface {
 	MigrateTssFunds(context.Context, *MsgMigrateTssFunds) (*MsgMigrateTssFundsResponse, error)
 	AbortStuckCCTX(context.Context, *MsgAbortStuckCCTX) (*MsgAbortStuckCCTXResponse, error)
 	RefundAbortedCCTX(context.Context, *MsgRefundAbortedCCTX) (*MsgRefundAbortedCCTXResponse, error)
+	CancelDelayedCCTX(context.Context, *MsgCancelDelayedCCTX) (*MsgCancelDelayedCCTXResponse, error)
 	UpdateRateLimiterFlags(context.Context, *MsgUpdateRateLimiterFlags) (*MsgUpdateRateLimiterFlagsResponse, error)
 	MigrateERC20CustodyFunds(context.Context, *MsgMigrateERC20CustodyFunds) (*MsgMigrateERC20CustodyFundsResponse, error)
 	UpdateERC20CustodyPauseStatus(context.Context, *MsgUpdateERC20CustodyPauseStatus) (*MsgUpdateERC20CustodyPauseStatusResponse, error)
+	ConsolidateUTXOs(context.Context, *MsgConsolidateUTXOs) (*MsgConsolidateUTXOsResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -2068,6 +2302,9 @@ func (*UnimplementedMsgServer) AbortStuckCCTX(ctx context.Context, req *MsgAbort
 func (*UnimplementedMsgServer) RefundAbortedCCTX(ctx context.Context, req *MsgRefundAbortedCCTX) (*MsgRefundAbortedCCTXResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RefundAbortedCCTX not implemented")
 }
+func (*UnimplementedMsgServer) CancelDelayedCCTX(ctx context.Context, req *MsgCancelDelayedCCTX) (*MsgCancelDelayedCCTXResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelDelayedCCTX not implemented")
+}
 func (*UnimplementedMsgServer) UpdateRateLimiterFlags(ctx context.Context, req *MsgUpdateRateLimiterFlags) (*MsgUpdateRateLimiterFlagsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateRateLimiterFlags not implemented")
 }
@@ -2077,6 +2314,9 @@ func (*UnimplementedMsgServer) MigrateERC20CustodyFunds(ctx context.Context, req
 func (*UnimplementedMsgServer) UpdateERC20CustodyPauseStatus(ctx context.Context, req *MsgUpdateERC20CustodyPauseStatus) (*MsgUpdateERC20CustodyPauseStatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateERC20CustodyPauseStatus not implemented")
 }
+func (*UnimplementedMsgServer) ConsolidateUTXOs(ctx context.Context, req *MsgConsolidateUTXOs) (*MsgConsolidateUTXOsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConsolidateUTXOs not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -2280,6 +2520,24 @@ func _Msg_RefundAbortedCCTX_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CancelDelayedCCTX_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelDelayedCCTX)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelDelayedCCTX(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Msg/CancelDelayedCCTX",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelDelayedCCTX(ctx, req.(*MsgCancelDelayedCCTX))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_UpdateRateLimiterFlags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgUpdateRateLimiterFlags)
 	if err := dec(in); err != nil {
@@ -2334,6 +2592,24 @@ func _Msg_UpdateERC20CustodyPauseStatus_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ConsolidateUTXOs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgConsolidateUTXOs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ConsolidateUTXOs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Msg/ConsolidateUTXOs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ConsolidateUTXOs(ctx, req.(*MsgConsolidateUTXOs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "zetachain.zetacore.crosschain.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -2382,6 +2658,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RefundAbortedCCTX",
 			Handler:    _Msg_RefundAbortedCCTX_Handler,
 		},
+		{
+			MethodName: "CancelDelayedCCTX",
+			Handler:    _Msg_CancelDelayedCCTX_Handler,
+		},
 		{
 			MethodName: "UpdateRateLimiterFlags",
 			Handler:    _Msg_UpdateRateLimiterFlags_Handler,
@@ -2394,6 +2674,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateERC20CustodyPauseStatus",
 			Handler:    _Msg_UpdateERC20CustodyPauseStatus_Handler,
 		},
+		{
+			MethodName: "ConsolidateUTXOs",
+			Handler:    _Msg_ConsolidateUTXOs_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "zetachain/zetacore/crosschain/tx.proto",
@@ -3333,6 +3617,66 @@ func (m *MsgAbortStuckCCTXResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgCancelDelayedCCTX) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelDelayedCCTX) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelDelayedCCTX) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CctxIndex) > 0 {
+		i -= len(m.CctxIndex)
+		copy(dAtA[i:], m.CctxIndex)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CctxIndex)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelDelayedCCTXResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelDelayedCCTXResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelDelayedCCTXResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgRefundAbortedCCTX) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3627,15 +3971,90 @@ func (m *MsgUpdateERC20CustodyPauseStatusResponse) MarshalToSizedBuffer(dAtA []b
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgConsolidateUTXOs) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
+	return dAtA[:n], nil
+}
+
+func (m *MsgConsolidateUTXOs) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConsolidateUTXOs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DustCount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DustCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.UtxoCount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.UtxoCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConsolidateUTXOsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConsolidateUTXOsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConsolidateUTXOsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CctxIndex) > 0 {
+		i -= len(m.CctxIndex)
+		copy(dAtA[i:], m.CctxIndex)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CctxIndex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
 	return base
 }
 func (m *MsgMigrateTssFunds) Size() (n int) {
@@ -4056,6 +4475,32 @@ func (m *MsgAbortStuckCCTXResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgCancelDelayedCCTX) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CctxIndex)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelDelayedCCTXResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgRefundAbortedCCTX) Size() (n int) {
 	if m == nil {
 		return 0
@@ -4181,6 +4626,41 @@ func (m *MsgUpdateERC20CustodyPauseStatusResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgConsolidateUTXOs) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovTx(uint64(m.ChainId))
+	}
+	if m.UtxoCount != 0 {
+		n += 1 + sovTx(uint64(m.UtxoCount))
+	}
+	if m.DustCount != 0 {
+		n += 1 + sovTx(uint64(m.DustCount))
+	}
+	return n
+}
+
+func (m *MsgConsolidateUTXOsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CctxIndex)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -7034,6 +7514,170 @@ func (m *MsgAbortStuckCCTXResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgCancelDelayedCCTX) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelDelayedCCTX: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelDelayedCCTX: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CctxIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CctxIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelDelayedCCTXResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelDelayedCCTXResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelDelayedCCTXResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgRefundAbortedCCTX) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -7879,6 +8523,227 @@ func (m *MsgUpdateERC20CustodyPauseStatusResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
+func (m *MsgConsolidateUTXOs) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConsolidateUTXOs: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConsolidateUTXOs: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UtxoCount", wireType)
+			}
+			m.UtxoCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UtxoCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DustCount", wireType)
+			}
+			m.DustCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DustCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgConsolidateUTXOsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConsolidateUTXOsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConsolidateUTXOsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CctxIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CctxIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -8,9 +8,11 @@ import (
 	"github.com/btcsuite/btcd/btcutil"
 	eth "github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/zeta-chain/protocol-contracts/v2/pkg/gatewayevm.sol"
 
 	"github.com/zeta-chain/node/pkg/chains"
 	"github.com/zeta-chain/node/pkg/coin"
+	"github.com/zeta-chain/node/pkg/crypto"
 	observertypes "github.com/zeta-chain/node/x/observer/types"
 )
 
@@ -79,6 +81,109 @@ func verifyInboundBodyEVM(
 	return nil
 }
 
+// VerifyInboundReceipt validates the tx and the gateway logs of its receipt for an inbound
+// The gateway logs allow to verify the contract-based deposits that don't transfer funds to the TSS address
+func VerifyInboundReceipt(
+	msg MsgAddInboundTracker,
+	txBytes []byte,
+	receiptBytes []byte,
+	chainParams observertypes.ChainParams,
+) error {
+	// NOTE: since this functionality is disabled on live network we don't provide on-chain additional chains for simplicity
+	// TODO: use authorityKeeper.GetChainInfo to provide additional chains
+	// https://github.com/zeta-chain/node/issues/2385
+	if !chains.IsEVMChain(msg.ChainId, []chains.Chain{}) {
+		return fmt.Errorf("cannot verify inbound receipt for chain %d", msg.ChainId)
+	}
+
+	var txx ethtypes.Transaction
+	err := txx.UnmarshalBinary(txBytes)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal transaction %s", err.Error())
+	}
+	if txx.Hash().Hex() != msg.TxHash {
+		return fmt.Errorf("invalid hash, want tx hash %s, got %s", txx.Hash().Hex(), msg.TxHash)
+	}
+	if txx.ChainId().Cmp(big.NewInt(msg.ChainId)) != 0 {
+		return fmt.Errorf("invalid chain id, want evm chain id %d, got %d", txx.ChainId(), msg.ChainId)
+	}
+
+	var receipt ethtypes.Receipt
+	err = receipt.UnmarshalBinary(receiptBytes)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal receipt %s", err.Error())
+	}
+	if receipt.Status != ethtypes.ReceiptStatusSuccessful {
+		return fmt.Errorf("transaction %s failed", msg.TxHash)
+	}
+
+	// look for a gateway event of the tracked coin type
+	gatewayAddr := eth.HexToAddress(chainParams.GatewayAddress)
+	if crypto.IsEmptyAddress(gatewayAddr) {
+		return fmt.Errorf("gateway address not found for chain %d", msg.ChainId)
+	}
+	for _, log := range receipt.Logs {
+		if log == nil || log.Address != gatewayAddr {
+			continue
+		}
+		coinType, found, err := gatewayInboundCoinType(gatewayAddr, *log)
+		if err != nil {
+			return err
+		}
+		if found && coinType == msg.CoinType {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("no gateway inbound event found for coin type %s", msg.CoinType)
+}
+
+// gatewayInboundCoinType returns the coin type of the inbound emitted in the given gateway log
+// returns false if the log is not an inbound event
+func gatewayInboundCoinType(gatewayAddr eth.Address, log ethtypes.Log) (coin.CoinType, bool, error) {
+	gatewayABI, err := gatewayevm.GatewayEVMMetaData.GetAbi()
+	if err != nil {
+		return coin.CoinType_Gas, false, err
+	}
+	gateway, err := gatewayevm.NewGatewayEVMFilterer(gatewayAddr, nil)
+	if err != nil {
+		return coin.CoinType_Gas, false, err
+	}
+	if len(log.Topics) == 0 {
+		return coin.CoinType_Gas, false, nil
+	}
+
+	// deposits of zero asset address are deposits of gas token
+	depositCoinType := func(asset eth.Address) coin.CoinType {
+		if crypto.IsEmptyAddress(asset) {
+			return coin.CoinType_Gas
+		}
+		return coin.CoinType_ERC20
+	}
+
+	switch log.Topics[0] {
+	case gatewayABI.Events["Deposited"].ID:
+		event, err := gateway.ParseDeposited(log)
+		if err != nil {
+			return coin.CoinType_Gas, false, fmt.Errorf("failed to parse Deposited event %s", err.Error())
+		}
+		return depositCoinType(event.Asset), true, nil
+	case gatewayABI.Events["DepositedAndCalled"].ID:
+		event, err := gateway.ParseDepositedAndCalled(log)
+		if err != nil {
+			return coin.CoinType_Gas, false, fmt.Errorf("failed to parse DepositedAndCalled event %s", err.Error())
+		}
+		return depositCoinType(event.Asset), true, nil
+	case gatewayABI.Events["Called"].ID:
+		if _, err := gateway.ParseCalled(log); err != nil {
+			return coin.CoinType_Gas, false, fmt.Errorf("failed to parse Called event %s", err.Error())
+		}
+		return coin.CoinType_NoAssetCall, true, nil
+	default:
+		return coin.CoinType_Gas, false, nil
+	}
+}
+
 // VerifyOutboundBody verifies the tx body for an outbound
 func VerifyOutboundBody(msg MsgAddOutboundTracker, txBytes []byte, tss observertypes.QueryGetTssAddressResponse) error {
 	// verify message against transaction body
@@ -1,9 +1,13 @@
 package types_test
 
 import (
+	"math/big"
 	"testing"
 
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/protocol-contracts/v2/pkg/gatewayevm.sol"
 
 	"github.com/zeta-chain/node/pkg/chains"
 	"github.com/zeta-chain/node/pkg/coin"
@@ -155,6 +159,213 @@ func TestVerifyInboundBody(t *testing.T) {
 	}
 }
 
+func TestVerifyInboundReceipt(t *testing.T) {
+	gateway := sample.EthAddress()
+	chainParams := observertypes.ChainParams{GatewayAddress: gateway.Hex()}
+	sampleEthTx, sampleEthTxBytes := sample.EthTx(t, chains.Ethereum.ChainId, gateway, 42)
+	revertOptions := gatewayevm.RevertOptions{OnRevertGasLimit: big.NewInt(0)}
+
+	// gateway logs
+	gasDeposit := sample.EthGatewayLog(
+		t,
+		gateway,
+		"Deposited",
+		big.NewInt(42),
+		ethcommon.Address{}, // zero asset address for gas token
+		[]byte{},
+		revertOptions,
+	)
+	erc20Deposit := sample.EthGatewayLog(
+		t,
+		gateway,
+		"Deposited",
+		big.NewInt(42),
+		sample.EthAddress(),
+		[]byte{},
+		revertOptions,
+	)
+	erc20DepositAndCall := sample.EthGatewayLog(
+		t,
+		gateway,
+		"DepositedAndCalled",
+		big.NewInt(42),
+		sample.EthAddress(),
+		[]byte("payload"),
+		revertOptions,
+	)
+	call := sample.EthGatewayLog(t, gateway, "Called", []byte("payload"), revertOptions)
+	otherContractDeposit := sample.EthGatewayLog(
+		t,
+		sample.EthAddress(),
+		"Deposited",
+		big.NewInt(42),
+		sample.EthAddress(),
+		[]byte{},
+		revertOptions,
+	)
+
+	_, gasDepositReceipt := sample.EthReceipt(t, ethtypes.ReceiptStatusSuccessful, gasDeposit)
+	_, erc20DepositReceipt := sample.EthReceipt(t, ethtypes.ReceiptStatusSuccessful, erc20Deposit)
+	_, erc20DepositAndCallReceipt := sample.EthReceipt(t, ethtypes.ReceiptStatusSuccessful, erc20DepositAndCall)
+	_, callReceipt := sample.EthReceipt(t, ethtypes.ReceiptStatusSuccessful, otherContractDeposit, call)
+	_, otherContractReceipt := sample.EthReceipt(t, ethtypes.ReceiptStatusSuccessful, otherContractDeposit)
+	_, failedReceipt := sample.EthReceipt(t, ethtypes.ReceiptStatusFailed)
+
+	// NOTE: errContains == "" means no error
+	for _, tc := range []struct {
+		desc         string
+		msg          types.MsgAddInboundTracker
+		txBytes      []byte
+		receiptBytes []byte
+		chainParams  observertypes.ChainParams
+		errContains  string
+	}{
+		{
+			desc: "can't verify btc tx receipt",
+			msg: types.MsgAddInboundTracker{
+				ChainId: chains.BitcoinMainnet.ChainId,
+			},
+			txBytes:     sample.Bytes(),
+			errContains: "cannot verify inbound receipt for chain",
+		},
+		{
+			desc: "txBytes can't be unmarshaled",
+			msg: types.MsgAddInboundTracker{
+				ChainId: chains.Ethereum.ChainId,
+			},
+			txBytes:     []byte("invalid"),
+			errContains: "failed to unmarshal transaction",
+		},
+		{
+			desc: "txHash doesn't correspond",
+			msg: types.MsgAddInboundTracker{
+				ChainId: chains.Ethereum.ChainId,
+				TxHash:  sample.Hash().Hex(),
+			},
+			txBytes:     sampleEthTxBytes,
+			errContains: "invalid hash",
+		},
+		{
+			desc: "chain id doesn't correspond",
+			msg: types.MsgAddInboundTracker{
+				ChainId: chains.Sepolia.ChainId,
+				TxHash:  sampleEthTx.Hash().Hex(),
+			},
+			txBytes:     sampleEthTxBytes,
+			errContains: "invalid chain id",
+		},
+		{
+			desc: "receiptBytes can't be unmarshaled",
+			msg: types.MsgAddInboundTracker{
+				ChainId: chains.Ethereum.ChainId,
+				TxHash:  sampleEthTx.Hash().Hex(),
+			},
+			txBytes:      sampleEthTxBytes,
+			receiptBytes: []byte("invalid"),
+			errContains:  "failed to unmarshal receipt",
+		},
+		{
+			desc: "transaction failed",
+			msg: types.MsgAddInboundTracker{
+				ChainId: chains.Ethereum.ChainId,
+				TxHash:  sampleEthTx.Hash().Hex(),
+			},
+			txBytes:      sampleEthTxBytes,
+			receiptBytes: failedReceipt,
+			chainParams:  chainParams,
+			errContains:  "failed",
+		},
+		{
+			desc: "gateway address not set",
+			msg: types.MsgAddInboundTracker{
+				ChainId:  chains.Ethereum.ChainId,
+				TxHash:   sampleEthTx.Hash().Hex(),
+				CoinType: coin.CoinType_Gas,
+			},
+			txBytes:      sampleEthTxBytes,
+			receiptBytes: gasDepositReceipt,
+			errContains:  "gateway address not found",
+		},
+		{
+			desc: "no event emitted by the gateway",
+			msg: types.MsgAddInboundTracker{
+				ChainId:  chains.Ethereum.ChainId,
+				TxHash:   sampleEthTx.Hash().Hex(),
+				CoinType: coin.CoinType_ERC20,
+			},
+			txBytes:      sampleEthTxBytes,
+			receiptBytes: otherContractReceipt,
+			chainParams:  chainParams,
+			errContains:  "no gateway inbound event found",
+		},
+		{
+			desc: "gateway event of another coin type",
+			msg: types.MsgAddInboundTracker{
+				ChainId:  chains.Ethereum.ChainId,
+				TxHash:   sampleEthTx.Hash().Hex(),
+				CoinType: coin.CoinType_ERC20,
+			},
+			txBytes:      sampleEthTxBytes,
+			receiptBytes: gasDepositReceipt,
+			chainParams:  chainParams,
+			errContains:  "no gateway inbound event found",
+		},
+		{
+			desc: "can verify gas deposit",
+			msg: types.MsgAddInboundTracker{
+				ChainId:  chains.Ethereum.ChainId,
+				TxHash:   sampleEthTx.Hash().Hex(),
+				CoinType: coin.CoinType_Gas,
+			},
+			txBytes:      sampleEthTxBytes,
+			receiptBytes: gasDepositReceipt,
+			chainParams:  chainParams,
+		},
+		{
+			desc: "can verify erc20 deposit",
+			msg: types.MsgAddInboundTracker{
+				ChainId:  chains.Ethereum.ChainId,
+				TxHash:   sampleEthTx.Hash().Hex(),
+				CoinType: coin.CoinType_ERC20,
+			},
+			txBytes:      sampleEthTxBytes,
+			receiptBytes: erc20DepositReceipt,
+			chainParams:  chainParams,
+		},
+		{
+			desc: "can verify erc20 deposit and call",
+			msg: types.MsgAddInboundTracker{
+				ChainId:  chains.Ethereum.ChainId,
+				TxHash:   sampleEthTx.Hash().Hex(),
+				CoinType: coin.CoinType_ERC20,
+			},
+			txBytes:      sampleEthTxBytes,
+			receiptBytes: erc20DepositAndCallReceipt,
+			chainParams:  chainParams,
+		},
+		{
+			desc: "can verify call",
+			msg: types.MsgAddInboundTracker{
+				ChainId:  chains.Ethereum.ChainId,
+				TxHash:   sampleEthTx.Hash().Hex(),
+				CoinType: coin.CoinType_NoAssetCall,
+			},
+			txBytes:      sampleEthTxBytes,
+			receiptBytes: callReceipt,
+			chainParams:  chainParams,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := types.VerifyInboundReceipt(tc.msg, tc.txBytes, tc.receiptBytes, tc.chainParams)
+			if tc.errContains == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.errContains)
+			}
+		})
+	}
+}
+
 func TestVerifyOutboundBody(t *testing.T) {
 
 	sampleTo := sample.EthAddress()
@@ -32,6 +32,13 @@ func BeginBlocker(ctx sdk.Context, keeper keeper.Keeper) {
 	}
 	blockRewards := params.BlockRewardAmount
 
+	// record the observers participation in the matured ballots and prune the ballots that are past the retention window
+	// once rewards are processed, the liveness must be recorded first as the ballots can be pruned at maturity
+	defer func() {
+		keeper.GetObserverKeeper().UpdateObserversLiveness(ctx, ctx.BlockHeight()-params.BallotMaturityBlocks)
+		PruneMaturedBallots(ctx, keeper, params)
+	}()
+
 	// skip if block rewards are nil or not positive
 	if blockRewards.IsNil() || !blockRewards.IsPositive() {
 		logEach10Blocks("Block rewards are nil or not positive")
@@ -178,11 +185,26 @@ func DistributeObserverRewards(
 		}
 	}
 	types.EmitObserverEmissions(ctx, finalDistributionList)
-	// TODO : Delete Ballots after distribution
-	// https://github.com/zeta-chain/node/issues/942
 	return nil
 }
 
+// PruneMaturedBallots deletes the ballots created at least BallotMaturityBlocks + BallotRetentionBlocks blocks ago
+// The ballots are used for rewards distribution at maturity and kept for the retention window afterward, a ballot
+// still in progress at the end of the retention window is deleted as well
+// The pruning is bounded per block, the ballots created before the pruning was introduced are pruned over several blocks
+// If EmitBallotArchiveEvents is set, the pruned ballots are emitted as events for indexers
+func PruneMaturedBallots(ctx sdk.Context, keeper keeper.Keeper, params types.Params) {
+	height := ctx.BlockHeight() - params.BallotMaturityBlocks - params.BallotRetentionBlocks
+	if height < 0 {
+		return
+	}
+
+	pruned := keeper.GetObserverKeeper().PruneBallotListsUntil(ctx, height, params.EmitBallotArchiveEvents)
+	if pruned > 0 {
+		ctx.Logger().Debug(fmt.Sprintf("Pruned %d ballots created until height %d", pruned, height))
+	}
+}
+
 // DistributeTSSRewards trasferes the allocated rewards to the Undistributed Tss Rewards Pool.
 // This is done so that the reserves factor is properly calculated in the next block
 func DistributeTSSRewards(ctx sdk.Context, amount sdk.Int, bankKeeper types.BankKeeper) error {
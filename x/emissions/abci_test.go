@@ -310,6 +310,31 @@ func TestBeginBlocker(t *testing.T) {
 			observerPoolBalances.String(),
 		)
 	})
+
+	t.Run("observers liveness is recorded before the matured ballots are pruned", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.EmissionsKeeper(t)
+		params, found := k.GetParams(ctx)
+		require.True(t, found)
+
+		observerSet := sample.ObserverSet(3)
+		zk.ObserverKeeper.SetObserverSet(ctx, observerSet)
+		ballot := sample.BallotList(1, observerSet.ObserverList)[0]
+		ballot.Votes[0] = observertypes.VoteType_NotYetVoted
+		zk.ObserverKeeper.SetBallot(ctx, &ballot)
+		zk.ObserverKeeper.AddBallotToList(ctx, ballot)
+
+		ctx = ctx.WithBlockHeight(params.BallotMaturityBlocks)
+		emissions.BeginBlocker(ctx, *k)
+
+		_, found = zk.ObserverKeeper.GetBallot(ctx, ballot.BallotIdentifier)
+		require.False(t, found)
+		for i, observer := range observerSet.ObserverList {
+			liveness, found := zk.ObserverKeeper.GetObserverLiveness(ctx, observer)
+			require.True(t, found)
+			require.EqualValues(t, 1, liveness.RecordedBallots)
+			require.Equal(t, i == 0, liveness.MissedBallotsCounter == 1)
+		}
+	})
 }
 
 func TestDistributeObserverRewards(t *testing.T) {
@@ -592,3 +617,62 @@ func setEmissionsParams(t *testing.T, ctx sdk.Context, k emissionskeeper.Keeper,
 
 	store.Set(emissionstypes.KeyPrefix(emissionstypes.ParamsKey), bz)
 }
+
+func TestPruneMaturedBallots(t *testing.T) {
+	setBallot := func(t *testing.T, ctx sdk.Context, zk keepertest.ZetaKeepers, height int64) observertypes.Ballot {
+		ballot := observertypes.Ballot{
+			BallotIdentifier:     sample.ZetaIndex(t),
+			BallotThreshold:      sdk.ZeroDec(),
+			BallotStatus:         observertypes.BallotStatus_BallotFinalized_SuccessObservation,
+			BallotCreationHeight: height,
+		}
+		zk.ObserverKeeper.SetBallot(ctx, &ballot)
+		zk.ObserverKeeper.AddBallotToList(ctx, ballot)
+		return ballot
+	}
+
+	t.Run("should prune ballots at maturity if retention is zero", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.EmissionsKeeper(t)
+		params := emissionstypes.DefaultParams()
+		ballot := setBallot(t, ctx, zk, 10)
+		ctx = ctx.WithBlockHeight(10 + params.BallotMaturityBlocks)
+
+		emissions.PruneMaturedBallots(ctx, *k, params)
+
+		_, found := zk.ObserverKeeper.GetBallot(ctx, ballot.BallotIdentifier)
+		require.False(t, found)
+		_, found = zk.ObserverKeeper.GetBallotList(ctx, 10)
+		require.False(t, found)
+	})
+
+	t.Run("should keep ballots during the retention window", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.EmissionsKeeper(t)
+		params := emissionstypes.DefaultParams()
+		params.BallotRetentionBlocks = 50
+		ballot := setBallot(t, ctx, zk, 10)
+
+		ctx = ctx.WithBlockHeight(10 + params.BallotMaturityBlocks)
+		emissions.PruneMaturedBallots(ctx, *k, params)
+		_, found := zk.ObserverKeeper.GetBallot(ctx, ballot.BallotIdentifier)
+		require.True(t, found)
+
+		ctx = ctx.WithBlockHeight(10 + params.BallotMaturityBlocks + params.BallotRetentionBlocks)
+		emissions.PruneMaturedBallots(ctx, *k, params)
+		_, found = zk.ObserverKeeper.GetBallot(ctx, ballot.BallotIdentifier)
+		require.False(t, found)
+	})
+
+	t.Run("should emit archive events if enabled", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.EmissionsKeeper(t)
+		params := emissionstypes.DefaultParams()
+		params.EmitBallotArchiveEvents = true
+		setBallot(t, ctx, zk, 10)
+		ctx = ctx.WithBlockHeight(10 + params.BallotMaturityBlocks).WithEventManager(sdk.NewEventManager())
+
+		emissions.PruneMaturedBallots(ctx, *k, params)
+
+		events := ctx.EventManager().Events()
+		require.Len(t, events, 1)
+		require.Equal(t, "zetachain.zetacore.observer.EventBallotArchived", events[0].Type)
+	})
+}
@@ -16,6 +16,8 @@ type AccountKeeper interface {
 type ObserverKeeper interface {
 	GetBallot(ctx sdk.Context, index string) (val observertypes.Ballot, found bool)
 	GetMaturedBallots(ctx sdk.Context, maturityBlocks int64) (val observertypes.BallotListForHeight, found bool)
+	PruneBallotListsUntil(ctx sdk.Context, height int64, archive bool) int
+	UpdateObserversLiveness(ctx sdk.Context, height int64)
 }
 
 // BankKeeper defines the expected interface needed to retrieve account balances.
@@ -42,6 +42,9 @@ func (p Params) Validate() error {
 	if err := validateBlockRewardsAmount(p.BlockRewardAmount); err != nil {
 		return err
 	}
+	if err := validateBallotRetentionBlocks(p.BallotRetentionBlocks); err != nil {
+		return err
+	}
 	return validateObserverSlashAmount(p.ObserverSlashAmount)
 }
 
@@ -126,6 +129,19 @@ func validateBallotMaturityBlocks(i interface{}) error {
 	return nil
 }
 
+func validateBallotRetentionBlocks(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("ballot retention blocks must not be negative")
+	}
+
+	return nil
+}
+
 func validateBlockRewardsAmount(i interface{}) error {
 	v, ok := i.(sdkmath.LegacyDec)
 	if !ok {
@@ -33,6 +33,8 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 //	ObserverSlashAmount:         100000000000000000,
 //	BallotMaturityBlocks:        100,
 //	BlockRewardAmount:           9620949074074074074.074070733466756687,
+//	BallotRetentionBlocks:       0,
+//	EmitBallotArchiveEvents:     false,
 type Params struct {
 	ValidatorEmissionPercentage string                                 `protobuf:"bytes,5,opt,name=validator_emission_percentage,json=validatorEmissionPercentage,proto3" json:"validator_emission_percentage,omitempty"`
 	ObserverEmissionPercentage  string                                 `protobuf:"bytes,6,opt,name=observer_emission_percentage,json=observerEmissionPercentage,proto3" json:"observer_emission_percentage,omitempty"`
@@ -40,6 +42,11 @@ type Params struct {
 	ObserverSlashAmount         github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,9,opt,name=observer_slash_amount,json=observerSlashAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"observer_slash_amount"`
 	BallotMaturityBlocks        int64                                  `protobuf:"varint,10,opt,name=ballot_maturity_blocks,json=ballotMaturityBlocks,proto3" json:"ballot_maturity_blocks,omitempty"`
 	BlockRewardAmount           github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,11,opt,name=block_reward_amount,json=blockRewardAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"block_reward_amount"`
+	// number of blocks ballots are kept in the state after their
+	// rewards have been distributed at maturity
+	BallotRetentionBlocks int64 `protobuf:"varint,12,opt,name=ballot_retention_blocks,json=ballotRetentionBlocks,proto3" json:"ballot_retention_blocks,omitempty"`
+	// emit an event containing the full ballot when it is pruned
+	EmitBallotArchiveEvents bool `protobuf:"varint,13,opt,name=emit_ballot_archive_events,json=emitBallotArchiveEvents,proto3" json:"emit_ballot_archive_events,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -102,6 +109,20 @@ func (m *Params) GetBallotMaturityBlocks() int64 {
 	return 0
 }
 
+func (m *Params) GetBallotRetentionBlocks() int64 {
+	if m != nil {
+		return m.BallotRetentionBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetEmitBallotArchiveEvents() bool {
+	if m != nil {
+		return m.EmitBallotArchiveEvents
+	}
+	return false
+}
+
 // Deprecated (v20): Do not use. Use Params Instead
 type LegacyParams struct {
 	MaxBondFactor               string                                 `protobuf:"bytes,1,opt,name=max_bond_factor,json=maxBondFactor,proto3" json:"max_bond_factor,omitempty"`
@@ -221,40 +242,44 @@ func init() {
 }
 
 var fileDescriptor_259272924aec0acf = []byte{
-	// 528 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x94, 0xcf, 0x6f, 0xd3, 0x30,
-	0x14, 0xc7, 0x1b, 0xda, 0x95, 0xd6, 0x0c, 0xb6, 0x65, 0x63, 0x8a, 0xc6, 0x48, 0xa7, 0x09, 0x4d,
-	0x03, 0xb1, 0xe4, 0x00, 0x07, 0xc4, 0x09, 0x3a, 0x98, 0xc4, 0x04, 0xd2, 0x94, 0x71, 0xe2, 0x80,
-	0xf5, 0x92, 0x98, 0xd4, 0x5a, 0x63, 0x57, 0xb6, 0x5b, 0x5a, 0xfe, 0x0a, 0x8e, 0x1c, 0xf9, 0x73,
-	0x76, 0xdc, 0x05, 0x09, 0x71, 0x18, 0xa8, 0xfd, 0x47, 0x50, 0x5e, 0x7e, 0xa8, 0x48, 0xe5, 0xc0,
-	0x0d, 0xed, 0x14, 0xcb, 0xef, 0xf3, 0x3e, 0xfa, 0xda, 0xb1, 0x1e, 0xb9, 0xff, 0x89, 0x19, 0x88,
-	0x7a, 0xc0, 0x85, 0x8f, 0x2b, 0xa9, 0x98, 0xcf, 0x52, 0xae, 0x35, 0x97, 0x42, 0xfb, 0x03, 0x50,
-	0x90, 0x6a, 0x6f, 0xa0, 0xa4, 0x91, 0xf6, 0x76, 0x85, 0x7a, 0x25, 0xea, 0x55, 0xe8, 0xd6, 0x46,
-	0x22, 0x13, 0x89, 0xa0, 0x9f, 0xad, 0xf2, 0x9e, 0xdd, 0x9f, 0x75, 0xd2, 0x3c, 0x41, 0x89, 0xdd,
-	0x25, 0x77, 0x47, 0xd0, 0xe7, 0x31, 0x18, 0xa9, 0x68, 0xd9, 0x47, 0x07, 0x4c, 0x45, 0x4c, 0x18,
-	0x48, 0x98, 0xb3, 0xb4, 0x63, 0xed, 0xb7, 0x83, 0x3b, 0x15, 0xf4, 0xb2, 0x60, 0x4e, 0x2a, 0xc4,
-	0x7e, 0x46, 0xb6, 0x65, 0xa8, 0x99, 0x1a, 0xb1, 0xc5, 0x8a, 0x26, 0x2a, 0xb6, 0x4a, 0x66, 0x81,
-	0xe1, 0x90, 0xb8, 0x46, 0x6b, 0xaa, 0x79, 0x22, 0xfe, 0xe2, 0xb8, 0x9e, 0xc7, 0x30, 0x5a, 0x9f,
-	0x22, 0xb4, 0x40, 0x12, 0x92, 0xdb, 0x55, 0x0c, 0xdd, 0x07, 0xdd, 0xa3, 0x90, 0xca, 0xa1, 0x30,
-	0x4e, 0x3b, 0xeb, 0xed, 0x7a, 0xe7, 0x97, 0x9d, 0xda, 0x8f, 0xcb, 0xce, 0x5e, 0xc2, 0x4d, 0x6f,
-	0x18, 0x7a, 0x91, 0x4c, 0xfd, 0x48, 0xea, 0x54, 0xea, 0xe2, 0x73, 0xa0, 0xe3, 0x33, 0xdf, 0x4c,
-	0x06, 0x4c, 0x7b, 0xaf, 0x84, 0x09, 0xd6, 0x4b, 0xd9, 0x69, 0xe6, 0x7a, 0x8e, 0x2a, 0xfb, 0x31,
-	0xd9, 0x0c, 0xa1, 0xdf, 0x97, 0x86, 0xa6, 0x60, 0x86, 0x8a, 0x9b, 0x09, 0x0d, 0xfb, 0x32, 0x3a,
-	0xd3, 0x0e, 0xd9, 0xb1, 0xf6, 0xeb, 0xc1, 0x46, 0x5e, 0x7d, 0x53, 0x14, 0xbb, 0x58, 0xb3, 0xdf,
-	0x93, 0x75, 0xa4, 0xa8, 0x62, 0x1f, 0x41, 0xc5, 0x65, 0xae, 0x1b, 0xff, 0x9c, 0xeb, 0x05, 0x8b,
-	0x82, 0x35, 0x54, 0x05, 0x68, 0xca, 0x53, 0x3d, 0x6d, 0x7c, 0xf9, 0xda, 0xa9, 0x1d, 0x37, 0x5a,
-	0xd6, 0xea, 0xd2, 0x71, 0xa3, 0xd5, 0x5a, 0x6d, 0xef, 0x7e, 0x6b, 0x90, 0xe5, 0xd7, 0x2c, 0x81,
-	0x68, 0x52, 0xfc, 0xe7, 0x3d, 0xb2, 0x92, 0xc2, 0x98, 0x86, 0x52, 0xc4, 0xf4, 0x03, 0x44, 0x46,
-	0x2a, 0xc7, 0xc2, 0x2b, 0xbd, 0x99, 0xc2, 0xb8, 0x2b, 0x45, 0x7c, 0x84, 0x9b, 0xc8, 0x71, 0xf1,
-	0x07, 0x77, 0xad, 0xe0, 0xb8, 0x98, 0xe3, 0xee, 0x91, 0x5b, 0x30, 0x4a, 0xf2, 0xc3, 0x53, 0xc3,
-	0x53, 0xe6, 0xd4, 0x11, 0x5b, 0x86, 0x51, 0x82, 0xa7, 0x7e, 0xcb, 0x53, 0x66, 0x3f, 0x20, 0x6b,
-	0x06, 0x54, 0xc2, 0x4c, 0x2e, 0x54, 0x60, 0xb8, 0x74, 0x1a, 0x08, 0xae, 0xe4, 0x85, 0x4c, 0x19,
-	0x64, 0xdb, 0x57, 0xe9, 0x25, 0x3e, 0x21, 0x4e, 0x3c, 0xc4, 0xc3, 0x8a, 0xe2, 0x12, 0x69, 0x24,
-	0x85, 0x36, 0x20, 0x8c, 0xd3, 0xc2, 0xf6, 0xcd, 0xb2, 0x9e, 0x5f, 0xe7, 0x61, 0x51, 0xfd, 0x7f,
-	0xdf, 0x70, 0xfe, 0xc6, 0xba, 0x47, 0xe7, 0x53, 0xd7, 0xba, 0x98, 0xba, 0xd6, 0xaf, 0xa9, 0x6b,
-	0x7d, 0x9e, 0xb9, 0xb5, 0x8b, 0x99, 0x5b, 0xfb, 0x3e, 0x73, 0x6b, 0xef, 0x1e, 0xce, 0x45, 0xca,
-	0x06, 0xd1, 0x41, 0x3e, 0xbe, 0x84, 0x8c, 0x99, 0x3f, 0x9e, 0x1b, 0x5e, 0x18, 0x2e, 0x6c, 0xe2,
-	0x20, 0x7a, 0xf4, 0x3b, 0x00, 0x00, 0xff, 0xff, 0xea, 0x36, 0x06, 0xa1, 0xe9, 0x04, 0x00, 0x00,
+	// 583 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x94, 0xcf, 0x6e, 0xd3, 0x4c,
+	0x14, 0xc5, 0xe3, 0xaf, 0x6e, 0xbf, 0x74, 0x68, 0x69, 0xeb, 0xfe, 0xb3, 0x4a, 0x71, 0xab, 0x0a,
+	0x55, 0x01, 0x51, 0x7b, 0x01, 0x42, 0x08, 0x36, 0xd4, 0xa5, 0x95, 0xa8, 0x40, 0xaa, 0x5c, 0x56,
+	0x2c, 0x18, 0x8d, 0xed, 0xc1, 0x19, 0x35, 0x9e, 0x89, 0x66, 0x6e, 0x4c, 0xc2, 0x53, 0xb0, 0x64,
+	0xc9, 0xe3, 0x74, 0xd9, 0x05, 0x48, 0x88, 0x45, 0x85, 0x92, 0x17, 0x41, 0x1e, 0x8f, 0xa3, 0x20,
+	0x85, 0x05, 0x3b, 0xc4, 0x2a, 0xa3, 0x39, 0xbf, 0x7b, 0x74, 0xe6, 0xe6, 0xfa, 0xa2, 0xbb, 0x1f,
+	0x28, 0x90, 0xa4, 0x4d, 0x18, 0x0f, 0xf4, 0x49, 0x48, 0x1a, 0xd0, 0x9c, 0x29, 0xc5, 0x04, 0x57,
+	0x41, 0x97, 0x48, 0x92, 0x2b, 0xbf, 0x2b, 0x05, 0x08, 0x67, 0x7b, 0x8c, 0xfa, 0x35, 0xea, 0x8f,
+	0xd1, 0xad, 0xb5, 0x4c, 0x64, 0x42, 0x83, 0x41, 0x79, 0xaa, 0x6a, 0xf6, 0xbe, 0xd8, 0x68, 0xee,
+	0x4c, 0x9b, 0x38, 0x21, 0xba, 0x5d, 0x90, 0x0e, 0x4b, 0x09, 0x08, 0x89, 0xeb, 0x3a, 0xdc, 0xa5,
+	0x32, 0xa1, 0x1c, 0x48, 0x46, 0xdd, 0xd9, 0x5d, 0xab, 0x35, 0x1f, 0xdd, 0x1a, 0x43, 0xc7, 0x86,
+	0x39, 0x1b, 0x23, 0xce, 0x33, 0xb4, 0x2d, 0x62, 0x45, 0x65, 0x41, 0xa7, 0x5b, 0xcc, 0x69, 0x8b,
+	0xad, 0x9a, 0x99, 0xe2, 0x70, 0x84, 0x3c, 0x50, 0x0a, 0x2b, 0x96, 0xf1, 0xdf, 0x78, 0xfc, 0x5f,
+	0xc5, 0x00, 0xa5, 0xce, 0x35, 0x34, 0xc5, 0x24, 0x46, 0xeb, 0xe3, 0x18, 0xaa, 0x43, 0x54, 0x1b,
+	0x93, 0x5c, 0xf4, 0x38, 0xb8, 0xf3, 0x65, 0x6d, 0xe8, 0x5f, 0x5e, 0xef, 0x34, 0xbe, 0x5f, 0xef,
+	0xec, 0x67, 0x0c, 0xda, 0xbd, 0xd8, 0x4f, 0x44, 0x1e, 0x24, 0x42, 0xe5, 0x42, 0x99, 0x9f, 0x03,
+	0x95, 0x5e, 0x04, 0x30, 0xe8, 0x52, 0xe5, 0xbf, 0xe0, 0x10, 0xad, 0xd6, 0x66, 0xe7, 0xa5, 0xd7,
+	0xa1, 0xb6, 0x72, 0x1e, 0xa2, 0x8d, 0x98, 0x74, 0x3a, 0x02, 0x70, 0x4e, 0xa0, 0x27, 0x19, 0x0c,
+	0x70, 0xdc, 0x11, 0xc9, 0x85, 0x72, 0xd1, 0xae, 0xd5, 0x9a, 0x89, 0xd6, 0x2a, 0xf5, 0x95, 0x11,
+	0x43, 0xad, 0x39, 0x6f, 0xd1, 0xaa, 0xa6, 0xb0, 0xa4, 0xef, 0x89, 0x4c, 0xeb, 0x5c, 0x37, 0xfe,
+	0x38, 0xd7, 0x73, 0x9a, 0x44, 0x2b, 0xda, 0x2a, 0xd2, 0x4e, 0x26, 0xd5, 0x23, 0xb4, 0x69, 0x52,
+	0x49, 0x0a, 0x94, 0x43, 0xd9, 0x3b, 0x13, 0x6b, 0x41, 0xc7, 0x5a, 0xaf, 0xe4, 0xa8, 0x56, 0x4d,
+	0xae, 0xa7, 0x68, 0x8b, 0xe6, 0x0c, 0xb0, 0x29, 0x26, 0x32, 0x69, 0xb3, 0x82, 0x62, 0x5a, 0x50,
+	0x0e, 0xca, 0x5d, 0xdc, 0xb5, 0x5a, 0xcd, 0x68, 0xb3, 0x24, 0x42, 0x0d, 0x1c, 0x56, 0xfa, 0xb1,
+	0x96, 0x9f, 0xd8, 0x9f, 0x3e, 0xef, 0x34, 0x4e, 0xed, 0xa6, 0xb5, 0x3c, 0x7b, 0x6a, 0x37, 0x9b,
+	0xcb, 0xf3, 0x7b, 0x5f, 0x6d, 0xb4, 0xf0, 0x92, 0x66, 0x24, 0x19, 0x98, 0xe1, 0xda, 0x47, 0x4b,
+	0x39, 0xe9, 0xe3, 0x58, 0xf0, 0x14, 0xbf, 0x23, 0x09, 0x08, 0xe9, 0x5a, 0xfa, 0x7f, 0x5c, 0xcc,
+	0x49, 0x3f, 0x14, 0x3c, 0x3d, 0xd1, 0x97, 0x9a, 0x63, 0xfc, 0x17, 0xee, 0x3f, 0xc3, 0x31, 0x3e,
+	0xc1, 0xdd, 0x41, 0x37, 0x49, 0x91, 0x55, 0x4f, 0xc3, 0xc0, 0x72, 0xea, 0xce, 0x68, 0x6c, 0x81,
+	0x14, 0x99, 0x7e, 0xd2, 0x6b, 0x96, 0x53, 0xe7, 0x1e, 0x5a, 0x01, 0x22, 0x33, 0x0a, 0x95, 0xa1,
+	0x24, 0xc0, 0x84, 0x6b, 0x6b, 0x70, 0xa9, 0x12, 0x4a, 0xcb, 0xa8, 0xbc, 0xfe, 0x97, 0xc6, 0xff,
+	0x31, 0x72, 0xd3, 0x9e, 0x7e, 0x2c, 0x37, 0x4d, 0xc4, 0x89, 0xe0, 0x0a, 0x08, 0x07, 0xb7, 0xa9,
+	0xcb, 0x37, 0x6a, 0xbd, 0x6a, 0xe7, 0x91, 0x51, 0xff, 0xde, 0x0f, 0xa7, 0x9a, 0xb1, 0xf0, 0xe4,
+	0x72, 0xe8, 0x59, 0x57, 0x43, 0xcf, 0xfa, 0x31, 0xf4, 0xac, 0x8f, 0x23, 0xaf, 0x71, 0x35, 0xf2,
+	0x1a, 0xdf, 0x46, 0x5e, 0xe3, 0xcd, 0xfd, 0x89, 0x48, 0xe5, 0xf6, 0x3b, 0xa8, 0x76, 0x26, 0x17,
+	0x29, 0x0d, 0xfa, 0x13, 0x1b, 0x53, 0x87, 0x8b, 0xe7, 0xf4, 0xf6, 0x7b, 0xf0, 0x73, 0x00, 0x53,
+	0x00, 0xb8, 0x56, 0x5e, 0x05, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -277,6 +302,21 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.EmitBallotArchiveEvents {
+		i--
+		if m.EmitBallotArchiveEvents {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.BallotRetentionBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.BallotRetentionBlocks))
+		i--
+		dAtA[i] = 0x60
+	}
 	{
 		size := m.BlockRewardAmount.Size()
 		i -= size
@@ -456,6 +496,12 @@ func (m *Params) Size() (n int) {
 	}
 	l = m.BlockRewardAmount.Size()
 	n += 1 + l + sovParams(uint64(l))
+	if m.BallotRetentionBlocks != 0 {
+		n += 1 + sovParams(uint64(m.BallotRetentionBlocks))
+	}
+	if m.EmitBallotArchiveEvents {
+		n += 2
+	}
 	return n
 }
 
@@ -723,6 +769,45 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BallotRetentionBlocks", wireType)
+			}
+			m.BallotRetentionBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BallotRetentionBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmitBallotArchiveEvents", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EmitBallotArchiveEvents = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -66,6 +66,13 @@ func TestValidateBallotMaturityBlocks(t *testing.T) {
 	require.NoError(t, validateBallotMaturityBlocks(int64(100)))
 }
 
+func TestValidateBallotRetentionBlocks(t *testing.T) {
+	require.Error(t, validateBallotRetentionBlocks("10"))
+	require.Error(t, validateBallotRetentionBlocks(int64(-1)))
+	require.NoError(t, validateBallotRetentionBlocks(int64(0)))
+	require.NoError(t, validateBallotRetentionBlocks(int64(1000)))
+}
+
 func TestValidateBlockRewardAmount(t *testing.T) {
 	require.Error(t, validateBlockRewardsAmount("0.50"))
 	require.Error(t, validateBlockRewardsAmount("-0.50"))
@@ -112,6 +119,12 @@ func TestValidate(t *testing.T) {
 		require.Error(t, params.Validate())
 	})
 
+	t.Run("should error for negative ballot retention blocks", func(t *testing.T) {
+		params := NewParams()
+		params.BallotRetentionBlocks = -100
+		require.ErrorContains(t, params.Validate(), "ballot retention blocks must not be negative")
+	})
+
 	t.Run("should error for negative block reward amount", func(t *testing.T) {
 		params := NewParams()
 		params.BlockRewardAmount = sdkmath.LegacyMustNewDecFromStr("-1.30")
@@ -7,6 +7,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	ethcommon "github.com/ethereum/go-ethereum/common"
 
+	"github.com/zeta-chain/node/pkg/chains"
 	"github.com/zeta-chain/node/pkg/coin"
 	"github.com/zeta-chain/node/x/fungible/types"
 )
@@ -109,8 +110,21 @@ func (k Keeper) GetGasCoinForForeignCoin(ctx sdk.Context, chainID int64) (types.
 }
 
 // GetForeignCoinFromAsset returns the foreign coin for a given asset for a given chain
+// Non-hex assets are only supported for TON chains (jetton master address) and are matched as is
 func (k Keeper) GetForeignCoinFromAsset(ctx sdk.Context, asset string, chainID int64) (types.ForeignCoins, bool) {
+	if asset == "" {
+		return types.ForeignCoins{}, false
+	}
+
 	if !ethcommon.IsHexAddress(asset) {
+		if !chains.IsTONChain(chainID, k.GetAuthorityKeeper().GetAdditionalChainList(ctx)) {
+			return types.ForeignCoins{}, false
+		}
+		for _, coin := range k.GetAllForeignCoinsForChain(ctx, chainID) {
+			if coin.Asset == asset && coin.ForeignChainId == chainID {
+				return coin, true
+			}
+		}
 		return types.ForeignCoins{}, false
 	}
 	assetAddr := ethcommon.HexToAddress(asset)
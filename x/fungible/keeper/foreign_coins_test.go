@@ -9,6 +9,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
+	"github.com/zeta-chain/node/pkg/chains"
 	"github.com/zeta-chain/node/pkg/coin"
 	keepertest "github.com/zeta-chain/node/testutil/keeper"
 	"github.com/zeta-chain/node/testutil/sample"
@@ -153,6 +154,59 @@ func TestKeeperGetForeignCoinFromAsset(t *testing.T) {
 		require.True(t, found)
 		require.Equal(t, "foo", fc.Name)
 	})
+
+	t.Run("can get foreign coin with non-EVM asset", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.FungibleKeeper(t)
+
+		const jettonWallet = "0:997d889c815aeac21c47f86ae0e38383efc3c3463067582f6263ad48c5a1485b"
+
+		setForeignCoins(ctx, k,
+			types.ForeignCoins{
+				Zrc20ContractAddress: sample.EthAddress().String(),
+				Asset:                "",
+				ForeignChainId:       2015141,
+				CoinType:             coin.CoinType_Gas,
+				Name:                 "ton",
+			},
+			types.ForeignCoins{
+				Zrc20ContractAddress: sample.EthAddress().String(),
+				Asset:                jettonWallet,
+				ForeignChainId:       2015141,
+				CoinType:             coin.CoinType_ERC20,
+				Name:                 "jetton",
+			},
+		)
+
+		fc, found := k.GetForeignCoinFromAsset(ctx, jettonWallet, 2015141)
+		require.True(t, found)
+		require.Equal(t, "jetton", fc.Name)
+
+		_, found = k.GetForeignCoinFromAsset(ctx, jettonWallet, 1)
+		require.False(t, found)
+
+		_, found = k.GetForeignCoinFromAsset(ctx, "", 2015141)
+		require.False(t, found)
+	})
+
+	t.Run("should not get foreign coin with non-hex asset of non-TON chain", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.FungibleKeeper(t)
+
+		const splMint = "4zMMC9srt5Ri5X14GAgXhaHii3GnPAEERYPJgZJDncDU"
+
+		setForeignCoins(ctx, k,
+			types.ForeignCoins{
+				Zrc20ContractAddress: sample.EthAddress().String(),
+				Asset:                splMint,
+				ForeignChainId:       chains.SolanaDevnet.ChainId,
+				CoinType:             coin.CoinType_ERC20,
+				Name:                 "spl",
+			},
+		)
+
+		// SPL mints are not resolved from the asset, same as before the support of TON jettons
+		_, found := k.GetForeignCoinFromAsset(ctx, splMint, chains.SolanaDevnet.ChainId)
+		require.False(t, found)
+	})
 }
 
 func TestKeeperGetAllForeignCoinMap(t *testing.T) {
@@ -25,6 +25,7 @@ func GetQueryCmd(_ string) *cobra.Command {
 		CmdListBlockHeader(),
 		CmdShowChainState(),
 		CmdListChainState(),
+		CmdShowCanonicalTip(),
 		CmdShowHeaderHeaderSupportedChains(),
 	)
 
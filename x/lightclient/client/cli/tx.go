@@ -22,6 +22,7 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(
 		CmdEnableVerificationFlags(),
 		CmdDisableVerificationFlags(),
+		CmdUpdateHeaderRetention(),
 	)
 
 	return cmd
@@ -0,0 +1,54 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	"github.com/zeta-chain/node/x/lightclient/types"
+)
+
+func CmdUpdateHeaderRetention() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-header-retention [chain-id] [header-retention]",
+		Short: "Update the number of blocks for which the block headers of a chain are kept",
+		Long: `Provide a chain id and the number of blocks below the canonical tip for which the block headers are kept, 0 keeps all block headers.
+
+  				Example:
+                    To keep the block headers of the last 10000 blocks for chain id 1
+					zetacored tx lightclient update-header-retention 1 10000
+				`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			chainID, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			headerRetention, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateHeaderRetention(clientCtx.GetFromAddress().String(), chainID, headerRetention)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
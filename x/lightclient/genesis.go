@@ -19,6 +19,11 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 		k.SetChainState(ctx, elem)
 	}
 
+	// set block header states
+	for _, elem := range genState.BlockHeaderStates {
+		k.SetBlockHeaderState(ctx, elem)
+	}
+
 	k.SetBlockHeaderVerification(ctx, genState.BlockHeaderVerification)
 }
 
@@ -33,5 +38,6 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		BlockHeaders:            k.GetAllBlockHeaders(ctx),
 		ChainStates:             k.GetAllChainStates(ctx),
 		BlockHeaderVerification: blockHeaderVerification,
+		BlockHeaderStates:       k.GetAllBlockHeaderStates(ctx),
 	}
 }
@@ -28,6 +28,10 @@ func TestGenesis(t *testing.T) {
 				sample.ChainState(chains.BitcoinMainnet.ChainId),
 				sample.ChainState(chains.BscMainnet.ChainId),
 			},
+			BlockHeaderStates: []types.BlockHeaderState{
+				sample.BlockHeaderState(sample.Hash().Bytes()),
+				sample.BlockHeaderState(sample.Hash().Bytes()),
+			},
 		}
 
 		// Init and export
@@ -1,9 +1,12 @@
 package keeper
 
 import (
+	"bytes"
 	"fmt"
 
 	cosmoserrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"github.com/btcsuite/btcd/wire"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -30,10 +33,16 @@ func (k Keeper) GetAllBlockHeaders(ctx sdk.Context) (list []proofs.BlockHeader)
 }
 
 // SetBlockHeader set a specific block header in the store from its index
+// The block header is also indexed by height to allow pruning the oldest block headers of a chain
 func (k Keeper) SetBlockHeader(ctx sdk.Context, header proofs.BlockHeader) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BlockHeaderKey))
 	b := k.cdc.MustMarshal(&header)
 	store.Set(header.Hash, b)
+
+	k.getBlockHeaderHeightStore(ctx, header.ChainId).Set(
+		types.BlockHeaderHeightIndex(header.Height, header.Hash),
+		[]byte{1},
+	)
 }
 
 // GetBlockHeader returns a block header from its hash
@@ -49,12 +58,24 @@ func (k Keeper) GetBlockHeader(ctx sdk.Context, hash []byte) (val proofs.BlockHe
 	return val, true
 }
 
-// RemoveBlockHeader removes a block header from the store
+// RemoveBlockHeader removes a block header and its height index from the store
 func (k Keeper) RemoveBlockHeader(ctx sdk.Context, hash []byte) {
+	header, found := k.GetBlockHeader(ctx, hash)
+	if !found {
+		return
+	}
+	k.getBlockHeaderHeightStore(ctx, header.ChainId).Delete(types.BlockHeaderHeightIndex(header.Height, hash))
+
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BlockHeaderKey))
 	store.Delete(hash)
 }
 
+// getBlockHeaderHeightStore returns the store indexing the block headers of a chain by height
+func (k Keeper) getBlockHeaderHeightStore(ctx sdk.Context, chainID int64) prefix.Store {
+	p := append(types.KeyPrefix(types.BlockHeaderHeightKey), types.BlockHeaderHeightKeyPrefix(chainID)...)
+	return prefix.NewStore(ctx.KVStore(k.storeKey), p)
+}
+
 // CheckNewBlockHeader checks if a new block header is valid and can be added to the store
 // It checks that the parent block header exists and that the block height is valid
 // It also checks that the block header does not already exist
@@ -89,17 +110,38 @@ func (k Keeper) CheckNewBlockHeader(
 	// validate block height as it's not part of the header itself
 	chainState, found := k.GetChainState(ctx, chainID)
 	if found && chainState.EarliestHeight > 0 && chainState.EarliestHeight < height {
-		if height != chainState.LatestHeight+1 {
+		// the block header can fork the canonical chain but can't be higher than the next block
+		if height > chainState.LatestHeight+1 {
 			return nil, cosmoserrors.Wrap(types.ErrInvalidHeight, fmt.Sprintf(
-				"invalid block height: wanted %d, got %d",
+				"invalid block height: wanted at most %d, got %d",
 				chainState.LatestHeight+1,
 				height,
 			))
 		}
-		_, found = k.GetBlockHeader(ctx, parentHash)
+		parent, found := k.GetBlockHeader(ctx, parentHash)
 		if !found {
 			return nil, cosmoserrors.Wrap(types.ErrNoParentHash, "parent block header not found")
 		}
+		if height != parent.Height+1 {
+			return nil, cosmoserrors.Wrap(types.ErrInvalidHeight, fmt.Sprintf(
+				"invalid block height: wanted %d, got %d",
+				parent.Height+1,
+				height,
+			))
+		}
+
+		// Ethereum blocks are final after the finality depth, forks below it are rejected
+		if header.GetEthereumHeader() != nil {
+			if err := k.checkEthereumFinality(ctx, chainState, parentHash); err != nil {
+				return nil, err
+			}
+		}
+	} else if found && height < chainState.EarliestHeight {
+		return nil, cosmoserrors.Wrap(types.ErrInvalidHeight, fmt.Sprintf(
+			"invalid block height: %d is lower than earliest height %d",
+			height,
+			chainState.EarliestHeight,
+		))
 	}
 
 	// Check timestamp
@@ -107,10 +149,44 @@ func (k Keeper) CheckNewBlockHeader(
 		return nil, cosmoserrors.Wrap(types.ErrInvalidTimestamp, err.Error())
 	}
 
+	// Bitcoin block headers must follow the difficulty rules of the network relative to the stored parent
+	// the difficulty is computed from the period state of the parent, unknown if the parent is not stored
+	if btcHeader := header.GetBitcoinHeader(); btcHeader != nil {
+		parentState, _ := k.GetBlockHeaderState(ctx, parentHash)
+		err := proofs.ValidateBitcoinHeaderChain(
+			chainID,
+			btcHeader,
+			height,
+			k.bitcoinHeaderGetter(ctx),
+			parentState.BitcoinPeriodState(),
+		)
+		if err != nil {
+			return nil, cosmoserrors.Wrap(types.ErrInvalidProofOfWork, err.Error())
+		}
+	}
+
 	return parentHash, nil
 }
 
+// bitcoinHeaderGetter returns a getter of the Bitcoin block headers stored in the lightclient
+func (k Keeper) bitcoinHeaderGetter(ctx sdk.Context) proofs.BitcoinHeaderGetter {
+	return func(hash []byte) (*wire.BlockHeader, bool) {
+		blockHeader, found := k.GetBlockHeader(ctx, hash)
+		if !found || blockHeader.Header.GetBitcoinHeader() == nil {
+			return nil, false
+		}
+		var header wire.BlockHeader
+		if err := header.Deserialize(bytes.NewReader(blockHeader.Header.GetBitcoinHeader())); err != nil {
+			return nil, false
+		}
+		return &header, true
+	}
+}
+
 // AddBlockHeader adds a new block header to the store and updates the chain state
+// The canonical chain is the branch with the most cumulative work, if the new block header makes its branch
+// heavier than the canonical chain, the chain is reorganized to the new branch
+// Otherwise, the block header is stored as orphaned and can't be used to verify proofs
 func (k Keeper) AddBlockHeader(
 	ctx sdk.Context,
 	chainID int64,
@@ -119,9 +195,44 @@ func (k Keeper) AddBlockHeader(
 	header proofs.HeaderData,
 	parentHash []byte,
 ) {
+	// the cumulative work of the branch ending with the block header
+	// the parent state is not found for the first block header of the chain
+	cumulativeWork := sdkmath.ZeroUint()
+	parentState, found := k.GetBlockHeaderState(ctx, parentHash)
+	if found {
+		cumulativeWork = parentState.CumulativeWork
+	}
+	// NOTE: the header is decoded in BasicValidation in msg
+	if work, err := header.Work(); err == nil {
+		cumulativeWork = cumulativeWork.Add(sdkmath.NewUintFromBigInt(work))
+	}
+	blockHeaderState := types.BlockHeaderState{
+		BlockHash:      blockHash,
+		ChainId:        chainID,
+		Height:         height,
+		CumulativeWork: cumulativeWork,
+	}
+
+	// Bitcoin block headers keep the state of their difficulty period to validate the difficulty of their children
+	// NOTE: the header is decoded with the rules of the chain in CheckNewBlockHeader, the error is not expected
+	if btcHeader := header.GetBitcoinHeader(); btcHeader != nil {
+		period, err := proofs.NextBitcoinPeriodState(chainID, btcHeader, height, parentState.BitcoinPeriodState())
+		if err != nil {
+			k.Logger(ctx).Error(
+				"unable to compute the Bitcoin difficulty period state",
+				"chain", chainID,
+				"height", height,
+				"error", err,
+			)
+		} else {
+			blockHeaderState.SetBitcoinPeriodState(period)
+		}
+	}
+
 	// update chain state
 	chainState, found := k.GetChainState(ctx, chainID)
-	if !found {
+	switch {
+	case !found:
 		// create a new chain state if it does not exist
 		chainState = types.ChainState{
 			ChainId:         chainID,
@@ -129,18 +240,20 @@ func (k Keeper) AddBlockHeader(
 			EarliestHeight:  height,
 			LatestBlockHash: blockHash,
 		}
-	} else {
-		// update the chain state with the latest block header
-		// TODO: these checks would need to be more sophisticated for production
-		// We should investigate and implement the correct assumptions for adding new block header
-		// https://github.com/zeta-chain/node/issues/1997
-		if height > chainState.LatestHeight {
-			chainState.LatestHeight = height
-			chainState.LatestBlockHash = blockHash
-		}
-		if chainState.EarliestHeight == 0 {
-			chainState.EarliestHeight = height
-		}
+	case bytes.Equal(parentHash, chainState.LatestBlockHash):
+		// the block header extends the canonical chain
+		chainState.LatestHeight = height
+		chainState.LatestBlockHash = blockHash
+	case cumulativeWork.GT(k.getCumulativeWork(ctx, chainState.LatestBlockHash)):
+		// the branch of the block header has more work than the canonical chain
+		k.reorganize(ctx, chainState.LatestBlockHash, parentHash)
+		chainState.LatestHeight = height
+		chainState.LatestBlockHash = blockHash
+	default:
+		blockHeaderState.Orphaned = true
+	}
+	if chainState.EarliestHeight == 0 {
+		chainState.EarliestHeight = height
 	}
 	k.SetChainState(ctx, chainState)
 
@@ -153,4 +266,91 @@ func (k Keeper) AddBlockHeader(
 		ChainId:    chainID,
 	}
 	k.SetBlockHeader(ctx, blockHeader)
+	k.SetBlockHeaderState(ctx, blockHeaderState)
+}
+
+// getCumulativeWork returns the cumulative work of the branch ending with the given block header
+// it returns zero if the block header state is not found
+func (k Keeper) getCumulativeWork(ctx sdk.Context, blockHash []byte) sdkmath.Uint {
+	state, found := k.GetBlockHeaderState(ctx, blockHash)
+	if !found {
+		return sdkmath.ZeroUint()
+	}
+	return state.CumulativeWork
+}
+
+// reorganize switches the canonical chain from the branch ending with oldTip to the branch ending with newTip
+// the block headers of the new branch are marked canonical down to the fork point
+// and the block headers of the old branch are marked orphaned down to the fork point
+func (k Keeper) reorganize(ctx sdk.Context, oldTip, newTip []byte) {
+	forkPoint := newTip
+	for {
+		state, found := k.GetBlockHeaderState(ctx, forkPoint)
+		if !found || !state.Orphaned {
+			break
+		}
+		state.Orphaned = false
+		k.SetBlockHeaderState(ctx, state)
+
+		header, found := k.GetBlockHeader(ctx, forkPoint)
+		if !found {
+			break
+		}
+		forkPoint = header.ParentHash
+	}
+
+	hash := oldTip
+	for !bytes.Equal(hash, forkPoint) {
+		state, found := k.GetBlockHeaderState(ctx, hash)
+		if !found || state.Orphaned {
+			break
+		}
+		state.Orphaned = true
+		k.SetBlockHeaderState(ctx, state)
+
+		header, found := k.GetBlockHeader(ctx, hash)
+		if !found {
+			break
+		}
+		hash = header.ParentHash
+	}
+}
+
+// checkEthereumFinality checks that a new Ethereum block header with the given parent
+// does not fork the canonical chain below the finality depth
+func (k Keeper) checkEthereumFinality(ctx sdk.Context, chainState types.ChainState, parentHash []byte) error {
+	finalizedHeight := chainState.LatestHeight - types.EthereumFinalityDepth
+
+	// walk back the branch of the parent to the fork point with the canonical chain
+	hash := parentHash
+	for i := 0; i <= types.EthereumFinalityDepth; i++ {
+		state, found := k.GetBlockHeaderState(ctx, hash)
+		if !found {
+			// fork-choice state is not tracked for the block header
+			return nil
+		}
+		if !state.Orphaned {
+			if state.Height < finalizedHeight {
+				return cosmoserrors.Wrapf(
+					types.ErrReorgTooDeep,
+					"fork point height %d is lower than finalized height %d",
+					state.Height,
+					finalizedHeight,
+				)
+			}
+			return nil
+		}
+
+		header, found := k.GetBlockHeader(ctx, hash)
+		if !found {
+			return nil
+		}
+		hash = header.ParentHash
+	}
+
+	return cosmoserrors.Wrapf(
+		types.ErrReorgTooDeep,
+		"no fork point found within %d blocks",
+		types.EthereumFinalityDepth,
+	)
 }
@@ -0,0 +1,79 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/zeta-chain/node/x/lightclient/types"
+)
+
+// PruneBlockHeaders removes the block headers older than the header retention of each chain
+// The block headers are removed along with their state and height index, and the earliest height of the chain is updated
+// At most MaxPrunedBlockHeaders block headers are removed per call, the remaining ones are removed in the next calls
+func (k Keeper) PruneBlockHeaders(ctx sdk.Context) {
+	bhv, found := k.GetBlockHeaderVerification(ctx)
+	if !found {
+		return
+	}
+
+	remaining := types.MaxPrunedBlockHeaders
+	for _, chain := range bhv.HeaderSupportedChains {
+		if remaining <= 0 {
+			return
+		}
+		if chain.HeaderRetention <= 0 {
+			continue
+		}
+
+		chainState, found := k.GetChainState(ctx, chain.ChainId)
+		if !found {
+			continue
+		}
+
+		// block headers below this height are pruned
+		pruneHeight := chainState.LatestHeight - chain.HeaderRetention
+		if pruneHeight <= chainState.EarliestHeight {
+			continue
+		}
+
+		earliestHeight, pruned := k.pruneChainBlockHeaders(ctx, chain.ChainId, pruneHeight, remaining)
+		remaining -= pruned
+
+		if earliestHeight > chainState.EarliestHeight {
+			chainState.EarliestHeight = earliestHeight
+			k.SetChainState(ctx, chainState)
+		}
+	}
+}
+
+// pruneChainBlockHeaders removes at most limit block headers of a chain below the prune height
+// it returns the height of the earliest block header kept and the number of block headers removed
+func (k Keeper) pruneChainBlockHeaders(
+	ctx sdk.Context,
+	chainID int64,
+	pruneHeight int64,
+	limit int,
+) (int64, int) {
+	store := k.getBlockHeaderHeightStore(ctx, chainID)
+
+	// collect the index keys to remove before deleting them from the iterated store
+	var keys [][]byte
+	earliestHeight := pruneHeight
+	iterator := store.Iterator(nil, sdk.Uint64ToBigEndian(uint64(pruneHeight)))
+	for ; iterator.Valid(); iterator.Next() {
+		if len(keys) == limit {
+			earliestHeight, _ = types.ParseBlockHeaderHeightIndex(iterator.Key())
+			break
+		}
+		keys = append(keys, append([]byte{}, iterator.Key()...))
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		_, hash := types.ParseBlockHeaderHeightIndex(key)
+		k.RemoveBlockHeader(ctx, hash)
+		k.RemoveBlockHeaderState(ctx, hash)
+		store.Delete(key)
+	}
+
+	return earliestHeight, len(keys)
+}
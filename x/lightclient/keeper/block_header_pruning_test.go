@@ -0,0 +1,130 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/node/pkg/chains"
+	"github.com/zeta-chain/node/pkg/proofs"
+	keepertest "github.com/zeta-chain/node/testutil/keeper"
+	"github.com/zeta-chain/node/testutil/sample"
+	"github.com/zeta-chain/node/x/lightclient/types"
+)
+
+func TestKeeper_PruneBlockHeaders(t *testing.T) {
+	t.Run("should not prune if verification flags not found", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.LightclientKeeper(t)
+
+		headers := ethBlockHeaders(t, sample.Hash().Bytes(), 100, 10, 0)
+		addBlockHeaders(ctx, k, headers...)
+
+		k.PruneBlockHeaders(ctx)
+		require.Len(t, k.GetAllBlockHeaders(ctx), 10)
+	})
+
+	t.Run("should not prune if header retention is 0", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.LightclientKeeper(t)
+
+		k.SetBlockHeaderVerification(ctx, types.BlockHeaderVerification{
+			HeaderSupportedChains: []types.HeaderSupportedChain{
+				{
+					ChainId: chains.Sepolia.ChainId,
+					Enabled: true,
+				},
+			},
+		})
+		headers := ethBlockHeaders(t, sample.Hash().Bytes(), 100, 10, 0)
+		addBlockHeaders(ctx, k, headers...)
+
+		k.PruneBlockHeaders(ctx)
+		require.Len(t, k.GetAllBlockHeaders(ctx), 10)
+
+		chainState, found := k.GetChainState(ctx, chains.Sepolia.ChainId)
+		require.True(t, found)
+		require.EqualValues(t, 100, chainState.EarliestHeight)
+	})
+
+	t.Run("should prune block headers below header retention", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.LightclientKeeper(t)
+
+		k.SetBlockHeaderVerification(ctx, types.BlockHeaderVerification{
+			HeaderSupportedChains: []types.HeaderSupportedChain{
+				{
+					ChainId:         chains.Sepolia.ChainId,
+					Enabled:         true,
+					HeaderRetention: 5,
+				},
+			},
+		})
+		headers := ethBlockHeaders(t, sample.Hash().Bytes(), 100, 10, 0)
+		fork := ethBlockHeader(t, headers[0].Hash, 101, 1)
+		addBlockHeaders(ctx, k, headers...)
+		addBlockHeaders(ctx, k, fork)
+
+		// block headers of other chains are not pruned
+		otherChain := btcBlockHeader(t, sample.Hash().Bytes(), 50, 0x207fffff)
+		addBlockHeaders(ctx, k, otherChain)
+
+		k.PruneBlockHeaders(ctx)
+
+		// the block headers below the height 104 are pruned, including the orphaned ones
+		pruned := []proofs.BlockHeader{headers[0], headers[1], headers[2], headers[3], fork}
+		for _, header := range pruned {
+			_, found := k.GetBlockHeader(ctx, header.Hash)
+			require.False(t, found)
+			_, found = k.GetBlockHeaderState(ctx, header.Hash)
+			require.False(t, found)
+		}
+		kept := append([]proofs.BlockHeader{otherChain}, headers[4:]...)
+		for _, header := range kept {
+			_, found := k.GetBlockHeader(ctx, header.Hash)
+			require.True(t, found)
+			_, found = k.GetBlockHeaderState(ctx, header.Hash)
+			require.True(t, found)
+		}
+
+		chainState, found := k.GetChainState(ctx, chains.Sepolia.ChainId)
+		require.True(t, found)
+		require.EqualValues(t, 104, chainState.EarliestHeight)
+		require.EqualValues(t, 109, chainState.LatestHeight)
+
+		// the chain can still be extended
+		next := ethBlockHeader(t, headers[9].Hash, 110, 0)
+		_, err := k.CheckNewBlockHeader(ctx, next.ChainId, next.Hash, next.Height, next.Header)
+		require.NoError(t, err)
+	})
+
+	t.Run("should prune at most the max number of block headers per call", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.LightclientKeeper(t)
+
+		k.SetBlockHeaderVerification(ctx, types.BlockHeaderVerification{
+			HeaderSupportedChains: []types.HeaderSupportedChain{
+				{
+					ChainId:         chains.Sepolia.ChainId,
+					Enabled:         true,
+					HeaderRetention: 10,
+				},
+			},
+		})
+		headers := ethBlockHeaders(t, sample.Hash().Bytes(), 100, 150, 0)
+		addBlockHeaders(ctx, k, headers...)
+
+		// first call prunes the max number of block headers
+		k.PruneBlockHeaders(ctx)
+		require.Len(t, k.GetAllBlockHeaders(ctx), 150-types.MaxPrunedBlockHeaders)
+
+		chainState, found := k.GetChainState(ctx, chains.Sepolia.ChainId)
+		require.True(t, found)
+		require.EqualValues(t, 100+types.MaxPrunedBlockHeaders, chainState.EarliestHeight)
+
+		// second call prunes the remaining block headers
+		k.PruneBlockHeaders(ctx)
+		require.Len(t, k.GetAllBlockHeaders(ctx), 11)
+
+		chainState, found = k.GetChainState(ctx, chains.Sepolia.ChainId)
+		require.True(t, found)
+		require.EqualValues(t, 239, chainState.EarliestHeight)
+		require.EqualValues(t, 249, chainState.LatestHeight)
+	})
+}
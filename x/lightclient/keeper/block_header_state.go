@@ -0,0 +1,51 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/zeta-chain/node/x/lightclient/types"
+)
+
+// GetAllBlockHeaderStates returns all block header states
+func (k Keeper) GetAllBlockHeaderStates(ctx sdk.Context) (list []types.BlockHeaderState) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BlockHeaderStateKey))
+
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.BlockHeaderState
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return list
+}
+
+// SetBlockHeaderState set a specific block header state in the store from its block hash
+func (k Keeper) SetBlockHeaderState(ctx sdk.Context, state types.BlockHeaderState) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BlockHeaderStateKey))
+	b := k.cdc.MustMarshal(&state)
+	store.Set(state.BlockHash, b)
+}
+
+// GetBlockHeaderState returns a block header state from its block hash
+func (k Keeper) GetBlockHeaderState(ctx sdk.Context, hash []byte) (val types.BlockHeaderState, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BlockHeaderStateKey))
+
+	b := store.Get(hash)
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveBlockHeaderState removes a block header state from the store
+func (k Keeper) RemoveBlockHeaderState(ctx sdk.Context, hash []byte) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BlockHeaderStateKey))
+	store.Delete(hash)
+}
@@ -1,10 +1,17 @@
 package keeper_test
 
 import (
+	"bytes"
 	"encoding/json"
+	"math/big"
 	"os"
 	"testing"
+	"time"
 
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ethcommon "github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/stretchr/testify/require"
@@ -13,6 +20,7 @@ import (
 	"github.com/zeta-chain/node/pkg/proofs"
 	keepertest "github.com/zeta-chain/node/testutil/keeper"
 	"github.com/zeta-chain/node/testutil/sample"
+	"github.com/zeta-chain/node/x/lightclient/keeper"
 	"github.com/zeta-chain/node/x/lightclient/types"
 )
 
@@ -88,6 +96,77 @@ func sepoliaBlockHeaders(t *testing.T) (proofs.BlockHeader, proofs.BlockHeader,
 		}
 }
 
+// ethBlockHeader returns an Ethereum block header with the given parent
+// the extra data allows creating different block headers at the same height to simulate forks
+func ethBlockHeader(t *testing.T, parentHash []byte, height int64, extra byte) proofs.BlockHeader {
+	header := &ethtypes.Header{
+		ParentHash: ethcommon.BytesToHash(parentHash),
+		Number:     big.NewInt(height),
+		Difficulty: big.NewInt(0),
+		Extra:      []byte{extra},
+	}
+	headerRLP, err := rlp.EncodeToBytes(header)
+	require.NoError(t, err)
+
+	return proofs.BlockHeader{
+		Height:     height,
+		Hash:       header.Hash().Bytes(),
+		ParentHash: parentHash,
+		ChainId:    chains.Sepolia.ChainId,
+		Header:     proofs.NewEthereumHeader(headerRLP),
+	}
+}
+
+// ethBlockHeaders returns a chain of count Ethereum block headers starting from the given parent
+func ethBlockHeaders(t *testing.T, parentHash []byte, height int64, count int, extra byte) []proofs.BlockHeader {
+	headers := make([]proofs.BlockHeader, 0, count)
+	for i := 0; i < count; i++ {
+		header := ethBlockHeader(t, parentHash, height+int64(i), extra)
+		headers = append(headers, header)
+		parentHash = header.Hash
+	}
+	return headers
+}
+
+// btcBlockHeader returns a Bitcoin block header with the given parent and difficulty target
+func btcBlockHeader(t *testing.T, parentHash []byte, height int64, bits uint32) proofs.BlockHeader {
+	prevBlock, err := chainhash.NewHash(parentHash)
+	require.NoError(t, err)
+	header := wire.BlockHeader{
+		Version:   1,
+		PrevBlock: *prevBlock,
+		Timestamp: time.Unix(1700000000+height, 0),
+		Bits:      bits,
+	}
+	var buf bytes.Buffer
+	require.NoError(t, header.Serialize(&buf))
+	hash := header.BlockHash()
+
+	return proofs.BlockHeader{
+		Height:     height,
+		Hash:       hash[:],
+		ParentHash: parentHash,
+		ChainId:    chains.BitcoinMainnet.ChainId,
+		Header:     proofs.NewBitcoinHeader(buf.Bytes()),
+	}
+}
+
+// addBlockHeaders adds the block headers to the store in order
+func addBlockHeaders(ctx sdk.Context, k *keeper.Keeper, headers ...proofs.BlockHeader) {
+	for _, header := range headers {
+		k.AddBlockHeader(ctx, header.ChainId, header.Height, header.Hash, header.Header, header.ParentHash)
+	}
+}
+
+// requireOrphaned checks the orphaned flag of the block header states
+func requireOrphaned(t *testing.T, ctx sdk.Context, k *keeper.Keeper, orphaned bool, headers ...proofs.BlockHeader) {
+	for _, header := range headers {
+		state, found := k.GetBlockHeaderState(ctx, header.Hash)
+		require.True(t, found)
+		require.Equal(t, orphaned, state.Orphaned, "block header at height %d", header.Height)
+	}
+}
+
 // TestKeeper_GetBlockHeader tests get, set, and remove block header
 func TestKeeper_GetBlockHeader(t *testing.T) {
 	k, ctx, _, _ := keepertest.LightclientKeeper(t)
@@ -251,6 +330,179 @@ func TestKeeper_CheckNewBlockHeader(t *testing.T) {
 		_, err := k.CheckNewBlockHeader(ctx, bh.ChainId, bh.Hash, bh.Height, bh.Header)
 		require.ErrorIs(t, err, types.ErrNoParentHash)
 	})
+
+	t.Run("fail if height lower than earliest height", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.LightclientKeeper(t)
+
+		k.SetBlockHeaderVerification(ctx, types.BlockHeaderVerification{
+			HeaderSupportedChains: []types.HeaderSupportedChain{
+				{
+					ChainId: chains.Sepolia.ChainId,
+					Enabled: true,
+				},
+			},
+		})
+		bh := ethBlockHeader(t, sample.Hash().Bytes(), 99, 0)
+
+		k.SetChainState(ctx, types.ChainState{
+			ChainId:         bh.ChainId,
+			LatestHeight:    200,
+			EarliestHeight:  100,
+			LatestBlockHash: sample.Hash().Bytes(),
+		})
+
+		_, err := k.CheckNewBlockHeader(ctx, bh.ChainId, bh.Hash, bh.Height, bh.Header)
+		require.ErrorIs(t, err, types.ErrInvalidHeight)
+	})
+
+	t.Run("fail if height doesn't follow parent height", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.LightclientKeeper(t)
+
+		k.SetBlockHeaderVerification(ctx, types.BlockHeaderVerification{
+			HeaderSupportedChains: []types.HeaderSupportedChain{
+				{
+					ChainId: chains.Sepolia.ChainId,
+					Enabled: true,
+				},
+			},
+		})
+		headers := ethBlockHeaders(t, sample.Hash().Bytes(), 100, 3, 0)
+		addBlockHeaders(ctx, k, headers...)
+
+		// fork from the first block header with the height of the third block header
+		bh := ethBlockHeader(t, headers[0].Hash, 102, 1)
+
+		_, err := k.CheckNewBlockHeader(ctx, bh.ChainId, bh.Hash, bh.Height, bh.Header)
+		require.ErrorIs(t, err, types.ErrInvalidHeight)
+	})
+
+	t.Run("should succeed if block header forks the chain above the finality depth", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.LightclientKeeper(t)
+
+		k.SetBlockHeaderVerification(ctx, types.BlockHeaderVerification{
+			HeaderSupportedChains: []types.HeaderSupportedChain{
+				{
+					ChainId: chains.Sepolia.ChainId,
+					Enabled: true,
+				},
+			},
+		})
+		headers := ethBlockHeaders(t, sample.Hash().Bytes(), 100, types.EthereumFinalityDepth+2, 0)
+		addBlockHeaders(ctx, k, headers...)
+
+		// fork from the latest finalized block header
+		parent := headers[1]
+		bh := ethBlockHeader(t, parent.Hash, parent.Height+1, 1)
+
+		parentHash, err := k.CheckNewBlockHeader(ctx, bh.ChainId, bh.Hash, bh.Height, bh.Header)
+		require.NoError(t, err)
+		require.Equal(t, parent.Hash, parentHash)
+	})
+
+	t.Run("fail if Ethereum block header forks the chain below the finality depth", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.LightclientKeeper(t)
+
+		k.SetBlockHeaderVerification(ctx, types.BlockHeaderVerification{
+			HeaderSupportedChains: []types.HeaderSupportedChain{
+				{
+					ChainId: chains.Sepolia.ChainId,
+					Enabled: true,
+				},
+			},
+		})
+		headers := ethBlockHeaders(t, sample.Hash().Bytes(), 100, types.EthereumFinalityDepth+2, 0)
+		addBlockHeaders(ctx, k, headers...)
+
+		// fork from a block header below the latest finalized block header
+		parent := headers[0]
+		bh := ethBlockHeader(t, parent.Hash, parent.Height+1, 1)
+
+		_, err := k.CheckNewBlockHeader(ctx, bh.ChainId, bh.Hash, bh.Height, bh.Header)
+		require.ErrorIs(t, err, types.ErrReorgTooDeep)
+	})
+
+	t.Run("fail if Ethereum block header extends an orphaned branch forking below the finality depth",
+		func(t *testing.T) {
+			k, ctx, _, _ := keepertest.LightclientKeeper(t)
+
+			k.SetBlockHeaderVerification(ctx, types.BlockHeaderVerification{
+				HeaderSupportedChains: []types.HeaderSupportedChain{
+					{
+						ChainId: chains.Sepolia.ChainId,
+						Enabled: true,
+					},
+				},
+			})
+			headers := ethBlockHeaders(t, sample.Hash().Bytes(), 100, 2, 0)
+			fork := ethBlockHeader(t, headers[0].Hash, 101, 1)
+			addBlockHeaders(ctx, k, headers...)
+			addBlockHeaders(ctx, k, fork)
+			addBlockHeaders(ctx, k, ethBlockHeaders(t, headers[1].Hash, 102, types.EthereumFinalityDepth, 0)...)
+			requireOrphaned(t, ctx, k, true, fork)
+
+			bh := ethBlockHeader(t, fork.Hash, fork.Height+1, 1)
+
+			_, err := k.CheckNewBlockHeader(ctx, bh.ChainId, bh.Hash, bh.Height, bh.Header)
+			require.ErrorIs(t, err, types.ErrReorgTooDeep)
+		})
+
+	t.Run("should succeed if Bitcoin block header follows the difficulty of its parent", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.LightclientKeeper(t)
+
+		k.SetBlockHeaderVerification(ctx, types.BlockHeaderVerification{
+			HeaderSupportedChains: []types.HeaderSupportedChain{
+				{
+					ChainId: chains.BitcoinMainnet.ChainId,
+					Enabled: true,
+				},
+			},
+		})
+		parent := btcBlockHeader(t, sample.Hash().Bytes(), 800_000, 0x1703a30c)
+		addBlockHeaders(ctx, k, parent)
+
+		bh := btcBlockHeader(t, parent.Hash, parent.Height+1, 0x1703a30c)
+
+		parentHash, err := k.CheckNewBlockHeader(ctx, bh.ChainId, bh.Hash, bh.Height, bh.Header)
+		require.NoError(t, err)
+		require.Equal(t, parent.Hash, parentHash)
+	})
+
+	t.Run("fail if Bitcoin block header doesn't follow the difficulty of its parent", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.LightclientKeeper(t)
+
+		k.SetBlockHeaderVerification(ctx, types.BlockHeaderVerification{
+			HeaderSupportedChains: []types.HeaderSupportedChain{
+				{
+					ChainId: chains.BitcoinMainnet.ChainId,
+					Enabled: true,
+				},
+			},
+		})
+		parent := btcBlockHeader(t, sample.Hash().Bytes(), 800_000, 0x1703a30c)
+		addBlockHeaders(ctx, k, parent)
+
+		bh := btcBlockHeader(t, parent.Hash, parent.Height+1, 0x1803a30c)
+
+		_, err := k.CheckNewBlockHeader(ctx, bh.ChainId, bh.Hash, bh.Height, bh.Header)
+		require.ErrorIs(t, err, types.ErrInvalidProofOfWork)
+	})
+
+	t.Run("fail if Bitcoin block header has less than the minimum work", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.LightclientKeeper(t)
+
+		k.SetBlockHeaderVerification(ctx, types.BlockHeaderVerification{
+			HeaderSupportedChains: []types.HeaderSupportedChain{
+				{
+					ChainId: chains.BitcoinMainnet.ChainId,
+					Enabled: true,
+				},
+			},
+		})
+		bh := btcBlockHeader(t, sample.Hash().Bytes(), 800_000, 0x207fffff)
+
+		_, err := k.CheckNewBlockHeader(ctx, bh.ChainId, bh.Hash, bh.Height, bh.Header)
+		require.ErrorIs(t, err, types.ErrInvalidProofOfWork)
+	})
 }
 
 func TestKeeper_AddBlockHeader(t *testing.T) {
@@ -368,4 +620,107 @@ func TestKeeper_AddBlockHeader(t *testing.T) {
 			require.EqualValues(t, bh.ChainId, chainState.ChainId)
 		},
 	)
+
+	t.Run("should store the difficulty period state of Bitcoin block headers", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.LightclientKeeper(t)
+
+		// the state of the period is unknown if its first block is not stored
+		unknown := btcBlockHeader(t, sample.Hash().Bytes(), 2016*400-1, 0x1703a30c)
+		first := btcBlockHeader(t, unknown.Hash, 2016*400, 0x1703a30c)
+		second := btcBlockHeader(t, first.Hash, 2016*400+1, 0x1703a30c)
+		addBlockHeaders(ctx, k, unknown, first, second)
+
+		state, found := k.GetBlockHeaderState(ctx, unknown.Hash)
+		require.True(t, found)
+		require.False(t, state.BitcoinPeriodState().IsKnown())
+
+		for _, header := range []proofs.BlockHeader{first, second} {
+			state, found = k.GetBlockHeaderState(ctx, header.Hash)
+			require.True(t, found)
+			require.EqualValues(t, 1700000000+2016*400, state.PeriodStartTimestamp)
+			require.EqualValues(t, 0x1703a30c, state.PeriodStartBits)
+			require.EqualValues(t, 0x1703a30c, state.LastBits)
+		}
+	})
+}
+
+func TestKeeper_AddBlockHeader_ForkChoice(t *testing.T) {
+	t.Run("should store a block header forking the canonical chain as orphaned", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.LightclientKeeper(t)
+
+		headers := ethBlockHeaders(t, sample.Hash().Bytes(), 100, 2, 0)
+		fork := ethBlockHeader(t, headers[0].Hash, 101, 1)
+		addBlockHeaders(ctx, k, headers...)
+		addBlockHeaders(ctx, k, fork)
+
+		requireOrphaned(t, ctx, k, false, headers...)
+		requireOrphaned(t, ctx, k, true, fork)
+
+		chainState, found := k.GetChainState(ctx, chains.Sepolia.ChainId)
+		require.True(t, found)
+		require.EqualValues(t, 101, chainState.LatestHeight)
+		require.Equal(t, headers[1].Hash, chainState.LatestBlockHash)
+
+		state, found := k.GetBlockHeaderState(ctx, fork.Hash)
+		require.True(t, found)
+		require.EqualValues(t, 2, state.CumulativeWork.Uint64())
+	})
+
+	t.Run("should reorganize the chain to a longer Ethereum fork", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.LightclientKeeper(t)
+
+		headers := ethBlockHeaders(t, sample.Hash().Bytes(), 100, 3, 0)
+		fork := ethBlockHeaders(t, headers[0].Hash, 101, 3, 1)
+		addBlockHeaders(ctx, k, headers...)
+		addBlockHeaders(ctx, k, fork[:2]...)
+
+		// the fork has the same length as the canonical chain
+		requireOrphaned(t, ctx, k, false, headers...)
+		requireOrphaned(t, ctx, k, true, fork[:2]...)
+
+		// the fork becomes longer than the canonical chain
+		addBlockHeaders(ctx, k, fork[2])
+		requireOrphaned(t, ctx, k, false, headers[0])
+		requireOrphaned(t, ctx, k, true, headers[1:]...)
+		requireOrphaned(t, ctx, k, false, fork...)
+
+		chainState, found := k.GetChainState(ctx, chains.Sepolia.ChainId)
+		require.True(t, found)
+		require.EqualValues(t, 103, chainState.LatestHeight)
+		require.EqualValues(t, 100, chainState.EarliestHeight)
+		require.Equal(t, fork[2].Hash, chainState.LatestBlockHash)
+
+		// the former canonical chain can be reorganized back
+		addBlockHeaders(ctx, k, ethBlockHeaders(t, headers[2].Hash, 103, 2, 0)...)
+		requireOrphaned(t, ctx, k, false, headers...)
+		requireOrphaned(t, ctx, k, true, fork...)
+	})
+
+	t.Run("should reorganize the chain to the Bitcoin fork with the most work", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.LightclientKeeper(t)
+
+		// the fork is shorter but has a higher difficulty
+		genesis := btcBlockHeader(t, sample.Hash().Bytes(), 100, 0x207fffff)
+		a1 := btcBlockHeader(t, genesis.Hash, 101, 0x207fffff)
+		a2 := btcBlockHeader(t, a1.Hash, 102, 0x207fffff)
+		b1 := btcBlockHeader(t, genesis.Hash, 101, 0x1d00ffff)
+		addBlockHeaders(ctx, k, genesis, a1, a2, b1)
+
+		requireOrphaned(t, ctx, k, false, genesis, b1)
+		requireOrphaned(t, ctx, k, true, a1, a2)
+
+		chainState, found := k.GetChainState(ctx, chains.BitcoinMainnet.ChainId)
+		require.True(t, found)
+		require.EqualValues(t, 101, chainState.LatestHeight)
+		require.Equal(t, b1.Hash, chainState.LatestBlockHash)
+
+		// a longer fork with less work doesn't reorganize the chain
+		a3 := btcBlockHeader(t, a2.Hash, 103, 0x207fffff)
+		addBlockHeaders(ctx, k, a3)
+		requireOrphaned(t, ctx, k, true, a1, a2, a3)
+
+		chainState, found = k.GetChainState(ctx, chains.BitcoinMainnet.ChainId)
+		require.True(t, found)
+		require.Equal(t, b1.Hash, chainState.LatestBlockHash)
+	})
 }
@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/zeta-chain/node/x/lightclient/types"
+)
+
+// CanonicalTip queries the latest block header of the canonical chain of a chain along with its fork-choice state
+func (k Keeper) CanonicalTip(
+	c context.Context,
+	req *types.QueryCanonicalTipRequest,
+) (*types.QueryCanonicalTipResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	chainState, found := k.GetChainState(ctx, req.ChainId)
+	if !found {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("not found: chain id %d", req.ChainId))
+	}
+
+	header, found := k.GetBlockHeader(ctx, chainState.LatestBlockHash)
+	if !found {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("not found: block header %x", chainState.LatestBlockHash))
+	}
+
+	state, found := k.GetBlockHeaderState(ctx, chainState.LatestBlockHash)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			fmt.Sprintf("not found: block header state %x", chainState.LatestBlockHash),
+		)
+	}
+
+	return &types.QueryCanonicalTipResponse{
+		BlockHeader:      header,
+		BlockHeaderState: state,
+	}, nil
+}
@@ -0,0 +1,68 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/node/pkg/chains"
+	keepertest "github.com/zeta-chain/node/testutil/keeper"
+	"github.com/zeta-chain/node/testutil/sample"
+	"github.com/zeta-chain/node/x/lightclient/types"
+)
+
+func TestKeeper_CanonicalTip(t *testing.T) {
+	t.Run("should error if req is nil", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.LightclientKeeper(t)
+		wctx := sdk.WrapSDKContext(ctx)
+
+		res, err := k.CanonicalTip(wctx, nil)
+		require.Nil(t, res)
+		require.Error(t, err)
+	})
+
+	t.Run("should error if chain state not found", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.LightclientKeeper(t)
+		wctx := sdk.WrapSDKContext(ctx)
+
+		res, err := k.CanonicalTip(wctx, &types.QueryCanonicalTipRequest{
+			ChainId: chains.Sepolia.ChainId,
+		})
+		require.Nil(t, res)
+		require.ErrorContains(t, err, "not found: chain id")
+	})
+
+	t.Run("should error if block header not found", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.LightclientKeeper(t)
+		wctx := sdk.WrapSDKContext(ctx)
+
+		k.SetChainState(ctx, sample.ChainState(chains.Sepolia.ChainId))
+
+		res, err := k.CanonicalTip(wctx, &types.QueryCanonicalTipRequest{
+			ChainId: chains.Sepolia.ChainId,
+		})
+		require.Nil(t, res)
+		require.ErrorContains(t, err, "not found: block header")
+	})
+
+	t.Run("should return the canonical tip after a reorg", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.LightclientKeeper(t)
+		wctx := sdk.WrapSDKContext(ctx)
+
+		headers := ethBlockHeaders(t, sample.Hash().Bytes(), 100, 2, 0)
+		fork := ethBlockHeaders(t, headers[0].Hash, 101, 2, 1)
+		addBlockHeaders(ctx, k, headers...)
+		addBlockHeaders(ctx, k, fork...)
+
+		res, err := k.CanonicalTip(wctx, &types.QueryCanonicalTipRequest{
+			ChainId: chains.Sepolia.ChainId,
+		})
+		require.NoError(t, err)
+		require.Equal(t, fork[1].Hash, res.BlockHeader.Hash)
+		require.Equal(t, fork[1].Hash, res.BlockHeaderState.BlockHash)
+		require.EqualValues(t, 102, res.BlockHeaderState.Height)
+		require.EqualValues(t, 3, res.BlockHeaderState.CumulativeWork.Uint64())
+		require.False(t, res.BlockHeaderState.Orphaned)
+	})
+}
@@ -36,6 +36,9 @@ func (k Keeper) Prove(c context.Context, req *types.QueryProveRequest) (*types.Q
 	if !found {
 		return nil, status.Error(codes.NotFound, "block header not found")
 	}
+	if state, found := k.GetBlockHeaderState(ctx, blockHash); found && state.Orphaned {
+		return nil, status.Error(codes.FailedPrecondition, "block header is orphaned")
+	}
 
 	proven := false
 
@@ -56,6 +56,27 @@ func TestKeeper_Prove(t *testing.T) {
 		require.ErrorContains(t, err, "block header not found")
 	})
 
+	t.Run("should error if block header is orphaned", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.LightclientKeeper(t)
+		wctx := sdk.WrapSDKContext(ctx)
+
+		proof, blockHeader, blockHash, txIndex, chainID, hash := sample.Proof(t)
+
+		k.SetBlockHeader(ctx, blockHeader)
+		state := sample.BlockHeaderState(blockHeader.Hash)
+		state.Orphaned = true
+		k.SetBlockHeaderState(ctx, state)
+
+		_, err := k.Prove(wctx, &types.QueryProveRequest{
+			ChainId:   chainID,
+			TxHash:    hash.Hex(),
+			Proof:     proof,
+			BlockHash: blockHash,
+			TxIndex:   txIndex,
+		})
+		require.ErrorContains(t, err, "block header is orphaned")
+	})
+
 	t.Run("should returns response with proven false if invalid proof", func(t *testing.T) {
 		k, ctx, _, _ := keepertest.LightclientKeeper(t)
 		wctx := sdk.WrapSDKContext(ctx)
@@ -0,0 +1,24 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	v2 "github.com/zeta-chain/node/x/lightclient/migrations/v2"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	lightclientKeeper Keeper
+}
+
+// NewMigrator returns a new Migrator for the lightclient module.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{
+		lightclientKeeper: keeper,
+	}
+}
+
+// Migrate1to2 migrates the lightclient store from consensus version 1 to 2
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	return v2.MigrateStore(ctx, m.lightclientKeeper)
+}
@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	"context"
+
+	cosmoserrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	authoritytypes "github.com/zeta-chain/node/x/authority/types"
+	"github.com/zeta-chain/node/x/lightclient/types"
+)
+
+// UpdateHeaderRetention updates the number of blocks for which the block headers of a chain are kept
+// The block headers older than the retention are pruned at the beginning of the next blocks
+func (k msgServer) UpdateHeaderRetention(goCtx context.Context, msg *types.MsgUpdateHeaderRetention) (
+	*types.MsgUpdateHeaderRetentionResponse,
+	error,
+) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// check permission
+	err := k.GetAuthorityKeeper().CheckAuthorization(ctx, msg)
+	if err != nil {
+		return nil, cosmoserrors.Wrap(authoritytypes.ErrUnauthorized, err.Error())
+	}
+
+	bhv, found := k.GetBlockHeaderVerification(ctx)
+	if !found || !bhv.UpdateHeaderRetention(msg.ChainId, msg.HeaderRetention) {
+		return nil, cosmoserrors.Wrapf(
+			types.ErrChainNotSupported,
+			"block header verification is not supported for chain %d",
+			msg.ChainId,
+		)
+	}
+
+	k.SetBlockHeaderVerification(ctx, bhv)
+	return &types.MsgUpdateHeaderRetentionResponse{}, nil
+}
@@ -0,0 +1,105 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/node/pkg/chains"
+	keepertest "github.com/zeta-chain/node/testutil/keeper"
+	"github.com/zeta-chain/node/testutil/sample"
+	authoritytypes "github.com/zeta-chain/node/x/authority/types"
+	"github.com/zeta-chain/node/x/lightclient/keeper"
+	"github.com/zeta-chain/node/x/lightclient/types"
+)
+
+func TestMsgServer_UpdateHeaderRetention(t *testing.T) {
+	t.Run("admin group can update header retention", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.LightclientKeeperWithMocks(t, keepertest.LightclientMockOptions{
+			UseAuthorityMock: true,
+		})
+		srv := keeper.NewMsgServerImpl(*k)
+		admin := sample.AccAddress()
+
+		// mock the authority keeper for authorization
+		authorityMock := keepertest.GetLightclientAuthorityMock(t, k)
+
+		k.SetBlockHeaderVerification(ctx, types.BlockHeaderVerification{
+			HeaderSupportedChains: []types.HeaderSupportedChain{
+				{
+					ChainId: chains.Ethereum.ChainId,
+					Enabled: true,
+				},
+				{
+					ChainId: chains.BitcoinMainnet.ChainId,
+					Enabled: true,
+				},
+			},
+		})
+
+		msg := types.MsgUpdateHeaderRetention{
+			Creator:         admin,
+			ChainId:         chains.Ethereum.ChainId,
+			HeaderRetention: 1000,
+		}
+		keepertest.MockCheckAuthorization(&authorityMock.Mock, &msg, nil)
+		_, err := srv.UpdateHeaderRetention(sdk.WrapSDKContext(ctx), &msg)
+		require.NoError(t, err)
+
+		bhv, found := k.GetBlockHeaderVerification(ctx)
+		require.True(t, found)
+		require.EqualValues(t, 1000, bhv.HeaderSupportedChains[0].HeaderRetention)
+		require.EqualValues(t, 0, bhv.HeaderSupportedChains[1].HeaderRetention)
+		require.True(t, bhv.IsChainEnabled(chains.Ethereum.ChainId))
+	})
+
+	t.Run("cannot update header retention if not authorized", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.LightclientKeeperWithMocks(t, keepertest.LightclientMockOptions{
+			UseAuthorityMock: true,
+		})
+		srv := keeper.NewMsgServerImpl(*k)
+		admin := sample.AccAddress()
+
+		// mock the authority keeper for authorization
+		authorityMock := keepertest.GetLightclientAuthorityMock(t, k)
+
+		msg := types.MsgUpdateHeaderRetention{
+			Creator:         admin,
+			ChainId:         chains.Ethereum.ChainId,
+			HeaderRetention: 1000,
+		}
+		keepertest.MockCheckAuthorization(&authorityMock.Mock, &msg, authoritytypes.ErrUnauthorized)
+		_, err := srv.UpdateHeaderRetention(sdk.WrapSDKContext(ctx), &msg)
+		require.ErrorIs(t, err, authoritytypes.ErrUnauthorized)
+	})
+
+	t.Run("cannot update header retention if chain not supported", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.LightclientKeeperWithMocks(t, keepertest.LightclientMockOptions{
+			UseAuthorityMock: true,
+		})
+		srv := keeper.NewMsgServerImpl(*k)
+		admin := sample.AccAddress()
+
+		// mock the authority keeper for authorization
+		authorityMock := keepertest.GetLightclientAuthorityMock(t, k)
+
+		k.SetBlockHeaderVerification(ctx, types.BlockHeaderVerification{
+			HeaderSupportedChains: []types.HeaderSupportedChain{
+				{
+					ChainId: chains.BitcoinMainnet.ChainId,
+					Enabled: true,
+				},
+			},
+		})
+
+		msg := types.MsgUpdateHeaderRetention{
+			Creator:         admin,
+			ChainId:         chains.Ethereum.ChainId,
+			HeaderRetention: 1000,
+		}
+		keepertest.MockCheckAuthorization(&authorityMock.Mock, &msg, nil)
+		_, err := srv.UpdateHeaderRetention(sdk.WrapSDKContext(ctx), &msg)
+		require.ErrorIs(t, err, types.ErrChainNotSupported)
+	})
+}
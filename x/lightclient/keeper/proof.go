@@ -18,9 +18,55 @@ func (k Keeper) VerifyProof(
 	blockHash string,
 	txIndex int64,
 ) ([]byte, error) {
+	header, err := k.getProofBlockHeader(ctx, chainID, blockHash)
+	if err != nil {
+		return nil, err
+	}
+
+	// verify merkle proof
+	txBytes, err := proof.Verify(header.Header, int(txIndex))
+	if err != nil {
+		return nil, cosmoserror.Wrapf(
+			types.ErrProofVerificationFailed,
+			"failed to verify merkle proof: %s",
+			err.Error(),
+		)
+	}
+	return txBytes, nil
+}
+
+// VerifyReceiptProof verifies the merkle proofs of a transaction and its receipt for a given chain and block header
+// It returns the transaction and receipt bytes if the proofs are valid
+func (k Keeper) VerifyReceiptProof(
+	ctx sdk.Context,
+	proof *proofs.Proof,
+	chainID int64,
+	blockHash string,
+	txIndex int64,
+) ([]byte, []byte, error) {
+	header, err := k.getProofBlockHeader(ctx, chainID, blockHash)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// verify merkle proofs
+	txBytes, receiptBytes, err := proof.VerifyReceipt(header.Header, int(txIndex))
+	if err != nil {
+		return nil, nil, cosmoserror.Wrapf(
+			types.ErrProofVerificationFailed,
+			"failed to verify receipt merkle proof: %s",
+			err.Error(),
+		)
+	}
+	return txBytes, receiptBytes, nil
+}
+
+// getProofBlockHeader returns the block header to verify a proof against
+// the block header verification must be enabled for the chain and the block header must be in the canonical chain
+func (k Keeper) getProofBlockHeader(ctx sdk.Context, chainID int64, blockHash string) (proofs.BlockHeader, error) {
 	// check block header verification is set
 	if err := k.CheckBlockHeaderVerificationEnabled(ctx, chainID); err != nil {
-		return nil, err
+		return proofs.BlockHeader{}, err
 	}
 
 	// additionalChains is a list of additional chains to search from
@@ -30,7 +76,7 @@ func (k Keeper) VerifyProof(
 	// get block header from the store
 	hashBytes, err := chains.StringToHash(chainID, blockHash, additionalChains)
 	if err != nil {
-		return nil, cosmoserror.Wrapf(
+		return proofs.BlockHeader{}, cosmoserror.Wrapf(
 			types.ErrInvalidBlockHash,
 			"block hash %s conversion failed %s",
 			blockHash,
@@ -39,17 +85,18 @@ func (k Keeper) VerifyProof(
 	}
 	res, found := k.GetBlockHeader(ctx, hashBytes)
 	if !found {
-		return nil, cosmoserror.Wrapf(types.ErrBlockHeaderNotFound, "block header not found %s", blockHash)
+		return proofs.BlockHeader{}, cosmoserror.Wrapf(
+			types.ErrBlockHeaderNotFound,
+			"block header not found %s",
+			blockHash,
+		)
 	}
-
-	// verify merkle proof
-	txBytes, err := proof.Verify(res.Header, int(txIndex))
-	if err != nil {
-		return nil, cosmoserror.Wrapf(
-			types.ErrProofVerificationFailed,
-			"failed to verify merkle proof: %s",
-			err.Error(),
+	if state, found := k.GetBlockHeaderState(ctx, hashBytes); found && state.Orphaned {
+		return proofs.BlockHeader{}, cosmoserror.Wrapf(
+			types.ErrOrphanedBlockHeader,
+			"block header %s is not in the canonical chain",
+			blockHash,
 		)
 	}
-	return txBytes, nil
+	return res, nil
 }
@@ -165,4 +165,128 @@ func TestKeeper_VerifyProof(t *testing.T) {
 		require.NoError(t, err)
 		require.NotNil(t, txBytes)
 	})
+
+	t.Run("should fail if block header is orphaned", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.LightclientKeeper(t)
+
+		proof, blockHeader, blockHash, txIndex, chainID, _ := sample.Proof(t)
+
+		k.SetBlockHeaderVerification(ctx, types.BlockHeaderVerification{
+			HeaderSupportedChains: []types.HeaderSupportedChain{
+				{
+					ChainId: chains.Sepolia.ChainId,
+					Enabled: true,
+				},
+			},
+		})
+
+		k.SetBlockHeader(ctx, blockHeader)
+		state := sample.BlockHeaderState(blockHeader.Hash)
+		state.Orphaned = true
+		k.SetBlockHeaderState(ctx, state)
+
+		_, err := k.VerifyProof(ctx, proof, chainID, blockHash, txIndex)
+		require.ErrorIs(t, err, types.ErrOrphanedBlockHeader)
+
+		// the proof can be verified once the block header is canonical again
+		state.Orphaned = false
+		k.SetBlockHeaderState(ctx, state)
+
+		txBytes, err := k.VerifyProof(ctx, proof, chainID, blockHash, txIndex)
+		require.NoError(t, err)
+		require.NotNil(t, txBytes)
+	})
+}
+
+func TestKeeper_VerifyReceiptProof(t *testing.T) {
+	t.Run("should error if verification flags not found", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.LightclientKeeper(t)
+
+		_, _, err := k.VerifyReceiptProof(ctx, &proofs.Proof{}, chains.Sepolia.ChainId, sample.Hash().String(), 1)
+		require.ErrorIs(t, err, types.ErrBlockHeaderVerificationDisabled)
+	})
+
+	t.Run("should error if block header not found", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.LightclientKeeper(t)
+
+		k.SetBlockHeaderVerification(ctx, types.BlockHeaderVerification{
+			HeaderSupportedChains: []types.HeaderSupportedChain{
+				{
+					ChainId: chains.Sepolia.ChainId,
+					Enabled: true,
+				},
+			},
+		})
+
+		_, _, err := k.VerifyReceiptProof(ctx, &proofs.Proof{}, chains.Sepolia.ChainId, sample.Hash().String(), 1)
+		require.ErrorIs(t, err, types.ErrBlockHeaderNotFound)
+	})
+
+	t.Run("should fail if proof is not a receipt proof", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.LightclientKeeper(t)
+
+		proof, blockHeader, blockHash, txIndex, chainID, _ := sample.Proof(t)
+
+		k.SetBlockHeaderVerification(ctx, types.BlockHeaderVerification{
+			HeaderSupportedChains: []types.HeaderSupportedChain{
+				{
+					ChainId: chains.Sepolia.ChainId,
+					Enabled: true,
+				},
+			},
+		})
+
+		k.SetBlockHeader(ctx, blockHeader)
+
+		_, _, err := k.VerifyReceiptProof(ctx, proof, chainID, blockHash, txIndex)
+		require.ErrorIs(t, err, types.ErrProofVerificationFailed)
+	})
+
+	t.Run("should fail if proof can't be verified", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.LightclientKeeper(t)
+
+		proof, blockHeader, blockHash, txIndex, chainID, _ := sample.ReceiptProof(t)
+
+		k.SetBlockHeaderVerification(ctx, types.BlockHeaderVerification{
+			HeaderSupportedChains: []types.HeaderSupportedChain{
+				{
+					ChainId: chains.Sepolia.ChainId,
+					Enabled: true,
+				},
+			},
+		})
+
+		k.SetBlockHeader(ctx, blockHeader)
+
+		// providing wrong tx index
+		_, _, err := k.VerifyReceiptProof(ctx, proof, chainID, blockHash, txIndex+1)
+		require.ErrorIs(t, err, types.ErrProofVerificationFailed)
+	})
+
+	t.Run("can verify a receipt proof", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.LightclientKeeper(t)
+
+		proof, blockHeader, blockHash, txIndex, chainID, _ := sample.ReceiptProof(t)
+
+		k.SetBlockHeaderVerification(ctx, types.BlockHeaderVerification{
+			HeaderSupportedChains: []types.HeaderSupportedChain{
+				{
+					ChainId: chains.Sepolia.ChainId,
+					Enabled: true,
+				},
+			},
+		})
+
+		k.SetBlockHeader(ctx, blockHeader)
+
+		txBytes, receiptBytes, err := k.VerifyReceiptProof(ctx, proof, chainID, blockHash, txIndex)
+		require.NoError(t, err)
+		require.NotNil(t, txBytes)
+		require.NotNil(t, receiptBytes)
+
+		// the proof also verifies as a transaction proof
+		verified, err := k.VerifyProof(ctx, proof, chainID, blockHash, txIndex)
+		require.NoError(t, err)
+		require.Equal(t, txBytes, verified)
+	})
 }
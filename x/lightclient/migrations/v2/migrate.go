@@ -0,0 +1,95 @@
+package v2
+
+import (
+	"sort"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/zeta-chain/node/pkg/proofs"
+	"github.com/zeta-chain/node/x/lightclient/types"
+)
+
+type lightclientKeeper interface {
+	GetAllBlockHeaders(ctx sdk.Context) []proofs.BlockHeader
+	SetBlockHeader(ctx sdk.Context, header proofs.BlockHeader)
+	GetAllChainStates(ctx sdk.Context) []types.ChainState
+	SetBlockHeaderState(ctx sdk.Context, state types.BlockHeaderState)
+}
+
+// MigrateStore migrates the lightclient module state from the consensus version 1 to 2
+// It indexes the existing block headers by height and computes their fork-choice state,
+// the block headers from the latest block header of each chain are canonical, the other ones are orphaned
+// The difficulty period state of the Bitcoin block headers is backfilled from the stored first blocks of the periods
+func MigrateStore(ctx sdk.Context, k lightclientKeeper) error {
+	headers := k.GetAllBlockHeaders(ctx)
+
+	// sort the block headers by height to compute the cumulative work of the parents first
+	sort.SliceStable(headers, func(i, j int) bool {
+		return headers[i].Height < headers[j].Height
+	})
+
+	parentHashes := make(map[string][]byte, len(headers))
+	states := make(map[string]*types.BlockHeaderState, len(headers))
+	for _, header := range headers {
+		// set the block header again to index it by height
+		k.SetBlockHeader(ctx, header)
+
+		cumulativeWork := sdkmath.ZeroUint()
+		if parentState, found := states[string(header.ParentHash)]; found {
+			cumulativeWork = parentState.CumulativeWork
+		}
+		if work, err := header.Header.Work(); err == nil {
+			cumulativeWork = cumulativeWork.Add(sdkmath.NewUintFromBigInt(work))
+		}
+
+		state := &types.BlockHeaderState{
+			BlockHash:      header.Hash,
+			ChainId:        header.ChainId,
+			Height:         header.Height,
+			CumulativeWork: cumulativeWork,
+			Orphaned:       true,
+		}
+
+		// the period state of the parent is computed first, it is unknown if the parent is not stored
+		if btcHeader := header.Header.GetBitcoinHeader(); btcHeader != nil {
+			var parentPeriod proofs.BitcoinPeriodState
+			if parentState, found := states[string(header.ParentHash)]; found {
+				parentPeriod = parentState.BitcoinPeriodState()
+			}
+			period, err := proofs.NextBitcoinPeriodState(header.ChainId, btcHeader, header.Height, parentPeriod)
+			if err != nil {
+				ctx.Logger().Error(
+					"MigrateStore: unable to compute the Bitcoin difficulty period state",
+					"chain", header.ChainId,
+					"height", header.Height,
+					"error", err,
+				)
+			} else {
+				state.SetBitcoinPeriodState(period)
+			}
+		}
+
+		parentHashes[string(header.Hash)] = header.ParentHash
+		states[string(header.Hash)] = state
+	}
+
+	// the canonical chain is the branch ending with the latest block header of the chain
+	for _, chainState := range k.GetAllChainStates(ctx) {
+		hash := chainState.LatestBlockHash
+		for {
+			state, found := states[string(hash)]
+			if !found || !state.Orphaned {
+				break
+			}
+			state.Orphaned = false
+			hash = parentHashes[string(hash)]
+		}
+	}
+
+	for _, header := range headers {
+		k.SetBlockHeaderState(ctx, *states[string(header.Hash)])
+	}
+
+	return nil
+}
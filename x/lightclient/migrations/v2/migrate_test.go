@@ -0,0 +1,136 @@
+package v2_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/node/pkg/chains"
+	"github.com/zeta-chain/node/pkg/proofs"
+	keepertest "github.com/zeta-chain/node/testutil/keeper"
+	"github.com/zeta-chain/node/testutil/sample"
+	v2 "github.com/zeta-chain/node/x/lightclient/migrations/v2"
+	"github.com/zeta-chain/node/x/lightclient/types"
+)
+
+// blockHeader returns an Ethereum block header with the given parent
+func blockHeader(parentHash []byte, height int64) proofs.BlockHeader {
+	return proofs.BlockHeader{
+		Height:     height,
+		Hash:       sample.Hash().Bytes(),
+		ParentHash: parentHash,
+		ChainId:    chains.Sepolia.ChainId,
+		Header:     proofs.NewEthereumHeader([]byte{1}),
+	}
+}
+
+// btcBlockHeader returns a Bitcoin mainnet block header with the given parent, height and bits
+func btcBlockHeader(t *testing.T, parentHash []byte, height int64, bits uint32) proofs.BlockHeader {
+	prevBlock, err := chainhash.NewHash(parentHash)
+	require.NoError(t, err)
+	header := wire.BlockHeader{
+		Version:   1,
+		PrevBlock: *prevBlock,
+		Timestamp: time.Unix(1700000000+height, 0),
+		Bits:      bits,
+	}
+	var buf bytes.Buffer
+	require.NoError(t, header.Serialize(&buf))
+	hash := header.BlockHash()
+
+	return proofs.BlockHeader{
+		Height:     height,
+		Hash:       hash[:],
+		ParentHash: parentHash,
+		ChainId:    chains.BitcoinMainnet.ChainId,
+		Header:     proofs.NewBitcoinHeader(buf.Bytes()),
+	}
+}
+
+func TestMigrateStore(t *testing.T) {
+	t.Run("should compute the block header states", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.LightclientKeeper(t)
+
+		// a chain with a fork from the first block header
+		h1 := blockHeader(sample.Hash().Bytes(), 100)
+		h2 := blockHeader(h1.Hash, 101)
+		h3 := blockHeader(h2.Hash, 102)
+		fork := blockHeader(h1.Hash, 101)
+		for _, header := range []proofs.BlockHeader{h3, fork, h1, h2} {
+			k.SetBlockHeader(ctx, header)
+		}
+		k.SetChainState(ctx, types.ChainState{
+			ChainId:         chains.Sepolia.ChainId,
+			LatestHeight:    102,
+			EarliestHeight:  100,
+			LatestBlockHash: h3.Hash,
+		})
+
+		err := v2.MigrateStore(ctx, k)
+		require.NoError(t, err)
+
+		for _, tt := range []struct {
+			header   proofs.BlockHeader
+			work     uint64
+			orphaned bool
+		}{
+			{h1, 1, false},
+			{h2, 2, false},
+			{h3, 3, false},
+			{fork, 2, true},
+		} {
+			state, found := k.GetBlockHeaderState(ctx, tt.header.Hash)
+			require.True(t, found)
+			require.Equal(t, tt.header.Hash, state.BlockHash)
+			require.Equal(t, tt.header.ChainId, state.ChainId)
+			require.Equal(t, tt.header.Height, state.Height)
+			require.EqualValues(t, tt.work, state.CumulativeWork.Uint64())
+			require.Equal(t, tt.orphaned, state.Orphaned)
+		}
+	})
+
+	t.Run("should backfill the difficulty period state of Bitcoin block headers", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.LightclientKeeper(t)
+
+		// the first block of the period is stored at height 2016*400
+		unknown := btcBlockHeader(t, sample.Hash().Bytes(), 2016*400-1, 0x1703a30c)
+		first := btcBlockHeader(t, unknown.Hash, 2016*400, 0x1703a30c)
+		second := btcBlockHeader(t, first.Hash, 2016*400+1, 0x1703a30c)
+		for _, header := range []proofs.BlockHeader{second, unknown, first} {
+			k.SetBlockHeader(ctx, header)
+		}
+		k.SetChainState(ctx, types.ChainState{
+			ChainId:         chains.BitcoinMainnet.ChainId,
+			LatestHeight:    second.Height,
+			EarliestHeight:  unknown.Height,
+			LatestBlockHash: second.Hash,
+		})
+
+		err := v2.MigrateStore(ctx, k)
+		require.NoError(t, err)
+
+		state, found := k.GetBlockHeaderState(ctx, unknown.Hash)
+		require.True(t, found)
+		require.False(t, state.BitcoinPeriodState().IsKnown())
+
+		for _, header := range []proofs.BlockHeader{first, second} {
+			state, found = k.GetBlockHeaderState(ctx, header.Hash)
+			require.True(t, found)
+			require.EqualValues(t, 1700000000+2016*400, state.PeriodStartTimestamp)
+			require.EqualValues(t, 0x1703a30c, state.PeriodStartBits)
+			require.EqualValues(t, 0x1703a30c, state.LastBits)
+		}
+	})
+
+	t.Run("should succeed with no block headers", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.LightclientKeeper(t)
+
+		err := v2.MigrateStore(ctx, k)
+		require.NoError(t, err)
+		require.Empty(t, k.GetAllBlockHeaderStates(ctx))
+	})
+}
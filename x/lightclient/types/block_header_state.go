@@ -0,0 +1,49 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/zeta-chain/node/pkg/proofs"
+)
+
+const (
+	// EthereumFinalityDepth is the number of blocks after which an Ethereum block is considered final
+	// block headers forking the canonical chain below this depth are rejected
+	EthereumFinalityDepth = 64
+
+	// MaxPrunedBlockHeaders is the maximum number of block headers pruned in a block
+	// this bounds the gas consumed by the pruning in BeginBlock
+	MaxPrunedBlockHeaders = 100
+)
+
+// BlockHeaderHeightKeyPrefix returns the prefix of the block header height index for a chain
+func BlockHeaderHeightKeyPrefix(chainID int64) []byte {
+	return sdk.Uint64ToBigEndian(uint64(chainID))
+}
+
+// BlockHeaderHeightIndex returns the key of a block header in the block header height index of its chain
+// the height is encoded in big endian so the block headers are iterated by increasing height
+func BlockHeaderHeightIndex(height int64, blockHash []byte) []byte {
+	return append(sdk.Uint64ToBigEndian(uint64(height)), blockHash...)
+}
+
+// ParseBlockHeaderHeightIndex returns the height and the block hash from a block header height index key
+func ParseBlockHeaderHeightIndex(key []byte) (int64, []byte) {
+	return int64(sdk.BigEndianToUint64(key[:8])), key[8:]
+}
+
+// BitcoinPeriodState returns the state of the difficulty period of the Bitcoin block header
+func (m BlockHeaderState) BitcoinPeriodState() proofs.BitcoinPeriodState {
+	return proofs.BitcoinPeriodState{
+		StartTimestamp: m.PeriodStartTimestamp,
+		StartBits:      m.PeriodStartBits,
+		LastBits:       m.LastBits,
+	}
+}
+
+// SetBitcoinPeriodState sets the state of the difficulty period of the Bitcoin block header
+func (m *BlockHeaderState) SetBitcoinPeriodState(period proofs.BitcoinPeriodState) {
+	m.PeriodStartTimestamp = period.StartTimestamp
+	m.PeriodStartBits = period.StartBits
+	m.LastBits = period.LastBits
+}
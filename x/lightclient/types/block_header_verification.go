@@ -9,6 +9,9 @@ func (b *BlockHeaderVerification) Validate() error {
 			return fmt.Errorf("duplicated chain id for block header verification")
 		}
 		detectDuplicates[chain.ChainId] = true
+		if chain.HeaderRetention < 0 {
+			return fmt.Errorf("negative header retention for chain %d", chain.ChainId)
+		}
 	}
 	return nil
 }
@@ -49,6 +52,18 @@ func (b *BlockHeaderVerification) DisableChain(chainID int64) {
 	}
 }
 
+// UpdateHeaderRetention sets the header retention for a specific chain
+// It returns false if the chain is not found in the list of chains supporting block header verification
+func (b *BlockHeaderVerification) UpdateHeaderRetention(chainID int64, headerRetention int64) bool {
+	for i, v := range b.HeaderSupportedChains {
+		if v.ChainId == chainID {
+			b.HeaderSupportedChains[i].HeaderRetention = headerRetention
+			return true
+		}
+	}
+	return false
+}
+
 // IsChainEnabled checks if block header verification is enabled for a specific chain
 // It returns true if the chain is enabled, false otherwise
 // If the chain is not found in the list of chains, it returns false
@@ -28,6 +28,10 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type HeaderSupportedChain struct {
 	ChainId int64 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 	Enabled bool  `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// header_retention is the number of blocks below the canonical tip for which
+	// the block headers are kept, older headers are pruned
+	// 0 means the block headers are never pruned
+	HeaderRetention int64 `protobuf:"varint,3,opt,name=header_retention,json=headerRetention,proto3" json:"header_retention,omitempty"`
 }
 
 func (m *HeaderSupportedChain) Reset()         { *m = HeaderSupportedChain{} }
@@ -77,6 +81,13 @@ func (m *HeaderSupportedChain) GetEnabled() bool {
 	return false
 }
 
+func (m *HeaderSupportedChain) GetHeaderRetention() int64 {
+	if m != nil {
+		return m.HeaderRetention
+	}
+	return 0
+}
+
 type BlockHeaderVerification struct {
 	HeaderSupportedChains []HeaderSupportedChain `protobuf:"bytes,1,rep,name=header_supported_chains,json=headerSupportedChains,proto3" json:"header_supported_chains"`
 }
@@ -131,25 +142,26 @@ func init() {
 }
 
 var fileDescriptor_deea61e47e024601 = []byte{
-	// 286 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x90, 0x31, 0x4b, 0xc4, 0x30,
-	0x14, 0xc7, 0x1b, 0x4f, 0xbc, 0x23, 0x6e, 0xe5, 0xe4, 0xaa, 0x43, 0x2c, 0x37, 0x75, 0x31, 0x05,
-	0x75, 0x76, 0xa8, 0xcb, 0x89, 0x5b, 0x05, 0x07, 0x97, 0xd2, 0x36, 0xcf, 0x36, 0x58, 0xfb, 0x4a,
-	0x9a, 0x13, 0xf5, 0x3b, 0x08, 0x7e, 0xac, 0x1b, 0x6f, 0x74, 0x12, 0x69, 0xbf, 0x88, 0x34, 0xb5,
-	0x72, 0xc2, 0xe1, 0xf6, 0x1e, 0xc9, 0xff, 0x97, 0x7f, 0x7e, 0xf4, 0xe2, 0x15, 0x74, 0x9c, 0xe6,
-	0xb1, 0x2c, 0x7d, 0x33, 0xa1, 0x02, 0xbf, 0x90, 0x59, 0xae, 0xd3, 0x42, 0x42, 0xa9, 0xfd, 0xa4,
-	0xc0, 0xf4, 0x21, 0xca, 0x21, 0x16, 0xa0, 0xa2, 0x27, 0x50, 0xf2, 0x5e, 0xa6, 0xb1, 0x96, 0x58,
-	0xf2, 0x4a, 0xa1, 0x46, 0x9b, 0xfd, 0xe6, 0xf9, 0x90, 0xe7, 0x1b, 0xf9, 0xa3, 0x69, 0x86, 0x19,
-	0x9a, 0xab, 0x7e, 0x37, 0xf5, 0xa9, 0xf9, 0x35, 0x9d, 0x2e, 0x0c, 0xf2, 0x66, 0x59, 0x55, 0xa8,
-	0x34, 0x88, 0xcb, 0x0e, 0x61, 0x1f, 0xd2, 0x89, 0x61, 0x45, 0x52, 0x38, 0xc4, 0x25, 0xde, 0x28,
-	0x1c, 0x9b, 0xfd, 0x4a, 0xd8, 0x0e, 0x1d, 0x43, 0x19, 0x27, 0x05, 0x08, 0x67, 0xc7, 0x25, 0xde,
-	0x24, 0x1c, 0xd6, 0xf9, 0x1b, 0xa1, 0xb3, 0xa0, 0xab, 0xd9, 0x23, 0x6f, 0x37, 0x4a, 0xda, 0x8a,
-	0xce, 0x7e, 0xba, 0xd7, 0xc3, 0x4b, 0x91, 0x21, 0xd6, 0x0e, 0x71, 0x47, 0xde, 0xfe, 0xe9, 0x39,
-	0xff, 0xff, 0x03, 0x7c, 0x5b, 0xcf, 0x60, 0x77, 0xf5, 0x79, 0x6c, 0x85, 0x07, 0xf9, 0x96, 0xb3,
-	0x3a, 0x58, 0xac, 0x1a, 0x46, 0xd6, 0x0d, 0x23, 0x5f, 0x0d, 0x23, 0xef, 0x2d, 0xb3, 0xd6, 0x2d,
-	0xb3, 0x3e, 0x5a, 0x66, 0xdd, 0xf1, 0x4c, 0xea, 0x7c, 0x99, 0xf0, 0x14, 0x1f, 0x8d, 0xed, 0x93,
-	0x5e, 0x7c, 0x89, 0x02, 0xfc, 0xe7, 0x3f, 0xda, 0xf5, 0x4b, 0x05, 0x75, 0xb2, 0x67, 0x6c, 0x9d,
-	0x7d, 0x07, 0x00, 0x00, 0xff, 0xff, 0xb2, 0x4b, 0xb4, 0x68, 0xa5, 0x01, 0x00, 0x00,
+	// 303 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x51, 0xb1, 0x4e, 0xc3, 0x30,
+	0x10, 0x8d, 0x29, 0xa2, 0x95, 0x19, 0x40, 0x51, 0x51, 0x03, 0x83, 0x89, 0x3a, 0x85, 0x01, 0x47,
+	0x02, 0x66, 0x86, 0xb0, 0x94, 0x35, 0x48, 0x0c, 0x2c, 0x51, 0x12, 0x1f, 0x89, 0x45, 0xb0, 0x23,
+	0xc7, 0xad, 0x80, 0x7f, 0x40, 0xe2, 0xb3, 0x3a, 0x76, 0x64, 0x42, 0x28, 0xf9, 0x11, 0x14, 0xa7,
+	0x41, 0x45, 0xaa, 0xd8, 0xee, 0xce, 0x7e, 0xef, 0xde, 0xbb, 0x87, 0xaf, 0xdf, 0x40, 0xc7, 0x69,
+	0x1e, 0x73, 0xe1, 0x9b, 0x4a, 0x2a, 0xf0, 0x0b, 0x9e, 0xe5, 0x3a, 0x2d, 0x38, 0x08, 0xed, 0x27,
+	0x85, 0x4c, 0x9f, 0xa2, 0x1c, 0x62, 0x06, 0x2a, 0x5a, 0x80, 0xe2, 0x8f, 0x3c, 0x8d, 0x35, 0x97,
+	0x82, 0x96, 0x4a, 0x6a, 0x69, 0x93, 0x5f, 0x3c, 0xed, 0xf1, 0x74, 0x03, 0x7f, 0x32, 0xce, 0x64,
+	0x26, 0xcd, 0x57, 0xbf, 0xad, 0x3a, 0xd4, 0x74, 0x81, 0xc7, 0x33, 0x43, 0x79, 0x37, 0x2f, 0x4b,
+	0xa9, 0x34, 0xb0, 0x9b, 0x96, 0xc2, 0x3e, 0xc6, 0x23, 0xc3, 0x15, 0x71, 0xe6, 0x20, 0x17, 0x79,
+	0x83, 0x70, 0x68, 0xfa, 0x5b, 0x66, 0x3b, 0x78, 0x08, 0x22, 0x4e, 0x0a, 0x60, 0xce, 0x8e, 0x8b,
+	0xbc, 0x51, 0xd8, 0xb7, 0xf6, 0x19, 0x3e, 0x5c, 0xeb, 0x53, 0xa0, 0x41, 0xb4, 0xe2, 0x9c, 0x81,
+	0x01, 0x1f, 0x74, 0xf3, 0xb0, 0x1f, 0x4f, 0xdf, 0x11, 0x9e, 0x04, 0xad, 0xa3, 0x6e, 0xfb, 0xfd,
+	0x86, 0x1f, 0x5b, 0xe1, 0xc9, 0x9a, 0xa6, 0xea, 0x45, 0x45, 0x66, 0x79, 0xe5, 0x20, 0x77, 0xe0,
+	0xed, 0x5f, 0x5c, 0xd1, 0xff, 0xbd, 0xd2, 0x6d, 0x96, 0x82, 0xdd, 0xe5, 0xd7, 0xa9, 0x15, 0x1e,
+	0xe5, 0x5b, 0xde, 0xaa, 0x60, 0xb6, 0xac, 0x09, 0x5a, 0xd5, 0x04, 0x7d, 0xd7, 0x04, 0x7d, 0x34,
+	0xc4, 0x5a, 0x35, 0xc4, 0xfa, 0x6c, 0x88, 0xf5, 0x40, 0x33, 0xae, 0xf3, 0x79, 0x42, 0x53, 0xf9,
+	0x6c, 0x82, 0x39, 0xef, 0x32, 0x12, 0x92, 0x81, 0xff, 0xf2, 0x27, 0x21, 0xfd, 0x5a, 0x42, 0x95,
+	0xec, 0x99, 0xc3, 0x5e, 0xfe, 0x0c, 0x00, 0x29, 0x19, 0x16, 0x85, 0xd0, 0x01, 0x00, 0x00,
 }
 
 func (m *HeaderSupportedChain) Marshal() (dAtA []byte, err error) {
@@ -172,6 +184,11 @@ func (m *HeaderSupportedChain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.HeaderRetention != 0 {
+		i = encodeVarintBlockHeaderVerification(dAtA, i, uint64(m.HeaderRetention))
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.Enabled {
 		i--
 		if m.Enabled {
@@ -250,6 +267,9 @@ func (m *HeaderSupportedChain) Size() (n int) {
 	if m.Enabled {
 		n += 2
 	}
+	if m.HeaderRetention != 0 {
+		n += 1 + sovBlockHeaderVerification(uint64(m.HeaderRetention))
+	}
 	return n
 }
 
@@ -342,6 +362,25 @@ func (m *HeaderSupportedChain) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Enabled = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeaderRetention", wireType)
+			}
+			m.HeaderRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlockHeaderVerification
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HeaderRetention |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBlockHeaderVerification(dAtA[iNdEx:])
@@ -28,7 +28,32 @@ func TestBlockHeaderVerification_Validate(t *testing.T) {
 			}}
 		require.Error(t, bhv.Validate())
 	})
+
+	t.Run("should return error if negative header retention", func(t *testing.T) {
+		bhv := types.BlockHeaderVerification{
+			HeaderSupportedChains: []types.HeaderSupportedChain{
+				{ChainId: 1, Enabled: true, HeaderRetention: -1},
+			}}
+		require.ErrorContains(t, bhv.Validate(), "negative header retention")
+	})
+}
+
+func TestBlockHeaderVerification_UpdateHeaderRetention(t *testing.T) {
+	t.Run("should update header retention if chain present", func(t *testing.T) {
+		bhv := types.BlockHeaderVerification{
+			HeaderSupportedChains: []types.HeaderSupportedChain{
+				{ChainId: chains.BscMainnet.ChainId, Enabled: true},
+			}}
+		require.True(t, bhv.UpdateHeaderRetention(chains.BscMainnet.ChainId, 1000))
+		require.EqualValues(t, 1000, bhv.HeaderSupportedChains[0].HeaderRetention)
+	})
+
+	t.Run("should return false if chain not present", func(t *testing.T) {
+		bhv := sample.BlockHeaderVerification()
+		require.False(t, bhv.UpdateHeaderRetention(chains.BscMainnet.ChainId, 1000))
+	})
 }
+
 func TestBlockHeaderVerification_EnableChain(t *testing.T) {
 	t.Run("should enable chain if chain not present", func(t *testing.T) {
 		bhv := sample.BlockHeaderVerification()
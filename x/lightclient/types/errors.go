@@ -14,4 +14,7 @@ var (
 	ErrProofVerificationFailed         = errorsmod.Register(ModuleName, 1109, "proof verification failed")
 	ErrInvalidHeight                   = errorsmod.Register(ModuleName, 1110, "invalid height")
 	ErrInvalidBlockHeader              = errorsmod.Register(ModuleName, 1111, "invalid block header")
+	ErrOrphanedBlockHeader             = errorsmod.Register(ModuleName, 1112, "block header is orphaned")
+	ErrReorgTooDeep                    = errorsmod.Register(ModuleName, 1113, "reorg deeper than finality depth")
+	ErrInvalidProofOfWork              = errorsmod.Register(ModuleName, 1114, "invalid proof-of-work")
 )
@@ -12,6 +12,7 @@ func DefaultGenesis() *GenesisState {
 		BlockHeaders:            []proofs.BlockHeader{},
 		ChainStates:             []ChainState{},
 		BlockHeaderVerification: BlockHeaderVerification{},
+		BlockHeaderStates:       []BlockHeaderState{},
 	}
 }
 
@@ -34,6 +35,14 @@ func (gs GenesisState) Validate() error {
 		ChainStateMap[elem.ChainId] = true
 	}
 
+	blockHeaderStateMap := make(map[string]bool)
+	for _, elem := range gs.BlockHeaderStates {
+		if _, ok := blockHeaderStateMap[string(elem.BlockHash)]; ok {
+			return fmt.Errorf("duplicated hash for block header states")
+		}
+		blockHeaderStateMap[string(elem.BlockHash)] = true
+	}
+
 	err := gs.BlockHeaderVerification.Validate()
 	if err != nil {
 		return err
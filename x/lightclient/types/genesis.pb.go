@@ -29,6 +29,7 @@ type GenesisState struct {
 	BlockHeaders            []proofs.BlockHeader    `protobuf:"bytes,1,rep,name=block_headers,json=blockHeaders,proto3" json:"block_headers"`
 	ChainStates             []ChainState            `protobuf:"bytes,2,rep,name=chain_states,json=chainStates,proto3" json:"chain_states"`
 	BlockHeaderVerification BlockHeaderVerification `protobuf:"bytes,3,opt,name=block_header_verification,json=blockHeaderVerification,proto3" json:"block_header_verification"`
+	BlockHeaderStates       []BlockHeaderState      `protobuf:"bytes,4,rep,name=block_header_states,json=blockHeaderStates,proto3" json:"block_header_states"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -85,6 +86,13 @@ func (m *GenesisState) GetBlockHeaderVerification() BlockHeaderVerification {
 	return BlockHeaderVerification{}
 }
 
+func (m *GenesisState) GetBlockHeaderStates() []BlockHeaderState {
+	if m != nil {
+		return m.BlockHeaderStates
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "zetachain.zetacore.lightclient.GenesisState")
 }
@@ -94,28 +102,29 @@ func init() {
 }
 
 var fileDescriptor_57c7baf4497aa1be = []byte{
-	// 325 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x91, 0x31, 0x4e, 0xc3, 0x30,
-	0x14, 0x86, 0x93, 0x16, 0x31, 0xa4, 0x65, 0x89, 0x90, 0x08, 0x1d, 0x4c, 0xc5, 0x54, 0x55, 0x60,
-	0xa3, 0x32, 0xb0, 0x31, 0x94, 0x81, 0xce, 0x54, 0x30, 0xb0, 0x44, 0x89, 0xeb, 0x3a, 0x56, 0x42,
-	0x1c, 0xc5, 0x06, 0x51, 0x4e, 0xc1, 0x65, 0xb8, 0x43, 0xc7, 0x8e, 0x4c, 0x08, 0x25, 0x17, 0x41,
-	0x71, 0x4c, 0x31, 0x52, 0xa3, 0x4c, 0xb6, 0xfc, 0xfe, 0xef, 0x7f, 0xff, 0xf3, 0x73, 0xce, 0xde,
-	0x88, 0x0c, 0x70, 0x14, 0xb0, 0x14, 0xa9, 0x1b, 0xcf, 0x09, 0x4a, 0x18, 0x8d, 0x24, 0x4e, 0x18,
-	0x49, 0x25, 0xa2, 0x24, 0x25, 0x82, 0x09, 0x98, 0xe5, 0x5c, 0x72, 0x17, 0x6c, 0xd5, 0xf0, 0x57,
-	0x0d, 0x0d, 0xf5, 0xe0, 0x90, 0x72, 0xca, 0x95, 0x14, 0x55, 0xb7, 0x9a, 0x1a, 0x5c, 0xb7, 0xf4,
-	0x08, 0x13, 0x8e, 0x63, 0x3f, 0x22, 0xc1, 0x82, 0xe4, 0xfe, 0x0b, 0xc9, 0xd9, 0x92, 0xe1, 0x40,
-	0x32, 0x9e, 0x6a, 0xfe, 0xa2, 0x85, 0x57, 0x25, 0x5f, 0xc8, 0x40, 0x12, 0x4d, 0x8c, 0x77, 0x10,
-	0x59, 0x4c, 0x51, 0x96, 0x73, 0xbe, 0x14, 0xfa, 0xa8, 0xb5, 0xa7, 0x1f, 0x1d, 0xa7, 0x7f, 0x5b,
-	0x4f, 0x39, 0xaf, 0x2c, 0xdc, 0x7b, 0xe7, 0xc0, 0x4c, 0x24, 0x3c, 0x7b, 0xd8, 0x1d, 0xf5, 0x26,
-	0x63, 0xb8, 0x63, 0xf8, 0x2c, 0xa6, 0x50, 0xbb, 0x4d, 0x2b, 0x66, 0xa6, 0x90, 0xe9, 0xde, 0xfa,
-	0xeb, 0xc4, 0xba, 0xeb, 0x87, 0x7f, 0x4f, 0xc2, 0x9d, 0x3b, 0x7d, 0x23, 0xa8, 0xf0, 0x3a, 0xcd,
-	0xae, 0xc6, 0x70, 0xf0, 0xa6, 0x2a, 0xa9, 0x60, 0xda, 0xb5, 0x87, 0xb7, 0x2f, 0xc2, 0x5d, 0x39,
-	0xc7, 0x8d, 0xbf, 0xe7, 0x75, 0x87, 0xf6, 0xa8, 0x37, 0xb9, 0x6a, 0xeb, 0x60, 0x04, 0x7f, 0x30,
-	0x70, 0xdd, 0xee, 0x28, 0x6c, 0x28, 0xcf, 0xd6, 0x05, 0xb0, 0x37, 0x05, 0xb0, 0xbf, 0x0b, 0x60,
-	0xbf, 0x97, 0xc0, 0xda, 0x94, 0xc0, 0xfa, 0x2c, 0x81, 0xf5, 0x08, 0x29, 0x93, 0xd1, 0x73, 0x08,
-	0x31, 0x7f, 0x52, 0xdf, 0x7f, 0x5e, 0x6f, 0x22, 0xe5, 0x0b, 0x82, 0x5e, 0xff, 0x6d, 0x4e, 0xae,
-	0x32, 0x22, 0xc2, 0x7d, 0xb5, 0x88, 0xcb, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xef, 0xd4, 0x05,
-	0x10, 0x8c, 0x02, 0x00, 0x00,
+	// 351 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0x3f, 0x4f, 0xfa, 0x40,
+	0x18, 0xc7, 0xdb, 0x1f, 0xe4, 0x37, 0x14, 0x1c, 0xac, 0x26, 0x56, 0x86, 0x93, 0x38, 0x11, 0xa2,
+	0x77, 0x04, 0x07, 0x36, 0x07, 0x1c, 0x64, 0x96, 0xe8, 0xe0, 0x42, 0x7a, 0xc7, 0x71, 0xbd, 0x80,
+	0xbd, 0xa6, 0x77, 0x1a, 0xf1, 0x55, 0xf8, 0x06, 0x7c, 0x3f, 0x8c, 0x8c, 0x4e, 0xc6, 0xc0, 0x1b,
+	0x31, 0xbd, 0x9e, 0x78, 0x28, 0xa4, 0x61, 0xea, 0xe5, 0x79, 0xbe, 0x9f, 0xe7, 0xfb, 0xfc, 0xa9,
+	0x77, 0xf6, 0x42, 0x55, 0x48, 0xa2, 0x90, 0xc7, 0x48, 0xbf, 0x44, 0x4a, 0xd1, 0x84, 0xb3, 0x48,
+	0x91, 0x09, 0xa7, 0xb1, 0x42, 0x8c, 0xc6, 0x54, 0x72, 0x09, 0x93, 0x54, 0x28, 0xe1, 0x83, 0x95,
+	0x1a, 0x7e, 0xab, 0xa1, 0xa5, 0xae, 0x1d, 0x32, 0xc1, 0x84, 0x96, 0xa2, 0xec, 0x95, 0x53, 0xb5,
+	0x4e, 0x81, 0x07, 0x9e, 0x08, 0x32, 0x1e, 0x44, 0x34, 0x1c, 0xd2, 0x74, 0x20, 0x55, 0xa8, 0xa8,
+	0x01, 0x2f, 0x77, 0x01, 0x9f, 0x68, 0xca, 0x47, 0x9c, 0x84, 0x8a, 0x8b, 0xd8, 0xf0, 0xad, 0x02,
+	0x5e, 0xa7, 0xd6, 0x1c, 0x9b, 0x1b, 0x88, 0x64, 0xcc, 0x50, 0x92, 0x0a, 0x31, 0x92, 0xe6, 0x93,
+	0x6b, 0x4f, 0xdf, 0x4a, 0x5e, 0xf5, 0x3a, 0x5f, 0x4f, 0x3f, 0x2b, 0xe1, 0xdf, 0x7a, 0x7b, 0x76,
+	0x47, 0x32, 0x70, 0xeb, 0xa5, 0x46, 0xa5, 0xdd, 0x84, 0x1b, 0xb6, 0x96, 0x8c, 0x19, 0x34, 0xd5,
+	0xba, 0x19, 0xd3, 0xd3, 0x48, 0xb7, 0x3c, 0xfb, 0x38, 0x71, 0x6e, 0xaa, 0xf8, 0x27, 0x24, 0xfd,
+	0xbe, 0x57, 0xb5, 0x1a, 0x95, 0xc1, 0xbf, 0xed, 0x55, 0xad, 0xe1, 0xe0, 0x55, 0x96, 0xd2, 0x8d,
+	0x99, 0xaa, 0x15, 0xb2, 0x8a, 0x48, 0x7f, 0xea, 0x1d, 0x6f, 0xdd, 0x5e, 0x50, 0xaa, 0xbb, 0x8d,
+	0x4a, 0xbb, 0x53, 0xe4, 0x60, 0x35, 0x7e, 0x67, 0xe1, 0xc6, 0xee, 0x08, 0x6f, 0x4e, 0xfb, 0x23,
+	0xef, 0xe0, 0xef, 0xc5, 0x65, 0x50, 0xd6, 0x63, 0xb5, 0x76, 0x30, 0xb5, 0x87, 0xdb, 0xc7, 0xbf,
+	0xe2, 0xb2, 0xdb, 0x9b, 0x2d, 0x80, 0x3b, 0x5f, 0x00, 0xf7, 0x73, 0x01, 0xdc, 0xd7, 0x25, 0x70,
+	0xe6, 0x4b, 0xe0, 0xbc, 0x2f, 0x81, 0x73, 0x0f, 0x19, 0x57, 0xd1, 0x23, 0x86, 0x44, 0x3c, 0xe8,
+	0x33, 0x9f, 0xe7, 0x17, 0x8f, 0xc5, 0x90, 0xa2, 0xe7, 0xb5, 0x3f, 0x44, 0x4d, 0x13, 0x2a, 0xf1,
+	0x7f, 0x7d, 0xf0, 0x8b, 0xaf, 0x01, 0x00, 0x4a, 0x5c, 0xa2, 0xcd, 0x2d, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -138,6 +147,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.BlockHeaderStates) > 0 {
+		for iNdEx := len(m.BlockHeaderStates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BlockHeaderStates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	{
 		size, err := m.BlockHeaderVerification.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -210,6 +233,12 @@ func (m *GenesisState) Size() (n int) {
 	}
 	l = m.BlockHeaderVerification.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.BlockHeaderStates) > 0 {
+		for _, e := range m.BlockHeaderStates {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -349,6 +378,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeaderStates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockHeaderStates = append(m.BlockHeaderStates, BlockHeaderState{})
+			if err := m.BlockHeaderStates[len(m.BlockHeaderStates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
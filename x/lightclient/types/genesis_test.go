@@ -33,6 +33,10 @@ func TestGenesisState_Validate(t *testing.T) {
 					sample.ChainState(chains.BitcoinMainnet.ChainId),
 					sample.ChainState(chains.BscMainnet.ChainId),
 				},
+				BlockHeaderStates: []types.BlockHeaderState{
+					sample.BlockHeaderState(sample.Hash().Bytes()),
+					sample.BlockHeaderState(sample.Hash().Bytes()),
+				},
 			},
 			valid: true,
 		},
@@ -63,6 +67,17 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "duplicate block header state is invalid",
+			genState: &types.GenesisState{
+				BlockHeaderStates: []types.BlockHeaderState{
+					sample.BlockHeaderState(sample.Hash().Bytes()),
+					sample.BlockHeaderState(duplicatedHash),
+					sample.BlockHeaderState(duplicatedHash),
+				},
+			},
+			valid: false,
+		},
 		{
 			desc: "invalid block header verification",
 			genState: &types.GenesisState{
@@ -21,6 +21,8 @@ const (
 	BlockHeaderKey       = "BlockHeader-value-"
 	ChainStateKey        = "ChainState-value-"
 	VerificationFlagsKey = "VerificationFlags-value-"
+	BlockHeaderStateKey  = "BlockHeaderState-value-"
+	BlockHeaderHeightKey = "BlockHeaderHeight-value-"
 )
 
 func KeyPrefix(p string) []byte {
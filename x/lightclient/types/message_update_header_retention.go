@@ -0,0 +1,51 @@
+package types
+
+import (
+	cosmoserrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgUpdateHeaderRetention = "update_header_retention"
+
+var _ sdk.Msg = &MsgUpdateHeaderRetention{}
+
+func NewMsgUpdateHeaderRetention(creator string, chainID int64, headerRetention int64) *MsgUpdateHeaderRetention {
+	return &MsgUpdateHeaderRetention{
+		Creator:         creator,
+		ChainId:         chainID,
+		HeaderRetention: headerRetention,
+	}
+}
+
+func (msg *MsgUpdateHeaderRetention) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUpdateHeaderRetention) Type() string {
+	return TypeMsgUpdateHeaderRetention
+}
+
+func (msg *MsgUpdateHeaderRetention) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgUpdateHeaderRetention) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgUpdateHeaderRetention) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return cosmoserrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.HeaderRetention < 0 {
+		return cosmoserrors.Wrapf(sdkerrors.ErrInvalidRequest, "header retention cannot be negative")
+	}
+
+	return nil
+}
@@ -0,0 +1,123 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/node/pkg/chains"
+	"github.com/zeta-chain/node/testutil/sample"
+	"github.com/zeta-chain/node/x/lightclient/types"
+)
+
+func TestMsgUpdateHeaderRetention_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  types.MsgUpdateHeaderRetention
+		err  require.ErrorAssertionFunc
+	}{
+		{
+			name: "invalid address",
+			msg: types.MsgUpdateHeaderRetention{
+				Creator: "invalid_address",
+			},
+			err: func(t require.TestingT, err error, i ...interface{}) {
+				require.ErrorIs(t, err, sdkerrors.ErrInvalidAddress)
+			},
+		},
+		{
+			name: "negative header retention",
+			msg: types.MsgUpdateHeaderRetention{
+				Creator:         sample.AccAddress(),
+				ChainId:         chains.Ethereum.ChainId,
+				HeaderRetention: -1,
+			},
+			err: func(t require.TestingT, err error, i ...interface{}) {
+				require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+				require.ErrorContains(t, err, "header retention cannot be negative")
+			},
+		},
+		{
+			name: "valid with zero header retention",
+			msg: types.MsgUpdateHeaderRetention{
+				Creator:         sample.AccAddress(),
+				ChainId:         chains.Ethereum.ChainId,
+				HeaderRetention: 0,
+			},
+			err: require.NoError,
+		},
+		{
+			name: "valid",
+			msg: types.MsgUpdateHeaderRetention{
+				Creator:         sample.AccAddress(),
+				ChainId:         chains.Ethereum.ChainId,
+				HeaderRetention: 1000,
+			},
+			err: require.NoError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			tt.err(t, err)
+		})
+	}
+}
+
+func TestMsgUpdateHeaderRetention_GetSigners(t *testing.T) {
+	signer := sample.AccAddress()
+	tests := []struct {
+		name   string
+		msg    *types.MsgUpdateHeaderRetention
+		panics bool
+	}{
+		{
+			name:   "valid signer",
+			msg:    types.NewMsgUpdateHeaderRetention(signer, chains.Ethereum.ChainId, 1000),
+			panics: false,
+		},
+		{
+			name:   "invalid signer",
+			msg:    types.NewMsgUpdateHeaderRetention("invalid", chains.Ethereum.ChainId, 1000),
+			panics: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !tt.panics {
+				signers := tt.msg.GetSigners()
+				require.Equal(t, []sdk.AccAddress{sdk.MustAccAddressFromBech32(signer)}, signers)
+			} else {
+				require.Panics(t, func() {
+					tt.msg.GetSigners()
+				})
+			}
+		})
+	}
+}
+
+func TestMsgUpdateHeaderRetention_Type(t *testing.T) {
+	msg := types.MsgUpdateHeaderRetention{
+		Creator: sample.AccAddress(),
+	}
+	require.Equal(t, types.TypeMsgUpdateHeaderRetention, msg.Type())
+}
+
+func TestMsgUpdateHeaderRetention_Route(t *testing.T) {
+	msg := types.MsgUpdateHeaderRetention{
+		Creator: sample.AccAddress(),
+	}
+	require.Equal(t, types.RouterKey, msg.Route())
+}
+
+func TestMsgUpdateHeaderRetention_GetSignBytes(t *testing.T) {
+	msg := types.MsgUpdateHeaderRetention{
+		Creator: sample.AccAddress(),
+	}
+	require.NotPanics(t, func() {
+		msg.GetSignBytes()
+	})
+}
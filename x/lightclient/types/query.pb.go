@@ -399,6 +399,102 @@ func (m *QueryGetChainStateResponse) GetChainState() *ChainState {
 	return nil
 }
 
+type QueryCanonicalTipRequest struct {
+	ChainId int64 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+}
+
+func (m *QueryCanonicalTipRequest) Reset()         { *m = QueryCanonicalTipRequest{} }
+func (m *QueryCanonicalTipRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCanonicalTipRequest) ProtoMessage()    {}
+func (*QueryCanonicalTipRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ff0d7827c501c48, []int{8}
+}
+func (m *QueryCanonicalTipRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCanonicalTipRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCanonicalTipRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCanonicalTipRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCanonicalTipRequest.Merge(m, src)
+}
+func (m *QueryCanonicalTipRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCanonicalTipRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCanonicalTipRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCanonicalTipRequest proto.InternalMessageInfo
+
+func (m *QueryCanonicalTipRequest) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+type QueryCanonicalTipResponse struct {
+	BlockHeader      proofs.BlockHeader `protobuf:"bytes,1,opt,name=block_header,json=blockHeader,proto3" json:"block_header"`
+	BlockHeaderState BlockHeaderState   `protobuf:"bytes,2,opt,name=block_header_state,json=blockHeaderState,proto3" json:"block_header_state"`
+}
+
+func (m *QueryCanonicalTipResponse) Reset()         { *m = QueryCanonicalTipResponse{} }
+func (m *QueryCanonicalTipResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCanonicalTipResponse) ProtoMessage()    {}
+func (*QueryCanonicalTipResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ff0d7827c501c48, []int{9}
+}
+func (m *QueryCanonicalTipResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCanonicalTipResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCanonicalTipResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCanonicalTipResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCanonicalTipResponse.Merge(m, src)
+}
+func (m *QueryCanonicalTipResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCanonicalTipResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCanonicalTipResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCanonicalTipResponse proto.InternalMessageInfo
+
+func (m *QueryCanonicalTipResponse) GetBlockHeader() proofs.BlockHeader {
+	if m != nil {
+		return m.BlockHeader
+	}
+	return proofs.BlockHeader{}
+}
+
+func (m *QueryCanonicalTipResponse) GetBlockHeaderState() BlockHeaderState {
+	if m != nil {
+		return m.BlockHeaderState
+	}
+	return BlockHeaderState{}
+}
+
 type QueryProveRequest struct {
 	ChainId   int64         `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 	TxHash    string        `protobuf:"bytes,2,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
@@ -411,7 +507,7 @@ func (m *QueryProveRequest) Reset()         { *m = QueryProveRequest{} }
 func (m *QueryProveRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryProveRequest) ProtoMessage()    {}
 func (*QueryProveRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1ff0d7827c501c48, []int{8}
+	return fileDescriptor_1ff0d7827c501c48, []int{10}
 }
 func (m *QueryProveRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -483,7 +579,7 @@ func (m *QueryProveResponse) Reset()         { *m = QueryProveResponse{} }
 func (m *QueryProveResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryProveResponse) ProtoMessage()    {}
 func (*QueryProveResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1ff0d7827c501c48, []int{9}
+	return fileDescriptor_1ff0d7827c501c48, []int{11}
 }
 func (m *QueryProveResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -526,7 +622,7 @@ func (m *QueryHeaderSupportedChainsRequest) Reset()         { *m = QueryHeaderSu
 func (m *QueryHeaderSupportedChainsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryHeaderSupportedChainsRequest) ProtoMessage()    {}
 func (*QueryHeaderSupportedChainsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1ff0d7827c501c48, []int{10}
+	return fileDescriptor_1ff0d7827c501c48, []int{12}
 }
 func (m *QueryHeaderSupportedChainsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -563,7 +659,7 @@ func (m *QueryHeaderSupportedChainsResponse) Reset()         { *m = QueryHeaderS
 func (m *QueryHeaderSupportedChainsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryHeaderSupportedChainsResponse) ProtoMessage()    {}
 func (*QueryHeaderSupportedChainsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1ff0d7827c501c48, []int{11}
+	return fileDescriptor_1ff0d7827c501c48, []int{13}
 }
 func (m *QueryHeaderSupportedChainsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -606,7 +702,7 @@ func (m *QueryHeaderEnabledChainsRequest) Reset()         { *m = QueryHeaderEnab
 func (m *QueryHeaderEnabledChainsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryHeaderEnabledChainsRequest) ProtoMessage()    {}
 func (*QueryHeaderEnabledChainsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1ff0d7827c501c48, []int{12}
+	return fileDescriptor_1ff0d7827c501c48, []int{14}
 }
 func (m *QueryHeaderEnabledChainsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -643,7 +739,7 @@ func (m *QueryHeaderEnabledChainsResponse) Reset()         { *m = QueryHeaderEna
 func (m *QueryHeaderEnabledChainsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryHeaderEnabledChainsResponse) ProtoMessage()    {}
 func (*QueryHeaderEnabledChainsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1ff0d7827c501c48, []int{13}
+	return fileDescriptor_1ff0d7827c501c48, []int{15}
 }
 func (m *QueryHeaderEnabledChainsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -688,6 +784,8 @@ func init() {
 	proto.RegisterType((*QueryAllChainStateResponse)(nil), "zetachain.zetacore.lightclient.QueryAllChainStateResponse")
 	proto.RegisterType((*QueryGetChainStateRequest)(nil), "zetachain.zetacore.lightclient.QueryGetChainStateRequest")
 	proto.RegisterType((*QueryGetChainStateResponse)(nil), "zetachain.zetacore.lightclient.QueryGetChainStateResponse")
+	proto.RegisterType((*QueryCanonicalTipRequest)(nil), "zetachain.zetacore.lightclient.QueryCanonicalTipRequest")
+	proto.RegisterType((*QueryCanonicalTipResponse)(nil), "zetachain.zetacore.lightclient.QueryCanonicalTipResponse")
 	proto.RegisterType((*QueryProveRequest)(nil), "zetachain.zetacore.lightclient.QueryProveRequest")
 	proto.RegisterType((*QueryProveResponse)(nil), "zetachain.zetacore.lightclient.QueryProveResponse")
 	proto.RegisterType((*QueryHeaderSupportedChainsRequest)(nil), "zetachain.zetacore.lightclient.QueryHeaderSupportedChainsRequest")
@@ -701,66 +799,71 @@ func init() {
 }
 
 var fileDescriptor_1ff0d7827c501c48 = []byte{
-	// 929 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0xcf, 0x8f, 0xdb, 0x44,
-	0x14, 0xc7, 0x77, 0xba, 0x4d, 0x7f, 0xbc, 0x6c, 0x91, 0x98, 0xb6, 0x6a, 0x6a, 0x68, 0x76, 0xeb,
-	0xb2, 0xb4, 0x0a, 0x5a, 0xbb, 0x09, 0xa5, 0x88, 0x54, 0x02, 0x36, 0x08, 0xb6, 0x15, 0x42, 0xda,
-	0xba, 0xe2, 0xc2, 0x25, 0x72, 0x9c, 0xa9, 0x6d, 0xad, 0xeb, 0x71, 0x3d, 0x93, 0x28, 0xa5, 0xea,
-	0x85, 0xbf, 0x00, 0x81, 0x90, 0xf8, 0x3f, 0xb8, 0x54, 0x42, 0x08, 0x89, 0x53, 0x6f, 0x54, 0xe2,
-	0x00, 0x27, 0x84, 0x76, 0xf9, 0x43, 0x90, 0x67, 0x26, 0xf5, 0x24, 0xeb, 0x6c, 0x9c, 0xb0, 0xa7,
-	0xf8, 0xc7, 0xbc, 0xf7, 0x3e, 0xdf, 0xef, 0x3c, 0xbf, 0x09, 0x34, 0xbe, 0x26, 0xdc, 0xf5, 0x02,
-	0x37, 0x8c, 0x6d, 0x71, 0x45, 0x53, 0x62, 0x47, 0xa1, 0x1f, 0x70, 0x2f, 0x0a, 0x49, 0xcc, 0xed,
-	0xc7, 0x03, 0x92, 0x3e, 0xb1, 0x92, 0x94, 0x72, 0x8a, 0xeb, 0xaf, 0xd6, 0x5a, 0xe3, 0xb5, 0x96,
-	0xb6, 0xd6, 0x68, 0x78, 0x94, 0x3d, 0xa2, 0xcc, 0xee, 0xb9, 0x8c, 0xc8, 0x40, 0x7b, 0xd8, 0xec,
-	0x11, 0xee, 0x36, 0xed, 0xc4, 0xf5, 0xc3, 0xd8, 0xe5, 0x21, 0x8d, 0x65, 0x2e, 0xe3, 0x82, 0x4f,
-	0x7d, 0x2a, 0x2e, 0xed, 0xec, 0x4a, 0x3d, 0x7d, 0xd3, 0xa7, 0xd4, 0x8f, 0x88, 0xed, 0x26, 0xa1,
-	0xed, 0xc6, 0x31, 0xe5, 0x22, 0x84, 0xa9, 0xb7, 0x1f, 0xce, 0x61, 0xed, 0x45, 0xd4, 0xdb, 0xeb,
-	0x06, 0xc4, 0xed, 0x93, 0xb4, 0x3b, 0x24, 0x69, 0xf8, 0x30, 0xf4, 0xf4, 0x9a, 0x37, 0xe7, 0xc4,
-	0x8b, 0x57, 0x5d, 0xc6, 0x5d, 0x4e, 0x54, 0x44, 0x91, 0x3b, 0xc9, 0x9e, 0x6f, 0x27, 0x29, 0xa5,
-	0x0f, 0x99, 0xfa, 0x91, 0x6b, 0xcd, 0x3e, 0x18, 0xf7, 0x33, 0xcd, 0xdb, 0x51, 0xd4, 0xc9, 0x40,
-	0xee, 0x0a, 0x0e, 0x87, 0x3c, 0x1e, 0x10, 0xc6, 0xf1, 0x67, 0x00, 0xb9, 0x07, 0x35, 0xb4, 0x81,
-	0x6e, 0x54, 0x5b, 0x6f, 0x5b, 0xd2, 0x30, 0x2b, 0x33, 0xcc, 0x92, 0x4e, 0x2b, 0xc3, 0xac, 0x5d,
-	0xd7, 0x27, 0x2a, 0xd6, 0xd1, 0x22, 0xcd, 0x5f, 0x10, 0xbc, 0x51, 0x58, 0x86, 0x25, 0x34, 0x66,
-	0x04, 0x7f, 0x09, 0xe7, 0x74, 0x1b, 0x58, 0x0d, 0x6d, 0xac, 0xde, 0xa8, 0xb6, 0x1a, 0x56, 0xc1,
-	0xde, 0x25, 0x7b, 0xbe, 0xa5, 0x24, 0x68, 0xa9, 0x3a, 0x27, 0x5f, 0xfc, 0xbd, 0xbe, 0xe2, 0xac,
-	0xf5, 0xf2, 0x47, 0x0c, 0xef, 0x4c, 0xe0, 0x9f, 0x10, 0xf8, 0xd7, 0xe7, 0xe2, 0x4b, 0xa6, 0x09,
-	0xfe, 0x3b, 0xca, 0xa5, 0x1d, 0xc2, 0x0b, 0x5c, 0xba, 0x02, 0xa0, 0xe8, 0x5d, 0x16, 0x08, 0x97,
-	0xd6, 0x9c, 0xb3, 0x12, 0xc4, 0x65, 0x81, 0x19, 0x29, 0xed, 0xd3, 0xc1, 0x4a, 0xfb, 0x17, 0xb0,
-	0xa6, 0x6b, 0x57, 0x2e, 0x2f, 0x20, 0xdd, 0xa9, 0x6a, 0xa2, 0x4d, 0x0f, 0x2e, 0x8f, 0x9d, 0xfe,
-	0x24, 0x8b, 0x7e, 0x90, 0x35, 0xc6, 0x71, 0xef, 0xe7, 0x73, 0x94, 0xb7, 0x8d, 0x5e, 0x45, 0x49,
-	0xba, 0x0f, 0x55, 0xad, 0x2b, 0x8f, 0xda, 0x4c, 0xad, 0x91, 0xad, 0x3c, 0x91, 0xda, 0x4c, 0xf0,
-	0x5e, 0x3d, 0x39, 0xbe, 0xad, 0xbc, 0xad, 0xfc, 0xd9, 0x21, 0xfc, 0xb0, 0x3f, 0x97, 0xe1, 0x8c,
-	0x04, 0x0f, 0xfb, 0xc2, 0x9d, 0x55, 0xe7, 0xb4, 0xb8, 0xbf, 0xd7, 0x37, 0xc3, 0xbc, 0x05, 0x0a,
-	0x14, 0x7f, 0x3e, 0xad, 0x18, 0x2d, 0xa6, 0x58, 0xd7, 0x9a, 0x7d, 0x2d, 0xaf, 0x8b, 0x5a, 0xbb,
-	0x29, 0x1d, 0x96, 0x60, 0xc3, 0x97, 0xe0, 0x34, 0x1f, 0xc9, 0xee, 0xcb, 0x9c, 0x39, 0xeb, 0x9c,
-	0xe2, 0xa3, 0xac, 0xf5, 0x70, 0x1b, 0x2a, 0xa2, 0x5f, 0x6a, 0xab, 0x02, 0xe8, 0xad, 0x39, 0x4d,
-	0xb5, 0x9b, 0xfd, 0x38, 0x32, 0x64, 0xaa, 0xab, 0x4f, 0x8a, 0xbc, 0x79, 0x57, 0x67, 0x38, 0x7c,
-	0xd4, 0x0d, 0xe3, 0x3e, 0x19, 0xd5, 0x2a, 0x12, 0x87, 0x8f, 0xee, 0x65, 0xb7, 0x66, 0x03, 0xb0,
-	0x8e, 0xaf, 0x2c, 0xba, 0x00, 0x95, 0xa1, 0x1b, 0x29, 0xf8, 0x33, 0x8e, 0xbc, 0x31, 0xaf, 0xc1,
-	0x55, 0xb1, 0x56, 0x76, 0xef, 0x83, 0x41, 0x92, 0xd0, 0x94, 0x93, 0xbe, 0x70, 0x86, 0x29, 0xe9,
-	0xe6, 0x8f, 0x08, 0xcc, 0xa3, 0x56, 0xa9, 0x0a, 0x29, 0x5c, 0x52, 0x63, 0x94, 0x8d, 0x57, 0x74,
-	0x85, 0xd8, 0xf1, 0x3c, 0xb9, 0x35, 0x6f, 0x43, 0x8a, 0xf2, 0xab, 0x66, 0xbc, 0x18, 0x14, 0xd5,
-	0x36, 0xaf, 0xc2, 0xba, 0x46, 0xf6, 0x69, 0xec, 0xf6, 0xa2, 0x69, 0xfa, 0xef, 0x10, 0x6c, 0xcc,
-	0x5e, 0xa3, 0xd8, 0x63, 0x50, 0x05, 0xba, 0x44, 0xbe, 0x3f, 0x3e, 0xf2, 0xf3, 0xc1, 0xe1, 0xba,
-	0xad, 0x5f, 0x01, 0x2a, 0x02, 0x0a, 0x3f, 0x47, 0xf0, 0x9a, 0x36, 0x4d, 0xb6, 0xa3, 0x08, 0xb7,
-	0xe7, 0x55, 0x9b, 0x7d, 0x64, 0x18, 0x77, 0x96, 0x8a, 0x95, 0x2e, 0x98, 0x5b, 0xdf, 0xfc, 0xf1,
-	0xef, 0xf7, 0x27, 0xae, 0xe3, 0x4d, 0x71, 0x70, 0x6d, 0xc9, 0x33, 0x6c, 0xd6, 0x61, 0xc9, 0xf0,
-	0x6f, 0x08, 0xaa, 0x5a, 0x9a, 0x92, 0xdc, 0x85, 0x43, 0xbc, 0x24, 0x77, 0xf1, 0x0c, 0x37, 0xdb,
-	0x82, 0xfb, 0x16, 0x6e, 0x95, 0xe2, 0xb6, 0x9f, 0xe6, 0x1f, 0xd6, 0x33, 0xfc, 0x13, 0x82, 0x73,
-	0xf9, 0x20, 0xc8, 0xec, 0xff, 0xa0, 0xac, 0x85, 0x87, 0x06, 0x98, 0xd1, 0x5e, 0x26, 0x54, 0x89,
-	0x78, 0x47, 0x88, 0xd8, 0xc4, 0xd7, 0x66, 0x89, 0xd0, 0x26, 0x1c, 0xfe, 0x19, 0x01, 0xe4, 0x39,
-	0x4a, 0x22, 0x17, 0xcd, 0x5c, 0xa3, 0xbd, 0x4c, 0xa8, 0x42, 0xbe, 0x2d, 0x90, 0x6f, 0x62, 0xab,
-	0x04, 0xb2, 0xfd, 0x74, 0x3c, 0x3e, 0x9f, 0xe1, 0x1f, 0x10, 0x54, 0xc4, 0x74, 0xc2, 0xcd, 0x52,
-	0xd5, 0xf5, 0x41, 0x6c, 0xb4, 0x16, 0x09, 0x51, 0xa0, 0x9b, 0x02, 0x74, 0x1d, 0x5f, 0x99, 0x05,
-	0x9a, 0x08, 0x9a, 0x3f, 0x11, 0x5c, 0x2c, 0x9c, 0x71, 0x78, 0xbb, 0x54, 0xd1, 0xa3, 0xa6, 0xa8,
-	0xd1, 0xf9, 0x3f, 0x29, 0x94, 0x8e, 0xf7, 0x85, 0x8e, 0x26, 0xb6, 0x67, 0xe9, 0x98, 0x31, 0x80,
-	0xf1, 0xef, 0x08, 0xce, 0x17, 0xcc, 0x3f, 0xfc, 0xd1, 0x02, 0x50, 0x45, 0xd3, 0xd5, 0xf8, 0x78,
-	0xf9, 0x04, 0x4a, 0xd3, 0x7b, 0x42, 0x93, 0x8d, 0xb7, 0xe6, 0x68, 0x9a, 0x1c, 0xcc, 0x9d, 0xbb,
-	0x2f, 0xf6, 0xeb, 0xe8, 0xe5, 0x7e, 0x1d, 0xfd, 0xb3, 0x5f, 0x47, 0xdf, 0x1e, 0xd4, 0x57, 0x5e,
-	0x1e, 0xd4, 0x57, 0xfe, 0x3a, 0xa8, 0xaf, 0x7c, 0x65, 0xf9, 0x21, 0x0f, 0x06, 0x3d, 0xcb, 0xa3,
-	0x8f, 0xf4, 0x94, 0x31, 0xed, 0x13, 0x7b, 0x34, 0x91, 0x99, 0x3f, 0x49, 0x08, 0xeb, 0x9d, 0x12,
-	0x7f, 0xc5, 0xdf, 0xfd, 0x2f, 0x00, 0x00, 0xff, 0xff, 0xc6, 0xb3, 0x80, 0x2a, 0xd6, 0x0c, 0x00,
-	0x00,
+	// 1015 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x97, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xc7, 0x33, 0x4d, 0xdd, 0x1f, 0xcf, 0x29, 0x82, 0x69, 0xab, 0x3a, 0x5b, 0xea, 0xa4, 0x5b,
+	0x42, 0xab, 0xa0, 0xec, 0x26, 0xa6, 0x3f, 0x1d, 0x09, 0x48, 0x2a, 0x48, 0x2b, 0x84, 0x94, 0x6e,
+	0xe1, 0xc2, 0xc5, 0x1a, 0xaf, 0xa7, 0xeb, 0x55, 0xb6, 0x3b, 0xdb, 0xdd, 0x49, 0xe4, 0x52, 0xf5,
+	0xc2, 0x5f, 0x80, 0x40, 0x48, 0xfc, 0x1f, 0x5c, 0x2a, 0xa1, 0x5e, 0x38, 0xf5, 0x46, 0x25, 0x24,
+	0xe0, 0x84, 0x50, 0xc2, 0xff, 0x01, 0xda, 0xd9, 0xe7, 0x7a, 0x1c, 0xaf, 0xe3, 0xb5, 0x93, 0x93,
+	0xf7, 0xc7, 0x7c, 0xdf, 0xfb, 0x7c, 0xdf, 0x9b, 0x9d, 0x97, 0xc0, 0xe2, 0x37, 0x5c, 0x32, 0xb7,
+	0xcd, 0xfc, 0xd0, 0x56, 0x57, 0x22, 0xe6, 0x76, 0xe0, 0x7b, 0x6d, 0xe9, 0x06, 0x3e, 0x0f, 0xa5,
+	0xfd, 0x64, 0x9b, 0xc7, 0x4f, 0xad, 0x28, 0x16, 0x52, 0xd0, 0xea, 0x9b, 0xb5, 0x56, 0x77, 0xad,
+	0xa5, 0xad, 0x35, 0x16, 0x5d, 0x91, 0x3c, 0x16, 0x89, 0xdd, 0x64, 0x09, 0xcf, 0x84, 0xf6, 0xce,
+	0x4a, 0x93, 0x4b, 0xb6, 0x62, 0x47, 0xcc, 0xf3, 0x43, 0x26, 0x7d, 0x11, 0x66, 0xb1, 0x8c, 0x73,
+	0x9e, 0xf0, 0x84, 0xba, 0xb4, 0xd3, 0x2b, 0x7c, 0xfa, 0xae, 0x27, 0x84, 0x17, 0x70, 0x9b, 0x45,
+	0xbe, 0xcd, 0xc2, 0x50, 0x48, 0x25, 0x49, 0xf0, 0xed, 0xad, 0x11, 0xac, 0xcd, 0x40, 0xb8, 0x5b,
+	0x8d, 0x36, 0x67, 0x2d, 0x1e, 0x37, 0x12, 0xc9, 0x24, 0x47, 0xe1, 0x47, 0xe3, 0x08, 0x77, 0x78,
+	0xec, 0x3f, 0xf2, 0x5d, 0x1d, 0x76, 0x79, 0x84, 0x5e, 0xbd, 0xea, 0xcb, 0x98, 0x57, 0xd6, 0x68,
+	0xcb, 0xb3, 0xa3, 0x58, 0x88, 0x47, 0x09, 0xfe, 0x64, 0x6b, 0xcd, 0x16, 0x18, 0x0f, 0xd2, 0x62,
+	0xad, 0x05, 0xc1, 0x7a, 0x0a, 0x72, 0x4f, 0x71, 0x38, 0xfc, 0xc9, 0x36, 0x4f, 0x24, 0xfd, 0x0c,
+	0xa0, 0x57, 0xbc, 0x0a, 0x99, 0x27, 0xd7, 0xca, 0xb5, 0xf7, 0xad, 0xac, 0xd2, 0x56, 0x5a, 0x69,
+	0x2b, 0x6b, 0x11, 0x56, 0xda, 0xda, 0x64, 0x1e, 0x47, 0xad, 0xa3, 0x29, 0xcd, 0x97, 0x04, 0x2e,
+	0xe6, 0xa6, 0x49, 0x22, 0x11, 0x26, 0x9c, 0x7e, 0x05, 0x67, 0xf4, 0x32, 0x24, 0x15, 0x32, 0x3f,
+	0x7d, 0xad, 0x5c, 0x5b, 0xb4, 0x72, 0x9a, 0x1e, 0x6d, 0x79, 0x16, 0x5a, 0xd0, 0x42, 0xad, 0x1f,
+	0x7f, 0xf5, 0xf7, 0xdc, 0x94, 0x33, 0xd3, 0xec, 0x3d, 0x4a, 0xe8, 0x46, 0x1f, 0xfe, 0x31, 0x85,
+	0x7f, 0x75, 0x24, 0x7e, 0xc6, 0xd4, 0xc7, 0xbf, 0x8a, 0x55, 0xda, 0xe0, 0x32, 0xa7, 0x4a, 0x97,
+	0x00, 0x90, 0x9e, 0x25, 0x6d, 0x55, 0xa5, 0x19, 0xe7, 0x74, 0x06, 0xc2, 0x92, 0xb6, 0x19, 0xc0,
+	0xc5, 0x5c, 0x31, 0x7a, 0xff, 0x02, 0x66, 0x74, 0xef, 0x58, 0xe5, 0x31, 0xac, 0x3b, 0x65, 0xcd,
+	0xb4, 0xe9, 0xc2, 0x6c, 0xb7, 0xd2, 0x77, 0x53, 0xf5, 0x43, 0xc9, 0x24, 0x3f, 0xea, 0x7e, 0xbe,
+	0x20, 0x60, 0xe4, 0x65, 0x41, 0x4b, 0x0f, 0xa0, 0xac, 0xed, 0xca, 0x83, 0x9a, 0xa9, 0x6d, 0x64,
+	0xab, 0x17, 0x08, 0x9b, 0x09, 0xee, 0x9b, 0x27, 0x47, 0xd7, 0xca, 0x9b, 0x58, 0x9f, 0x0d, 0x2e,
+	0x07, 0xeb, 0x33, 0x0b, 0xa7, 0x32, 0x70, 0xbf, 0xa5, 0xaa, 0x33, 0xed, 0x9c, 0x54, 0xf7, 0xf7,
+	0x5b, 0xa6, 0x0f, 0x46, 0x9e, 0x0e, 0x1d, 0x7f, 0xbe, 0xdf, 0x31, 0x19, 0xcf, 0xb1, 0xee, 0xd5,
+	0xbc, 0x01, 0x15, 0x95, 0xea, 0x2e, 0x0b, 0x45, 0xe8, 0xbb, 0x2c, 0xf8, 0xd2, 0x8f, 0x0a, 0x10,
+	0xfe, 0x41, 0x60, 0x36, 0x47, 0x87, 0x84, 0x0f, 0x0f, 0xbb, 0xcd, 0xb0, 0x29, 0xfa, 0x66, 0xa3,
+	0x2d, 0xa0, 0x83, 0xe7, 0x1e, 0x76, 0x67, 0x79, 0x94, 0x7b, 0x2d, 0xb6, 0xde, 0xf5, 0xb7, 0x9b,
+	0xfb, 0x9e, 0xa7, 0xa7, 0xc7, 0x3b, 0xca, 0xd8, 0x66, 0x2c, 0x76, 0x0a, 0xf4, 0x8a, 0x5e, 0x80,
+	0x93, 0xb2, 0x93, 0x7d, 0x8d, 0x29, 0xcb, 0x69, 0xe7, 0x84, 0xec, 0xa4, 0x9f, 0x22, 0xad, 0x43,
+	0x49, 0x19, 0xab, 0x4c, 0x2b, 0xc4, 0xf7, 0x46, 0xb8, 0xdf, 0x4c, 0x7f, 0x9c, 0x4c, 0xb2, 0xef,
+	0x2b, 0x3f, 0xae, 0xe2, 0xf6, 0xbe, 0xf2, 0x14, 0x47, 0x76, 0x1a, 0x7e, 0xd8, 0xe2, 0x9d, 0x4a,
+	0x29, 0xc3, 0x91, 0x9d, 0xfb, 0xe9, 0xad, 0xb9, 0x08, 0x54, 0xc7, 0xc7, 0x86, 0x9c, 0x83, 0xd2,
+	0x0e, 0x0b, 0x10, 0xfe, 0x94, 0x93, 0xdd, 0x98, 0x57, 0xe0, 0xb2, 0x5a, 0x8b, 0xfe, 0xb7, 0xa3,
+	0x48, 0xc4, 0x92, 0xb7, 0xd4, 0x4e, 0x49, 0xd0, 0xba, 0xf9, 0x13, 0x01, 0xf3, 0xa0, 0x55, 0x98,
+	0x21, 0x86, 0x0b, 0xdd, 0xbe, 0x74, 0x57, 0x34, 0x94, 0xd9, 0xee, 0xf9, 0x7a, 0x7d, 0x54, 0x8b,
+	0xf2, 0xe2, 0x63, 0x9b, 0xce, 0xb7, 0xf3, 0x72, 0x9b, 0x97, 0x61, 0x4e, 0x23, 0xfb, 0x34, 0x64,
+	0xcd, 0x60, 0x3f, 0xfd, 0xf7, 0x04, 0xe6, 0x87, 0xaf, 0x41, 0xf6, 0x10, 0x30, 0x41, 0x83, 0x67,
+	0xef, 0x8f, 0x8e, 0xfc, 0x6c, 0x7b, 0x30, 0x6f, 0xed, 0xbf, 0x32, 0x94, 0x14, 0x14, 0x7d, 0x41,
+	0xe0, 0x2d, 0x6d, 0x6b, 0xae, 0x05, 0x01, 0xad, 0x8f, 0xca, 0x36, 0x7c, 0x84, 0x1a, 0xab, 0x13,
+	0x69, 0xb3, 0x2a, 0x98, 0x4b, 0xdf, 0xfe, 0xfe, 0xef, 0x0f, 0xc7, 0xae, 0xd2, 0x05, 0x35, 0xc8,
+	0x97, 0xb2, 0x99, 0x3e, 0xec, 0x8f, 0x87, 0x84, 0xfe, 0x4a, 0xa0, 0xac, 0x85, 0x29, 0xc8, 0x9d,
+	0x3b, 0xd4, 0x8c, 0xd5, 0x89, 0xb4, 0xc8, 0x5d, 0x57, 0xdc, 0xd7, 0x69, 0xad, 0x10, 0xb7, 0xfd,
+	0xac, 0xf7, 0x61, 0x3d, 0xa7, 0x3f, 0x13, 0x38, 0xd3, 0x3b, 0x18, 0xd3, 0xf2, 0xdf, 0x29, 0x5a,
+	0xc2, 0x81, 0x03, 0xdd, 0xa8, 0x4f, 0x22, 0x45, 0x13, 0x1f, 0x28, 0x13, 0x0b, 0xf4, 0xca, 0x30,
+	0x13, 0xda, 0x89, 0x4f, 0x7f, 0x21, 0x00, 0xbd, 0x18, 0x05, 0x91, 0xf3, 0x66, 0x90, 0x51, 0x9f,
+	0x44, 0x8a, 0xc8, 0x37, 0x15, 0xf2, 0x32, 0xb5, 0x0a, 0x20, 0xdb, 0xcf, 0xba, 0xc7, 0xe7, 0x73,
+	0xfa, 0x92, 0xc0, 0x8c, 0x3e, 0x35, 0xe8, 0xed, 0x42, 0x10, 0x39, 0x03, 0xca, 0xb8, 0x33, 0x81,
+	0x12, 0xe9, 0x6f, 0x2b, 0xfa, 0x1a, 0x5d, 0x1e, 0x4a, 0xdf, 0x55, 0x35, 0xa4, 0x1f, 0xe9, 0xfc,
+	0x3f, 0x12, 0x28, 0xa9, 0xd3, 0x95, 0xae, 0x14, 0x4a, 0xaf, 0x0f, 0x12, 0xa3, 0x36, 0x8e, 0x04,
+	0x51, 0x17, 0x14, 0xea, 0x1c, 0xbd, 0x34, 0x0c, 0x35, 0x52, 0x34, 0x7f, 0x12, 0x38, 0x9f, 0x7b,
+	0x46, 0xd3, 0xb5, 0x42, 0x49, 0x0f, 0x9a, 0x02, 0xc6, 0xfa, 0x61, 0x42, 0xa0, 0x8f, 0x5b, 0xca,
+	0xc7, 0x0a, 0xb5, 0x87, 0xf9, 0x18, 0x32, 0x40, 0xe8, 0x6f, 0x04, 0xce, 0xe6, 0x9c, 0xdf, 0xf4,
+	0xe3, 0x31, 0xa0, 0xf2, 0xa6, 0x83, 0xf1, 0xc9, 0xe4, 0x01, 0xd0, 0xd3, 0x0d, 0xe5, 0xc9, 0xa6,
+	0x4b, 0x23, 0x3c, 0xf5, 0x0f, 0x96, 0xf5, 0x7b, 0xaf, 0x76, 0xab, 0xe4, 0xf5, 0x6e, 0x95, 0xfc,
+	0xb3, 0x5b, 0x25, 0xdf, 0xed, 0x55, 0xa7, 0x5e, 0xef, 0x55, 0xa7, 0xfe, 0xda, 0xab, 0x4e, 0x7d,
+	0x6d, 0x79, 0xbe, 0x6c, 0x6f, 0x37, 0x2d, 0x57, 0x3c, 0xd6, 0x43, 0x86, 0xa2, 0xc5, 0xed, 0x4e,
+	0x5f, 0x64, 0xf9, 0x34, 0xe2, 0x49, 0xf3, 0x84, 0xfa, 0xd7, 0xea, 0xc3, 0xff, 0x07, 0x00, 0xe8,
+	0x20, 0xb2, 0x27, 0xdf, 0x0e, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -779,6 +882,7 @@ type QueryClient interface {
 	BlockHeader(ctx context.Context, in *QueryGetBlockHeaderRequest, opts ...grpc.CallOption) (*QueryGetBlockHeaderResponse, error)
 	ChainStateAll(ctx context.Context, in *QueryAllChainStateRequest, opts ...grpc.CallOption) (*QueryAllChainStateResponse, error)
 	ChainState(ctx context.Context, in *QueryGetChainStateRequest, opts ...grpc.CallOption) (*QueryGetChainStateResponse, error)
+	CanonicalTip(ctx context.Context, in *QueryCanonicalTipRequest, opts ...grpc.CallOption) (*QueryCanonicalTipResponse, error)
 	Prove(ctx context.Context, in *QueryProveRequest, opts ...grpc.CallOption) (*QueryProveResponse, error)
 	HeaderSupportedChains(ctx context.Context, in *QueryHeaderSupportedChainsRequest, opts ...grpc.CallOption) (*QueryHeaderSupportedChainsResponse, error)
 	HeaderEnabledChains(ctx context.Context, in *QueryHeaderEnabledChainsRequest, opts ...grpc.CallOption) (*QueryHeaderEnabledChainsResponse, error)
@@ -828,6 +932,15 @@ func (c *queryClient) ChainState(ctx context.Context, in *QueryGetChainStateRequ
 	return out, nil
 }
 
+func (c *queryClient) CanonicalTip(ctx context.Context, in *QueryCanonicalTipRequest, opts ...grpc.CallOption) (*QueryCanonicalTipResponse, error) {
+	out := new(QueryCanonicalTipResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.lightclient.Query/CanonicalTip", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Prove(ctx context.Context, in *QueryProveRequest, opts ...grpc.CallOption) (*QueryProveResponse, error) {
 	out := new(QueryProveResponse)
 	err := c.cc.Invoke(ctx, "/zetachain.zetacore.lightclient.Query/Prove", in, out, opts...)
@@ -861,6 +974,7 @@ type QueryServer interface {
 	BlockHeader(context.Context, *QueryGetBlockHeaderRequest) (*QueryGetBlockHeaderResponse, error)
 	ChainStateAll(context.Context, *QueryAllChainStateRequest) (*QueryAllChainStateResponse, error)
 	ChainState(context.Context, *QueryGetChainStateRequest) (*QueryGetChainStateResponse, error)
+	CanonicalTip(context.Context, *QueryCanonicalTipRequest) (*QueryCanonicalTipResponse, error)
 	Prove(context.Context, *QueryProveRequest) (*QueryProveResponse, error)
 	HeaderSupportedChains(context.Context, *QueryHeaderSupportedChainsRequest) (*QueryHeaderSupportedChainsResponse, error)
 	HeaderEnabledChains(context.Context, *QueryHeaderEnabledChainsRequest) (*QueryHeaderEnabledChainsResponse, error)
@@ -882,6 +996,9 @@ func (*UnimplementedQueryServer) ChainStateAll(ctx context.Context, req *QueryAl
 func (*UnimplementedQueryServer) ChainState(ctx context.Context, req *QueryGetChainStateRequest) (*QueryGetChainStateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ChainState not implemented")
 }
+func (*UnimplementedQueryServer) CanonicalTip(ctx context.Context, req *QueryCanonicalTipRequest) (*QueryCanonicalTipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CanonicalTip not implemented")
+}
 func (*UnimplementedQueryServer) Prove(ctx context.Context, req *QueryProveRequest) (*QueryProveResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Prove not implemented")
 }
@@ -968,6 +1085,24 @@ func _Query_ChainState_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_CanonicalTip_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCanonicalTipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CanonicalTip(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.lightclient.Query/CanonicalTip",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CanonicalTip(ctx, req.(*QueryCanonicalTipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Prove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryProveRequest)
 	if err := dec(in); err != nil {
@@ -1042,6 +1177,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ChainState",
 			Handler:    _Query_ChainState_Handler,
 		},
+		{
+			MethodName: "CanonicalTip",
+			Handler:    _Query_CanonicalTip_Handler,
+		},
 		{
 			MethodName: "Prove",
 			Handler:    _Query_Prove_Handler,
@@ -1355,6 +1494,77 @@ func (m *QueryGetChainStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryCanonicalTipRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCanonicalTipRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCanonicalTipRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCanonicalTipResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCanonicalTipResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCanonicalTipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.BlockHeaderState.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.BlockHeader.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryProveRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1693,6 +1903,31 @@ func (m *QueryGetChainStateResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryCanonicalTipRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	return n
+}
+
+func (m *QueryCanonicalTipResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BlockHeader.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.BlockHeaderState.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func (m *QueryProveRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2523,6 +2758,191 @@ func (m *QueryGetChainStateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryCanonicalTipRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCanonicalTipRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCanonicalTipRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCanonicalTipResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCanonicalTipResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCanonicalTipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BlockHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeaderState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BlockHeaderState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryProveRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -204,11 +204,109 @@ func (m *MsgDisableHeaderVerificationResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgDisableHeaderVerificationResponse proto.InternalMessageInfo
 
+type MsgUpdateHeaderRetention struct {
+	Creator         string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ChainId         int64  `protobuf:"varint,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	HeaderRetention int64  `protobuf:"varint,3,opt,name=header_retention,json=headerRetention,proto3" json:"header_retention,omitempty"`
+}
+
+func (m *MsgUpdateHeaderRetention) Reset()         { *m = MsgUpdateHeaderRetention{} }
+func (m *MsgUpdateHeaderRetention) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateHeaderRetention) ProtoMessage()    {}
+func (*MsgUpdateHeaderRetention) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6fec9f445d2bf2d1, []int{4}
+}
+func (m *MsgUpdateHeaderRetention) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateHeaderRetention) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateHeaderRetention.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateHeaderRetention) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateHeaderRetention.Merge(m, src)
+}
+func (m *MsgUpdateHeaderRetention) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateHeaderRetention) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateHeaderRetention.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateHeaderRetention proto.InternalMessageInfo
+
+func (m *MsgUpdateHeaderRetention) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgUpdateHeaderRetention) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *MsgUpdateHeaderRetention) GetHeaderRetention() int64 {
+	if m != nil {
+		return m.HeaderRetention
+	}
+	return 0
+}
+
+type MsgUpdateHeaderRetentionResponse struct {
+}
+
+func (m *MsgUpdateHeaderRetentionResponse) Reset()         { *m = MsgUpdateHeaderRetentionResponse{} }
+func (m *MsgUpdateHeaderRetentionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateHeaderRetentionResponse) ProtoMessage()    {}
+func (*MsgUpdateHeaderRetentionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6fec9f445d2bf2d1, []int{5}
+}
+func (m *MsgUpdateHeaderRetentionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateHeaderRetentionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateHeaderRetentionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateHeaderRetentionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateHeaderRetentionResponse.Merge(m, src)
+}
+func (m *MsgUpdateHeaderRetentionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateHeaderRetentionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateHeaderRetentionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateHeaderRetentionResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgEnableHeaderVerification)(nil), "zetachain.zetacore.lightclient.MsgEnableHeaderVerification")
 	proto.RegisterType((*MsgEnableHeaderVerificationResponse)(nil), "zetachain.zetacore.lightclient.MsgEnableHeaderVerificationResponse")
 	proto.RegisterType((*MsgDisableHeaderVerification)(nil), "zetachain.zetacore.lightclient.MsgDisableHeaderVerification")
 	proto.RegisterType((*MsgDisableHeaderVerificationResponse)(nil), "zetachain.zetacore.lightclient.MsgDisableHeaderVerificationResponse")
+	proto.RegisterType((*MsgUpdateHeaderRetention)(nil), "zetachain.zetacore.lightclient.MsgUpdateHeaderRetention")
+	proto.RegisterType((*MsgUpdateHeaderRetentionResponse)(nil), "zetachain.zetacore.lightclient.MsgUpdateHeaderRetentionResponse")
 }
 
 func init() {
@@ -216,28 +314,33 @@ func init() {
 }
 
 var fileDescriptor_6fec9f445d2bf2d1 = []byte{
-	// 334 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xaf, 0x4a, 0x2d, 0x49,
-	0x4c, 0xce, 0x48, 0xcc, 0xcc, 0xd3, 0x07, 0xb3, 0xf2, 0x8b, 0x52, 0xf5, 0x73, 0x32, 0xd3, 0x33,
-	0x4a, 0x92, 0x73, 0x32, 0x53, 0xf3, 0x4a, 0xf4, 0x4b, 0x2a, 0xf4, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2,
-	0x85, 0xe4, 0xe0, 0x0a, 0xf5, 0x60, 0x0a, 0xf5, 0x90, 0x14, 0x4a, 0x89, 0xa4, 0xe7, 0xa7, 0xe7,
-	0x83, 0x95, 0xea, 0x83, 0x58, 0x10, 0x5d, 0x52, 0x76, 0x04, 0x8c, 0x4f, 0xca, 0xc9, 0x4f, 0xce,
-	0x8e, 0xcf, 0x48, 0x4d, 0x4c, 0x49, 0x2d, 0x8a, 0x2f, 0x4b, 0x2d, 0xca, 0x4c, 0xcb, 0x4c, 0x4e,
-	0x2c, 0xc9, 0xcc, 0xcf, 0x83, 0xe8, 0x57, 0x8a, 0xe6, 0x92, 0xf6, 0x2d, 0x4e, 0x77, 0xcd, 0x4b,
-	0x4c, 0xca, 0x49, 0xf5, 0x00, 0xab, 0x0a, 0x43, 0x52, 0x24, 0x24, 0xc1, 0xc5, 0x9e, 0x5c, 0x94,
-	0x9a, 0x58, 0x92, 0x5f, 0x24, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0x19, 0x04, 0xe3, 0x0a, 0x29, 0x71,
-	0xf1, 0x82, 0xad, 0x8d, 0xcf, 0x4c, 0x89, 0xcf, 0xc9, 0x2c, 0x2e, 0x91, 0x60, 0x52, 0x60, 0xd6,
-	0x60, 0x0e, 0xe2, 0x06, 0x0b, 0x7a, 0xa6, 0xf8, 0x64, 0x16, 0x97, 0x28, 0xa9, 0x72, 0x29, 0xe3,
-	0x31, 0x3c, 0x28, 0xb5, 0xb8, 0x20, 0x3f, 0xaf, 0x38, 0x55, 0x29, 0x86, 0x4b, 0xc6, 0xb7, 0x38,
-	0xdd, 0x25, 0xb3, 0x98, 0x26, 0x8e, 0x50, 0xe3, 0x52, 0xc1, 0x67, 0x3a, 0xcc, 0x15, 0x46, 0xc7,
-	0x98, 0xb8, 0x98, 0x7d, 0x8b, 0xd3, 0x85, 0xe6, 0x30, 0x72, 0x49, 0xe0, 0x0c, 0x0f, 0x6b, 0x3d,
-	0xfc, 0xb1, 0xa4, 0x87, 0xc7, 0xbf, 0x52, 0xce, 0x14, 0x68, 0x86, 0x39, 0x53, 0x68, 0x3e, 0x23,
-	0x97, 0x24, 0xee, 0xa0, 0xb2, 0x21, 0xc2, 0x0a, 0x9c, 0xba, 0xa5, 0x5c, 0x28, 0xd1, 0x0d, 0x73,
-	0xa1, 0x93, 0xc7, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38,
-	0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0xe9, 0xa5, 0x67,
-	0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0x82, 0x53, 0xab, 0x2e, 0x24, 0xe1, 0xe6, 0xe5,
-	0xa7, 0xa4, 0xea, 0x57, 0xa0, 0xe6, 0x8a, 0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36, 0x70, 0x1a, 0x35,
-	0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0x8a, 0xd2, 0xa9, 0x28, 0x44, 0x03, 0x00, 0x00,
+	// 411 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x94, 0x4f, 0x4b, 0xe3, 0x40,
+	0x18, 0xc6, 0x3b, 0x0d, 0x6c, 0x77, 0x67, 0x59, 0x76, 0x09, 0xbb, 0x90, 0x66, 0x97, 0x10, 0xb2,
+	0xbb, 0x5a, 0x0f, 0x26, 0xa0, 0x17, 0x41, 0x11, 0xd1, 0x0a, 0x15, 0xcc, 0x25, 0xa0, 0x07, 0x15,
+	0x42, 0xfe, 0x8c, 0x93, 0xc1, 0x98, 0x09, 0x99, 0x51, 0x6a, 0x3f, 0x85, 0xe0, 0x55, 0xf0, 0xeb,
+	0x78, 0xec, 0xd1, 0x83, 0x07, 0x69, 0xbf, 0x88, 0x38, 0x6d, 0x4a, 0x0b, 0x4d, 0x5a, 0x2c, 0xde,
+	0x26, 0xe1, 0x79, 0x9e, 0xf7, 0x47, 0x9e, 0xbc, 0x03, 0x97, 0x3b, 0x88, 0x7b, 0x41, 0xe4, 0x91,
+	0xc4, 0x12, 0x27, 0x9a, 0x21, 0x2b, 0x26, 0x38, 0xe2, 0x41, 0x4c, 0x50, 0xc2, 0x2d, 0xde, 0x36,
+	0xd3, 0x8c, 0x72, 0x2a, 0x6b, 0x23, 0xa1, 0x99, 0x0b, 0xcd, 0x31, 0xa1, 0xfa, 0x13, 0x53, 0x4c,
+	0x85, 0xd4, 0x7a, 0x3b, 0x0d, 0x5c, 0xea, 0xf6, 0x8c, 0x78, 0x3f, 0xa6, 0xc1, 0x85, 0x1b, 0x21,
+	0x2f, 0x44, 0x99, 0x7b, 0x8d, 0x32, 0x72, 0x4e, 0x02, 0x8f, 0x13, 0x9a, 0x0c, 0xfc, 0xc6, 0x29,
+	0xfc, 0x6d, 0x33, 0xbc, 0x9f, 0x78, 0x7e, 0x8c, 0x5a, 0x42, 0x75, 0x3c, 0x26, 0x92, 0x15, 0x58,
+	0x0b, 0x32, 0xe4, 0x71, 0x9a, 0x29, 0x40, 0x07, 0x8d, 0x2f, 0x4e, 0xfe, 0x28, 0x1b, 0xf0, 0x9b,
+	0x18, 0xeb, 0x92, 0xd0, 0x8d, 0x09, 0xe3, 0x4a, 0x55, 0x97, 0x1a, 0x92, 0xf3, 0x55, 0xbc, 0x3c,
+	0x08, 0x0f, 0x09, 0xe3, 0xc6, 0x7f, 0xf8, 0xb7, 0x24, 0xdc, 0x41, 0x2c, 0xa5, 0x09, 0x43, 0xc6,
+	0x19, 0xfc, 0x63, 0x33, 0xdc, 0x24, 0xec, 0x43, 0x20, 0x96, 0xe0, 0xbf, 0xb2, 0xf4, 0x11, 0x45,
+	0x07, 0x2a, 0x36, 0xc3, 0x47, 0x69, 0xe8, 0xf1, 0xa1, 0xcc, 0x41, 0x1c, 0x25, 0x33, 0x08, 0xea,
+	0xf0, 0x73, 0x4e, 0xa0, 0x54, 0x75, 0xd0, 0x90, 0x9c, 0xda, 0x70, 0xb8, 0xbc, 0x02, 0x7f, 0x0c,
+	0xbf, 0x7b, 0x96, 0x07, 0x29, 0x92, 0x90, 0x7c, 0x8f, 0x26, 0xf3, 0x0d, 0x03, 0xea, 0x45, 0xb3,
+	0x73, 0xbe, 0xb5, 0x67, 0x09, 0x4a, 0x36, 0xc3, 0xf2, 0x3d, 0x80, 0x4a, 0x61, 0x5f, 0x9b, 0x66,
+	0xf9, 0x5f, 0x64, 0x96, 0xf4, 0xa1, 0xee, 0x2d, 0x60, 0xce, 0x31, 0xe5, 0x07, 0x00, 0xeb, 0xc5,
+	0x55, 0x6e, 0xcd, 0x31, 0xa2, 0xd0, 0xad, 0x36, 0x17, 0x71, 0x8f, 0x08, 0xef, 0x00, 0xfc, 0x35,
+	0xbd, 0xe6, 0x8d, 0x39, 0xf2, 0xa7, 0x3a, 0xd5, 0x9d, 0xf7, 0x3a, 0x73, 0xaa, 0xdd, 0xd6, 0x63,
+	0x4f, 0x03, 0xdd, 0x9e, 0x06, 0x5e, 0x7a, 0x1a, 0xb8, 0xed, 0x6b, 0x95, 0x6e, 0x5f, 0xab, 0x3c,
+	0xf5, 0xb5, 0xca, 0x89, 0x89, 0x09, 0x8f, 0xae, 0x7c, 0x33, 0xa0, 0x97, 0x62, 0xc7, 0x57, 0x07,
+	0xeb, 0x9e, 0xd0, 0x10, 0x59, 0xed, 0xc9, 0xbb, 0xe4, 0x26, 0x45, 0xcc, 0xff, 0x24, 0x36, 0x7b,
+	0xfd, 0x75, 0x00, 0x9f, 0xf1, 0xf9, 0xf2, 0x7a, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -254,6 +357,7 @@ const _ = grpc.SupportPackageIsVersion4
 type MsgClient interface {
 	EnableHeaderVerification(ctx context.Context, in *MsgEnableHeaderVerification, opts ...grpc.CallOption) (*MsgEnableHeaderVerificationResponse, error)
 	DisableHeaderVerification(ctx context.Context, in *MsgDisableHeaderVerification, opts ...grpc.CallOption) (*MsgDisableHeaderVerificationResponse, error)
+	UpdateHeaderRetention(ctx context.Context, in *MsgUpdateHeaderRetention, opts ...grpc.CallOption) (*MsgUpdateHeaderRetentionResponse, error)
 }
 
 type msgClient struct {
@@ -282,10 +386,20 @@ func (c *msgClient) DisableHeaderVerification(ctx context.Context, in *MsgDisabl
 	return out, nil
 }
 
+func (c *msgClient) UpdateHeaderRetention(ctx context.Context, in *MsgUpdateHeaderRetention, opts ...grpc.CallOption) (*MsgUpdateHeaderRetentionResponse, error) {
+	out := new(MsgUpdateHeaderRetentionResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.lightclient.Msg/UpdateHeaderRetention", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	EnableHeaderVerification(context.Context, *MsgEnableHeaderVerification) (*MsgEnableHeaderVerificationResponse, error)
 	DisableHeaderVerification(context.Context, *MsgDisableHeaderVerification) (*MsgDisableHeaderVerificationResponse, error)
+	UpdateHeaderRetention(context.Context, *MsgUpdateHeaderRetention) (*MsgUpdateHeaderRetentionResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -298,6 +412,9 @@ func (*UnimplementedMsgServer) EnableHeaderVerification(ctx context.Context, req
 func (*UnimplementedMsgServer) DisableHeaderVerification(ctx context.Context, req *MsgDisableHeaderVerification) (*MsgDisableHeaderVerificationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DisableHeaderVerification not implemented")
 }
+func (*UnimplementedMsgServer) UpdateHeaderRetention(ctx context.Context, req *MsgUpdateHeaderRetention) (*MsgUpdateHeaderRetentionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateHeaderRetention not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -339,6 +456,24 @@ func _Msg_DisableHeaderVerification_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateHeaderRetention_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateHeaderRetention)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateHeaderRetention(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.lightclient.Msg/UpdateHeaderRetention",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateHeaderRetention(ctx, req.(*MsgUpdateHeaderRetention))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "zetachain.zetacore.lightclient.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -351,6 +486,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DisableHeaderVerification",
 			Handler:    _Msg_DisableHeaderVerification_Handler,
 		},
+		{
+			MethodName: "UpdateHeaderRetention",
+			Handler:    _Msg_UpdateHeaderRetention_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "zetachain/zetacore/lightclient/tx.proto",
@@ -500,6 +639,69 @@ func (m *MsgDisableHeaderVerificationResponse) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgUpdateHeaderRetention) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateHeaderRetention) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateHeaderRetention) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.HeaderRetention != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.HeaderRetention))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateHeaderRetentionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateHeaderRetentionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateHeaderRetentionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -569,6 +771,34 @@ func (m *MsgDisableHeaderVerificationResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgUpdateHeaderRetention) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovTx(uint64(m.ChainId))
+	}
+	if m.HeaderRetention != 0 {
+		n += 1 + sovTx(uint64(m.HeaderRetention))
+	}
+	return n
+}
+
+func (m *MsgUpdateHeaderRetentionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -991,6 +1221,176 @@ func (m *MsgDisableHeaderVerificationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgUpdateHeaderRetention) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateHeaderRetention: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateHeaderRetention: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeaderRetention", wireType)
+			}
+			m.HeaderRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HeaderRetention |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateHeaderRetentionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateHeaderRetentionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateHeaderRetentionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
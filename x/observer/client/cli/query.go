@@ -29,6 +29,7 @@ func GetQueryCmd(_ string) *cobra.Command {
 		CmdListNodeAccount(),
 		CmdShowNodeAccount(),
 		CmdShowCrosschainFlags(),
+		CmdListPausedCCTX(),
 		CmdShowKeygen(),
 		CmdShowObserverCount(),
 		CmdBlameByIdentifier(),
@@ -43,6 +44,9 @@ func GetQueryCmd(_ string) *cobra.Command {
 		CmdListPendingNonces(),
 		CmdGetAllTssFundsMigrator(),
 		CmdGetTssFundsMigrator(),
+		CmdShowLivenessParams(),
+		CmdListObserverLiveness(),
+		CmdShowObserverLiveness(),
 	)
 
 	return cmd
@@ -35,3 +35,29 @@ func CmdShowCrosschainFlags() *cobra.Command {
 
 	return cmd
 }
+
+func CmdListPausedCCTX() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-paused-cctx",
+		Short: "lists the chains and zrc20 tokens for which the inbound or outbound is paused",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryPausedCCTXRequest{}
+
+			res, err := queryClient.PausedCCTX(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
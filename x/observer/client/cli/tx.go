@@ -31,7 +31,11 @@ func GetTxCmd() *cobra.Command {
 		CmdVoteTSS(),
 		CmdEnableCCTX(),
 		CmdDisableCCTX(),
+		CmdPauseCCTX(),
+		CmdUnpauseCCTX(),
 		CmdUpdateGasPriceIncreaseFlags(),
+		CmdUpdateLivenessParams(),
+		CmdUnjailObserver(),
 	)
 
 	return cmd
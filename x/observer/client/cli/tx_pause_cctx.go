@@ -0,0 +1,120 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	"github.com/zeta-chain/node/x/observer/types"
+)
+
+const (
+	flagChainIDs       = "chain-ids"
+	flagZRC20Addresses = "zrc20-addresses"
+)
+
+func CmdPauseCCTX() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "pause-cctx [pause-inbound] [pause-outbound]",
+		Short:   "Pause inbound and outbound for CCTX of specific chains and ZRC20 tokens",
+		Example: `zetacored tx observer pause-cctx true false --chain-ids 1,56 --zrc20-addresses 0xece40cbB54d65282c4623f141c4a8a0bE7D6AdEc`,
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			pauseInbound, err := strconv.ParseBool(args[0])
+			if err != nil {
+				return err
+			}
+			pauseOutbound, err := strconv.ParseBool(args[1])
+			if err != nil {
+				return err
+			}
+			chainIDs, zrc20Addresses, err := getPauseTargets(cmd)
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgPauseCCTX(
+				clientCtx.GetFromAddress().String(),
+				chainIDs,
+				zrc20Addresses,
+				pauseInbound,
+				pauseOutbound,
+			)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	addPauseTargetFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdUnpauseCCTX() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "unpause-cctx [unpause-inbound] [unpause-outbound]",
+		Short:   "Unpause inbound and outbound for CCTX of specific chains and ZRC20 tokens",
+		Example: `zetacored tx observer unpause-cctx true true --chain-ids 1,56 --zrc20-addresses 0xece40cbB54d65282c4623f141c4a8a0bE7D6AdEc`,
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			unpauseInbound, err := strconv.ParseBool(args[0])
+			if err != nil {
+				return err
+			}
+			unpauseOutbound, err := strconv.ParseBool(args[1])
+			if err != nil {
+				return err
+			}
+			chainIDs, zrc20Addresses, err := getPauseTargets(cmd)
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgUnpauseCCTX(
+				clientCtx.GetFromAddress().String(),
+				chainIDs,
+				zrc20Addresses,
+				unpauseInbound,
+				unpauseOutbound,
+			)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	addPauseTargetFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func addPauseTargetFlags(cmd *cobra.Command) {
+	cmd.Flags().Int64Slice(flagChainIDs, nil, "comma separated list of chain ids")
+	cmd.Flags().StringSlice(flagZRC20Addresses, nil, "comma separated list of zrc20 addresses")
+}
+
+func getPauseTargets(cmd *cobra.Command) ([]int64, []string, error) {
+	chainIDs, err := cmd.Flags().GetInt64Slice(flagChainIDs)
+	if err != nil {
+		return nil, nil, err
+	}
+	zrc20Addresses, err := cmd.Flags().GetStringSlice(flagZRC20Addresses)
+	if err != nil {
+		return nil, nil, err
+	}
+	return chainIDs, zrc20Addresses, nil
+}
@@ -0,0 +1,63 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+
+	"github.com/zeta-chain/node/x/observer/types"
+)
+
+func CmdUpdateLivenessParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-liveness-params [enabled] [windowSize] [minVoteRatio] [jailDurationBlocks] [maxJailedObservers]",
+		Short: "Update the params used to track the observers liveness and jail inactive observers",
+		Args:  cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			enabled, err := strconv.ParseBool(args[0])
+			if err != nil {
+				return err
+			}
+			windowSize, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			minVoteRatio, err := sdk.NewDecFromStr(args[2])
+			if err != nil {
+				return err
+			}
+			jailDurationBlocks, err := strconv.ParseInt(args[3], 10, 64)
+			if err != nil {
+				return err
+			}
+			maxJailedObservers, err := strconv.ParseUint(args[4], 10, 64)
+			if err != nil {
+				return err
+			}
+			livenessParams := types.LivenessParams{
+				Enabled:            enabled,
+				WindowSize:         windowSize,
+				MinVoteRatio:       minVoteRatio,
+				JailDurationBlocks: jailDurationBlocks,
+				MaxJailedObservers: maxJailedObservers,
+			}
+			msg := types.NewMsgUpdateLivenessParams(clientCtx.GetFromAddress().String(), livenessParams)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -130,6 +130,15 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	for _, elem := range genState.NonceToCctx {
 		k.SetNonceToCctx(ctx, elem)
 	}
+
+	// Set if defined
+	if genState.LivenessParams != nil {
+		k.SetLivenessParams(ctx, *genState.LivenessParams)
+	}
+
+	for _, elem := range genState.ObserversLiveness {
+		k.SetObserverLiveness(ctx, elem)
+	}
 }
 
 // ExportGenesis returns the observer module's exported genesis.
@@ -185,6 +194,8 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		os = observers
 	}
 
+	livenessParams := k.GetLivenessParams(ctx)
+
 	return &types.GenesisState{
 		Ballots:           k.GetAllBallots(ctx),
 		ChainParamsList:   chainParams,
@@ -200,5 +211,7 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		BlameList:         k.GetAllBlame(ctx),
 		ChainNonces:       k.GetAllChainNonces(ctx),
 		NonceToCctx:       k.GetAllNonceToCctx(ctx),
+		LivenessParams:    &livenessParams,
+		ObserversLiveness: k.GetAllObserverLiveness(ctx),
 	}
 }
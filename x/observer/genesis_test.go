@@ -15,6 +15,7 @@ import (
 func TestGenesis(t *testing.T) {
 	t.Run("genState fields defined", func(t *testing.T) {
 		tss := sample.Tss()
+		livenessParams := sample.LivenessParams()
 		genesisState := types.GenesisState{
 			Tss:       &tss,
 			BlameList: sample.BlameRecordsList(t, 10),
@@ -39,9 +40,14 @@ func TestGenesis(t *testing.T) {
 				sample.ChainNonces(1),
 				sample.ChainNonces(2),
 			},
-			PendingNonces: sample.PendingNoncesList(t, "sample", 20),
-			NonceToCctx:   sample.NonceToCctxList(t, "sample", 20),
-			TssHistory:    []types.TSS{sample.Tss()},
+			PendingNonces:  sample.PendingNoncesList(t, "sample", 20),
+			NonceToCctx:    sample.NonceToCctxList(t, "sample", 20),
+			TssHistory:     []types.TSS{sample.Tss()},
+			LivenessParams: &livenessParams,
+			ObserversLiveness: []types.ObserverLiveness{
+				sample.ObserverLiveness(t, "0"),
+				sample.ObserverLiveness(t, "1"),
+			},
 		}
 
 		// Init and export
@@ -77,6 +83,7 @@ func TestGenesis(t *testing.T) {
 				zetaPrivnetChainParams,
 			},
 		}
+		defaultLivenessParams := types.DefaultLivenessParams()
 		expectedGenesisState := types.GenesisState{
 			CrosschainFlags:   types.DefaultCrosschainFlags(),
 			ChainParamsList:   localnetChainParams,
@@ -84,6 +91,7 @@ func TestGenesis(t *testing.T) {
 			Keygen:            &types.Keygen{},
 			LastObserverCount: &types.LastObserverCount{},
 			NodeAccountList:   []*types.NodeAccount{},
+			LivenessParams:    &defaultLivenessParams,
 		}
 
 		require.Equal(t, expectedGenesisState, *got)
@@ -116,6 +124,7 @@ func TestGenesis(t *testing.T) {
 		pendingNonces, err := k.GetAllPendingNonces(ctx)
 		require.NoError(t, err)
 		require.NotEmpty(t, pendingNonces)
+		defaultLivenessParams := types.DefaultLivenessParams()
 		expectedGenesisState := types.GenesisState{
 			CrosschainFlags:   types.DefaultCrosschainFlags(),
 			ChainParamsList:   localnetChainParams,
@@ -124,6 +133,7 @@ func TestGenesis(t *testing.T) {
 			LastObserverCount: &types.LastObserverCount{},
 			NodeAccountList:   []*types.NodeAccount{},
 			PendingNonces:     pendingNonces,
+			LivenessParams:    &defaultLivenessParams,
 		}
 
 		require.Equal(t, expectedGenesisState, *got)
@@ -135,6 +145,7 @@ func TestGenesis(t *testing.T) {
 		got := observer.ExportGenesis(ctx, *k)
 		require.NotNil(t, got)
 
+		defaultLivenessParams := types.DefaultLivenessParams()
 		expectedGenesisState := types.GenesisState{
 			CrosschainFlags:   types.DefaultCrosschainFlags(),
 			ChainParamsList:   types.ChainParamsList{},
@@ -148,6 +159,8 @@ func TestGenesis(t *testing.T) {
 			BlameList:         k.GetAllBlame(ctx),
 			ChainNonces:       k.GetAllChainNonces(ctx),
 			NonceToCctx:       k.GetAllNonceToCctx(ctx),
+			LivenessParams:    &defaultLivenessParams,
+			ObserversLiveness: k.GetAllObserverLiveness(ctx),
 		}
 
 		require.Equal(t, expectedGenesisState, *got)
@@ -65,3 +65,97 @@ func (k Keeper) AddBallotToList(ctx sdk.Context, ballot types.Ballot) {
 	list.BallotsIndexList = append(list.BallotsIndexList, ballot.BallotIdentifier)
 	k.SetBallotList(ctx, &list)
 }
+
+// DeleteBallot removes the ballot with the given index from the store
+func (k Keeper) DeleteBallot(ctx sdk.Context, index string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.VoterKey))
+	store.Delete(types.KeyPrefix(index))
+}
+
+// DeleteBallotList removes the ballot list for the given height from the store
+func (k Keeper) DeleteBallotList(ctx sdk.Context, height int64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BallotListKey))
+	store.Delete(types.BallotListKeyPrefix(height))
+}
+
+// GetAllBallotLists returns all the ballot lists stored
+func (k Keeper) GetAllBallotLists(ctx sdk.Context) (lists []types.BallotListForHeight) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BallotListKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.BallotListForHeight
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		lists = append(lists, val)
+	}
+	return
+}
+
+// PruneBallotList deletes the ballot list created at the given height and all its ballots
+// Ballots still in progress are deleted as well, the ballot prune height moves past the list and they would
+// never be visited again otherwise
+// If archive is true, an EventBallotArchived is emitted for each deleted ballot
+// It returns the number of deleted ballots
+func (k Keeper) PruneBallotList(ctx sdk.Context, height int64, archive bool) int {
+	list, found := k.GetBallotList(ctx, height)
+	if !found {
+		return 0
+	}
+
+	pruned := 0
+	for _, index := range list.BallotsIndexList {
+		ballot, found := k.GetBallot(ctx, index)
+		if !found {
+			continue
+		}
+		if archive {
+			EmitEventBallotArchived(ctx, ballot)
+		}
+		k.DeleteBallot(ctx, index)
+		pruned++
+	}
+
+	k.DeleteBallotList(ctx, height)
+	return pruned
+}
+
+// GetBallotPruneHeight returns the creation height of the next ballot list to prune
+func (k Keeper) GetBallotPruneHeight(ctx sdk.Context) int64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyPrefix(types.BallotPruneHeightKey))
+	if bz == nil {
+		return 0
+	}
+	// #nosec G115 always positive
+	return int64(sdk.BigEndianToUint64(bz))
+}
+
+// SetBallotPruneHeight sets the creation height of the next ballot list to prune
+func (k Keeper) SetBallotPruneHeight(ctx sdk.Context, height int64) {
+	store := ctx.KVStore(k.storeKey)
+	// #nosec G115 always positive
+	store.Set(types.KeyPrefix(types.BallotPruneHeightKey), sdk.Uint64ToBigEndian(uint64(height)))
+}
+
+// PruneBallotListsUntil prunes the ballot lists created from the ballot prune height up to the given height
+// At most MaxPrunedBallotLists ballot lists are visited per call and the call stops once MaxPrunedBallots ballots
+// are deleted, the next call resumes from the first ballot list not visited
+// It returns the number of deleted ballots
+func (k Keeper) PruneBallotListsUntil(ctx sdk.Context, height int64, archive bool) int {
+	pruneHeight := k.GetBallotPruneHeight(ctx)
+	if pruneHeight > height {
+		return 0
+	}
+
+	pruned := 0
+	for visited := 0; visited < types.MaxPrunedBallotLists && pruneHeight <= height; visited++ {
+		if pruned >= types.MaxPrunedBallots {
+			break
+		}
+		pruned += k.PruneBallotList(ctx, pruneHeight, archive)
+		pruneHeight++
+	}
+
+	k.SetBallotPruneHeight(ctx, pruneHeight)
+	return pruned
+}
@@ -118,3 +118,188 @@ func TestKeeper_GetAllBallots(t *testing.T) {
 	require.Equal(t, 1, len(ballots))
 	require.Equal(t, b, ballots[0])
 }
+
+func TestKeeper_DeleteBallot(t *testing.T) {
+	k, ctx, _, _ := keepertest.ObserverKeeper(t)
+	identifier := sample.ZetaIndex(t)
+	k.SetBallot(ctx, &types.Ballot{
+		BallotIdentifier:     identifier,
+		BallotThreshold:      sdk.ZeroDec(),
+		BallotCreationHeight: 1,
+	})
+
+	k.DeleteBallot(ctx, identifier)
+	_, found := k.GetBallot(ctx, identifier)
+	require.False(t, found)
+
+	// deleting a non-existent ballot is a no-op
+	k.DeleteBallot(ctx, identifier)
+}
+
+func TestKeeper_DeleteBallotList(t *testing.T) {
+	k, ctx, _, _ := keepertest.ObserverKeeper(t)
+	k.AddBallotToList(ctx, types.Ballot{BallotIdentifier: sample.ZetaIndex(t), BallotCreationHeight: 1})
+	k.AddBallotToList(ctx, types.Ballot{BallotIdentifier: sample.ZetaIndex(t), BallotCreationHeight: 2})
+
+	k.DeleteBallotList(ctx, 1)
+	_, found := k.GetBallotList(ctx, 1)
+	require.False(t, found)
+	_, found = k.GetBallotList(ctx, 2)
+	require.True(t, found)
+	require.Len(t, k.GetAllBallotLists(ctx), 1)
+}
+
+func TestKeeper_PruneBallotList(t *testing.T) {
+	setBallot := func(t *testing.T, k interface {
+		SetBallot(sdk.Context, *types.Ballot)
+		AddBallotToList(sdk.Context, types.Ballot)
+	}, ctx sdk.Context, height int64, status types.BallotStatus) types.Ballot {
+		ballot := types.Ballot{
+			BallotIdentifier:     sample.ZetaIndex(t),
+			BallotThreshold:      sdk.ZeroDec(),
+			BallotStatus:         status,
+			BallotCreationHeight: height,
+		}
+		k.SetBallot(ctx, &ballot)
+		k.AddBallotToList(ctx, ballot)
+		return ballot
+	}
+
+	t.Run("should delete finalized ballots and the empty list", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		b1 := setBallot(t, k, ctx, 1, types.BallotStatus_BallotFinalized_SuccessObservation)
+		b2 := setBallot(t, k, ctx, 1, types.BallotStatus_BallotFinalized_FailureObservation)
+
+		pruned := k.PruneBallotList(ctx, 1, false)
+
+		require.Equal(t, 2, pruned)
+		_, found := k.GetBallot(ctx, b1.BallotIdentifier)
+		require.False(t, found)
+		_, found = k.GetBallot(ctx, b2.BallotIdentifier)
+		require.False(t, found)
+		_, found = k.GetBallotList(ctx, 1)
+		require.False(t, found)
+		require.Empty(t, ctx.EventManager().Events())
+	})
+
+	t.Run("should delete ballots in progress with the list", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		finalized := setBallot(t, k, ctx, 1, types.BallotStatus_BallotFinalized_SuccessObservation)
+		inProgress := setBallot(t, k, ctx, 1, types.BallotStatus_BallotInProgress)
+
+		pruned := k.PruneBallotList(ctx, 1, false)
+
+		require.Equal(t, 2, pruned)
+		_, found := k.GetBallot(ctx, finalized.BallotIdentifier)
+		require.False(t, found)
+		_, found = k.GetBallot(ctx, inProgress.BallotIdentifier)
+		require.False(t, found)
+		_, found = k.GetBallotList(ctx, 1)
+		require.False(t, found)
+	})
+
+	t.Run("should emit archive events if enabled", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		setBallot(t, k, ctx, 1, types.BallotStatus_BallotFinalized_SuccessObservation)
+
+		pruned := k.PruneBallotList(ctx, 1, true)
+
+		require.Equal(t, 1, pruned)
+		events := ctx.EventManager().Events()
+		require.Len(t, events, 1)
+		require.Equal(t, "zetachain.zetacore.observer.EventBallotArchived", events[0].Type)
+	})
+
+	t.Run("should return zero if the list does not exist", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		require.Equal(t, 0, k.PruneBallotList(ctx, 1, false))
+	})
+
+	t.Run("should prune all lists until the given height", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		setBallot(t, k, ctx, 1, types.BallotStatus_BallotFinalized_SuccessObservation)
+		setBallot(t, k, ctx, 2, types.BallotStatus_BallotFinalized_SuccessObservation)
+		kept := setBallot(t, k, ctx, 3, types.BallotStatus_BallotFinalized_SuccessObservation)
+
+		pruned := k.PruneBallotListsUntil(ctx, 2, false)
+
+		require.Equal(t, 2, pruned)
+		require.Equal(t, []*types.Ballot{&kept}, k.GetAllBallots(ctx))
+		lists := k.GetAllBallotLists(ctx)
+		require.Len(t, lists, 1)
+		require.EqualValues(t, 3, lists[0].Height)
+		require.EqualValues(t, 3, k.GetBallotPruneHeight(ctx))
+	})
+
+	t.Run("should remove a ballot finalized after its creation height is passed", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		ballot := setBallot(t, k, ctx, 2, types.BallotStatus_BallotInProgress)
+
+		// the ballot is still in progress when the pruning passes its creation height
+		require.Equal(t, 0, k.PruneBallotListsUntil(ctx, 1, false))
+		_, found := k.GetBallot(ctx, ballot.BallotIdentifier)
+		require.True(t, found)
+
+		ballot.BallotStatus = types.BallotStatus_BallotFinalized_SuccessObservation
+		k.SetBallot(ctx, &ballot)
+
+		require.Equal(t, 1, k.PruneBallotListsUntil(ctx, 2, false))
+		require.Empty(t, k.GetAllBallots(ctx))
+		require.Empty(t, k.GetAllBallotLists(ctx))
+	})
+
+	t.Run("should not leak ballots still in progress once their list is pruned", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		setBallot(t, k, ctx, 1, types.BallotStatus_BallotInProgress)
+		kept := setBallot(t, k, ctx, 3, types.BallotStatus_BallotInProgress)
+
+		require.Equal(t, 1, k.PruneBallotListsUntil(ctx, 2, false))
+
+		require.Equal(t, []*types.Ballot{&kept}, k.GetAllBallots(ctx))
+		lists := k.GetAllBallotLists(ctx)
+		require.Len(t, lists, 1)
+		require.EqualValues(t, 3, lists[0].Height)
+		require.EqualValues(t, 3, k.GetBallotPruneHeight(ctx))
+	})
+
+	t.Run("should resume pruning from the ballot prune height", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		k.SetBallotPruneHeight(ctx, 2)
+		kept := setBallot(t, k, ctx, 1, types.BallotStatus_BallotFinalized_SuccessObservation)
+		setBallot(t, k, ctx, 2, types.BallotStatus_BallotFinalized_SuccessObservation)
+
+		pruned := k.PruneBallotListsUntil(ctx, 2, false)
+
+		require.Equal(t, 1, pruned)
+		require.Equal(t, []*types.Ballot{&kept}, k.GetAllBallots(ctx))
+		require.Equal(t, 0, k.PruneBallotListsUntil(ctx, 2, false))
+	})
+
+	t.Run("should visit at most MaxPrunedBallotLists ballot lists per call", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		height := int64(types.MaxPrunedBallotLists + 1)
+		setBallot(t, k, ctx, height, types.BallotStatus_BallotFinalized_SuccessObservation)
+
+		require.Equal(t, 0, k.PruneBallotListsUntil(ctx, height, false))
+		require.EqualValues(t, types.MaxPrunedBallotLists, k.GetBallotPruneHeight(ctx))
+
+		require.Equal(t, 1, k.PruneBallotListsUntil(ctx, height, false))
+		require.EqualValues(t, height+1, k.GetBallotPruneHeight(ctx))
+		require.Empty(t, k.GetAllBallots(ctx))
+	})
+
+	t.Run("should stop once MaxPrunedBallots ballots are deleted", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		for i := 0; i < types.MaxPrunedBallots; i++ {
+			setBallot(t, k, ctx, 1, types.BallotStatus_BallotFinalized_SuccessObservation)
+		}
+		setBallot(t, k, ctx, 2, types.BallotStatus_BallotFinalized_SuccessObservation)
+
+		require.Equal(t, types.MaxPrunedBallots, k.PruneBallotListsUntil(ctx, 2, false))
+		require.Len(t, k.GetAllBallots(ctx), 1)
+
+		require.Equal(t, 1, k.PruneBallotListsUntil(ctx, 2, false))
+		require.Empty(t, k.GetAllBallots(ctx))
+	})
+}
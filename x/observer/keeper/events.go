@@ -21,6 +21,40 @@ func EmitEventBallotCreated(ctx sdk.Context, ballot types.Ballot, observationHas
 	}
 }
 
+// EmitEventBallotArchived emits an event containing the full ballot before it is pruned from the state
+func EmitEventBallotArchived(ctx sdk.Context, ballot types.Ballot) {
+	err := ctx.EventManager().EmitTypedEvent(&types.EventBallotArchived{
+		Ballot: &ballot,
+	})
+	if err != nil {
+		ctx.Logger().Error("failed to emit EventBallotArchived : %s", err.Error())
+	}
+}
+
+// EmitEventObserverJailed emits an event when an observer is jailed for missing ballots
+func EmitEventObserverJailed(ctx sdk.Context, liveness types.ObserverLiveness, windowSize uint64) {
+	err := ctx.EventManager().EmitTypedEvent(&types.EventObserverJailed{
+		ObserverAddress: liveness.ObserverAddress,
+		MissedBallots:   liveness.MissedBallotsCounter,
+		WindowSize:      windowSize,
+		JailedUntil:     liveness.JailedUntil,
+	})
+	if err != nil {
+		ctx.Logger().Error("failed to emit EventObserverJailed : %s", err.Error())
+	}
+}
+
+// EmitEventObserverUnjailed emits an event when an observer is unjailed
+func EmitEventObserverUnjailed(ctx sdk.Context, observerAddress string) {
+	err := ctx.EventManager().EmitTypedEvent(&types.EventObserverUnjailed{
+		MsgTypeUrl:      sdk.MsgTypeURL(&types.MsgUnjailObserver{}),
+		ObserverAddress: observerAddress,
+	})
+	if err != nil {
+		ctx.Logger().Error("failed to emit EventObserverUnjailed : %s", err.Error())
+	}
+}
+
 func EmitEventKeyGenBlockUpdated(ctx sdk.Context, keygen *types.Keygen) {
 	err := ctx.EventManager().EmitTypedEvents(&types.EventKeygenBlockUpdated{
 		MsgTypeUrl:    sdk.MsgTypeURL(&types.MsgUpdateKeygen{}),
@@ -46,6 +46,7 @@ func (k Keeper) BallotByIdentifier(
 		voter := types.VoterList{
 			VoterAddress: voterAddress,
 			VoteType:     ballot.Votes[ballot.GetVoterIndex(voterAddress)],
+			VotingPower:  ballot.GetVoterPower(i),
 		}
 		votersList[i] = &voter
 	}
@@ -55,5 +56,6 @@ func (k Keeper) BallotByIdentifier(
 		Voters:           votersList,
 		ObservationType:  ballot.ObservationType,
 		BallotStatus:     ballot.BallotStatus,
+		Tally:            ballot.Tally(),
 	}, nil
 }
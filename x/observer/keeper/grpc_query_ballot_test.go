@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"testing"
 
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
@@ -128,10 +129,57 @@ func TestKeeper_BallotByIdentifier(t *testing.T) {
 				{
 					VoterAddress: voter,
 					VoteType:     types.VoteType_SuccessObservation,
+					VotingPower:  sdkmath.OneInt(),
 				},
 			},
 			ObservationType: ballot.ObservationType,
 			BallotStatus:    ballot.BallotStatus,
+			Tally: types.BallotTally{
+				SuccessPower:  sdkmath.OneInt(),
+				FailurePower:  sdkmath.ZeroInt(),
+				NotVotedPower: sdkmath.ZeroInt(),
+				TotalPower:    sdkmath.OneInt(),
+			},
 		}, res)
 	})
+
+	t.Run("should return the voting power of the voters if the ballot is weighted", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		wctx := sdk.WrapSDKContext(ctx)
+
+		voter1 := sample.AccAddress()
+		voter2 := sample.AccAddress()
+		ballot := types.Ballot{
+			Index:            "index",
+			BallotIdentifier: "index",
+			VoterList:        []string{voter1, voter2},
+			Votes:            []types.VoteType{types.VoteType_FailureObservation, types.VoteType_NotYetVoted},
+			VotingPower:      []sdkmath.Int{sdkmath.NewInt(300), sdkmath.NewInt(100)},
+			BallotStatus:     types.BallotStatus_BallotInProgress,
+		}
+		k.SetBallot(ctx, &ballot)
+
+		res, err := k.BallotByIdentifier(wctx, &types.QueryBallotByIdentifierRequest{
+			BallotIdentifier: "index",
+		})
+		require.NoError(t, err)
+		require.Equal(t, []*types.VoterList{
+			{
+				VoterAddress: voter1,
+				VoteType:     types.VoteType_FailureObservation,
+				VotingPower:  sdkmath.NewInt(300),
+			},
+			{
+				VoterAddress: voter2,
+				VoteType:     types.VoteType_NotYetVoted,
+				VotingPower:  sdkmath.NewInt(100),
+			},
+		}, res.Voters)
+		require.Equal(t, types.BallotTally{
+			SuccessPower:  sdkmath.ZeroInt(),
+			FailurePower:  sdkmath.NewInt(300),
+			NotVotedPower: sdkmath.NewInt(100),
+			TotalPower:    sdkmath.NewInt(400),
+		}, res.Tally)
+	})
 }
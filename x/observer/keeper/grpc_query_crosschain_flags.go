@@ -26,3 +26,24 @@ func (k Keeper) CrosschainFlags(
 
 	return &types.QueryGetCrosschainFlagsResponse{CrosschainFlags: val}, nil
 }
+
+// PausedCCTX returns the connected chains and ZRC20 tokens for which the inbound or outbound is paused
+func (k Keeper) PausedCCTX(
+	c context.Context,
+	req *types.QueryPausedCCTXRequest,
+) (*types.QueryPausedCCTXResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	flags, found := k.GetCrosschainFlags(ctx)
+	if !found {
+		return &types.QueryPausedCCTXResponse{}, nil
+	}
+
+	return &types.QueryPausedCCTXResponse{
+		PausedChains:      flags.PausedChains,
+		PausedZrc20Tokens: flags.PausedZRC20Tokens,
+	}, nil
+}
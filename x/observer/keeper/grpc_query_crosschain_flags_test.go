@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	keepertest "github.com/zeta-chain/node/testutil/keeper"
+	"github.com/zeta-chain/node/testutil/sample"
 	"github.com/zeta-chain/node/x/observer/types"
 )
 
@@ -46,3 +47,44 @@ func TestKeeper_CrosschainFlags(t *testing.T) {
 		}, res)
 	})
 }
+
+func TestKeeper_PausedCCTX(t *testing.T) {
+	t.Run("should error if req is nil", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		wctx := sdk.WrapSDKContext(ctx)
+
+		res, err := k.PausedCCTX(wctx, nil)
+		require.Nil(t, res)
+		require.Error(t, err)
+	})
+
+	t.Run("should return empty lists if crosschain flags not found", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		wctx := sdk.WrapSDKContext(ctx)
+
+		res, err := k.PausedCCTX(wctx, &types.QueryPausedCCTXRequest{})
+		require.NoError(t, err)
+		require.Empty(t, res.PausedChains)
+		require.Empty(t, res.PausedZrc20Tokens)
+	})
+
+	t.Run("should return paused chains and zrc20 tokens", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		wctx := sdk.WrapSDKContext(ctx)
+
+		zrc20 := sample.EthAddress().Hex()
+		flags := types.CrosschainFlags{IsInboundEnabled: true, IsOutboundEnabled: true}
+		flags.PauseChain(1, true, false)
+		flags.PauseZRC20(zrc20, false, true)
+		k.SetCrosschainFlags(ctx, flags)
+
+		res, err := k.PausedCCTX(wctx, &types.QueryPausedCCTXRequest{})
+		require.NoError(t, err)
+		require.Equal(t, []types.ChainPauseFlags{
+			{ChainId: 1, IsInboundPaused: true},
+		}, res.PausedChains)
+		require.Equal(t, []types.ZRC20PauseFlags{
+			{Zrc20Address: zrc20, IsOutboundPaused: true},
+		}, res.PausedZrc20Tokens)
+	})
+}
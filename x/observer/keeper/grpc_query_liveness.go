@@ -0,0 +1,80 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/zeta-chain/node/x/observer/types"
+)
+
+// LivenessParams returns the params used to track the observers liveness
+func (k Keeper) LivenessParams(
+	c context.Context,
+	req *types.QueryLivenessParamsRequest,
+) (*types.QueryLivenessParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryLivenessParamsResponse{LivenessParams: k.GetLivenessParams(ctx)}, nil
+}
+
+// ObserverLiveness returns the liveness record and score of an observer
+func (k Keeper) ObserverLiveness(
+	c context.Context,
+	req *types.QueryObserverLivenessRequest,
+) (*types.QueryObserverLivenessResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	liveness, found := k.GetObserverLiveness(ctx, req.ObserverAddress)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryObserverLivenessResponse{
+		Liveness: types.ObserverLivenessScore{
+			Liveness: liveness,
+			Score:    liveness.Score(),
+		},
+	}, nil
+}
+
+// ObserverLivenessAll returns the liveness records and scores of all observers
+func (k Keeper) ObserverLivenessAll(
+	c context.Context,
+	req *types.QueryAllObserverLivenessRequest,
+) (*types.QueryAllObserverLivenessResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var livenessList []types.ObserverLivenessScore
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ObserverLivenessKey))
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(_ []byte, value []byte) error {
+		var liveness types.ObserverLiveness
+		if err := k.cdc.Unmarshal(value, &liveness); err != nil {
+			return err
+		}
+		livenessList = append(livenessList, types.ObserverLivenessScore{
+			Liveness: liveness,
+			Score:    liveness.Score(),
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllObserverLivenessResponse{Liveness: livenessList, Pagination: pageRes}, nil
+}
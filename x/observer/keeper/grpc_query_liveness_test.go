@@ -0,0 +1,115 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/zeta-chain/node/testutil/keeper"
+	"github.com/zeta-chain/node/testutil/sample"
+	"github.com/zeta-chain/node/x/observer/types"
+)
+
+func TestKeeper_LivenessParams(t *testing.T) {
+	t.Run("should error if req is nil", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		wctx := sdk.WrapSDKContext(ctx)
+
+		res, err := k.LivenessParams(wctx, nil)
+		require.Nil(t, res)
+		require.Error(t, err)
+	})
+
+	t.Run("should return default params if not set", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		wctx := sdk.WrapSDKContext(ctx)
+
+		res, err := k.LivenessParams(wctx, &types.QueryLivenessParamsRequest{})
+		require.NoError(t, err)
+		require.Equal(t, types.DefaultLivenessParams(), res.LivenessParams)
+	})
+
+	t.Run("should return params if set", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		wctx := sdk.WrapSDKContext(ctx)
+		params := sample.LivenessParams()
+		k.SetLivenessParams(ctx, params)
+
+		res, err := k.LivenessParams(wctx, &types.QueryLivenessParamsRequest{})
+		require.NoError(t, err)
+		require.Equal(t, params, res.LivenessParams)
+	})
+}
+
+func TestKeeper_ObserverLiveness(t *testing.T) {
+	t.Run("should error if req is nil", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		wctx := sdk.WrapSDKContext(ctx)
+
+		res, err := k.ObserverLiveness(wctx, nil)
+		require.Nil(t, res)
+		require.Error(t, err)
+	})
+
+	t.Run("should error if liveness not found", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		wctx := sdk.WrapSDKContext(ctx)
+
+		res, err := k.ObserverLiveness(wctx, &types.QueryObserverLivenessRequest{
+			ObserverAddress: sample.AccAddress(),
+		})
+		require.Nil(t, res)
+		require.Error(t, err)
+	})
+
+	t.Run("should return liveness with score", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		wctx := sdk.WrapSDKContext(ctx)
+		liveness := types.NewObserverLiveness(sample.AccAddress())
+		liveness.RecordBallot(true, 4)
+		liveness.RecordBallot(false, 4)
+		k.SetObserverLiveness(ctx, liveness)
+
+		res, err := k.ObserverLiveness(wctx, &types.QueryObserverLivenessRequest{
+			ObserverAddress: liveness.ObserverAddress,
+		})
+		require.NoError(t, err)
+		require.Equal(t, liveness, res.Liveness.Liveness)
+		require.True(t, res.Liveness.Score.Equal(sdk.NewDecWithPrec(5, 1)))
+	})
+}
+
+func TestKeeper_ObserverLivenessAll(t *testing.T) {
+	t.Run("should error if req is nil", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		wctx := sdk.WrapSDKContext(ctx)
+
+		res, err := k.ObserverLivenessAll(wctx, nil)
+		require.Nil(t, res)
+		require.Error(t, err)
+	})
+
+	t.Run("should return all liveness records paginated", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		wctx := sdk.WrapSDKContext(ctx)
+		for _, index := range []string{"0", "1", "2"} {
+			k.SetObserverLiveness(ctx, sample.ObserverLiveness(t, index))
+		}
+
+		res, err := k.ObserverLivenessAll(wctx, &types.QueryAllObserverLivenessRequest{})
+		require.NoError(t, err)
+		require.Len(t, res.Liveness, 3)
+		for _, liveness := range res.Liveness {
+			require.True(t, liveness.Score.Equal(liveness.Liveness.Score()))
+		}
+
+		res, err = k.ObserverLivenessAll(wctx, &types.QueryAllObserverLivenessRequest{
+			Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+		})
+		require.NoError(t, err)
+		require.Len(t, res.Liveness, 2)
+		require.EqualValues(t, 3, res.Pagination.Total)
+	})
+}
@@ -66,10 +66,15 @@ func (k Keeper) GetTssAddress(
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
+	btcTaprootAddress, err := crypto.GetTssAddrBTCTaproot(tss.TssPubkey, bitcoinParams)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
 
 	return &types.QueryGetTssAddressResponse{
-		Eth: ethAddress.String(),
-		Btc: btcAddress,
+		Eth:        ethAddress.String(),
+		Btc:        btcAddress,
+		BtcTaproot: btcTaprootAddress,
 	}, nil
 }
 
@@ -100,8 +105,13 @@ func (k Keeper) GetTssAddressByFinalizedHeight(
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
+	btcTaprootAddress, err := crypto.GetTssAddrBTCTaproot(tss.TssPubkey, bitcoinParams)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
 	return &types.QueryGetTssAddressByFinalizedHeightResponse{
-		Eth: ethAddress.String(),
-		Btc: btcAddress,
+		Eth:        ethAddress.String(),
+		Btc:        btcAddress,
+		BtcTaproot: btcTaprootAddress,
 	}, nil
 }
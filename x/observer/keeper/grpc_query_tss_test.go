@@ -154,11 +154,14 @@ func TestKeeper_GetTssAddress(t *testing.T) {
 		require.NoError(t, err)
 		expectedBtcAddress, err := crypto.GetTssAddrBTC(tss.TssPubkey, expectedBitcoinParams)
 		require.NoError(t, err)
+		expectedBtcTaprootAddress, err := crypto.GetTssAddrBTCTaproot(tss.TssPubkey, expectedBitcoinParams)
+		require.NoError(t, err)
 		expectedEthAddress, err := crypto.GetTssAddrEVM(tss.TssPubkey)
 		require.NoError(t, err)
-		require.NotNil(t, &types.QueryGetTssAddressByFinalizedHeightResponse{
-			Eth: expectedEthAddress.String(),
-			Btc: expectedBtcAddress,
+		require.Equal(t, &types.QueryGetTssAddressResponse{
+			Eth:        expectedEthAddress.String(),
+			Btc:        expectedBtcAddress,
+			BtcTaproot: expectedBtcTaprootAddress,
 		}, res)
 	})
 }
@@ -221,11 +224,14 @@ func TestKeeper_GetTssAddressByFinalizedHeight(t *testing.T) {
 		require.NoError(t, err)
 		expectedBtcAddress, err := crypto.GetTssAddrBTC(tssList[r].TssPubkey, expectedBitcoinParams)
 		require.NoError(t, err)
+		expectedBtcTaprootAddress, err := crypto.GetTssAddrBTCTaproot(tssList[r].TssPubkey, expectedBitcoinParams)
+		require.NoError(t, err)
 		expectedEthAddress, err := crypto.GetTssAddrEVM(tssList[r].TssPubkey)
 		require.NoError(t, err)
-		require.NotNil(t, &types.QueryGetTssAddressByFinalizedHeightResponse{
-			Eth: expectedEthAddress.String(),
-			Btc: expectedBtcAddress,
+		require.Equal(t, &types.QueryGetTssAddressByFinalizedHeightResponse{
+			Eth:        expectedEthAddress.String(),
+			Btc:        expectedBtcAddress,
+			BtcTaproot: expectedBtcTaprootAddress,
 		}, res)
 	})
 }
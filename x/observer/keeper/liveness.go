@@ -0,0 +1,160 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/zeta-chain/node/x/observer/types"
+)
+
+// SetLivenessParams sets the liveness params in the store
+func (k Keeper) SetLivenessParams(ctx sdk.Context, params types.LivenessParams) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.LivenessParamsKey))
+	b := k.cdc.MustMarshal(&params)
+	store.Set([]byte{0}, b)
+}
+
+// GetLivenessParams returns the liveness params, the default params are returned if not set
+func (k Keeper) GetLivenessParams(ctx sdk.Context) types.LivenessParams {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.LivenessParamsKey))
+	b := store.Get([]byte{0})
+	if b == nil {
+		return types.DefaultLivenessParams()
+	}
+
+	var params types.LivenessParams
+	k.cdc.MustUnmarshal(b, &params)
+	return params
+}
+
+// SetObserverLiveness sets the liveness record of an observer in the store
+func (k Keeper) SetObserverLiveness(ctx sdk.Context, liveness types.ObserverLiveness) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ObserverLivenessKey))
+	b := k.cdc.MustMarshal(&liveness)
+	store.Set(types.KeyPrefix(liveness.ObserverAddress), b)
+}
+
+// GetObserverLiveness returns the liveness record of an observer
+func (k Keeper) GetObserverLiveness(ctx sdk.Context, observerAddress string) (val types.ObserverLiveness, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ObserverLivenessKey))
+	b := store.Get(types.KeyPrefix(observerAddress))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveObserverLiveness removes the liveness record of an observer from the store
+func (k Keeper) RemoveObserverLiveness(ctx sdk.Context, observerAddress string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ObserverLivenessKey))
+	store.Delete(types.KeyPrefix(observerAddress))
+}
+
+// GetAllObserverLiveness returns the liveness records of all observers
+func (k Keeper) GetAllObserverLiveness(ctx sdk.Context) (list []types.ObserverLiveness) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ObserverLivenessKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.ObserverLiveness
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+	return
+}
+
+// IsObserverJailed returns true if the observer has been jailed for missing ballots
+func (k Keeper) IsObserverJailed(ctx sdk.Context, observerAddress string) bool {
+	liveness, found := k.GetObserverLiveness(ctx, observerAddress)
+	return found && liveness.Jailed
+}
+
+// GetActiveObserverList returns the observers of the observer set that are not jailed
+// Only active observers are added to the voter list of new ballots
+func (k Keeper) GetActiveObserverList(ctx sdk.Context) []string {
+	observerSet, found := k.GetObserverSet(ctx)
+	if !found {
+		return nil
+	}
+	active := make([]string, 0, len(observerSet.ObserverList))
+	for _, observer := range observerSet.ObserverList {
+		if !k.IsObserverJailed(ctx, observer) {
+			active = append(active, observer)
+		}
+	}
+	return active
+}
+
+// UpdateObserversLiveness records the participation of the observers in the finalized ballots created at the given height
+// An observer present in the voter list of a ballot without a vote misses the ballot
+// If liveness tracking is enabled, observers below the minimum vote ratio over a full window are jailed
+func (k Keeper) UpdateObserversLiveness(ctx sdk.Context, height int64) {
+	list, found := k.GetBallotList(ctx, height)
+	if !found {
+		return
+	}
+	params := k.GetLivenessParams(ctx)
+
+	// observers removed from the observer set since the ballot creation are not tracked anymore
+	observerSet, _ := k.GetObserverSet(ctx)
+	isObserver := make(map[string]bool, len(observerSet.ObserverList))
+	for _, observer := range observerSet.ObserverList {
+		isObserver[observer] = true
+	}
+
+	// the records are loaded once and saved at the end to avoid a store write per ballot
+	records := map[string]*types.ObserverLiveness{}
+	var observers []string
+	for _, index := range list.BallotsIndexList {
+		ballot, found := k.GetBallot(ctx, index)
+		if !found || ballot.BallotStatus == types.BallotStatus_BallotInProgress {
+			continue
+		}
+		for i, voter := range ballot.VoterList {
+			if !isObserver[voter] {
+				continue
+			}
+			record, ok := records[voter]
+			if !ok {
+				liveness, found := k.GetObserverLiveness(ctx, voter)
+				if !found {
+					liveness = types.NewObserverLiveness(voter)
+				}
+				record = &liveness
+				records[voter] = record
+				observers = append(observers, voter)
+			}
+			record.RecordBallot(ballot.Votes[i] == types.VoteType_NotYetVoted, params.WindowSize)
+		}
+	}
+
+	jailedCount := uint64(0)
+	if params.Enabled {
+		for _, liveness := range k.GetAllObserverLiveness(ctx) {
+			if liveness.Jailed {
+				jailedCount++
+			}
+		}
+	}
+
+	for _, observer := range observers {
+		record := records[observer]
+		if params.Enabled &&
+			!record.Jailed &&
+			jailedCount < params.MaxJailedObservers &&
+			record.IsWindowFull(params.WindowSize) &&
+			record.Score().LT(params.MinVoteRatio) {
+			record.Jailed = true
+			record.JailedUntil = ctx.BlockHeight() + params.JailDurationBlocks
+			jailedCount++
+
+			ctx.Logger().Info(fmt.Sprintf("Observer %s jailed until block %d, missed %d of the last %d ballots",
+				observer, record.JailedUntil, record.MissedBallotsCounter, params.WindowSize))
+			EmitEventObserverJailed(ctx, *record, params.WindowSize)
+		}
+		k.SetObserverLiveness(ctx, *record)
+	}
+}
@@ -0,0 +1,180 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/zeta-chain/node/testutil/keeper"
+	"github.com/zeta-chain/node/testutil/sample"
+	"github.com/zeta-chain/node/x/observer/keeper"
+	"github.com/zeta-chain/node/x/observer/types"
+)
+
+// setFinalizedBallot sets a finalized ballot at the given height where the voters at the missed indexes did not vote
+func setFinalizedBallot(
+	t *testing.T,
+	ctx sdk.Context,
+	k *keeper.Keeper,
+	height int64,
+	voters []string,
+	missed ...int,
+) types.Ballot {
+	votes := sample.VotesSuccessOnly(len(voters))
+	for _, i := range missed {
+		votes[i] = types.VoteType_NotYetVoted
+	}
+	ballot := types.Ballot{
+		BallotIdentifier:     sample.ZetaIndex(t),
+		VoterList:            voters,
+		Votes:                votes,
+		BallotThreshold:      sdk.OneDec(),
+		BallotStatus:         types.BallotStatus_BallotFinalized_SuccessObservation,
+		BallotCreationHeight: height,
+	}
+	k.SetBallot(ctx, &ballot)
+	k.AddBallotToList(ctx, ballot)
+	return ballot
+}
+
+func TestKeeper_GetLivenessParams(t *testing.T) {
+	k, ctx, _, _ := keepertest.ObserverKeeper(t)
+	require.Equal(t, types.DefaultLivenessParams(), k.GetLivenessParams(ctx))
+
+	params := sample.LivenessParams()
+	k.SetLivenessParams(ctx, params)
+	require.Equal(t, params, k.GetLivenessParams(ctx))
+}
+
+func TestKeeper_GetObserverLiveness(t *testing.T) {
+	k, ctx, _, _ := keepertest.ObserverKeeper(t)
+	liveness := sample.ObserverLiveness(t, "0")
+
+	_, found := k.GetObserverLiveness(ctx, liveness.ObserverAddress)
+	require.False(t, found)
+
+	k.SetObserverLiveness(ctx, liveness)
+	got, found := k.GetObserverLiveness(ctx, liveness.ObserverAddress)
+	require.True(t, found)
+	require.Equal(t, liveness, got)
+	require.Len(t, k.GetAllObserverLiveness(ctx), 1)
+
+	k.RemoveObserverLiveness(ctx, liveness.ObserverAddress)
+	_, found = k.GetObserverLiveness(ctx, liveness.ObserverAddress)
+	require.False(t, found)
+}
+
+func TestKeeper_GetActiveObserverList(t *testing.T) {
+	k, ctx, _, _ := keepertest.ObserverKeeper(t)
+	require.Empty(t, k.GetActiveObserverList(ctx))
+
+	observerSet := sample.ObserverSet(3)
+	k.SetObserverSet(ctx, observerSet)
+	require.Equal(t, observerSet.ObserverList, k.GetActiveObserverList(ctx))
+
+	jailed := types.NewObserverLiveness(observerSet.ObserverList[1])
+	jailed.Jailed = true
+	k.SetObserverLiveness(ctx, jailed)
+	k.SetObserverLiveness(ctx, types.NewObserverLiveness(observerSet.ObserverList[2]))
+
+	require.True(t, k.IsObserverJailed(ctx, observerSet.ObserverList[1]))
+	require.False(t, k.IsObserverJailed(ctx, observerSet.ObserverList[2]))
+	require.Equal(
+		t,
+		[]string{observerSet.ObserverList[0], observerSet.ObserverList[2]},
+		k.GetActiveObserverList(ctx),
+	)
+}
+
+func TestKeeper_UpdateObserversLiveness(t *testing.T) {
+	t.Run("should record the participation without jailing if disabled", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		observerSet := sample.ObserverSet(3)
+		k.SetObserverSet(ctx, observerSet)
+		params := types.DefaultLivenessParams()
+		params.WindowSize = 2
+		k.SetLivenessParams(ctx, params)
+
+		setFinalizedBallot(t, ctx, k, 10, observerSet.ObserverList, 0)
+		setFinalizedBallot(t, ctx, k, 10, observerSet.ObserverList, 0, 1)
+
+		k.UpdateObserversLiveness(ctx, 10)
+
+		for i, expectedMissed := range []uint64{2, 1, 0} {
+			liveness, found := k.GetObserverLiveness(ctx, observerSet.ObserverList[i])
+			require.True(t, found)
+			require.EqualValues(t, 2, liveness.RecordedBallots)
+			require.Equal(t, expectedMissed, liveness.MissedBallotsCounter)
+			require.False(t, liveness.Jailed)
+		}
+	})
+
+	t.Run("should ignore ballots in progress and removed observers", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		observerSet := sample.ObserverSet(2)
+		k.SetObserverSet(ctx, observerSet)
+		removed := sample.AccAddress()
+
+		ballot := setFinalizedBallot(t, ctx, k, 10, append(observerSet.ObserverList, removed))
+		ballot.BallotStatus = types.BallotStatus_BallotInProgress
+		k.SetBallot(ctx, &ballot)
+		setFinalizedBallot(t, ctx, k, 10, append(observerSet.ObserverList, removed), 2)
+
+		k.UpdateObserversLiveness(ctx, 10)
+
+		liveness, found := k.GetObserverLiveness(ctx, observerSet.ObserverList[0])
+		require.True(t, found)
+		require.EqualValues(t, 1, liveness.RecordedBallots)
+		_, found = k.GetObserverLiveness(ctx, removed)
+		require.False(t, found)
+	})
+
+	t.Run("should jail observers below the min vote ratio once the window is full", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		ctx = ctx.WithBlockHeight(100).WithEventManager(sdk.NewEventManager())
+		observerSet := sample.ObserverSet(3)
+		k.SetObserverSet(ctx, observerSet)
+		params := sample.LivenessParams()
+		params.WindowSize = 2
+		params.MaxJailedObservers = 3
+		k.SetLivenessParams(ctx, params)
+
+		// the window is not full after the first ballot
+		setFinalizedBallot(t, ctx, k, 10, observerSet.ObserverList, 0, 1)
+		k.UpdateObserversLiveness(ctx, 10)
+		require.False(t, k.IsObserverJailed(ctx, observerSet.ObserverList[0]))
+
+		setFinalizedBallot(t, ctx, k, 11, observerSet.ObserverList, 0)
+		k.UpdateObserversLiveness(ctx, 11)
+
+		liveness, found := k.GetObserverLiveness(ctx, observerSet.ObserverList[0])
+		require.True(t, found)
+		require.True(t, liveness.Jailed)
+		require.Equal(t, ctx.BlockHeight()+params.JailDurationBlocks, liveness.JailedUntil)
+
+		// score of 0.5 is below the min vote ratio of 0.8
+		require.True(t, k.IsObserverJailed(ctx, observerSet.ObserverList[1]))
+		require.False(t, k.IsObserverJailed(ctx, observerSet.ObserverList[2]))
+
+		events := ctx.EventManager().Events()
+		require.Len(t, events, 2)
+		require.Equal(t, "zetachain.zetacore.observer.EventObserverJailed", events[0].Type)
+	})
+
+	t.Run("should not jail more than the max jailed observers", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		observerSet := sample.ObserverSet(3)
+		k.SetObserverSet(ctx, observerSet)
+		params := sample.LivenessParams()
+		params.WindowSize = 1
+		params.MaxJailedObservers = 1
+		k.SetLivenessParams(ctx, params)
+
+		setFinalizedBallot(t, ctx, k, 10, observerSet.ObserverList, 0, 1)
+		k.UpdateObserversLiveness(ctx, 10)
+
+		require.True(t, k.IsObserverJailed(ctx, observerSet.ObserverList[0]))
+		require.False(t, k.IsObserverJailed(ctx, observerSet.ObserverList[1]))
+	})
+}
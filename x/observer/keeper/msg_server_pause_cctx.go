@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+
+	authoritytypes "github.com/zeta-chain/node/x/authority/types"
+	"github.com/zeta-chain/node/x/observer/types"
+)
+
+// PauseCCTX pauses the inbound and/or outbound of the given connected chains and ZRC20 tokens,
+// the CCTXs of other chains and tokens are not affected.
+// The flags are paused by the policy account with the groupEmergency policy type.
+func (k msgServer) PauseCCTX(
+	goCtx context.Context,
+	msg *types.MsgPauseCCTX,
+) (*types.MsgPauseCCTXResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// check permission
+	err := k.GetAuthorityKeeper().CheckAuthorization(ctx, msg)
+	if err != nil {
+		return nil, errors.Wrap(authoritytypes.ErrUnauthorized, err.Error())
+	}
+
+	// check if the value exists,
+	// if not, set the default value for the Inbound and Outbound flags only
+	flags, isFound := k.GetCrosschainFlags(ctx)
+	if !isFound {
+		flags = *types.DefaultCrosschainFlags()
+		flags.GasPriceIncreaseFlags = nil
+	}
+
+	for _, chainID := range msg.ChainIds {
+		flags.PauseChain(chainID, msg.PauseInbound, msg.PauseOutbound)
+	}
+	for _, zrc20Address := range msg.Zrc20Addresses {
+		flags.PauseZRC20(ethcommon.HexToAddress(zrc20Address).Hex(), msg.PauseInbound, msg.PauseOutbound)
+	}
+
+	k.SetCrosschainFlags(ctx, flags)
+
+	err = ctx.EventManager().EmitTypedEvents(&types.EventCCTXPaused{
+		MsgTypeUrl:     sdk.MsgTypeURL(&types.MsgPauseCCTX{}),
+		ChainIds:       msg.ChainIds,
+		Zrc20Addresses: msg.Zrc20Addresses,
+		PauseInbound:   msg.PauseInbound,
+		PauseOutbound:  msg.PauseOutbound,
+	})
+
+	if err != nil {
+		ctx.Logger().Error("Error emitting event EventCCTXPaused :", err)
+	}
+
+	return &types.MsgPauseCCTXResponse{}, nil
+}
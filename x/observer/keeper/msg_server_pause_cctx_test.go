@@ -0,0 +1,102 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/zeta-chain/node/testutil/keeper"
+	"github.com/zeta-chain/node/testutil/sample"
+	authoritytypes "github.com/zeta-chain/node/x/authority/types"
+	"github.com/zeta-chain/node/x/observer/keeper"
+	"github.com/zeta-chain/node/x/observer/types"
+)
+
+func TestMsgServer_PauseCCTX(t *testing.T) {
+	t.Run("can pause chains and zrc20 tokens if flags dont exist", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeperWithMocks(t, keepertest.ObserverMockOptions{
+			UseAuthorityMock: true,
+		})
+		srv := keeper.NewMsgServerImpl(*k)
+		admin := sample.AccAddress()
+		authorityMock := keepertest.GetObserverAuthorityMock(t, k)
+		zrc20 := sample.EthAddress()
+
+		msg := types.MsgPauseCCTX{
+			Creator:        admin,
+			ChainIds:       []int64{1, 2},
+			Zrc20Addresses: []string{zrc20.Hex()},
+			PauseInbound:   true,
+		}
+		keepertest.MockCheckAuthorization(&authorityMock.Mock, &msg, nil)
+		_, err := srv.PauseCCTX(sdk.WrapSDKContext(ctx), &msg)
+		require.NoError(t, err)
+
+		flags, found := k.GetCrosschainFlags(ctx)
+		require.True(t, found)
+		require.True(t, flags.IsInboundEnabled)
+		require.True(t, flags.IsOutboundEnabled)
+		require.Nil(t, flags.GasPriceIncreaseFlags)
+		require.True(t, flags.IsChainInboundPaused(1))
+		require.True(t, flags.IsChainInboundPaused(2))
+		require.False(t, flags.IsChainOutboundPaused(1))
+		require.False(t, flags.IsChainInboundPaused(3))
+		require.True(t, flags.IsZRC20InboundPaused(zrc20.Hex()))
+		require.False(t, flags.IsZRC20OutboundPaused(zrc20.Hex()))
+	})
+
+	t.Run("can pause outbound on top of paused inbound", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeperWithMocks(t, keepertest.ObserverMockOptions{
+			UseAuthorityMock: true,
+		})
+		gasPriceIncreaseFlags := sample.GasPriceIncreaseFlags()
+		flags := types.CrosschainFlags{
+			IsInboundEnabled:      true,
+			IsOutboundEnabled:     true,
+			GasPriceIncreaseFlags: &gasPriceIncreaseFlags,
+		}
+		flags.PauseChain(1, true, false)
+		k.SetCrosschainFlags(ctx, flags)
+		srv := keeper.NewMsgServerImpl(*k)
+		admin := sample.AccAddress()
+		authorityMock := keepertest.GetObserverAuthorityMock(t, k)
+
+		msg := types.MsgPauseCCTX{
+			Creator:       admin,
+			ChainIds:      []int64{1},
+			PauseOutbound: true,
+		}
+		keepertest.MockCheckAuthorization(&authorityMock.Mock, &msg, nil)
+		_, err := srv.PauseCCTX(sdk.WrapSDKContext(ctx), &msg)
+		require.NoError(t, err)
+
+		flags, found := k.GetCrosschainFlags(ctx)
+		require.True(t, found)
+		require.True(t, flags.IsChainInboundPaused(1))
+		require.True(t, flags.IsChainOutboundPaused(1))
+		require.Len(t, flags.PausedChains, 1)
+		require.Equal(t, gasPriceIncreaseFlags, *flags.GasPriceIncreaseFlags)
+	})
+
+	t.Run("cannot pause if not authorized", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeperWithMocks(t, keepertest.ObserverMockOptions{
+			UseAuthorityMock: true,
+		})
+		srv := keeper.NewMsgServerImpl(*k)
+		admin := sample.AccAddress()
+		authorityMock := keepertest.GetObserverAuthorityMock(t, k)
+
+		msg := types.MsgPauseCCTX{
+			Creator:      admin,
+			ChainIds:     []int64{1},
+			PauseInbound: true,
+		}
+		keepertest.MockCheckAuthorization(&authorityMock.Mock, &msg, authoritytypes.ErrUnauthorized)
+		_, err := srv.PauseCCTX(sdk.WrapSDKContext(ctx), &msg)
+		require.ErrorIs(t, authoritytypes.ErrUnauthorized, err)
+
+		_, found := k.GetCrosschainFlags(ctx)
+		require.False(t, found)
+	})
+}
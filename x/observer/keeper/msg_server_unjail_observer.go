@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	authoritytypes "github.com/zeta-chain/node/x/authority/types"
+	"github.com/zeta-chain/node/x/observer/types"
+)
+
+// UnjailObserver unjails an observer jailed for missing ballots.
+// The observer can unjail itself once the jail duration has elapsed,
+// the policy account with the groupOperational policy type can unjail an observer at any time.
+// The participation window of the observer is reset when unjailed.
+func (k msgServer) UnjailObserver(
+	goCtx context.Context,
+	msg *types.MsgUnjailObserver,
+) (*types.MsgUnjailObserverResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	isSelfUnjail := msg.Creator == msg.ObserverAddress
+	if !isSelfUnjail {
+		// check permission
+		err := k.GetAuthorityKeeper().CheckAuthorization(ctx, msg)
+		if err != nil {
+			return nil, errors.Wrap(authoritytypes.ErrUnauthorized, err.Error())
+		}
+	}
+
+	liveness, found := k.GetObserverLiveness(ctx, msg.ObserverAddress)
+	if !found || !liveness.Jailed {
+		return nil, errors.Wrapf(types.ErrObserverNotJailed, "observer %s", msg.ObserverAddress)
+	}
+	if isSelfUnjail && ctx.BlockHeight() < liveness.JailedUntil {
+		return nil, errors.Wrapf(
+			types.ErrObserverStillJailed,
+			"observer %s jailed until block %d",
+			msg.ObserverAddress,
+			liveness.JailedUntil,
+		)
+	}
+
+	liveness.Jailed = false
+	liveness.JailedUntil = 0
+	liveness.ResetWindow()
+	k.SetObserverLiveness(ctx, liveness)
+
+	EmitEventObserverUnjailed(ctx, msg.ObserverAddress)
+
+	return &types.MsgUnjailObserverResponse{}, nil
+}
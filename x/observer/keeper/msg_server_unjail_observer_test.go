@@ -0,0 +1,107 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/zeta-chain/node/testutil/keeper"
+	"github.com/zeta-chain/node/testutil/sample"
+	authoritytypes "github.com/zeta-chain/node/x/authority/types"
+	"github.com/zeta-chain/node/x/observer/keeper"
+	"github.com/zeta-chain/node/x/observer/types"
+)
+
+func TestMsgServer_UnjailObserver(t *testing.T) {
+	// setJailedObserver sets a jailed observer liveness record with missed ballots
+	setJailedObserver := func(ctx sdk.Context, k *keeper.Keeper, jailedUntil int64) string {
+		liveness := types.NewObserverLiveness(sample.AccAddress())
+		liveness.RecordBallot(true, 10)
+		liveness.Jailed = true
+		liveness.JailedUntil = jailedUntil
+		k.SetObserverLiveness(ctx, liveness)
+		return liveness.ObserverAddress
+	}
+
+	t.Run("observer can unjail itself after the jail duration", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeperWithMocks(t, keepertest.ObserverMockOptions{
+			UseAuthorityMock: true,
+		})
+		srv := keeper.NewMsgServerImpl(*k)
+		ctx = ctx.WithBlockHeight(100)
+		observer := setJailedObserver(ctx, k, 100)
+
+		_, err := srv.UnjailObserver(sdk.WrapSDKContext(ctx), types.NewMsgUnjailObserver(observer, observer))
+		require.NoError(t, err)
+
+		liveness, found := k.GetObserverLiveness(ctx, observer)
+		require.True(t, found)
+		require.False(t, liveness.Jailed)
+		require.Zero(t, liveness.JailedUntil)
+		require.Empty(t, liveness.MissedBallots)
+		require.Zero(t, liveness.MissedBallotsCounter)
+	})
+
+	t.Run("observer cannot unjail itself before the jail duration", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeperWithMocks(t, keepertest.ObserverMockOptions{
+			UseAuthorityMock: true,
+		})
+		srv := keeper.NewMsgServerImpl(*k)
+		ctx = ctx.WithBlockHeight(99)
+		observer := setJailedObserver(ctx, k, 100)
+
+		_, err := srv.UnjailObserver(sdk.WrapSDKContext(ctx), types.NewMsgUnjailObserver(observer, observer))
+		require.ErrorIs(t, err, types.ErrObserverStillJailed)
+		require.True(t, k.IsObserverJailed(ctx, observer))
+	})
+
+	t.Run("operational policy can unjail an observer before the jail duration", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeperWithMocks(t, keepertest.ObserverMockOptions{
+			UseAuthorityMock: true,
+		})
+		srv := keeper.NewMsgServerImpl(*k)
+		observer := setJailedObserver(ctx, k, 100)
+
+		// mock the authority keeper for authorization
+		authorityMock := keepertest.GetObserverAuthorityMock(t, k)
+		msg := types.NewMsgUnjailObserver(sample.AccAddress(), observer)
+		keepertest.MockCheckAuthorization(&authorityMock.Mock, msg, nil)
+
+		_, err := srv.UnjailObserver(sdk.WrapSDKContext(ctx), msg)
+		require.NoError(t, err)
+		require.False(t, k.IsObserverJailed(ctx, observer))
+	})
+
+	t.Run("cannot unjail an observer if not authorized", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeperWithMocks(t, keepertest.ObserverMockOptions{
+			UseAuthorityMock: true,
+		})
+		srv := keeper.NewMsgServerImpl(*k)
+		observer := setJailedObserver(ctx, k, 0)
+
+		// mock the authority keeper for authorization
+		authorityMock := keepertest.GetObserverAuthorityMock(t, k)
+		msg := types.NewMsgUnjailObserver(sample.AccAddress(), observer)
+		keepertest.MockCheckAuthorization(&authorityMock.Mock, msg, authoritytypes.ErrUnauthorized)
+
+		_, err := srv.UnjailObserver(sdk.WrapSDKContext(ctx), msg)
+		require.ErrorIs(t, err, authoritytypes.ErrUnauthorized)
+		require.True(t, k.IsObserverJailed(ctx, observer))
+	})
+
+	t.Run("cannot unjail an observer not jailed", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeperWithMocks(t, keepertest.ObserverMockOptions{
+			UseAuthorityMock: true,
+		})
+		srv := keeper.NewMsgServerImpl(*k)
+		observer := sample.AccAddress()
+
+		_, err := srv.UnjailObserver(sdk.WrapSDKContext(ctx), types.NewMsgUnjailObserver(observer, observer))
+		require.ErrorIs(t, err, types.ErrObserverNotJailed)
+
+		k.SetObserverLiveness(ctx, types.NewObserverLiveness(observer))
+		_, err = srv.UnjailObserver(sdk.WrapSDKContext(ctx), types.NewMsgUnjailObserver(observer, observer))
+		require.ErrorIs(t, err, types.ErrObserverNotJailed)
+	})
+}
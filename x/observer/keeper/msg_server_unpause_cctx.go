@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+
+	authoritytypes "github.com/zeta-chain/node/x/authority/types"
+	"github.com/zeta-chain/node/x/observer/types"
+)
+
+// UnpauseCCTX unpauses the inbound and/or outbound of the given connected chains and ZRC20 tokens.
+// The flags are unpaused by the policy account with the groupOperational policy type.
+func (k msgServer) UnpauseCCTX(
+	goCtx context.Context,
+	msg *types.MsgUnpauseCCTX,
+) (*types.MsgUnpauseCCTXResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// check permission
+	err := k.GetAuthorityKeeper().CheckAuthorization(ctx, msg)
+	if err != nil {
+		return nil, errors.Wrap(authoritytypes.ErrUnauthorized, err.Error())
+	}
+
+	// nothing is paused if the flags don't exist
+	flags, isFound := k.GetCrosschainFlags(ctx)
+	if !isFound {
+		return &types.MsgUnpauseCCTXResponse{}, nil
+	}
+
+	for _, chainID := range msg.ChainIds {
+		flags.UnpauseChain(chainID, msg.UnpauseInbound, msg.UnpauseOutbound)
+	}
+	for _, zrc20Address := range msg.Zrc20Addresses {
+		flags.UnpauseZRC20(ethcommon.HexToAddress(zrc20Address).Hex(), msg.UnpauseInbound, msg.UnpauseOutbound)
+	}
+
+	k.SetCrosschainFlags(ctx, flags)
+
+	err = ctx.EventManager().EmitTypedEvents(&types.EventCCTXUnpaused{
+		MsgTypeUrl:      sdk.MsgTypeURL(&types.MsgUnpauseCCTX{}),
+		ChainIds:        msg.ChainIds,
+		Zrc20Addresses:  msg.Zrc20Addresses,
+		UnpauseInbound:  msg.UnpauseInbound,
+		UnpauseOutbound: msg.UnpauseOutbound,
+	})
+
+	if err != nil {
+		ctx.Logger().Error("Error emitting event EventCCTXUnpaused :", err)
+	}
+
+	return &types.MsgUnpauseCCTXResponse{}, nil
+}
@@ -0,0 +1,115 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/zeta-chain/node/testutil/keeper"
+	"github.com/zeta-chain/node/testutil/sample"
+	authoritytypes "github.com/zeta-chain/node/x/authority/types"
+	"github.com/zeta-chain/node/x/observer/keeper"
+	"github.com/zeta-chain/node/x/observer/types"
+)
+
+func TestMsgServer_UnpauseCCTX(t *testing.T) {
+	t.Run("can unpause chains and zrc20 tokens", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeperWithMocks(t, keepertest.ObserverMockOptions{
+			UseAuthorityMock: true,
+		})
+		zrc20 := sample.EthAddress()
+		flags := types.CrosschainFlags{IsInboundEnabled: true, IsOutboundEnabled: true}
+		flags.PauseChain(1, true, true)
+		flags.PauseChain(2, true, true)
+		flags.PauseZRC20(zrc20.Hex(), true, true)
+		k.SetCrosschainFlags(ctx, flags)
+		srv := keeper.NewMsgServerImpl(*k)
+		admin := sample.AccAddress()
+		authorityMock := keepertest.GetObserverAuthorityMock(t, k)
+
+		msg := types.MsgUnpauseCCTX{
+			Creator:         admin,
+			ChainIds:        []int64{1},
+			Zrc20Addresses:  []string{zrc20.Hex()},
+			UnpauseInbound:  true,
+			UnpauseOutbound: true,
+		}
+		keepertest.MockCheckAuthorization(&authorityMock.Mock, &msg, nil)
+		_, err := srv.UnpauseCCTX(sdk.WrapSDKContext(ctx), &msg)
+		require.NoError(t, err)
+
+		flags, found := k.GetCrosschainFlags(ctx)
+		require.True(t, found)
+		require.False(t, flags.IsChainInboundPaused(1))
+		require.False(t, flags.IsChainOutboundPaused(1))
+		require.True(t, flags.IsChainInboundPaused(2))
+		require.True(t, flags.IsChainOutboundPaused(2))
+		require.Empty(t, flags.PausedZRC20Tokens)
+	})
+
+	t.Run("can unpause only inbound", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeperWithMocks(t, keepertest.ObserverMockOptions{
+			UseAuthorityMock: true,
+		})
+		flags := types.CrosschainFlags{IsInboundEnabled: true, IsOutboundEnabled: true}
+		flags.PauseChain(1, true, true)
+		k.SetCrosschainFlags(ctx, flags)
+		srv := keeper.NewMsgServerImpl(*k)
+		admin := sample.AccAddress()
+		authorityMock := keepertest.GetObserverAuthorityMock(t, k)
+
+		msg := types.MsgUnpauseCCTX{
+			Creator:        admin,
+			ChainIds:       []int64{1},
+			UnpauseInbound: true,
+		}
+		keepertest.MockCheckAuthorization(&authorityMock.Mock, &msg, nil)
+		_, err := srv.UnpauseCCTX(sdk.WrapSDKContext(ctx), &msg)
+		require.NoError(t, err)
+
+		flags, found := k.GetCrosschainFlags(ctx)
+		require.True(t, found)
+		require.False(t, flags.IsChainInboundPaused(1))
+		require.True(t, flags.IsChainOutboundPaused(1))
+	})
+
+	t.Run("does nothing if flags dont exist", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeperWithMocks(t, keepertest.ObserverMockOptions{
+			UseAuthorityMock: true,
+		})
+		srv := keeper.NewMsgServerImpl(*k)
+		admin := sample.AccAddress()
+		authorityMock := keepertest.GetObserverAuthorityMock(t, k)
+
+		msg := types.MsgUnpauseCCTX{
+			Creator:        admin,
+			ChainIds:       []int64{1},
+			UnpauseInbound: true,
+		}
+		keepertest.MockCheckAuthorization(&authorityMock.Mock, &msg, nil)
+		_, err := srv.UnpauseCCTX(sdk.WrapSDKContext(ctx), &msg)
+		require.NoError(t, err)
+
+		_, found := k.GetCrosschainFlags(ctx)
+		require.False(t, found)
+	})
+
+	t.Run("cannot unpause if not authorized", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeperWithMocks(t, keepertest.ObserverMockOptions{
+			UseAuthorityMock: true,
+		})
+		srv := keeper.NewMsgServerImpl(*k)
+		admin := sample.AccAddress()
+		authorityMock := keepertest.GetObserverAuthorityMock(t, k)
+
+		msg := types.MsgUnpauseCCTX{
+			Creator:        admin,
+			ChainIds:       []int64{1},
+			UnpauseInbound: true,
+		}
+		keepertest.MockCheckAuthorization(&authorityMock.Mock, &msg, authoritytypes.ErrUnauthorized)
+		_, err := srv.UnpauseCCTX(sdk.WrapSDKContext(ctx), &msg)
+		require.ErrorIs(t, authoritytypes.ErrUnauthorized, err)
+	})
+}
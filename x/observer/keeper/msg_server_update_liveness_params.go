@@ -0,0 +1,34 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	authoritytypes "github.com/zeta-chain/node/x/authority/types"
+	"github.com/zeta-chain/node/x/observer/types"
+)
+
+// UpdateLivenessParams updates the params used to track the observers liveness and jail inactive observers.
+// The params are updated by the policy account with the groupOperational policy type.
+func (k msgServer) UpdateLivenessParams(
+	goCtx context.Context,
+	msg *types.MsgUpdateLivenessParams,
+) (*types.MsgUpdateLivenessParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// check permission
+	err := k.GetAuthorityKeeper().CheckAuthorization(ctx, msg)
+	if err != nil {
+		return nil, errors.Wrap(authoritytypes.ErrUnauthorized, err.Error())
+	}
+
+	if err := msg.LivenessParams.Validate(); err != nil {
+		return nil, err
+	}
+
+	k.SetLivenessParams(ctx, msg.LivenessParams)
+
+	return &types.MsgUpdateLivenessParamsResponse{}, nil
+}
@@ -0,0 +1,77 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/zeta-chain/node/testutil/keeper"
+	"github.com/zeta-chain/node/testutil/sample"
+	authoritytypes "github.com/zeta-chain/node/x/authority/types"
+	"github.com/zeta-chain/node/x/observer/keeper"
+	"github.com/zeta-chain/node/x/observer/types"
+)
+
+func TestMsgServer_UpdateLivenessParams(t *testing.T) {
+	t.Run("can update liveness params", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeperWithMocks(t, keepertest.ObserverMockOptions{
+			UseAuthorityMock: true,
+		})
+		srv := keeper.NewMsgServerImpl(*k)
+		admin := sample.AccAddress()
+		params := sample.LivenessParams()
+
+		// mock the authority keeper for authorization
+		authorityMock := keepertest.GetObserverAuthorityMock(t, k)
+		msg := types.MsgUpdateLivenessParams{
+			Creator:        admin,
+			LivenessParams: params,
+		}
+		keepertest.MockCheckAuthorization(&authorityMock.Mock, &msg, nil)
+		_, err := srv.UpdateLivenessParams(sdk.WrapSDKContext(ctx), &msg)
+		require.NoError(t, err)
+
+		require.Equal(t, params, k.GetLivenessParams(ctx))
+	})
+
+	t.Run("cannot update invalid liveness params", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeperWithMocks(t, keepertest.ObserverMockOptions{
+			UseAuthorityMock: true,
+		})
+		srv := keeper.NewMsgServerImpl(*k)
+		admin := sample.AccAddress()
+		params := sample.LivenessParams()
+		params.WindowSize = 0
+
+		// mock the authority keeper for authorization
+		authorityMock := keepertest.GetObserverAuthorityMock(t, k)
+		msg := types.MsgUpdateLivenessParams{
+			Creator:        admin,
+			LivenessParams: params,
+		}
+		keepertest.MockCheckAuthorization(&authorityMock.Mock, &msg, nil)
+		_, err := srv.UpdateLivenessParams(sdk.WrapSDKContext(ctx), &msg)
+		require.ErrorIs(t, err, types.ErrInvalidLivenessParams)
+
+		require.Equal(t, types.DefaultLivenessParams(), k.GetLivenessParams(ctx))
+	})
+
+	t.Run("cannot update liveness params if not authorized", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeperWithMocks(t, keepertest.ObserverMockOptions{
+			UseAuthorityMock: true,
+		})
+		srv := keeper.NewMsgServerImpl(*k)
+		admin := sample.AccAddress()
+
+		// mock the authority keeper for authorization
+		authorityMock := keepertest.GetObserverAuthorityMock(t, k)
+		msg := types.MsgUpdateLivenessParams{
+			Creator:        admin,
+			LivenessParams: sample.LivenessParams(),
+		}
+		keepertest.MockCheckAuthorization(&authorityMock.Mock, &msg, authoritytypes.ErrUnauthorized)
+		_, err := srv.UpdateLivenessParams(sdk.WrapSDKContext(ctx), &msg)
+		require.ErrorIs(t, err, authoritytypes.ErrUnauthorized)
+	})
+}
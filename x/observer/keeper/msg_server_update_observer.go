@@ -61,6 +61,13 @@ func (k msgServer) UpdateObserver(
 	k.RemoveNodeAccount(ctx, msg.OldObserverAddress)
 	k.SetNodeAccount(ctx, newNodeAccount)
 
+	// Move the liveness record so the participation history and jail status follow the observer
+	if liveness, found := k.GetObserverLiveness(ctx, msg.OldObserverAddress); found {
+		k.RemoveObserverLiveness(ctx, msg.OldObserverAddress)
+		liveness.ObserverAddress = msg.NewObserverAddress
+		k.SetObserverLiveness(ctx, liveness)
+	}
+
 	// Check LastBlockObserver count just to be safe
 	observerSet, found := k.GetObserverSet(ctx)
 	if !found {
@@ -61,6 +61,11 @@ func TestMsgServer_UpdateObserver(t *testing.T) {
 			Count: count,
 		})
 
+		liveness := types.NewObserverLiveness(accAddressOfValidator.String())
+		liveness.RecordBallot(true, 10)
+		liveness.Jailed = true
+		k.SetObserverLiveness(ctx, liveness)
+
 		_, err = srv.UpdateObserver(sdk.WrapSDKContext(ctx), &types.MsgUpdateObserver{
 			Creator:            accAddressOfValidator.String(),
 			OldObserverAddress: accAddressOfValidator.String(),
@@ -71,6 +76,14 @@ func TestMsgServer_UpdateObserver(t *testing.T) {
 		acc, found := k.GetNodeAccount(ctx, newOperatorAddress.String())
 		require.True(t, found)
 		require.Equal(t, newOperatorAddress.String(), acc.Operator)
+
+		// liveness record is moved to the new address
+		_, found = k.GetObserverLiveness(ctx, accAddressOfValidator.String())
+		require.False(t, found)
+		newLiveness, found := k.GetObserverLiveness(ctx, newOperatorAddress.String())
+		require.True(t, found)
+		require.True(t, newLiveness.Jailed)
+		require.EqualValues(t, 1, newLiveness.MissedBallotsCounter)
 	})
 
 	t.Run(
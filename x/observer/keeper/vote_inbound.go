@@ -23,6 +23,9 @@ func (k Keeper) VoteOnInboundBallot(
 	if !k.IsInboundEnabled(ctx) {
 		return false, false, types.ErrInboundDisabled
 	}
+	if flags, found := k.GetCrosschainFlags(ctx); found && flags.IsChainInboundPaused(senderChainID) {
+		return false, false, sdkerrors.Wrapf(types.ErrInboundDisabled, "inbound from chain %d is paused", senderChainID)
+	}
 
 	// makes sure we are getting only supported chains
 	// if a chain support has been turned on using gov proposal
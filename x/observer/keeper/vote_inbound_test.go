@@ -36,6 +36,30 @@ func TestKeeper_VoteOnInboundBallot(t *testing.T) {
 		require.ErrorIs(t, err, types.ErrInboundDisabled)
 	})
 
+	t.Run("fail if inbound from sender chain is paused", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+
+		senderChainID := getValidEthChainIDWithIndex(t, 0)
+		flags := types.CrosschainFlags{
+			IsInboundEnabled: true,
+		}
+		flags.PauseChain(senderChainID, true, false)
+		k.SetCrosschainFlags(ctx, flags)
+
+		_, _, err := k.VoteOnInboundBallot(
+			ctx,
+			senderChainID,
+			chains.ZetaChainPrivnet.ChainId,
+			coin.CoinType_ERC20,
+			sample.AccAddress(),
+			"index",
+			"inTxHash",
+		)
+
+		require.Error(t, err)
+		require.ErrorIs(t, err, types.ErrInboundDisabled)
+	})
+
 	t.Run("fail if sender chain not supported", func(t *testing.T) {
 		k, ctx, _, _ := keepertest.ObserverKeeper(t)
 
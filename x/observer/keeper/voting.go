@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	sdkerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/pkg/errors"
 
@@ -68,7 +69,8 @@ func (k Keeper) FindBallot(
 	isNew = false
 	ballot, found := k.GetBallot(ctx, index)
 	if !found {
-		observerSet, _ := k.GetObserverSet(ctx)
+		// jailed observers are excluded from the voter list and therefore from the threshold
+		voterList := k.GetActiveObserverList(ctx)
 
 		cp, found := k.GetChainParamsByChainID(ctx, chain.ChainId)
 		if !found || cp == nil || !cp.IsSupported {
@@ -78,19 +80,46 @@ func (k Keeper) FindBallot(
 		ballot = types.Ballot{
 			Index:                "",
 			BallotIdentifier:     index,
-			VoterList:            observerSet.ObserverList,
-			Votes:                types.CreateVotes(len(observerSet.ObserverList)),
+			VoterList:            voterList,
+			Votes:                types.CreateVotes(len(voterList)),
 			ObservationType:      observationType,
 			BallotThreshold:      cp.BallotThreshold,
 			BallotStatus:         types.BallotStatus_BallotInProgress,
 			BallotCreationHeight: ctx.BlockHeight(),
 		}
+		if cp.WeightedVoting {
+			ballot.VotingPower = k.GetVotingPower(ctx, voterList)
+		}
 		isNew = true
 		k.AddBallotToList(ctx, ballot)
 	}
 	return
 }
 
+// GetVotingPower returns the voting power of each observer of the list, which is the bonded stake of its validator
+// Nil is returned if none of the observers has bonded stake, the ballot then counts one vote per observer
+func (k Keeper) GetVotingPower(ctx sdk.Context, observers []string) []sdkmath.Int {
+	votingPower := make([]sdkmath.Int, len(observers))
+	total := sdkmath.ZeroInt()
+	for i, observer := range observers {
+		votingPower[i] = sdkmath.ZeroInt()
+		valAddress, err := types.GetOperatorAddressFromAccAddress(observer)
+		if err != nil {
+			continue
+		}
+		validator, found := k.stakingKeeper.GetValidator(ctx, valAddress)
+		if !found {
+			continue
+		}
+		votingPower[i] = validator.BondedTokens()
+		total = total.Add(votingPower[i])
+	}
+	if total.IsZero() {
+		return nil
+	}
+	return votingPower
+}
+
 func (k Keeper) IsValidator(ctx sdk.Context, creator string) error {
 	valAddress, err := types.GetOperatorAddressFromAccAddress(creator)
 	if err != nil {
@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -319,6 +320,93 @@ func TestKeeper_FindBallot(t *testing.T) {
 		}, types.ObservationType_InboundTx)
 		require.Error(t, err)
 	})
+
+	t.Run("should exclude jailed observers from the voter list", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		chainID := getValidEthChainIDWithIndex(t, 0)
+		k.SetChainParamsList(ctx, types.ChainParamsList{
+			ChainParams: []*types.ChainParams{
+				{
+					ChainId:     chainID,
+					IsSupported: true,
+				},
+			},
+		})
+		observerSet := sample.ObserverSet(3)
+		k.SetObserverSet(ctx, observerSet)
+		jailed := types.NewObserverLiveness(observerSet.ObserverList[0])
+		jailed.Jailed = true
+		k.SetObserverLiveness(ctx, jailed)
+
+		ballot, isNew, err := k.FindBallot(ctx, "index", chains.Chain{
+			ChainId: chainID,
+		}, types.ObservationType_InboundTx)
+		require.NoError(t, err)
+		require.True(t, isNew)
+		require.Equal(t, observerSet.ObserverList[1:], ballot.VoterList)
+		require.Len(t, ballot.Votes, 2)
+	})
+
+	t.Run("should set the voting power of the voters if the votes are weighted", func(t *testing.T) {
+		k, ctx, sdkk, _ := keepertest.ObserverKeeper(t)
+		chainID := getValidEthChainIDWithIndex(t, 0)
+		k.SetChainParamsList(ctx, types.ChainParamsList{
+			ChainParams: []*types.ChainParams{
+				{
+					ChainId:        chainID,
+					IsSupported:    true,
+					WeightedVoting: true,
+				},
+			},
+		})
+
+		r := rand.New(rand.NewSource(9))
+		var observers []string
+		for _, tokens := range []int64{100, 300} {
+			validator := sample.Validator(t, r)
+			validator.Status = stakingtypes.Bonded
+			validator.Tokens = sdkmath.NewInt(tokens)
+			sdkk.StakingKeeper.SetValidator(ctx, validator)
+			observer, err := types.GetAccAddressFromOperatorAddress(validator.OperatorAddress)
+			require.NoError(t, err)
+			observers = append(observers, observer.String())
+		}
+
+		// observer without validator has no voting power
+		observers = append(observers, sample.AccAddress())
+		k.SetObserverSet(ctx, types.ObserverSet{ObserverList: observers})
+
+		ballot, isNew, err := k.FindBallot(ctx, "index", chains.Chain{
+			ChainId: chainID,
+		}, types.ObservationType_InboundTx)
+		require.NoError(t, err)
+		require.True(t, isNew)
+		require.True(t, ballot.IsWeighted())
+		require.EqualValues(t, 100, ballot.VotingPower[0].Int64())
+		require.EqualValues(t, 300, ballot.VotingPower[1].Int64())
+		require.True(t, ballot.VotingPower[2].IsZero())
+	})
+
+	t.Run("should not weight the votes if no voter has bonded stake", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		chainID := getValidEthChainIDWithIndex(t, 0)
+		k.SetChainParamsList(ctx, types.ChainParamsList{
+			ChainParams: []*types.ChainParams{
+				{
+					ChainId:        chainID,
+					IsSupported:    true,
+					WeightedVoting: true,
+				},
+			},
+		})
+		k.SetObserverSet(ctx, sample.ObserverSet(3))
+
+		ballot, _, err := k.FindBallot(ctx, "index", chains.Chain{
+			ChainId: chainID,
+		}, types.ObservationType_InboundTx)
+		require.NoError(t, err)
+		require.False(t, ballot.IsWeighted())
+	})
 }
 
 func TestKeeper_VoteOnBallot(t *testing.T) {
@@ -0,0 +1,51 @@
+package v9
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/zeta-chain/node/pkg/chains"
+	"github.com/zeta-chain/node/x/observer/types"
+)
+
+type observerKeeper interface {
+	GetChainParamsList(ctx sdk.Context) (val types.ChainParamsList, found bool)
+	SetChainParamsList(ctx sdk.Context, chainParams types.ChainParamsList)
+}
+
+// MigrateStore migrates the x/observer module state from the consensus version 8 to 9
+// It sets the default confirmation tiers for Bitcoin chains, so that large deposits and withdrawals
+// keep requiring the same number of confirmations as before confirmation tiers were introduced
+func MigrateStore(ctx sdk.Context, observerKeeper observerKeeper) error {
+	chainParamsList, found := observerKeeper.GetChainParamsList(ctx)
+	if !found {
+		return nil
+	}
+
+	for _, chainParams := range chainParamsList.ChainParams {
+		if chainParams == nil || !needsDefaultBtcConfirmationTiers(*chainParams) {
+			continue
+		}
+		chainParams.ConfirmationTiers = types.DefaultBtcConfirmationTiers()
+	}
+
+	observerKeeper.SetChainParamsList(ctx, chainParamsList)
+
+	return nil
+}
+
+// needsDefaultBtcConfirmationTiers returns true if the chain params belong to a non-regnet Bitcoin chain
+// that has no confirmation tiers and requires fewer confirmations than the default tiers
+func needsDefaultBtcConfirmationTiers(chainParams types.ChainParams) bool {
+	if !chains.IsBitcoinChain(chainParams.ChainId, []chains.Chain{}) || chains.IsBitcoinRegnet(chainParams.ChainId) {
+		return false
+	}
+	if len(chainParams.ConfirmationTiers) > 0 {
+		return false
+	}
+	for _, tier := range types.DefaultBtcConfirmationTiers() {
+		if chainParams.ConfirmationCount >= tier.ConfirmationCount {
+			return false
+		}
+	}
+	return true
+}
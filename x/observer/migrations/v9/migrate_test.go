@@ -0,0 +1,55 @@
+package v9_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/node/pkg/chains"
+	keepertest "github.com/zeta-chain/node/testutil/keeper"
+	"github.com/zeta-chain/node/testutil/sample"
+	v9 "github.com/zeta-chain/node/x/observer/migrations/v9"
+	"github.com/zeta-chain/node/x/observer/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	t.Run("can migrate with no chain params", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+
+		err := v9.MigrateStore(ctx, k)
+		require.NoError(t, err)
+
+		_, found := k.GetChainParamsList(ctx)
+		require.False(t, found)
+	})
+
+	t.Run("can set default confirmation tiers for bitcoin chains", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+
+		btcMainnet := sample.ChainParams(chains.BitcoinMainnet.ChainId)
+		btcMainnet.ConfirmationCount = 2
+		btcTestnet := sample.ChainParams(chains.BitcoinTestnet.ChainId)
+		btcTestnet.ConfirmationCount = 10
+		btcRegnet := sample.ChainParams(chains.BitcoinRegtest.ChainId)
+		btcRegnet.ConfirmationCount = 1
+		eth := sample.ChainParams(chains.Ethereum.ChainId)
+		eth.ConfirmationCount = 2
+
+		k.SetChainParamsList(ctx, types.ChainParamsList{
+			ChainParams: []*types.ChainParams{btcMainnet, btcTestnet, btcRegnet, eth},
+		})
+
+		err := v9.MigrateStore(ctx, k)
+		require.NoError(t, err)
+
+		chainParamsList, found := k.GetChainParamsList(ctx)
+		require.True(t, found)
+		require.Len(t, chainParamsList.ChainParams, 4)
+
+		// only bitcoin mainnet requires the default tiers
+		require.Equal(t, types.DefaultBtcConfirmationTiers(), chainParamsList.ChainParams[0].ConfirmationTiers)
+		require.Empty(t, chainParamsList.ChainParams[1].ConfirmationTiers)
+		require.Empty(t, chainParamsList.ChainParams[2].ConfirmationTiers)
+		require.Empty(t, chainParamsList.ChainParams[3].ConfirmationTiers)
+	})
+}
@@ -4,9 +4,19 @@ import (
 	"fmt"
 
 	cosmoserrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+const (
+	// MaxPrunedBallotLists is the maximum number of ballot lists visited by a ballot pruning call
+	MaxPrunedBallotLists = 1000
+
+	// MaxPrunedBallots is the number of deleted ballots after which a ballot pruning call stops
+	// together with MaxPrunedBallotLists, this bounds the gas consumed by the pruning in BeginBlock
+	MaxPrunedBallots = 1000
+)
+
 func (m Ballot) AddVote(address string, vote VoteType) (Ballot, error) {
 	if m.HasVoted(address) {
 		return m, cosmoserrors.Wrap(
@@ -43,26 +53,56 @@ func (m Ballot) GetVoterIndex(address string) int {
 	return index
 }
 
+// IsWeighted returns true if the votes of the ballot are weighted by the voting power of the voters
+func (m Ballot) IsWeighted() bool {
+	return len(m.VotingPower) > 0 && len(m.VotingPower) == len(m.VoterList)
+}
+
+// GetVoterPower returns the voting power of the voter at the given index of the `VoterList`
+// Each voter has a voting power of one if the votes of the ballot are not weighted
+func (m Ballot) GetVoterPower(index int) sdkmath.Int {
+	if !m.IsWeighted() {
+		return sdkmath.OneInt()
+	}
+	return m.VotingPower[index]
+}
+
+// Tally returns the voting power of each vote type of the ballot
+func (m Ballot) Tally() BallotTally {
+	tally := BallotTally{
+		SuccessPower: sdkmath.ZeroInt(),
+		FailurePower: sdkmath.ZeroInt(),
+		TotalPower:   sdkmath.ZeroInt(),
+	}
+	for i := range m.VoterList {
+		tally.TotalPower = tally.TotalPower.Add(m.GetVoterPower(i))
+	}
+	for i, vote := range m.Votes {
+		switch vote {
+		case VoteType_SuccessObservation:
+			tally.SuccessPower = tally.SuccessPower.Add(m.GetVoterPower(i))
+		case VoteType_FailureObservation:
+			tally.FailurePower = tally.FailurePower.Add(m.GetVoterPower(i))
+		}
+	}
+	tally.NotVotedPower = tally.TotalPower.Sub(tally.SuccessPower).Sub(tally.FailurePower)
+	return tally
+}
+
 // IsFinalizingVote checks sets the ballot to a final status if enough votes have been added
 // If it has already been finalized it returns false
 // It enough votes have not been added it returns false
+// The votes are compared to the threshold using the voting power of the voters if the ballot is weighted
 func (m Ballot) IsFinalizingVote() (Ballot, bool) {
 	if m.BallotStatus != BallotStatus_BallotInProgress {
 		return m, false
 	}
-	success, failure := sdk.ZeroDec(), sdk.ZeroDec()
-	total := sdk.NewDec(int64(len(m.VoterList)))
-	if total.IsZero() {
+	tally := m.Tally()
+	if tally.TotalPower.IsZero() {
 		return m, false
 	}
-	for _, vote := range m.Votes {
-		if vote == VoteType_SuccessObservation {
-			success = success.Add(sdk.OneDec())
-		}
-		if vote == VoteType_FailureObservation {
-			failure = failure.Add(sdk.OneDec())
-		}
-	}
+	total := sdk.NewDecFromInt(tally.TotalPower)
+	success, failure := sdk.NewDecFromInt(tally.SuccessPower), sdk.NewDecFromInt(tally.FailurePower)
 	if failure.IsPositive() {
 		if failure.Quo(total).GTE(m.BallotThreshold) {
 			m.BallotStatus = BallotStatus_BallotFinalized_FailureObservation
@@ -92,6 +92,9 @@ type Ballot struct {
 	BallotThreshold      github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=ballot_threshold,json=ballotThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"ballot_threshold"`
 	BallotStatus         BallotStatus                           `protobuf:"varint,7,opt,name=ballot_status,json=ballotStatus,proto3,enum=zetachain.zetacore.observer.BallotStatus" json:"ballot_status,omitempty"`
 	BallotCreationHeight int64                                  `protobuf:"varint,8,opt,name=ballot_creation_height,json=ballotCreationHeight,proto3" json:"ballot_creation_height,omitempty"`
+	// voting power of each voter of the voter list, empty if the votes are not
+	// weighted
+	VotingPower []github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,9,rep,name=voting_power,json=votingPower,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"voting_power"`
 }
 
 func (m *Ballot) Reset()         { *m = Ballot{} }
@@ -176,6 +179,47 @@ func (m *Ballot) GetBallotCreationHeight() int64 {
 	return 0
 }
 
+// BallotTally is the voting power of each vote type of a ballot
+type BallotTally struct {
+	SuccessPower  github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=success_power,json=successPower,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"success_power"`
+	FailurePower  github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=failure_power,json=failurePower,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"failure_power"`
+	NotVotedPower github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=not_voted_power,json=notVotedPower,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"not_voted_power"`
+	TotalPower    github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=total_power,json=totalPower,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_power"`
+}
+
+func (m *BallotTally) Reset()         { *m = BallotTally{} }
+func (m *BallotTally) String() string { return proto.CompactTextString(m) }
+func (*BallotTally) ProtoMessage()    {}
+func (*BallotTally) Descriptor() ([]byte, []int) {
+	return fileDescriptor_18c7141b763f2e87, []int{1}
+}
+func (m *BallotTally) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BallotTally) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BallotTally.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BallotTally) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BallotTally.Merge(m, src)
+}
+func (m *BallotTally) XXX_Size() int {
+	return m.Size()
+}
+func (m *BallotTally) XXX_DiscardUnknown() {
+	xxx_messageInfo_BallotTally.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BallotTally proto.InternalMessageInfo
+
 type BallotListForHeight struct {
 	Height           int64    `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
 	BallotsIndexList []string `protobuf:"bytes,2,rep,name=ballots_index_list,json=ballotsIndexList,proto3" json:"ballots_index_list,omitempty"`
@@ -185,7 +229,7 @@ func (m *BallotListForHeight) Reset()         { *m = BallotListForHeight{} }
 func (m *BallotListForHeight) String() string { return proto.CompactTextString(m) }
 func (*BallotListForHeight) ProtoMessage()    {}
 func (*BallotListForHeight) Descriptor() ([]byte, []int) {
-	return fileDescriptor_18c7141b763f2e87, []int{1}
+	return fileDescriptor_18c7141b763f2e87, []int{2}
 }
 func (m *BallotListForHeight) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -232,6 +276,7 @@ func init() {
 	proto.RegisterEnum("zetachain.zetacore.observer.VoteType", VoteType_name, VoteType_value)
 	proto.RegisterEnum("zetachain.zetacore.observer.BallotStatus", BallotStatus_name, BallotStatus_value)
 	proto.RegisterType((*Ballot)(nil), "zetachain.zetacore.observer.Ballot")
+	proto.RegisterType((*BallotTally)(nil), "zetachain.zetacore.observer.BallotTally")
 	proto.RegisterType((*BallotListForHeight)(nil), "zetachain.zetacore.observer.BallotListForHeight")
 }
 
@@ -240,42 +285,48 @@ func init() {
 }
 
 var fileDescriptor_18c7141b763f2e87 = []byte{
-	// 545 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x53, 0xc1, 0x6e, 0xd3, 0x4c,
-	0x10, 0x8e, 0x93, 0x26, 0x7f, 0xb3, 0x7f, 0x69, 0xc2, 0x12, 0x45, 0x56, 0x10, 0x6e, 0x14, 0x89,
-	0xca, 0xa4, 0xad, 0x2d, 0x15, 0x6e, 0xdc, 0x02, 0x44, 0x44, 0x42, 0x05, 0xdc, 0x0a, 0x54, 0x38,
-	0x58, 0x8e, 0x3d, 0xc4, 0x2b, 0x5c, 0x6f, 0xb4, 0xbb, 0xa9, 0xda, 0x3c, 0x05, 0x0f, 0xc1, 0x81,
-	0x47, 0xe9, 0xb1, 0x47, 0xc4, 0xa1, 0x82, 0xe4, 0x45, 0xd0, 0xee, 0xda, 0xad, 0x91, 0xa2, 0x9c,
-	0x76, 0x67, 0xe6, 0x9b, 0x6f, 0x66, 0xe7, 0xdb, 0x41, 0xf6, 0x1c, 0x44, 0x10, 0xc6, 0x01, 0x49,
-	0x5d, 0x75, 0xa3, 0x0c, 0x5c, 0x3a, 0xe6, 0xc0, 0xce, 0x81, 0xb9, 0xe3, 0x20, 0x49, 0xa8, 0x70,
-	0xa6, 0x8c, 0x0a, 0x8a, 0x1f, 0xde, 0x22, 0x9d, 0x1c, 0xe9, 0xe4, 0xc8, 0x4e, 0x6b, 0x42, 0x27,
-	0x54, 0xe1, 0x5c, 0x79, 0xd3, 0x29, 0x9d, 0xfe, 0x3a, 0xf2, 0xfc, 0xa2, 0xb1, 0xbd, 0x3f, 0x15,
-	0x54, 0x1b, 0xa8, 0x7a, 0xb8, 0x85, 0xaa, 0x24, 0x8d, 0xe0, 0xc2, 0x34, 0xba, 0x86, 0x5d, 0xf7,
-	0xb4, 0x81, 0xf7, 0xd0, 0x7d, 0xdd, 0x8f, 0x4f, 0x22, 0x48, 0x05, 0xf9, 0x42, 0x80, 0x99, 0x65,
-	0x85, 0x68, 0xea, 0xc0, 0xe8, 0xd6, 0x8f, 0x1f, 0x21, 0x74, 0x4e, 0x05, 0x30, 0x3f, 0x21, 0x5c,
-	0x98, 0x95, 0x6e, 0xc5, 0xae, 0x7b, 0x75, 0xe5, 0x79, 0x43, 0xb8, 0xc0, 0xcf, 0x51, 0x55, 0x1a,
-	0xdc, 0xdc, 0xe8, 0x56, 0xec, 0xed, 0xc3, 0xc7, 0xce, 0x9a, 0xb7, 0x39, 0x1f, 0xa8, 0x80, 0x93,
-	0xcb, 0x29, 0x78, 0x3a, 0x07, 0x7f, 0x44, 0x4d, 0x1d, 0x0b, 0x04, 0xa1, 0xa9, 0x2f, 0x2e, 0xa7,
-	0x60, 0x56, 0xbb, 0x86, 0xbd, 0x7d, 0xb8, 0xbf, 0x96, 0xe7, 0xed, 0x5d, 0x92, 0xa2, 0x6b, 0xd0,
-	0x7f, 0x1d, 0xf8, 0x14, 0x65, 0x0f, 0xf1, 0x45, 0xcc, 0x80, 0xc7, 0x34, 0x89, 0xcc, 0x9a, 0x7c,
-	0xe0, 0xc0, 0xb9, 0xba, 0xd9, 0x29, 0xfd, 0xba, 0xd9, 0xd9, 0x9d, 0x10, 0x11, 0xcf, 0xc6, 0x4e,
-	0x48, 0xcf, 0xdc, 0x90, 0xf2, 0x33, 0xca, 0xb3, 0xe3, 0x80, 0x47, 0x5f, 0x5d, 0xd9, 0x09, 0x77,
-	0x5e, 0x42, 0xe8, 0x35, 0x34, 0xcf, 0x49, 0x4e, 0x83, 0x8f, 0xd0, 0xbd, 0x8c, 0x9a, 0x8b, 0x40,
-	0xcc, 0xb8, 0xf9, 0x9f, 0x6a, 0xf8, 0xc9, 0xda, 0x86, 0xb5, 0x1c, 0xc7, 0x2a, 0xc1, 0xdb, 0x1a,
-	0x17, 0x2c, 0xfc, 0x0c, 0xb5, 0x33, 0xbe, 0x90, 0x81, 0x9e, 0x43, 0x0c, 0x64, 0x12, 0x0b, 0x73,
-	0xb3, 0x6b, 0xd8, 0x15, 0xaf, 0xa5, 0xa3, 0x2f, 0xb2, 0xe0, 0x6b, 0x15, 0xeb, 0x7d, 0x46, 0x0f,
-	0x34, 0xa7, 0x14, 0x61, 0x48, 0x99, 0x76, 0xe3, 0x36, 0xaa, 0x65, 0xc9, 0x86, 0x4a, 0xce, 0x2c,
-	0xbc, 0x8f, 0xb0, 0xa6, 0xe1, 0xbe, 0xfa, 0x02, 0x5a, 0xcc, 0xb2, 0x12, 0x33, 0x9b, 0x14, 0x1f,
-	0xc9, 0x80, 0xa4, 0xeb, 0xbf, 0x47, 0x9b, 0xb9, 0x52, 0xb8, 0x8d, 0xf0, 0xf1, 0x2c, 0x0c, 0x81,
-	0xf3, 0xc2, 0xd0, 0x9b, 0x25, 0xe9, 0x1f, 0x06, 0x24, 0x99, 0x31, 0x28, 0xfa, 0x0d, 0xdc, 0x40,
-	0xff, 0x1f, 0x51, 0x71, 0x0a, 0x42, 0x32, 0x44, 0xcd, 0x72, 0x67, 0xe3, 0xc7, 0x77, 0xcb, 0xe8,
-	0xcf, 0xd1, 0x56, 0x71, 0x06, 0x78, 0x17, 0xf5, 0xb4, 0x3d, 0x24, 0x69, 0x90, 0x90, 0x39, 0x44,
-	0xfe, 0xca, 0x32, 0x2b, 0x70, 0x2b, 0xcb, 0xb6, 0x50, 0x53, 0xe3, 0x46, 0xe9, 0x3b, 0x46, 0x27,
-	0x0c, 0x38, 0xcf, 0x6b, 0x0f, 0x5e, 0x5d, 0x2d, 0x2c, 0xe3, 0x7a, 0x61, 0x19, 0xbf, 0x17, 0x96,
-	0xf1, 0x6d, 0x69, 0x95, 0xae, 0x97, 0x56, 0xe9, 0xe7, 0xd2, 0x2a, 0x7d, 0xda, 0x2b, 0x7c, 0x02,
-	0x29, 0xda, 0x81, 0xde, 0xb0, 0x94, 0x46, 0xe0, 0x5e, 0xdc, 0xed, 0x97, 0xfa, 0x0d, 0xe3, 0x9a,
-	0xda, 0xae, 0xa7, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x5c, 0x75, 0x1d, 0x77, 0xe8, 0x03, 0x00,
-	0x00,
+	// 647 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xcb, 0x4e, 0xdb, 0x4c,
+	0x14, 0x80, 0xe3, 0x24, 0xe4, 0x27, 0x27, 0x81, 0xe4, 0x9f, 0x46, 0xc8, 0xa2, 0xaa, 0xb1, 0x90,
+	0x8a, 0x52, 0x2e, 0xb6, 0x44, 0xbb, 0xeb, 0x8e, 0xb6, 0xa8, 0x91, 0x2a, 0x2e, 0x06, 0x51, 0xd1,
+	0x2e, 0x2c, 0xc7, 0x1e, 0x92, 0x51, 0x8d, 0x27, 0x9a, 0x99, 0x50, 0xe0, 0x29, 0xfa, 0x10, 0x5d,
+	0xf4, 0x01, 0xfa, 0x10, 0x2c, 0x59, 0x56, 0x5d, 0x20, 0x04, 0x2f, 0x52, 0xcd, 0xc5, 0x90, 0x4a,
+	0x51, 0xa4, 0x66, 0x65, 0x9f, 0xcb, 0x7c, 0xe7, 0x36, 0x67, 0xa0, 0x7d, 0x89, 0x45, 0x14, 0xf7,
+	0x23, 0x92, 0xf9, 0xea, 0x8f, 0x32, 0xec, 0xd3, 0x2e, 0xc7, 0xec, 0x0c, 0x33, 0xbf, 0x1b, 0xa5,
+	0x29, 0x15, 0xde, 0x80, 0x51, 0x41, 0xd1, 0xd3, 0x07, 0x4f, 0x2f, 0xf7, 0xf4, 0x72, 0xcf, 0xc5,
+	0x56, 0x8f, 0xf6, 0xa8, 0xf2, 0xf3, 0xe5, 0x9f, 0x3e, 0xb2, 0xb8, 0x3a, 0x09, 0x9e, 0xff, 0x68,
+	0xdf, 0xe5, 0x9f, 0x65, 0xa8, 0x6c, 0xa9, 0x78, 0xa8, 0x05, 0x33, 0x24, 0x4b, 0xf0, 0xb9, 0x6d,
+	0xb9, 0x56, 0xbb, 0x1a, 0x68, 0x01, 0xad, 0xc1, 0xff, 0x3a, 0x9f, 0x90, 0x24, 0x38, 0x13, 0xe4,
+	0x84, 0x60, 0x66, 0x17, 0x95, 0x47, 0x53, 0x1b, 0x3a, 0x0f, 0x7a, 0xf4, 0x0c, 0xe0, 0x8c, 0x0a,
+	0xcc, 0xc2, 0x94, 0x70, 0x61, 0x97, 0xdc, 0x52, 0xbb, 0x1a, 0x54, 0x95, 0xe6, 0x03, 0xe1, 0x02,
+	0xbd, 0x86, 0x19, 0x29, 0x70, 0xbb, 0xec, 0x96, 0xda, 0xf3, 0x9b, 0xcf, 0xbd, 0x09, 0xb5, 0x79,
+	0x47, 0x54, 0xe0, 0xc3, 0x8b, 0x01, 0x0e, 0xf4, 0x19, 0xf4, 0x11, 0x9a, 0xda, 0x16, 0x09, 0x42,
+	0xb3, 0x50, 0x5c, 0x0c, 0xb0, 0x3d, 0xe3, 0x5a, 0xed, 0xf9, 0xcd, 0xf5, 0x89, 0x9c, 0xdd, 0xc7,
+	0x43, 0x0a, 0xd7, 0xa0, 0x7f, 0x2b, 0xd0, 0x31, 0x98, 0x42, 0x42, 0xd1, 0x67, 0x98, 0xf7, 0x69,
+	0x9a, 0xd8, 0x15, 0x59, 0xe0, 0x96, 0x77, 0x75, 0xb3, 0x54, 0xf8, 0x7d, 0xb3, 0xb4, 0xd2, 0x23,
+	0xa2, 0x3f, 0xec, 0x7a, 0x31, 0x3d, 0xf5, 0x63, 0xca, 0x4f, 0x29, 0x37, 0x9f, 0x0d, 0x9e, 0x7c,
+	0xf1, 0x65, 0x26, 0xdc, 0x7b, 0x8b, 0xe3, 0xa0, 0xa1, 0x39, 0x87, 0x39, 0x06, 0xed, 0xc0, 0x9c,
+	0x41, 0x73, 0x11, 0x89, 0x21, 0xb7, 0xff, 0x53, 0x09, 0xbf, 0x98, 0x98, 0xb0, 0x1e, 0xc7, 0x81,
+	0x3a, 0x10, 0xd4, 0xbb, 0x23, 0x12, 0x7a, 0x05, 0x0b, 0x86, 0x17, 0x33, 0xac, 0xfb, 0xd0, 0xc7,
+	0xa4, 0xd7, 0x17, 0xf6, 0xac, 0x6b, 0xb5, 0x4b, 0x41, 0x4b, 0x5b, 0xdf, 0x18, 0xe3, 0x7b, 0x65,
+	0x43, 0xfb, 0x50, 0x3f, 0xa3, 0x82, 0x64, 0xbd, 0x70, 0x40, 0xbf, 0x62, 0x66, 0x57, 0xdd, 0xd2,
+	0x3f, 0x16, 0xd7, 0xc9, 0x44, 0x50, 0xd3, 0x8c, 0x3d, 0x89, 0x58, 0xbe, 0x2d, 0x42, 0x4d, 0xe7,
+	0x79, 0x18, 0xa5, 0xe9, 0x05, 0x3a, 0x80, 0x39, 0x3e, 0x8c, 0x63, 0xcc, 0xb9, 0x89, 0x61, 0xb9,
+	0xd6, 0x14, 0x31, 0xea, 0x06, 0xa2, 0x82, 0x48, 0xe8, 0x49, 0x44, 0xd2, 0x21, 0xc3, 0x06, 0x5a,
+	0x9c, 0x0e, 0x6a, 0x20, 0x1a, 0x7a, 0x04, 0x8d, 0x8c, 0x8a, 0x50, 0xde, 0xa9, 0xc4, 0x60, 0x4b,
+	0x53, 0x61, 0xe7, 0x32, 0x2a, 0xe4, 0x1d, 0x4d, 0x34, 0x77, 0x17, 0x6a, 0x82, 0x8a, 0x28, 0x35,
+	0xcc, 0xf2, 0x54, 0x4c, 0x50, 0x08, 0xdd, 0xe2, 0xcf, 0xf0, 0x44, 0x77, 0x58, 0xae, 0xce, 0x36,
+	0x65, 0x66, 0x98, 0x0b, 0x50, 0x31, 0x23, 0xb7, 0xd4, 0xc8, 0x8d, 0x84, 0xd6, 0x01, 0xe9, 0xe1,
+	0xf3, 0x50, 0x2d, 0xae, 0x5e, 0xc1, 0xa2, 0x5a, 0x41, 0x73, 0xbf, 0x79, 0x47, 0x1a, 0x24, 0x6e,
+	0x75, 0x1f, 0x66, 0xf3, 0xfd, 0x42, 0x0b, 0x80, 0x0e, 0x74, 0xdb, 0x47, 0x56, 0xa5, 0x59, 0x90,
+	0xfa, 0x6d, 0xdd, 0xb9, 0x51, 0xbd, 0x85, 0x1a, 0x50, 0xdb, 0xa1, 0xe2, 0x18, 0xeb, 0xea, 0x9b,
+	0xc5, 0xc5, 0xf2, 0x8f, 0xef, 0x8e, 0xb5, 0x7a, 0x09, 0xf5, 0xd1, 0x9b, 0x8b, 0x56, 0x60, 0x59,
+	0xcb, 0xdb, 0x24, 0x8b, 0x52, 0x72, 0x89, 0x93, 0x70, 0x6c, 0x98, 0x31, 0x7e, 0x63, 0xc3, 0xb6,
+	0xa0, 0xa9, 0xfd, 0x3a, 0xd9, 0x1e, 0xa3, 0x3d, 0x86, 0x39, 0xcf, 0x63, 0x6f, 0xbd, 0xbb, 0xba,
+	0x73, 0xac, 0xeb, 0x3b, 0xc7, 0xba, 0xbd, 0x73, 0xac, 0x6f, 0xf7, 0x4e, 0xe1, 0xfa, 0xde, 0x29,
+	0xfc, 0xba, 0x77, 0x0a, 0x9f, 0xd6, 0x46, 0x3a, 0x2f, 0x57, 0x6d, 0x43, 0xbf, 0x8b, 0x19, 0x4d,
+	0xb0, 0x7f, 0xfe, 0xf8, 0x2a, 0xaa, 0x11, 0x74, 0x2b, 0xea, 0x4d, 0x7c, 0xf9, 0x67, 0x00, 0x42,
+	0x5c, 0x1a, 0x72, 0x9e, 0x05, 0x00, 0x00,
 }
 
 func (m *Ballot) Marshal() (dAtA []byte, err error) {
@@ -298,6 +349,20 @@ func (m *Ballot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.VotingPower) > 0 {
+		for iNdEx := len(m.VotingPower) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.VotingPower[iNdEx].Size()
+				i -= size
+				if _, err := m.VotingPower[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintBallot(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if m.BallotCreationHeight != 0 {
 		i = encodeVarintBallot(dAtA, i, uint64(m.BallotCreationHeight))
 		i--
@@ -367,6 +432,69 @@ func (m *Ballot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *BallotTally) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BallotTally) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BallotTally) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TotalPower.Size()
+		i -= size
+		if _, err := m.TotalPower.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBallot(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.NotVotedPower.Size()
+		i -= size
+		if _, err := m.NotVotedPower.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBallot(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.FailurePower.Size()
+		i -= size
+		if _, err := m.FailurePower.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBallot(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.SuccessPower.Size()
+		i -= size
+		if _, err := m.SuccessPower.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBallot(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *BallotListForHeight) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -453,6 +581,29 @@ func (m *Ballot) Size() (n int) {
 	if m.BallotCreationHeight != 0 {
 		n += 1 + sovBallot(uint64(m.BallotCreationHeight))
 	}
+	if len(m.VotingPower) > 0 {
+		for _, e := range m.VotingPower {
+			l = e.Size()
+			n += 1 + l + sovBallot(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BallotTally) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SuccessPower.Size()
+	n += 1 + l + sovBallot(uint64(l))
+	l = m.FailurePower.Size()
+	n += 1 + l + sovBallot(uint64(l))
+	l = m.NotVotedPower.Size()
+	n += 1 + l + sovBallot(uint64(l))
+	l = m.TotalPower.Size()
+	n += 1 + l + sovBallot(uint64(l))
 	return n
 }
 
@@ -765,6 +916,228 @@ func (m *Ballot) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBallot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBallot
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBallot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.VotingPower = append(m.VotingPower, v)
+			if err := m.VotingPower[len(m.VotingPower)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBallot(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBallot
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BallotTally) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBallot
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BallotTally: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BallotTally: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuccessPower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBallot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBallot
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBallot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SuccessPower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailurePower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBallot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBallot
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBallot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FailurePower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NotVotedPower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBallot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBallot
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBallot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NotVotedPower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBallot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBallot
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBallot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalPower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBallot(dAtA[iNdEx:])
@@ -3,6 +3,7 @@ package types
 import (
 	"testing"
 
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 )
@@ -290,6 +291,7 @@ func TestBallot_IsFinalizingVote(t *testing.T) {
 	tt := []struct {
 		name            string
 		BallotThreshold sdk.Dec
+		VotingPower     []int64
 		Votes           []VoteType
 		finalizingVote  int
 		finalStatus     BallotStatus
@@ -374,6 +376,32 @@ func TestBallot_IsFinalizingVote(t *testing.T) {
 			finalizingVote: 0,
 			finalStatus:    BallotStatus_BallotInProgress,
 		},
+		{
+			name:            "weighted votes finalized to success by the voter with most power",
+			BallotThreshold: sdk.MustNewDecFromStr("0.66"),
+			VotingPower:     []int64{70, 10, 10, 10},
+			Votes: []VoteType{
+				VoteType_SuccessObservation,
+				VoteType_NotYetVoted,
+				VoteType_NotYetVoted,
+				VoteType_NotYetVoted,
+			},
+			finalizingVote: 0,
+			finalStatus:    BallotStatus_BallotFinalized_SuccessObservation,
+		},
+		{
+			name:            "weighted votes not finalized by the majority of voters with low power",
+			BallotThreshold: sdk.MustNewDecFromStr("0.66"),
+			VotingPower:     []int64{10, 10, 10, 70},
+			Votes: []VoteType{
+				VoteType_FailureObservation,
+				VoteType_FailureObservation,
+				VoteType_FailureObservation,
+				VoteType_NotYetVoted,
+			},
+			finalizingVote: 0,
+			finalStatus:    BallotStatus_BallotInProgress,
+		},
 		{
 			name:            "100 percent threshold can finalize with 100 percent votes",
 			BallotThreshold: sdk.MustNewDecFromStr("1"),
@@ -404,6 +432,9 @@ func TestBallot_IsFinalizingVote(t *testing.T) {
 				BallotThreshold: test.BallotThreshold,
 				VoterList:       make([]string, len(test.Votes)),
 			}
+			for _, power := range test.VotingPower {
+				ballot.VotingPower = append(ballot.VotingPower, sdkmath.NewInt(power))
+			}
 			isFinalizingVote := false
 			for index, vote := range test.Votes {
 				ballot.Votes = append(ballot.Votes, vote)
@@ -417,6 +448,45 @@ func TestBallot_IsFinalizingVote(t *testing.T) {
 	}
 }
 
+func TestBallot_Tally(t *testing.T) {
+	t.Run("should count one vote per voter if the ballot is not weighted", func(t *testing.T) {
+		ballot := Ballot{
+			VoterList: []string{"Observer1", "Observer2", "Observer3"},
+			Votes: []VoteType{
+				VoteType_SuccessObservation,
+				VoteType_FailureObservation,
+				VoteType_NotYetVoted,
+			},
+		}
+		require.False(t, ballot.IsWeighted())
+
+		tally := ballot.Tally()
+		require.EqualValues(t, 1, tally.SuccessPower.Int64())
+		require.EqualValues(t, 1, tally.FailurePower.Int64())
+		require.EqualValues(t, 1, tally.NotVotedPower.Int64())
+		require.EqualValues(t, 3, tally.TotalPower.Int64())
+	})
+
+	t.Run("should sum the voting power of the voters if the ballot is weighted", func(t *testing.T) {
+		ballot := Ballot{
+			VoterList: []string{"Observer1", "Observer2", "Observer3"},
+			Votes: []VoteType{
+				VoteType_SuccessObservation,
+				VoteType_FailureObservation,
+				VoteType_NotYetVoted,
+			},
+			VotingPower: []sdkmath.Int{sdkmath.NewInt(100), sdkmath.NewInt(20), sdkmath.NewInt(3)},
+		}
+		require.True(t, ballot.IsWeighted())
+
+		tally := ballot.Tally()
+		require.EqualValues(t, 100, tally.SuccessPower.Int64())
+		require.EqualValues(t, 20, tally.FailurePower.Int64())
+		require.EqualValues(t, 3, tally.NotVotedPower.Int64())
+		require.EqualValues(t, 123, tally.TotalPower.Int64())
+	})
+}
+
 func Test_BuildRewardsDistribution(t *testing.T) {
 	tt := []struct {
 		name         string
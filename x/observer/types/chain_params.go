@@ -5,11 +5,13 @@ import (
 	"strings"
 
 	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	ethchains "github.com/ethereum/go-ethereum/common"
 
 	"github.com/zeta-chain/node/pkg/chains"
+	"github.com/zeta-chain/node/pkg/coin"
 	"github.com/zeta-chain/node/pkg/constant"
 )
 
@@ -18,6 +20,10 @@ var (
 	DefaultBallotThreshold       = sdk.MustNewDecFromStr("0.66")
 )
 
+// MinConsolidationUTXOs is the minimum number of UTXOs worth consolidating, the consolidation outputs
+// a nonce-mark and a change to TSS so consolidating fewer UTXOs doesn't reduce their number
+const MinConsolidationUTXOs = 3
+
 // Validate checks all chain params correspond to a chain and there is no duplicate chain id
 func (cpl ChainParamsList) Validate() error {
 	// check all chain params correspond to a chain
@@ -123,9 +129,145 @@ func ValidateChainParams(params *ChainParams) error {
 		return ErrParamsMinObserverDelegation
 	}
 
+	if err := validateUTXOConsolidation(params); err != nil {
+		return err
+	}
+
+	return validateConfirmationTiers(params.ConfirmationTiers, params.ConfirmationCount)
+}
+
+// validateUTXOConsolidation checks the UTXO consolidation thresholds are only set for Bitcoin chains
+func validateUTXOConsolidation(params *ChainParams) error {
+	isSet := params.UtxoConsolidationThreshold > 0 ||
+		params.UtxoDustRatioThreshold > 0 ||
+		params.UtxoConsolidationMaxFeeRate > 0
+	if isSet && !chains.IsBitcoinChain(params.ChainId, nil) {
+		return errorsmod.Wrapf(
+			sdkerrors.ErrInvalidRequest,
+			"UTXO consolidation can't be set for non-Bitcoin chain %d",
+			params.ChainId,
+		)
+	}
+	if params.UtxoDustRatioThreshold > 100 {
+		return errorsmod.Wrapf(
+			sdkerrors.ErrInvalidRequest,
+			"UtxoDustRatioThreshold %d out of range",
+			params.UtxoDustRatioThreshold,
+		)
+	}
 	return nil
 }
 
+// IsUTXOConsolidationEnabled returns true if the consolidation of the TSS UTXOs can be scheduled for the chain
+func (cp ChainParams) IsUTXOConsolidationEnabled() bool {
+	return cp.UtxoConsolidationThreshold > 0 || cp.UtxoDustRatioThreshold > 0
+}
+
+// IsUTXOConsolidationNeeded returns true if the TSS UTXOs should be consolidated according to the chain params:
+//   - the number of UTXOs reaches the consolidation threshold, or
+//   - the percentage of dust UTXOs reaches the dust ratio threshold
+func (cp ChainParams) IsUTXOConsolidationNeeded(utxoCount, dustCount uint64) bool {
+	if !cp.IsUTXOConsolidationEnabled() || utxoCount < MinConsolidationUTXOs || dustCount > utxoCount {
+		return false
+	}
+	if cp.UtxoConsolidationThreshold > 0 && utxoCount >= cp.UtxoConsolidationThreshold {
+		return true
+	}
+	return cp.UtxoDustRatioThreshold > 0 && dustCount*100 >= uint64(cp.UtxoDustRatioThreshold)*utxoCount
+}
+
+// validateConfirmationTiers checks the confirmation tiers are well-formed, there is no duplicate tier
+// and no tier requires fewer confirmations than the default confirmation count
+func validateConfirmationTiers(tiers []ConfirmationTier, confirmationCount uint64) error {
+	type tierKey struct {
+		coinType  coin.CoinType
+		asset     string
+		threshold string
+	}
+	existing := make(map[tierKey]struct{})
+
+	for _, tier := range tiers {
+		switch tier.CoinType {
+		case coin.CoinType_Gas, coin.CoinType_Zeta:
+			if tier.Asset != "" {
+				return errorsmod.Wrapf(
+					sdkerrors.ErrInvalidRequest,
+					"confirmation tier of coin type %s cannot have an asset",
+					tier.CoinType,
+				)
+			}
+		case coin.CoinType_ERC20:
+			if tier.Asset == "" {
+				return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "confirmation tier of coin type ERC20 must have an asset")
+			}
+		default:
+			return errorsmod.Wrapf(
+				sdkerrors.ErrInvalidRequest,
+				"invalid confirmation tier coin type %s",
+				tier.CoinType,
+			)
+		}
+		if tier.AmountThreshold.IsNil() || tier.AmountThreshold.IsZero() {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "confirmation tier amount threshold must be positive")
+		}
+		if tier.ConfirmationCount < confirmationCount {
+			return errorsmod.Wrapf(
+				sdkerrors.ErrInvalidRequest,
+				"confirmation tier count %d is lower than ConfirmationCount %d",
+				tier.ConfirmationCount,
+				confirmationCount,
+			)
+		}
+
+		key := tierKey{tier.CoinType, strings.ToLower(tier.Asset), tier.AmountThreshold.String()}
+		if _, ok := existing[key]; ok {
+			return errorsmod.Wrapf(
+				sdkerrors.ErrInvalidRequest,
+				"duplicated confirmation tier for coin type %s asset %s threshold %s",
+				tier.CoinType,
+				tier.Asset,
+				tier.AmountThreshold,
+			)
+		}
+		existing[key] = struct{}{}
+	}
+	return nil
+}
+
+// ConfirmationCountForAmount returns the number of confirmations required for an inbound or outbound
+// of the given coin type, asset and amount. It is the highest confirmation count among the tiers
+// whose threshold is reached by the amount, or ConfirmationCount if no tier applies.
+func (cp *ChainParams) ConfirmationCountForAmount(coinType coin.CoinType, asset string, amount sdkmath.Uint) uint64 {
+	count := cp.ConfirmationCount
+	if amount.IsNil() {
+		return count
+	}
+	for _, tier := range cp.ConfirmationTiers {
+		if !tier.appliesTo(coinType, asset) || amount.LT(tier.AmountThreshold) {
+			continue
+		}
+		if tier.ConfirmationCount > count {
+			count = tier.ConfirmationCount
+		}
+	}
+	return count
+}
+
+// appliesTo returns true if the tier applies to the given coin type and asset
+// the asset is only compared for the ERC20 coin type, case-insensitively for hex addresses
+func (tier ConfirmationTier) appliesTo(coinType coin.CoinType, asset string) bool {
+	if tier.CoinType != coinType {
+		return false
+	}
+	if coinType != coin.CoinType_ERC20 {
+		return true
+	}
+	if ethchains.IsHexAddress(tier.Asset) && ethchains.IsHexAddress(asset) {
+		return strings.EqualFold(tier.Asset, asset)
+	}
+	return tier.Asset == asset
+}
+
 func validChainContractAddress(address string) bool {
 	if !strings.HasPrefix(address, "0x") {
 		return false
@@ -204,6 +346,7 @@ func GetDefaultBtcMainnetChainParams() *ChainParams {
 		BallotThreshold:             DefaultBallotThreshold,
 		MinObserverDelegation:       DefaultMinObserverDelegation,
 		IsSupported:                 false,
+		ConfirmationTiers:           DefaultBtcConfirmationTiers(),
 	}
 }
 func GetDefaultGoerliTestnetChainParams() *ChainParams {
@@ -277,6 +420,7 @@ func GetDefaultBtcTestnetChainParams() *ChainParams {
 		BallotThreshold:             DefaultBallotThreshold,
 		MinObserverDelegation:       DefaultMinObserverDelegation,
 		IsSupported:                 false,
+		ConfirmationTiers:           DefaultBtcConfirmationTiers(),
 	}
 }
 func GetDefaultBtcRegtestChainParams() *ChainParams {
@@ -297,6 +441,18 @@ func GetDefaultBtcRegtestChainParams() *ChainParams {
 		IsSupported:                 false,
 	}
 }
+
+// DefaultBtcConfirmationTiers returns the default confirmation tiers for Bitcoin chains
+// deposits and withdrawals of 2 BTC or more require 6 confirmations
+func DefaultBtcConfirmationTiers() []ConfirmationTier {
+	return []ConfirmationTier{
+		{
+			CoinType:          coin.CoinType_Gas,
+			AmountThreshold:   sdkmath.NewUint(200_000_000),
+			ConfirmationCount: 6,
+		},
+	}
+}
 func GetDefaultGoerliLocalnetChainParams() *ChainParams {
 	return &ChainParams{
 		ChainId:                     chains.GoerliLocalnet.ChainId,
@@ -351,5 +507,26 @@ func ChainParamsEqual(params1, params2 ChainParams) bool {
 		params1.BallotThreshold.Equal(params2.BallotThreshold) &&
 		params1.MinObserverDelegation.Equal(params2.MinObserverDelegation) &&
 		params1.IsSupported == params2.IsSupported &&
-		params1.GatewayAddress == params2.GatewayAddress
+		params1.GatewayAddress == params2.GatewayAddress &&
+		params1.WeightedVoting == params2.WeightedVoting &&
+		params1.UtxoConsolidationThreshold == params2.UtxoConsolidationThreshold &&
+		params1.UtxoDustRatioThreshold == params2.UtxoDustRatioThreshold &&
+		params1.UtxoConsolidationMaxFeeRate == params2.UtxoConsolidationMaxFeeRate &&
+		confirmationTiersEqual(params1.ConfirmationTiers, params2.ConfirmationTiers)
+}
+
+// confirmationTiersEqual returns true if two lists of confirmation tiers are equal
+func confirmationTiersEqual(tiers1, tiers2 []ConfirmationTier) bool {
+	if len(tiers1) != len(tiers2) {
+		return false
+	}
+	for i := range tiers1 {
+		if tiers1[i].CoinType != tiers2[i].CoinType ||
+			tiers1[i].Asset != tiers2[i].Asset ||
+			!tiers1[i].AmountThreshold.Equal(tiers2[i].AmountThreshold) ||
+			tiers1[i].ConfirmationCount != tiers2[i].ConfirmationCount {
+			return false
+		}
+	}
+	return true
 }
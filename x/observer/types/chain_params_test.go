@@ -1,13 +1,17 @@
 package types_test
 
 import (
+	"strings"
 	"testing"
 
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	. "gopkg.in/check.v1"
 
+	"github.com/zeta-chain/node/pkg/coin"
+	"github.com/zeta-chain/node/testutil/sample"
 	"github.com/zeta-chain/node/x/observer/types"
 )
 
@@ -52,6 +56,92 @@ func TestChainParamsEqual(t *testing.T) {
 	require.False(t, types.ChainParamsEqual(*params.ChainParams[0], *params.ChainParams[1]))
 }
 
+func TestChainParamsEqual_ConfirmationTiers(t *testing.T) {
+	params1 := *types.GetDefaultBtcMainnetChainParams()
+	params2 := *types.GetDefaultBtcMainnetChainParams()
+	require.True(t, types.ChainParamsEqual(params1, params2))
+
+	params2.ConfirmationTiers[0].ConfirmationCount++
+	require.False(t, types.ChainParamsEqual(params1, params2))
+
+	params2.ConfirmationTiers = nil
+	require.False(t, types.ChainParamsEqual(params1, params2))
+}
+
+func TestChainParamsEqual_WeightedVoting(t *testing.T) {
+	params1 := *types.GetDefaultBtcMainnetChainParams()
+	params2 := *types.GetDefaultBtcMainnetChainParams()
+	params2.WeightedVoting = true
+	require.False(t, types.ChainParamsEqual(params1, params2))
+}
+
+func TestChainParamsEqual_UTXOConsolidation(t *testing.T) {
+	params1 := *types.GetDefaultBtcMainnetChainParams()
+	params2 := *types.GetDefaultBtcMainnetChainParams()
+	params2.UtxoConsolidationThreshold = 100
+	require.False(t, types.ChainParamsEqual(params1, params2))
+
+	params2 = *types.GetDefaultBtcMainnetChainParams()
+	params2.UtxoDustRatioThreshold = 50
+	require.False(t, types.ChainParamsEqual(params1, params2))
+
+	params2 = *types.GetDefaultBtcMainnetChainParams()
+	params2.UtxoConsolidationMaxFeeRate = 5
+	require.False(t, types.ChainParamsEqual(params1, params2))
+}
+
+func TestChainParams_IsUTXOConsolidationNeeded(t *testing.T) {
+	params := *types.GetDefaultBtcMainnetChainParams()
+	require.False(t, params.IsUTXOConsolidationNeeded(1000, 1000))
+
+	params.UtxoConsolidationThreshold = 100
+	params.UtxoDustRatioThreshold = 50
+	require.True(t, params.IsUTXOConsolidationNeeded(100, 0))
+	require.True(t, params.IsUTXOConsolidationNeeded(10, 5))
+	require.False(t, params.IsUTXOConsolidationNeeded(99, 49))
+
+	// not enough UTXOs to consolidate
+	require.False(t, params.IsUTXOConsolidationNeeded(types.MinConsolidationUTXOs-1, types.MinConsolidationUTXOs-1))
+
+	// more dust UTXOs than UTXOs
+	require.False(t, params.IsUTXOConsolidationNeeded(10, 11))
+}
+
+func TestChainParams_ConfirmationCountForAmount(t *testing.T) {
+	asset := "0xA8D5060feb6B456e886F023709A2795373691E63"
+	params := types.ChainParams{
+		ConfirmationCount: 2,
+		ConfirmationTiers: []types.ConfirmationTier{
+			{CoinType: coin.CoinType_Gas, AmountThreshold: sdkmath.NewUint(1000), ConfirmationCount: 6},
+			{CoinType: coin.CoinType_Gas, AmountThreshold: sdkmath.NewUint(100), ConfirmationCount: 4},
+			{CoinType: coin.CoinType_ERC20, Asset: asset, AmountThreshold: sdkmath.NewUint(500), ConfirmationCount: 10},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		coinType coin.CoinType
+		asset    string
+		amount   sdkmath.Uint
+		expected uint64
+	}{
+		{"below lowest tier", coin.CoinType_Gas, "", sdkmath.NewUint(99), 2},
+		{"lower tier threshold", coin.CoinType_Gas, "", sdkmath.NewUint(100), 4},
+		{"between tiers", coin.CoinType_Gas, "", sdkmath.NewUint(999), 4},
+		{"higher tier", coin.CoinType_Gas, "", sdkmath.NewUint(5000), 6},
+		{"asset tier", coin.CoinType_ERC20, asset, sdkmath.NewUint(500), 10},
+		{"asset tier case-insensitive", coin.CoinType_ERC20, strings.ToLower(asset), sdkmath.NewUint(500), 10},
+		{"other asset", coin.CoinType_ERC20, sample.EthAddress().Hex(), sdkmath.NewUint(5000), 2},
+		{"other coin type", coin.CoinType_Zeta, "", sdkmath.NewUint(5000), 2},
+		{"nil amount", coin.CoinType_Gas, "", sdkmath.Uint{}, 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, params.ConfirmationCountForAmount(tt.coinType, tt.asset, tt.amount))
+		})
+	}
+}
+
 func (s *UpdateChainParamsSuite) SetupTest() {
 	s.evmParams = &types.ChainParams{
 		ConfirmationCount:           1,
@@ -107,6 +197,28 @@ func (s *UpdateChainParamsSuite) TestBTCParamsInvalid() {
 	require.NotNil(s.T(), err)
 }
 
+func (s *UpdateChainParamsSuite) TestUTXOConsolidation() {
+	copy := *s.btcParams
+	copy.UtxoConsolidationThreshold = 100
+	copy.UtxoDustRatioThreshold = 50
+	copy.UtxoConsolidationMaxFeeRate = 5
+	err := types.ValidateChainParams(&copy)
+	require.Nil(s.T(), err)
+	require.True(s.T(), copy.IsUTXOConsolidationEnabled())
+	require.False(s.T(), s.btcParams.IsUTXOConsolidationEnabled())
+
+	// dust ratio above 100%
+	copy.UtxoDustRatioThreshold = 101
+	err = types.ValidateChainParams(&copy)
+	require.ErrorContains(s.T(), err, "UtxoDustRatioThreshold 101 out of range")
+
+	// non-Bitcoin chain
+	copy = *s.evmParams
+	copy.UtxoConsolidationThreshold = 100
+	err = types.ValidateChainParams(&copy)
+	require.ErrorContains(s.T(), err, "non-Bitcoin chain")
+}
+
 func (s *UpdateChainParamsSuite) TestCoreContractAddresses() {
 	copy := *s.evmParams
 	copy.ZetaTokenContractAddress = "0x123"
@@ -139,6 +251,63 @@ func (s *UpdateChainParamsSuite) TestCoreContractAddresses() {
 	require.NotNil(s.T(), err)
 }
 
+func (s *UpdateChainParamsSuite) TestConfirmationTiers() {
+	asset := "0xA8D5060feb6B456e886F023709A2795373691E63"
+	validTier := types.ConfirmationTier{
+		CoinType:          coin.CoinType_Gas,
+		AmountThreshold:   sdkmath.NewUint(100),
+		ConfirmationCount: 2,
+	}
+
+	copy := *s.evmParams
+	copy.ConfirmationTiers = []types.ConfirmationTier{
+		validTier,
+		{CoinType: coin.CoinType_ERC20, Asset: asset, AmountThreshold: sdkmath.NewUint(100), ConfirmationCount: 3},
+	}
+	err := types.ValidateChainParams(&copy)
+	require.Nil(s.T(), err)
+
+	// gas tier with asset
+	tier := validTier
+	tier.Asset = asset
+	copy.ConfirmationTiers = []types.ConfirmationTier{tier}
+	err = types.ValidateChainParams(&copy)
+	require.ErrorContains(s.T(), err, "cannot have an asset")
+
+	// erc20 tier without asset
+	tier = validTier
+	tier.CoinType = coin.CoinType_ERC20
+	copy.ConfirmationTiers = []types.ConfirmationTier{tier}
+	err = types.ValidateChainParams(&copy)
+	require.ErrorContains(s.T(), err, "must have an asset")
+
+	// invalid coin type
+	tier = validTier
+	tier.CoinType = coin.CoinType_Cmd
+	copy.ConfirmationTiers = []types.ConfirmationTier{tier}
+	err = types.ValidateChainParams(&copy)
+	require.ErrorContains(s.T(), err, "invalid confirmation tier coin type")
+
+	// zero threshold
+	tier = validTier
+	tier.AmountThreshold = sdkmath.ZeroUint()
+	copy.ConfirmationTiers = []types.ConfirmationTier{tier}
+	err = types.ValidateChainParams(&copy)
+	require.ErrorContains(s.T(), err, "amount threshold must be positive")
+
+	// fewer confirmations than default
+	tier = validTier
+	tier.ConfirmationCount = 0
+	copy.ConfirmationTiers = []types.ConfirmationTier{tier}
+	err = types.ValidateChainParams(&copy)
+	require.ErrorContains(s.T(), err, "lower than ConfirmationCount")
+
+	// duplicated tier
+	copy.ConfirmationTiers = []types.ConfirmationTier{validTier, validTier}
+	err = types.ValidateChainParams(&copy)
+	require.ErrorContains(s.T(), err, "duplicated confirmation tier")
+}
+
 func (s *UpdateChainParamsSuite) Validate(params *types.ChainParams) {
 	copy := *params
 	copy.ConfirmationCount = 0
@@ -20,6 +20,10 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgEnableCCTX{}, "observer/EnableCCTX", nil)
 	cdc.RegisterConcrete(&MsgDisableCCTX{}, "observer/DisableCCTX", nil)
 	cdc.RegisterConcrete(&MsgUpdateGasPriceIncreaseFlags{}, "observer/UpdateGasPriceIncreaseFlags", nil)
+	cdc.RegisterConcrete(&MsgPauseCCTX{}, "observer/PauseCCTX", nil)
+	cdc.RegisterConcrete(&MsgUnpauseCCTX{}, "observer/UnpauseCCTX", nil)
+	cdc.RegisterConcrete(&MsgUpdateLivenessParams{}, "observer/UpdateLivenessParams", nil)
+	cdc.RegisterConcrete(&MsgUnjailObserver{}, "observer/UnjailObserver", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -36,6 +40,10 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgEnableCCTX{},
 		&MsgDisableCCTX{},
 		&MsgUpdateGasPriceIncreaseFlags{},
+		&MsgPauseCCTX{},
+		&MsgUnpauseCCTX{},
+		&MsgUpdateLivenessParams{},
+		&MsgUnjailObserver{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -1,6 +1,9 @@
 package types
 
-import "time"
+import (
+	"strings"
+	"time"
+)
 
 var DefaultGasPriceIncreaseFlags = GasPriceIncreaseFlags{
 	// EpochLength is the number of blocks in an epoch before triggering a gas price increase
@@ -29,3 +32,111 @@ func DefaultCrosschainFlags() *CrosschainFlags {
 		GasPriceIncreaseFlags: &DefaultGasPriceIncreaseFlags,
 	}
 }
+
+// IsChainInboundPaused returns true if the inbounds from the given chain are paused
+func (f CrosschainFlags) IsChainInboundPaused(chainID int64) bool {
+	flags, found := f.getChainPauseFlags(chainID)
+	return found && flags.IsInboundPaused
+}
+
+// IsChainOutboundPaused returns true if the outbounds to the given chain are paused
+func (f CrosschainFlags) IsChainOutboundPaused(chainID int64) bool {
+	flags, found := f.getChainPauseFlags(chainID)
+	return found && flags.IsOutboundPaused
+}
+
+// IsZRC20InboundPaused returns true if the deposits of the given ZRC20 are paused
+func (f CrosschainFlags) IsZRC20InboundPaused(zrc20Address string) bool {
+	flags, found := f.getZRC20PauseFlags(zrc20Address)
+	return found && flags.IsInboundPaused
+}
+
+// IsZRC20OutboundPaused returns true if the withdrawals of the given ZRC20 are paused
+func (f CrosschainFlags) IsZRC20OutboundPaused(zrc20Address string) bool {
+	flags, found := f.getZRC20PauseFlags(zrc20Address)
+	return found && flags.IsOutboundPaused
+}
+
+// PauseChain pauses the inbound and/or outbound of the given chain
+func (f *CrosschainFlags) PauseChain(chainID int64, inbound, outbound bool) {
+	flags, _ := f.getChainPauseFlags(chainID)
+	flags.ChainId = chainID
+	flags.IsInboundPaused = flags.IsInboundPaused || inbound
+	flags.IsOutboundPaused = flags.IsOutboundPaused || outbound
+	f.setChainPauseFlags(flags)
+}
+
+// UnpauseChain unpauses the inbound and/or outbound of the given chain
+func (f *CrosschainFlags) UnpauseChain(chainID int64, inbound, outbound bool) {
+	flags, _ := f.getChainPauseFlags(chainID)
+	flags.ChainId = chainID
+	flags.IsInboundPaused = flags.IsInboundPaused && !inbound
+	flags.IsOutboundPaused = flags.IsOutboundPaused && !outbound
+	f.setChainPauseFlags(flags)
+}
+
+// PauseZRC20 pauses the inbound and/or outbound of the given ZRC20
+func (f *CrosschainFlags) PauseZRC20(zrc20Address string, inbound, outbound bool) {
+	flags, _ := f.getZRC20PauseFlags(zrc20Address)
+	flags.Zrc20Address = zrc20Address
+	flags.IsInboundPaused = flags.IsInboundPaused || inbound
+	flags.IsOutboundPaused = flags.IsOutboundPaused || outbound
+	f.setZRC20PauseFlags(flags)
+}
+
+// UnpauseZRC20 unpauses the inbound and/or outbound of the given ZRC20
+func (f *CrosschainFlags) UnpauseZRC20(zrc20Address string, inbound, outbound bool) {
+	flags, _ := f.getZRC20PauseFlags(zrc20Address)
+	flags.Zrc20Address = zrc20Address
+	flags.IsInboundPaused = flags.IsInboundPaused && !inbound
+	flags.IsOutboundPaused = flags.IsOutboundPaused && !outbound
+	f.setZRC20PauseFlags(flags)
+}
+
+// getChainPauseFlags returns the pause flags of the given chain
+func (f CrosschainFlags) getChainPauseFlags(chainID int64) (ChainPauseFlags, bool) {
+	for _, flags := range f.PausedChains {
+		if flags.ChainId == chainID {
+			return flags, true
+		}
+	}
+	return ChainPauseFlags{}, false
+}
+
+// setChainPauseFlags sets the pause flags of a chain, the chain is removed from the list if nothing is paused
+func (f *CrosschainFlags) setChainPauseFlags(flags ChainPauseFlags) {
+	pausedChains := make([]ChainPauseFlags, 0, len(f.PausedChains)+1)
+	for _, existing := range f.PausedChains {
+		if existing.ChainId != flags.ChainId {
+			pausedChains = append(pausedChains, existing)
+		}
+	}
+	if flags.IsInboundPaused || flags.IsOutboundPaused {
+		pausedChains = append(pausedChains, flags)
+	}
+	f.PausedChains = pausedChains
+}
+
+// getZRC20PauseFlags returns the pause flags of the given ZRC20
+func (f CrosschainFlags) getZRC20PauseFlags(zrc20Address string) (ZRC20PauseFlags, bool) {
+	for _, flags := range f.PausedZRC20Tokens {
+		if strings.EqualFold(flags.Zrc20Address, zrc20Address) {
+			return flags, true
+		}
+	}
+	return ZRC20PauseFlags{}, false
+}
+
+// setZRC20PauseFlags sets the pause flags of a ZRC20, the ZRC20 is removed from the list if nothing is paused
+func (f *CrosschainFlags) setZRC20PauseFlags(flags ZRC20PauseFlags) {
+	pausedTokens := make([]ZRC20PauseFlags, 0, len(f.PausedZRC20Tokens)+1)
+	for _, existing := range f.PausedZRC20Tokens {
+		if !strings.EqualFold(existing.Zrc20Address, flags.Zrc20Address) {
+			pausedTokens = append(pausedTokens, existing)
+		}
+	}
+	if flags.IsInboundPaused || flags.IsOutboundPaused {
+		pausedTokens = append(pausedTokens, flags)
+	}
+	f.PausedZRC20Tokens = pausedTokens
+}
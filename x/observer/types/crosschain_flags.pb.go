@@ -107,17 +107,145 @@ func (m *GasPriceIncreaseFlags) GetMaxPendingCctxs() uint32 {
 	return 0
 }
 
+// ChainPauseFlags contains the pause switches of the inbounds from and the
+// outbounds to a connected chain
+type ChainPauseFlags struct {
+	ChainId          int64 `protobuf:"varint,1,opt,name=chainId,proto3" json:"chainId,omitempty"`
+	IsInboundPaused  bool  `protobuf:"varint,2,opt,name=isInboundPaused,proto3" json:"isInboundPaused,omitempty"`
+	IsOutboundPaused bool  `protobuf:"varint,3,opt,name=isOutboundPaused,proto3" json:"isOutboundPaused,omitempty"`
+}
+
+func (m *ChainPauseFlags) Reset()         { *m = ChainPauseFlags{} }
+func (m *ChainPauseFlags) String() string { return proto.CompactTextString(m) }
+func (*ChainPauseFlags) ProtoMessage()    {}
+func (*ChainPauseFlags) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f617dc4ef266f323, []int{1}
+}
+func (m *ChainPauseFlags) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChainPauseFlags) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChainPauseFlags.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChainPauseFlags) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChainPauseFlags.Merge(m, src)
+}
+func (m *ChainPauseFlags) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChainPauseFlags) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChainPauseFlags.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChainPauseFlags proto.InternalMessageInfo
+
+func (m *ChainPauseFlags) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *ChainPauseFlags) GetIsInboundPaused() bool {
+	if m != nil {
+		return m.IsInboundPaused
+	}
+	return false
+}
+
+func (m *ChainPauseFlags) GetIsOutboundPaused() bool {
+	if m != nil {
+		return m.IsOutboundPaused
+	}
+	return false
+}
+
+// ZRC20PauseFlags contains the pause switches of the deposits (inbounds) and
+// the withdrawals (outbounds) of a ZRC20 token
+type ZRC20PauseFlags struct {
+	Zrc20Address     string `protobuf:"bytes,1,opt,name=zrc20Address,proto3" json:"zrc20Address,omitempty"`
+	IsInboundPaused  bool   `protobuf:"varint,2,opt,name=isInboundPaused,proto3" json:"isInboundPaused,omitempty"`
+	IsOutboundPaused bool   `protobuf:"varint,3,opt,name=isOutboundPaused,proto3" json:"isOutboundPaused,omitempty"`
+}
+
+func (m *ZRC20PauseFlags) Reset()         { *m = ZRC20PauseFlags{} }
+func (m *ZRC20PauseFlags) String() string { return proto.CompactTextString(m) }
+func (*ZRC20PauseFlags) ProtoMessage()    {}
+func (*ZRC20PauseFlags) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f617dc4ef266f323, []int{2}
+}
+func (m *ZRC20PauseFlags) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ZRC20PauseFlags) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ZRC20PauseFlags.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ZRC20PauseFlags) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ZRC20PauseFlags.Merge(m, src)
+}
+func (m *ZRC20PauseFlags) XXX_Size() int {
+	return m.Size()
+}
+func (m *ZRC20PauseFlags) XXX_DiscardUnknown() {
+	xxx_messageInfo_ZRC20PauseFlags.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ZRC20PauseFlags proto.InternalMessageInfo
+
+func (m *ZRC20PauseFlags) GetZrc20Address() string {
+	if m != nil {
+		return m.Zrc20Address
+	}
+	return ""
+}
+
+func (m *ZRC20PauseFlags) GetIsInboundPaused() bool {
+	if m != nil {
+		return m.IsInboundPaused
+	}
+	return false
+}
+
+func (m *ZRC20PauseFlags) GetIsOutboundPaused() bool {
+	if m != nil {
+		return m.IsOutboundPaused
+	}
+	return false
+}
+
 type CrosschainFlags struct {
 	IsInboundEnabled      bool                   `protobuf:"varint,1,opt,name=isInboundEnabled,proto3" json:"isInboundEnabled,omitempty"`
 	IsOutboundEnabled     bool                   `protobuf:"varint,2,opt,name=isOutboundEnabled,proto3" json:"isOutboundEnabled,omitempty"`
 	GasPriceIncreaseFlags *GasPriceIncreaseFlags `protobuf:"bytes,3,opt,name=gasPriceIncreaseFlags,proto3" json:"gasPriceIncreaseFlags,omitempty"`
+	// Connected chains with paused inbound and/or outbound
+	PausedChains []ChainPauseFlags `protobuf:"bytes,4,rep,name=pausedChains,proto3" json:"pausedChains"`
+	// ZRC20 tokens with paused inbound and/or outbound
+	PausedZRC20Tokens []ZRC20PauseFlags `protobuf:"bytes,5,rep,name=pausedZRC20Tokens,proto3" json:"pausedZRC20Tokens"`
 }
 
 func (m *CrosschainFlags) Reset()         { *m = CrosschainFlags{} }
 func (m *CrosschainFlags) String() string { return proto.CompactTextString(m) }
 func (*CrosschainFlags) ProtoMessage()    {}
 func (*CrosschainFlags) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f617dc4ef266f323, []int{1}
+	return fileDescriptor_f617dc4ef266f323, []int{3}
 }
 func (m *CrosschainFlags) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -167,6 +295,20 @@ func (m *CrosschainFlags) GetGasPriceIncreaseFlags() *GasPriceIncreaseFlags {
 	return nil
 }
 
+func (m *CrosschainFlags) GetPausedChains() []ChainPauseFlags {
+	if m != nil {
+		return m.PausedChains
+	}
+	return nil
+}
+
+func (m *CrosschainFlags) GetPausedZRC20Tokens() []ZRC20PauseFlags {
+	if m != nil {
+		return m.PausedZRC20Tokens
+	}
+	return nil
+}
+
 type LegacyCrosschainFlags struct {
 	IsInboundEnabled      bool                   `protobuf:"varint,1,opt,name=isInboundEnabled,proto3" json:"isInboundEnabled,omitempty"`
 	IsOutboundEnabled     bool                   `protobuf:"varint,2,opt,name=isOutboundEnabled,proto3" json:"isOutboundEnabled,omitempty"`
@@ -177,7 +319,7 @@ func (m *LegacyCrosschainFlags) Reset()         { *m = LegacyCrosschainFlags{} }
 func (m *LegacyCrosschainFlags) String() string { return proto.CompactTextString(m) }
 func (*LegacyCrosschainFlags) ProtoMessage()    {}
 func (*LegacyCrosschainFlags) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f617dc4ef266f323, []int{2}
+	return fileDescriptor_f617dc4ef266f323, []int{4}
 }
 func (m *LegacyCrosschainFlags) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -229,6 +371,8 @@ func (m *LegacyCrosschainFlags) GetGasPriceIncreaseFlags() *GasPriceIncreaseFlag
 
 func init() {
 	proto.RegisterType((*GasPriceIncreaseFlags)(nil), "zetachain.zetacore.observer.GasPriceIncreaseFlags")
+	proto.RegisterType((*ChainPauseFlags)(nil), "zetachain.zetacore.observer.ChainPauseFlags")
+	proto.RegisterType((*ZRC20PauseFlags)(nil), "zetachain.zetacore.observer.ZRC20PauseFlags")
 	proto.RegisterType((*CrosschainFlags)(nil), "zetachain.zetacore.observer.CrosschainFlags")
 	proto.RegisterType((*LegacyCrosschainFlags)(nil), "zetachain.zetacore.observer.LegacyCrosschainFlags")
 }
@@ -238,34 +382,42 @@ func init() {
 }
 
 var fileDescriptor_f617dc4ef266f323 = []byte{
-	// 419 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x53, 0x4d, 0x6f, 0xd4, 0x30,
-	0x10, 0x8d, 0xb7, 0x80, 0x2a, 0xaf, 0xaa, 0x82, 0x61, 0x45, 0x28, 0x52, 0x1a, 0xed, 0x29, 0xe2,
-	0xc3, 0x41, 0xe1, 0xc2, 0xb9, 0xa5, 0xa0, 0x48, 0x45, 0xac, 0x72, 0xe4, 0x82, 0x1c, 0x67, 0xea,
-	0x44, 0x4a, 0xed, 0x95, 0xed, 0x54, 0x59, 0x7e, 0x05, 0x47, 0xc4, 0x2f, 0xea, 0xb1, 0x17, 0x24,
-	0x24, 0x24, 0x40, 0xbb, 0x7f, 0x04, 0xd5, 0x61, 0x0b, 0xbb, 0x1b, 0xf8, 0x01, 0xbd, 0x4d, 0xe6,
-	0xbd, 0x97, 0x67, 0xcf, 0xf3, 0xe0, 0xe4, 0x03, 0x58, 0xc6, 0x4b, 0x56, 0xc9, 0xd8, 0x55, 0x4a,
-	0x43, 0xac, 0x72, 0x03, 0xfa, 0x0c, 0x74, 0xcc, 0xb5, 0x32, 0xc6, 0x81, 0xef, 0x4f, 0x6a, 0x26,
-	0x0c, 0x9d, 0x6a, 0x65, 0x15, 0x79, 0x78, 0xa5, 0xa1, 0x4b, 0x0d, 0x5d, 0x6a, 0xf6, 0xee, 0x09,
-	0x25, 0x94, 0xe3, 0xc5, 0x97, 0x55, 0x27, 0xd9, 0x0b, 0x84, 0x52, 0xa2, 0x86, 0xd8, 0x7d, 0xe5,
-	0xcd, 0x49, 0x5c, 0x34, 0x9a, 0xd9, 0x4a, 0xc9, 0x0e, 0x1f, 0x7f, 0x1e, 0xe0, 0xd1, 0x6b, 0x66,
-	0x26, 0xba, 0xe2, 0x90, 0x4a, 0xae, 0x81, 0x19, 0x78, 0x75, 0x69, 0x49, 0x42, 0x3c, 0x84, 0xa9,
-	0xe2, 0xe5, 0x31, 0x48, 0x61, 0x4b, 0x1f, 0x85, 0x28, 0xda, 0xca, 0xfe, 0x6e, 0x91, 0x14, 0xef,
-	0x68, 0xb0, 0x7a, 0x96, 0x4a, 0x0b, 0xfa, 0x8c, 0xd5, 0xfe, 0x20, 0x44, 0xd1, 0x30, 0x79, 0x40,
-	0x3b, 0x4f, 0xba, 0xf4, 0xa4, 0x2f, 0x7f, 0x7b, 0x1e, 0x6c, 0x9f, 0x7f, 0xdf, 0xf7, 0x3e, 0xfd,
-	0xd8, 0x47, 0xd9, 0xaa, 0x92, 0xbc, 0xc0, 0xf7, 0xc5, 0xda, 0x29, 0x26, 0xa0, 0x39, 0x48, 0xeb,
-	0x6f, 0x85, 0x28, 0xda, 0xc9, 0xfe, 0x05, 0x93, 0x67, 0xf8, 0xee, 0x3a, 0xf4, 0x86, 0xb5, 0xfe,
-	0x0d, 0xa7, 0xea, 0x83, 0x48, 0x84, 0x77, 0x4f, 0x59, 0x3b, 0x01, 0x59, 0x54, 0x52, 0x1c, 0x72,
-	0xdb, 0x1a, 0xff, 0xa6, 0x63, 0xaf, 0xb7, 0xc7, 0x5f, 0x10, 0xde, 0x3d, 0xbc, 0x8a, 0xa2, 0x1b,
-	0xcb, 0x23, 0x7c, 0xbb, 0x32, 0xa9, 0xcc, 0x55, 0x23, 0x8b, 0x23, 0xc9, 0xf2, 0x1a, 0x0a, 0x37,
-	0x9b, 0xed, 0x6c, 0xa3, 0x4f, 0x9e, 0xe0, 0x3b, 0x95, 0x79, 0xdb, 0xd8, 0x15, 0xf2, 0xc0, 0x91,
-	0x37, 0x01, 0x52, 0xe2, 0x91, 0xe8, 0x4b, 0xc2, 0x4d, 0x60, 0x98, 0x24, 0xf4, 0x3f, 0xe9, 0xd3,
-	0xde, 0x0c, 0xb3, 0xfe, 0x1f, 0x8e, 0xbf, 0x21, 0x3c, 0x3a, 0x06, 0xc1, 0xf8, 0xec, 0x1a, 0xde,
-	0xee, 0xe0, 0xe8, 0x7c, 0x1e, 0xa0, 0x8b, 0x79, 0x80, 0x7e, 0xce, 0x03, 0xf4, 0x71, 0x11, 0x78,
-	0x17, 0x8b, 0xc0, 0xfb, 0xba, 0x08, 0xbc, 0x77, 0x8f, 0x45, 0x65, 0xcb, 0x26, 0xa7, 0x5c, 0x9d,
-	0xba, 0xa5, 0x7b, 0xda, 0xed, 0x9f, 0x54, 0x05, 0xc4, 0xed, 0x9f, 0xed, 0xb3, 0xb3, 0x29, 0x98,
-	0xfc, 0x96, 0x7b, 0xbe, 0xcf, 0x7f, 0x05, 0x00, 0x00, 0xff, 0xff, 0x51, 0x22, 0x6b, 0xce, 0xa9,
-	0x03, 0x00, 0x00,
+	// 545 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x54, 0xbf, 0x6f, 0xd3, 0x40,
+	0x14, 0xce, 0x35, 0x05, 0xca, 0xa5, 0x55, 0xe8, 0x41, 0x84, 0x29, 0x92, 0x1b, 0x65, 0x8a, 0xa0,
+	0xd8, 0x91, 0x59, 0x58, 0x49, 0x28, 0x28, 0x52, 0x11, 0x91, 0x85, 0x18, 0xba, 0xc0, 0xd9, 0x7e,
+	0xbd, 0x58, 0xa4, 0x77, 0xd1, 0xdd, 0xb9, 0x4a, 0xba, 0x31, 0x33, 0xc0, 0x88, 0xf8, 0x8b, 0x3a,
+	0x76, 0x44, 0x42, 0x02, 0x94, 0xfc, 0x23, 0xc8, 0xe7, 0xb8, 0x4d, 0x9c, 0x50, 0x26, 0x16, 0xb6,
+	0x7b, 0x3f, 0xbe, 0xef, 0x7d, 0x7e, 0xef, 0xf9, 0x61, 0xef, 0x14, 0x34, 0x0d, 0xfb, 0x34, 0xe6,
+	0xae, 0x79, 0x09, 0x09, 0xae, 0x08, 0x14, 0xc8, 0x13, 0x90, 0x6e, 0x28, 0x85, 0x52, 0x26, 0xf8,
+	0xf6, 0x68, 0x40, 0x99, 0x72, 0x86, 0x52, 0x68, 0x41, 0xee, 0x5f, 0x60, 0x9c, 0x1c, 0xe3, 0xe4,
+	0x98, 0x9d, 0x3b, 0x4c, 0x30, 0x61, 0xf2, 0xdc, 0xf4, 0x95, 0x41, 0x76, 0x6c, 0x26, 0x04, 0x1b,
+	0x80, 0x6b, 0xac, 0x20, 0x39, 0x72, 0xa3, 0x44, 0x52, 0x1d, 0x0b, 0x9e, 0xc5, 0x1b, 0x5f, 0xd7,
+	0x70, 0xed, 0x05, 0x55, 0x3d, 0x19, 0x87, 0xd0, 0xe5, 0xa1, 0x04, 0xaa, 0xe0, 0x79, 0x5a, 0x92,
+	0xd4, 0x71, 0x05, 0x86, 0x22, 0xec, 0x1f, 0x00, 0x67, 0xba, 0x6f, 0xa1, 0x3a, 0x6a, 0x96, 0xfd,
+	0x79, 0x17, 0xe9, 0xe2, 0x2d, 0x09, 0x5a, 0x8e, 0xbb, 0x5c, 0x83, 0x3c, 0xa1, 0x03, 0x6b, 0xad,
+	0x8e, 0x9a, 0x15, 0xef, 0x9e, 0x93, 0xd5, 0x74, 0xf2, 0x9a, 0xce, 0xb3, 0x59, 0xcd, 0xf6, 0xc6,
+	0xd9, 0x8f, 0xdd, 0xd2, 0x97, 0x9f, 0xbb, 0xc8, 0x5f, 0x44, 0x92, 0x27, 0xf8, 0x2e, 0x2b, 0xa8,
+	0xe8, 0x81, 0x0c, 0x81, 0x6b, 0xab, 0x5c, 0x47, 0xcd, 0x2d, 0xff, 0x4f, 0x61, 0xd2, 0xc2, 0xb7,
+	0x8b, 0xa1, 0x97, 0x74, 0x64, 0xad, 0x1b, 0xd4, 0xaa, 0x10, 0x69, 0xe2, 0xea, 0x31, 0x1d, 0xf5,
+	0x80, 0x47, 0x31, 0x67, 0x9d, 0x50, 0x8f, 0x94, 0x75, 0xcd, 0x64, 0x17, 0xdd, 0x8d, 0x0f, 0x08,
+	0x57, 0x3b, 0x69, 0xbb, 0x7b, 0x34, 0xc9, 0xdb, 0x62, 0xe1, 0x1b, 0x66, 0x02, 0xdd, 0x68, 0xd6,
+	0x92, 0xdc, 0x4c, 0x79, 0x63, 0xd5, 0xe5, 0x81, 0x48, 0x78, 0x64, 0x00, 0x91, 0x69, 0xc8, 0x86,
+	0x5f, 0x74, 0x93, 0x07, 0xf8, 0x56, 0xac, 0x5e, 0x25, 0x7a, 0x3e, 0xb5, 0x6c, 0x52, 0x97, 0xfc,
+	0x8d, 0x8f, 0x08, 0x57, 0x0f, 0xfd, 0x8e, 0xd7, 0x9a, 0xd3, 0xd0, 0xc0, 0x9b, 0xa7, 0x32, 0xf4,
+	0x5a, 0x4f, 0xa3, 0x48, 0x82, 0x52, 0x46, 0xc8, 0x4d, 0x7f, 0xc1, 0xf7, 0x8f, 0xd4, 0x7c, 0x2a,
+	0xe3, 0x6a, 0xe7, 0x62, 0x39, 0x33, 0x35, 0x06, 0x3f, 0xa3, 0xdc, 0xe7, 0x34, 0x18, 0x40, 0xd6,
+	0x9a, 0x0d, 0x7f, 0xc9, 0x4f, 0xf6, 0xf0, 0xf6, 0x25, 0x67, 0x9e, 0x9c, 0xe9, 0x5a, 0x0e, 0x90,
+	0x3e, 0xae, 0xb1, 0x55, 0xbb, 0x69, 0xe4, 0x55, 0x3c, 0xcf, 0xb9, 0xe2, 0x7f, 0x70, 0x56, 0x6e,
+	0xb5, 0xbf, 0x9a, 0x90, 0xbc, 0xc1, 0x9b, 0x43, 0xf3, 0x85, 0x66, 0xdc, 0xca, 0x5a, 0xaf, 0x97,
+	0x9b, 0x15, 0x6f, 0xef, 0xca, 0x02, 0x85, 0xcd, 0x68, 0xaf, 0xa7, 0xcb, 0xed, 0x2f, 0xf0, 0x90,
+	0x77, 0x78, 0x3b, 0xb3, 0xcd, 0x08, 0x5f, 0x8b, 0xf7, 0xc0, 0xd3, 0x6d, 0xfb, 0x3b, 0x79, 0x61,
+	0xe4, 0x33, 0xf2, 0x65, 0xb2, 0xc6, 0x77, 0x84, 0x6b, 0x07, 0xc0, 0x68, 0x38, 0xfe, 0x0f, 0xe7,
+	0xd2, 0xde, 0x3f, 0x9b, 0xd8, 0xe8, 0x7c, 0x62, 0xa3, 0x5f, 0x13, 0x1b, 0x7d, 0x9e, 0xda, 0xa5,
+	0xf3, 0xa9, 0x5d, 0xfa, 0x36, 0xb5, 0x4b, 0x87, 0x0f, 0x59, 0xac, 0xfb, 0x49, 0xe0, 0x84, 0xe2,
+	0xd8, 0x1c, 0xd0, 0x47, 0xd9, 0x2d, 0xe5, 0x22, 0x02, 0x77, 0x74, 0x79, 0x49, 0xf5, 0x78, 0x08,
+	0x2a, 0xb8, 0x6e, 0x4e, 0xd1, 0xe3, 0xdf, 0x03, 0x00, 0xdf, 0x3a, 0x2f, 0x62, 0x75, 0x05, 0x00,
+	0x00,
 }
 
 func (m *GasPriceIncreaseFlags) Marshal() (dAtA []byte, err error) {
@@ -319,6 +471,104 @@ func (m *GasPriceIncreaseFlags) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ChainPauseFlags) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChainPauseFlags) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChainPauseFlags) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IsOutboundPaused {
+		i--
+		if m.IsOutboundPaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.IsInboundPaused {
+		i--
+		if m.IsInboundPaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintCrosschainFlags(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ZRC20PauseFlags) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ZRC20PauseFlags) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ZRC20PauseFlags) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IsOutboundPaused {
+		i--
+		if m.IsOutboundPaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.IsInboundPaused {
+		i--
+		if m.IsInboundPaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Zrc20Address) > 0 {
+		i -= len(m.Zrc20Address)
+		copy(dAtA[i:], m.Zrc20Address)
+		i = encodeVarintCrosschainFlags(dAtA, i, uint64(len(m.Zrc20Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *CrosschainFlags) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -339,6 +589,34 @@ func (m *CrosschainFlags) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PausedZRC20Tokens) > 0 {
+		for iNdEx := len(m.PausedZRC20Tokens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PausedZRC20Tokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCrosschainFlags(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.PausedChains) > 0 {
+		for iNdEx := len(m.PausedChains) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PausedChains[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCrosschainFlags(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if m.GasPriceIncreaseFlags != nil {
 		{
 			size, err := m.GasPriceIncreaseFlags.MarshalToSizedBuffer(dAtA[:i])
@@ -463,6 +741,43 @@ func (m *GasPriceIncreaseFlags) Size() (n int) {
 	return n
 }
 
+func (m *ChainPauseFlags) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovCrosschainFlags(uint64(m.ChainId))
+	}
+	if m.IsInboundPaused {
+		n += 2
+	}
+	if m.IsOutboundPaused {
+		n += 2
+	}
+	return n
+}
+
+func (m *ZRC20PauseFlags) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Zrc20Address)
+	if l > 0 {
+		n += 1 + l + sovCrosschainFlags(uint64(l))
+	}
+	if m.IsInboundPaused {
+		n += 2
+	}
+	if m.IsOutboundPaused {
+		n += 2
+	}
+	return n
+}
+
 func (m *CrosschainFlags) Size() (n int) {
 	if m == nil {
 		return 0
@@ -479,6 +794,18 @@ func (m *CrosschainFlags) Size() (n int) {
 		l = m.GasPriceIncreaseFlags.Size()
 		n += 1 + l + sovCrosschainFlags(uint64(l))
 	}
+	if len(m.PausedChains) > 0 {
+		for _, e := range m.PausedChains {
+			l = e.Size()
+			n += 1 + l + sovCrosschainFlags(uint64(l))
+		}
+	}
+	if len(m.PausedZRC20Tokens) > 0 {
+		for _, e := range m.PausedZRC20Tokens {
+			l = e.Size()
+			n += 1 + l + sovCrosschainFlags(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -666,6 +993,237 @@ func (m *GasPriceIncreaseFlags) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ChainPauseFlags) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCrosschainFlags
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChainPauseFlags: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChainPauseFlags: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrosschainFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsInboundPaused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrosschainFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsInboundPaused = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsOutboundPaused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrosschainFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsOutboundPaused = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCrosschainFlags(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCrosschainFlags
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ZRC20PauseFlags) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCrosschainFlags
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ZRC20PauseFlags: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ZRC20PauseFlags: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Zrc20Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrosschainFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCrosschainFlags
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCrosschainFlags
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Zrc20Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsInboundPaused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrosschainFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsInboundPaused = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsOutboundPaused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrosschainFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsOutboundPaused = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCrosschainFlags(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCrosschainFlags
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *CrosschainFlags) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -771,6 +1329,74 @@ func (m *CrosschainFlags) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedChains", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrosschainFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCrosschainFlags
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCrosschainFlags
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PausedChains = append(m.PausedChains, ChainPauseFlags{})
+			if err := m.PausedChains[len(m.PausedChains)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedZRC20Tokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrosschainFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCrosschainFlags
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCrosschainFlags
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PausedZRC20Tokens = append(m.PausedZRC20Tokens, ZRC20PauseFlags{})
+			if err := m.PausedZRC20Tokens[len(m.PausedZRC20Tokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCrosschainFlags(dAtA[iNdEx:])
@@ -1,6 +1,7 @@
 package types_test
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -17,3 +18,62 @@ func TestDefaultDefaultCrosschainFlags(t *testing.T) {
 		GasPriceIncreaseFlags: &types.DefaultGasPriceIncreaseFlags,
 	}, defaultCrosschainFlags)
 }
+
+func TestCrosschainFlags_PauseChain(t *testing.T) {
+	t.Run("should pause and unpause chain inbound and outbound", func(t *testing.T) {
+		flags := types.CrosschainFlags{}
+		require.False(t, flags.IsChainInboundPaused(1))
+		require.False(t, flags.IsChainOutboundPaused(1))
+
+		flags.PauseChain(1, true, false)
+		flags.PauseChain(2, true, true)
+		require.True(t, flags.IsChainInboundPaused(1))
+		require.False(t, flags.IsChainOutboundPaused(1))
+		require.True(t, flags.IsChainInboundPaused(2))
+		require.True(t, flags.IsChainOutboundPaused(2))
+		require.False(t, flags.IsChainInboundPaused(3))
+
+		// pausing again doesn't unpause anything
+		flags.PauseChain(1, false, true)
+		require.True(t, flags.IsChainInboundPaused(1))
+		require.True(t, flags.IsChainOutboundPaused(1))
+		require.Len(t, flags.PausedChains, 2)
+
+		flags.UnpauseChain(1, true, false)
+		require.False(t, flags.IsChainInboundPaused(1))
+		require.True(t, flags.IsChainOutboundPaused(1))
+		require.Len(t, flags.PausedChains, 2)
+	})
+
+	t.Run("should remove chain once fully unpaused", func(t *testing.T) {
+		flags := types.CrosschainFlags{}
+		flags.PauseChain(1, true, true)
+		flags.UnpauseChain(1, true, true)
+		require.Empty(t, flags.PausedChains)
+
+		// unpausing a chain not paused is no-op
+		flags.UnpauseChain(2, true, true)
+		require.Empty(t, flags.PausedChains)
+	})
+}
+
+func TestCrosschainFlags_PauseZRC20(t *testing.T) {
+	const zrc20 = "0x13A0c5930C028511Dc02665E7285134B6d11A5f4"
+
+	t.Run("should pause and unpause ZRC20 inbound and outbound", func(t *testing.T) {
+		flags := types.CrosschainFlags{}
+		require.False(t, flags.IsZRC20InboundPaused(zrc20))
+		require.False(t, flags.IsZRC20OutboundPaused(zrc20))
+
+		flags.PauseZRC20(zrc20, false, true)
+		require.False(t, flags.IsZRC20InboundPaused(zrc20))
+		require.True(t, flags.IsZRC20OutboundPaused(zrc20))
+
+		// address comparison is case-insensitive
+		require.True(t, flags.IsZRC20OutboundPaused(strings.ToLower(zrc20)))
+
+		flags.UnpauseZRC20(strings.ToLower(zrc20), false, true)
+		require.False(t, flags.IsZRC20OutboundPaused(zrc20))
+		require.Empty(t, flags.PausedZRC20Tokens)
+	})
+}
@@ -52,4 +52,9 @@ var (
 	ErrDuplicateObserver      = errorsmod.Register(ModuleName, 1135, "observer already exists")
 	ErrObserverNotFound       = errorsmod.Register(ModuleName, 1136, "observer not found")
 	ErrInvalidObserverAddress = errorsmod.Register(ModuleName, 1137, "invalid observer address")
+	ErrOutboundDisabled       = errorsmod.Register(ModuleName, 1138, "outbound tx processing is disabled")
+
+	ErrInvalidLivenessParams = errorsmod.Register(ModuleName, 1139, "invalid liveness params")
+	ErrObserverNotJailed     = errorsmod.Register(ModuleName, 1140, "observer is not jailed")
+	ErrObserverStillJailed   = errorsmod.Register(ModuleName, 1141, "observer jail duration not elapsed")
 )
@@ -355,6 +355,158 @@ func (m *EventCCTXEnabled) GetIsOutboundEnabled() bool {
 	return false
 }
 
+type EventCCTXPaused struct {
+	MsgTypeUrl     string   `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	ChainIds       []int64  `protobuf:"varint,2,rep,packed,name=chain_ids,json=chainIds,proto3" json:"chain_ids,omitempty"`
+	Zrc20Addresses []string `protobuf:"bytes,3,rep,name=zrc20_addresses,json=zrc20Addresses,proto3" json:"zrc20_addresses,omitempty"`
+	PauseInbound   bool     `protobuf:"varint,4,opt,name=pause_inbound,json=pauseInbound,proto3" json:"pause_inbound,omitempty"`
+	PauseOutbound  bool     `protobuf:"varint,5,opt,name=pause_outbound,json=pauseOutbound,proto3" json:"pause_outbound,omitempty"`
+}
+
+func (m *EventCCTXPaused) Reset()         { *m = EventCCTXPaused{} }
+func (m *EventCCTXPaused) String() string { return proto.CompactTextString(m) }
+func (*EventCCTXPaused) ProtoMessage()    {}
+func (*EventCCTXPaused) Descriptor() ([]byte, []int) {
+	return fileDescriptor_067e682d8234d605, []int{5}
+}
+func (m *EventCCTXPaused) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCCTXPaused) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCCTXPaused.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventCCTXPaused) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCCTXPaused.Merge(m, src)
+}
+func (m *EventCCTXPaused) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCCTXPaused) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCCTXPaused.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCCTXPaused proto.InternalMessageInfo
+
+func (m *EventCCTXPaused) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *EventCCTXPaused) GetChainIds() []int64 {
+	if m != nil {
+		return m.ChainIds
+	}
+	return nil
+}
+
+func (m *EventCCTXPaused) GetZrc20Addresses() []string {
+	if m != nil {
+		return m.Zrc20Addresses
+	}
+	return nil
+}
+
+func (m *EventCCTXPaused) GetPauseInbound() bool {
+	if m != nil {
+		return m.PauseInbound
+	}
+	return false
+}
+
+func (m *EventCCTXPaused) GetPauseOutbound() bool {
+	if m != nil {
+		return m.PauseOutbound
+	}
+	return false
+}
+
+type EventCCTXUnpaused struct {
+	MsgTypeUrl      string   `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	ChainIds        []int64  `protobuf:"varint,2,rep,packed,name=chain_ids,json=chainIds,proto3" json:"chain_ids,omitempty"`
+	Zrc20Addresses  []string `protobuf:"bytes,3,rep,name=zrc20_addresses,json=zrc20Addresses,proto3" json:"zrc20_addresses,omitempty"`
+	UnpauseInbound  bool     `protobuf:"varint,4,opt,name=unpause_inbound,json=unpauseInbound,proto3" json:"unpause_inbound,omitempty"`
+	UnpauseOutbound bool     `protobuf:"varint,5,opt,name=unpause_outbound,json=unpauseOutbound,proto3" json:"unpause_outbound,omitempty"`
+}
+
+func (m *EventCCTXUnpaused) Reset()         { *m = EventCCTXUnpaused{} }
+func (m *EventCCTXUnpaused) String() string { return proto.CompactTextString(m) }
+func (*EventCCTXUnpaused) ProtoMessage()    {}
+func (*EventCCTXUnpaused) Descriptor() ([]byte, []int) {
+	return fileDescriptor_067e682d8234d605, []int{6}
+}
+func (m *EventCCTXUnpaused) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCCTXUnpaused) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCCTXUnpaused.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventCCTXUnpaused) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCCTXUnpaused.Merge(m, src)
+}
+func (m *EventCCTXUnpaused) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCCTXUnpaused) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCCTXUnpaused.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCCTXUnpaused proto.InternalMessageInfo
+
+func (m *EventCCTXUnpaused) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *EventCCTXUnpaused) GetChainIds() []int64 {
+	if m != nil {
+		return m.ChainIds
+	}
+	return nil
+}
+
+func (m *EventCCTXUnpaused) GetZrc20Addresses() []string {
+	if m != nil {
+		return m.Zrc20Addresses
+	}
+	return nil
+}
+
+func (m *EventCCTXUnpaused) GetUnpauseInbound() bool {
+	if m != nil {
+		return m.UnpauseInbound
+	}
+	return false
+}
+
+func (m *EventCCTXUnpaused) GetUnpauseOutbound() bool {
+	if m != nil {
+		return m.UnpauseOutbound
+	}
+	return false
+}
+
 type EventGasPriceIncreaseFlagsUpdated struct {
 	MsgTypeUrl            string                 `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
 	GasPriceIncreaseFlags *GasPriceIncreaseFlags `protobuf:"bytes,2,opt,name=gasPriceIncreaseFlags,proto3" json:"gasPriceIncreaseFlags,omitempty"`
@@ -364,7 +516,7 @@ func (m *EventGasPriceIncreaseFlagsUpdated) Reset()         { *m = EventGasPrice
 func (m *EventGasPriceIncreaseFlagsUpdated) String() string { return proto.CompactTextString(m) }
 func (*EventGasPriceIncreaseFlagsUpdated) ProtoMessage()    {}
 func (*EventGasPriceIncreaseFlagsUpdated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_067e682d8234d605, []int{5}
+	return fileDescriptor_067e682d8234d605, []int{7}
 }
 func (m *EventGasPriceIncreaseFlagsUpdated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -407,13 +559,186 @@ func (m *EventGasPriceIncreaseFlagsUpdated) GetGasPriceIncreaseFlags() *GasPrice
 	return nil
 }
 
+// EventBallotArchived is emitted for each finalized ballot pruned from the
+// state when ballot archive events are enabled in the emissions params
+type EventBallotArchived struct {
+	Ballot *Ballot `protobuf:"bytes,1,opt,name=ballot,proto3" json:"ballot,omitempty"`
+}
+
+func (m *EventBallotArchived) Reset()         { *m = EventBallotArchived{} }
+func (m *EventBallotArchived) String() string { return proto.CompactTextString(m) }
+func (*EventBallotArchived) ProtoMessage()    {}
+func (*EventBallotArchived) Descriptor() ([]byte, []int) {
+	return fileDescriptor_067e682d8234d605, []int{8}
+}
+func (m *EventBallotArchived) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventBallotArchived) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventBallotArchived.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventBallotArchived) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventBallotArchived.Merge(m, src)
+}
+func (m *EventBallotArchived) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventBallotArchived) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventBallotArchived.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventBallotArchived proto.InternalMessageInfo
+
+func (m *EventBallotArchived) GetBallot() *Ballot {
+	if m != nil {
+		return m.Ballot
+	}
+	return nil
+}
+
+// EventObserverJailed is emitted when an observer is jailed for missing too
+// many ballots in the liveness window
+type EventObserverJailed struct {
+	ObserverAddress string `protobuf:"bytes,1,opt,name=observer_address,json=observerAddress,proto3" json:"observer_address,omitempty"`
+	MissedBallots   uint64 `protobuf:"varint,2,opt,name=missed_ballots,json=missedBallots,proto3" json:"missed_ballots,omitempty"`
+	WindowSize      uint64 `protobuf:"varint,3,opt,name=window_size,json=windowSize,proto3" json:"window_size,omitempty"`
+	JailedUntil     int64  `protobuf:"varint,4,opt,name=jailed_until,json=jailedUntil,proto3" json:"jailed_until,omitempty"`
+}
+
+func (m *EventObserverJailed) Reset()         { *m = EventObserverJailed{} }
+func (m *EventObserverJailed) String() string { return proto.CompactTextString(m) }
+func (*EventObserverJailed) ProtoMessage()    {}
+func (*EventObserverJailed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_067e682d8234d605, []int{9}
+}
+func (m *EventObserverJailed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventObserverJailed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventObserverJailed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventObserverJailed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventObserverJailed.Merge(m, src)
+}
+func (m *EventObserverJailed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventObserverJailed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventObserverJailed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventObserverJailed proto.InternalMessageInfo
+
+func (m *EventObserverJailed) GetObserverAddress() string {
+	if m != nil {
+		return m.ObserverAddress
+	}
+	return ""
+}
+
+func (m *EventObserverJailed) GetMissedBallots() uint64 {
+	if m != nil {
+		return m.MissedBallots
+	}
+	return 0
+}
+
+func (m *EventObserverJailed) GetWindowSize() uint64 {
+	if m != nil {
+		return m.WindowSize
+	}
+	return 0
+}
+
+func (m *EventObserverJailed) GetJailedUntil() int64 {
+	if m != nil {
+		return m.JailedUntil
+	}
+	return 0
+}
+
+type EventObserverUnjailed struct {
+	MsgTypeUrl      string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	ObserverAddress string `protobuf:"bytes,2,opt,name=observer_address,json=observerAddress,proto3" json:"observer_address,omitempty"`
+}
+
+func (m *EventObserverUnjailed) Reset()         { *m = EventObserverUnjailed{} }
+func (m *EventObserverUnjailed) String() string { return proto.CompactTextString(m) }
+func (*EventObserverUnjailed) ProtoMessage()    {}
+func (*EventObserverUnjailed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_067e682d8234d605, []int{10}
+}
+func (m *EventObserverUnjailed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventObserverUnjailed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventObserverUnjailed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventObserverUnjailed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventObserverUnjailed.Merge(m, src)
+}
+func (m *EventObserverUnjailed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventObserverUnjailed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventObserverUnjailed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventObserverUnjailed proto.InternalMessageInfo
+
+func (m *EventObserverUnjailed) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *EventObserverUnjailed) GetObserverAddress() string {
+	if m != nil {
+		return m.ObserverAddress
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*EventBallotCreated)(nil), "zetachain.zetacore.observer.EventBallotCreated")
 	proto.RegisterType((*EventKeygenBlockUpdated)(nil), "zetachain.zetacore.observer.EventKeygenBlockUpdated")
 	proto.RegisterType((*EventNewObserverAdded)(nil), "zetachain.zetacore.observer.EventNewObserverAdded")
 	proto.RegisterType((*EventCCTXDisabled)(nil), "zetachain.zetacore.observer.EventCCTXDisabled")
 	proto.RegisterType((*EventCCTXEnabled)(nil), "zetachain.zetacore.observer.EventCCTXEnabled")
+	proto.RegisterType((*EventCCTXPaused)(nil), "zetachain.zetacore.observer.EventCCTXPaused")
+	proto.RegisterType((*EventCCTXUnpaused)(nil), "zetachain.zetacore.observer.EventCCTXUnpaused")
 	proto.RegisterType((*EventGasPriceIncreaseFlagsUpdated)(nil), "zetachain.zetacore.observer.EventGasPriceIncreaseFlagsUpdated")
+	proto.RegisterType((*EventBallotArchived)(nil), "zetachain.zetacore.observer.EventBallotArchived")
+	proto.RegisterType((*EventObserverJailed)(nil), "zetachain.zetacore.observer.EventObserverJailed")
+	proto.RegisterType((*EventObserverUnjailed)(nil), "zetachain.zetacore.observer.EventObserverUnjailed")
 }
 
 func init() {
@@ -421,44 +746,59 @@ func init() {
 }
 
 var fileDescriptor_067e682d8234d605 = []byte{
-	// 586 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x54, 0x41, 0x6f, 0xd3, 0x4c,
-	0x10, 0x8d, 0xdb, 0x7e, 0x9f, 0x60, 0x53, 0x68, 0x62, 0x51, 0xea, 0x06, 0xc9, 0xb4, 0x91, 0x90,
-	0x4a, 0x0b, 0x8e, 0x14, 0x4e, 0x20, 0x2e, 0x24, 0x84, 0x12, 0x81, 0x68, 0x15, 0xb5, 0x12, 0xe2,
-	0x62, 0xad, 0xed, 0xa9, 0x6d, 0xc5, 0xd9, 0x8d, 0x76, 0xd7, 0x85, 0x70, 0xe7, 0x0a, 0x5c, 0xf9,
-	0x15, 0xfc, 0x0d, 0x8e, 0x3d, 0x72, 0xe0, 0x80, 0x92, 0x3f, 0x82, 0x76, 0xd7, 0x76, 0x82, 0x12,
-	0x45, 0xb9, 0x71, 0x5b, 0xcd, 0xbc, 0xf7, 0xf6, 0xbd, 0x59, 0x7b, 0xd0, 0xc1, 0x47, 0x10, 0xd8,
-	0x8f, 0x70, 0x4c, 0x1a, 0xea, 0x44, 0x19, 0x34, 0xa8, 0xc7, 0x81, 0x5d, 0x02, 0x6b, 0xc0, 0x25,
-	0x10, 0xc1, 0x9d, 0x21, 0xa3, 0x82, 0x9a, 0x77, 0x0a, 0xa4, 0x93, 0x23, 0x9d, 0x1c, 0x59, 0xbb,
-	0x15, 0xd2, 0x90, 0x2a, 0x5c, 0x43, 0x9e, 0x34, 0xa5, 0xd6, 0x5c, 0x26, 0xee, 0x33, 0xca, 0xb9,
-	0x6a, 0xba, 0x17, 0x09, 0x0e, 0xb3, 0x6b, 0x6a, 0x87, 0xcb, 0x38, 0xf9, 0x41, 0x63, 0xeb, 0xbf,
-	0x0c, 0x64, 0x76, 0xa4, 0xc7, 0x16, 0x4e, 0x12, 0x2a, 0xda, 0x0c, 0xb0, 0x80, 0xc0, 0xdc, 0x43,
-	0x9b, 0x03, 0x1e, 0xba, 0x62, 0x34, 0x04, 0x37, 0x65, 0x89, 0x65, 0xec, 0x19, 0x07, 0xd7, 0x7b,
-	0x68, 0xc0, 0xc3, 0xb3, 0xd1, 0x10, 0xce, 0x59, 0x62, 0x1e, 0xa1, 0xaa, 0xa7, 0x28, 0x6e, 0x1c,
-	0x00, 0x11, 0xf1, 0x45, 0x0c, 0xcc, 0x5a, 0x53, 0xb0, 0x8a, 0x6e, 0x74, 0x8b, 0xba, 0x79, 0x1f,
-	0x55, 0xf4, 0xbd, 0x58, 0xc4, 0x94, 0xb8, 0x11, 0xe6, 0x91, 0xb5, 0xae, 0xb0, 0x5b, 0x33, 0xf5,
-	0x97, 0x98, 0x47, 0x52, 0x77, 0x16, 0xaa, 0x62, 0x58, 0x1b, 0x5a, 0x77, 0xa6, 0xd1, 0x96, 0x75,
-	0xf3, 0x2e, 0x2a, 0x67, 0x26, 0xa4, 0x53, 0xeb, 0x3f, 0xed, 0x52, 0x97, 0xa4, 0xd1, 0xfa, 0x27,
-	0x03, 0xed, 0xa8, 0x78, 0xaf, 0x60, 0x14, 0x02, 0x69, 0x25, 0xd4, 0xef, 0x9f, 0x0f, 0x83, 0x15,
-	0x33, 0xee, 0xa3, 0xcd, 0xbe, 0xe2, 0xb9, 0x9e, 0x24, 0x66, 0xf1, 0xca, 0xfd, 0xa9, 0x96, 0x79,
-	0x0f, 0xdd, 0xcc, 0x20, 0xc3, 0xd4, 0xeb, 0xc3, 0x88, 0x67, 0xb9, 0x6e, 0xe8, 0xea, 0xa9, 0x2e,
-	0xd6, 0xbf, 0xad, 0xa1, 0x6d, 0xe5, 0xe3, 0x0d, 0xbc, 0x3f, 0xc9, 0x5e, 0xe0, 0x59, 0x10, 0xac,
-	0xe4, 0xa2, 0x18, 0x1e, 0x30, 0x17, 0x07, 0x01, 0x03, 0xce, 0x33, 0x27, 0x5b, 0x74, 0x2a, 0x25,
-	0xcb, 0xe6, 0x53, 0x54, 0x53, 0x2f, 0x9e, 0xc4, 0x40, 0x84, 0x1b, 0x32, 0x4c, 0x04, 0x40, 0x41,
-	0xd2, 0xce, 0xac, 0x29, 0xe2, 0x58, 0x03, 0x72, 0xf6, 0x13, 0xb4, 0xbb, 0x80, 0xad, 0x73, 0x65,
-	0x4f, 0xb0, 0x33, 0x47, 0xd6, 0x09, 0xcd, 0xc7, 0x68, 0xb7, 0x30, 0x99, 0x60, 0x2e, 0xf4, 0xc4,
-	0x5c, 0x9f, 0xa6, 0x44, 0xa8, 0x77, 0xd9, 0xe8, 0xdd, 0xce, 0x01, 0xaf, 0x31, 0x17, 0x6a, 0x7a,
-	0x6d, 0xd9, 0xad, 0x7f, 0x31, 0x50, 0x55, 0xcd, 0xa6, 0xdd, 0x3e, 0x7b, 0xfb, 0x3c, 0xe6, 0xd8,
-	0x4b, 0x56, 0x9a, 0xcb, 0x21, 0xaa, 0xc4, 0xbc, 0x4b, 0x3c, 0x9a, 0x92, 0xa0, 0x43, 0x14, 0x4b,
-	0xcd, 0xe5, 0x5a, 0x6f, 0xae, 0x6e, 0x3e, 0x40, 0xd5, 0x98, 0x9f, 0xa4, 0xe2, 0x2f, 0xf0, 0xba,
-	0x02, 0xcf, 0x37, 0xea, 0x9f, 0x0d, 0x54, 0x29, 0x1c, 0xe5, 0x12, 0xff, 0xd2, 0xd0, 0x77, 0x03,
-	0xed, 0x2b, 0x43, 0xc7, 0x98, 0x9f, 0xb2, 0xd8, 0x87, 0x2e, 0xf1, 0x19, 0x60, 0x0e, 0x2f, 0xe4,
-	0x6f, 0xbf, 0xfa, 0x07, 0x1d, 0xa1, 0xed, 0x70, 0x91, 0x82, 0xb2, 0x59, 0x6e, 0x36, 0x9d, 0x25,
-	0x0b, 0xca, 0x59, 0x78, 0x77, 0x6f, 0xb1, 0x60, 0xab, 0xf3, 0x63, 0x6c, 0x1b, 0x57, 0x63, 0xdb,
-	0xf8, 0x3d, 0xb6, 0x8d, 0xaf, 0x13, 0xbb, 0x74, 0x35, 0xb1, 0x4b, 0x3f, 0x27, 0x76, 0xe9, 0xdd,
-	0x51, 0x18, 0x8b, 0x28, 0xf5, 0x1c, 0x9f, 0x0e, 0xd4, 0x7a, 0x7a, 0xa8, 0x37, 0x15, 0xa1, 0x01,
-	0x34, 0x3e, 0x4c, 0xf7, 0x94, 0x4c, 0xc1, 0xbd, 0xff, 0xd5, 0x96, 0x7a, 0xf4, 0x27, 0x00, 0x00,
-	0xff, 0xff, 0xfb, 0x7d, 0x42, 0xa2, 0x64, 0x05, 0x00, 0x00,
+	// 825 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x55, 0x4d, 0x4f, 0xeb, 0x46,
+	0x14, 0xc5, 0x24, 0x45, 0x61, 0x02, 0x49, 0x70, 0x4b, 0x09, 0x41, 0x4a, 0xc1, 0x08, 0x11, 0xa0,
+	0x4d, 0xaa, 0x74, 0xd5, 0x8f, 0x0d, 0xa4, 0x29, 0x4d, 0x5b, 0x15, 0xe4, 0x12, 0xa9, 0xea, 0xc6,
+	0x1a, 0xdb, 0x83, 0x33, 0x8d, 0x33, 0x13, 0xcd, 0x8c, 0xa1, 0xc9, 0xbe, 0xdb, 0xb6, 0xdb, 0xfe,
+	0x86, 0x2e, 0xfa, 0x2b, 0x2a, 0x75, 0x57, 0x96, 0x5d, 0xbc, 0xc5, 0x13, 0xfc, 0x91, 0xa7, 0x99,
+	0xb1, 0x4d, 0x10, 0x56, 0x94, 0xc5, 0xd3, 0x7b, 0x3b, 0xeb, 0xcc, 0xb9, 0xf7, 0x9e, 0x73, 0xef,
+	0xf8, 0x0e, 0x68, 0x4c, 0x91, 0x80, 0xde, 0x00, 0x62, 0xd2, 0x52, 0x5f, 0x94, 0xa1, 0x16, 0x75,
+	0x39, 0x62, 0x37, 0x88, 0xb5, 0xd0, 0x0d, 0x22, 0x82, 0x37, 0xc7, 0x8c, 0x0a, 0x6a, 0xee, 0xa4,
+	0xcc, 0x66, 0xc2, 0x6c, 0x26, 0xcc, 0xda, 0x7b, 0x01, 0x0d, 0xa8, 0xe2, 0xb5, 0xe4, 0x97, 0x0e,
+	0xa9, 0xcd, 0x4d, 0xee, 0xc2, 0x30, 0xa4, 0x22, 0x66, 0xb6, 0xe7, 0x31, 0x3d, 0x46, 0x39, 0x57,
+	0x87, 0xce, 0x75, 0x08, 0x83, 0x58, 0x50, 0xed, 0x78, 0x5e, 0x4c, 0xf2, 0xa1, 0xb9, 0xd6, 0x0b,
+	0x03, 0x98, 0x5d, 0xe9, 0xe6, 0x4c, 0x55, 0xed, 0x30, 0x04, 0x05, 0xf2, 0xcd, 0x5d, 0xb0, 0x36,
+	0xe2, 0x81, 0x23, 0x26, 0x63, 0xe4, 0x44, 0x2c, 0xac, 0x1a, 0xbb, 0x46, 0x63, 0xd5, 0x06, 0x23,
+	0x1e, 0x5c, 0x4d, 0xc6, 0xa8, 0xcf, 0x42, 0xf3, 0x04, 0x6c, 0x68, 0xa1, 0x0e, 0xf6, 0x11, 0x11,
+	0xf8, 0x1a, 0x23, 0x56, 0x5d, 0x56, 0xb4, 0x8a, 0x3e, 0xe8, 0xa5, 0xb8, 0x79, 0x04, 0x2a, 0xba,
+	0x2e, 0x14, 0x98, 0x12, 0x67, 0x00, 0xf9, 0xa0, 0x9a, 0x53, 0xdc, 0xf2, 0x0c, 0xfe, 0x35, 0xe4,
+	0x03, 0x99, 0x77, 0x96, 0xaa, 0x6c, 0x54, 0xf3, 0x3a, 0xef, 0xcc, 0x41, 0x47, 0xe2, 0xe6, 0x07,
+	0xa0, 0x18, 0x8b, 0x90, 0x4a, 0xab, 0xef, 0x68, 0x95, 0x1a, 0x92, 0x42, 0xad, 0x5f, 0x0d, 0xb0,
+	0xa5, 0xec, 0x7d, 0x8b, 0x26, 0x01, 0x22, 0x67, 0x21, 0xf5, 0x86, 0xfd, 0xb1, 0xbf, 0xa0, 0xc7,
+	0x3d, 0xb0, 0x36, 0x54, 0x71, 0x8e, 0x2b, 0x03, 0x63, 0x7b, 0xc5, 0xe1, 0x63, 0x2e, 0xf3, 0x00,
+	0x94, 0x62, 0xca, 0x38, 0x72, 0x87, 0x68, 0xc2, 0x63, 0x5f, 0xeb, 0x1a, 0xbd, 0xd4, 0xa0, 0xf5,
+	0xe7, 0x32, 0xd8, 0x54, 0x3a, 0xbe, 0x47, 0xb7, 0x17, 0xf1, 0x04, 0x4e, 0x7d, 0x7f, 0x21, 0x15,
+	0x69, 0xf3, 0x10, 0x73, 0xa0, 0xef, 0x33, 0xc4, 0x79, 0x75, 0x79, 0xb6, 0x79, 0x2a, 0x95, 0x84,
+	0xcd, 0x2f, 0x40, 0x4d, 0x4d, 0x3c, 0xc4, 0x88, 0x08, 0x27, 0x60, 0x90, 0x08, 0x84, 0xd2, 0x20,
+	0xad, 0xac, 0xfa, 0xc8, 0x38, 0xd7, 0x84, 0x24, 0xfa, 0x33, 0xb0, 0x9d, 0x11, 0xad, 0x7d, 0xc5,
+	0x23, 0xd8, 0x7a, 0x16, 0xac, 0x1d, 0x9a, 0x9f, 0x82, 0xed, 0x54, 0x64, 0x08, 0xb9, 0xd0, 0x1d,
+	0x73, 0x3c, 0x1a, 0x11, 0xa1, 0xe6, 0x92, 0xb7, 0xdf, 0x4f, 0x08, 0xdf, 0x41, 0x2e, 0x54, 0xf7,
+	0x3a, 0xf2, 0xd4, 0xfa, 0xdd, 0x00, 0x1b, 0xaa, 0x37, 0x9d, 0xce, 0xd5, 0x8f, 0x5f, 0x62, 0x0e,
+	0xdd, 0x70, 0xa1, 0xbe, 0x1c, 0x83, 0x0a, 0xe6, 0x3d, 0xe2, 0xd2, 0x88, 0xf8, 0x5d, 0xa2, 0xa2,
+	0x54, 0x5f, 0x0a, 0xf6, 0x33, 0xdc, 0xfc, 0x10, 0x6c, 0x60, 0x7e, 0x11, 0x89, 0x27, 0xe4, 0x9c,
+	0x22, 0x3f, 0x3f, 0xb0, 0x7e, 0x33, 0x40, 0x25, 0x55, 0xd4, 0x25, 0x6f, 0x5f, 0xd0, 0x3f, 0x06,
+	0x28, 0xa7, 0x82, 0x2e, 0x61, 0xc4, 0x17, 0xd2, 0xb3, 0x03, 0x56, 0xf5, 0x72, 0xc0, 0xbe, 0xbc,
+	0x31, 0xb9, 0x46, 0xce, 0x2e, 0x28, 0xa0, 0xe7, 0x73, 0xf3, 0x10, 0x94, 0xa7, 0xcc, 0x6b, 0x7f,
+	0x9c, 0xdc, 0x0e, 0x24, 0xef, 0x47, 0xae, 0xb1, 0x6a, 0x97, 0x14, 0x7c, 0x9a, 0xa0, 0xe6, 0x3e,
+	0x58, 0x1f, 0xcb, 0x8a, 0x0e, 0xd6, 0x0e, 0xd4, 0x4d, 0x28, 0xd8, 0x6b, 0x0a, 0x8c, 0x5d, 0xc9,
+	0xdf, 0x40, 0x93, 0x68, 0xac, 0x5c, 0xcd, 0xbc, 0x60, 0xeb, 0xd0, 0xc4, 0x8e, 0xf5, 0xdf, 0xec,
+	0xa8, 0xfb, 0x64, 0xfc, 0x66, 0x9d, 0x1c, 0x82, 0x72, 0x44, 0xb2, 0xbc, 0x94, 0x22, 0xf2, 0xc4,
+	0xcd, 0x11, 0xa8, 0x44, 0x24, 0xd3, 0x4f, 0x92, 0x20, 0x75, 0xf4, 0xb7, 0x01, 0xf6, 0x94, 0xa3,
+	0x73, 0xc8, 0x2f, 0x19, 0xf6, 0x50, 0x8f, 0x78, 0x0c, 0x41, 0x8e, 0xbe, 0x92, 0x0b, 0x79, 0xf1,
+	0x55, 0x33, 0x00, 0x9b, 0x41, 0x56, 0x06, 0x75, 0x81, 0x8a, 0xed, 0x76, 0x73, 0xce, 0x23, 0xd3,
+	0xcc, 0xac, 0x6d, 0x67, 0x27, 0xb4, 0x6c, 0xf0, 0xee, 0xcc, 0xc2, 0x3f, 0x65, 0xde, 0x00, 0xdf,
+	0x20, 0xdf, 0xfc, 0x1c, 0xac, 0xe8, 0xbd, 0xa9, 0xc4, 0x15, 0xdb, 0xfb, 0x73, 0x2b, 0xea, 0x60,
+	0x3b, 0x0e, 0xb1, 0xfe, 0x32, 0xe2, 0xa4, 0xc9, 0x6e, 0xfb, 0x06, 0x62, 0x79, 0xcb, 0xb3, 0x56,
+	0x97, 0x91, 0xbd, 0xba, 0x0e, 0x40, 0x69, 0x84, 0x39, 0x47, 0xbe, 0xa3, 0x73, 0x6a, 0xe7, 0x79,
+	0x7b, 0x5d, 0xa3, 0xba, 0x20, 0x97, 0x1b, 0xff, 0x16, 0x13, 0x9f, 0xde, 0x3a, 0x1c, 0x4f, 0x91,
+	0xfa, 0x63, 0xf2, 0x36, 0xd0, 0xd0, 0x0f, 0x78, 0x8a, 0xe4, 0xce, 0xfe, 0x59, 0x15, 0x77, 0x22,
+	0x22, 0x70, 0xa8, 0x26, 0x9c, 0xb3, 0x8b, 0x1a, 0xeb, 0x4b, 0xc8, 0xf2, 0xc1, 0xe6, 0x13, 0xb1,
+	0x7d, 0xa2, 0x4f, 0x5f, 0xeb, 0x2e, 0x3e, 0xeb, 0xfe, 0x7b, 0x5f, 0x37, 0xee, 0xee, 0xeb, 0xc6,
+	0xcb, 0xfb, 0xba, 0xf1, 0xc7, 0x43, 0x7d, 0xe9, 0xee, 0xa1, 0xbe, 0xf4, 0xff, 0x43, 0x7d, 0xe9,
+	0xa7, 0x93, 0x00, 0x8b, 0x41, 0xe4, 0x36, 0x3d, 0x3a, 0x52, 0x0f, 0xf4, 0x47, 0xfa, 0xad, 0x26,
+	0xd4, 0x47, 0xad, 0x5f, 0x1e, 0x5f, 0x6a, 0x29, 0x83, 0xbb, 0x2b, 0xea, 0x9d, 0xfe, 0xe4, 0xd5,
+	0x00, 0xb7, 0x43, 0x9c, 0x9a, 0x90, 0x08, 0x00, 0x00,
 }
 
 func (m *EventBallotCreated) Marshal() (dAtA []byte, err error) {
@@ -719,7 +1059,7 @@ func (m *EventCCTXEnabled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *EventGasPriceIncreaseFlagsUpdated) Marshal() (dAtA []byte, err error) {
+func (m *EventCCTXPaused) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -729,25 +1069,61 @@ func (m *EventGasPriceIncreaseFlagsUpdated) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventGasPriceIncreaseFlagsUpdated) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventCCTXPaused) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventGasPriceIncreaseFlagsUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventCCTXPaused) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.GasPriceIncreaseFlags != nil {
-		{
-			size, err := m.GasPriceIncreaseFlags.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvents(dAtA, i, uint64(size))
+	if m.PauseOutbound {
+		i--
+		if m.PauseOutbound {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.PauseInbound {
+		i--
+		if m.PauseInbound {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Zrc20Addresses) > 0 {
+		for iNdEx := len(m.Zrc20Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Zrc20Addresses[iNdEx])
+			copy(dAtA[i:], m.Zrc20Addresses[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.Zrc20Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
 		}
+	}
+	if len(m.ChainIds) > 0 {
+		dAtA2 := make([]byte, len(m.ChainIds)*10)
+		var j1 int
+		for _, num1 := range m.ChainIds {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintEvents(dAtA, i, uint64(j1))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -761,31 +1137,268 @@ func (m *EventGasPriceIncreaseFlagsUpdated) MarshalToSizedBuffer(dAtA []byte) (i
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvents(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *EventCCTXUnpaused) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *EventBallotCreated) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *EventCCTXUnpaused) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCCTXUnpaused) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.MsgTypeUrl)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.BallotIdentifier)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
+	if m.UnpauseOutbound {
+		i--
+		if m.UnpauseOutbound {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.UnpauseInbound {
+		i--
+		if m.UnpauseInbound {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Zrc20Addresses) > 0 {
+		for iNdEx := len(m.Zrc20Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Zrc20Addresses[iNdEx])
+			copy(dAtA[i:], m.Zrc20Addresses[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.Zrc20Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ChainIds) > 0 {
+		dAtA4 := make([]byte, len(m.ChainIds)*10)
+		var j3 int
+		for _, num1 := range m.ChainIds {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintEvents(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventGasPriceIncreaseFlagsUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventGasPriceIncreaseFlagsUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventGasPriceIncreaseFlagsUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasPriceIncreaseFlags != nil {
+		{
+			size, err := m.GasPriceIncreaseFlags.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventBallotArchived) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventBallotArchived) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventBallotArchived) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Ballot != nil {
+		{
+			size, err := m.Ballot.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventObserverJailed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventObserverJailed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventObserverJailed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.JailedUntil != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.JailedUntil))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.WindowSize != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.WindowSize))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MissedBallots != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MissedBallots))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ObserverAddress) > 0 {
+		i -= len(m.ObserverAddress)
+		copy(dAtA[i:], m.ObserverAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ObserverAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventObserverUnjailed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventObserverUnjailed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventObserverUnjailed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ObserverAddress) > 0 {
+		i -= len(m.ObserverAddress)
+		copy(dAtA[i:], m.ObserverAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ObserverAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventBallotCreated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.BallotIdentifier)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
 	l = len(m.ObservationHash)
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
@@ -888,6 +1501,70 @@ func (m *EventCCTXEnabled) Size() (n int) {
 	return n
 }
 
+func (m *EventCCTXPaused) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.ChainIds) > 0 {
+		l = 0
+		for _, e := range m.ChainIds {
+			l += sovEvents(uint64(e))
+		}
+		n += 1 + sovEvents(uint64(l)) + l
+	}
+	if len(m.Zrc20Addresses) > 0 {
+		for _, s := range m.Zrc20Addresses {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if m.PauseInbound {
+		n += 2
+	}
+	if m.PauseOutbound {
+		n += 2
+	}
+	return n
+}
+
+func (m *EventCCTXUnpaused) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.ChainIds) > 0 {
+		l = 0
+		for _, e := range m.ChainIds {
+			l += sovEvents(uint64(e))
+		}
+		n += 1 + sovEvents(uint64(l)) + l
+	}
+	if len(m.Zrc20Addresses) > 0 {
+		for _, s := range m.Zrc20Addresses {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if m.UnpauseInbound {
+		n += 2
+	}
+	if m.UnpauseOutbound {
+		n += 2
+	}
+	return n
+}
+
 func (m *EventGasPriceIncreaseFlagsUpdated) Size() (n int) {
 	if m == nil {
 		return 0
@@ -905,13 +1582,421 @@ func (m *EventGasPriceIncreaseFlagsUpdated) Size() (n int) {
 	return n
 }
 
-func sovEvents(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *EventBallotArchived) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Ballot != nil {
+		l = m.Ballot.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
 }
-func sozEvents(x uint64) (n int) {
-	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *EventObserverJailed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ObserverAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.MissedBallots != 0 {
+		n += 1 + sovEvents(uint64(m.MissedBallots))
+	}
+	if m.WindowSize != 0 {
+		n += 1 + sovEvents(uint64(m.WindowSize))
+	}
+	if m.JailedUntil != 0 {
+		n += 1 + sovEvents(uint64(m.JailedUntil))
+	}
+	return n
+}
+
+func (m *EventObserverUnjailed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ObserverAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventBallotCreated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventBallotCreated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventBallotCreated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BallotIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BallotIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservationHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObservationHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservationChain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObservationChain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BallotType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BallotType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventKeygenBlockUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventKeygenBlockUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventKeygenBlockUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeygenBlock", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeygenBlock = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeygenPubkeys", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeygenPubkeys = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *EventBallotCreated) Unmarshal(dAtA []byte) error {
+func (m *EventNewObserverAdded) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -934,10 +2019,10 @@ func (m *EventBallotCreated) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventBallotCreated: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventNewObserverAdded: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventBallotCreated: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventNewObserverAdded: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -974,7 +2059,7 @@ func (m *EventBallotCreated) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BallotIdentifier", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObserverAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1002,11 +2087,11 @@ func (m *EventBallotCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BallotIdentifier = string(dAtA[iNdEx:postIndex])
+			m.ObserverAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObservationHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ZetaclientGranteeAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1034,11 +2119,11 @@ func (m *EventBallotCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ObservationHash = string(dAtA[iNdEx:postIndex])
+			m.ZetaclientGranteeAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObservationChain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ZetaclientGranteePubkey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1066,11 +2151,80 @@ func (m *EventBallotCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ObservationChain = string(dAtA[iNdEx:postIndex])
+			m.ZetaclientGranteePubkey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObserverLastBlockCount", wireType)
+			}
+			m.ObserverLastBlockCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ObserverLastBlockCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventCCTXDisabled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCCTXDisabled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCCTXDisabled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BallotType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1098,8 +2252,170 @@ func (m *EventBallotCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BallotType = string(dAtA[iNdEx:postIndex])
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsInboundEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsInboundEnabled = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsOutboundEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsOutboundEnabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventCCTXEnabled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCCTXEnabled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCCTXEnabled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsInboundEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsInboundEnabled = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsOutboundEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsOutboundEnabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -1121,7 +2437,7 @@ func (m *EventBallotCreated) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventKeygenBlockUpdated) Unmarshal(dAtA []byte) error {
+func (m *EventCCTXPaused) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1144,10 +2460,10 @@ func (m *EventKeygenBlockUpdated) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventKeygenBlockUpdated: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventCCTXPaused: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventKeygenBlockUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventCCTXPaused: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1183,8 +2499,84 @@ func (m *EventKeygenBlockUpdated) Unmarshal(dAtA []byte) error {
 			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvents
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ChainIds = append(m.ChainIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvents
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthEvents
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthEvents
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ChainIds) == 0 {
+					m.ChainIds = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvents
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ChainIds = append(m.ChainIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainIds", wireType)
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeygenBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Zrc20Addresses", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1212,13 +2604,13 @@ func (m *EventKeygenBlockUpdated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KeygenBlock = string(dAtA[iNdEx:postIndex])
+			m.Zrc20Addresses = append(m.Zrc20Addresses, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeygenPubkeys", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PauseInbound", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1228,24 +2620,32 @@ func (m *EventKeygenBlockUpdated) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
+			m.PauseInbound = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PauseOutbound", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.KeygenPubkeys = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.PauseOutbound = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -1267,7 +2667,7 @@ func (m *EventKeygenBlockUpdated) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventNewObserverAdded) Unmarshal(dAtA []byte) error {
+func (m *EventCCTXUnpaused) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1290,10 +2690,10 @@ func (m *EventNewObserverAdded) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventNewObserverAdded: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventCCTXUnpaused: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventNewObserverAdded: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventCCTXUnpaused: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1329,40 +2729,84 @@ func (m *EventNewObserverAdded) Unmarshal(dAtA []byte) error {
 			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObserverAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvents
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ChainIds = append(m.ChainIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvents
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthEvents
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthEvents
+				}
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ChainIds) == 0 {
+					m.ChainIds = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvents
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ChainIds = append(m.ChainIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainIds", wireType)
 			}
-			m.ObserverAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ZetaclientGranteeAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Zrc20Addresses", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1390,13 +2834,13 @@ func (m *EventNewObserverAdded) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ZetaclientGranteeAddress = string(dAtA[iNdEx:postIndex])
+			m.Zrc20Addresses = append(m.Zrc20Addresses, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ZetaclientGranteePubkey", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnpauseInbound", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1406,29 +2850,17 @@ func (m *EventNewObserverAdded) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ZetaclientGranteePubkey = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.UnpauseInbound = bool(v != 0)
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObserverLastBlockCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnpauseOutbound", wireType)
 			}
-			m.ObserverLastBlockCount = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1438,11 +2870,12 @@ func (m *EventNewObserverAdded) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ObserverLastBlockCount |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.UnpauseOutbound = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -1464,7 +2897,7 @@ func (m *EventNewObserverAdded) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventCCTXDisabled) Unmarshal(dAtA []byte) error {
+func (m *EventGasPriceIncreaseFlagsUpdated) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1487,10 +2920,10 @@ func (m *EventCCTXDisabled) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventCCTXDisabled: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventGasPriceIncreaseFlagsUpdated: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventCCTXDisabled: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventGasPriceIncreaseFlagsUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1526,10 +2959,10 @@ func (m *EventCCTXDisabled) Unmarshal(dAtA []byte) error {
 			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsInboundEnabled", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPriceIncreaseFlags", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1539,17 +2972,83 @@ func (m *EventCCTXDisabled) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsInboundEnabled = bool(v != 0)
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsOutboundEnabled", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
 			}
-			var v int
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GasPriceIncreaseFlags == nil {
+				m.GasPriceIncreaseFlags = &GasPriceIncreaseFlags{}
+			}
+			if err := m.GasPriceIncreaseFlags.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventBallotArchived) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventBallotArchived: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventBallotArchived: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ballot", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1559,12 +3058,28 @@ func (m *EventCCTXDisabled) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsOutboundEnabled = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Ballot == nil {
+				m.Ballot = &Ballot{}
+			}
+			if err := m.Ballot.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -1586,7 +3101,7 @@ func (m *EventCCTXDisabled) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventCCTXEnabled) Unmarshal(dAtA []byte) error {
+func (m *EventObserverJailed) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1609,15 +3124,15 @@ func (m *EventCCTXEnabled) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventCCTXEnabled: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventObserverJailed: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventCCTXEnabled: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventObserverJailed: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObserverAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1645,13 +3160,13 @@ func (m *EventCCTXEnabled) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			m.ObserverAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsInboundEnabled", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedBallots", wireType)
 			}
-			var v int
+			m.MissedBallots = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1661,17 +3176,16 @@ func (m *EventCCTXEnabled) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.MissedBallots |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsInboundEnabled = bool(v != 0)
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsOutboundEnabled", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowSize", wireType)
 			}
-			var v int
+			m.WindowSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1681,12 +3195,30 @@ func (m *EventCCTXEnabled) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.WindowSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailedUntil", wireType)
+			}
+			m.JailedUntil = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JailedUntil |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsOutboundEnabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -1708,7 +3240,7 @@ func (m *EventCCTXEnabled) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventGasPriceIncreaseFlagsUpdated) Unmarshal(dAtA []byte) error {
+func (m *EventObserverUnjailed) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1731,10 +3263,10 @@ func (m *EventGasPriceIncreaseFlagsUpdated) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventGasPriceIncreaseFlagsUpdated: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventObserverUnjailed: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventGasPriceIncreaseFlagsUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventObserverUnjailed: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1771,9 +3303,9 @@ func (m *EventGasPriceIncreaseFlagsUpdated) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasPriceIncreaseFlags", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObserverAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1783,27 +3315,23 @@ func (m *EventGasPriceIncreaseFlagsUpdated) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.GasPriceIncreaseFlags == nil {
-				m.GasPriceIncreaseFlags = &GasPriceIncreaseFlags{}
-			}
-			if err := m.GasPriceIncreaseFlags.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ObserverAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -47,6 +47,23 @@ func (gs GenesisState) Validate() error {
 		chainNoncesIndexMap[elem.ChainId] = true
 	}
 
+	// check for invalid liveness params
+	if gs.LivenessParams != nil {
+		if err := gs.LivenessParams.Validate(); err != nil {
+			return err
+		}
+	}
+
+	// Check for duplicated index in observersLiveness
+	observersLivenessIndexMap := make(map[string]bool)
+
+	for _, elem := range gs.ObserversLiveness {
+		if _, ok := observersLivenessIndexMap[elem.ObserverAddress]; ok {
+			return fmt.Errorf("duplicated index for observersLiveness")
+		}
+		observersLivenessIndexMap[elem.ObserverAddress] = true
+	}
+
 	return gs.Observers.Validate()
 }
 
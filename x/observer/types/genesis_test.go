@@ -22,6 +22,15 @@ func TestGenesisState_Validate(t *testing.T) {
 	chainNonce := sample.ChainNonces(0)
 	gsWithDuplicateChainNonces.ChainNonces = []types.ChainNonces{chainNonce, chainNonce}
 
+	gsWithInvalidLivenessParams := types.DefaultGenesis()
+	livenessParams := types.DefaultLivenessParams()
+	livenessParams.WindowSize = 0
+	gsWithInvalidLivenessParams.LivenessParams = &livenessParams
+
+	gsWithDuplicateObserversLiveness := types.DefaultGenesis()
+	observerLiveness := sample.ObserverLiveness(t, "0")
+	gsWithDuplicateObserversLiveness.ObserversLiveness = []types.ObserverLiveness{observerLiveness, observerLiveness}
+
 	for _, tc := range []struct {
 		desc     string
 		genState *types.GenesisState
@@ -52,6 +61,16 @@ func TestGenesisState_Validate(t *testing.T) {
 			genState: gsWithDuplicateChainNonces,
 			valid:    false,
 		},
+		{
+			desc:     "invalid liveness params",
+			genState: gsWithInvalidLivenessParams,
+			valid:    false,
+		},
+		{
+			desc:     "invalid genesis state duplicate observers liveness",
+			genState: gsWithDuplicateObserversLiveness,
+			valid:    false,
+		},
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
 			err := tc.genState.Validate()
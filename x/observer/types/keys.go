@@ -70,16 +70,22 @@ const (
 	BlockHeaderKey            = "BlockHeader-value-"
 	BlockHeaderStateKey       = "BlockHeaderState-value-"
 
-	BallotListKey      = "BallotList-value-"
-	TSSKey             = "TSS-value-"
-	TSSHistoryKey      = "TSS-History-value-"
-	TssFundMigratorKey = "FundsMigrator-value-"
+	BallotListKey        = "BallotList-value-"
+	BallotPruneHeightKey = "BallotPruneHeight-value-"
+	TSSKey               = "TSS-value-"
+	TSSHistoryKey        = "TSS-History-value-"
+	TssFundMigratorKey   = "FundsMigrator-value-"
 
 	PendingNoncesKeyPrefix = "PendingNonces-value-"
 	ChainNoncesKey         = "ChainNonces-value-"
 	NonceToCctxKeyPrefix   = "NonceToCctx-value-"
 
 	ParamsKey = "Params-value-"
+
+	// LivenessParamsKey is the key for the observer liveness params
+	LivenessParamsKey = "LivenessParams-value-"
+	// ObserverLivenessKey is the key prefix for the liveness of each observer
+	ObserverLivenessKey = "ObserverLiveness-value-"
 )
 
 func GetBlameIndex(chainID int64, nonce uint64, digest string, height uint64) string {
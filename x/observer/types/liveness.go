@@ -0,0 +1,90 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+)
+
+// DefaultLivenessParams returns the default liveness params
+// The tracking is disabled by default and must be enabled by the operational policy
+func DefaultLivenessParams() LivenessParams {
+	return LivenessParams{
+		Enabled:            false,
+		WindowSize:         1000,
+		MinVoteRatio:       sdkmath.LegacyNewDecWithPrec(5, 1),
+		JailDurationBlocks: 14400,
+		MaxJailedObservers: 1,
+	}
+}
+
+// Validate checks the liveness params are valid
+func (p LivenessParams) Validate() error {
+	if p.WindowSize == 0 {
+		return errors.Wrap(ErrInvalidLivenessParams, "window size must be positive")
+	}
+	if p.MinVoteRatio.IsNil() || p.MinVoteRatio.IsNegative() || p.MinVoteRatio.GT(sdkmath.LegacyOneDec()) {
+		return errors.Wrap(ErrInvalidLivenessParams, "min vote ratio must be between 0 and 1")
+	}
+	if p.JailDurationBlocks < 0 {
+		return errors.Wrap(ErrInvalidLivenessParams, "jail duration blocks must not be negative")
+	}
+	return nil
+}
+
+// NewObserverLiveness returns an empty liveness record for the observer
+func NewObserverLiveness(observerAddress string) ObserverLiveness {
+	return ObserverLiveness{
+		ObserverAddress: observerAddress,
+		MissedBallots:   []bool{},
+	}
+}
+
+// RecordBallot records the participation of the observer in a ballot in the sliding window
+// The window is reset if the window size has changed since the last record
+func (l *ObserverLiveness) RecordBallot(missed bool, windowSize uint64) {
+	expectedLen := l.RecordedBallots
+	if expectedLen > windowSize {
+		expectedLen = windowSize
+	}
+	if uint64(len(l.MissedBallots)) != expectedLen {
+		l.ResetWindow()
+	}
+
+	if uint64(len(l.MissedBallots)) < windowSize {
+		// the ring buffer grows until reaching the window size
+		l.MissedBallots = append(l.MissedBallots, missed)
+	} else {
+		index := l.RecordedBallots % windowSize
+		if l.MissedBallots[index] {
+			l.MissedBallotsCounter--
+		}
+		l.MissedBallots[index] = missed
+	}
+
+	if missed {
+		l.MissedBallotsCounter++
+	}
+	l.RecordedBallots++
+}
+
+// ResetWindow clears the participation recorded in the sliding window
+func (l *ObserverLiveness) ResetWindow() {
+	l.MissedBallots = []bool{}
+	l.RecordedBallots = 0
+	l.MissedBallotsCounter = 0
+}
+
+// IsWindowFull returns true if the window contains enough ballots to evaluate the liveness
+func (l ObserverLiveness) IsWindowFull(windowSize uint64) bool {
+	return uint64(len(l.MissedBallots)) >= windowSize
+}
+
+// Score returns the ratio of ballots voted in the window, one if no ballot has been recorded
+func (l ObserverLiveness) Score() sdkmath.LegacyDec {
+	if len(l.MissedBallots) == 0 {
+		return sdkmath.LegacyOneDec()
+	}
+	recorded := sdkmath.LegacyNewDec(int64(len(l.MissedBallots)))
+	voted := recorded.Sub(sdkmath.LegacyNewDec(int64(l.MissedBallotsCounter)))
+	return voted.Quo(recorded)
+}
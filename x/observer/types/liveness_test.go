@@ -0,0 +1,91 @@
+package types_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/node/x/observer/types"
+)
+
+func TestLivenessParams_Validate(t *testing.T) {
+	t.Run("default params are valid", func(t *testing.T) {
+		require.NoError(t, types.DefaultLivenessParams().Validate())
+	})
+
+	t.Run("should error if window size is zero", func(t *testing.T) {
+		params := types.DefaultLivenessParams()
+		params.WindowSize = 0
+		require.ErrorIs(t, params.Validate(), types.ErrInvalidLivenessParams)
+	})
+
+	t.Run("should error if min vote ratio is nil", func(t *testing.T) {
+		params := types.DefaultLivenessParams()
+		params.MinVoteRatio = sdkmath.LegacyDec{}
+		require.ErrorIs(t, params.Validate(), types.ErrInvalidLivenessParams)
+	})
+
+	t.Run("should error if min vote ratio is greater than one", func(t *testing.T) {
+		params := types.DefaultLivenessParams()
+		params.MinVoteRatio = sdkmath.LegacyNewDecWithPrec(11, 1)
+		require.ErrorIs(t, params.Validate(), types.ErrInvalidLivenessParams)
+	})
+
+	t.Run("should error if jail duration is negative", func(t *testing.T) {
+		params := types.DefaultLivenessParams()
+		params.JailDurationBlocks = -1
+		require.ErrorIs(t, params.Validate(), types.ErrInvalidLivenessParams)
+	})
+}
+
+func TestObserverLiveness_RecordBallot(t *testing.T) {
+	t.Run("should fill the window then slide", func(t *testing.T) {
+		liveness := types.NewObserverLiveness("observer")
+		require.True(t, liveness.Score().Equal(sdkmath.LegacyOneDec()))
+
+		liveness.RecordBallot(true, 4)
+		liveness.RecordBallot(false, 4)
+		liveness.RecordBallot(true, 4)
+		require.False(t, liveness.IsWindowFull(4))
+		require.EqualValues(t, 2, liveness.MissedBallotsCounter)
+
+		liveness.RecordBallot(false, 4)
+		require.True(t, liveness.IsWindowFull(4))
+		require.True(t, liveness.Score().Equal(sdkmath.LegacyNewDecWithPrec(5, 1)))
+
+		// the oldest missed ballot leaves the window
+		liveness.RecordBallot(false, 4)
+		require.EqualValues(t, 1, liveness.MissedBallotsCounter)
+		require.EqualValues(t, 5, liveness.RecordedBallots)
+		require.Len(t, liveness.MissedBallots, 4)
+		require.True(t, liveness.Score().Equal(sdkmath.LegacyNewDecWithPrec(75, 2)))
+	})
+
+	t.Run("should reset the window if the window size changes", func(t *testing.T) {
+		liveness := types.NewObserverLiveness("observer")
+		for i := 0; i < 6; i++ {
+			liveness.RecordBallot(true, 4)
+		}
+		require.EqualValues(t, 4, liveness.MissedBallotsCounter)
+
+		liveness.RecordBallot(false, 8)
+		require.EqualValues(t, 0, liveness.MissedBallotsCounter)
+		require.EqualValues(t, 1, liveness.RecordedBallots)
+		require.Equal(t, []bool{false}, liveness.MissedBallots)
+
+		liveness.RecordBallot(true, 8)
+		liveness.RecordBallot(true, 1)
+		require.Equal(t, []bool{true}, liveness.MissedBallots)
+		require.EqualValues(t, 1, liveness.MissedBallotsCounter)
+	})
+
+	t.Run("should reset the window", func(t *testing.T) {
+		liveness := types.NewObserverLiveness("observer")
+		liveness.RecordBallot(true, 4)
+		liveness.ResetWindow()
+		require.Empty(t, liveness.MissedBallots)
+		require.Zero(t, liveness.RecordedBallots)
+		require.Zero(t, liveness.MissedBallotsCounter)
+	})
+}
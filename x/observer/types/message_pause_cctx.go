@@ -0,0 +1,76 @@
+package types
+
+import (
+	cosmoserrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	TypeMsgPauseCCTX = "pause_crosschain"
+)
+
+var _ sdk.Msg = &MsgPauseCCTX{}
+
+func NewMsgPauseCCTX(
+	creator string,
+	chainIDs []int64,
+	zrc20Addresses []string,
+	pauseInbound, pauseOutbound bool,
+) *MsgPauseCCTX {
+	return &MsgPauseCCTX{
+		Creator:        creator,
+		ChainIds:       chainIDs,
+		Zrc20Addresses: zrc20Addresses,
+		PauseInbound:   pauseInbound,
+		PauseOutbound:  pauseOutbound,
+	}
+}
+
+func (msg *MsgPauseCCTX) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgPauseCCTX) Type() string {
+	return TypeMsgPauseCCTX
+}
+
+func (msg *MsgPauseCCTX) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgPauseCCTX) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgPauseCCTX) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return cosmoserrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if !msg.PauseInbound && !msg.PauseOutbound {
+		return cosmoserrors.Wrap(
+			sdkerrors.ErrInvalidRequest,
+			"at least one of PauseInbound or PauseOutbound must be true",
+		)
+	}
+	return validatePauseTargets(msg.ChainIds, msg.Zrc20Addresses)
+}
+
+// validatePauseTargets validates the chains and ZRC20s to pause or unpause
+func validatePauseTargets(chainIDs []int64, zrc20Addresses []string) error {
+	if len(chainIDs) == 0 && len(zrc20Addresses) == 0 {
+		return cosmoserrors.Wrap(sdkerrors.ErrInvalidRequest, "no chain or zrc20 provided")
+	}
+	for _, zrc20Address := range zrc20Addresses {
+		if !ethcommon.IsHexAddress(zrc20Address) {
+			return cosmoserrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid zrc20 address (%s)", zrc20Address)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,119 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/node/testutil/sample"
+	"github.com/zeta-chain/node/x/observer/types"
+)
+
+func TestMsgPauseCCTX_ValidateBasic(t *testing.T) {
+	zrc20 := sample.EthAddress().Hex()
+	tt := []struct {
+		name string
+		msg  *types.MsgPauseCCTX
+		err  require.ErrorAssertionFunc
+	}{
+		{
+			name: "invalid creator address",
+			msg:  types.NewMsgPauseCCTX("invalid", []int64{1}, nil, true, true),
+			err: func(t require.TestingT, err error, i ...interface{}) {
+				require.ErrorContains(t, err, "invalid creator address")
+			},
+		},
+		{
+			name: "invalid flags",
+			msg:  types.NewMsgPauseCCTX(sample.AccAddress(), []int64{1}, nil, false, false),
+			err: func(t require.TestingT, err error, i ...interface{}) {
+				require.ErrorContains(t, err, "at least one of PauseInbound or PauseOutbound must be true")
+			},
+		},
+		{
+			name: "no chain or zrc20",
+			msg:  types.NewMsgPauseCCTX(sample.AccAddress(), nil, nil, true, true),
+			err: func(t require.TestingT, err error, i ...interface{}) {
+				require.ErrorContains(t, err, "no chain or zrc20 provided")
+			},
+		},
+		{
+			name: "invalid zrc20 address",
+			msg:  types.NewMsgPauseCCTX(sample.AccAddress(), nil, []string{"invalid"}, true, true),
+			err: func(t require.TestingT, err error, i ...interface{}) {
+				require.ErrorContains(t, err, "invalid zrc20 address")
+			},
+		},
+		{
+			name: "valid",
+			msg:  types.NewMsgPauseCCTX(sample.AccAddress(), []int64{1, 2}, []string{zrc20}, true, false),
+			err:  require.NoError,
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.err(t, tc.msg.ValidateBasic())
+		})
+	}
+}
+
+func TestMsgPauseCCTX_GetSigners(t *testing.T) {
+	signer := sample.AccAddress()
+	tests := []struct {
+		name   string
+		msg    types.MsgPauseCCTX
+		panics bool
+	}{
+		{
+			name: "valid signer",
+			msg: types.MsgPauseCCTX{
+				Creator: signer,
+			},
+			panics: false,
+		},
+		{
+			name: "invalid signer",
+			msg: types.MsgPauseCCTX{
+				Creator: "invalid",
+			},
+			panics: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !tt.panics {
+				signers := tt.msg.GetSigners()
+				require.Equal(t, []sdk.AccAddress{sdk.MustAccAddressFromBech32(signer)}, signers)
+			} else {
+				require.Panics(t, func() {
+					tt.msg.GetSigners()
+				})
+			}
+		})
+	}
+}
+
+func TestMsgPauseCCTX_Type(t *testing.T) {
+	msg := types.MsgPauseCCTX{
+		Creator: sample.AccAddress(),
+	}
+	require.Equal(t, types.TypeMsgPauseCCTX, msg.Type())
+}
+
+func TestMsgPauseCCTX_Route(t *testing.T) {
+	msg := types.MsgPauseCCTX{
+		Creator: sample.AccAddress(),
+	}
+	require.Equal(t, types.RouterKey, msg.Route())
+}
+
+func TestMsgPauseCCTX_GetSignBytes(t *testing.T) {
+	msg := types.MsgPauseCCTX{
+		Creator: sample.AccAddress(),
+	}
+	require.NotPanics(t, func() {
+		msg.GetSignBytes()
+	})
+}
@@ -0,0 +1,104 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/node/testutil/sample"
+	"github.com/zeta-chain/node/x/observer/types"
+)
+
+func TestMsgUnjailObserver_ValidateBasic(t *testing.T) {
+	tt := []struct {
+		name string
+		msg  *types.MsgUnjailObserver
+		err  require.ErrorAssertionFunc
+	}{
+		{
+			name: "invalid creator address",
+			msg:  types.NewMsgUnjailObserver("invalid", sample.AccAddress()),
+			err: func(t require.TestingT, err error, i ...interface{}) {
+				require.ErrorContains(t, err, "invalid creator address")
+			},
+		},
+		{
+			name: "invalid observer address",
+			msg:  types.NewMsgUnjailObserver(sample.AccAddress(), "invalid"),
+			err: func(t require.TestingT, err error, i ...interface{}) {
+				require.ErrorContains(t, err, "invalid observer address")
+			},
+		},
+		{
+			name: "valid",
+			msg:  types.NewMsgUnjailObserver(sample.AccAddress(), sample.AccAddress()),
+			err:  require.NoError,
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.err(t, tc.msg.ValidateBasic())
+		})
+	}
+}
+
+func TestMsgUnjailObserver_GetSigners(t *testing.T) {
+	signer := sample.AccAddress()
+	tests := []struct {
+		name   string
+		msg    types.MsgUnjailObserver
+		panics bool
+	}{
+		{
+			name: "valid signer",
+			msg: types.MsgUnjailObserver{
+				Creator: signer,
+			},
+			panics: false,
+		},
+		{
+			name: "invalid signer",
+			msg: types.MsgUnjailObserver{
+				Creator: "invalid",
+			},
+			panics: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !tt.panics {
+				signers := tt.msg.GetSigners()
+				require.Equal(t, []sdk.AccAddress{sdk.MustAccAddressFromBech32(signer)}, signers)
+			} else {
+				require.Panics(t, func() {
+					tt.msg.GetSigners()
+				})
+			}
+		})
+	}
+}
+
+func TestMsgUnjailObserver_Type(t *testing.T) {
+	msg := types.MsgUnjailObserver{
+		Creator: sample.AccAddress(),
+	}
+	require.Equal(t, types.TypeMsgUnjailObserver, msg.Type())
+}
+
+func TestMsgUnjailObserver_Route(t *testing.T) {
+	msg := types.MsgUnjailObserver{
+		Creator: sample.AccAddress(),
+	}
+	require.Equal(t, types.RouterKey, msg.Route())
+}
+
+func TestMsgUnjailObserver_GetSignBytes(t *testing.T) {
+	msg := types.MsgUnjailObserver{
+		Creator: sample.AccAddress(),
+	}
+	require.NotPanics(t, func() {
+		msg.GetSignBytes()
+	})
+}
@@ -0,0 +1,62 @@
+package types
+
+import (
+	cosmoserrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	TypeMsgUnpauseCCTX = "unpause_crosschain"
+)
+
+var _ sdk.Msg = &MsgUnpauseCCTX{}
+
+func NewMsgUnpauseCCTX(
+	creator string,
+	chainIDs []int64,
+	zrc20Addresses []string,
+	unpauseInbound, unpauseOutbound bool,
+) *MsgUnpauseCCTX {
+	return &MsgUnpauseCCTX{
+		Creator:         creator,
+		ChainIds:        chainIDs,
+		Zrc20Addresses:  zrc20Addresses,
+		UnpauseInbound:  unpauseInbound,
+		UnpauseOutbound: unpauseOutbound,
+	}
+}
+
+func (msg *MsgUnpauseCCTX) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUnpauseCCTX) Type() string {
+	return TypeMsgUnpauseCCTX
+}
+
+func (msg *MsgUnpauseCCTX) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgUnpauseCCTX) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgUnpauseCCTX) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return cosmoserrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if !msg.UnpauseInbound && !msg.UnpauseOutbound {
+		return cosmoserrors.Wrap(
+			sdkerrors.ErrInvalidRequest,
+			"at least one of UnpauseInbound or UnpauseOutbound must be true",
+		)
+	}
+	return validatePauseTargets(msg.ChainIds, msg.Zrc20Addresses)
+}
@@ -0,0 +1,107 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/node/testutil/sample"
+	"github.com/zeta-chain/node/x/observer/types"
+)
+
+func TestMsgUpdateLivenessParams_ValidateBasic(t *testing.T) {
+	invalidParams := types.DefaultLivenessParams()
+	invalidParams.WindowSize = 0
+
+	tt := []struct {
+		name string
+		msg  *types.MsgUpdateLivenessParams
+		err  require.ErrorAssertionFunc
+	}{
+		{
+			name: "invalid creator address",
+			msg:  types.NewMsgUpdateLivenessParams("invalid", types.DefaultLivenessParams()),
+			err: func(t require.TestingT, err error, i ...interface{}) {
+				require.ErrorContains(t, err, "invalid creator address")
+			},
+		},
+		{
+			name: "invalid liveness params",
+			msg:  types.NewMsgUpdateLivenessParams(sample.AccAddress(), invalidParams),
+			err: func(t require.TestingT, err error, i ...interface{}) {
+				require.ErrorContains(t, err, "invalid request")
+			},
+		},
+		{
+			name: "valid",
+			msg:  types.NewMsgUpdateLivenessParams(sample.AccAddress(), types.DefaultLivenessParams()),
+			err:  require.NoError,
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.err(t, tc.msg.ValidateBasic())
+		})
+	}
+}
+
+func TestMsgUpdateLivenessParams_GetSigners(t *testing.T) {
+	signer := sample.AccAddress()
+	tests := []struct {
+		name   string
+		msg    types.MsgUpdateLivenessParams
+		panics bool
+	}{
+		{
+			name: "valid signer",
+			msg: types.MsgUpdateLivenessParams{
+				Creator: signer,
+			},
+			panics: false,
+		},
+		{
+			name: "invalid signer",
+			msg: types.MsgUpdateLivenessParams{
+				Creator: "invalid",
+			},
+			panics: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !tt.panics {
+				signers := tt.msg.GetSigners()
+				require.Equal(t, []sdk.AccAddress{sdk.MustAccAddressFromBech32(signer)}, signers)
+			} else {
+				require.Panics(t, func() {
+					tt.msg.GetSigners()
+				})
+			}
+		})
+	}
+}
+
+func TestMsgUpdateLivenessParams_Type(t *testing.T) {
+	msg := types.MsgUpdateLivenessParams{
+		Creator: sample.AccAddress(),
+	}
+	require.Equal(t, types.TypeMsgUpdateLivenessParams, msg.Type())
+}
+
+func TestMsgUpdateLivenessParams_Route(t *testing.T) {
+	msg := types.MsgUpdateLivenessParams{
+		Creator: sample.AccAddress(),
+	}
+	require.Equal(t, types.RouterKey, msg.Route())
+}
+
+func TestMsgUpdateLivenessParams_GetSignBytes(t *testing.T) {
+	msg := types.MsgUpdateLivenessParams{
+		Creator: sample.AccAddress(),
+	}
+	require.NotPanics(t, func() {
+		msg.GetSignBytes()
+	})
+}
@@ -8,6 +8,7 @@ import (
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	coin "github.com/zeta-chain/node/pkg/coin"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -68,6 +69,71 @@ func (m *ChainParamsList) GetChainParams() []*ChainParams {
 	return nil
 }
 
+// ConfirmationTier defines the number of confirmations required for the
+// inbounds and outbounds of an asset with an amount greater than or equal to
+// the threshold
+type ConfirmationTier struct {
+	CoinType coin.CoinType `protobuf:"varint,1,opt,name=coin_type,json=coinType,proto3,enum=zetachain.zetacore.pkg.coin.CoinType" json:"coin_type,omitempty"`
+	// asset address, only used for the ERC20 coin type
+	Asset             string                                  `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	AmountThreshold   github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,3,opt,name=amount_threshold,json=amountThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"amount_threshold"`
+	ConfirmationCount uint64                                  `protobuf:"varint,4,opt,name=confirmation_count,json=confirmationCount,proto3" json:"confirmation_count,omitempty"`
+}
+
+func (m *ConfirmationTier) Reset()         { *m = ConfirmationTier{} }
+func (m *ConfirmationTier) String() string { return proto.CompactTextString(m) }
+func (*ConfirmationTier) ProtoMessage()    {}
+func (*ConfirmationTier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7fa4666eddf88e5, []int{1}
+}
+func (m *ConfirmationTier) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConfirmationTier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConfirmationTier.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConfirmationTier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConfirmationTier.Merge(m, src)
+}
+func (m *ConfirmationTier) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConfirmationTier) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConfirmationTier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConfirmationTier proto.InternalMessageInfo
+
+func (m *ConfirmationTier) GetCoinType() coin.CoinType {
+	if m != nil {
+		return m.CoinType
+	}
+	return coin.CoinType_Zeta
+}
+
+func (m *ConfirmationTier) GetAsset() string {
+	if m != nil {
+		return m.Asset
+	}
+	return ""
+}
+
+func (m *ConfirmationTier) GetConfirmationCount() uint64 {
+	if m != nil {
+		return m.ConfirmationCount
+	}
+	return 0
+}
+
 type ChainParams struct {
 	ChainId                     int64                                  `protobuf:"varint,11,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 	ConfirmationCount           uint64                                 `protobuf:"varint,1,opt,name=confirmation_count,json=confirmationCount,proto3" json:"confirmation_count,omitempty"`
@@ -84,13 +150,29 @@ type ChainParams struct {
 	MinObserverDelegation       github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,15,opt,name=min_observer_delegation,json=minObserverDelegation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_observer_delegation"`
 	IsSupported                 bool                                   `protobuf:"varint,16,opt,name=is_supported,json=isSupported,proto3" json:"is_supported,omitempty"`
 	GatewayAddress              string                                 `protobuf:"bytes,17,opt,name=gateway_address,json=gatewayAddress,proto3" json:"gateway_address,omitempty"`
+	// confirmation counts for larger amounts, confirmation_count is used for
+	// amounts below the lowest threshold
+	ConfirmationTiers []ConfirmationTier `protobuf:"bytes,18,rep,name=confirmation_tiers,json=confirmationTiers,proto3" json:"confirmation_tiers"`
+	// if true, the votes of the ballots are weighted by the bonded stake of the
+	// observers at the ballot creation
+	WeightedVoting bool `protobuf:"varint,19,opt,name=weighted_voting,json=weightedVoting,proto3" json:"weighted_voting,omitempty"`
+	// bitcoin only: the consolidation of the TSS UTXOs is scheduled when their
+	// number reaches this threshold, 0 disables the trigger
+	UtxoConsolidationThreshold uint64 `protobuf:"varint,20,opt,name=utxo_consolidation_threshold,json=utxoConsolidationThreshold,proto3" json:"utxo_consolidation_threshold,omitempty"`
+	// bitcoin only: the consolidation of the TSS UTXOs is scheduled when the
+	// percentage of dust UTXOs reaches this threshold, 0 disables the trigger
+	UtxoDustRatioThreshold uint32 `protobuf:"varint,21,opt,name=utxo_dust_ratio_threshold,json=utxoDustRatioThreshold,proto3" json:"utxo_dust_ratio_threshold,omitempty"`
+	// bitcoin only: the consolidation of the TSS UTXOs is scheduled only when
+	// the fee rate (in sat/vB) is lower than or equal to this value, 0 means no
+	// limit
+	UtxoConsolidationMaxFeeRate uint64 `protobuf:"varint,22,opt,name=utxo_consolidation_max_fee_rate,json=utxoConsolidationMaxFeeRate,proto3" json:"utxo_consolidation_max_fee_rate,omitempty"`
 }
 
 func (m *ChainParams) Reset()         { *m = ChainParams{} }
 func (m *ChainParams) String() string { return proto.CompactTextString(m) }
 func (*ChainParams) ProtoMessage()    {}
 func (*ChainParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7fa4666eddf88e5, []int{1}
+	return fileDescriptor_e7fa4666eddf88e5, []int{2}
 }
 func (m *ChainParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -210,6 +292,41 @@ func (m *ChainParams) GetGatewayAddress() string {
 	return ""
 }
 
+func (m *ChainParams) GetConfirmationTiers() []ConfirmationTier {
+	if m != nil {
+		return m.ConfirmationTiers
+	}
+	return nil
+}
+
+func (m *ChainParams) GetWeightedVoting() bool {
+	if m != nil {
+		return m.WeightedVoting
+	}
+	return false
+}
+
+func (m *ChainParams) GetUtxoConsolidationThreshold() uint64 {
+	if m != nil {
+		return m.UtxoConsolidationThreshold
+	}
+	return 0
+}
+
+func (m *ChainParams) GetUtxoDustRatioThreshold() uint32 {
+	if m != nil {
+		return m.UtxoDustRatioThreshold
+	}
+	return 0
+}
+
+func (m *ChainParams) GetUtxoConsolidationMaxFeeRate() uint64 {
+	if m != nil {
+		return m.UtxoConsolidationMaxFeeRate
+	}
+	return 0
+}
+
 // Deprecated(v17)
 type Params struct {
 	// Deprecated(v17):Moved into the emissions module
@@ -220,7 +337,7 @@ func (m *Params) Reset()         { *m = Params{} }
 func (m *Params) String() string { return proto.CompactTextString(m) }
 func (*Params) ProtoMessage()    {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7fa4666eddf88e5, []int{2}
+	return fileDescriptor_e7fa4666eddf88e5, []int{3}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -258,6 +375,7 @@ func (m *Params) GetBallotMaturityBlocks() int64 {
 
 func init() {
 	proto.RegisterType((*ChainParamsList)(nil), "zetachain.zetacore.observer.ChainParamsList")
+	proto.RegisterType((*ConfirmationTier)(nil), "zetachain.zetacore.observer.ConfirmationTier")
 	proto.RegisterType((*ChainParams)(nil), "zetachain.zetacore.observer.ChainParams")
 	proto.RegisterType((*Params)(nil), "zetachain.zetacore.observer.Params")
 }
@@ -267,48 +385,62 @@ func init() {
 }
 
 var fileDescriptor_e7fa4666eddf88e5 = []byte{
-	// 642 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xc1, 0x4e, 0xdb, 0x4a,
-	0x14, 0x86, 0xe3, 0x1b, 0x2e, 0x17, 0x26, 0x90, 0x80, 0xc5, 0xbd, 0xd7, 0x80, 0x64, 0x52, 0xa4,
-	0xb6, 0x16, 0x15, 0x76, 0x45, 0xbb, 0x6c, 0x91, 0x4a, 0xe8, 0x02, 0x95, 0xaa, 0xc8, 0xd0, 0x45,
-	0xbb, 0xe8, 0x68, 0x32, 0x1e, 0xec, 0x51, 0x6c, 0x9f, 0x68, 0x66, 0x0c, 0xa4, 0x4f, 0xd1, 0xc7,
-	0x62, 0xd1, 0x05, 0xcb, 0xaa, 0x0b, 0x54, 0xc1, 0x8b, 0x54, 0x1e, 0x8f, 0x43, 0x0a, 0x15, 0x8b,
-	0xae, 0x32, 0x3e, 0xff, 0xf7, 0xff, 0x9e, 0xf1, 0x39, 0x19, 0xe4, 0x7d, 0x66, 0x8a, 0xd0, 0x84,
-	0xf0, 0x3c, 0xd0, 0x2b, 0x10, 0x2c, 0x80, 0xbe, 0x64, 0xe2, 0x84, 0x89, 0x60, 0x48, 0x04, 0xc9,
-	0xa4, 0x3f, 0x14, 0xa0, 0xc0, 0x5e, 0x1d, 0x93, 0x7e, 0x4d, 0xfa, 0x35, 0xb9, 0xb2, 0x14, 0x43,
-	0x0c, 0x9a, 0x0b, 0xca, 0x55, 0x65, 0x59, 0xd9, 0xb8, 0x2f, 0xbc, 0x5e, 0x54, 0xec, 0xfa, 0x27,
-	0xd4, 0xe9, 0x95, 0xe4, 0x81, 0x7e, 0xe7, 0x3e, 0x97, 0xca, 0x7e, 0x83, 0xe6, 0xb4, 0x19, 0x57,
-	0xfb, 0x70, 0xac, 0x6e, 0xd3, 0x6b, 0x6d, 0x79, 0xfe, 0x3d, 0x1b, 0xf1, 0x27, 0x32, 0xc2, 0x16,
-	0xbd, 0x79, 0x58, 0xff, 0x3a, 0x8d, 0x5a, 0x13, 0xa2, 0xbd, 0x8c, 0x66, 0xaa, 0x70, 0x1e, 0x39,
-	0xad, 0xae, 0xe5, 0x35, 0xc3, 0x7f, 0xf4, 0xf3, 0x5e, 0x64, 0x6f, 0x22, 0x9b, 0x42, 0x7e, 0xcc,
-	0x45, 0x46, 0x14, 0x87, 0x1c, 0x53, 0x28, 0x72, 0xe5, 0x58, 0x5d, 0xcb, 0x9b, 0x0a, 0x17, 0x27,
-	0x95, 0x5e, 0x29, 0xd8, 0x1e, 0x5a, 0x88, 0x89, 0xc4, 0x43, 0xc1, 0x29, 0xc3, 0x8a, 0xd3, 0x01,
-	0x13, 0xce, 0x5f, 0x1a, 0x6e, 0xc7, 0x44, 0x1e, 0x94, 0xe5, 0x23, 0x5d, 0xb5, 0x1f, 0xa2, 0x36,
-	0xcf, 0xfb, 0x50, 0xe4, 0x51, 0xcd, 0x35, 0x35, 0x37, 0x6f, 0xaa, 0x06, 0x7b, 0x8c, 0x3a, 0x50,
-	0xa8, 0x5f, 0xb8, 0xa9, 0x2a, 0xaf, 0x2e, 0x1b, 0x70, 0x03, 0x2d, 0x9e, 0x12, 0x45, 0x13, 0x5c,
-	0xa8, 0x33, 0xa8, 0xd1, 0xbf, 0x35, 0xda, 0xd1, 0xc2, 0x7b, 0x75, 0x06, 0x86, 0x7d, 0x89, 0x74,
-	0x03, 0xb1, 0x82, 0x01, 0x2b, 0x8f, 0x94, 0x2b, 0x41, 0xa8, 0xc2, 0x24, 0x8a, 0x04, 0x93, 0xd2,
-	0x99, 0xe9, 0x5a, 0xde, 0x6c, 0xe8, 0x94, 0xc8, 0x51, 0x49, 0xf4, 0x0c, 0xf0, 0xaa, 0xd2, 0xed,
-	0x17, 0x68, 0x85, 0x42, 0x9e, 0x33, 0xaa, 0x40, 0xdc, 0x75, 0xcf, 0x56, 0xee, 0x31, 0x71, 0xdb,
-	0xdd, 0x43, 0x2e, 0x13, 0x74, 0xeb, 0x29, 0xa6, 0x85, 0x54, 0x10, 0x8d, 0xee, 0x26, 0x20, 0x9d,
-	0xb0, 0xaa, 0xa9, 0x5e, 0x05, 0xfd, 0x66, 0x0b, 0xe3, 0xcf, 0x22, 0x69, 0xc2, 0xa2, 0x22, 0x65,
-	0x98, 0xe7, 0x8a, 0x89, 0x13, 0x92, 0x3a, 0x73, 0xba, 0x87, 0x4e, 0x4d, 0x1c, 0x1a, 0x60, 0xcf,
-	0xe8, 0xf6, 0x36, 0x5a, 0xbd, 0xeb, 0x4e, 0x01, 0x06, 0x24, 0x61, 0x24, 0x72, 0xe6, 0xb5, 0x7d,
-	0xf9, 0xb6, 0x7d, 0xbf, 0x06, 0xec, 0x0f, 0x68, 0xa1, 0x4f, 0xd2, 0x14, 0x14, 0x56, 0x89, 0x60,
-	0x32, 0x81, 0x34, 0x72, 0xda, 0xe5, 0xa6, 0x77, 0xfc, 0xf3, 0xcb, 0xb5, 0xc6, 0xf7, 0xcb, 0xb5,
-	0x47, 0x31, 0x57, 0x49, 0xd1, 0xf7, 0x29, 0x64, 0x01, 0x05, 0x99, 0x81, 0x34, 0x3f, 0x9b, 0x32,
-	0x1a, 0x04, 0x6a, 0x34, 0x64, 0xd2, 0xdf, 0x65, 0x34, 0xec, 0x54, 0x39, 0x47, 0x75, 0x8c, 0x7d,
-	0x8c, 0xfe, 0xcf, 0x78, 0x8e, 0xeb, 0x19, 0xc6, 0x11, 0x4b, 0x59, 0xac, 0x07, 0xcc, 0xe9, 0xfc,
-	0xd1, 0x1b, 0xfe, 0xcd, 0x78, 0xfe, 0xce, 0xa4, 0xed, 0x8e, 0xc3, 0xec, 0x07, 0x68, 0x8e, 0x4b,
-	0x2c, 0x8b, 0xe1, 0x10, 0x84, 0x62, 0x91, 0xb3, 0xd0, 0xb5, 0xbc, 0x99, 0xb0, 0xc5, 0xe5, 0x61,
-	0x5d, 0x2a, 0x47, 0x2f, 0x26, 0x8a, 0x9d, 0x92, 0xd1, 0xb8, 0x33, 0x8b, 0xba, 0x33, 0x6d, 0x53,
-	0x36, 0xcd, 0x58, 0xdf, 0x46, 0xd3, 0xe6, 0x8f, 0xf4, 0x1c, 0xfd, 0x67, 0x3e, 0x4c, 0x46, 0x54,
-	0x21, 0xb8, 0x1a, 0xe1, 0x7e, 0x0a, 0x74, 0x20, 0xf5, 0x70, 0x37, 0xc3, 0xa5, 0x4a, 0x7d, 0x6b,
-	0xc4, 0x1d, 0xad, 0xed, 0xbc, 0x3e, 0xbf, 0x72, 0xad, 0x8b, 0x2b, 0xd7, 0xfa, 0x71, 0xe5, 0x5a,
-	0x5f, 0xae, 0xdd, 0xc6, 0xc5, 0xb5, 0xdb, 0xf8, 0x76, 0xed, 0x36, 0x3e, 0x3e, 0x99, 0x38, 0x64,
-	0x39, 0x8e, 0x9b, 0xd5, 0x05, 0x92, 0x43, 0xc4, 0x82, 0xb3, 0x9b, 0xeb, 0x43, 0x9f, 0xb6, 0x3f,
-	0xad, 0x2f, 0x8f, 0x67, 0x3f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x74, 0xb1, 0xf1, 0x72, 0xc7, 0x04,
-	0x00, 0x00,
+	// 877 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x95, 0x41, 0x6f, 0xdb, 0x36,
+	0x14, 0xc7, 0xa3, 0x39, 0xed, 0x12, 0x3a, 0xb1, 0x1d, 0x2d, 0xcd, 0x94, 0x64, 0x70, 0xbc, 0x00,
+	0x6d, 0x85, 0x0e, 0x91, 0x86, 0x6c, 0x97, 0x01, 0x5b, 0xb1, 0xd9, 0xd9, 0x80, 0x62, 0x2d, 0x56,
+	0xa8, 0xee, 0x80, 0xf5, 0x30, 0x82, 0xa6, 0x5e, 0x64, 0xc2, 0x12, 0x29, 0x90, 0x54, 0x62, 0xef,
+	0x53, 0xec, 0x03, 0xec, 0x03, 0xf5, 0xd8, 0xe3, 0xb0, 0x43, 0x31, 0x24, 0x1f, 0x62, 0xd7, 0x81,
+	0x94, 0x64, 0xbb, 0x49, 0x1a, 0x0c, 0xbb, 0xd8, 0xd4, 0x7b, 0xbf, 0xf7, 0xe7, 0x23, 0xdf, 0x23,
+	0x89, 0xfc, 0xdf, 0x40, 0x13, 0x3a, 0x26, 0x8c, 0x87, 0x76, 0x24, 0x24, 0x84, 0x62, 0xa4, 0x40,
+	0x9e, 0x81, 0x0c, 0x73, 0x22, 0x49, 0xa6, 0x82, 0x5c, 0x0a, 0x2d, 0xdc, 0xfd, 0x39, 0x19, 0xd4,
+	0x64, 0x50, 0x93, 0x7b, 0xdb, 0x89, 0x48, 0x84, 0xe5, 0x42, 0x33, 0x2a, 0x43, 0xf6, 0x1e, 0xdd,
+	0x26, 0x5e, 0x0f, 0x2a, 0xf6, 0xc1, 0x0d, 0x6c, 0x3e, 0x49, 0x42, 0x2a, 0x18, 0xb7, 0x3f, 0x25,
+	0x77, 0xf8, 0x2b, 0x6a, 0x0f, 0x0c, 0xf5, 0xdc, 0xe6, 0xf6, 0x94, 0x29, 0xed, 0xfe, 0x88, 0x36,
+	0x6c, 0x20, 0x2e, 0xf3, 0xf5, 0x9c, 0x5e, 0xc3, 0x6f, 0x1e, 0xfb, 0xc1, 0x2d, 0x09, 0x07, 0x4b,
+	0x1a, 0x51, 0x93, 0x2e, 0x3e, 0x0e, 0xff, 0x71, 0x50, 0x67, 0x20, 0xf8, 0x29, 0x93, 0x19, 0xd1,
+	0x4c, 0xf0, 0x21, 0x03, 0xe9, 0xf6, 0xd1, 0xba, 0x49, 0x01, 0xeb, 0x59, 0x0e, 0x9e, 0xd3, 0x73,
+	0xfc, 0xd6, 0xf1, 0xfd, 0x9b, 0xe4, 0xf3, 0x49, 0x12, 0xd8, 0x5c, 0x07, 0x82, 0xf1, 0xe1, 0x2c,
+	0x87, 0x68, 0x8d, 0x56, 0x23, 0x77, 0x1b, 0xdd, 0x21, 0x4a, 0x81, 0xf6, 0x3e, 0xe8, 0x39, 0xfe,
+	0x7a, 0x54, 0x7e, 0xb8, 0xaf, 0x50, 0x87, 0x64, 0xa2, 0xe0, 0x1a, 0xeb, 0xb1, 0x04, 0x35, 0x16,
+	0x69, 0xec, 0x35, 0x0c, 0xd0, 0x0f, 0x5f, 0xbf, 0x3d, 0x58, 0xf9, 0xeb, 0xed, 0xc1, 0xc3, 0x84,
+	0xe9, 0x71, 0x31, 0x0a, 0xa8, 0xc8, 0x42, 0x2a, 0x54, 0x26, 0x54, 0xf5, 0x77, 0xa4, 0xe2, 0x49,
+	0x68, 0x32, 0x52, 0xc1, 0x4b, 0xc6, 0x75, 0xd4, 0x2e, 0x85, 0x86, 0xb5, 0x8e, 0x7b, 0x84, 0x5c,
+	0xba, 0xb4, 0x12, 0x4c, 0x8d, 0xdb, 0x5b, 0xed, 0x39, 0xfe, 0x6a, 0xb4, 0xb5, 0xec, 0x19, 0x18,
+	0xc7, 0xe1, 0x1f, 0xeb, 0xa8, 0xb9, 0xb4, 0x2d, 0xee, 0x2e, 0x5a, 0x2b, 0xb7, 0x95, 0xc5, 0x5e,
+	0xb3, 0xe7, 0xf8, 0x8d, 0xe8, 0x43, 0xfb, 0xfd, 0xe4, 0x7d, 0xca, 0xce, 0x7b, 0x94, 0x5d, 0x1f,
+	0x75, 0x12, 0xa2, 0x70, 0x2e, 0x19, 0x05, 0xac, 0x19, 0x9d, 0x80, 0xb4, 0xbb, 0xb0, 0x1a, 0xb5,
+	0x12, 0xa2, 0x9e, 0x1b, 0xf3, 0xd0, 0x5a, 0xdd, 0xfb, 0xa8, 0xc5, 0xf8, 0x48, 0x14, 0x3c, 0xae,
+	0xb9, 0x86, 0xe5, 0x36, 0x2b, 0x6b, 0x85, 0x3d, 0x44, 0x6d, 0x51, 0xe8, 0x77, 0xb8, 0x72, 0x59,
+	0xad, 0xda, 0x5c, 0x81, 0x8f, 0xd0, 0xd6, 0x39, 0xd1, 0x74, 0x8c, 0x0b, 0x3d, 0x15, 0x35, 0x7a,
+	0xc7, 0xa2, 0x6d, 0xeb, 0x78, 0xa9, 0xa7, 0xa2, 0x62, 0xbf, 0x41, 0xb6, 0xc5, 0xb1, 0x16, 0x13,
+	0x30, 0x4b, 0xe2, 0x5a, 0x12, 0xaa, 0x31, 0x89, 0x63, 0x09, 0x4a, 0x79, 0x6b, 0xb6, 0x6c, 0x9e,
+	0x41, 0x86, 0x86, 0x18, 0x54, 0xc0, 0x77, 0xa5, 0xdf, 0xfd, 0x1a, 0xed, 0x51, 0xc1, 0x39, 0x50,
+	0x2d, 0xe4, 0xf5, 0xe8, 0xf5, 0x32, 0x7a, 0x4e, 0x5c, 0x8d, 0x1e, 0xa0, 0x2e, 0x48, 0x7a, 0xfc,
+	0x39, 0xa6, 0x85, 0xd2, 0x22, 0x9e, 0x5d, 0x57, 0x40, 0x56, 0x61, 0xdf, 0x52, 0x83, 0x12, 0xba,
+	0x21, 0x85, 0xf9, 0xb6, 0x28, 0x3a, 0x86, 0xb8, 0x48, 0x01, 0x33, 0xae, 0x41, 0x9e, 0x91, 0xd4,
+	0xdb, 0xb0, 0x35, 0xf4, 0x6a, 0xe2, 0x45, 0x05, 0x3c, 0xa9, 0xfc, 0xee, 0x63, 0xb4, 0x7f, 0x3d,
+	0x3a, 0x15, 0x62, 0x42, 0xc6, 0x40, 0x62, 0x6f, 0xd3, 0x86, 0xef, 0x5e, 0x0d, 0x7f, 0x5a, 0x03,
+	0xee, 0x2f, 0xa8, 0x33, 0x22, 0x69, 0x2a, 0x96, 0x5b, 0xb9, 0x65, 0x5b, 0x39, 0xa8, 0x5a, 0xf9,
+	0xc1, 0x7f, 0x68, 0xe5, 0x13, 0xa0, 0x51, 0xbb, 0xd4, 0x59, 0x74, 0xf2, 0x29, 0xfa, 0x38, 0x63,
+	0x1c, 0xd7, 0xa7, 0x17, 0xc7, 0x90, 0x42, 0x62, 0x1b, 0xcc, 0x6b, 0xff, 0xaf, 0x19, 0xee, 0x65,
+	0x8c, 0xff, 0x54, 0xa9, 0x9d, 0xcc, 0xc5, 0xdc, 0x4f, 0xd1, 0x06, 0x53, 0x58, 0x15, 0x79, 0x2e,
+	0xa4, 0x86, 0xd8, 0xeb, 0xf4, 0x1c, 0x7f, 0x2d, 0x6a, 0x32, 0xf5, 0xa2, 0x36, 0x99, 0xd6, 0x4b,
+	0x88, 0x86, 0x73, 0x32, 0x9b, 0x57, 0x66, 0xcb, 0x56, 0xa6, 0x55, 0x99, 0xeb, 0x62, 0x8c, 0xae,
+	0x9c, 0x11, 0xcd, 0x40, 0x2a, 0xcf, 0xb5, 0x77, 0xd3, 0xd1, 0xed, 0x77, 0xd3, 0x95, 0xeb, 0xa7,
+	0xbf, 0x6a, 0x56, 0xf7, 0xee, 0xc1, 0x32, 0x76, 0x65, 0x92, 0x39, 0x07, 0x96, 0x8c, 0x35, 0xc4,
+	0xf8, 0x4c, 0x68, 0xc6, 0x13, 0xef, 0x23, 0x9b, 0x72, 0xab, 0x36, 0xff, 0x6c, 0xad, 0xee, 0xb7,
+	0xe8, 0x13, 0x7b, 0x02, 0xa8, 0xe0, 0x4a, 0xa4, 0x2c, 0xae, 0x52, 0x9a, 0xd7, 0x69, 0xdb, 0x1e,
+	0x89, 0x3d, 0xc3, 0x0c, 0x96, 0x91, 0x45, 0x09, 0xbe, 0x42, 0xbb, 0x56, 0x21, 0x2e, 0x94, 0xc6,
+	0xd2, 0x38, 0x97, 0xc2, 0xef, 0xf5, 0x1c, 0x7f, 0x33, 0xda, 0x31, 0xc0, 0x49, 0xa1, 0x74, 0x64,
+	0xdc, 0x8b, 0xd0, 0x13, 0x74, 0x70, 0xc3, 0xe4, 0x19, 0x99, 0xe2, 0x53, 0x00, 0xa3, 0x05, 0xde,
+	0x8e, 0x9d, 0x7f, 0xff, 0xda, 0xfc, 0xcf, 0xc8, 0xf4, 0x07, 0x80, 0x88, 0x68, 0x38, 0x7c, 0x8c,
+	0xee, 0x56, 0x17, 0xd3, 0x97, 0x68, 0xa7, 0x6a, 0xb4, 0x8c, 0xe8, 0x42, 0x32, 0x3d, 0xc3, 0xa3,
+	0x54, 0xd0, 0x89, 0xb2, 0x97, 0x45, 0x23, 0xda, 0x2e, 0xbd, 0xcf, 0x2a, 0x67, 0xdf, 0xfa, 0xfa,
+	0xdf, 0xbf, 0xbe, 0xe8, 0x3a, 0x6f, 0x2e, 0xba, 0xce, 0xdf, 0x17, 0x5d, 0xe7, 0xf7, 0xcb, 0xee,
+	0xca, 0x9b, 0xcb, 0xee, 0xca, 0x9f, 0x97, 0xdd, 0x95, 0x57, 0x9f, 0x2d, 0x35, 0x8d, 0xa9, 0xc6,
+	0x51, 0xf9, 0x0c, 0x71, 0x11, 0x43, 0x38, 0x5d, 0x3c, 0x58, 0xb6, 0x7b, 0x46, 0x77, 0xed, 0x33,
+	0xf4, 0xc5, 0xbf, 0x03, 0x00, 0x02, 0x13, 0x6b, 0xaa, 0x39, 0x07, 0x00, 0x00,
 }
 
 func (m *ChainParamsList) Marshal() (dAtA []byte, err error) {
@@ -348,6 +480,56 @@ func (m *ChainParamsList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ConfirmationTier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConfirmationTier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConfirmationTier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ConfirmationCount != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ConfirmationCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.AmountThreshold.Size()
+		i -= size
+		if _, err := m.AmountThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Asset) > 0 {
+		i -= len(m.Asset)
+		copy(dAtA[i:], m.Asset)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Asset)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.CoinType != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.CoinType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ChainParams) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -368,6 +550,55 @@ func (m *ChainParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.UtxoConsolidationMaxFeeRate != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.UtxoConsolidationMaxFeeRate))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	if m.UtxoDustRatioThreshold != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.UtxoDustRatioThreshold))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if m.UtxoConsolidationThreshold != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.UtxoConsolidationThreshold))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.WeightedVoting {
+		i--
+		if m.WeightedVoting {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if len(m.ConfirmationTiers) > 0 {
+		for iNdEx := len(m.ConfirmationTiers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConfirmationTiers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
 	if len(m.GatewayAddress) > 0 {
 		i -= len(m.GatewayAddress)
 		copy(dAtA[i:], m.GatewayAddress)
@@ -527,6 +758,27 @@ func (m *ChainParamsList) Size() (n int) {
 	return n
 }
 
+func (m *ConfirmationTier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CoinType != 0 {
+		n += 1 + sovParams(uint64(m.CoinType))
+	}
+	l = len(m.Asset)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = m.AmountThreshold.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.ConfirmationCount != 0 {
+		n += 1 + sovParams(uint64(m.ConfirmationCount))
+	}
+	return n
+}
+
 func (m *ChainParams) Size() (n int) {
 	if m == nil {
 		return 0
@@ -580,6 +832,24 @@ func (m *ChainParams) Size() (n int) {
 	if l > 0 {
 		n += 2 + l + sovParams(uint64(l))
 	}
+	if len(m.ConfirmationTiers) > 0 {
+		for _, e := range m.ConfirmationTiers {
+			l = e.Size()
+			n += 2 + l + sovParams(uint64(l))
+		}
+	}
+	if m.WeightedVoting {
+		n += 3
+	}
+	if m.UtxoConsolidationThreshold != 0 {
+		n += 2 + sovParams(uint64(m.UtxoConsolidationThreshold))
+	}
+	if m.UtxoDustRatioThreshold != 0 {
+		n += 2 + sovParams(uint64(m.UtxoDustRatioThreshold))
+	}
+	if m.UtxoConsolidationMaxFeeRate != 0 {
+		n += 2 + sovParams(uint64(m.UtxoConsolidationMaxFeeRate))
+	}
 	return n
 }
 
@@ -685,6 +955,160 @@ func (m *ChainParamsList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ConfirmationTier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConfirmationTier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConfirmationTier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoinType", wireType)
+			}
+			m.CoinType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CoinType |= coin.CoinType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Asset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AmountThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfirmationCount", wireType)
+			}
+			m.ConfirmationCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConfirmationCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ChainParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1082,6 +1506,117 @@ func (m *ChainParams) Unmarshal(dAtA []byte) error {
 			}
 			m.GatewayAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfirmationTiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConfirmationTiers = append(m.ConfirmationTiers, ConfirmationTier{})
+			if err := m.ConfirmationTiers[len(m.ConfirmationTiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WeightedVoting", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.WeightedVoting = bool(v != 0)
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UtxoConsolidationThreshold", wireType)
+			}
+			m.UtxoConsolidationThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UtxoConsolidationThreshold |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UtxoDustRatioThreshold", wireType)
+			}
+			m.UtxoDustRatioThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UtxoDustRatioThreshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UtxoConsolidationMaxFeeRate", wireType)
+			}
+			m.UtxoConsolidationMaxFeeRate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UtxoConsolidationMaxFeeRate |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
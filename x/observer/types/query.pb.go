@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -32,6 +33,317 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+type QueryLivenessParamsRequest struct {
+}
+
+func (m *QueryLivenessParamsRequest) Reset()         { *m = QueryLivenessParamsRequest{} }
+func (m *QueryLivenessParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLivenessParamsRequest) ProtoMessage()    {}
+func (*QueryLivenessParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25b2aa420449a0c0, []int{0}
+}
+func (m *QueryLivenessParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLivenessParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLivenessParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLivenessParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLivenessParamsRequest.Merge(m, src)
+}
+func (m *QueryLivenessParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLivenessParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLivenessParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLivenessParamsRequest proto.InternalMessageInfo
+
+type QueryLivenessParamsResponse struct {
+	LivenessParams LivenessParams `protobuf:"bytes,1,opt,name=liveness_params,json=livenessParams,proto3" json:"liveness_params"`
+}
+
+func (m *QueryLivenessParamsResponse) Reset()         { *m = QueryLivenessParamsResponse{} }
+func (m *QueryLivenessParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLivenessParamsResponse) ProtoMessage()    {}
+func (*QueryLivenessParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25b2aa420449a0c0, []int{1}
+}
+func (m *QueryLivenessParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLivenessParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLivenessParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLivenessParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLivenessParamsResponse.Merge(m, src)
+}
+func (m *QueryLivenessParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLivenessParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLivenessParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLivenessParamsResponse proto.InternalMessageInfo
+
+func (m *QueryLivenessParamsResponse) GetLivenessParams() LivenessParams {
+	if m != nil {
+		return m.LivenessParams
+	}
+	return LivenessParams{}
+}
+
+// ObserverLivenessScore is the liveness of an observer with its score, the
+// ratio of ballots voted in the window
+type ObserverLivenessScore struct {
+	Liveness ObserverLiveness                       `protobuf:"bytes,1,opt,name=liveness,proto3" json:"liveness"`
+	Score    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=score,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"score"`
+}
+
+func (m *ObserverLivenessScore) Reset()         { *m = ObserverLivenessScore{} }
+func (m *ObserverLivenessScore) String() string { return proto.CompactTextString(m) }
+func (*ObserverLivenessScore) ProtoMessage()    {}
+func (*ObserverLivenessScore) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25b2aa420449a0c0, []int{2}
+}
+func (m *ObserverLivenessScore) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ObserverLivenessScore) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ObserverLivenessScore.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ObserverLivenessScore) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObserverLivenessScore.Merge(m, src)
+}
+func (m *ObserverLivenessScore) XXX_Size() int {
+	return m.Size()
+}
+func (m *ObserverLivenessScore) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObserverLivenessScore.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObserverLivenessScore proto.InternalMessageInfo
+
+func (m *ObserverLivenessScore) GetLiveness() ObserverLiveness {
+	if m != nil {
+		return m.Liveness
+	}
+	return ObserverLiveness{}
+}
+
+type QueryObserverLivenessRequest struct {
+	ObserverAddress string `protobuf:"bytes,1,opt,name=observer_address,json=observerAddress,proto3" json:"observer_address,omitempty"`
+}
+
+func (m *QueryObserverLivenessRequest) Reset()         { *m = QueryObserverLivenessRequest{} }
+func (m *QueryObserverLivenessRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryObserverLivenessRequest) ProtoMessage()    {}
+func (*QueryObserverLivenessRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25b2aa420449a0c0, []int{3}
+}
+func (m *QueryObserverLivenessRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryObserverLivenessRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryObserverLivenessRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryObserverLivenessRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryObserverLivenessRequest.Merge(m, src)
+}
+func (m *QueryObserverLivenessRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryObserverLivenessRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryObserverLivenessRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryObserverLivenessRequest proto.InternalMessageInfo
+
+func (m *QueryObserverLivenessRequest) GetObserverAddress() string {
+	if m != nil {
+		return m.ObserverAddress
+	}
+	return ""
+}
+
+type QueryObserverLivenessResponse struct {
+	Liveness ObserverLivenessScore `protobuf:"bytes,1,opt,name=liveness,proto3" json:"liveness"`
+}
+
+func (m *QueryObserverLivenessResponse) Reset()         { *m = QueryObserverLivenessResponse{} }
+func (m *QueryObserverLivenessResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryObserverLivenessResponse) ProtoMessage()    {}
+func (*QueryObserverLivenessResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25b2aa420449a0c0, []int{4}
+}
+func (m *QueryObserverLivenessResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryObserverLivenessResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryObserverLivenessResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryObserverLivenessResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryObserverLivenessResponse.Merge(m, src)
+}
+func (m *QueryObserverLivenessResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryObserverLivenessResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryObserverLivenessResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryObserverLivenessResponse proto.InternalMessageInfo
+
+func (m *QueryObserverLivenessResponse) GetLiveness() ObserverLivenessScore {
+	if m != nil {
+		return m.Liveness
+	}
+	return ObserverLivenessScore{}
+}
+
+type QueryAllObserverLivenessRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllObserverLivenessRequest) Reset()         { *m = QueryAllObserverLivenessRequest{} }
+func (m *QueryAllObserverLivenessRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllObserverLivenessRequest) ProtoMessage()    {}
+func (*QueryAllObserverLivenessRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25b2aa420449a0c0, []int{5}
+}
+func (m *QueryAllObserverLivenessRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllObserverLivenessRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllObserverLivenessRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllObserverLivenessRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllObserverLivenessRequest.Merge(m, src)
+}
+func (m *QueryAllObserverLivenessRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllObserverLivenessRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllObserverLivenessRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllObserverLivenessRequest proto.InternalMessageInfo
+
+func (m *QueryAllObserverLivenessRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryAllObserverLivenessResponse struct {
+	Liveness   []ObserverLivenessScore `protobuf:"bytes,1,rep,name=liveness,proto3" json:"liveness"`
+	Pagination *query.PageResponse     `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllObserverLivenessResponse) Reset()         { *m = QueryAllObserverLivenessResponse{} }
+func (m *QueryAllObserverLivenessResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllObserverLivenessResponse) ProtoMessage()    {}
+func (*QueryAllObserverLivenessResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25b2aa420449a0c0, []int{6}
+}
+func (m *QueryAllObserverLivenessResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllObserverLivenessResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllObserverLivenessResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllObserverLivenessResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllObserverLivenessResponse.Merge(m, src)
+}
+func (m *QueryAllObserverLivenessResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllObserverLivenessResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllObserverLivenessResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllObserverLivenessResponse proto.InternalMessageInfo
+
+func (m *QueryAllObserverLivenessResponse) GetLiveness() []ObserverLivenessScore {
+	if m != nil {
+		return m.Liveness
+	}
+	return nil
+}
+
+func (m *QueryAllObserverLivenessResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 type QueryTssFundsMigratorInfoAllRequest struct {
 }
 
@@ -39,7 +351,7 @@ func (m *QueryTssFundsMigratorInfoAllRequest) Reset()         { *m = QueryTssFun
 func (m *QueryTssFundsMigratorInfoAllRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTssFundsMigratorInfoAllRequest) ProtoMessage()    {}
 func (*QueryTssFundsMigratorInfoAllRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{0}
+	return fileDescriptor_25b2aa420449a0c0, []int{7}
 }
 func (m *QueryTssFundsMigratorInfoAllRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -76,7 +388,7 @@ func (m *QueryTssFundsMigratorInfoAllResponse) Reset()         { *m = QueryTssFu
 func (m *QueryTssFundsMigratorInfoAllResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTssFundsMigratorInfoAllResponse) ProtoMessage()    {}
 func (*QueryTssFundsMigratorInfoAllResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{1}
+	return fileDescriptor_25b2aa420449a0c0, []int{8}
 }
 func (m *QueryTssFundsMigratorInfoAllResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -120,7 +432,7 @@ func (m *QueryTssFundsMigratorInfoRequest) Reset()         { *m = QueryTssFundsM
 func (m *QueryTssFundsMigratorInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTssFundsMigratorInfoRequest) ProtoMessage()    {}
 func (*QueryTssFundsMigratorInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{2}
+	return fileDescriptor_25b2aa420449a0c0, []int{9}
 }
 func (m *QueryTssFundsMigratorInfoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -164,7 +476,7 @@ func (m *QueryTssFundsMigratorInfoResponse) Reset()         { *m = QueryTssFunds
 func (m *QueryTssFundsMigratorInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTssFundsMigratorInfoResponse) ProtoMessage()    {}
 func (*QueryTssFundsMigratorInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{3}
+	return fileDescriptor_25b2aa420449a0c0, []int{10}
 }
 func (m *QueryTssFundsMigratorInfoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -208,7 +520,7 @@ func (m *QueryGetChainNoncesRequest) Reset()         { *m = QueryGetChainNoncesR
 func (m *QueryGetChainNoncesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetChainNoncesRequest) ProtoMessage()    {}
 func (*QueryGetChainNoncesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{4}
+	return fileDescriptor_25b2aa420449a0c0, []int{11}
 }
 func (m *QueryGetChainNoncesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -252,7 +564,7 @@ func (m *QueryGetChainNoncesResponse) Reset()         { *m = QueryGetChainNonces
 func (m *QueryGetChainNoncesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetChainNoncesResponse) ProtoMessage()    {}
 func (*QueryGetChainNoncesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{5}
+	return fileDescriptor_25b2aa420449a0c0, []int{12}
 }
 func (m *QueryGetChainNoncesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -296,7 +608,7 @@ func (m *QueryAllChainNoncesRequest) Reset()         { *m = QueryAllChainNoncesR
 func (m *QueryAllChainNoncesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAllChainNoncesRequest) ProtoMessage()    {}
 func (*QueryAllChainNoncesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{6}
+	return fileDescriptor_25b2aa420449a0c0, []int{13}
 }
 func (m *QueryAllChainNoncesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -341,7 +653,7 @@ func (m *QueryAllChainNoncesResponse) Reset()         { *m = QueryAllChainNonces
 func (m *QueryAllChainNoncesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAllChainNoncesResponse) ProtoMessage()    {}
 func (*QueryAllChainNoncesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{7}
+	return fileDescriptor_25b2aa420449a0c0, []int{14}
 }
 func (m *QueryAllChainNoncesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -392,7 +704,7 @@ func (m *QueryAllPendingNoncesRequest) Reset()         { *m = QueryAllPendingNon
 func (m *QueryAllPendingNoncesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAllPendingNoncesRequest) ProtoMessage()    {}
 func (*QueryAllPendingNoncesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{8}
+	return fileDescriptor_25b2aa420449a0c0, []int{15}
 }
 func (m *QueryAllPendingNoncesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -437,7 +749,7 @@ func (m *QueryAllPendingNoncesResponse) Reset()         { *m = QueryAllPendingNo
 func (m *QueryAllPendingNoncesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAllPendingNoncesResponse) ProtoMessage()    {}
 func (*QueryAllPendingNoncesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{9}
+	return fileDescriptor_25b2aa420449a0c0, []int{16}
 }
 func (m *QueryAllPendingNoncesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -488,7 +800,7 @@ func (m *QueryPendingNoncesByChainRequest) Reset()         { *m = QueryPendingNo
 func (m *QueryPendingNoncesByChainRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryPendingNoncesByChainRequest) ProtoMessage()    {}
 func (*QueryPendingNoncesByChainRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{10}
+	return fileDescriptor_25b2aa420449a0c0, []int{17}
 }
 func (m *QueryPendingNoncesByChainRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -532,7 +844,7 @@ func (m *QueryPendingNoncesByChainResponse) Reset()         { *m = QueryPendingN
 func (m *QueryPendingNoncesByChainResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryPendingNoncesByChainResponse) ProtoMessage()    {}
 func (*QueryPendingNoncesByChainResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{11}
+	return fileDescriptor_25b2aa420449a0c0, []int{18}
 }
 func (m *QueryPendingNoncesByChainResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -575,7 +887,7 @@ func (m *QueryGetTSSRequest) Reset()         { *m = QueryGetTSSRequest{} }
 func (m *QueryGetTSSRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetTSSRequest) ProtoMessage()    {}
 func (*QueryGetTSSRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{12}
+	return fileDescriptor_25b2aa420449a0c0, []int{19}
 }
 func (m *QueryGetTSSRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -612,7 +924,7 @@ func (m *QueryGetTSSResponse) Reset()         { *m = QueryGetTSSResponse{} }
 func (m *QueryGetTSSResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetTSSResponse) ProtoMessage()    {}
 func (*QueryGetTSSResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{13}
+	return fileDescriptor_25b2aa420449a0c0, []int{20}
 }
 func (m *QueryGetTSSResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -656,7 +968,7 @@ func (m *QueryGetTssAddressRequest) Reset()         { *m = QueryGetTssAddressReq
 func (m *QueryGetTssAddressRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetTssAddressRequest) ProtoMessage()    {}
 func (*QueryGetTssAddressRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{14}
+	return fileDescriptor_25b2aa420449a0c0, []int{21}
 }
 func (m *QueryGetTssAddressRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -693,15 +1005,16 @@ func (m *QueryGetTssAddressRequest) GetBitcoinChainId() int64 {
 }
 
 type QueryGetTssAddressResponse struct {
-	Eth string `protobuf:"bytes,1,opt,name=eth,proto3" json:"eth,omitempty"`
-	Btc string `protobuf:"bytes,2,opt,name=btc,proto3" json:"btc,omitempty"`
+	Eth        string `protobuf:"bytes,1,opt,name=eth,proto3" json:"eth,omitempty"`
+	Btc        string `protobuf:"bytes,2,opt,name=btc,proto3" json:"btc,omitempty"`
+	BtcTaproot string `protobuf:"bytes,3,opt,name=btc_taproot,json=btcTaproot,proto3" json:"btc_taproot,omitempty"`
 }
 
 func (m *QueryGetTssAddressResponse) Reset()         { *m = QueryGetTssAddressResponse{} }
 func (m *QueryGetTssAddressResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetTssAddressResponse) ProtoMessage()    {}
 func (*QueryGetTssAddressResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{15}
+	return fileDescriptor_25b2aa420449a0c0, []int{22}
 }
 func (m *QueryGetTssAddressResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -744,6 +1057,13 @@ func (m *QueryGetTssAddressResponse) GetBtc() string {
 	return ""
 }
 
+func (m *QueryGetTssAddressResponse) GetBtcTaproot() string {
+	if m != nil {
+		return m.BtcTaproot
+	}
+	return ""
+}
+
 type QueryGetTssAddressByFinalizedHeightRequest struct {
 	FinalizedZetaHeight int64 `protobuf:"varint,1,opt,name=finalized_zeta_height,json=finalizedZetaHeight,proto3" json:"finalized_zeta_height,omitempty"`
 	BitcoinChainId      int64 `protobuf:"varint,2,opt,name=bitcoin_chain_id,json=bitcoinChainId,proto3" json:"bitcoin_chain_id,omitempty"`
@@ -757,7 +1077,7 @@ func (m *QueryGetTssAddressByFinalizedHeightRequest) String() string {
 }
 func (*QueryGetTssAddressByFinalizedHeightRequest) ProtoMessage() {}
 func (*QueryGetTssAddressByFinalizedHeightRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{16}
+	return fileDescriptor_25b2aa420449a0c0, []int{23}
 }
 func (m *QueryGetTssAddressByFinalizedHeightRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -801,8 +1121,9 @@ func (m *QueryGetTssAddressByFinalizedHeightRequest) GetBitcoinChainId() int64 {
 }
 
 type QueryGetTssAddressByFinalizedHeightResponse struct {
-	Eth string `protobuf:"bytes,1,opt,name=eth,proto3" json:"eth,omitempty"`
-	Btc string `protobuf:"bytes,2,opt,name=btc,proto3" json:"btc,omitempty"`
+	Eth        string `protobuf:"bytes,1,opt,name=eth,proto3" json:"eth,omitempty"`
+	Btc        string `protobuf:"bytes,2,opt,name=btc,proto3" json:"btc,omitempty"`
+	BtcTaproot string `protobuf:"bytes,3,opt,name=btc_taproot,json=btcTaproot,proto3" json:"btc_taproot,omitempty"`
 }
 
 func (m *QueryGetTssAddressByFinalizedHeightResponse) Reset() {
@@ -813,7 +1134,7 @@ func (m *QueryGetTssAddressByFinalizedHeightResponse) String() string {
 }
 func (*QueryGetTssAddressByFinalizedHeightResponse) ProtoMessage() {}
 func (*QueryGetTssAddressByFinalizedHeightResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{17}
+	return fileDescriptor_25b2aa420449a0c0, []int{24}
 }
 func (m *QueryGetTssAddressByFinalizedHeightResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -856,6 +1177,13 @@ func (m *QueryGetTssAddressByFinalizedHeightResponse) GetBtc() string {
 	return ""
 }
 
+func (m *QueryGetTssAddressByFinalizedHeightResponse) GetBtcTaproot() string {
+	if m != nil {
+		return m.BtcTaproot
+	}
+	return ""
+}
+
 type QueryTssHistoryRequest struct {
 	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
@@ -864,7 +1192,7 @@ func (m *QueryTssHistoryRequest) Reset()         { *m = QueryTssHistoryRequest{}
 func (m *QueryTssHistoryRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTssHistoryRequest) ProtoMessage()    {}
 func (*QueryTssHistoryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{18}
+	return fileDescriptor_25b2aa420449a0c0, []int{25}
 }
 func (m *QueryTssHistoryRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -909,7 +1237,7 @@ func (m *QueryTssHistoryResponse) Reset()         { *m = QueryTssHistoryResponse
 func (m *QueryTssHistoryResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTssHistoryResponse) ProtoMessage()    {}
 func (*QueryTssHistoryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{19}
+	return fileDescriptor_25b2aa420449a0c0, []int{26}
 }
 func (m *QueryTssHistoryResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -961,7 +1289,7 @@ func (m *QueryHasVotedRequest) Reset()         { *m = QueryHasVotedRequest{} }
 func (m *QueryHasVotedRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryHasVotedRequest) ProtoMessage()    {}
 func (*QueryHasVotedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{20}
+	return fileDescriptor_25b2aa420449a0c0, []int{27}
 }
 func (m *QueryHasVotedRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1012,7 +1340,7 @@ func (m *QueryHasVotedResponse) Reset()         { *m = QueryHasVotedResponse{} }
 func (m *QueryHasVotedResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryHasVotedResponse) ProtoMessage()    {}
 func (*QueryHasVotedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{21}
+	return fileDescriptor_25b2aa420449a0c0, []int{28}
 }
 func (m *QueryHasVotedResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1056,7 +1384,7 @@ func (m *QueryBallotByIdentifierRequest) Reset()         { *m = QueryBallotByIde
 func (m *QueryBallotByIdentifierRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryBallotByIdentifierRequest) ProtoMessage()    {}
 func (*QueryBallotByIdentifierRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{22}
+	return fileDescriptor_25b2aa420449a0c0, []int{29}
 }
 func (m *QueryBallotByIdentifierRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1093,15 +1421,16 @@ func (m *QueryBallotByIdentifierRequest) GetBallotIdentifier() string {
 }
 
 type VoterList struct {
-	VoterAddress string   `protobuf:"bytes,1,opt,name=voter_address,json=voterAddress,proto3" json:"voter_address,omitempty"`
-	VoteType     VoteType `protobuf:"varint,2,opt,name=vote_type,json=voteType,proto3,enum=zetachain.zetacore.observer.VoteType" json:"vote_type,omitempty"`
+	VoterAddress string                                 `protobuf:"bytes,1,opt,name=voter_address,json=voterAddress,proto3" json:"voter_address,omitempty"`
+	VoteType     VoteType                               `protobuf:"varint,2,opt,name=vote_type,json=voteType,proto3,enum=zetachain.zetacore.observer.VoteType" json:"vote_type,omitempty"`
+	VotingPower  github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=voting_power,json=votingPower,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"voting_power"`
 }
 
 func (m *VoterList) Reset()         { *m = VoterList{} }
 func (m *VoterList) String() string { return proto.CompactTextString(m) }
 func (*VoterList) ProtoMessage()    {}
 func (*VoterList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{23}
+	return fileDescriptor_25b2aa420449a0c0, []int{30}
 }
 func (m *VoterList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1149,13 +1478,14 @@ type QueryBallotByIdentifierResponse struct {
 	Voters           []*VoterList    `protobuf:"bytes,2,rep,name=voters,proto3" json:"voters,omitempty"`
 	ObservationType  ObservationType `protobuf:"varint,3,opt,name=observation_type,json=observationType,proto3,enum=zetachain.zetacore.observer.ObservationType" json:"observation_type,omitempty"`
 	BallotStatus     BallotStatus    `protobuf:"varint,4,opt,name=ballot_status,json=ballotStatus,proto3,enum=zetachain.zetacore.observer.BallotStatus" json:"ballot_status,omitempty"`
+	Tally            BallotTally     `protobuf:"bytes,5,opt,name=tally,proto3" json:"tally"`
 }
 
 func (m *QueryBallotByIdentifierResponse) Reset()         { *m = QueryBallotByIdentifierResponse{} }
 func (m *QueryBallotByIdentifierResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryBallotByIdentifierResponse) ProtoMessage()    {}
 func (*QueryBallotByIdentifierResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{24}
+	return fileDescriptor_25b2aa420449a0c0, []int{31}
 }
 func (m *QueryBallotByIdentifierResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1212,6 +1542,13 @@ func (m *QueryBallotByIdentifierResponse) GetBallotStatus() BallotStatus {
 	return BallotStatus_BallotFinalized_SuccessObservation
 }
 
+func (m *QueryBallotByIdentifierResponse) GetTally() BallotTally {
+	if m != nil {
+		return m.Tally
+	}
+	return BallotTally{}
+}
+
 type QueryObserverSet struct {
 }
 
@@ -1219,7 +1556,7 @@ func (m *QueryObserverSet) Reset()         { *m = QueryObserverSet{} }
 func (m *QueryObserverSet) String() string { return proto.CompactTextString(m) }
 func (*QueryObserverSet) ProtoMessage()    {}
 func (*QueryObserverSet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{25}
+	return fileDescriptor_25b2aa420449a0c0, []int{32}
 }
 func (m *QueryObserverSet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1256,7 +1593,7 @@ func (m *QueryObserverSetResponse) Reset()         { *m = QueryObserverSetRespon
 func (m *QueryObserverSetResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryObserverSetResponse) ProtoMessage()    {}
 func (*QueryObserverSetResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{26}
+	return fileDescriptor_25b2aa420449a0c0, []int{33}
 }
 func (m *QueryObserverSetResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1299,7 +1636,7 @@ func (m *QuerySupportedChains) Reset()         { *m = QuerySupportedChains{} }
 func (m *QuerySupportedChains) String() string { return proto.CompactTextString(m) }
 func (*QuerySupportedChains) ProtoMessage()    {}
 func (*QuerySupportedChains) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{27}
+	return fileDescriptor_25b2aa420449a0c0, []int{34}
 }
 func (m *QuerySupportedChains) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1336,7 +1673,7 @@ func (m *QuerySupportedChainsResponse) Reset()         { *m = QuerySupportedChai
 func (m *QuerySupportedChainsResponse) String() string { return proto.CompactTextString(m) }
 func (*QuerySupportedChainsResponse) ProtoMessage()    {}
 func (*QuerySupportedChainsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{28}
+	return fileDescriptor_25b2aa420449a0c0, []int{35}
 }
 func (m *QuerySupportedChainsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1380,7 +1717,7 @@ func (m *QueryGetChainParamsForChainRequest) Reset()         { *m = QueryGetChai
 func (m *QueryGetChainParamsForChainRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetChainParamsForChainRequest) ProtoMessage()    {}
 func (*QueryGetChainParamsForChainRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{29}
+	return fileDescriptor_25b2aa420449a0c0, []int{36}
 }
 func (m *QueryGetChainParamsForChainRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1424,7 +1761,7 @@ func (m *QueryGetChainParamsForChainResponse) Reset()         { *m = QueryGetCha
 func (m *QueryGetChainParamsForChainResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetChainParamsForChainResponse) ProtoMessage()    {}
 func (*QueryGetChainParamsForChainResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{30}
+	return fileDescriptor_25b2aa420449a0c0, []int{37}
 }
 func (m *QueryGetChainParamsForChainResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1467,7 +1804,7 @@ func (m *QueryGetChainParamsRequest) Reset()         { *m = QueryGetChainParamsR
 func (m *QueryGetChainParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetChainParamsRequest) ProtoMessage()    {}
 func (*QueryGetChainParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{31}
+	return fileDescriptor_25b2aa420449a0c0, []int{38}
 }
 func (m *QueryGetChainParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1504,7 +1841,7 @@ func (m *QueryGetChainParamsResponse) Reset()         { *m = QueryGetChainParams
 func (m *QueryGetChainParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetChainParamsResponse) ProtoMessage()    {}
 func (*QueryGetChainParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{32}
+	return fileDescriptor_25b2aa420449a0c0, []int{39}
 }
 func (m *QueryGetChainParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1548,7 +1885,7 @@ func (m *QueryGetNodeAccountRequest) Reset()         { *m = QueryGetNodeAccountR
 func (m *QueryGetNodeAccountRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetNodeAccountRequest) ProtoMessage()    {}
 func (*QueryGetNodeAccountRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{33}
+	return fileDescriptor_25b2aa420449a0c0, []int{40}
 }
 func (m *QueryGetNodeAccountRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1592,7 +1929,7 @@ func (m *QueryGetNodeAccountResponse) Reset()         { *m = QueryGetNodeAccount
 func (m *QueryGetNodeAccountResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetNodeAccountResponse) ProtoMessage()    {}
 func (*QueryGetNodeAccountResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{34}
+	return fileDescriptor_25b2aa420449a0c0, []int{41}
 }
 func (m *QueryGetNodeAccountResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1636,7 +1973,7 @@ func (m *QueryAllNodeAccountRequest) Reset()         { *m = QueryAllNodeAccountR
 func (m *QueryAllNodeAccountRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAllNodeAccountRequest) ProtoMessage()    {}
 func (*QueryAllNodeAccountRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{35}
+	return fileDescriptor_25b2aa420449a0c0, []int{42}
 }
 func (m *QueryAllNodeAccountRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1681,7 +2018,7 @@ func (m *QueryAllNodeAccountResponse) Reset()         { *m = QueryAllNodeAccount
 func (m *QueryAllNodeAccountResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAllNodeAccountResponse) ProtoMessage()    {}
 func (*QueryAllNodeAccountResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{36}
+	return fileDescriptor_25b2aa420449a0c0, []int{43}
 }
 func (m *QueryAllNodeAccountResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1731,7 +2068,7 @@ func (m *QueryGetCrosschainFlagsRequest) Reset()         { *m = QueryGetCrosscha
 func (m *QueryGetCrosschainFlagsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetCrosschainFlagsRequest) ProtoMessage()    {}
 func (*QueryGetCrosschainFlagsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{37}
+	return fileDescriptor_25b2aa420449a0c0, []int{44}
 }
 func (m *QueryGetCrosschainFlagsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1768,7 +2105,7 @@ func (m *QueryGetCrosschainFlagsResponse) Reset()         { *m = QueryGetCrossch
 func (m *QueryGetCrosschainFlagsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetCrosschainFlagsResponse) ProtoMessage()    {}
 func (*QueryGetCrosschainFlagsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{38}
+	return fileDescriptor_25b2aa420449a0c0, []int{45}
 }
 func (m *QueryGetCrosschainFlagsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1804,6 +2141,94 @@ func (m *QueryGetCrosschainFlagsResponse) GetCrosschainFlags() CrosschainFlags {
 	return CrosschainFlags{}
 }
 
+type QueryPausedCCTXRequest struct {
+}
+
+func (m *QueryPausedCCTXRequest) Reset()         { *m = QueryPausedCCTXRequest{} }
+func (m *QueryPausedCCTXRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPausedCCTXRequest) ProtoMessage()    {}
+func (*QueryPausedCCTXRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25b2aa420449a0c0, []int{46}
+}
+func (m *QueryPausedCCTXRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPausedCCTXRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPausedCCTXRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPausedCCTXRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPausedCCTXRequest.Merge(m, src)
+}
+func (m *QueryPausedCCTXRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPausedCCTXRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPausedCCTXRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPausedCCTXRequest proto.InternalMessageInfo
+
+type QueryPausedCCTXResponse struct {
+	PausedChains      []ChainPauseFlags `protobuf:"bytes,1,rep,name=paused_chains,json=pausedChains,proto3" json:"paused_chains"`
+	PausedZrc20Tokens []ZRC20PauseFlags `protobuf:"bytes,2,rep,name=paused_zrc20_tokens,json=pausedZrc20Tokens,proto3" json:"paused_zrc20_tokens"`
+}
+
+func (m *QueryPausedCCTXResponse) Reset()         { *m = QueryPausedCCTXResponse{} }
+func (m *QueryPausedCCTXResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPausedCCTXResponse) ProtoMessage()    {}
+func (*QueryPausedCCTXResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25b2aa420449a0c0, []int{47}
+}
+func (m *QueryPausedCCTXResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPausedCCTXResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPausedCCTXResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPausedCCTXResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPausedCCTXResponse.Merge(m, src)
+}
+func (m *QueryPausedCCTXResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPausedCCTXResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPausedCCTXResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPausedCCTXResponse proto.InternalMessageInfo
+
+func (m *QueryPausedCCTXResponse) GetPausedChains() []ChainPauseFlags {
+	if m != nil {
+		return m.PausedChains
+	}
+	return nil
+}
+
+func (m *QueryPausedCCTXResponse) GetPausedZrc20Tokens() []ZRC20PauseFlags {
+	if m != nil {
+		return m.PausedZrc20Tokens
+	}
+	return nil
+}
+
 type QueryGetKeygenRequest struct {
 }
 
@@ -1811,7 +2236,7 @@ func (m *QueryGetKeygenRequest) Reset()         { *m = QueryGetKeygenRequest{} }
 func (m *QueryGetKeygenRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetKeygenRequest) ProtoMessage()    {}
 func (*QueryGetKeygenRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{39}
+	return fileDescriptor_25b2aa420449a0c0, []int{48}
 }
 func (m *QueryGetKeygenRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1848,7 +2273,7 @@ func (m *QueryGetKeygenResponse) Reset()         { *m = QueryGetKeygenResponse{}
 func (m *QueryGetKeygenResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetKeygenResponse) ProtoMessage()    {}
 func (*QueryGetKeygenResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{40}
+	return fileDescriptor_25b2aa420449a0c0, []int{49}
 }
 func (m *QueryGetKeygenResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1891,7 +2316,7 @@ func (m *QueryShowObserverCountRequest) Reset()         { *m = QueryShowObserver
 func (m *QueryShowObserverCountRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryShowObserverCountRequest) ProtoMessage()    {}
 func (*QueryShowObserverCountRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{41}
+	return fileDescriptor_25b2aa420449a0c0, []int{50}
 }
 func (m *QueryShowObserverCountRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1928,7 +2353,7 @@ func (m *QueryShowObserverCountResponse) Reset()         { *m = QueryShowObserve
 func (m *QueryShowObserverCountResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryShowObserverCountResponse) ProtoMessage()    {}
 func (*QueryShowObserverCountResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{42}
+	return fileDescriptor_25b2aa420449a0c0, []int{51}
 }
 func (m *QueryShowObserverCountResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1972,7 +2397,7 @@ func (m *QueryBlameByIdentifierRequest) Reset()         { *m = QueryBlameByIdent
 func (m *QueryBlameByIdentifierRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryBlameByIdentifierRequest) ProtoMessage()    {}
 func (*QueryBlameByIdentifierRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{43}
+	return fileDescriptor_25b2aa420449a0c0, []int{52}
 }
 func (m *QueryBlameByIdentifierRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2016,7 +2441,7 @@ func (m *QueryBlameByIdentifierResponse) Reset()         { *m = QueryBlameByIden
 func (m *QueryBlameByIdentifierResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryBlameByIdentifierResponse) ProtoMessage()    {}
 func (*QueryBlameByIdentifierResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{44}
+	return fileDescriptor_25b2aa420449a0c0, []int{53}
 }
 func (m *QueryBlameByIdentifierResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2060,7 +2485,7 @@ func (m *QueryAllBlameRecordsRequest) Reset()         { *m = QueryAllBlameRecord
 func (m *QueryAllBlameRecordsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAllBlameRecordsRequest) ProtoMessage()    {}
 func (*QueryAllBlameRecordsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{45}
+	return fileDescriptor_25b2aa420449a0c0, []int{54}
 }
 func (m *QueryAllBlameRecordsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2105,7 +2530,7 @@ func (m *QueryAllBlameRecordsResponse) Reset()         { *m = QueryAllBlameRecor
 func (m *QueryAllBlameRecordsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAllBlameRecordsResponse) ProtoMessage()    {}
 func (*QueryAllBlameRecordsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{46}
+	return fileDescriptor_25b2aa420449a0c0, []int{55}
 }
 func (m *QueryAllBlameRecordsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2157,7 +2582,7 @@ func (m *QueryBlameByChainAndNonceRequest) Reset()         { *m = QueryBlameByCh
 func (m *QueryBlameByChainAndNonceRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryBlameByChainAndNonceRequest) ProtoMessage()    {}
 func (*QueryBlameByChainAndNonceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{47}
+	return fileDescriptor_25b2aa420449a0c0, []int{56}
 }
 func (m *QueryBlameByChainAndNonceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2208,7 +2633,7 @@ func (m *QueryBlameByChainAndNonceResponse) Reset()         { *m = QueryBlameByC
 func (m *QueryBlameByChainAndNonceResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryBlameByChainAndNonceResponse) ProtoMessage()    {}
 func (*QueryBlameByChainAndNonceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{48}
+	return fileDescriptor_25b2aa420449a0c0, []int{57}
 }
 func (m *QueryBlameByChainAndNonceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2245,6 +2670,13 @@ func (m *QueryBlameByChainAndNonceResponse) GetBlameInfo() []*Blame {
 }
 
 func init() {
+	proto.RegisterType((*QueryLivenessParamsRequest)(nil), "zetachain.zetacore.observer.QueryLivenessParamsRequest")
+	proto.RegisterType((*QueryLivenessParamsResponse)(nil), "zetachain.zetacore.observer.QueryLivenessParamsResponse")
+	proto.RegisterType((*ObserverLivenessScore)(nil), "zetachain.zetacore.observer.ObserverLivenessScore")
+	proto.RegisterType((*QueryObserverLivenessRequest)(nil), "zetachain.zetacore.observer.QueryObserverLivenessRequest")
+	proto.RegisterType((*QueryObserverLivenessResponse)(nil), "zetachain.zetacore.observer.QueryObserverLivenessResponse")
+	proto.RegisterType((*QueryAllObserverLivenessRequest)(nil), "zetachain.zetacore.observer.QueryAllObserverLivenessRequest")
+	proto.RegisterType((*QueryAllObserverLivenessResponse)(nil), "zetachain.zetacore.observer.QueryAllObserverLivenessResponse")
 	proto.RegisterType((*QueryTssFundsMigratorInfoAllRequest)(nil), "zetachain.zetacore.observer.QueryTssFundsMigratorInfoAllRequest")
 	proto.RegisterType((*QueryTssFundsMigratorInfoAllResponse)(nil), "zetachain.zetacore.observer.QueryTssFundsMigratorInfoAllResponse")
 	proto.RegisterType((*QueryTssFundsMigratorInfoRequest)(nil), "zetachain.zetacore.observer.QueryTssFundsMigratorInfoRequest")
@@ -2284,6 +2716,8 @@ func init() {
 	proto.RegisterType((*QueryAllNodeAccountResponse)(nil), "zetachain.zetacore.observer.QueryAllNodeAccountResponse")
 	proto.RegisterType((*QueryGetCrosschainFlagsRequest)(nil), "zetachain.zetacore.observer.QueryGetCrosschainFlagsRequest")
 	proto.RegisterType((*QueryGetCrosschainFlagsResponse)(nil), "zetachain.zetacore.observer.QueryGetCrosschainFlagsResponse")
+	proto.RegisterType((*QueryPausedCCTXRequest)(nil), "zetachain.zetacore.observer.QueryPausedCCTXRequest")
+	proto.RegisterType((*QueryPausedCCTXResponse)(nil), "zetachain.zetacore.observer.QueryPausedCCTXResponse")
 	proto.RegisterType((*QueryGetKeygenRequest)(nil), "zetachain.zetacore.observer.QueryGetKeygenRequest")
 	proto.RegisterType((*QueryGetKeygenResponse)(nil), "zetachain.zetacore.observer.QueryGetKeygenResponse")
 	proto.RegisterType((*QueryShowObserverCountRequest)(nil), "zetachain.zetacore.observer.QueryShowObserverCountRequest")
@@ -2301,151 +2735,177 @@ func init() {
 }
 
 var fileDescriptor_25b2aa420449a0c0 = []byte{
-	// 2300 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x5a, 0xcf, 0x6f, 0x1b, 0xc7,
-	0x15, 0xf6, 0x4a, 0x89, 0x22, 0x8d, 0x6c, 0xfd, 0x18, 0xcb, 0xb6, 0x42, 0x3b, 0xb2, 0xbc, 0x92,
-	0x63, 0x59, 0x96, 0xb8, 0xb6, 0xec, 0xd4, 0xbf, 0xe2, 0xd8, 0xa2, 0x6b, 0x49, 0x76, 0x12, 0xdb,
-	0x21, 0xdd, 0x06, 0x30, 0xda, 0xb2, 0x4b, 0xee, 0x90, 0xdc, 0x7a, 0xb5, 0xc3, 0xec, 0x8c, 0x9c,
-	0x30, 0xaa, 0x80, 0xa2, 0xb7, 0xe6, 0x50, 0x14, 0x28, 0xd0, 0xde, 0x8a, 0x02, 0x45, 0x8f, 0x05,
-	0x8a, 0x00, 0x41, 0x0b, 0x14, 0x3d, 0xe4, 0xd4, 0x1c, 0x7a, 0x48, 0xd1, 0xa2, 0xe8, 0xa9, 0x35,
-	0xec, 0xfe, 0x21, 0xc5, 0xce, 0xbc, 0x25, 0x77, 0x97, 0xbb, 0xcb, 0x21, 0xad, 0x9e, 0xc8, 0x9d,
-	0x99, 0xf7, 0xe6, 0xfb, 0xde, 0xce, 0xbc, 0xf9, 0x66, 0x66, 0xd1, 0x99, 0x4f, 0x09, 0x37, 0xab,
-	0x0d, 0xd3, 0x76, 0x0d, 0xf1, 0x8f, 0x7a, 0xc4, 0xa0, 0x15, 0x46, 0xbc, 0xa7, 0xc4, 0x33, 0x3e,
-	0xda, 0x21, 0x5e, 0x2b, 0xdf, 0xf4, 0x28, 0xa7, 0xf8, 0x78, 0xbb, 0x61, 0x3e, 0x68, 0x98, 0x0f,
-	0x1a, 0xe6, 0x96, 0xab, 0x94, 0x6d, 0x53, 0x66, 0x54, 0x4c, 0x46, 0xa4, 0x95, 0xf1, 0xf4, 0x42,
-	0x85, 0x70, 0xf3, 0x82, 0xd1, 0x34, 0xeb, 0xb6, 0x6b, 0x72, 0x9b, 0xba, 0xd2, 0x51, 0x6e, 0xa6,
-	0x4e, 0xeb, 0x54, 0xfc, 0x35, 0xfc, 0x7f, 0x50, 0x7a, 0xa2, 0x4e, 0x69, 0xdd, 0x21, 0x86, 0xd9,
-	0xb4, 0x0d, 0xd3, 0x75, 0x29, 0x17, 0x26, 0x0c, 0x6a, 0x97, 0xb2, 0x50, 0x56, 0x4c, 0xc7, 0xa1,
-	0x1c, 0x5a, 0x66, 0xf2, 0xa9, 0x38, 0xe6, 0x36, 0x81, 0x86, 0xf9, 0xac, 0x86, 0xa2, 0xbc, 0xec,
-	0x52, 0xb7, 0x4a, 0x02, 0x08, 0x6b, 0x99, 0xed, 0x3d, 0xca, 0x98, 0x34, 0xaa, 0x39, 0x66, 0x5d,
-	0x09, 0xf6, 0x13, 0xd2, 0xaa, 0x13, 0x57, 0x05, 0x8d, 0x4b, 0x2d, 0x52, 0x36, 0xab, 0x55, 0xba,
-	0xe3, 0x06, 0x34, 0x97, 0xb3, 0xda, 0x07, 0x7f, 0x54, 0x50, 0x34, 0x4d, 0xcf, 0xdc, 0x0e, 0xf0,
-	0x9e, 0xcf, 0x6c, 0x49, 0x5c, 0xcb, 0x76, 0xeb, 0xd1, 0xa8, 0x9c, 0xce, 0xb2, 0xe0, 0x8c, 0x65,
-	0xc0, 0x6d, 0x3e, 0xa9, 0xcb, 0x38, 0x33, 0xf8, 0xe9, 0xd1, 0xb6, 0xe9, 0x51, 0x5a, 0x63, 0xf0,
-	0x03, 0x6d, 0x2f, 0xf5, 0xe8, 0xbe, 0x5c, 0xdb, 0x71, 0x2d, 0x56, 0xde, 0xb6, 0xeb, 0x9e, 0xc9,
-	0x29, 0x04, 0x44, 0x3f, 0x8d, 0x16, 0x3e, 0xf0, 0xc7, 0xe8, 0x23, 0xc6, 0x36, 0xfc, 0xfa, 0xf7,
-	0xa1, 0xfa, 0xae, 0x5b, 0xa3, 0xeb, 0x8e, 0x53, 0x24, 0x1f, 0xed, 0x10, 0xc6, 0xf5, 0x9f, 0x6a,
-	0x68, 0x31, 0xbb, 0x1d, 0x6b, 0x52, 0x97, 0x11, 0x5c, 0x43, 0x87, 0xbb, 0xfb, 0x62, 0xb3, 0xda,
-	0xfc, 0xf0, 0xd2, 0xf8, 0xda, 0xf9, 0x7c, 0xc6, 0xc4, 0xc9, 0x83, 0xeb, 0xb0, 0xe7, 0xc2, 0x2b,
-	0x5f, 0xfd, 0xfb, 0xe4, 0x81, 0xe2, 0x34, 0x8f, 0xf5, 0xca, 0xf4, 0x1b, 0x68, 0x3e, 0x15, 0x0f,
-	0x80, 0xc6, 0xaf, 0xa3, 0x51, 0x39, 0x0e, 0x6d, 0x6b, 0x56, 0x9b, 0xd7, 0x96, 0x86, 0x8b, 0xaf,
-	0x89, 0xe7, 0xbb, 0x96, 0xfe, 0x13, 0x0d, 0x9d, 0xca, 0xb0, 0x07, 0x32, 0x16, 0xc2, 0xdd, 0x64,
-	0x84, 0xab, 0xc1, 0xb9, 0x4c, 0xc5, 0xb9, 0xe8, 0x97, 0x51, 0x4e, 0x40, 0xd9, 0x24, 0xfc, 0xb6,
-	0xef, 0xee, 0xbe, 0x18, 0x54, 0x0a, 0x24, 0x28, 0x3a, 0x9e, 0x68, 0x08, 0xe8, 0x1f, 0xa2, 0xf1,
-	0x50, 0x31, 0xc0, 0x5e, 0xca, 0x84, 0x1d, 0x6a, 0x0f, 0x70, 0xc3, 0x2e, 0x74, 0x0b, 0x90, 0xae,
-	0x3b, 0x4e, 0x02, 0xd2, 0x0d, 0x84, 0x3a, 0x09, 0x0e, 0xba, 0x7b, 0x33, 0x2f, 0xb3, 0x61, 0xde,
-	0xcf, 0x86, 0x79, 0x99, 0x43, 0x21, 0x1b, 0xe6, 0x1f, 0x9a, 0x75, 0x02, 0xb6, 0xc5, 0x90, 0xa5,
-	0xfe, 0x47, 0x0d, 0x78, 0xc5, 0xbb, 0x49, 0xe3, 0x35, 0xfc, 0x92, 0xbc, 0xf0, 0x66, 0x04, 0xf9,
-	0x90, 0x40, 0x7e, 0xa6, 0x27, 0x72, 0x09, 0x27, 0x02, 0xbd, 0x86, 0x4e, 0x04, 0xc8, 0x1f, 0xca,
-	0x14, 0xf1, 0xff, 0x09, 0xd1, 0x97, 0x1a, 0x7a, 0x23, 0xa5, 0x23, 0x08, 0xd2, 0x87, 0x68, 0x22,
-	0x9a, 0xa4, 0x20, 0x4e, 0xcb, 0x99, 0x71, 0x8a, 0xf8, 0x82, 0x48, 0x1d, 0x6a, 0x86, 0x0b, 0xf7,
-	0x2f, 0x56, 0xc1, 0x0c, 0x8e, 0xf6, 0xd9, 0x12, 0xef, 0x45, 0x61, 0xf0, 0xff, 0x10, 0x26, 0x70,
-	0xb2, 0x79, 0x46, 0x14, 0xb4, 0x7d, 0x88, 0x82, 0x3e, 0x83, 0x70, 0x30, 0xf5, 0x1e, 0x95, 0x4a,
-	0x41, 0x96, 0x7c, 0x80, 0x0e, 0x47, 0x4a, 0x01, 0xc5, 0x15, 0x34, 0xfc, 0xa8, 0x54, 0x82, 0xae,
-	0xe7, 0xb3, 0xf3, 0x46, 0xa9, 0x04, 0x1d, 0xfa, 0x26, 0xfa, 0x1d, 0xf4, 0x7a, 0xdb, 0x21, 0x63,
-	0xeb, 0x96, 0xe5, 0x11, 0xd6, 0x1e, 0x4c, 0x4b, 0x68, 0xaa, 0x62, 0xf3, 0x2a, 0xb5, 0xdd, 0x72,
-	0x3b, 0x48, 0x43, 0x22, 0x48, 0x13, 0x50, 0x7e, 0x1b, 0x62, 0x75, 0xab, 0x93, 0x61, 0xc2, 0x6e,
-	0x00, 0xde, 0x14, 0x1a, 0x26, 0xbc, 0x21, 0xe0, 0x8d, 0x15, 0xfd, 0xbf, 0x7e, 0x49, 0x85, 0x57,
-	0x85, 0xb3, 0xb1, 0xa2, 0xff, 0x57, 0xff, 0x4c, 0x43, 0xcb, 0xdd, 0x2e, 0x0a, 0xad, 0x0d, 0xdb,
-	0x35, 0x1d, 0xfb, 0x53, 0x62, 0x6d, 0x11, 0xbb, 0xde, 0xe0, 0x01, 0xb4, 0x35, 0x74, 0xa4, 0x16,
-	0xd4, 0x94, 0x7d, 0x96, 0xe5, 0x86, 0xa8, 0x87, 0x97, 0x78, 0xb8, 0x5d, 0xf9, 0x98, 0x70, 0x53,
-	0x9a, 0xf6, 0x41, 0xe7, 0x03, 0x74, 0x4e, 0x09, 0x4b, 0x1f, 0xfc, 0xbe, 0x8f, 0x8e, 0x06, 0xcb,
-	0xc1, 0x96, 0xcd, 0x38, 0xf5, 0x5a, 0xfb, 0x3d, 0x65, 0x7f, 0xab, 0xa1, 0x63, 0x5d, 0x5d, 0x00,
-	0xc2, 0x75, 0x34, 0xea, 0xaf, 0x33, 0x8e, 0xcd, 0x38, 0x4c, 0x53, 0xd5, 0x51, 0xf2, 0x1a, 0x67,
-	0xec, 0x3d, 0x9b, 0xf1, 0xfd, 0x9b, 0x96, 0x0d, 0x34, 0x23, 0x60, 0x6e, 0x99, 0xec, 0xdb, 0x94,
-	0x13, 0x2b, 0x88, 0xc3, 0x39, 0x34, 0x2d, 0xc5, 0x65, 0xd9, 0xb6, 0x88, 0xcb, 0xed, 0x9a, 0x4d,
-	0x3c, 0x88, 0xe9, 0x94, 0xac, 0xb8, 0xdb, 0x2e, 0xc7, 0x0b, 0xe8, 0xd0, 0x53, 0xca, 0x89, 0x57,
-	0x36, 0xe5, 0xcb, 0x81, 0x50, 0x1f, 0x14, 0x85, 0xf0, 0xc2, 0xf4, 0x4b, 0xe8, 0x48, 0xac, 0x27,
-	0x08, 0xc7, 0x71, 0x34, 0xd6, 0x30, 0x59, 0xd9, 0x6f, 0x2c, 0xa7, 0xfd, 0x68, 0x71, 0xb4, 0x01,
-	0x8d, 0xf4, 0xf7, 0xd1, 0x9c, 0xb0, 0x2a, 0x88, 0x3e, 0x0b, 0xad, 0x4e, 0xaf, 0x83, 0x20, 0xd5,
-	0x39, 0x1a, 0xf3, 0xfd, 0x7a, 0x22, 0x88, 0x5d, 0xb0, 0xb5, 0x6e, 0xd8, 0xb8, 0x80, 0xc6, 0xfc,
-	0xe7, 0x32, 0x6f, 0x35, 0x89, 0xe0, 0x35, 0xb1, 0x76, 0x3a, 0xf3, 0x6d, 0xf9, 0xfe, 0x1f, 0xb5,
-	0x9a, 0xa4, 0x38, 0xfa, 0x14, 0xfe, 0xe9, 0x7f, 0x18, 0x42, 0x27, 0x53, 0x59, 0x40, 0x14, 0xfa,
-	0x0a, 0xf8, 0x3b, 0x68, 0x44, 0x80, 0xf4, 0x23, 0x3d, 0x2c, 0x46, 0x68, 0x2f, 0x44, 0x82, 0x71,
-	0x11, 0xac, 0xf0, 0x87, 0x68, 0x4a, 0xd6, 0x8a, 0x41, 0x20, 0xb9, 0x0d, 0x0b, 0x6e, 0x2b, 0x99,
-	0x9e, 0x1e, 0x74, 0x8c, 0x04, 0xc5, 0x49, 0x1a, 0x2d, 0xc0, 0xf7, 0xd1, 0x21, 0x60, 0xc1, 0xb8,
-	0xc9, 0x77, 0xd8, 0xec, 0x2b, 0xc2, 0xeb, 0xd9, 0x4c, 0xaf, 0x32, 0x2a, 0x25, 0x61, 0x50, 0x3c,
-	0x58, 0x09, 0x3d, 0xe9, 0x18, 0x4d, 0x89, 0xc0, 0x3d, 0x80, 0xb6, 0x25, 0xc2, 0xf5, 0x2b, 0x68,
-	0x36, 0x5e, 0xd6, 0x8e, 0xe2, 0x09, 0x34, 0x16, 0xb8, 0x95, 0x4b, 0xe0, 0x58, 0xb1, 0x53, 0xa0,
-	0x1f, 0x85, 0xc1, 0x5e, 0xda, 0x69, 0x36, 0xa9, 0xc7, 0x89, 0x25, 0x52, 0x0c, 0xd3, 0x2b, 0xb0,
-	0x8e, 0xc7, 0xca, 0xdb, 0x5e, 0x0b, 0x68, 0x44, 0xea, 0x74, 0x98, 0xae, 0x8b, 0x49, 0x74, 0x9a,
-	0x4f, 0xea, 0x79, 0x50, 0xf3, 0xc2, 0x1c, 0xa6, 0x2c, 0x58, 0xea, 0x37, 0x91, 0x1e, 0x51, 0x6f,
-	0x0f, 0xc5, 0xee, 0x63, 0x83, 0x7a, 0xaa, 0x2b, 0xa0, 0x07, 0xd2, 0x3d, 0xcd, 0x01, 0x60, 0x7d,
-	0x17, 0x1d, 0x94, 0x1e, 0xe4, 0xf6, 0x46, 0x5d, 0x07, 0x4a, 0x7f, 0xc5, 0xf1, 0x6a, 0xe7, 0x41,
-	0x3f, 0x11, 0xd3, 0xaa, 0xd0, 0x06, 0xd6, 0x3f, 0x37, 0x26, 0x48, 0x83, 0x5a, 0x40, 0xf2, 0x20,
-	0x11, 0xc9, 0x8a, 0x2a, 0x12, 0x31, 0x60, 0x23, 0x68, 0xd6, 0x3a, 0x68, 0xee, 0x53, 0x8b, 0xac,
-	0xcb, 0x6d, 0x61, 0x10, 0xba, 0x19, 0xf4, 0xaa, 0xed, 0x5a, 0xe4, 0x13, 0x98, 0x34, 0xf2, 0x41,
-	0xff, 0x41, 0x07, 0x63, 0xc4, 0xa6, 0x13, 0xad, 0xf0, 0x16, 0x53, 0x29, 0x5a, 0x61, 0x3f, 0xe3,
-	0x6e, 0xe7, 0x21, 0xac, 0x97, 0x13, 0xf0, 0xed, 0xd7, 0xca, 0xf2, 0x79, 0x48, 0x2f, 0x27, 0x51,
-	0xba, 0x87, 0xc6, 0x43, 0xc5, 0x4a, 0x7a, 0x39, 0xc2, 0x28, 0xf4, 0xb0, 0x7f, 0xcb, 0xcc, 0x3c,
-	0xa4, 0x71, 0x7f, 0xa8, 0xb4, 0x0f, 0x0c, 0x36, 0x1c, 0xb3, 0xde, 0x1e, 0x4c, 0x3f, 0xd2, 0x20,
-	0x47, 0x26, 0x35, 0x01, 0x6a, 0xdf, 0x45, 0x53, 0xf1, 0xe3, 0x06, 0xb5, 0x51, 0x15, 0xf5, 0x07,
-	0x33, 0x73, 0xb2, 0x1a, 0x2d, 0xd6, 0x8f, 0xc1, 0x0a, 0xb5, 0x49, 0xf8, 0xbb, 0xe2, 0x84, 0x22,
-	0xc0, 0xf6, 0x2d, 0x90, 0x0b, 0xa1, 0x0a, 0x40, 0x74, 0x1d, 0x8d, 0xc8, 0xc3, 0x0c, 0xc0, 0xb1,
-	0x90, 0x89, 0x03, 0x8c, 0xc1, 0x44, 0x3f, 0x09, 0xaa, 0xbe, 0xd4, 0xa0, 0x1f, 0x07, 0xc9, 0xec,
-	0x76, 0x68, 0xc8, 0xf8, 0x31, 0x99, 0x4b, 0x6b, 0x01, 0x00, 0xbe, 0x87, 0x0e, 0x3b, 0x26, 0xe3,
-	0xe5, 0xa0, 0x8f, 0x72, 0x78, 0x1c, 0xe7, 0x33, 0xd1, 0xbc, 0x67, 0x32, 0x1e, 0x75, 0x3a, 0xed,
-	0xc4, 0x8b, 0xf4, 0x7b, 0x80, 0xb1, 0xe0, 0x98, 0xdb, 0x24, 0x69, 0xf9, 0x3d, 0x8b, 0xa6, 0xc4,
-	0xd9, 0x52, 0xf7, 0xb2, 0x35, 0x29, 0xca, 0x43, 0x8b, 0x6f, 0x35, 0x58, 0xcb, 0xbb, 0x7d, 0xb5,
-	0x95, 0x11, 0x02, 0x67, 0x6e, 0x8d, 0x02, 0x09, 0x3d, 0x7b, 0xed, 0xf0, 0x9b, 0x17, 0xc7, 0x64,
-	0x57, 0x6e, 0x8d, 0xea, 0xa4, 0x33, 0x3b, 0x64, 0x1d, 0xa9, 0x52, 0xcf, 0xda, 0xf7, 0x2d, 0xd9,
-	0xef, 0xb5, 0xce, 0xde, 0x2f, 0xda, 0x0f, 0x50, 0xd9, 0x8c, 0x51, 0x19, 0x56, 0xa3, 0x02, 0x63,
-	0xb3, 0x43, 0x68, 0xff, 0xe6, 0x60, 0x09, 0x76, 0x60, 0x10, 0x7e, 0x91, 0x6a, 0xd7, 0x5d, 0x4b,
-	0x6c, 0x71, 0x7a, 0xaf, 0x3f, 0x7e, 0x7e, 0x15, 0x9b, 0x2a, 0x50, 0xe9, 0xf2, 0x41, 0xaf, 0xc1,
-	0xbe, 0x2c, 0xd9, 0x69, 0xca, 0x6b, 0x1d, 0xee, 0xfb, 0xb5, 0xae, 0xfd, 0x66, 0x11, 0xbd, 0x2a,
-	0x3a, 0xc2, 0x7f, 0xd6, 0xd0, 0x68, 0xa0, 0x21, 0xf1, 0x85, 0x4c, 0x2f, 0x49, 0xca, 0x36, 0xb7,
-	0xd6, 0x8f, 0x89, 0x24, 0xa0, 0xdf, 0xfb, 0xf1, 0xdf, 0xff, 0xfb, 0xf3, 0xa1, 0x6f, 0xe2, 0x82,
-	0x38, 0x6b, 0x5b, 0x95, 0xc7, 0x6e, 0xed, 0xd3, 0xb6, 0xb6, 0x7a, 0x35, 0x76, 0xbb, 0x24, 0xdc,
-	0x9e, 0xb1, 0x1b, 0xd1, 0x98, 0x7b, 0xf8, 0x9f, 0x1a, 0xc2, 0xdd, 0x3a, 0x10, 0x5f, 0xef, 0x0d,
-	0x2b, 0x55, 0x03, 0xe7, 0xde, 0x1e, 0xcc, 0x18, 0xd8, 0xdd, 0x11, 0xec, 0x6e, 0xe2, 0x1b, 0x89,
-	0xec, 0x80, 0x52, 0xa5, 0x15, 0x62, 0x95, 0x44, 0x14, 0xff, 0x4a, 0x43, 0xe3, 0x21, 0x4d, 0x86,
-	0x57, 0x7b, 0x83, 0x0a, 0x35, 0xcf, 0xbd, 0xd5, 0x57, 0xf3, 0x36, 0xf8, 0xb3, 0x02, 0xfc, 0x02,
-	0x3e, 0x95, 0x08, 0xbe, 0x9d, 0x16, 0x19, 0xe1, 0xf8, 0x77, 0x1a, 0x9a, 0x8c, 0x49, 0x3c, 0x95,
-	0x01, 0x14, 0x33, 0xc9, 0x5d, 0xed, 0xdb, 0xa4, 0x0d, 0x76, 0x45, 0x80, 0x7d, 0x13, 0x2f, 0x26,
-	0x82, 0x65, 0x31, 0x6c, 0xff, 0xd1, 0xd0, 0xd1, 0x64, 0xb5, 0x87, 0x6f, 0xf6, 0xc6, 0x90, 0x29,
-	0x34, 0x73, 0xb7, 0x06, 0x77, 0x00, 0x5c, 0x0a, 0x82, 0xcb, 0xdb, 0xf8, 0x5a, 0x22, 0x97, 0x3a,
-	0xe1, 0xe5, 0xb0, 0xfa, 0x2b, 0xd7, 0xa8, 0x27, 0x0b, 0x8c, 0xdd, 0x20, 0xc3, 0xec, 0xe1, 0xcf,
-	0x35, 0x34, 0x11, 0xed, 0x06, 0x5f, 0xee, 0x17, 0x58, 0xc0, 0xe8, 0x4a, 0xff, 0x86, 0xc0, 0x64,
-	0x55, 0x30, 0x39, 0x83, 0x4f, 0x2b, 0x31, 0xf1, 0x41, 0x47, 0x44, 0x92, 0x1a, 0xe2, 0x6e, 0x45,
-	0xa8, 0x88, 0x38, 0x41, 0xe3, 0xe9, 0xe7, 0x05, 0xe2, 0x65, 0xbc, 0x94, 0x88, 0x38, 0xa4, 0x49,
-	0x8d, 0x5d, 0x21, 0x83, 0xf7, 0xfc, 0xb1, 0x3f, 0x11, 0xf2, 0xb4, 0xee, 0x38, 0x2a, 0xb8, 0x13,
-	0x95, 0xac, 0x0a, 0xee, 0x64, 0x6d, 0xaa, 0x2f, 0x09, 0xdc, 0x3a, 0x9e, 0xef, 0x85, 0x1b, 0xff,
-	0x49, 0x43, 0x93, 0x31, 0xd9, 0xa6, 0x92, 0x22, 0x53, 0xf5, 0xa5, 0x4a, 0x8a, 0x4c, 0x57, 0x9e,
-	0x3d, 0x86, 0x48, 0x5c, 0x94, 0xe2, 0x5f, 0x68, 0x68, 0x44, 0x8a, 0x3d, 0xbc, 0xa6, 0xd4, 0x6f,
-	0x44, 0x6f, 0xe6, 0x2e, 0xf6, 0x65, 0x03, 0x10, 0x17, 0x04, 0xc4, 0x37, 0xf0, 0xf1, 0x44, 0x88,
-	0x52, 0x72, 0xe2, 0xbf, 0x68, 0x68, 0xba, 0x4b, 0x4c, 0xe2, 0x6b, 0x0a, 0x19, 0x2d, 0x45, 0xa3,
-	0xe6, 0xae, 0x0f, 0x64, 0x0b, 0x98, 0xaf, 0x0a, 0xcc, 0x17, 0xf1, 0x85, 0x30, 0xe6, 0xee, 0xeb,
-	0x2c, 0xd6, 0xa0, 0x1f, 0xc7, 0x14, 0x2e, 0xfe, 0x9b, 0x86, 0xa6, 0xbb, 0x84, 0xa4, 0x0a, 0x93,
-	0x34, 0x25, 0xab, 0xc2, 0x24, 0x55, 0xb9, 0xea, 0xb7, 0x05, 0x93, 0x1b, 0xf8, 0x7a, 0xf2, 0x1a,
-	0x2a, 0xd4, 0x4f, 0x7c, 0x09, 0x8d, 0xc9, 0xe6, 0x3d, 0x5f, 0xda, 0xe0, 0x4d, 0xc2, 0x63, 0x92,
-	0x12, 0xab, 0xcd, 0xb7, 0x04, 0xb5, 0xab, 0xb2, 0x54, 0xa5, 0xe8, 0x57, 0x7d, 0x4d, 0x10, 0x5a,
-	0xc1, 0xcb, 0xa9, 0x49, 0xd1, 0x74, 0x9c, 0xb2, 0xe4, 0xe0, 0x01, 0xd0, 0x67, 0x1a, 0x3a, 0x22,
-	0x9c, 0xb1, 0x98, 0x12, 0xc4, 0x37, 0x94, 0x63, 0x9b, 0x24, 0x4b, 0x73, 0xef, 0x0c, 0x6a, 0x0e,
-	0x64, 0xb6, 0x04, 0x99, 0x02, 0xbe, 0x95, 0xfd, 0x76, 0xe4, 0x14, 0x36, 0x5d, 0x4b, 0x5e, 0x1f,
-	0x84, 0x56, 0x2a, 0x63, 0x57, 0x94, 0xec, 0xe1, 0x2f, 0x35, 0x74, 0x28, 0x72, 0x10, 0x8d, 0xbf,
-	0xa1, 0x34, 0x59, 0xbb, 0xce, 0xf3, 0x73, 0x97, 0xfb, 0xb6, 0x03, 0x32, 0x37, 0x05, 0x99, 0xab,
-	0xf8, 0x72, 0xea, 0x9b, 0xe1, 0x8c, 0x05, 0x7a, 0xd3, 0xd8, 0x8d, 0x9f, 0xb2, 0xef, 0xe1, 0x5f,
-	0x0e, 0xa1, 0xb9, 0xec, 0xc3, 0x74, 0xbc, 0xd9, 0x27, 0xb8, 0xb4, 0xab, 0x81, 0xdc, 0xd6, 0xcb,
-	0x3b, 0x02, 0xda, 0x15, 0x41, 0xfb, 0x3b, 0xf8, 0xb1, 0x0a, 0xed, 0x72, 0x43, 0x9c, 0xb9, 0xdb,
-	0x55, 0xd3, 0x31, 0x76, 0x13, 0xef, 0x26, 0xf6, 0x92, 0x22, 0xf3, 0x99, 0x26, 0xee, 0x6e, 0xb0,
-	0xa1, 0x86, 0xba, 0x7d, 0x15, 0x94, 0x3b, 0xaf, 0x6e, 0x00, 0x74, 0xe6, 0x05, 0x9d, 0x1c, 0x9e,
-	0x4d, 0xa4, 0xe3, 0x83, 0xf8, 0xb5, 0x86, 0x50, 0xe7, 0xf6, 0x00, 0x2b, 0x2c, 0x0a, 0x5d, 0xd7,
-	0x19, 0xb9, 0x4b, 0xfd, 0x19, 0x01, 0xb6, 0x33, 0x02, 0xdb, 0x29, 0x7c, 0x32, 0x11, 0x1b, 0xef,
-	0x60, 0xfa, 0x42, 0x43, 0x53, 0x91, 0xeb, 0x33, 0x5f, 0x57, 0xa8, 0x25, 0x9d, 0xa4, 0x0b, 0xd3,
-	0xdc, 0xb5, 0x41, 0x4c, 0x01, 0xf4, 0xb2, 0x00, 0xbd, 0x88, 0xf5, 0x44, 0xd0, 0xd1, 0x5b, 0xcd,
-	0xbf, 0x6a, 0x68, 0x26, 0xe9, 0x26, 0x51, 0x25, 0x4f, 0x65, 0x5c, 0x60, 0xaa, 0xe4, 0xa9, 0xac,
-	0x0b, 0x4c, 0xfd, 0x2d, 0xc1, 0xc1, 0xc0, 0xab, 0xbd, 0x39, 0x84, 0x65, 0xf4, 0x17, 0x5a, 0xe4,
-	0x8e, 0xbc, 0x1f, 0x0d, 0x1d, 0x8d, 0xff, 0x95, 0xfe, 0x0d, 0x01, 0xf9, 0x45, 0x81, 0x7c, 0x15,
-	0x9f, 0x4b, 0x16, 0x48, 0x1d, 0x8b, 0x30, 0x6e, 0x5f, 0x94, 0x86, 0x9c, 0xa9, 0x8b, 0xd2, 0xc1,
-	0xa0, 0x27, 0x7f, 0x60, 0xd0, 0x43, 0x94, 0x86, 0xa0, 0xfb, 0xea, 0x69, 0x26, 0xe9, 0x0b, 0x12,
-	0x95, 0x61, 0x93, 0xf1, 0xe5, 0x8a, 0xca, 0xb0, 0xc9, 0xfa, 0x70, 0xa5, 0xc7, 0x76, 0xa0, 0x2e,
-	0xf2, 0x6b, 0xc4, 0x1a, 0xff, 0x43, 0x43, 0xc7, 0x52, 0xbe, 0xed, 0xc1, 0xb7, 0x06, 0x43, 0xd3,
-	0xf9, 0x7c, 0x28, 0xb7, 0xfe, 0x12, 0x1e, 0x80, 0xd2, 0x25, 0x41, 0x29, 0x8f, 0x57, 0xd2, 0x28,
-	0xad, 0x3b, 0x4e, 0xdc, 0x07, 0x2b, 0xdc, 0xf9, 0xea, 0xf9, 0x9c, 0xf6, 0xf5, 0xf3, 0x39, 0xed,
-	0xd9, 0xf3, 0x39, 0xed, 0x67, 0x2f, 0xe6, 0x0e, 0x7c, 0xfd, 0x62, 0xee, 0xc0, 0xbf, 0x5e, 0xcc,
-	0x1d, 0x78, 0x7c, 0xae, 0x6e, 0xf3, 0xc6, 0x4e, 0x25, 0x5f, 0xa5, 0xdb, 0x61, 0x8f, 0xfe, 0x96,
-	0xc3, 0xf8, 0x24, 0x94, 0xdb, 0x5a, 0x4d, 0xc2, 0x2a, 0x23, 0xe2, 0x63, 0xa9, 0x8b, 0xff, 0x0b,
-	0x00, 0x00, 0xff, 0xff, 0x77, 0x67, 0x2d, 0x08, 0x22, 0x28, 0x00, 0x00,
+	// 2720 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x5a, 0xcd, 0x6f, 0x1b, 0xc7,
+	0xf9, 0xf6, 0x4a, 0xb1, 0x23, 0xbd, 0xb2, 0xf5, 0x31, 0x92, 0x6d, 0x65, 0x25, 0x4b, 0xf2, 0xca,
+	0x1f, 0xb2, 0x6c, 0x91, 0x32, 0xed, 0xfc, 0x2c, 0x5b, 0xfe, 0x12, 0x65, 0x5b, 0xb6, 0x93, 0xd8,
+	0x0a, 0xa9, 0x5f, 0x53, 0x08, 0x6d, 0xd9, 0x25, 0x39, 0xa4, 0xb6, 0x5e, 0xef, 0x32, 0x9c, 0x91,
+	0x6d, 0x59, 0x15, 0x50, 0xf4, 0xd6, 0x1c, 0x8a, 0x02, 0x05, 0xda, 0x5b, 0xd1, 0x1e, 0x7a, 0x6c,
+	0xd1, 0x06, 0x08, 0xd0, 0xa2, 0xe8, 0xc1, 0xe8, 0x21, 0x39, 0xf4, 0x90, 0xa2, 0x45, 0x50, 0xf4,
+	0x90, 0x06, 0x76, 0xff, 0x8d, 0x00, 0xc5, 0xce, 0xbc, 0x4b, 0xee, 0x2e, 0x97, 0xcb, 0x25, 0xcd,
+	0x9e, 0xc8, 0x9d, 0x99, 0xf7, 0x9d, 0xe7, 0x79, 0xe7, 0xeb, 0x99, 0x0f, 0x38, 0xfd, 0x9c, 0x72,
+	0xbd, 0xb0, 0xa5, 0x1b, 0x56, 0x52, 0xfc, 0xb3, 0xab, 0x34, 0x69, 0xe7, 0x19, 0xad, 0x3e, 0xa1,
+	0xd5, 0xe4, 0x87, 0xdb, 0xb4, 0xba, 0x93, 0xa8, 0x54, 0x6d, 0x6e, 0x93, 0x89, 0x5a, 0xc1, 0x84,
+	0x5b, 0x30, 0xe1, 0x16, 0x54, 0xe7, 0x0b, 0x36, 0x7b, 0x6c, 0xb3, 0x64, 0x5e, 0x67, 0x54, 0x5a,
+	0x25, 0x9f, 0x9c, 0xcf, 0x53, 0xae, 0x9f, 0x4f, 0x56, 0xf4, 0xb2, 0x61, 0xe9, 0xdc, 0xb0, 0x2d,
+	0xe9, 0x48, 0x1d, 0x2b, 0xdb, 0x65, 0x5b, 0xfc, 0x4d, 0x3a, 0xff, 0x30, 0x75, 0xb2, 0x6c, 0xdb,
+	0x65, 0x93, 0x26, 0xf5, 0x8a, 0x91, 0xd4, 0x2d, 0xcb, 0xe6, 0xc2, 0x84, 0x61, 0xee, 0x5c, 0x14,
+	0xca, 0xbc, 0x6e, 0x9a, 0x36, 0xc7, 0x92, 0x91, 0x7c, 0xf2, 0xa6, 0xfe, 0x98, 0x62, 0xc1, 0x44,
+	0x54, 0x41, 0x91, 0x9e, 0xb3, 0x6c, 0xab, 0x40, 0x5d, 0x08, 0xa9, 0xc8, 0xf2, 0x55, 0x9b, 0x31,
+	0x69, 0x54, 0x32, 0xf5, 0x72, 0x2c, 0xd8, 0x8f, 0xe8, 0x4e, 0x99, 0xba, 0x41, 0x99, 0x8f, 0x2a,
+	0x69, 0x1a, 0x4f, 0xa8, 0x45, 0x19, 0x8b, 0x83, 0xdc, 0xb2, 0x8b, 0x34, 0xa7, 0x17, 0x0a, 0xf6,
+	0xb6, 0xc5, 0xe3, 0xf8, 0x76, 0xff, 0xc4, 0x41, 0x5c, 0xd1, 0xab, 0xfa, 0x63, 0x17, 0xc5, 0x62,
+	0x64, 0x49, 0x6a, 0x15, 0x0d, 0xab, 0xec, 0x8f, 0xe0, 0xc9, 0x28, 0x0b, 0xce, 0x58, 0x04, 0xdc,
+	0xca, 0xa3, 0xb2, 0x6c, 0x13, 0x86, 0x3f, 0x2d, 0xca, 0x56, 0xaa, 0xb6, 0x5d, 0x62, 0xf8, 0x83,
+	0x65, 0x2f, 0xb6, 0xa8, 0x3e, 0x57, 0xda, 0xb6, 0x8a, 0x2c, 0xf7, 0xd8, 0x28, 0x57, 0x75, 0x6e,
+	0x63, 0x40, 0xb4, 0x49, 0x50, 0xdf, 0x77, 0xfa, 0xf3, 0xbb, 0xd8, 0x06, 0xeb, 0x22, 0x06, 0x19,
+	0xfa, 0xe1, 0x36, 0x65, 0x5c, 0xdb, 0x81, 0x89, 0xd0, 0x5c, 0x56, 0xb1, 0x2d, 0x46, 0xc9, 0x26,
+	0x0c, 0xb9, 0x6d, 0x97, 0x93, 0xc1, 0x1b, 0x57, 0x66, 0x94, 0xb9, 0x81, 0xd4, 0xd9, 0x44, 0xc4,
+	0x68, 0x4a, 0xf8, 0xbd, 0xa5, 0xdf, 0xf8, 0xec, 0xcb, 0xe9, 0x7d, 0x99, 0x41, 0xd3, 0x97, 0xaa,
+	0xfd, 0x56, 0x81, 0xc3, 0x0f, 0xd1, 0xc2, 0x35, 0xc8, 0x3a, 0x7e, 0xc8, 0x43, 0xe8, 0x73, 0xcb,
+	0x62, 0x75, 0x0b, 0x91, 0xd5, 0x05, 0xbd, 0x60, 0x85, 0x35, 0x27, 0xe4, 0x16, 0xec, 0x67, 0x8e,
+	0xc9, 0x78, 0xcf, 0x8c, 0x32, 0xd7, 0x9f, 0x4e, 0x38, 0xd9, 0xff, 0xfa, 0x72, 0xfa, 0x54, 0xd9,
+	0xe0, 0x5b, 0xdb, 0xf9, 0x44, 0xc1, 0x7e, 0x9c, 0xc4, 0xf1, 0x2f, 0x7f, 0x16, 0x58, 0xf1, 0x51,
+	0x92, 0xef, 0x54, 0x28, 0x4b, 0xdc, 0xa2, 0x85, 0x8c, 0x34, 0xd6, 0xee, 0xc1, 0xa4, 0x88, 0x55,
+	0xb0, 0x3a, 0x8c, 0x25, 0x39, 0x03, 0xc3, 0x2e, 0xa4, 0x9c, 0x5e, 0x2c, 0x56, 0x5d, 0xf8, 0xfd,
+	0x99, 0x21, 0x37, 0x7d, 0x45, 0x26, 0x6b, 0xdb, 0x70, 0xac, 0x89, 0x2b, 0x0c, 0xfc, 0x46, 0x43,
+	0x08, 0x52, 0x6d, 0x85, 0x40, 0x04, 0x32, 0x18, 0x07, 0xcd, 0x80, 0x69, 0x51, 0xed, 0x8a, 0x69,
+	0x36, 0x23, 0x71, 0x07, 0xa0, 0x3e, 0xe1, 0x61, 0xd5, 0xa7, 0x12, 0x32, 0x2c, 0x09, 0x67, 0x76,
+	0x4c, 0xc8, 0x39, 0x15, 0x67, 0xc7, 0xc4, 0xba, 0x5e, 0xa6, 0x68, 0x9b, 0xf1, 0x58, 0x6a, 0x2f,
+	0x14, 0x98, 0x69, 0x5e, 0x57, 0x28, 0xcb, 0xde, 0xee, 0xb0, 0x24, 0x6b, 0x3e, 0x0a, 0x3d, 0x82,
+	0xc2, 0xe9, 0x96, 0x14, 0x24, 0x24, 0x1f, 0x87, 0x93, 0x30, 0x2b, 0x28, 0x6c, 0x30, 0x76, 0xc7,
+	0x19, 0x5a, 0xef, 0xe1, 0xc8, 0xba, 0x67, 0x95, 0xec, 0x15, 0xd3, 0x74, 0xc7, 0xd0, 0x8f, 0x15,
+	0x38, 0x11, 0x5d, 0x0e, 0xe9, 0x96, 0x60, 0xb4, 0x71, 0x98, 0xba, 0xcc, 0x17, 0x23, 0x99, 0xa3,
+	0x6b, 0xaf, 0x67, 0xe4, 0x3d, 0xc2, 0x03, 0xb5, 0x32, 0xed, 0x1a, 0x86, 0x3e, 0x0c, 0x8f, 0xdb,
+	0xce, 0x6f, 0x41, 0x9f, 0x9c, 0xee, 0x8d, 0xa2, 0x68, 0xe5, 0xde, 0xcc, 0x9b, 0xe2, 0xfb, 0x5e,
+	0x51, 0xfb, 0x91, 0x02, 0xc7, 0x23, 0xec, 0x91, 0x4c, 0x11, 0x48, 0x23, 0x19, 0xec, 0x30, 0x9d,
+	0x72, 0x19, 0x0e, 0x72, 0xd1, 0x2e, 0xe1, 0xec, 0xb5, 0x46, 0xf9, 0xaa, 0xe3, 0xee, 0x81, 0x98,
+	0x8f, 0x63, 0x90, 0xb0, 0x61, 0x22, 0xd4, 0x10, 0xd1, 0xaf, 0xc3, 0x80, 0x27, 0x19, 0x61, 0xcf,
+	0x45, 0xc2, 0xf6, 0x94, 0x47, 0xb8, 0x5e, 0x17, 0x5a, 0x11, 0x91, 0xae, 0x98, 0x66, 0x08, 0xd2,
+	0x6e, 0x0d, 0xab, 0x3f, 0x28, 0x30, 0x11, 0x5a, 0x4d, 0x33, 0x5e, 0xbd, 0xaf, 0xc9, 0xab, 0x7b,
+	0xa3, 0xa9, 0x84, 0xd3, 0xe7, 0x8a, 0x69, 0xae, 0xcb, 0xd5, 0xf5, 0x7f, 0x13, 0xa2, 0x17, 0x0a,
+	0x1c, 0x6b, 0x52, 0x11, 0x06, 0xe9, 0x03, 0x18, 0xf4, 0xaf, 0xef, 0x18, 0xa7, 0xf9, 0xc8, 0x38,
+	0xf9, 0x7c, 0x61, 0xa4, 0x0e, 0x55, 0xbc, 0x89, 0xdd, 0x8b, 0x95, 0x3b, 0x82, 0xfd, 0x75, 0xee,
+	0x88, 0x76, 0x89, 0xd1, 0xf9, 0xbf, 0x0f, 0xc7, 0x23, 0xcc, 0x23, 0xa2, 0xa0, 0x74, 0x21, 0x0a,
+	0xda, 0x18, 0x10, 0x77, 0xe8, 0x6d, 0x64, 0xb3, 0xee, 0x2c, 0xf9, 0x10, 0x46, 0x7d, 0xa9, 0x88,
+	0x62, 0x09, 0x7a, 0x37, 0xb2, 0x59, 0xac, 0x7a, 0x26, 0x7a, 0xde, 0xc8, 0x66, 0xb1, 0x42, 0xc7,
+	0x44, 0xbb, 0x0d, 0x6f, 0xd5, 0x1c, 0x32, 0x86, 0x2b, 0xab, 0x1b, 0x9c, 0x39, 0x18, 0xce, 0x1b,
+	0xbc, 0x60, 0x1b, 0x56, 0xae, 0x16, 0xa4, 0x1e, 0x11, 0xa4, 0x41, 0x4c, 0x5f, 0xc5, 0x58, 0xe9,
+	0xa0, 0x86, 0xb9, 0x41, 0x78, 0xc3, 0xd0, 0x4b, 0xf9, 0x16, 0x2e, 0xe3, 0xce, 0x5f, 0x27, 0x25,
+	0xcf, 0x0b, 0x52, 0x49, 0x64, 0x9c, 0xbf, 0x64, 0x1a, 0x06, 0xf2, 0xbc, 0x90, 0xe3, 0xba, 0xa3,
+	0xd6, 0xf8, 0x78, 0xaf, 0xc8, 0x81, 0x3c, 0x2f, 0x6c, 0xc8, 0x14, 0xed, 0x23, 0x05, 0xe6, 0x1b,
+	0xeb, 0x48, 0xef, 0xdc, 0x31, 0x2c, 0xdd, 0x34, 0x9e, 0xd3, 0xe2, 0x5d, 0x6a, 0x94, 0xb7, 0xb8,
+	0x8b, 0x3d, 0x05, 0x87, 0x4b, 0x6e, 0x4e, 0xce, 0x09, 0x43, 0x6e, 0x4b, 0xe4, 0x63, 0x2b, 0x8f,
+	0xd6, 0x32, 0x37, 0x29, 0xd7, 0xa5, 0x69, 0x1b, 0x7c, 0xab, 0x70, 0x36, 0x16, 0x96, 0x6e, 0x06,
+	0xe0, 0xbb, 0x70, 0xc4, 0x5d, 0x50, 0xee, 0x1a, 0x8c, 0xdb, 0xd5, 0x9d, 0x6e, 0x0f, 0xfa, 0x5f,
+	0x2b, 0x70, 0xb4, 0xa1, 0x0a, 0xa4, 0xb0, 0x02, 0x7d, 0xce, 0x4a, 0x65, 0x1a, 0x8c, 0xe3, 0x40,
+	0x8f, 0xdb, 0xcf, 0xde, 0xe4, 0x8c, 0xbd, 0x6b, 0x30, 0xde, 0xbd, 0x81, 0xbd, 0x05, 0x63, 0x02,
+	0xe6, 0x5d, 0x9d, 0x7d, 0xc3, 0xe6, 0xb4, 0xe8, 0xc6, 0xe1, 0x2c, 0x8c, 0xc8, 0x5d, 0x60, 0xce,
+	0x28, 0x52, 0x8b, 0x1b, 0x25, 0x83, 0x56, 0x31, 0xe8, 0xc3, 0x32, 0xe3, 0x5e, 0x2d, 0x9d, 0xcc,
+	0xc2, 0xa1, 0x27, 0x36, 0xf7, 0xa8, 0x4c, 0xd9, 0x16, 0x07, 0x45, 0xa2, 0x2b, 0x31, 0x2f, 0xc2,
+	0xe1, 0x40, 0x4d, 0x18, 0x8e, 0x09, 0xe8, 0xdf, 0xd2, 0x59, 0xce, 0x29, 0x2c, 0x27, 0x8e, 0xbe,
+	0x4c, 0xdf, 0x16, 0x16, 0xd2, 0xde, 0x83, 0x29, 0x61, 0x95, 0x16, 0x75, 0xa6, 0x77, 0xea, 0xb5,
+	0x76, 0x82, 0x54, 0xfb, 0x54, 0x81, 0x7e, 0xc7, 0x71, 0x55, 0x44, 0xb1, 0x01, 0xb7, 0xd2, 0x88,
+	0x9b, 0xa4, 0xa1, 0xdf, 0xf9, 0xce, 0x39, 0xf2, 0x5b, 0x10, 0x1b, 0x4c, 0x9d, 0x8c, 0x6c, 0x2e,
+	0xc7, 0xff, 0xc6, 0x4e, 0x85, 0x66, 0xfa, 0x9e, 0xe0, 0x3f, 0xf2, 0x3e, 0x38, 0x3e, 0x9d, 0x99,
+	0xad, 0x62, 0x3f, 0xa5, 0xd5, 0xf1, 0xde, 0xb6, 0x65, 0xff, 0x3d, 0x8b, 0x67, 0x06, 0xa4, 0x8f,
+	0x75, 0xc7, 0x85, 0xf6, 0x75, 0x0f, 0x4c, 0x37, 0x8d, 0x0c, 0x46, 0xb6, 0xad, 0x46, 0xbc, 0x0e,
+	0x07, 0x04, 0x6f, 0xa7, 0xf5, 0x7a, 0x45, 0xaf, 0x6f, 0x45, 0x52, 0x04, 0x31, 0x83, 0x56, 0xe4,
+	0x03, 0x77, 0xb7, 0x21, 0x3a, 0x96, 0x0c, 0x57, 0xaf, 0x08, 0xd7, 0xb9, 0x18, 0x1a, 0x5a, 0x18,
+	0x89, 0xa8, 0x0d, 0xd9, 0xfe, 0x04, 0xf2, 0x00, 0x0e, 0x21, 0x0b, 0xc6, 0x75, 0xbe, 0xcd, 0xc6,
+	0xdf, 0x10, 0x5e, 0xcf, 0x44, 0x7a, 0x95, 0x51, 0xc9, 0x0a, 0x83, 0xcc, 0xc1, 0xbc, 0xe7, 0xcb,
+	0xd9, 0x7c, 0x71, 0xdd, 0x34, 0x77, 0xc6, 0xf7, 0xc7, 0x10, 0x59, 0xd2, 0xcf, 0x86, 0x53, 0x1e,
+	0xc7, 0xa0, 0x34, 0xd6, 0x08, 0x0c, 0xfb, 0x76, 0x4c, 0x59, 0xca, 0xb5, 0x25, 0x18, 0x0f, 0xa6,
+	0xd5, 0xda, 0x62, 0x12, 0xfa, 0x5d, 0xa7, 0x72, 0x79, 0xef, 0xcf, 0xd4, 0x13, 0xb4, 0x23, 0x38,
+	0x0c, 0xb3, 0xdb, 0x95, 0x8a, 0x5d, 0xe5, 0xb4, 0x28, 0x66, 0x47, 0xa6, 0xe5, 0x61, 0x32, 0x2c,
+	0xbd, 0xe6, 0x35, 0x0d, 0x07, 0x04, 0x72, 0x57, 0x31, 0x9c, 0x08, 0x23, 0x53, 0x79, 0x54, 0x4e,
+	0xc8, 0x52, 0x52, 0x5b, 0x21, 0x11, 0xb4, 0xd4, 0x6e, 0x80, 0xe6, 0x53, 0xa6, 0x72, 0x3b, 0x7c,
+	0xc7, 0xae, 0xc6, 0x5d, 0xdd, 0xab, 0x30, 0x1b, 0xe9, 0x00, 0xb1, 0xbe, 0x03, 0x07, 0xa5, 0x07,
+	0xdf, 0xc6, 0x3d, 0x86, 0x16, 0x94, 0xfe, 0x32, 0x03, 0x85, 0xfa, 0x47, 0xed, 0x14, 0xc1, 0x5f,
+	0xa7, 0xbb, 0xb6, 0x5b, 0x30, 0x11, 0x9a, 0x8b, 0x48, 0x1e, 0x86, 0x22, 0x39, 0x17, 0x17, 0x89,
+	0xe8, 0xf6, 0x3e, 0x34, 0xa9, 0x3a, 0x9a, 0x07, 0x76, 0x91, 0xae, 0xc8, 0xd3, 0x22, 0x37, 0x74,
+	0x63, 0xb0, 0xdf, 0xb0, 0x8a, 0xf4, 0x19, 0x0e, 0x3d, 0xf9, 0xa1, 0x7d, 0x0f, 0x26, 0x42, 0x6d,
+	0xea, 0xd1, 0xf2, 0x9e, 0x3c, 0xc5, 0x8a, 0x96, 0xd7, 0xcf, 0x80, 0x55, 0xff, 0xf0, 0xee, 0x05,
+	0x42, 0xf0, 0x75, 0x6b, 0xcd, 0xfb, 0xd8, 0xb3, 0x17, 0x08, 0xa3, 0x74, 0x1f, 0x06, 0x3c, 0xc9,
+	0xb1, 0xf6, 0x02, 0x3e, 0x46, 0x9e, 0x8f, 0xee, 0x2d, 0x80, 0x33, 0x30, 0x55, 0xeb, 0x2a, 0xb5,
+	0x33, 0xc7, 0x3b, 0xce, 0x91, 0xa3, 0xdb, 0x99, 0x7e, 0xa0, 0xc0, 0x74, 0xd3, 0x22, 0x48, 0xed,
+	0xdb, 0x30, 0x1c, 0x3c, 0xb1, 0x8c, 0xd7, 0xab, 0xfc, 0xfe, 0x70, 0x64, 0x0e, 0x15, 0xfc, 0xc9,
+	0xda, 0x38, 0xea, 0x95, 0x75, 0x7d, 0x9b, 0xd1, 0xe2, 0xea, 0xea, 0xc6, 0x37, 0x5d, 0x70, 0x5f,
+	0xb8, 0x3a, 0xc3, 0x9b, 0x55, 0x13, 0xd4, 0x87, 0x2a, 0x22, 0x35, 0xe7, 0x9b, 0x23, 0x62, 0xf5,
+	0xf3, 0x6d, 0x46, 0xbd, 0x88, 0x0e, 0x4a, 0x47, 0x22, 0x93, 0x91, 0x3c, 0x8c, 0xa2, 0xe3, 0xe7,
+	0xd5, 0x42, 0x6a, 0x31, 0xc7, 0xed, 0x47, 0xd4, 0x72, 0xd7, 0x8d, 0x68, 0xf7, 0x9b, 0x99, 0xd5,
+	0xd4, 0x62, 0x83, 0xfb, 0x11, 0xe9, 0x6e, 0xd3, 0xf1, 0xb6, 0x21, 0x9c, 0x69, 0x47, 0x51, 0x2e,
+	0xac, 0x51, 0xfe, 0x8e, 0x38, 0xd7, 0x75, 0x19, 0xff, 0x3f, 0x1c, 0x09, 0x66, 0x20, 0xdf, 0x65,
+	0x38, 0x20, 0x8f, 0x80, 0x31, 0xf4, 0xb3, 0x91, 0x48, 0xd0, 0x18, 0x4d, 0xb4, 0x69, 0xdc, 0xa4,
+	0x65, 0xb7, 0xec, 0xa7, 0xee, 0xfc, 0xbd, 0xea, 0x19, 0x25, 0x4e, 0x37, 0x98, 0x6a, 0x56, 0x02,
+	0x01, 0x7c, 0x07, 0x46, 0x4d, 0x9d, 0xf1, 0x5c, 0xed, 0xd4, 0xcd, 0x3b, 0x74, 0x13, 0xd1, 0x27,
+	0x94, 0x3a, 0xe3, 0x7e, 0xa7, 0x23, 0x66, 0x30, 0x49, 0xbb, 0x8f, 0x18, 0xd3, 0xce, 0xa9, 0x7b,
+	0x98, 0x16, 0x3a, 0x03, 0xc3, 0xe2, 0x44, 0xbe, 0x71, 0xbd, 0x1f, 0x12, 0xe9, 0x75, 0x0b, 0xad,
+	0xe0, 0x0a, 0xab, 0x46, 0x5f, 0x35, 0x99, 0x0a, 0xe8, 0xcc, 0x2a, 0xd9, 0x48, 0x42, 0x8b, 0x5e,
+	0x2c, 0x9d, 0xe2, 0x99, 0x7e, 0x59, 0x95, 0x55, 0xb2, 0x35, 0x5a, 0x9f, 0x10, 0x64, 0x1e, 0x2d,
+	0xd8, 0xd5, 0x62, 0xd7, 0x77, 0xd8, 0xbf, 0x53, 0x60, 0x32, 0xbc, 0x1e, 0xa4, 0xb2, 0x16, 0xa0,
+	0xd2, 0x1b, 0x8f, 0x0a, 0xf6, 0xce, 0x3a, 0xa1, 0xee, 0x4d, 0x3b, 0x59, 0xdc, 0x50, 0x63, 0xf8,
+	0xc5, 0xc0, 0x5a, 0xb1, 0x8a, 0x62, 0xc7, 0xda, 0x7a, 0xc9, 0x75, 0x96, 0x14, 0xb1, 0x47, 0xc6,
+	0x3d, 0x95, 0xfc, 0xd0, 0x4a, 0x70, 0x3c, 0xc2, 0x69, 0x93, 0x66, 0xed, 0x6d, 0xbb, 0x59, 0x53,
+	0x5f, 0x9f, 0x81, 0xfd, 0xa2, 0x22, 0xf2, 0x67, 0x05, 0xfa, 0x5c, 0x41, 0x4f, 0xce, 0x47, 0x7a,
+	0x09, 0xdb, 0x66, 0xa8, 0xa9, 0x76, 0x4c, 0x24, 0x01, 0xed, 0xfe, 0x0f, 0xff, 0xfe, 0x9f, 0x9f,
+	0xf6, 0xdc, 0x22, 0x69, 0x71, 0xeb, 0xb0, 0x20, 0x8c, 0xeb, 0xf7, 0x0e, 0xb5, 0xad, 0x44, 0x72,
+	0xb7, 0x41, 0xfb, 0xee, 0x25, 0x77, 0x7d, 0x7a, 0x7f, 0x8f, 0x7c, 0xa1, 0x00, 0x69, 0x14, 0xd0,
+	0x64, 0xb9, 0x35, 0xac, 0xa6, 0x1b, 0x12, 0xf5, 0x6a, 0x67, 0xc6, 0xc8, 0xee, 0xb6, 0x60, 0x77,
+	0x83, 0x5c, 0x0b, 0x65, 0x87, 0x94, 0xf2, 0x3b, 0x1e, 0x56, 0x61, 0x44, 0xc9, 0x2f, 0x14, 0x18,
+	0xf0, 0xc8, 0x50, 0xb2, 0xd0, 0x1a, 0x94, 0xa7, 0xb8, 0xfa, 0x76, 0x5b, 0xc5, 0x6b, 0xe0, 0xcf,
+	0x08, 0xf0, 0xb3, 0xe4, 0x78, 0x28, 0x78, 0xf7, 0x4f, 0x8e, 0x51, 0x4e, 0x7e, 0xa3, 0xc0, 0x50,
+	0x40, 0xd5, 0xc6, 0xe9, 0x40, 0x01, 0x13, 0xf5, 0x72, 0xdb, 0x26, 0x35, 0xb0, 0xe7, 0x04, 0xd8,
+	0x53, 0xe4, 0x44, 0x28, 0x58, 0x16, 0xc0, 0xf6, 0x6f, 0x05, 0x8e, 0x84, 0x0b, 0x5c, 0x72, 0xa3,
+	0x35, 0x86, 0x48, 0x6d, 0xad, 0xde, 0xec, 0xdc, 0x01, 0x72, 0x49, 0x0b, 0x2e, 0x57, 0xc9, 0x95,
+	0x50, 0x2e, 0x65, 0xca, 0x73, 0x5e, 0xc1, 0x9b, 0x2b, 0xd9, 0x55, 0x99, 0x90, 0xdc, 0x75, 0x67,
+	0x98, 0x3d, 0xf2, 0xb1, 0x02, 0x83, 0xfe, 0x6a, 0xc8, 0xa5, 0x76, 0x81, 0xb9, 0x8c, 0x96, 0xda,
+	0x37, 0x44, 0x26, 0x0b, 0x82, 0xc9, 0x69, 0x72, 0x32, 0x16, 0x13, 0x07, 0xb4, 0x4f, 0x17, 0xc6,
+	0x43, 0xdc, 0x28, 0x82, 0xd5, 0xa5, 0xf6, 0x0d, 0x11, 0xf1, 0xa2, 0x40, 0x3c, 0x4f, 0xe6, 0x42,
+	0x11, 0x7b, 0x64, 0x78, 0x72, 0x57, 0x28, 0xff, 0x3d, 0xa7, 0xef, 0x0f, 0x7a, 0x3c, 0xad, 0x98,
+	0x66, 0x1c, 0xdc, 0xa1, 0xe2, 0x5d, 0x5d, 0x6a, 0xdf, 0x10, 0x71, 0xcf, 0x09, 0xdc, 0x1a, 0x99,
+	0x69, 0x85, 0x9b, 0xfc, 0x49, 0x81, 0xa1, 0x80, 0x52, 0x25, 0xcb, 0xf1, 0x5a, 0x38, 0x54, 0x52,
+	0xab, 0x57, 0x3b, 0x33, 0x8e, 0xd5, 0x45, 0x82, 0x3a, 0x9c, 0xfc, 0x4a, 0x01, 0xa8, 0xab, 0x63,
+	0x72, 0xa1, 0x75, 0xdd, 0x0d, 0x32, 0x5b, 0xbd, 0xd8, 0x9e, 0x51, 0xac, 0x08, 0xbb, 0xda, 0xbc,
+	0xc0, 0x9f, 0x91, 0x9f, 0x29, 0x70, 0x40, 0x0a, 0x52, 0x92, 0x8a, 0x15, 0x1b, 0x9f, 0x26, 0x56,
+	0x2f, 0xb4, 0x65, 0x83, 0xe8, 0x66, 0x05, 0xba, 0x63, 0x64, 0x22, 0x14, 0x9d, 0x94, 0xc5, 0xe4,
+	0x53, 0x05, 0x46, 0x1a, 0x04, 0x2f, 0xb9, 0x12, 0x63, 0xd6, 0x6d, 0xa2, 0xa3, 0xd5, 0xe5, 0x8e,
+	0x6c, 0x11, 0xf3, 0x65, 0x81, 0xf9, 0x02, 0x39, 0xef, 0xc5, 0xdc, 0xf8, 0xf8, 0x80, 0x6d, 0xd9,
+	0x4f, 0x03, 0x2a, 0x9c, 0xfc, 0x4d, 0x81, 0x91, 0x06, 0xb1, 0x1b, 0x87, 0x49, 0x33, 0xb5, 0xad,
+	0x2e, 0x77, 0x64, 0x8b, 0x4c, 0x56, 0x05, 0x93, 0x6b, 0x64, 0x39, 0x7c, 0x9d, 0x17, 0x0a, 0x2d,
+	0xb8, 0xcc, 0x07, 0xa4, 0xfd, 0x9e, 0x23, 0xbf, 0xc8, 0x1a, 0xe5, 0x01, 0xd9, 0x4b, 0xe2, 0xcd,
+	0x09, 0x21, 0x8a, 0x5c, 0xbd, 0xdc, 0x81, 0x25, 0x12, 0x4a, 0x09, 0x42, 0xe7, 0xc8, 0x7c, 0xd3,
+	0x89, 0x5b, 0x37, 0xcd, 0x9c, 0xe4, 0x50, 0x45, 0xa0, 0x5f, 0x29, 0x70, 0x58, 0x38, 0x63, 0x01,
+	0xb5, 0x4a, 0xae, 0xc5, 0x8e, 0x6d, 0x98, 0x74, 0x56, 0xaf, 0x77, 0x6a, 0x8e, 0x64, 0xee, 0x0a,
+	0x32, 0x69, 0x72, 0x33, 0xba, 0x75, 0xe4, 0x34, 0xa3, 0x5b, 0x45, 0x79, 0x63, 0xe5, 0x59, 0x4d,
+	0x93, 0xbb, 0x22, 0x65, 0x8f, 0xbc, 0x50, 0xe0, 0x90, 0xef, 0x6a, 0x83, 0xfc, 0x5f, 0xac, 0xc1,
+	0xda, 0x70, 0x85, 0xa4, 0x5e, 0x6a, 0xdb, 0x0e, 0xc9, 0xdc, 0x10, 0x64, 0x2e, 0x93, 0x4b, 0x4d,
+	0x5b, 0xc6, 0xb9, 0x8e, 0x40, 0x4d, 0x9c, 0xdc, 0x0d, 0xde, 0xdb, 0xec, 0x91, 0x9f, 0xf7, 0xc0,
+	0x54, 0xf4, 0xf5, 0x0c, 0x59, 0x6b, 0x13, 0x5c, 0xb3, 0xcb, 0x26, 0xf5, 0xee, 0xeb, 0x3b, 0x42,
+	0xda, 0x79, 0x41, 0xfb, 0x5b, 0x64, 0x33, 0x0e, 0xed, 0xdc, 0x96, 0xb8, 0xa4, 0x31, 0x0a, 0xba,
+	0x99, 0xdc, 0x0d, 0xbd, 0xed, 0xda, 0x0b, 0x8b, 0xcc, 0x47, 0x8a, 0xb8, 0x2e, 0x24, 0xc9, 0x78,
+	0xa8, 0x6b, 0xb7, 0x8f, 0xea, 0x62, 0x7c, 0x03, 0xa4, 0x33, 0x23, 0xe8, 0xa8, 0x64, 0x3c, 0x94,
+	0x8e, 0x03, 0xe2, 0x97, 0x0a, 0x40, 0xfd, 0xba, 0x29, 0xce, 0x42, 0xd7, 0x70, 0xff, 0xa5, 0x5e,
+	0x6c, 0xcf, 0x08, 0xb1, 0x9d, 0x16, 0xd8, 0x8e, 0x93, 0xe9, 0x50, 0x6c, 0xbc, 0x8e, 0xe9, 0x13,
+	0x05, 0x86, 0x7d, 0x37, 0xb6, 0x8e, 0xf6, 0x89, 0x37, 0xe9, 0x84, 0xdd, 0xd1, 0xab, 0x57, 0x3a,
+	0x31, 0x45, 0xd0, 0xf3, 0x02, 0xf4, 0x09, 0xa2, 0x85, 0xaf, 0xce, 0x5e, 0x1b, 0xf2, 0x57, 0x05,
+	0xc6, 0xc2, 0x2e, 0xaf, 0xe3, 0xcc, 0x53, 0x11, 0x77, 0xe6, 0xea, 0xf5, 0x4e, 0xcd, 0x91, 0xc3,
+	0xdb, 0x82, 0x43, 0x92, 0x2c, 0xb4, 0xe6, 0xe0, 0x95, 0xfa, 0x9f, 0x28, 0xbe, 0x67, 0x19, 0xed,
+	0xe8, 0x7c, 0x7f, 0xfc, 0x97, 0xda, 0x37, 0x44, 0xe4, 0x17, 0x04, 0xf2, 0x05, 0x72, 0x36, 0x5c,
+	0xc4, 0xd5, 0x2d, 0xbc, 0xb8, 0x1d, 0xe1, 0xec, 0x71, 0x16, 0x5f, 0x38, 0x77, 0x06, 0x3d, 0xfc,
+	0x4d, 0x4b, 0x0b, 0x59, 0xe7, 0x81, 0xee, 0xa8, 0xa7, 0xb1, 0xb0, 0x47, 0x4b, 0x71, 0xba, 0x4d,
+	0xc4, 0x63, 0x29, 0xf5, 0x7a, 0xa7, 0xe6, 0xb1, 0xb6, 0x2c, 0x65, 0xca, 0x83, 0xd6, 0xe4, 0x1f,
+	0x0a, 0x1c, 0x6d, 0xf2, 0x9c, 0x8c, 0xdc, 0xec, 0x0c, 0x4d, 0xfd, 0xc5, 0x9a, 0xba, 0xf2, 0x1a,
+	0x1e, 0x90, 0xd2, 0x45, 0x41, 0x29, 0x41, 0xce, 0x35, 0xa3, 0xb4, 0x62, 0x9a, 0x41, 0x1f, 0x8c,
+	0xfc, 0x5e, 0x81, 0x41, 0xff, 0xe3, 0xd0, 0x38, 0x1d, 0x2a, 0xf4, 0xe9, 0xaa, 0xba, 0xd4, 0xbe,
+	0x61, 0xac, 0x93, 0x88, 0xc0, 0x83, 0x57, 0xf2, 0x17, 0x05, 0x86, 0x83, 0x0f, 0x0f, 0xe3, 0xcc,
+	0xa1, 0x4d, 0x5e, 0x58, 0xaa, 0x57, 0x3a, 0x31, 0x45, 0xe4, 0x4b, 0x02, 0x79, 0x8a, 0x2c, 0x46,
+	0x22, 0x4f, 0xee, 0x06, 0xdf, 0xa1, 0xee, 0x91, 0x3f, 0x2a, 0x30, 0x1a, 0x74, 0xeb, 0x74, 0xa6,
+	0xab, 0xb1, 0x86, 0x65, 0x33, 0x2e, 0xd7, 0x3a, 0xb4, 0x46, 0x3a, 0x27, 0x05, 0x9d, 0x69, 0x72,
+	0x2c, 0x92, 0x4e, 0xfa, 0xf6, 0x67, 0x2f, 0xa7, 0x94, 0xcf, 0x5f, 0x4e, 0x29, 0x5f, 0xbd, 0x9c,
+	0x52, 0x7e, 0xf2, 0x6a, 0x6a, 0xdf, 0xe7, 0xaf, 0xa6, 0xf6, 0xfd, 0xf3, 0xd5, 0xd4, 0xbe, 0xcd,
+	0xb3, 0x9e, 0xab, 0x7c, 0x8f, 0x0b, 0xcb, 0x2e, 0xd2, 0xe4, 0xb3, 0xba, 0x27, 0x71, 0xa7, 0x9f,
+	0x3f, 0x20, 0x1e, 0x44, 0x5f, 0xf8, 0xef, 0x00, 0x8c, 0xa3, 0x8d, 0x7d, 0x32, 0x30, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2476,6 +2936,8 @@ type QueryClient interface {
 	// Queries a list of nodeAccount items.
 	NodeAccountAll(ctx context.Context, in *QueryAllNodeAccountRequest, opts ...grpc.CallOption) (*QueryAllNodeAccountResponse, error)
 	CrosschainFlags(ctx context.Context, in *QueryGetCrosschainFlagsRequest, opts ...grpc.CallOption) (*QueryGetCrosschainFlagsResponse, error)
+	// Queries the chains and ZRC20 tokens with paused inbound and/or outbound
+	PausedCCTX(ctx context.Context, in *QueryPausedCCTXRequest, opts ...grpc.CallOption) (*QueryPausedCCTXResponse, error)
 	// Queries a keygen by index.
 	Keygen(ctx context.Context, in *QueryGetKeygenRequest, opts ...grpc.CallOption) (*QueryGetKeygenResponse, error)
 	// Queries a list of ShowObserverCount items.
@@ -2502,6 +2964,12 @@ type QueryClient interface {
 	TssFundsMigratorInfo(ctx context.Context, in *QueryTssFundsMigratorInfoRequest, opts ...grpc.CallOption) (*QueryTssFundsMigratorInfoResponse, error)
 	// Queries all TssFundMigratorInfo
 	TssFundsMigratorInfoAll(ctx context.Context, in *QueryTssFundsMigratorInfoAllRequest, opts ...grpc.CallOption) (*QueryTssFundsMigratorInfoAllResponse, error)
+	// Queries the liveness params
+	LivenessParams(ctx context.Context, in *QueryLivenessParamsRequest, opts ...grpc.CallOption) (*QueryLivenessParamsResponse, error)
+	// Queries the liveness of an observer
+	ObserverLiveness(ctx context.Context, in *QueryObserverLivenessRequest, opts ...grpc.CallOption) (*QueryObserverLivenessResponse, error)
+	// Queries the liveness of all the observers
+	ObserverLivenessAll(ctx context.Context, in *QueryAllObserverLivenessRequest, opts ...grpc.CallOption) (*QueryAllObserverLivenessResponse, error)
 }
 
 type queryClient struct {
@@ -2593,6 +3061,15 @@ func (c *queryClient) CrosschainFlags(ctx context.Context, in *QueryGetCrosschai
 	return out, nil
 }
 
+func (c *queryClient) PausedCCTX(ctx context.Context, in *QueryPausedCCTXRequest, opts ...grpc.CallOption) (*QueryPausedCCTXResponse, error) {
+	out := new(QueryPausedCCTXResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/PausedCCTX", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Keygen(ctx context.Context, in *QueryGetKeygenRequest, opts ...grpc.CallOption) (*QueryGetKeygenResponse, error) {
 	out := new(QueryGetKeygenResponse)
 	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/Keygen", in, out, opts...)
@@ -2728,6 +3205,33 @@ func (c *queryClient) TssFundsMigratorInfoAll(ctx context.Context, in *QueryTssF
 	return out, nil
 }
 
+func (c *queryClient) LivenessParams(ctx context.Context, in *QueryLivenessParamsRequest, opts ...grpc.CallOption) (*QueryLivenessParamsResponse, error) {
+	out := new(QueryLivenessParamsResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/LivenessParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ObserverLiveness(ctx context.Context, in *QueryObserverLivenessRequest, opts ...grpc.CallOption) (*QueryObserverLivenessResponse, error) {
+	out := new(QueryObserverLivenessResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/ObserverLiveness", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ObserverLivenessAll(ctx context.Context, in *QueryAllObserverLivenessRequest, opts ...grpc.CallOption) (*QueryAllObserverLivenessResponse, error) {
+	out := new(QueryAllObserverLivenessResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/ObserverLivenessAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Query if a voter has voted for a ballot
@@ -2746,6 +3250,8 @@ type QueryServer interface {
 	// Queries a list of nodeAccount items.
 	NodeAccountAll(context.Context, *QueryAllNodeAccountRequest) (*QueryAllNodeAccountResponse, error)
 	CrosschainFlags(context.Context, *QueryGetCrosschainFlagsRequest) (*QueryGetCrosschainFlagsResponse, error)
+	// Queries the chains and ZRC20 tokens with paused inbound and/or outbound
+	PausedCCTX(context.Context, *QueryPausedCCTXRequest) (*QueryPausedCCTXResponse, error)
 	// Queries a keygen by index.
 	Keygen(context.Context, *QueryGetKeygenRequest) (*QueryGetKeygenResponse, error)
 	// Queries a list of ShowObserverCount items.
@@ -2772,6 +3278,12 @@ type QueryServer interface {
 	TssFundsMigratorInfo(context.Context, *QueryTssFundsMigratorInfoRequest) (*QueryTssFundsMigratorInfoResponse, error)
 	// Queries all TssFundMigratorInfo
 	TssFundsMigratorInfoAll(context.Context, *QueryTssFundsMigratorInfoAllRequest) (*QueryTssFundsMigratorInfoAllResponse, error)
+	// Queries the liveness params
+	LivenessParams(context.Context, *QueryLivenessParamsRequest) (*QueryLivenessParamsResponse, error)
+	// Queries the liveness of an observer
+	ObserverLiveness(context.Context, *QueryObserverLivenessRequest) (*QueryObserverLivenessResponse, error)
+	// Queries the liveness of all the observers
+	ObserverLivenessAll(context.Context, *QueryAllObserverLivenessRequest) (*QueryAllObserverLivenessResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -2805,6 +3317,9 @@ func (*UnimplementedQueryServer) NodeAccountAll(ctx context.Context, req *QueryA
 func (*UnimplementedQueryServer) CrosschainFlags(ctx context.Context, req *QueryGetCrosschainFlagsRequest) (*QueryGetCrosschainFlagsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CrosschainFlags not implemented")
 }
+func (*UnimplementedQueryServer) PausedCCTX(ctx context.Context, req *QueryPausedCCTXRequest) (*QueryPausedCCTXResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PausedCCTX not implemented")
+}
 func (*UnimplementedQueryServer) Keygen(ctx context.Context, req *QueryGetKeygenRequest) (*QueryGetKeygenResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Keygen not implemented")
 }
@@ -2850,6 +3365,15 @@ func (*UnimplementedQueryServer) TssFundsMigratorInfo(ctx context.Context, req *
 func (*UnimplementedQueryServer) TssFundsMigratorInfoAll(ctx context.Context, req *QueryTssFundsMigratorInfoAllRequest) (*QueryTssFundsMigratorInfoAllResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TssFundsMigratorInfoAll not implemented")
 }
+func (*UnimplementedQueryServer) LivenessParams(ctx context.Context, req *QueryLivenessParamsRequest) (*QueryLivenessParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LivenessParams not implemented")
+}
+func (*UnimplementedQueryServer) ObserverLiveness(ctx context.Context, req *QueryObserverLivenessRequest) (*QueryObserverLivenessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ObserverLiveness not implemented")
+}
+func (*UnimplementedQueryServer) ObserverLivenessAll(ctx context.Context, req *QueryAllObserverLivenessRequest) (*QueryAllObserverLivenessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ObserverLivenessAll not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -3017,6 +3541,24 @@ func _Query_CrosschainFlags_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_PausedCCTX_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPausedCCTXRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PausedCCTX(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/PausedCCTX",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PausedCCTX(ctx, req.(*QueryPausedCCTXRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Keygen_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryGetKeygenRequest)
 	if err := dec(in); err != nil {
@@ -3287,6 +3829,61 @@ func _Query_TssFundsMigratorInfoAll_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_LivenessParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLivenessParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LivenessParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/LivenessParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LivenessParams(ctx, req.(*QueryLivenessParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ObserverLiveness_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryObserverLivenessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ObserverLiveness(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/ObserverLiveness",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ObserverLiveness(ctx, req.(*QueryObserverLivenessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ObserverLivenessAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllObserverLivenessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ObserverLivenessAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/ObserverLivenessAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ObserverLivenessAll(ctx, req.(*QueryAllObserverLivenessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "zetachain.zetacore.observer.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -3327,6 +3924,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CrosschainFlags",
 			Handler:    _Query_CrosschainFlags_Handler,
 		},
+		{
+			MethodName: "PausedCCTX",
+			Handler:    _Query_PausedCCTX_Handler,
+		},
 		{
 			MethodName: "Keygen",
 			Handler:    _Query_Keygen_Handler,
@@ -3387,12 +3988,24 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TssFundsMigratorInfoAll",
 			Handler:    _Query_TssFundsMigratorInfoAll_Handler,
 		},
+		{
+			MethodName: "LivenessParams",
+			Handler:    _Query_LivenessParams_Handler,
+		},
+		{
+			MethodName: "ObserverLiveness",
+			Handler:    _Query_ObserverLiveness_Handler,
+		},
+		{
+			MethodName: "ObserverLivenessAll",
+			Handler:    _Query_ObserverLivenessAll_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "zetachain/zetacore/observer/query.proto",
 }
 
-func (m *QueryTssFundsMigratorInfoAllRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryLivenessParamsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3402,12 +4015,12 @@ func (m *QueryTssFundsMigratorInfoAllRequest) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *QueryTssFundsMigratorInfoAllRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryLivenessParamsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTssFundsMigratorInfoAllRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryLivenessParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3415,7 +4028,7 @@ func (m *QueryTssFundsMigratorInfoAllRequest) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryTssFundsMigratorInfoAllResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryLivenessParamsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3425,34 +4038,30 @@ func (m *QueryTssFundsMigratorInfoAllResponse) Marshal() (dAtA []byte, err error
 	return dAtA[:n], nil
 }
 
-func (m *QueryTssFundsMigratorInfoAllResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryLivenessParamsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTssFundsMigratorInfoAllResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryLivenessParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.TssFundsMigrators) > 0 {
-		for iNdEx := len(m.TssFundsMigrators) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TssFundsMigrators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	{
+		size, err := m.LivenessParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryTssFundsMigratorInfoRequest) Marshal() (dAtA []byte, err error) {
+func (m *ObserverLivenessScore) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3462,46 +4071,28 @@ func (m *QueryTssFundsMigratorInfoRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryTssFundsMigratorInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ObserverLivenessScore) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTssFundsMigratorInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ObserverLivenessScore) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ChainId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *QueryTssFundsMigratorInfoResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	{
+		size := m.Score.Size()
+		i -= size
+		if _, err := m.Score.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryTssFundsMigratorInfoResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryTssFundsMigratorInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
+	i--
+	dAtA[i] = 0x12
 	{
-		size, err := m.TssFundsMigrator.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.Liveness.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -3513,7 +4104,7 @@ func (m *QueryTssFundsMigratorInfoResponse) MarshalToSizedBuffer(dAtA []byte) (i
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetChainNoncesRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryObserverLivenessRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3523,25 +4114,27 @@ func (m *QueryGetChainNoncesRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetChainNoncesRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryObserverLivenessRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetChainNoncesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryObserverLivenessRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ChainId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+	if len(m.ObserverAddress) > 0 {
+		i -= len(m.ObserverAddress)
+		copy(dAtA[i:], m.ObserverAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ObserverAddress)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetChainNoncesResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryObserverLivenessResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3551,18 +4144,18 @@ func (m *QueryGetChainNoncesResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetChainNoncesResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryObserverLivenessResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetChainNoncesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryObserverLivenessResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.ChainNonces.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.Liveness.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -3574,7 +4167,7 @@ func (m *QueryGetChainNoncesResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllChainNoncesRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryAllObserverLivenessRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3584,12 +4177,12 @@ func (m *QueryAllChainNoncesRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAllChainNoncesRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAllObserverLivenessRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllChainNoncesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAllObserverLivenessRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3609,7 +4202,7 @@ func (m *QueryAllChainNoncesRequest) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllChainNoncesResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryAllObserverLivenessResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3619,12 +4212,12 @@ func (m *QueryAllChainNoncesResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAllChainNoncesResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAllObserverLivenessResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllChainNoncesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAllObserverLivenessResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3641,10 +4234,10 @@ func (m *QueryAllChainNoncesResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.ChainNonces) > 0 {
-		for iNdEx := len(m.ChainNonces) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Liveness) > 0 {
+		for iNdEx := len(m.Liveness) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.ChainNonces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Liveness[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3658,7 +4251,7 @@ func (m *QueryAllChainNoncesResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllPendingNoncesRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryTssFundsMigratorInfoAllRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3668,32 +4261,20 @@ func (m *QueryAllPendingNoncesRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAllPendingNoncesRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryTssFundsMigratorInfoAllRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllPendingNoncesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryTssFundsMigratorInfoAllRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllPendingNoncesResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryTssFundsMigratorInfoAllResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3703,32 +4284,20 @@ func (m *QueryAllPendingNoncesResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAllPendingNoncesResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryTssFundsMigratorInfoAllResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllPendingNoncesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryTssFundsMigratorInfoAllResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.PendingNonces) > 0 {
-		for iNdEx := len(m.PendingNonces) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.TssFundsMigrators) > 0 {
+		for iNdEx := len(m.TssFundsMigrators) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.PendingNonces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.TssFundsMigrators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3742,7 +4311,7 @@ func (m *QueryAllPendingNoncesResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryPendingNoncesByChainRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryTssFundsMigratorInfoRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3752,12 +4321,12 @@ func (m *QueryPendingNoncesByChainRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryPendingNoncesByChainRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryTssFundsMigratorInfoRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryPendingNoncesByChainRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryTssFundsMigratorInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3770,7 +4339,7 @@ func (m *QueryPendingNoncesByChainRequest) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryPendingNoncesByChainResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryTssFundsMigratorInfoResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3780,18 +4349,18 @@ func (m *QueryPendingNoncesByChainResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryPendingNoncesByChainResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryTssFundsMigratorInfoResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryPendingNoncesByChainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryTssFundsMigratorInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.PendingNonces.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.TssFundsMigrator.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -3803,7 +4372,7 @@ func (m *QueryPendingNoncesByChainResponse) MarshalToSizedBuffer(dAtA []byte) (i
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetTSSRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetChainNoncesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3813,20 +4382,25 @@ func (m *QueryGetTSSRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetTSSRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetChainNoncesRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetTSSRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetChainNoncesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetTSSResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetChainNoncesResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3836,18 +4410,18 @@ func (m *QueryGetTSSResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetTSSResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetChainNoncesResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetTSSResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetChainNoncesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.TSS.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.ChainNonces.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -3859,35 +4433,7 @@ func (m *QueryGetTSSResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetTssAddressRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryGetTssAddressRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryGetTssAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BitcoinChainId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.BitcoinChainId))
-		i--
-		dAtA[i] = 0x10
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *QueryGetTssAddressResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryAllChainNoncesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3897,67 +4443,32 @@ func (m *QueryGetTssAddressResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetTssAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAllChainNoncesRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetTssAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAllChainNoncesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Btc) > 0 {
-		i -= len(m.Btc)
-		copy(dAtA[i:], m.Btc)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Btc)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Eth) > 0 {
-		i -= len(m.Eth)
-		copy(dAtA[i:], m.Eth)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Eth)))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetTssAddressByFinalizedHeightRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryGetTssAddressByFinalizedHeightRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryGetTssAddressByFinalizedHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BitcoinChainId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.BitcoinChainId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.FinalizedZetaHeight != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.FinalizedZetaHeight))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *QueryGetTssAddressByFinalizedHeightResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryAllChainNoncesResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3967,34 +4478,46 @@ func (m *QueryGetTssAddressByFinalizedHeightResponse) Marshal() (dAtA []byte, er
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetTssAddressByFinalizedHeightResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAllChainNoncesResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetTssAddressByFinalizedHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAllChainNoncesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Btc) > 0 {
-		i -= len(m.Btc)
-		copy(dAtA[i:], m.Btc)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Btc)))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Eth) > 0 {
-		i -= len(m.Eth)
-		copy(dAtA[i:], m.Eth)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Eth)))
-		i--
-		dAtA[i] = 0xa
+	if len(m.ChainNonces) > 0 {
+		for iNdEx := len(m.ChainNonces) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChainNonces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryTssHistoryRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryAllPendingNoncesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4004,12 +4527,12 @@ func (m *QueryTssHistoryRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryTssHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAllPendingNoncesRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTssHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAllPendingNoncesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4029,7 +4552,7 @@ func (m *QueryTssHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryTssHistoryResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryAllPendingNoncesResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4039,12 +4562,12 @@ func (m *QueryTssHistoryResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryTssHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAllPendingNoncesResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTssHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAllPendingNoncesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4061,10 +4584,10 @@ func (m *QueryTssHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.TssList) > 0 {
-		for iNdEx := len(m.TssList) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.PendingNonces) > 0 {
+		for iNdEx := len(m.PendingNonces) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.TssList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.PendingNonces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -4078,7 +4601,7 @@ func (m *QueryTssHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryHasVotedRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryPendingNoncesByChainRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4088,34 +4611,25 @@ func (m *QueryHasVotedRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryHasVotedRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryPendingNoncesByChainRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryHasVotedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryPendingNoncesByChainRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.VoterAddress) > 0 {
-		i -= len(m.VoterAddress)
-		copy(dAtA[i:], m.VoterAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.VoterAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.BallotIdentifier) > 0 {
-		i -= len(m.BallotIdentifier)
-		copy(dAtA[i:], m.BallotIdentifier)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BallotIdentifier)))
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryHasVotedResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryPendingNoncesByChainResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4125,30 +4639,30 @@ func (m *QueryHasVotedResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryHasVotedResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryPendingNoncesByChainResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryHasVotedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryPendingNoncesByChainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.HasVoted {
-		i--
-		if m.HasVoted {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
+	{
+		size, err := m.PendingNonces.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryBallotByIdentifierRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetTSSRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4158,27 +4672,53 @@ func (m *QueryBallotByIdentifierRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryBallotByIdentifierRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetTSSRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryBallotByIdentifierRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetTSSRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.BallotIdentifier) > 0 {
-		i -= len(m.BallotIdentifier)
-		copy(dAtA[i:], m.BallotIdentifier)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BallotIdentifier)))
-		i--
-		dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetTSSResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetTSSResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetTSSResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.TSS.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *VoterList) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetTssAddressRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4188,32 +4728,25 @@ func (m *VoterList) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *VoterList) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetTssAddressRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *VoterList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetTssAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.VoteType != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.VoteType))
+	if m.BitcoinChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BitcoinChainId))
 		i--
 		dAtA[i] = 0x10
 	}
-	if len(m.VoterAddress) > 0 {
-		i -= len(m.VoterAddress)
-		copy(dAtA[i:], m.VoterAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.VoterAddress)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryBallotByIdentifierResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetTssAddressResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4223,51 +4756,41 @@ func (m *QueryBallotByIdentifierResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryBallotByIdentifierResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetTssAddressResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryBallotByIdentifierResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetTssAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.BallotStatus != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.BallotStatus))
+	if len(m.BtcTaproot) > 0 {
+		i -= len(m.BtcTaproot)
+		copy(dAtA[i:], m.BtcTaproot)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BtcTaproot)))
 		i--
-		dAtA[i] = 0x20
+		dAtA[i] = 0x1a
 	}
-	if m.ObservationType != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ObservationType))
+	if len(m.Btc) > 0 {
+		i -= len(m.Btc)
+		copy(dAtA[i:], m.Btc)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Btc)))
 		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Voters) > 0 {
-		for iNdEx := len(m.Voters) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Voters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+		dAtA[i] = 0x12
 	}
-	if len(m.BallotIdentifier) > 0 {
-		i -= len(m.BallotIdentifier)
-		copy(dAtA[i:], m.BallotIdentifier)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BallotIdentifier)))
+	if len(m.Eth) > 0 {
+		i -= len(m.Eth)
+		copy(dAtA[i:], m.Eth)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Eth)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryObserverSet) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetTssAddressByFinalizedHeightRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4277,20 +4800,30 @@ func (m *QueryObserverSet) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryObserverSet) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetTssAddressByFinalizedHeightRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryObserverSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetTssAddressByFinalizedHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.BitcoinChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BitcoinChainId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FinalizedZetaHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FinalizedZetaHeight))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryObserverSetResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetTssAddressByFinalizedHeightResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4300,29 +4833,41 @@ func (m *QueryObserverSetResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryObserverSetResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetTssAddressByFinalizedHeightResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryObserverSetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetTssAddressByFinalizedHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Observers) > 0 {
-		for iNdEx := len(m.Observers) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Observers[iNdEx])
-			copy(dAtA[i:], m.Observers[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.Observers[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
+	if len(m.BtcTaproot) > 0 {
+		i -= len(m.BtcTaproot)
+		copy(dAtA[i:], m.BtcTaproot)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BtcTaproot)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Btc) > 0 {
+		i -= len(m.Btc)
+		copy(dAtA[i:], m.Btc)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Btc)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Eth) > 0 {
+		i -= len(m.Eth)
+		copy(dAtA[i:], m.Eth)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Eth)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QuerySupportedChains) Marshal() (dAtA []byte, err error) {
+func (m *QueryTssHistoryRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4332,20 +4877,32 @@ func (m *QuerySupportedChains) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QuerySupportedChains) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryTssHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QuerySupportedChains) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryTssHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QuerySupportedChainsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryTssHistoryResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4355,20 +4912,32 @@ func (m *QuerySupportedChainsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QuerySupportedChainsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryTssHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QuerySupportedChainsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryTssHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Chains) > 0 {
-		for iNdEx := len(m.Chains) - 1; iNdEx >= 0; iNdEx-- {
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TssList) > 0 {
+		for iNdEx := len(m.TssList) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Chains[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.TssList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -4382,7 +4951,7 @@ func (m *QuerySupportedChainsResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetChainParamsForChainRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryHasVotedRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4392,25 +4961,34 @@ func (m *QueryGetChainParamsForChainRequest) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetChainParamsForChainRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryHasVotedRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetChainParamsForChainRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryHasVotedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ChainId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+	if len(m.VoterAddress) > 0 {
+		i -= len(m.VoterAddress)
+		copy(dAtA[i:], m.VoterAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.VoterAddress)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x12
+	}
+	if len(m.BallotIdentifier) > 0 {
+		i -= len(m.BallotIdentifier)
+		copy(dAtA[i:], m.BallotIdentifier)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BallotIdentifier)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetChainParamsForChainResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryHasVotedResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4420,32 +4998,30 @@ func (m *QueryGetChainParamsForChainResponse) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetChainParamsForChainResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryHasVotedResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetChainParamsForChainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryHasVotedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ChainParams != nil {
-		{
-			size, err := m.ChainParams.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+	if m.HasVoted {
+		i--
+		if m.HasVoted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetChainParamsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryBallotByIdentifierRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4455,20 +5031,27 @@ func (m *QueryGetChainParamsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetChainParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryBallotByIdentifierRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetChainParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryBallotByIdentifierRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.BallotIdentifier) > 0 {
+		i -= len(m.BallotIdentifier)
+		copy(dAtA[i:], m.BallotIdentifier)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BallotIdentifier)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetChainParamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *VoterList) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4478,32 +5061,42 @@ func (m *QueryGetChainParamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetChainParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *VoterList) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetChainParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *VoterList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ChainParams != nil {
-		{
-			size, err := m.ChainParams.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+	{
+		size := m.VotingPower.Size()
+		i -= size
+		if _, err := m.VotingPower.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.VoteType != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.VoteType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.VoterAddress) > 0 {
+		i -= len(m.VoterAddress)
+		copy(dAtA[i:], m.VoterAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.VoterAddress)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetNodeAccountRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryBallotByIdentifierResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4513,62 +5106,61 @@ func (m *QueryGetNodeAccountRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetNodeAccountRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryBallotByIdentifierResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetNodeAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryBallotByIdentifierResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Index) > 0 {
-		i -= len(m.Index)
-		copy(dAtA[i:], m.Index)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Index)))
+	{
+		size, err := m.Tally.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.BallotStatus != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BallotStatus))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x20
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *QueryGetNodeAccountResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if m.ObservationType != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ObservationType))
+		i--
+		dAtA[i] = 0x18
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryGetNodeAccountResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryGetNodeAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.NodeAccount != nil {
-		{
-			size, err := m.NodeAccount.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.Voters) > 0 {
+		for iNdEx := len(m.Voters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Voters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x12
 		}
+	}
+	if len(m.BallotIdentifier) > 0 {
+		i -= len(m.BallotIdentifier)
+		copy(dAtA[i:], m.BallotIdentifier)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BallotIdentifier)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllNodeAccountRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryObserverSet) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4578,32 +5170,20 @@ func (m *QueryAllNodeAccountRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAllNodeAccountRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryObserverSet) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllNodeAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryObserverSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllNodeAccountResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryObserverSetResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4613,38 +5193,21 @@ func (m *QueryAllNodeAccountResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAllNodeAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryObserverSetResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllNodeAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryObserverSetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.NodeAccount) > 0 {
-		for iNdEx := len(m.NodeAccount) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.NodeAccount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
+	if len(m.Observers) > 0 {
+		for iNdEx := len(m.Observers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Observers[iNdEx])
+			copy(dAtA[i:], m.Observers[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Observers[iNdEx])))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -4652,7 +5215,7 @@ func (m *QueryAllNodeAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetCrosschainFlagsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QuerySupportedChains) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4662,12 +5225,12 @@ func (m *QueryGetCrosschainFlagsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetCrosschainFlagsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QuerySupportedChains) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetCrosschainFlagsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QuerySupportedChains) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4675,7 +5238,7 @@ func (m *QueryGetCrosschainFlagsRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetCrosschainFlagsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QuerySupportedChainsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4685,30 +5248,34 @@ func (m *QueryGetCrosschainFlagsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetCrosschainFlagsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QuerySupportedChainsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetCrosschainFlagsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QuerySupportedChainsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.CrosschainFlags.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.Chains) > 0 {
+		for iNdEx := len(m.Chains) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Chains[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetKeygenRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetChainParamsForChainRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4718,20 +5285,25 @@ func (m *QueryGetKeygenRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetKeygenRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetChainParamsForChainRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetKeygenRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetChainParamsForChainRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetKeygenResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetChainParamsForChainResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4741,19 +5313,19 @@ func (m *QueryGetKeygenResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetKeygenResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetChainParamsForChainResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetKeygenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetChainParamsForChainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Keygen != nil {
+	if m.ChainParams != nil {
 		{
-			size, err := m.Keygen.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.ChainParams.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4766,7 +5338,7 @@ func (m *QueryGetKeygenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryShowObserverCountRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetChainParamsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4776,12 +5348,12 @@ func (m *QueryShowObserverCountRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryShowObserverCountRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetChainParamsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryShowObserverCountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetChainParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4789,7 +5361,7 @@ func (m *QueryShowObserverCountRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryShowObserverCountResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetChainParamsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4799,19 +5371,19 @@ func (m *QueryShowObserverCountResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryShowObserverCountResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetChainParamsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryShowObserverCountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetChainParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.LastObserverCount != nil {
+	if m.ChainParams != nil {
 		{
-			size, err := m.LastObserverCount.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.ChainParams.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4824,7 +5396,7 @@ func (m *QueryShowObserverCountResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryBlameByIdentifierRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetNodeAccountRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4834,27 +5406,27 @@ func (m *QueryBlameByIdentifierRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryBlameByIdentifierRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetNodeAccountRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryBlameByIdentifierRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetNodeAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.BlameIdentifier) > 0 {
-		i -= len(m.BlameIdentifier)
-		copy(dAtA[i:], m.BlameIdentifier)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlameIdentifier)))
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Index)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryBlameByIdentifierResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetNodeAccountResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4864,19 +5436,19 @@ func (m *QueryBlameByIdentifierResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryBlameByIdentifierResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetNodeAccountResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryBlameByIdentifierResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetNodeAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.BlameInfo != nil {
+	if m.NodeAccount != nil {
 		{
-			size, err := m.BlameInfo.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.NodeAccount.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4889,7 +5461,7 @@ func (m *QueryBlameByIdentifierResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllBlameRecordsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryAllNodeAccountRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4899,12 +5471,12 @@ func (m *QueryAllBlameRecordsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAllBlameRecordsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAllNodeAccountRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllBlameRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAllNodeAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4924,7 +5496,7 @@ func (m *QueryAllBlameRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllBlameRecordsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryAllNodeAccountResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4934,12 +5506,12 @@ func (m *QueryAllBlameRecordsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAllBlameRecordsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAllNodeAccountResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllBlameRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAllNodeAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4956,10 +5528,10 @@ func (m *QueryAllBlameRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.BlameInfo) > 0 {
-		for iNdEx := len(m.BlameInfo) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.NodeAccount) > 0 {
+		for iNdEx := len(m.NodeAccount) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.BlameInfo[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.NodeAccount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -4973,7 +5545,7 @@ func (m *QueryAllBlameRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryBlameByChainAndNonceRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetCrosschainFlagsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4983,30 +5555,53 @@ func (m *QueryBlameByChainAndNonceRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryBlameByChainAndNonceRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetCrosschainFlagsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryBlameByChainAndNonceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetCrosschainFlagsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Nonce != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
-		i--
-		dAtA[i] = 0x10
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetCrosschainFlagsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.ChainId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
-		i--
-		dAtA[i] = 0x8
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetCrosschainFlagsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetCrosschainFlagsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.CrosschainFlags.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryBlameByChainAndNonceResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryPausedCCTXRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5016,20 +5611,57 @@ func (m *QueryBlameByChainAndNonceResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryBlameByChainAndNonceResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryPausedCCTXRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryBlameByChainAndNonceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryPausedCCTXRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.BlameInfo) > 0 {
-		for iNdEx := len(m.BlameInfo) - 1; iNdEx >= 0; iNdEx-- {
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPausedCCTXResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPausedCCTXResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPausedCCTXResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PausedZrc20Tokens) > 0 {
+		for iNdEx := len(m.PausedZrc20Tokens) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.BlameInfo[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.PausedZrc20Tokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.PausedChains) > 0 {
+		for iNdEx := len(m.PausedChains) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PausedChains[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -5043,175 +5675,353 @@ func (m *QueryBlameByChainAndNonceResponse) MarshalToSizedBuffer(dAtA []byte) (i
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryGetKeygenRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryTssFundsMigratorInfoAllRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QueryGetKeygenRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetKeygenRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryTssFundsMigratorInfoAllResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetKeygenResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetKeygenResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetKeygenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.TssFundsMigrators) > 0 {
-		for _, e := range m.TssFundsMigrators {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Keygen != nil {
+		{
+			size, err := m.Keygen.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryTssFundsMigratorInfoRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ChainId != 0 {
-		n += 1 + sovQuery(uint64(m.ChainId))
+func (m *QueryShowObserverCountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryTssFundsMigratorInfoResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.TssFundsMigrator.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryShowObserverCountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetChainNoncesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryShowObserverCountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ChainId != 0 {
-		n += 1 + sovQuery(uint64(m.ChainId))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetChainNoncesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryShowObserverCountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.ChainNonces.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryAllChainNoncesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryShowObserverCountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllChainNoncesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryShowObserverCountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.ChainNonces) > 0 {
-		for _, e := range m.ChainNonces {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.LastObserverCount != nil {
+		{
+			size, err := m.LastObserverCount.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllPendingNoncesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryBlameByIdentifierRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryAllPendingNoncesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryBlameByIdentifierRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlameByIdentifierRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BlameIdentifier) > 0 {
+		i -= len(m.BlameIdentifier)
+		copy(dAtA[i:], m.BlameIdentifier)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlameIdentifier)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlameByIdentifierResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlameByIdentifierResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlameByIdentifierResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.PendingNonces) > 0 {
-		for _, e := range m.PendingNonces {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.BlameInfo != nil {
+		{
+			size, err := m.BlameInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllBlameRecordsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllBlameRecordsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllBlameRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPendingNoncesByChainRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAllBlameRecordsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllBlameRecordsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllBlameRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BlameInfo) > 0 {
+		for iNdEx := len(m.BlameInfo) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BlameInfo[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlameByChainAndNonceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlameByChainAndNonceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlameByChainAndNonceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.ChainId != 0 {
-		n += 1 + sovQuery(uint64(m.ChainId))
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPendingNoncesByChainResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryBlameByChainAndNonceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlameByChainAndNonceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlameByChainAndNonceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.PendingNonces.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if len(m.BlameInfo) > 0 {
+		for iNdEx := len(m.BlameInfo) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BlameInfo[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetTSSRequest) Size() (n int) {
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryLivenessParamsRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -5220,84 +6030,79 @@ func (m *QueryGetTSSRequest) Size() (n int) {
 	return n
 }
 
-func (m *QueryGetTSSResponse) Size() (n int) {
+func (m *QueryLivenessParamsResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.TSS.Size()
+	l = m.LivenessParams.Size()
 	n += 1 + l + sovQuery(uint64(l))
 	return n
 }
 
-func (m *QueryGetTssAddressRequest) Size() (n int) {
+func (m *ObserverLivenessScore) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.BitcoinChainId != 0 {
-		n += 1 + sovQuery(uint64(m.BitcoinChainId))
-	}
+	l = m.Liveness.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Score.Size()
+	n += 1 + l + sovQuery(uint64(l))
 	return n
 }
 
-func (m *QueryGetTssAddressResponse) Size() (n int) {
+func (m *QueryObserverLivenessRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Eth)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Btc)
+	l = len(m.ObserverAddress)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func (m *QueryGetTssAddressByFinalizedHeightRequest) Size() (n int) {
+func (m *QueryObserverLivenessResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.FinalizedZetaHeight != 0 {
-		n += 1 + sovQuery(uint64(m.FinalizedZetaHeight))
-	}
-	if m.BitcoinChainId != 0 {
-		n += 1 + sovQuery(uint64(m.BitcoinChainId))
-	}
+	l = m.Liveness.Size()
+	n += 1 + l + sovQuery(uint64(l))
 	return n
 }
 
-func (m *QueryGetTssAddressByFinalizedHeightResponse) Size() (n int) {
+func (m *QueryAllObserverLivenessRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Eth)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Btc)
-	if l > 0 {
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func (m *QueryTssHistoryRequest) Size() (n int) {
+func (m *QueryAllObserverLivenessResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	if len(m.Liveness) > 0 {
+		for _, e := range m.Liveness {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
 	if m.Pagination != nil {
 		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
@@ -5305,230 +6110,253 @@ func (m *QueryTssHistoryRequest) Size() (n int) {
 	return n
 }
 
-func (m *QueryTssHistoryResponse) Size() (n int) {
+func (m *QueryTssFundsMigratorInfoAllRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.TssList) > 0 {
-		for _, e := range m.TssList {
+	return n
+}
+
+func (m *QueryTssFundsMigratorInfoAllResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TssFundsMigrators) > 0 {
+		for _, e := range m.TssFundsMigrators {
 			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
 	return n
 }
 
-func (m *QueryHasVotedRequest) Size() (n int) {
+func (m *QueryTssFundsMigratorInfoRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.BallotIdentifier)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
 	}
-	l = len(m.VoterAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTssFundsMigratorInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
 	}
+	var l int
+	_ = l
+	l = m.TssFundsMigrator.Size()
+	n += 1 + l + sovQuery(uint64(l))
 	return n
 }
 
-func (m *QueryHasVotedResponse) Size() (n int) {
+func (m *QueryGetChainNoncesRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.HasVoted {
-		n += 2
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
 	}
 	return n
 }
 
-func (m *QueryBallotByIdentifierRequest) Size() (n int) {
+func (m *QueryGetChainNoncesResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.BallotIdentifier)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
+	l = m.ChainNonces.Size()
+	n += 1 + l + sovQuery(uint64(l))
 	return n
 }
 
-func (m *VoterList) Size() (n int) {
+func (m *QueryAllChainNoncesRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.VoterAddress)
-	if l > 0 {
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	if m.VoteType != 0 {
-		n += 1 + sovQuery(uint64(m.VoteType))
-	}
 	return n
 }
 
-func (m *QueryBallotByIdentifierResponse) Size() (n int) {
+func (m *QueryAllChainNoncesResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.BallotIdentifier)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if len(m.Voters) > 0 {
-		for _, e := range m.Voters {
+	if len(m.ChainNonces) > 0 {
+		for _, e := range m.ChainNonces {
 			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
-	if m.ObservationType != 0 {
-		n += 1 + sovQuery(uint64(m.ObservationType))
-	}
-	if m.BallotStatus != 0 {
-		n += 1 + sovQuery(uint64(m.BallotStatus))
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func (m *QueryObserverSet) Size() (n int) {
+func (m *QueryAllPendingNoncesRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
-func (m *QueryObserverSetResponse) Size() (n int) {
+func (m *QueryAllPendingNoncesResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Observers) > 0 {
-		for _, s := range m.Observers {
-			l = len(s)
+	if len(m.PendingNonces) > 0 {
+		for _, e := range m.PendingNonces {
+			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
-func (m *QuerySupportedChains) Size() (n int) {
+func (m *QueryPendingNoncesByChainRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
 	return n
 }
 
-func (m *QuerySupportedChainsResponse) Size() (n int) {
+func (m *QueryPendingNoncesByChainResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Chains) > 0 {
-		for _, e := range m.Chains {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
+	l = m.PendingNonces.Size()
+	n += 1 + l + sovQuery(uint64(l))
 	return n
 }
 
-func (m *QueryGetChainParamsForChainRequest) Size() (n int) {
+func (m *QueryGetTSSRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.ChainId != 0 {
-		n += 1 + sovQuery(uint64(m.ChainId))
-	}
 	return n
 }
 
-func (m *QueryGetChainParamsForChainResponse) Size() (n int) {
+func (m *QueryGetTSSResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.ChainParams != nil {
-		l = m.ChainParams.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
+	l = m.TSS.Size()
+	n += 1 + l + sovQuery(uint64(l))
 	return n
 }
 
-func (m *QueryGetChainParamsRequest) Size() (n int) {
+func (m *QueryGetTssAddressRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	if m.BitcoinChainId != 0 {
+		n += 1 + sovQuery(uint64(m.BitcoinChainId))
+	}
 	return n
 }
 
-func (m *QueryGetChainParamsResponse) Size() (n int) {
+func (m *QueryGetTssAddressResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.ChainParams != nil {
-		l = m.ChainParams.Size()
+	l = len(m.Eth)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Btc)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.BtcTaproot)
+	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func (m *QueryGetNodeAccountRequest) Size() (n int) {
+func (m *QueryGetTssAddressByFinalizedHeightRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Index)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.FinalizedZetaHeight != 0 {
+		n += 1 + sovQuery(uint64(m.FinalizedZetaHeight))
+	}
+	if m.BitcoinChainId != 0 {
+		n += 1 + sovQuery(uint64(m.BitcoinChainId))
 	}
 	return n
 }
 
-func (m *QueryGetNodeAccountResponse) Size() (n int) {
+func (m *QueryGetTssAddressByFinalizedHeightResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.NodeAccount != nil {
-		l = m.NodeAccount.Size()
+	l = len(m.Eth)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Btc)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.BtcTaproot)
+	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func (m *QueryAllNodeAccountRequest) Size() (n int) {
+func (m *QueryTssHistoryRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -5541,14 +6369,14 @@ func (m *QueryAllNodeAccountRequest) Size() (n int) {
 	return n
 }
 
-func (m *QueryAllNodeAccountResponse) Size() (n int) {
+func (m *QueryTssHistoryResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.NodeAccount) > 0 {
-		for _, e := range m.NodeAccount {
+	if len(m.TssList) > 0 {
+		for _, e := range m.TssList {
 			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
 		}
@@ -5560,84 +6388,354 @@ func (m *QueryAllNodeAccountResponse) Size() (n int) {
 	return n
 }
 
-func (m *QueryGetCrosschainFlagsRequest) Size() (n int) {
+func (m *QueryHasVotedRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	l = len(m.BallotIdentifier)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.VoterAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
-func (m *QueryGetCrosschainFlagsResponse) Size() (n int) {
+func (m *QueryHasVotedResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.CrosschainFlags.Size()
-	n += 1 + l + sovQuery(uint64(l))
+	if m.HasVoted {
+		n += 2
+	}
 	return n
 }
 
-func (m *QueryGetKeygenRequest) Size() (n int) {
+func (m *QueryBallotByIdentifierRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	l = len(m.BallotIdentifier)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
-func (m *QueryGetKeygenResponse) Size() (n int) {
+func (m *VoterList) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Keygen != nil {
-		l = m.Keygen.Size()
+	l = len(m.VoterAddress)
+	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if m.VoteType != 0 {
+		n += 1 + sovQuery(uint64(m.VoteType))
+	}
+	l = m.VotingPower.Size()
+	n += 1 + l + sovQuery(uint64(l))
 	return n
 }
 
-func (m *QueryShowObserverCountRequest) Size() (n int) {
+func (m *QueryBallotByIdentifierResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	l = len(m.BallotIdentifier)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Voters) > 0 {
+		for _, e := range m.Voters {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.ObservationType != 0 {
+		n += 1 + sovQuery(uint64(m.ObservationType))
+	}
+	if m.BallotStatus != 0 {
+		n += 1 + sovQuery(uint64(m.BallotStatus))
+	}
+	l = m.Tally.Size()
+	n += 1 + l + sovQuery(uint64(l))
 	return n
 }
 
-func (m *QueryShowObserverCountResponse) Size() (n int) {
+func (m *QueryObserverSet) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.LastObserverCount != nil {
-		l = m.LastObserverCount.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
 	return n
 }
 
-func (m *QueryBlameByIdentifierRequest) Size() (n int) {
+func (m *QueryObserverSetResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.BlameIdentifier)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Observers) > 0 {
+		for _, s := range m.Observers {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
 	}
 	return n
 }
 
-func (m *QueryBlameByIdentifierResponse) Size() (n int) {
+func (m *QuerySupportedChains) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QuerySupportedChainsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Chains) > 0 {
+		for _, e := range m.Chains {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGetChainParamsForChainRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	return n
+}
+
+func (m *QueryGetChainParamsForChainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainParams != nil {
+		l = m.ChainParams.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetChainParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetChainParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainParams != nil {
+		l = m.ChainParams.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetNodeAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetNodeAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NodeAccount != nil {
+		l = m.NodeAccount.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllNodeAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllNodeAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.NodeAccount) > 0 {
+		for _, e := range m.NodeAccount {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetCrosschainFlagsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetCrosschainFlagsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CrosschainFlags.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPausedCCTXRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPausedCCTXResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PausedChains) > 0 {
+		for _, e := range m.PausedChains {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.PausedZrc20Tokens) > 0 {
+		for _, e := range m.PausedZrc20Tokens {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGetKeygenRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetKeygenResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Keygen != nil {
+		l = m.Keygen.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryShowObserverCountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryShowObserverCountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LastObserverCount != nil {
+		l = m.LastObserverCount.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBlameByIdentifierRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BlameIdentifier)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBlameByIdentifierResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -5679,44 +6777,665 @@ func (m *QueryAllBlameRecordsResponse) Size() (n int) {
 		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	return n
-}
+	return n
+}
+
+func (m *QueryBlameByChainAndNonceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovQuery(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *QueryBlameByChainAndNonceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BlameInfo) > 0 {
+		for _, e := range m.BlameInfo {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryLivenessParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLivenessParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLivenessParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLivenessParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLivenessParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLivenessParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LivenessParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LivenessParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ObserverLivenessScore) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ObserverLivenessScore: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ObserverLivenessScore: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Liveness", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Liveness.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Score.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryObserverLivenessRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryObserverLivenessRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryObserverLivenessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObserverAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObserverAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryObserverLivenessResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryObserverLivenessResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryObserverLivenessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Liveness", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Liveness.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *QueryBlameByChainAndNonceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ChainId != 0 {
-		n += 1 + sovQuery(uint64(m.ChainId))
-	}
-	if m.Nonce != 0 {
-		n += 1 + sovQuery(uint64(m.Nonce))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
+func (m *QueryAllObserverLivenessRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllObserverLivenessRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllObserverLivenessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *QueryBlameByChainAndNonceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	if len(m.BlameInfo) > 0 {
-		for _, e := range m.BlameInfo {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+func (m *QueryAllObserverLivenessResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllObserverLivenessResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllObserverLivenessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Liveness", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Liveness = append(m.Liveness, ObserverLivenessScore{})
+			if err := m.Liveness[len(m.Liveness)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
 func (m *QueryTssFundsMigratorInfoAllRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
@@ -6981,11 +8700,43 @@ func (m *QueryGetTssAddressResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Eth = string(dAtA[iNdEx:postIndex])
+			m.Eth = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Btc", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Btc = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Btc", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcTaproot", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7013,7 +8764,7 @@ func (m *QueryGetTssAddressResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Btc = string(dAtA[iNdEx:postIndex])
+			m.BtcTaproot = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7217,6 +8968,38 @@ func (m *QueryGetTssAddressByFinalizedHeightResponse) Unmarshal(dAtA []byte) err
 			}
 			m.Btc = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcTaproot", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BtcTaproot = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7790,6 +9573,40 @@ func (m *VoterList) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VotingPower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7944,6 +9761,39 @@ func (m *QueryBallotByIdentifierResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tally", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Tally.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -9029,6 +10879,174 @@ func (m *QueryGetCrosschainFlagsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryPausedCCTXRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPausedCCTXRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPausedCCTXRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPausedCCTXResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPausedCCTXResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPausedCCTXResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedChains", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PausedChains = append(m.PausedChains, ChainPauseFlags{})
+			if err := m.PausedChains[len(m.PausedChains)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedZrc20Tokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PausedZrc20Tokens = append(m.PausedZrc20Tokens, ZRC20PauseFlags{})
+			if err := m.PausedZrc20Tokens[len(m.PausedZrc20Tokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryGetKeygenRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
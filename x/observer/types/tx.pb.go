@@ -1240,6 +1240,406 @@ func (m *MsgUpdateGasPriceIncreaseFlagsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateGasPriceIncreaseFlagsResponse proto.InternalMessageInfo
 
+type MsgPauseCCTX struct {
+	Creator        string   `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ChainIds       []int64  `protobuf:"varint,2,rep,packed,name=chain_ids,json=chainIds,proto3" json:"chain_ids,omitempty"`
+	Zrc20Addresses []string `protobuf:"bytes,3,rep,name=zrc20_addresses,json=zrc20Addresses,proto3" json:"zrc20_addresses,omitempty"`
+	PauseInbound   bool     `protobuf:"varint,4,opt,name=pause_inbound,json=pauseInbound,proto3" json:"pause_inbound,omitempty"`
+	PauseOutbound  bool     `protobuf:"varint,5,opt,name=pause_outbound,json=pauseOutbound,proto3" json:"pause_outbound,omitempty"`
+}
+
+func (m *MsgPauseCCTX) Reset()         { *m = MsgPauseCCTX{} }
+func (m *MsgPauseCCTX) String() string { return proto.CompactTextString(m) }
+func (*MsgPauseCCTX) ProtoMessage()    {}
+func (*MsgPauseCCTX) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eda6e3b1d16a4021, []int{24}
+}
+func (m *MsgPauseCCTX) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPauseCCTX) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPauseCCTX.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPauseCCTX) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPauseCCTX.Merge(m, src)
+}
+func (m *MsgPauseCCTX) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPauseCCTX) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPauseCCTX.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPauseCCTX proto.InternalMessageInfo
+
+func (m *MsgPauseCCTX) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgPauseCCTX) GetChainIds() []int64 {
+	if m != nil {
+		return m.ChainIds
+	}
+	return nil
+}
+
+func (m *MsgPauseCCTX) GetZrc20Addresses() []string {
+	if m != nil {
+		return m.Zrc20Addresses
+	}
+	return nil
+}
+
+func (m *MsgPauseCCTX) GetPauseInbound() bool {
+	if m != nil {
+		return m.PauseInbound
+	}
+	return false
+}
+
+func (m *MsgPauseCCTX) GetPauseOutbound() bool {
+	if m != nil {
+		return m.PauseOutbound
+	}
+	return false
+}
+
+type MsgPauseCCTXResponse struct {
+}
+
+func (m *MsgPauseCCTXResponse) Reset()         { *m = MsgPauseCCTXResponse{} }
+func (m *MsgPauseCCTXResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPauseCCTXResponse) ProtoMessage()    {}
+func (*MsgPauseCCTXResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eda6e3b1d16a4021, []int{25}
+}
+func (m *MsgPauseCCTXResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPauseCCTXResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPauseCCTXResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPauseCCTXResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPauseCCTXResponse.Merge(m, src)
+}
+func (m *MsgPauseCCTXResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPauseCCTXResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPauseCCTXResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPauseCCTXResponse proto.InternalMessageInfo
+
+type MsgUnpauseCCTX struct {
+	Creator         string   `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ChainIds        []int64  `protobuf:"varint,2,rep,packed,name=chain_ids,json=chainIds,proto3" json:"chain_ids,omitempty"`
+	Zrc20Addresses  []string `protobuf:"bytes,3,rep,name=zrc20_addresses,json=zrc20Addresses,proto3" json:"zrc20_addresses,omitempty"`
+	UnpauseInbound  bool     `protobuf:"varint,4,opt,name=unpause_inbound,json=unpauseInbound,proto3" json:"unpause_inbound,omitempty"`
+	UnpauseOutbound bool     `protobuf:"varint,5,opt,name=unpause_outbound,json=unpauseOutbound,proto3" json:"unpause_outbound,omitempty"`
+}
+
+func (m *MsgUnpauseCCTX) Reset()         { *m = MsgUnpauseCCTX{} }
+func (m *MsgUnpauseCCTX) String() string { return proto.CompactTextString(m) }
+func (*MsgUnpauseCCTX) ProtoMessage()    {}
+func (*MsgUnpauseCCTX) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eda6e3b1d16a4021, []int{26}
+}
+func (m *MsgUnpauseCCTX) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnpauseCCTX) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnpauseCCTX.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnpauseCCTX) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnpauseCCTX.Merge(m, src)
+}
+func (m *MsgUnpauseCCTX) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnpauseCCTX) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnpauseCCTX.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnpauseCCTX proto.InternalMessageInfo
+
+func (m *MsgUnpauseCCTX) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgUnpauseCCTX) GetChainIds() []int64 {
+	if m != nil {
+		return m.ChainIds
+	}
+	return nil
+}
+
+func (m *MsgUnpauseCCTX) GetZrc20Addresses() []string {
+	if m != nil {
+		return m.Zrc20Addresses
+	}
+	return nil
+}
+
+func (m *MsgUnpauseCCTX) GetUnpauseInbound() bool {
+	if m != nil {
+		return m.UnpauseInbound
+	}
+	return false
+}
+
+func (m *MsgUnpauseCCTX) GetUnpauseOutbound() bool {
+	if m != nil {
+		return m.UnpauseOutbound
+	}
+	return false
+}
+
+type MsgUnpauseCCTXResponse struct {
+}
+
+func (m *MsgUnpauseCCTXResponse) Reset()         { *m = MsgUnpauseCCTXResponse{} }
+func (m *MsgUnpauseCCTXResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnpauseCCTXResponse) ProtoMessage()    {}
+func (*MsgUnpauseCCTXResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eda6e3b1d16a4021, []int{27}
+}
+func (m *MsgUnpauseCCTXResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnpauseCCTXResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnpauseCCTXResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnpauseCCTXResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnpauseCCTXResponse.Merge(m, src)
+}
+func (m *MsgUnpauseCCTXResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnpauseCCTXResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnpauseCCTXResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnpauseCCTXResponse proto.InternalMessageInfo
+
+type MsgUpdateLivenessParams struct {
+	Creator        string         `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	LivenessParams LivenessParams `protobuf:"bytes,2,opt,name=liveness_params,json=livenessParams,proto3" json:"liveness_params"`
+}
+
+func (m *MsgUpdateLivenessParams) Reset()         { *m = MsgUpdateLivenessParams{} }
+func (m *MsgUpdateLivenessParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateLivenessParams) ProtoMessage()    {}
+func (*MsgUpdateLivenessParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eda6e3b1d16a4021, []int{28}
+}
+func (m *MsgUpdateLivenessParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateLivenessParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateLivenessParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateLivenessParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateLivenessParams.Merge(m, src)
+}
+func (m *MsgUpdateLivenessParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateLivenessParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateLivenessParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateLivenessParams proto.InternalMessageInfo
+
+func (m *MsgUpdateLivenessParams) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgUpdateLivenessParams) GetLivenessParams() LivenessParams {
+	if m != nil {
+		return m.LivenessParams
+	}
+	return LivenessParams{}
+}
+
+type MsgUpdateLivenessParamsResponse struct {
+}
+
+func (m *MsgUpdateLivenessParamsResponse) Reset()         { *m = MsgUpdateLivenessParamsResponse{} }
+func (m *MsgUpdateLivenessParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateLivenessParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateLivenessParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eda6e3b1d16a4021, []int{29}
+}
+func (m *MsgUpdateLivenessParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateLivenessParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateLivenessParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateLivenessParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateLivenessParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateLivenessParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateLivenessParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateLivenessParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateLivenessParamsResponse proto.InternalMessageInfo
+
+type MsgUnjailObserver struct {
+	Creator         string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ObserverAddress string `protobuf:"bytes,2,opt,name=observer_address,json=observerAddress,proto3" json:"observer_address,omitempty"`
+}
+
+func (m *MsgUnjailObserver) Reset()         { *m = MsgUnjailObserver{} }
+func (m *MsgUnjailObserver) String() string { return proto.CompactTextString(m) }
+func (*MsgUnjailObserver) ProtoMessage()    {}
+func (*MsgUnjailObserver) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eda6e3b1d16a4021, []int{30}
+}
+func (m *MsgUnjailObserver) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnjailObserver) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnjailObserver.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnjailObserver) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnjailObserver.Merge(m, src)
+}
+func (m *MsgUnjailObserver) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnjailObserver) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnjailObserver.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnjailObserver proto.InternalMessageInfo
+
+func (m *MsgUnjailObserver) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgUnjailObserver) GetObserverAddress() string {
+	if m != nil {
+		return m.ObserverAddress
+	}
+	return ""
+}
+
+type MsgUnjailObserverResponse struct {
+}
+
+func (m *MsgUnjailObserverResponse) Reset()         { *m = MsgUnjailObserverResponse{} }
+func (m *MsgUnjailObserverResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnjailObserverResponse) ProtoMessage()    {}
+func (*MsgUnjailObserverResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eda6e3b1d16a4021, []int{31}
+}
+func (m *MsgUnjailObserverResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnjailObserverResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnjailObserverResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnjailObserverResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnjailObserverResponse.Merge(m, src)
+}
+func (m *MsgUnjailObserverResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnjailObserverResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnjailObserverResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnjailObserverResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgUpdateObserver)(nil), "zetachain.zetacore.observer.MsgUpdateObserver")
 	proto.RegisterType((*MsgUpdateObserverResponse)(nil), "zetachain.zetacore.observer.MsgUpdateObserverResponse")
@@ -1265,6 +1665,14 @@ func init() {
 	proto.RegisterType((*MsgDisableCCTXResponse)(nil), "zetachain.zetacore.observer.MsgDisableCCTXResponse")
 	proto.RegisterType((*MsgUpdateGasPriceIncreaseFlags)(nil), "zetachain.zetacore.observer.MsgUpdateGasPriceIncreaseFlags")
 	proto.RegisterType((*MsgUpdateGasPriceIncreaseFlagsResponse)(nil), "zetachain.zetacore.observer.MsgUpdateGasPriceIncreaseFlagsResponse")
+	proto.RegisterType((*MsgPauseCCTX)(nil), "zetachain.zetacore.observer.MsgPauseCCTX")
+	proto.RegisterType((*MsgPauseCCTXResponse)(nil), "zetachain.zetacore.observer.MsgPauseCCTXResponse")
+	proto.RegisterType((*MsgUnpauseCCTX)(nil), "zetachain.zetacore.observer.MsgUnpauseCCTX")
+	proto.RegisterType((*MsgUnpauseCCTXResponse)(nil), "zetachain.zetacore.observer.MsgUnpauseCCTXResponse")
+	proto.RegisterType((*MsgUpdateLivenessParams)(nil), "zetachain.zetacore.observer.MsgUpdateLivenessParams")
+	proto.RegisterType((*MsgUpdateLivenessParamsResponse)(nil), "zetachain.zetacore.observer.MsgUpdateLivenessParamsResponse")
+	proto.RegisterType((*MsgUnjailObserver)(nil), "zetachain.zetacore.observer.MsgUnjailObserver")
+	proto.RegisterType((*MsgUnjailObserverResponse)(nil), "zetachain.zetacore.observer.MsgUnjailObserverResponse")
 }
 
 func init() {
@@ -1272,86 +1680,102 @@ func init() {
 }
 
 var fileDescriptor_eda6e3b1d16a4021 = []byte{
-	// 1262 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0x4b, 0x6f, 0xdb, 0xc6,
-	0x13, 0x37, 0xff, 0xce, 0xc3, 0x1e, 0x59, 0xb2, 0xc3, 0xbf, 0x93, 0xc8, 0x74, 0xa3, 0x06, 0x44,
-	0xe2, 0x28, 0x8f, 0x4a, 0xb1, 0x52, 0xf4, 0x79, 0xf2, 0x23, 0xb1, 0xdd, 0xd4, 0xb1, 0x41, 0xb9,
-	0x41, 0x91, 0x0b, 0xb1, 0x22, 0xd7, 0x14, 0x6b, 0x6a, 0x57, 0xe0, 0x52, 0x7e, 0xa4, 0x41, 0x81,
-	0x1e, 0x0b, 0xf4, 0xd0, 0x0f, 0x50, 0xa0, 0xf7, 0x7e, 0x83, 0xf6, 0xdc, 0x43, 0x7a, 0xcb, 0xb1,
-	0xa7, 0xa2, 0xb0, 0x4f, 0xfd, 0x16, 0x05, 0x77, 0x97, 0xab, 0xa7, 0x29, 0x29, 0x45, 0x4f, 0x12,
-	0x67, 0x7f, 0xbf, 0x99, 0xdf, 0xec, 0xce, 0xce, 0x90, 0x70, 0xeb, 0x25, 0x8e, 0x90, 0x53, 0x47,
-	0x3e, 0x29, 0xf3, 0x7f, 0x34, 0xc4, 0x65, 0x5a, 0x63, 0x38, 0x3c, 0xc4, 0x61, 0x39, 0x3a, 0x2e,
-	0x35, 0x43, 0x1a, 0x51, 0x7d, 0x51, 0xa1, 0x4a, 0x09, 0xaa, 0x94, 0xa0, 0x8c, 0x79, 0x8f, 0x7a,
-	0x94, 0xe3, 0xca, 0xf1, 0x3f, 0x41, 0x31, 0xee, 0xa4, 0x39, 0xae, 0x05, 0xa8, 0x81, 0x25, 0xb0,
-	0x92, 0x06, 0x74, 0x42, 0xca, 0x18, 0x5f, 0xb4, 0xf7, 0x03, 0xe4, 0x31, 0xc9, 0xb9, 0x97, 0xc6,
-	0x49, 0xfe, 0x48, 0x6c, 0x31, 0x0d, 0xdb, 0x44, 0x21, 0x6a, 0x24, 0x5e, 0x1f, 0xa6, 0x22, 0x31,
-	0x71, 0x7d, 0xe2, 0xd9, 0x84, 0x12, 0x07, 0x27, 0x8c, 0xdb, 0xa9, 0xbb, 0xc7, 0xd2, 0xe4, 0x36,
-	0x0f, 0xbc, 0x32, 0x37, 0x31, 0xf9, 0x33, 0x04, 0xdb, 0x0c, 0x29, 0xdd, 0x67, 0xf2, 0x47, 0x60,
-	0xcd, 0xbf, 0x35, 0xb8, 0xb2, 0xcd, 0xbc, 0x2f, 0x9a, 0x2e, 0x8a, 0xf0, 0x8e, 0x8c, 0xab, 0xe7,
-	0xe1, 0xb2, 0x13, 0x62, 0x14, 0xd1, 0x30, 0xaf, 0xdd, 0xd4, 0x8a, 0xd3, 0x56, 0xf2, 0xa8, 0x3f,
-	0x84, 0x79, 0x1a, 0xb8, 0x76, 0xa2, 0xd0, 0x46, 0xae, 0x1b, 0x62, 0xc6, 0xf2, 0xff, 0xe3, 0x30,
-	0x9d, 0x06, 0x6e, 0xe2, 0x64, 0x45, 0xac, 0xc4, 0x0c, 0x82, 0x8f, 0xfa, 0x19, 0x93, 0x82, 0x41,
-	0xf0, 0x51, 0x2f, 0xe3, 0x39, 0x64, 0x5b, 0x5c, 0x8f, 0x1d, 0x62, 0xc4, 0x28, 0xc9, 0x5f, 0xb8,
-	0xa9, 0x15, 0x73, 0x95, 0xe5, 0x52, 0x4a, 0x09, 0x95, 0x12, 0x27, 0x22, 0x13, 0x8b, 0x13, 0xad,
-	0x99, 0x56, 0xc7, 0x93, 0xb9, 0x08, 0x0b, 0x7d, 0xa9, 0x5a, 0x98, 0x35, 0x29, 0x61, 0xd8, 0xfc,
-	0x5d, 0x03, 0x7d, 0x9b, 0x79, 0xcf, 0x69, 0x84, 0x57, 0x03, 0xea, 0x1c, 0x6c, 0x62, 0xe4, 0xa6,
-	0xee, 0xc4, 0x02, 0x4c, 0x89, 0xaa, 0xf2, 0x5d, 0x9e, 0xfd, 0xa4, 0x75, 0x99, 0x3f, 0x6f, 0xb9,
-	0xfa, 0x0d, 0x80, 0x5a, 0xec, 0xc3, 0xae, 0x23, 0x56, 0xe7, 0x89, 0xce, 0x58, 0xd3, 0xdc, 0xb2,
-	0x89, 0x58, 0x5d, 0xbf, 0x06, 0x97, 0xea, 0xd8, 0xf7, 0xea, 0x11, 0x4f, 0x6c, 0xd2, 0x92, 0x4f,
-	0xfa, 0x46, 0x6c, 0x8f, 0xa3, 0xe6, 0x2f, 0xde, 0xd4, 0x8a, 0x99, 0xca, 0xdd, 0x41, 0x09, 0x37,
-	0x0f, 0xbc, 0x92, 0x3c, 0x41, 0x21, 0x71, 0x1d, 0x45, 0x68, 0xf5, 0xc2, 0xeb, 0x3f, 0xdf, 0x9d,
-	0xb0, 0x24, 0xdd, 0xfc, 0x0a, 0x8c, 0xfe, 0x54, 0x92, 0x4c, 0xf5, 0xdb, 0x90, 0xab, 0xa1, 0x20,
-	0xa0, 0x91, 0xcd, 0x53, 0xc1, 0x2e, 0xcf, 0x6c, 0xca, 0xca, 0x0a, 0xeb, 0x9a, 0x30, 0xc6, 0xb0,
-	0x43, 0x1a, 0x61, 0x7b, 0xdf, 0x27, 0x28, 0xf0, 0x5f, 0x62, 0x91, 0xe5, 0x94, 0x95, 0x8d, 0xad,
-	0x4f, 0x12, 0xa3, 0xf9, 0x0a, 0xe6, 0xd5, 0xa6, 0xae, 0xc5, 0x52, 0x77, 0xf9, 0x7d, 0x48, 0xd9,
-	0xb8, 0xcf, 0x20, 0xe3, 0xb4, 0x81, 0xdc, 0x6b, 0xa6, 0x52, 0x4c, 0x3d, 0xdc, 0x0e, 0xc7, 0x56,
-	0x27, 0xd9, 0x2c, 0xc0, 0x3b, 0x83, 0xa2, 0xab, 0x53, 0x7d, 0xca, 0xd5, 0x59, 0xb8, 0x41, 0x0f,
-	0x47, 0x54, 0x77, 0xfe, 0xb1, 0xca, 0x60, 0x7d, 0xce, 0x54, 0xb0, 0xdf, 0x34, 0xc8, 0x6d, 0x33,
-	0x6f, 0xc5, 0x75, 0x47, 0xb8, 0x48, 0x77, 0x61, 0xee, 0x9c, 0x4b, 0x34, 0x4b, 0x7b, 0xee, 0xc3,
-	0x27, 0xb0, 0xc0, 0xb7, 0x24, 0xf0, 0x31, 0x89, 0x6c, 0x2f, 0x44, 0x24, 0xc2, 0xd8, 0x6e, 0xb6,
-	0x6a, 0x07, 0xf8, 0x44, 0x5e, 0xa3, 0xeb, 0x6d, 0xc0, 0x86, 0x58, 0xdf, 0xe5, 0xcb, 0xfa, 0x32,
-	0x5c, 0x45, 0xae, 0x6b, 0x13, 0xea, 0x62, 0x1b, 0x39, 0x0e, 0x6d, 0x91, 0xc8, 0xa6, 0x24, 0x38,
-	0xe1, 0xa5, 0x37, 0x65, 0xe9, 0xc8, 0x75, 0x9f, 0x51, 0x17, 0xaf, 0x88, 0xa5, 0x1d, 0x12, 0x9c,
-	0x98, 0x79, 0xb8, 0xd6, 0x9d, 0x85, 0x4a, 0xf0, 0x7b, 0x0d, 0x66, 0x54, 0x61, 0xa1, 0x06, 0x7e,
-	0xbb, 0xdb, 0xb1, 0x11, 0xdf, 0x0e, 0xd4, 0xc0, 0xb6, 0x4f, 0xf6, 0x29, 0xd7, 0x9f, 0xa9, 0x98,
-	0xa9, 0xc7, 0xcf, 0x83, 0xc9, 0x1a, 0x9f, 0xe6, 0xdc, 0x2d, 0xb2, 0x4f, 0xcd, 0x6b, 0xfc, 0x70,
-	0x95, 0x1a, 0x25, 0x73, 0x05, 0x66, 0x55, 0x51, 0x3c, 0xc5, 0x27, 0x1e, 0x26, 0x29, 0x42, 0xe7,
-	0xe1, 0x22, 0xbf, 0x99, 0x52, 0xa5, 0x78, 0x30, 0x17, 0xe0, 0x7a, 0x8f, 0x0b, 0xe5, 0xfd, 0x47,
-	0x0d, 0xfe, 0xcf, 0xcb, 0x80, 0xe1, 0x88, 0x57, 0xc1, 0x33, 0xde, 0xce, 0xdf, 0x6e, 0x2f, 0x96,
-	0x60, 0x56, 0x2c, 0xf1, 0x99, 0x60, 0x07, 0xf4, 0x88, 0x6f, 0xc8, 0xa4, 0x95, 0x75, 0x94, 0xeb,
-	0xcf, 0xe9, 0x91, 0x5e, 0x84, 0xb9, 0x4e, 0x5c, 0xdd, 0xf7, 0xea, 0xb2, 0x79, 0xe4, 0xda, 0xc0,
-	0x4d, 0xdf, 0xab, 0x9b, 0x37, 0x60, 0x71, 0x80, 0x3a, 0xa5, 0xfe, 0x17, 0x0d, 0x40, 0x6e, 0xda,
-	0x5e, 0xb5, 0x9a, 0x22, 0xfa, 0x06, 0x40, 0xc4, 0x58, 0x52, 0x65, 0xa2, 0x32, 0xa7, 0x23, 0xc6,
-	0x64, 0x5d, 0x3d, 0x00, 0xfd, 0x80, 0xef, 0x8b, 0x1d, 0x1f, 0x97, 0x2d, 0xfb, 0x99, 0xd0, 0x3e,
-	0x27, 0x56, 0x5e, 0xe0, 0x08, 0x6d, 0x8a, 0xce, 0xb6, 0x0e, 0x97, 0x58, 0x84, 0xa2, 0x16, 0x93,
-	0xad, 0xfc, 0xc1, 0x79, 0x9d, 0x4d, 0xce, 0x31, 0x0b, 0x3b, 0xd8, 0x3f, 0xc4, 0x55, 0xce, 0xb1,
-	0x24, 0xd7, 0xfc, 0xae, 0xdd, 0xa2, 0xf7, 0xaa, 0xd5, 0xff, 0xa6, 0x9f, 0xc5, 0x30, 0x99, 0x18,
-	0x6b, 0x39, 0x4e, 0x32, 0xa8, 0xa6, 0xac, 0xac, 0xb0, 0x56, 0x85, 0xd1, 0x3c, 0x82, 0xec, 0x36,
-	0xf3, 0x1e, 0x13, 0x54, 0x0b, 0xf0, 0xda, 0xda, 0xde, 0x97, 0x29, 0x3b, 0x79, 0x0b, 0xb2, 0x98,
-	0xe3, 0xb6, 0x48, 0x8d, 0xb6, 0x88, 0x8a, 0xdb, 0x65, 0xd4, 0x97, 0x20, 0x27, 0x0c, 0x3b, 0xad,
-	0x48, 0xc0, 0x44, 0xdc, 0x1e, 0xab, 0x79, 0x1d, 0xae, 0x76, 0x05, 0x56, 0x27, 0xfb, 0x8a, 0x37,
-	0x9f, 0x75, 0x9f, 0x8d, 0x20, 0x69, 0x09, 0x72, 0xae, 0x00, 0x76, 0x6b, 0xea, 0xb1, 0xea, 0x45,
-	0x98, 0x95, 0x96, 0x1e, 0x55, 0xbd, 0x66, 0xd9, 0x34, 0x3a, 0xa2, 0x2b, 0x5d, 0x3f, 0x6b, 0x50,
-	0x50, 0x77, 0x69, 0x03, 0xb1, 0xdd, 0xd0, 0x77, 0xf0, 0x16, 0x89, 0xa5, 0x30, 0xfc, 0x24, 0x7e,
-	0x23, 0x4b, 0x11, 0x4a, 0xe0, 0xaa, 0x37, 0x88, 0x22, 0xa7, 0x46, 0x25, 0xb5, 0x6d, 0x0c, 0x0c,
-	0x26, 0xdb, 0xc8, 0x60, 0xb7, 0x66, 0x11, 0x96, 0xd2, 0xb5, 0x26, 0x69, 0x55, 0x7e, 0xcd, 0xc0,
-	0xe4, 0x36, 0xf3, 0x74, 0x0a, 0x99, 0xce, 0x86, 0x7f, 0x3f, 0x55, 0x51, 0x77, 0x5f, 0x35, 0x1e,
-	0x8d, 0x01, 0x56, 0xe5, 0x7e, 0x0c, 0xb9, 0x9e, 0xb7, 0xb5, 0xd2, 0x30, 0x37, 0xdd, 0x78, 0xe3,
-	0x83, 0xf1, 0xf0, 0x2a, 0xf2, 0xb7, 0x1a, 0x5c, 0xe9, 0x1f, 0xf4, 0xcb, 0xa3, 0x79, 0xeb, 0xa0,
-	0x18, 0x1f, 0x8f, 0x4d, 0xe9, 0xd2, 0xd0, 0x3f, 0xce, 0x87, 0x6a, 0xe8, 0xa3, 0x0c, 0xd7, 0x70,
-	0xee, 0x9c, 0xd7, 0x7d, 0x98, 0x6e, 0x8f, 0xc0, 0xbb, 0xc3, 0xfc, 0x28, 0xa8, 0xb1, 0x3c, 0x32,
-	0x54, 0x85, 0x0a, 0x61, 0xa6, 0x6b, 0x8e, 0x3d, 0x18, 0x6d, 0xe7, 0x04, 0xda, 0x78, 0x7f, 0x1c,
-	0xb4, 0x8a, 0xf9, 0x35, 0xcc, 0xf6, 0xbe, 0x05, 0x97, 0x47, 0x53, 0xae, 0x08, 0xc6, 0x87, 0x63,
-	0x12, 0x54, 0xf0, 0x6f, 0x60, 0xae, 0x6f, 0xb2, 0x3e, 0x1c, 0x7e, 0x54, 0xdd, 0x0c, 0xe3, 0xa3,
-	0x71, 0x19, 0x2a, 0xbe, 0x03, 0x97, 0x93, 0xd9, 0x78, 0x67, 0x94, 0x1c, 0xf6, 0xaa, 0x55, 0xa3,
-	0x3c, 0x22, 0x50, 0x05, 0x09, 0x00, 0x3a, 0x26, 0xc7, 0xbd, 0x61, 0xf4, 0x36, 0xd6, 0xa8, 0x8c,
-	0x8e, 0x55, 0xd1, 0x28, 0x64, 0x3a, 0xa7, 0xc2, 0xd0, 0x0e, 0xd5, 0x01, 0x1e, 0xde, 0xa1, 0x06,
-	0x74, 0x7c, 0xfd, 0x27, 0x0d, 0x16, 0xd3, 0xda, 0xfd, 0xa7, 0xa3, 0x95, 0xe5, 0x40, 0xb2, 0xb1,
-	0xf6, 0x2f, 0xc8, 0x89, 0xc2, 0xd5, 0xc7, 0xaf, 0x4f, 0x0b, 0xda, 0x9b, 0xd3, 0x82, 0xf6, 0xd7,
-	0x69, 0x41, 0xfb, 0xe1, 0xac, 0x30, 0xf1, 0xe6, 0xac, 0x30, 0xf1, 0xc7, 0x59, 0x61, 0xe2, 0xc5,
-	0x7d, 0xcf, 0x8f, 0xea, 0xad, 0x5a, 0xc9, 0xa1, 0x0d, 0xfe, 0xf1, 0xfc, 0x9e, 0xf8, 0x8e, 0x8e,
-	0xdf, 0x9f, 0xcb, 0xc7, 0x1d, 0x1f, 0xe6, 0x27, 0x4d, 0xcc, 0x6a, 0x97, 0xf8, 0x37, 0xf4, 0xa3,
-	0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x65, 0x57, 0x89, 0x92, 0x02, 0x11, 0x00, 0x00,
+	// 1505 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0x4f, 0x6f, 0xdb, 0xc6,
+	0x12, 0x37, 0x9f, 0xf2, 0xc7, 0x1e, 0x5b, 0x92, 0xc3, 0xe7, 0x24, 0x36, 0xfd, 0xa2, 0xe4, 0xf1,
+	0x25, 0xb6, 0x9c, 0xe4, 0x49, 0xb6, 0xfc, 0x90, 0xd7, 0xb4, 0xbd, 0x38, 0x4e, 0x62, 0xbb, 0x89,
+	0x13, 0x83, 0x72, 0x82, 0x20, 0x17, 0x82, 0x22, 0xd7, 0x14, 0x63, 0x7a, 0x57, 0xe0, 0x52, 0x76,
+	0x9c, 0x06, 0x05, 0x7a, 0x6c, 0x51, 0xa0, 0xfd, 0x00, 0x2d, 0x7a, 0xef, 0x37, 0x68, 0xcf, 0x3d,
+	0xa4, 0xb7, 0x1c, 0x7b, 0x2a, 0x8a, 0xe4, 0xd4, 0x6f, 0x51, 0x70, 0x77, 0xb9, 0x26, 0x25, 0x99,
+	0x92, 0xd2, 0x3f, 0x27, 0x89, 0xb3, 0xbf, 0x99, 0xf9, 0xcd, 0xec, 0xec, 0xec, 0x90, 0x70, 0xf9,
+	0x05, 0x0a, 0x2d, 0xbb, 0x69, 0x79, 0xb8, 0xca, 0xfe, 0x91, 0x00, 0x55, 0x49, 0x83, 0xa2, 0x60,
+	0x1f, 0x05, 0xd5, 0xf0, 0x79, 0xa5, 0x15, 0x90, 0x90, 0xa8, 0xb3, 0x12, 0x55, 0x89, 0x51, 0x95,
+	0x18, 0xa5, 0x4d, 0xb9, 0xc4, 0x25, 0x0c, 0x57, 0x8d, 0xfe, 0x71, 0x15, 0x6d, 0x3e, 0xcb, 0x70,
+	0xc3, 0xb7, 0xf6, 0x90, 0x00, 0xd6, 0xb2, 0x80, 0x76, 0x40, 0x28, 0x65, 0x8b, 0xe6, 0x8e, 0x6f,
+	0xb9, 0x54, 0xe8, 0x5c, 0xcd, 0xd2, 0xf1, 0xbd, 0x7d, 0x84, 0x11, 0x1d, 0x08, 0x1b, 0xff, 0x11,
+	0xd8, 0x72, 0x16, 0xb6, 0x65, 0x05, 0xd6, 0x5e, 0x6c, 0x75, 0x31, 0x13, 0x89, 0xb0, 0xe3, 0x61,
+	0xd7, 0xc4, 0x04, 0xdb, 0x28, 0xd6, 0xb8, 0x92, 0x99, 0xe9, 0x4c, 0xba, 0xad, 0x5d, 0xb7, 0xca,
+	0x44, 0x54, 0xfc, 0xf4, 0xc1, 0xb6, 0x02, 0x42, 0x76, 0xa8, 0xf8, 0xe1, 0x58, 0xfd, 0x37, 0x05,
+	0xce, 0x6c, 0x52, 0xf7, 0x51, 0xcb, 0xb1, 0x42, 0xf4, 0x50, 0xf8, 0x55, 0xa7, 0xe1, 0xb4, 0x1d,
+	0x20, 0x2b, 0x24, 0xc1, 0xb4, 0x72, 0x49, 0x29, 0x8f, 0x19, 0xf1, 0xa3, 0xba, 0x08, 0x53, 0xc4,
+	0x77, 0xcc, 0x98, 0xa1, 0x69, 0x39, 0x4e, 0x80, 0x28, 0x9d, 0xfe, 0x07, 0x83, 0xa9, 0xc4, 0x77,
+	0x62, 0x23, 0x2b, 0x7c, 0x25, 0xd2, 0xc0, 0xe8, 0xa0, 0x5b, 0x23, 0xc7, 0x35, 0x30, 0x3a, 0xe8,
+	0xd4, 0x78, 0x0c, 0xf9, 0x36, 0xe3, 0x63, 0x06, 0xc8, 0xa2, 0x04, 0x4f, 0x9f, 0xb8, 0xa4, 0x94,
+	0x0b, 0xb5, 0xa5, 0x4a, 0x46, 0xb9, 0x55, 0x62, 0x23, 0x3c, 0x12, 0x83, 0x29, 0x1a, 0x13, 0xed,
+	0xc4, 0x93, 0x3e, 0x0b, 0x33, 0x5d, 0xa1, 0x1a, 0x88, 0xb6, 0x08, 0xa6, 0x48, 0xff, 0x49, 0x01,
+	0x75, 0x93, 0xba, 0x8f, 0x49, 0x88, 0x6e, 0xf9, 0xc4, 0xde, 0x5d, 0x47, 0x96, 0x93, 0x99, 0x89,
+	0x19, 0x18, 0xe5, 0x15, 0xe8, 0x39, 0x2c, 0xfa, 0x9c, 0x71, 0x9a, 0x3d, 0x6f, 0x38, 0xea, 0x05,
+	0x80, 0x46, 0x64, 0xc3, 0x6c, 0x5a, 0xb4, 0xc9, 0x02, 0x9d, 0x30, 0xc6, 0x98, 0x64, 0xdd, 0xa2,
+	0x4d, 0xf5, 0x1c, 0x9c, 0x6a, 0x22, 0xcf, 0x6d, 0x86, 0x2c, 0xb0, 0x9c, 0x21, 0x9e, 0xd4, 0xb5,
+	0x48, 0x1e, 0x79, 0x9d, 0x3e, 0x79, 0x49, 0x29, 0x8f, 0xd7, 0x16, 0x7a, 0x05, 0xdc, 0xda, 0x75,
+	0x2b, 0x62, 0x07, 0x39, 0xc5, 0xdb, 0x56, 0x68, 0xdd, 0x3a, 0xf1, 0xea, 0x97, 0x8b, 0x23, 0x86,
+	0x50, 0xd7, 0x9f, 0x81, 0xd6, 0x1d, 0x4a, 0x1c, 0xa9, 0x7a, 0x05, 0x0a, 0x0d, 0xcb, 0xf7, 0x49,
+	0x68, 0xb2, 0x50, 0x90, 0xc3, 0x22, 0x1b, 0x35, 0xf2, 0x5c, 0xba, 0xca, 0x85, 0x11, 0x6c, 0x9f,
+	0x84, 0xc8, 0xdc, 0xf1, 0xb0, 0xe5, 0x7b, 0x2f, 0x10, 0x8f, 0x72, 0xd4, 0xc8, 0x47, 0xd2, 0xbb,
+	0xb1, 0x50, 0x7f, 0x09, 0x53, 0x32, 0xa9, 0xab, 0x11, 0xd5, 0x2d, 0x76, 0x1e, 0x32, 0x12, 0xf7,
+	0x11, 0x8c, 0xdb, 0x47, 0x40, 0x66, 0x75, 0xbc, 0x56, 0xce, 0xdc, 0xdc, 0x84, 0x61, 0x23, 0xa9,
+	0xac, 0x97, 0xe0, 0x5f, 0xbd, 0xbc, 0xcb, 0x5d, 0xbd, 0xc7, 0xd8, 0x19, 0x68, 0x8f, 0xec, 0x0f,
+	0xc8, 0xee, 0xf8, 0x6d, 0x15, 0xce, 0xba, 0x8c, 0x49, 0x67, 0x3f, 0x2a, 0x50, 0xd8, 0xa4, 0xee,
+	0x8a, 0xe3, 0x0c, 0x70, 0x90, 0x16, 0x60, 0xf2, 0x98, 0x43, 0x54, 0x24, 0x1d, 0xe7, 0xe1, 0x7d,
+	0x98, 0x61, 0x29, 0xf1, 0x3d, 0x84, 0x43, 0xd3, 0x0d, 0x2c, 0x1c, 0x22, 0x64, 0xb6, 0xda, 0x8d,
+	0x5d, 0x74, 0x28, 0x8e, 0xd1, 0xf9, 0x23, 0xc0, 0x1a, 0x5f, 0xdf, 0x62, 0xcb, 0xea, 0x12, 0x9c,
+	0xb5, 0x1c, 0xc7, 0xc4, 0xc4, 0x41, 0xa6, 0x65, 0xdb, 0xa4, 0x8d, 0x43, 0x93, 0x60, 0xff, 0x90,
+	0x95, 0xde, 0xa8, 0xa1, 0x5a, 0x8e, 0xf3, 0x80, 0x38, 0x68, 0x85, 0x2f, 0x3d, 0xc4, 0xfe, 0xa1,
+	0x3e, 0x0d, 0xe7, 0xd2, 0x51, 0xc8, 0x00, 0xbf, 0x50, 0x60, 0x42, 0x16, 0x96, 0xb5, 0x87, 0xde,
+	0xed, 0x74, 0xac, 0x45, 0xa7, 0xc3, 0xda, 0x43, 0xa6, 0x87, 0x77, 0x08, 0xe3, 0x3f, 0x5e, 0xd3,
+	0x33, 0xb7, 0x9f, 0x39, 0x13, 0x35, 0x3e, 0xc6, 0x74, 0x37, 0xf0, 0x0e, 0xd1, 0xcf, 0xc1, 0x54,
+	0x92, 0x8d, 0xa4, 0xb9, 0x02, 0x45, 0x59, 0x14, 0xf7, 0xd0, 0xa1, 0x8b, 0x70, 0x06, 0xd1, 0x29,
+	0x38, 0xc9, 0x4e, 0xa6, 0x60, 0xc9, 0x1f, 0xf4, 0x19, 0x38, 0xdf, 0x61, 0x42, 0x5a, 0xff, 0x5a,
+	0x81, 0x7f, 0xb2, 0x32, 0xa0, 0x28, 0x64, 0x55, 0xf0, 0x80, 0xb5, 0xf3, 0x77, 0xcb, 0xc5, 0x1c,
+	0x14, 0xf9, 0x12, 0xbb, 0x13, 0x4c, 0x9f, 0x1c, 0xb0, 0x84, 0xe4, 0x8c, 0xbc, 0x2d, 0x4d, 0xdf,
+	0x27, 0x07, 0x6a, 0x19, 0x26, 0x93, 0xb8, 0xa6, 0xe7, 0x36, 0x45, 0xf3, 0x28, 0x1c, 0x01, 0xd7,
+	0x3d, 0xb7, 0xa9, 0x5f, 0x80, 0xd9, 0x1e, 0xec, 0x24, 0xfb, 0xef, 0x15, 0x00, 0x91, 0xb4, 0xed,
+	0x7a, 0x3d, 0x83, 0xf4, 0x05, 0x80, 0x90, 0xd2, 0xb8, 0xca, 0x78, 0x65, 0x8e, 0x85, 0x94, 0x8a,
+	0xba, 0xba, 0x0e, 0xea, 0x2e, 0xcb, 0x8b, 0x19, 0x6d, 0x97, 0x29, 0xfa, 0x19, 0xe7, 0x3e, 0xc9,
+	0x57, 0x9e, 0xa2, 0xd0, 0x5a, 0x67, 0x72, 0xf5, 0x36, 0x9c, 0xa2, 0xa1, 0x15, 0xb6, 0xa9, 0x68,
+	0xe5, 0xd7, 0x8f, 0xeb, 0x6c, 0xe2, 0x1e, 0x33, 0x90, 0x8d, 0xbc, 0x7d, 0x54, 0x67, 0x3a, 0x86,
+	0xd0, 0xd5, 0x3f, 0x3b, 0x6a, 0xd1, 0xdb, 0xf5, 0xfa, 0x5f, 0xd3, 0xcf, 0x22, 0x98, 0x08, 0x8c,
+	0xb6, 0x6d, 0x3b, 0xbe, 0xa8, 0x46, 0x8d, 0x3c, 0x97, 0xd6, 0xb9, 0x50, 0x3f, 0x80, 0xfc, 0x26,
+	0x75, 0xef, 0x60, 0xab, 0xe1, 0xa3, 0xd5, 0xd5, 0xed, 0x27, 0x19, 0x99, 0xbc, 0x0c, 0x79, 0xc4,
+	0x70, 0x1b, 0xb8, 0x41, 0xda, 0x58, 0xfa, 0x4d, 0x09, 0xd5, 0x39, 0x28, 0x70, 0xc1, 0xc3, 0x76,
+	0xc8, 0x61, 0xdc, 0x6f, 0x87, 0x54, 0x3f, 0x0f, 0x67, 0x53, 0x8e, 0xe5, 0xce, 0xbe, 0x64, 0xcd,
+	0xe7, 0xb6, 0x47, 0x07, 0xa0, 0x34, 0x07, 0x05, 0xc7, 0xa3, 0x09, 0xf7, 0x82, 0x53, 0x87, 0x54,
+	0x2d, 0x43, 0x51, 0x48, 0x3a, 0x58, 0x75, 0x8a, 0x45, 0xd3, 0x48, 0x78, 0x97, 0xbc, 0xbe, 0x53,
+	0xa0, 0x24, 0xcf, 0xd2, 0x9a, 0x45, 0xb7, 0x02, 0xcf, 0x46, 0x1b, 0x38, 0xa2, 0x42, 0xd1, 0xdd,
+	0x68, 0x7a, 0xcb, 0x20, 0x8a, 0xe1, 0xac, 0xdb, 0x4b, 0x45, 0xdc, 0x1a, 0xb5, 0xcc, 0xb6, 0xd1,
+	0xd3, 0x99, 0x68, 0x23, 0xbd, 0xcd, 0xea, 0x65, 0x98, 0xcb, 0xe6, 0x2a, 0xc3, 0xfa, 0x81, 0xf7,
+	0xc2, 0x2d, 0xab, 0x4d, 0xfb, 0x65, 0x7b, 0x16, 0xc6, 0xe2, 0xf3, 0x1f, 0x11, 0xcf, 0x95, 0x73,
+	0xc6, 0xa8, 0x68, 0x00, 0x54, 0x9d, 0x87, 0xe2, 0x8b, 0xc0, 0xae, 0x2d, 0xc6, 0x97, 0x00, 0x8a,
+	0x0a, 0x2e, 0x57, 0x1e, 0x33, 0x0a, 0x4c, 0xbc, 0x12, 0x4b, 0xd5, 0xff, 0x40, 0xbe, 0x15, 0x39,
+	0x33, 0x3d, 0xb1, 0x65, 0xbc, 0x83, 0x4f, 0x30, 0x61, 0xbc, 0x61, 0x57, 0xa0, 0xc0, 0x41, 0x24,
+	0xde, 0xaf, 0x93, 0xbc, 0xd8, 0x98, 0x54, 0xee, 0x16, 0xef, 0x9c, 0x92, 0x7b, 0xe7, 0x0d, 0xf6,
+	0x08, 0xb7, 0xfe, 0xb6, 0xb0, 0xe6, 0xa1, 0xd8, 0xc6, 0xbd, 0x02, 0x2b, 0xb4, 0x71, 0x2a, 0xb4,
+	0x05, 0x98, 0x6c, 0xe3, 0x9e, 0xc1, 0xc5, 0x06, 0x3a, 0x8a, 0x31, 0x11, 0x85, 0x0c, 0xf0, 0x4b,
+	0x25, 0xd1, 0xd8, 0xef, 0x8b, 0x37, 0x82, 0xbe, 0x33, 0xc1, 0x53, 0x28, 0xc6, 0x6f, 0x0f, 0x66,
+	0x2b, 0x39, 0xb5, 0x5c, 0xcb, 0xac, 0xbf, 0xb4, 0x7d, 0x51, 0x78, 0x05, 0x3f, 0x25, 0xd5, 0xff,
+	0x0d, 0x17, 0x8f, 0x21, 0x24, 0x49, 0x3f, 0xe1, 0x23, 0x3a, 0x7e, 0x66, 0x79, 0xfe, 0x9f, 0x3a,
+	0x59, 0xc4, 0x13, 0x71, 0xca, 0x72, 0xec, 0xb6, 0xf6, 0x4d, 0x11, 0x72, 0x9b, 0xd4, 0x55, 0x09,
+	0x8c, 0x27, 0x47, 0x9a, 0xec, 0x98, 0xd3, 0x93, 0x83, 0xb6, 0x3c, 0x04, 0x58, 0x36, 0xf4, 0xe7,
+	0x50, 0xe8, 0x78, 0x1f, 0xa9, 0xf4, 0x33, 0x93, 0xc6, 0x6b, 0x37, 0x86, 0xc3, 0x4b, 0xcf, 0x9f,
+	0x2a, 0x70, 0xa6, 0x7b, 0x94, 0x5d, 0x1a, 0xcc, 0x5a, 0x42, 0x45, 0xbb, 0x39, 0xb4, 0x4a, 0x8a,
+	0x43, 0xf7, 0xc0, 0xda, 0x97, 0x43, 0x97, 0x8a, 0x76, 0x73, 0x68, 0x15, 0xc9, 0xc1, 0x83, 0xb1,
+	0xa3, 0x21, 0x6f, 0xa1, 0x9f, 0x1d, 0x09, 0xd5, 0x96, 0x06, 0x86, 0x4a, 0x57, 0x01, 0x4c, 0xa4,
+	0x26, 0xb5, 0xeb, 0x83, 0x65, 0x8e, 0xa3, 0xb5, 0xff, 0x0d, 0x83, 0x96, 0x3e, 0x3f, 0x86, 0x62,
+	0xe7, 0x7b, 0x5e, 0x75, 0x30, 0xe6, 0x52, 0x41, 0xfb, 0xff, 0x90, 0x0a, 0xd2, 0xf9, 0x27, 0x30,
+	0xd9, 0x35, 0x3b, 0x2e, 0xf6, 0xdf, 0xaa, 0xb4, 0x86, 0xf6, 0xde, 0xb0, 0x1a, 0xd2, 0xbf, 0x0d,
+	0xa7, 0xe3, 0xe9, 0x6f, 0x7e, 0x90, 0x18, 0xb6, 0xeb, 0x75, 0xad, 0x3a, 0x20, 0x50, 0x3a, 0xf1,
+	0x01, 0x12, 0xb3, 0xd1, 0xd5, 0x7e, 0xea, 0x47, 0x58, 0xad, 0x36, 0x38, 0x56, 0x7a, 0x23, 0x30,
+	0x9e, 0x9c, 0x7b, 0xfa, 0x76, 0xa8, 0x04, 0x58, 0x5b, 0x1e, 0x02, 0x2c, 0x1d, 0x7e, 0xab, 0xc0,
+	0x6c, 0xd6, 0x40, 0xf3, 0xc1, 0x60, 0x65, 0xd9, 0x53, 0x59, 0x5b, 0xfd, 0x03, 0xca, 0xc9, 0x13,
+	0x7c, 0x34, 0x9a, 0xf4, 0x3d, 0xc1, 0x12, 0xaa, 0x2d, 0x0d, 0x0c, 0x4d, 0x66, 0x3f, 0x39, 0x30,
+	0xf4, 0xcd, 0x7e, 0x02, 0xac, 0x2d, 0x0f, 0x01, 0x96, 0x0e, 0x3f, 0x57, 0x60, 0xaa, 0xe7, 0x0d,
+	0x3e, 0x60, 0x37, 0x48, 0x6b, 0x69, 0x1f, 0xbe, 0x8b, 0x56, 0xea, 0xb2, 0x4a, 0xdf, 0xcc, 0xfd,
+	0x2f, 0xab, 0x14, 0x5e, 0xbb, 0x31, 0x1c, 0x3e, 0xf6, 0x7c, 0xeb, 0xce, 0xab, 0x37, 0x25, 0xe5,
+	0xf5, 0x9b, 0x92, 0xf2, 0xeb, 0x9b, 0x92, 0xf2, 0xd5, 0xdb, 0xd2, 0xc8, 0xeb, 0xb7, 0xa5, 0x91,
+	0x9f, 0xdf, 0x96, 0x46, 0x9e, 0x5e, 0x73, 0xbd, 0xb0, 0xd9, 0x6e, 0x54, 0x6c, 0xb2, 0xc7, 0xbe,
+	0x00, 0xfe, 0x97, 0x7f, 0x0c, 0xc4, 0xc4, 0x41, 0xd5, 0xe7, 0x89, 0xaf, 0x8b, 0x87, 0x2d, 0x44,
+	0x1b, 0xa7, 0xd8, 0x87, 0xc0, 0xe5, 0xdf, 0x07, 0x00, 0x1b, 0x70, 0x91, 0x6d, 0xf3, 0x15, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1378,6 +1802,10 @@ type MsgClient interface {
 	EnableCCTX(ctx context.Context, in *MsgEnableCCTX, opts ...grpc.CallOption) (*MsgEnableCCTXResponse, error)
 	DisableCCTX(ctx context.Context, in *MsgDisableCCTX, opts ...grpc.CallOption) (*MsgDisableCCTXResponse, error)
 	UpdateGasPriceIncreaseFlags(ctx context.Context, in *MsgUpdateGasPriceIncreaseFlags, opts ...grpc.CallOption) (*MsgUpdateGasPriceIncreaseFlagsResponse, error)
+	PauseCCTX(ctx context.Context, in *MsgPauseCCTX, opts ...grpc.CallOption) (*MsgPauseCCTXResponse, error)
+	UnpauseCCTX(ctx context.Context, in *MsgUnpauseCCTX, opts ...grpc.CallOption) (*MsgUnpauseCCTXResponse, error)
+	UpdateLivenessParams(ctx context.Context, in *MsgUpdateLivenessParams, opts ...grpc.CallOption) (*MsgUpdateLivenessParamsResponse, error)
+	UnjailObserver(ctx context.Context, in *MsgUnjailObserver, opts ...grpc.CallOption) (*MsgUnjailObserverResponse, error)
 }
 
 type msgClient struct {
@@ -1496,20 +1924,60 @@ func (c *msgClient) UpdateGasPriceIncreaseFlags(ctx context.Context, in *MsgUpda
 	return out, nil
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	AddObserver(context.Context, *MsgAddObserver) (*MsgAddObserverResponse, error)
-	UpdateObserver(context.Context, *MsgUpdateObserver) (*MsgUpdateObserverResponse, error)
-	UpdateChainParams(context.Context, *MsgUpdateChainParams) (*MsgUpdateChainParamsResponse, error)
-	RemoveChainParams(context.Context, *MsgRemoveChainParams) (*MsgRemoveChainParamsResponse, error)
-	VoteBlame(context.Context, *MsgVoteBlame) (*MsgVoteBlameResponse, error)
-	UpdateKeygen(context.Context, *MsgUpdateKeygen) (*MsgUpdateKeygenResponse, error)
-	VoteBlockHeader(context.Context, *MsgVoteBlockHeader) (*MsgVoteBlockHeaderResponse, error)
-	ResetChainNonces(context.Context, *MsgResetChainNonces) (*MsgResetChainNoncesResponse, error)
-	VoteTSS(context.Context, *MsgVoteTSS) (*MsgVoteTSSResponse, error)
-	EnableCCTX(context.Context, *MsgEnableCCTX) (*MsgEnableCCTXResponse, error)
-	DisableCCTX(context.Context, *MsgDisableCCTX) (*MsgDisableCCTXResponse, error)
+func (c *msgClient) PauseCCTX(ctx context.Context, in *MsgPauseCCTX, opts ...grpc.CallOption) (*MsgPauseCCTXResponse, error) {
+	out := new(MsgPauseCCTXResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Msg/PauseCCTX", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UnpauseCCTX(ctx context.Context, in *MsgUnpauseCCTX, opts ...grpc.CallOption) (*MsgUnpauseCCTXResponse, error) {
+	out := new(MsgUnpauseCCTXResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Msg/UnpauseCCTX", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateLivenessParams(ctx context.Context, in *MsgUpdateLivenessParams, opts ...grpc.CallOption) (*MsgUpdateLivenessParamsResponse, error) {
+	out := new(MsgUpdateLivenessParamsResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Msg/UpdateLivenessParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UnjailObserver(ctx context.Context, in *MsgUnjailObserver, opts ...grpc.CallOption) (*MsgUnjailObserverResponse, error) {
+	out := new(MsgUnjailObserverResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Msg/UnjailObserver", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	AddObserver(context.Context, *MsgAddObserver) (*MsgAddObserverResponse, error)
+	UpdateObserver(context.Context, *MsgUpdateObserver) (*MsgUpdateObserverResponse, error)
+	UpdateChainParams(context.Context, *MsgUpdateChainParams) (*MsgUpdateChainParamsResponse, error)
+	RemoveChainParams(context.Context, *MsgRemoveChainParams) (*MsgRemoveChainParamsResponse, error)
+	VoteBlame(context.Context, *MsgVoteBlame) (*MsgVoteBlameResponse, error)
+	UpdateKeygen(context.Context, *MsgUpdateKeygen) (*MsgUpdateKeygenResponse, error)
+	VoteBlockHeader(context.Context, *MsgVoteBlockHeader) (*MsgVoteBlockHeaderResponse, error)
+	ResetChainNonces(context.Context, *MsgResetChainNonces) (*MsgResetChainNoncesResponse, error)
+	VoteTSS(context.Context, *MsgVoteTSS) (*MsgVoteTSSResponse, error)
+	EnableCCTX(context.Context, *MsgEnableCCTX) (*MsgEnableCCTXResponse, error)
+	DisableCCTX(context.Context, *MsgDisableCCTX) (*MsgDisableCCTXResponse, error)
 	UpdateGasPriceIncreaseFlags(context.Context, *MsgUpdateGasPriceIncreaseFlags) (*MsgUpdateGasPriceIncreaseFlagsResponse, error)
+	PauseCCTX(context.Context, *MsgPauseCCTX) (*MsgPauseCCTXResponse, error)
+	UnpauseCCTX(context.Context, *MsgUnpauseCCTX) (*MsgUnpauseCCTXResponse, error)
+	UpdateLivenessParams(context.Context, *MsgUpdateLivenessParams) (*MsgUpdateLivenessParamsResponse, error)
+	UnjailObserver(context.Context, *MsgUnjailObserver) (*MsgUnjailObserverResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1552,6 +2020,18 @@ func (*UnimplementedMsgServer) DisableCCTX(ctx context.Context, req *MsgDisableC
 func (*UnimplementedMsgServer) UpdateGasPriceIncreaseFlags(ctx context.Context, req *MsgUpdateGasPriceIncreaseFlags) (*MsgUpdateGasPriceIncreaseFlagsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateGasPriceIncreaseFlags not implemented")
 }
+func (*UnimplementedMsgServer) PauseCCTX(ctx context.Context, req *MsgPauseCCTX) (*MsgPauseCCTXResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseCCTX not implemented")
+}
+func (*UnimplementedMsgServer) UnpauseCCTX(ctx context.Context, req *MsgUnpauseCCTX) (*MsgUnpauseCCTXResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnpauseCCTX not implemented")
+}
+func (*UnimplementedMsgServer) UpdateLivenessParams(ctx context.Context, req *MsgUpdateLivenessParams) (*MsgUpdateLivenessParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateLivenessParams not implemented")
+}
+func (*UnimplementedMsgServer) UnjailObserver(ctx context.Context, req *MsgUnjailObserver) (*MsgUnjailObserverResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnjailObserver not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1773,6 +2253,79 @@ func _Msg_UpdateGasPriceIncreaseFlags_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_PauseCCTX_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPauseCCTX)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PauseCCTX(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Msg/PauseCCTX",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PauseCCTX(ctx, req.(*MsgPauseCCTX))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnpauseCCTX_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnpauseCCTX)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnpauseCCTX(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Msg/UnpauseCCTX",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnpauseCCTX(ctx, req.(*MsgUnpauseCCTX))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateLivenessParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateLivenessParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateLivenessParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Msg/UpdateLivenessParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateLivenessParams(ctx, req.(*MsgUpdateLivenessParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnjailObserver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnjailObserver)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnjailObserver(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Msg/UnjailObserver",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnjailObserver(ctx, req.(*MsgUnjailObserver))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "zetachain.zetacore.observer.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1825,6 +2378,22 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateGasPriceIncreaseFlags",
 			Handler:    _Msg_UpdateGasPriceIncreaseFlags_Handler,
 		},
+		{
+			MethodName: "PauseCCTX",
+			Handler:    _Msg_PauseCCTX_Handler,
+		},
+		{
+			MethodName: "UnpauseCCTX",
+			Handler:    _Msg_UnpauseCCTX_Handler,
+		},
+		{
+			MethodName: "UpdateLivenessParams",
+			Handler:    _Msg_UpdateLivenessParams_Handler,
+		},
+		{
+			MethodName: "UnjailObserver",
+			Handler:    _Msg_UnjailObserver_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "zetachain/zetacore/observer/tx.proto",
@@ -2705,142 +3274,343 @@ func (m *MsgUpdateGasPriceIncreaseFlagsResponse) MarshalToSizedBuffer(dAtA []byt
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgPauseCCTX) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgUpdateObserver) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgPauseCCTX) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPauseCCTX) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.PauseOutbound {
+		i--
+		if m.PauseOutbound {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
 	}
-	l = len(m.OldObserverAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.PauseInbound {
+		i--
+		if m.PauseInbound {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
 	}
-	l = len(m.NewObserverAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Zrc20Addresses) > 0 {
+		for iNdEx := len(m.Zrc20Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Zrc20Addresses[iNdEx])
+			copy(dAtA[i:], m.Zrc20Addresses[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Zrc20Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ChainIds) > 0 {
+		dAtA6 := make([]byte, len(m.ChainIds)*10)
+		var j5 int
+		for _, num1 := range m.ChainIds {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
+			}
+			dAtA6[j5] = uint8(num)
+			j5++
+		}
+		i -= j5
+		copy(dAtA[i:], dAtA6[:j5])
+		i = encodeVarintTx(dAtA, i, uint64(j5))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.UpdateReason != 0 {
-		n += 1 + sovTx(uint64(m.UpdateReason))
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateObserverResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgPauseCCTXResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgVoteBlockHeader) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.ChainId != 0 {
-		n += 1 + sovTx(uint64(m.ChainId))
-	}
-	l = len(m.BlockHash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Height != 0 {
-		n += 1 + sovTx(uint64(m.Height))
-	}
-	l = m.Header.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+func (m *MsgPauseCCTXResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgVoteBlockHeaderResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgPauseCCTXResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.BallotCreated {
-		n += 2
-	}
-	if m.VoteFinalized {
-		n += 2
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateChainParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.ChainParams != nil {
-		l = m.ChainParams.Size()
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgUnpauseCCTX) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateChainParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgUnpauseCCTX) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRemoveChainParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgUnpauseCCTX) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.UnpauseOutbound {
+		i--
+		if m.UnpauseOutbound {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.ChainId != 0 {
-		n += 1 + sovTx(uint64(m.ChainId))
+	if m.UnpauseInbound {
+		i--
+		if m.UnpauseInbound {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
 	}
-	return n
+	if len(m.Zrc20Addresses) > 0 {
+		for iNdEx := len(m.Zrc20Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Zrc20Addresses[iNdEx])
+			copy(dAtA[i:], m.Zrc20Addresses[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Zrc20Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ChainIds) > 0 {
+		dAtA8 := make([]byte, len(m.ChainIds)*10)
+		var j7 int
+		for _, num1 := range m.ChainIds {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA8[j7] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j7++
+			}
+			dAtA8[j7] = uint8(num)
+			j7++
+		}
+		i -= j7
+		copy(dAtA[i:], dAtA8[:j7])
+		i = encodeVarintTx(dAtA, i, uint64(j7))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRemoveChainParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUnpauseCCTXResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnpauseCCTXResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnpauseCCTXResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgAddObserver) Size() (n int) {
+func (m *MsgUpdateLivenessParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateLivenessParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateLivenessParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.LivenessParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateLivenessParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateLivenessParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateLivenessParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnjailObserver) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnjailObserver) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnjailObserver) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ObserverAddress) > 0 {
+		i -= len(m.ObserverAddress)
+		copy(dAtA[i:], m.ObserverAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ObserverAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnjailObserverResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnjailObserverResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnjailObserverResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateObserver) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -2850,21 +3620,21 @@ func (m *MsgAddObserver) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = len(m.ObserverAddress)
+	l = len(m.OldObserverAddress)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = len(m.ZetaclientGranteePubkey)
+	l = len(m.NewObserverAddress)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	if m.AddNodeAccountOnly {
-		n += 2
+	if m.UpdateReason != 0 {
+		n += 1 + sovTx(uint64(m.UpdateReason))
 	}
 	return n
 }
 
-func (m *MsgAddObserverResponse) Size() (n int) {
+func (m *MsgUpdateObserverResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -2873,7 +3643,7 @@ func (m *MsgAddObserverResponse) Size() (n int) {
 	return n
 }
 
-func (m *MsgVoteBlame) Size() (n int) {
+func (m *MsgVoteBlockHeader) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -2886,21 +3656,34 @@ func (m *MsgVoteBlame) Size() (n int) {
 	if m.ChainId != 0 {
 		n += 1 + sovTx(uint64(m.ChainId))
 	}
-	l = m.BlameInfo.Size()
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTx(uint64(m.Height))
+	}
+	l = m.Header.Size()
 	n += 1 + l + sovTx(uint64(l))
 	return n
 }
 
-func (m *MsgVoteBlameResponse) Size() (n int) {
+func (m *MsgVoteBlockHeaderResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	if m.BallotCreated {
+		n += 2
+	}
+	if m.VoteFinalized {
+		n += 2
+	}
 	return n
 }
 
-func (m *MsgUpdateKeygen) Size() (n int) {
+func (m *MsgUpdateChainParams) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -2910,13 +3693,14 @@ func (m *MsgUpdateKeygen) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	if m.Block != 0 {
-		n += 1 + sovTx(uint64(m.Block))
+	if m.ChainParams != nil {
+		l = m.ChainParams.Size()
+		n += 1 + l + sovTx(uint64(l))
 	}
 	return n
 }
 
-func (m *MsgUpdateKeygenResponse) Size() (n int) {
+func (m *MsgUpdateChainParamsResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -2925,7 +3709,7 @@ func (m *MsgUpdateKeygenResponse) Size() (n int) {
 	return n
 }
 
-func (m *MsgResetChainNonces) Size() (n int) {
+func (m *MsgRemoveChainParams) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -2938,16 +3722,10 @@ func (m *MsgResetChainNonces) Size() (n int) {
 	if m.ChainId != 0 {
 		n += 1 + sovTx(uint64(m.ChainId))
 	}
-	if m.ChainNonceLow != 0 {
-		n += 1 + sovTx(uint64(m.ChainNonceLow))
-	}
-	if m.ChainNonceHigh != 0 {
-		n += 1 + sovTx(uint64(m.ChainNonceHigh))
-	}
 	return n
 }
 
-func (m *MsgResetChainNoncesResponse) Size() (n int) {
+func (m *MsgRemoveChainParamsResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -2956,7 +3734,7 @@ func (m *MsgResetChainNoncesResponse) Size() (n int) {
 	return n
 }
 
-func (m *MsgVoteTSS) Size() (n int) {
+func (m *MsgAddObserver) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -2966,38 +3744,30 @@ func (m *MsgVoteTSS) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = len(m.TssPubkey)
+	l = len(m.ObserverAddress)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	if m.KeygenZetaHeight != 0 {
-		n += 1 + sovTx(uint64(m.KeygenZetaHeight))
+	l = len(m.ZetaclientGranteePubkey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
 	}
-	if m.Status != 0 {
-		n += 1 + sovTx(uint64(m.Status))
+	if m.AddNodeAccountOnly {
+		n += 2
 	}
 	return n
 }
 
-func (m *MsgVoteTSSResponse) Size() (n int) {
+func (m *MsgAddObserverResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.BallotCreated {
-		n += 2
-	}
-	if m.VoteFinalized {
-		n += 2
-	}
-	if m.KeygenSuccess {
-		n += 2
-	}
 	return n
 }
 
-func (m *MsgEnableCCTX) Size() (n int) {
+func (m *MsgVoteBlame) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -3007,16 +3777,15 @@ func (m *MsgEnableCCTX) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	if m.EnableInbound {
-		n += 2
-	}
-	if m.EnableOutbound {
-		n += 2
+	if m.ChainId != 0 {
+		n += 1 + sovTx(uint64(m.ChainId))
 	}
+	l = m.BlameInfo.Size()
+	n += 1 + l + sovTx(uint64(l))
 	return n
 }
 
-func (m *MsgEnableCCTXResponse) Size() (n int) {
+func (m *MsgVoteBlameResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -3025,7 +3794,7 @@ func (m *MsgEnableCCTXResponse) Size() (n int) {
 	return n
 }
 
-func (m *MsgDisableCCTX) Size() (n int) {
+func (m *MsgUpdateKeygen) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -3035,11 +3804,136 @@ func (m *MsgDisableCCTX) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	if m.DisableInbound {
-		n += 2
+	if m.Block != 0 {
+		n += 1 + sovTx(uint64(m.Block))
 	}
-	if m.DisableOutbound {
-		n += 2
+	return n
+}
+
+func (m *MsgUpdateKeygenResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgResetChainNonces) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovTx(uint64(m.ChainId))
+	}
+	if m.ChainNonceLow != 0 {
+		n += 1 + sovTx(uint64(m.ChainNonceLow))
+	}
+	if m.ChainNonceHigh != 0 {
+		n += 1 + sovTx(uint64(m.ChainNonceHigh))
+	}
+	return n
+}
+
+func (m *MsgResetChainNoncesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgVoteTSS) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TssPubkey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.KeygenZetaHeight != 0 {
+		n += 1 + sovTx(uint64(m.KeygenZetaHeight))
+	}
+	if m.Status != 0 {
+		n += 1 + sovTx(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *MsgVoteTSSResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BallotCreated {
+		n += 2
+	}
+	if m.VoteFinalized {
+		n += 2
+	}
+	if m.KeygenSuccess {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgEnableCCTX) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.EnableInbound {
+		n += 2
+	}
+	if m.EnableOutbound {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgEnableCCTXResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDisableCCTX) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DisableInbound {
+		n += 2
+	}
+	if m.DisableOutbound {
+		n += 2
 	}
 	return n
 }
@@ -3048,42 +3942,985 @@ func (m *MsgDisableCCTXResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
-	var l int
-	_ = l
-	return n
-}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateGasPriceIncreaseFlags) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.GasPriceIncreaseFlags.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateGasPriceIncreaseFlagsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgPauseCCTX) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.ChainIds) > 0 {
+		l = 0
+		for _, e := range m.ChainIds {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	if len(m.Zrc20Addresses) > 0 {
+		for _, s := range m.Zrc20Addresses {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.PauseInbound {
+		n += 2
+	}
+	if m.PauseOutbound {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgPauseCCTXResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUnpauseCCTX) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.ChainIds) > 0 {
+		l = 0
+		for _, e := range m.ChainIds {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	if len(m.Zrc20Addresses) > 0 {
+		for _, s := range m.Zrc20Addresses {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.UnpauseInbound {
+		n += 2
+	}
+	if m.UnpauseOutbound {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgUnpauseCCTXResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateLivenessParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.LivenessParams.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateLivenessParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUnjailObserver) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ObserverAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUnjailObserverResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateObserver) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateObserver: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateObserver: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldObserverAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OldObserverAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewObserverAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewObserverAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateReason", wireType)
+			}
+			m.UpdateReason = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdateReason |= ObserverUpdateReason(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateObserverResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateObserverResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateObserverResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgVoteBlockHeader) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVoteBlockHeader: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVoteBlockHeader: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockHash = append(m.BlockHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockHash == nil {
+				m.BlockHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgVoteBlockHeaderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVoteBlockHeaderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVoteBlockHeaderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BallotCreated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BallotCreated = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteFinalized", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.VoteFinalized = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateChainParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateChainParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateChainParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ChainParams == nil {
+				m.ChainParams = &ChainParams{}
+			}
+			if err := m.ChainParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateChainParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateChainParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateChainParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveChainParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveChainParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveChainParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgUpdateGasPriceIncreaseFlags) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	l = m.GasPriceIncreaseFlags.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return nil
 }
-
-func (m *MsgUpdateGasPriceIncreaseFlagsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRemoveChainParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveChainParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveChainParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgUpdateObserver) Unmarshal(dAtA []byte) error {
+func (m *MsgAddObserver) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3106,10 +4943,10 @@ func (m *MsgUpdateObserver) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateObserver: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddObserver: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateObserver: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddObserver: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3146,7 +4983,7 @@ func (m *MsgUpdateObserver) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OldObserverAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObserverAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3174,11 +5011,11 @@ func (m *MsgUpdateObserver) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OldObserverAddress = string(dAtA[iNdEx:postIndex])
+			m.ObserverAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewObserverAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ZetaclientGranteePubkey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3206,13 +5043,13 @@ func (m *MsgUpdateObserver) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewObserverAddress = string(dAtA[iNdEx:postIndex])
+			m.ZetaclientGranteePubkey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdateReason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AddNodeAccountOnly", wireType)
 			}
-			m.UpdateReason = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3222,11 +5059,12 @@ func (m *MsgUpdateObserver) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UpdateReason |= ObserverUpdateReason(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.AddNodeAccountOnly = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3248,7 +5086,7 @@ func (m *MsgUpdateObserver) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateObserverResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAddObserverResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3271,10 +5109,10 @@ func (m *MsgUpdateObserverResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateObserverResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddObserverResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateObserverResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddObserverResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3298,7 +5136,7 @@ func (m *MsgUpdateObserverResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgVoteBlockHeader) Unmarshal(dAtA []byte) error {
+func (m *MsgVoteBlame) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3321,10 +5159,10 @@ func (m *MsgVoteBlockHeader) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgVoteBlockHeader: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgVoteBlame: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgVoteBlockHeader: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgVoteBlame: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3380,60 +5218,7 @@ func (m *MsgVoteBlockHeader) Unmarshal(dAtA []byte) error {
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BlockHash = append(m.BlockHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.BlockHash == nil {
-				m.BlockHash = []byte{}
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
-			}
-			m.Height = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Height |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlameInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3460,7 +5245,7 @@ func (m *MsgVoteBlockHeader) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BlameInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3485,7 +5270,7 @@ func (m *MsgVoteBlockHeader) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgVoteBlockHeaderResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgVoteBlameResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3508,52 +5293,12 @@ func (m *MsgVoteBlockHeaderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgVoteBlockHeaderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgVoteBlameResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgVoteBlockHeaderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgVoteBlameResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BallotCreated", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.BallotCreated = bool(v != 0)
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VoteFinalized", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.VoteFinalized = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3575,7 +5320,7 @@ func (m *MsgVoteBlockHeaderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateChainParams) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateKeygen) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3598,10 +5343,10 @@ func (m *MsgUpdateChainParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateChainParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateKeygen: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateChainParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateKeygen: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3637,41 +5382,24 @@ func (m *MsgUpdateChainParams) Unmarshal(dAtA []byte) error {
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainParams", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
 			}
-			var msglen int
+			m.Block = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ChainParams == nil {
-				m.ChainParams = &ChainParams{}
-			}
-			if err := m.ChainParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Block |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3693,7 +5421,7 @@ func (m *MsgUpdateChainParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateChainParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateKeygenResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3716,10 +5444,10 @@ func (m *MsgUpdateChainParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateChainParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateKeygenResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateChainParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateKeygenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3743,7 +5471,7 @@ func (m *MsgUpdateChainParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveChainParams) Unmarshal(dAtA []byte) error {
+func (m *MsgResetChainNonces) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3766,10 +5494,10 @@ func (m *MsgRemoveChainParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveChainParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgResetChainNonces: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveChainParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgResetChainNonces: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3823,6 +5551,44 @@ func (m *MsgRemoveChainParams) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainNonceLow", wireType)
+			}
+			m.ChainNonceLow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainNonceLow |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainNonceHigh", wireType)
+			}
+			m.ChainNonceHigh = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainNonceHigh |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3844,7 +5610,7 @@ func (m *MsgRemoveChainParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveChainParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgResetChainNoncesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3867,10 +5633,10 @@ func (m *MsgRemoveChainParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveChainParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgResetChainNoncesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveChainParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgResetChainNoncesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3894,7 +5660,7 @@ func (m *MsgRemoveChainParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddObserver) Unmarshal(dAtA []byte) error {
+func (m *MsgVoteTSS) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3917,10 +5683,10 @@ func (m *MsgAddObserver) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddObserver: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgVoteTSS: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddObserver: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgVoteTSS: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3957,7 +5723,7 @@ func (m *MsgAddObserver) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObserverAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TssPubkey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3985,13 +5751,13 @@ func (m *MsgAddObserver) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ObserverAddress = string(dAtA[iNdEx:postIndex])
+			m.TssPubkey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ZetaclientGranteePubkey", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeygenZetaHeight", wireType)
 			}
-			var stringLen uint64
+			m.KeygenZetaHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4001,29 +5767,16 @@ func (m *MsgAddObserver) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.KeygenZetaHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ZetaclientGranteePubkey = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AddNodeAccountOnly", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var v int
+			m.Status = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4033,12 +5786,11 @@ func (m *MsgAddObserver) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Status |= chains.ReceiveStatus(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.AddNodeAccountOnly = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4060,7 +5812,7 @@ func (m *MsgAddObserver) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddObserverResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgVoteTSSResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4083,12 +5835,72 @@ func (m *MsgAddObserverResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddObserverResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgVoteTSSResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddObserverResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgVoteTSSResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BallotCreated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BallotCreated = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteFinalized", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.VoteFinalized = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeygenSuccess", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.KeygenSuccess = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4110,7 +5922,7 @@ func (m *MsgAddObserverResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgVoteBlame) Unmarshal(dAtA []byte) error {
+func (m *MsgEnableCCTX) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4133,10 +5945,10 @@ func (m *MsgVoteBlame) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgVoteBlame: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgEnableCCTX: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgVoteBlame: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgEnableCCTX: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4173,9 +5985,9 @@ func (m *MsgVoteBlame) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableInbound", wireType)
 			}
-			m.ChainId = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4185,16 +5997,17 @@ func (m *MsgVoteBlame) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ChainId |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.EnableInbound = bool(v != 0)
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlameInfo", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableOutbound", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4204,25 +6017,12 @@ func (m *MsgVoteBlame) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.BlameInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.EnableOutbound = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4244,7 +6044,7 @@ func (m *MsgVoteBlame) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgVoteBlameResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgEnableCCTXResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4267,10 +6067,10 @@ func (m *MsgVoteBlameResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgVoteBlameResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgEnableCCTXResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgVoteBlameResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgEnableCCTXResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4294,7 +6094,7 @@ func (m *MsgVoteBlameResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateKeygen) Unmarshal(dAtA []byte) error {
+func (m *MsgDisableCCTX) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4317,10 +6117,10 @@ func (m *MsgUpdateKeygen) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateKeygen: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDisableCCTX: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateKeygen: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDisableCCTX: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4357,9 +6157,9 @@ func (m *MsgUpdateKeygen) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DisableInbound", wireType)
 			}
-			m.Block = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4369,11 +6169,32 @@ func (m *MsgUpdateKeygen) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Block |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DisableInbound = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisableOutbound", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.DisableOutbound = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4395,7 +6216,7 @@ func (m *MsgUpdateKeygen) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateKeygenResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDisableCCTXResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4418,10 +6239,10 @@ func (m *MsgUpdateKeygenResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateKeygenResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDisableCCTXResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateKeygenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDisableCCTXResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4445,7 +6266,7 @@ func (m *MsgUpdateKeygenResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgResetChainNonces) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateGasPriceIncreaseFlags) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4468,10 +6289,10 @@ func (m *MsgResetChainNonces) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgResetChainNonces: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateGasPriceIncreaseFlags: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgResetChainNonces: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateGasPriceIncreaseFlags: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4507,10 +6328,10 @@ func (m *MsgResetChainNonces) Unmarshal(dAtA []byte) error {
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPriceIncreaseFlags", wireType)
 			}
-			m.ChainId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4520,49 +6341,25 @@ func (m *MsgResetChainNonces) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ChainId |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainNonceLow", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthTx
 			}
-			m.ChainNonceLow = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ChainNonceLow |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainNonceHigh", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.ChainNonceHigh = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ChainNonceHigh |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.GasPriceIncreaseFlags.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4584,7 +6381,7 @@ func (m *MsgResetChainNonces) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgResetChainNoncesResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateGasPriceIncreaseFlagsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4607,10 +6404,10 @@ func (m *MsgResetChainNoncesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgResetChainNoncesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateGasPriceIncreaseFlagsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgResetChainNoncesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateGasPriceIncreaseFlagsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4634,7 +6431,7 @@ func (m *MsgResetChainNoncesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgVoteTSS) Unmarshal(dAtA []byte) error {
+func (m *MsgPauseCCTX) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4657,10 +6454,10 @@ func (m *MsgVoteTSS) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgVoteTSS: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPauseCCTX: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgVoteTSS: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPauseCCTX: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4696,8 +6493,84 @@ func (m *MsgVoteTSS) Unmarshal(dAtA []byte) error {
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ChainIds = append(m.ChainIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ChainIds) == 0 {
+					m.ChainIds = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ChainIds = append(m.ChainIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainIds", wireType)
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TssPubkey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Zrc20Addresses", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4725,13 +6598,13 @@ func (m *MsgVoteTSS) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TssPubkey = string(dAtA[iNdEx:postIndex])
+			m.Zrc20Addresses = append(m.Zrc20Addresses, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeygenZetaHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PauseInbound", wireType)
 			}
-			m.KeygenZetaHeight = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4741,16 +6614,17 @@ func (m *MsgVoteTSS) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.KeygenZetaHeight |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+			m.PauseInbound = bool(v != 0)
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PauseOutbound", wireType)
 			}
-			m.Status = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4760,11 +6634,12 @@ func (m *MsgVoteTSS) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= chains.ReceiveStatus(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.PauseOutbound = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4786,7 +6661,7 @@ func (m *MsgVoteTSS) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgVoteTSSResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgPauseCCTXResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4809,72 +6684,12 @@ func (m *MsgVoteTSSResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgVoteTSSResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPauseCCTXResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgVoteTSSResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPauseCCTXResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BallotCreated", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.BallotCreated = bool(v != 0)
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VoteFinalized", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.VoteFinalized = bool(v != 0)
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeygenSuccess", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.KeygenSuccess = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4896,7 +6711,7 @@ func (m *MsgVoteTSSResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgEnableCCTX) Unmarshal(dAtA []byte) error {
+func (m *MsgUnpauseCCTX) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4919,10 +6734,10 @@ func (m *MsgEnableCCTX) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgEnableCCTX: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnpauseCCTX: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgEnableCCTX: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnpauseCCTX: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4958,8 +6773,116 @@ func (m *MsgEnableCCTX) Unmarshal(dAtA []byte) error {
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ChainIds = append(m.ChainIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ChainIds) == 0 {
+					m.ChainIds = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ChainIds = append(m.ChainIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainIds", wireType)
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Zrc20Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Zrc20Addresses = append(m.Zrc20Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnableInbound", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnpauseInbound", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -4976,10 +6899,10 @@ func (m *MsgEnableCCTX) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.EnableInbound = bool(v != 0)
-		case 3:
+			m.UnpauseInbound = bool(v != 0)
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnableOutbound", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnpauseOutbound", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -4996,7 +6919,7 @@ func (m *MsgEnableCCTX) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.EnableOutbound = bool(v != 0)
+			m.UnpauseOutbound = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5018,7 +6941,7 @@ func (m *MsgEnableCCTX) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgEnableCCTXResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUnpauseCCTXResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5041,10 +6964,10 @@ func (m *MsgEnableCCTXResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgEnableCCTXResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnpauseCCTXResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgEnableCCTXResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnpauseCCTXResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -5068,7 +6991,7 @@ func (m *MsgEnableCCTXResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDisableCCTX) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateLivenessParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5091,10 +7014,10 @@ func (m *MsgDisableCCTX) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDisableCCTX: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateLivenessParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDisableCCTX: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateLivenessParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5130,10 +7053,10 @@ func (m *MsgDisableCCTX) Unmarshal(dAtA []byte) error {
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DisableInbound", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LivenessParams", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5143,32 +7066,25 @@ func (m *MsgDisableCCTX) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.DisableInbound = bool(v != 0)
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DisableOutbound", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthTx
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-			m.DisableOutbound = bool(v != 0)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LivenessParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5190,7 +7106,7 @@ func (m *MsgDisableCCTX) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDisableCCTXResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateLivenessParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5213,10 +7129,10 @@ func (m *MsgDisableCCTXResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDisableCCTXResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateLivenessParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDisableCCTXResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateLivenessParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -5240,7 +7156,7 @@ func (m *MsgDisableCCTXResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateGasPriceIncreaseFlags) Unmarshal(dAtA []byte) error {
+func (m *MsgUnjailObserver) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5263,10 +7179,10 @@ func (m *MsgUpdateGasPriceIncreaseFlags) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateGasPriceIncreaseFlags: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnjailObserver: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateGasPriceIncreaseFlags: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnjailObserver: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5303,9 +7219,9 @@ func (m *MsgUpdateGasPriceIncreaseFlags) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasPriceIncreaseFlags", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObserverAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5315,24 +7231,23 @@ func (m *MsgUpdateGasPriceIncreaseFlags) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.GasPriceIncreaseFlags.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ObserverAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5355,7 +7270,7 @@ func (m *MsgUpdateGasPriceIncreaseFlags) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateGasPriceIncreaseFlagsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUnjailObserverResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5378,10 +7293,10 @@ func (m *MsgUpdateGasPriceIncreaseFlagsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateGasPriceIncreaseFlagsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnjailObserverResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateGasPriceIncreaseFlagsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnjailObserverResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -0,0 +1,263 @@
+// Package admin provides the local admin API used to inspect and steer a running zetaclient
+package admin
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/rs/zerolog"
+
+	"github.com/zeta-chain/node/zetaclient/config"
+	zctx "github.com/zeta-chain/node/zetaclient/context"
+	"github.com/zeta-chain/node/zetaclient/orchestrator"
+	"github.com/zeta-chain/node/zetaclient/outboundprocessor"
+)
+
+// Node is the running zetaclient inspected and steered by the admin API
+type Node interface {
+	ChainStatuses(app *zctx.AppContext) []orchestrator.ChainStatus
+	ActiveOutbounds() []outboundprocessor.ActiveOutbound
+	RescanFromHeight(app *zctx.AppContext, chainID int64, height uint64) error
+	BackfillInbound(app *zctx.AppContext, chainID int64, from, to uint64) error
+}
+
+// Server provides the http endpoints of the admin API
+type Server struct {
+	app    *zctx.AppContext
+	node   Node
+	token  string
+	logger zerolog.Logger
+	s      *http.Server
+}
+
+// NewServer creates a new admin API server, it only listens to the loopback
+func NewServer(cfg config.AdminAPIConfig, app *zctx.AppContext, node Node, logger zerolog.Logger) (*Server, error) {
+	switch {
+	case !cfg.Enabled():
+		return nil, errors.New("admin API port is not set")
+	case cfg.Token == "":
+		return nil, errors.New("admin API token is not set")
+	case app == nil || node == nil:
+		return nil, errors.New("app context or node is nil")
+	}
+
+	server := &Server{
+		app:    app,
+		node:   node,
+		token:  cfg.Token,
+		logger: logger.With().Str("module", "admin").Logger(),
+	}
+	server.s = &http.Server{
+		Addr:              fmt.Sprintf("127.0.0.1:%d", cfg.Port),
+		Handler:           server.Handlers(),
+		ReadTimeout:       5 * time.Second,
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+
+	return server, nil
+}
+
+// Handlers returns the http handlers of the admin API
+func (s *Server) Handlers() http.Handler {
+	router := mux.NewRouter()
+	router.Handle("/chains", http.HandlerFunc(s.chainsHandler)).Methods(http.MethodGet)
+	router.Handle("/chains/{chain_id}/rescan", http.HandlerFunc(s.rescanHandler)).Methods(http.MethodPost)
+	router.Handle("/chains/{chain_id}/backfill", http.HandlerFunc(s.backfillHandler)).Methods(http.MethodPost)
+	router.Handle("/chains/{chain_id}/pause", http.HandlerFunc(s.pauseHandler)).Methods(http.MethodPost)
+	router.Handle("/chains/{chain_id}/resume", http.HandlerFunc(s.resumeHandler)).Methods(http.MethodPost)
+	router.Handle("/outbounds", http.HandlerFunc(s.outboundsHandler)).Methods(http.MethodGet)
+	router.Handle("/appcontext", http.HandlerFunc(s.appContextHandler)).Methods(http.MethodGet)
+
+	router.Use(s.authMiddleware())
+
+	return router
+}
+
+// Start starts the admin API server
+func (s *Server) Start() error {
+	s.logger.Info().Str("addr", s.s.Addr).Msg("starting admin API")
+
+	if err := s.s.ListenAndServe(); err != nil {
+		if !errors.Is(err, http.ErrServerClosed) {
+			return fmt.Errorf("fail to start admin API server: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// Stop stops the admin API server
+func (s *Server) Stop() error {
+	c, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	err := s.s.Shutdown(c)
+	if err != nil {
+		s.logger.Error().Err(err).Msg("Failed to shutdown the admin API server gracefully")
+	}
+	return err
+}
+
+// chainsHandler returns the status of the observers and signers of all chains
+func (s *Server) chainsHandler(w http.ResponseWriter, _ *http.Request) {
+	s.writeJSON(w, http.StatusOK, s.node.ChainStatuses(s.app))
+}
+
+// outboundsHandler returns the outbounds being processed
+func (s *Server) outboundsHandler(w http.ResponseWriter, _ *http.Request) {
+	s.writeJSON(w, http.StatusOK, s.node.ActiveOutbounds())
+}
+
+// appContextHandler returns a dump of the app context
+func (s *Server) appContextHandler(w http.ResponseWriter, _ *http.Request) {
+	s.writeJSON(w, http.StatusOK, s.app.Snapshot())
+}
+
+// rescanHandler forces the observer of a chain to scan inbounds again from the height given in the query
+func (s *Server) rescanHandler(w http.ResponseWriter, r *http.Request) {
+	chainID, ok := s.parseChainID(w, r)
+	if !ok {
+		return
+	}
+
+	height, err := strconv.ParseUint(r.URL.Query().Get("height"), 10, 64)
+	if err != nil {
+		s.writeError(w, http.StatusBadRequest, fmt.Errorf("invalid height: %w", err))
+		return
+	}
+
+	if err := s.node.RescanFromHeight(s.app, chainID, height); err != nil {
+		s.writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	s.writeJSON(w, http.StatusOK, map[string]any{"chain_id": chainID, "height": height})
+}
+
+// backfillHandler starts the backfill of the missed inbounds of a chain over the block range given in the query
+func (s *Server) backfillHandler(w http.ResponseWriter, r *http.Request) {
+	chainID, ok := s.parseChainID(w, r)
+	if !ok {
+		return
+	}
+
+	query := r.URL.Query()
+	from, err := strconv.ParseUint(query.Get("from"), 10, 64)
+	if err != nil {
+		s.writeError(w, http.StatusBadRequest, fmt.Errorf("invalid from block: %w", err))
+		return
+	}
+	to, err := strconv.ParseUint(query.Get("to"), 10, 64)
+	if err != nil {
+		s.writeError(w, http.StatusBadRequest, fmt.Errorf("invalid to block: %w", err))
+		return
+	}
+
+	if err := s.node.BackfillInbound(s.app, chainID, from, to); err != nil {
+		s.writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	// the backfill runs in the background, its progress is reported in the logs
+	s.writeJSON(w, http.StatusAccepted, map[string]any{"chain_id": chainID, "from": from, "to": to})
+}
+
+// pauseHandler locally pauses the observation of a chain
+func (s *Server) pauseHandler(w http.ResponseWriter, r *http.Request) {
+	chainID, ok := s.parseChainID(w, r)
+	if !ok {
+		return
+	}
+
+	s.app.PauseChain(chainID)
+	s.logger.Warn().Int64("chain_id", chainID).Msg("chain paused")
+
+	s.writeJSON(w, http.StatusOK, map[string]any{"chain_id": chainID, "paused": true})
+}
+
+// resumeHandler resumes the observation of a locally paused chain
+func (s *Server) resumeHandler(w http.ResponseWriter, r *http.Request) {
+	chainID, ok := s.parseChainID(w, r)
+	if !ok {
+		return
+	}
+
+	s.app.ResumeChain(chainID)
+	s.logger.Warn().Int64("chain_id", chainID).Msg("chain resumed")
+
+	s.writeJSON(w, http.StatusOK, map[string]any{"chain_id": chainID, "paused": false})
+}
+
+// parseChainID parses the chain id of the route and checks the chain is an external chain known by the app context
+func (s *Server) parseChainID(w http.ResponseWriter, r *http.Request) (int64, bool) {
+	chainID, err := strconv.ParseInt(mux.Vars(r)["chain_id"], 10, 64)
+	if err != nil {
+		s.writeError(w, http.StatusBadRequest, fmt.Errorf("invalid chain id: %w", err))
+		return 0, false
+	}
+
+	chain, err := s.app.GetChain(chainID)
+	switch {
+	case err != nil:
+		s.writeError(w, http.StatusNotFound, err)
+		return 0, false
+	case chain.IsZeta():
+		s.writeError(w, http.StatusBadRequest, fmt.Errorf("chain %d is not an external chain", chainID))
+		return 0, false
+	}
+
+	return chainID, true
+}
+
+func (s *Server) writeJSON(w http.ResponseWriter, status int, v any) {
+	b, err := json.MarshalIndent(v, "", "\t")
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if _, err := w.Write(b); err != nil {
+		s.logger.Error().Err(err).Msg("Failed to write response")
+	}
+}
+
+func (s *Server) writeError(w http.ResponseWriter, status int, err error) {
+	s.writeJSON(w, status, map[string]string{"error": err.Error()})
+}
+
+// authMiddleware rejects the requests that don't present the admin API bearer token
+func (s *Server) authMiddleware() mux.MiddlewareFunc {
+	expected := []byte("Bearer " + s.token)
+
+	return func(handler http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			provided := []byte(strings.TrimSpace(r.Header.Get("Authorization")))
+			if subtle.ConstantTimeCompare(provided, expected) != 1 {
+				s.logger.Warn().
+					Str("route", r.URL.Path).
+					Str("method", r.Method).
+					Msg("unauthorized admin API request")
+
+				s.writeError(w, http.StatusUnauthorized, errors.New("unauthorized"))
+				return
+			}
+
+			s.logger.Info().
+				Str("route", r.URL.Path).
+				Str("method", r.Method).
+				Msg("admin API request received")
+
+			handler.ServeHTTP(w, r)
+		})
+	}
+}
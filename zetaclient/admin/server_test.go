@@ -0,0 +1,241 @@
+package admin
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/node/pkg/chains"
+	"github.com/zeta-chain/node/testutil/sample"
+	observertypes "github.com/zeta-chain/node/x/observer/types"
+	"github.com/zeta-chain/node/zetaclient/config"
+	zctx "github.com/zeta-chain/node/zetaclient/context"
+	"github.com/zeta-chain/node/zetaclient/orchestrator"
+	"github.com/zeta-chain/node/zetaclient/outboundprocessor"
+)
+
+const testToken = "admin-token"
+
+// fakeNode is a fake zetaclient node recording the rescan and backfill requests
+type fakeNode struct {
+	rescanChainID int64
+	rescanHeight  uint64
+	rescanErr     error
+
+	backfillChainID int64
+	backfillFrom    uint64
+	backfillTo      uint64
+	backfillErr     error
+}
+
+func (n *fakeNode) ChainStatuses(app *zctx.AppContext) []orchestrator.ChainStatus {
+	statuses := []orchestrator.ChainStatus{}
+	for _, chainID := range app.ListChainIDs() {
+		statuses = append(statuses, orchestrator.ChainStatus{ChainID: chainID, Paused: app.IsChainPaused(chainID)})
+	}
+	return statuses
+}
+
+func (n *fakeNode) ActiveOutbounds() []outboundprocessor.ActiveOutbound {
+	return []outboundprocessor.ActiveOutbound{{OutboundID: "0x123-1-2"}}
+}
+
+func (n *fakeNode) RescanFromHeight(_ *zctx.AppContext, chainID int64, height uint64) error {
+	n.rescanChainID = chainID
+	n.rescanHeight = height
+	return n.rescanErr
+}
+
+func (n *fakeNode) BackfillInbound(_ *zctx.AppContext, chainID int64, from, to uint64) error {
+	n.backfillChainID = chainID
+	n.backfillFrom = from
+	n.backfillTo = to
+	return n.backfillErr
+}
+
+func newTestServer(t *testing.T) (*Server, *zctx.AppContext, *fakeNode) {
+	cfg := config.New(false)
+	cfg.AdminAPI = config.AdminAPIConfig{Port: 8887, Token: testToken}
+
+	app := zctx.New(cfg, nil, zerolog.Nop())
+	ethParams := sample.ChainParamsSupported(chains.Ethereum.ChainId)
+	err := app.Update(
+		observertypes.Keygen{},
+		[]chains.Chain{chains.Ethereum},
+		nil,
+		map[int64]*observertypes.ChainParams{chains.Ethereum.ChainId: ethParams},
+		"tssPubKey",
+		*sample.CrosschainFlags(),
+	)
+	require.NoError(t, err)
+
+	node := &fakeNode{}
+	server, err := NewServer(cfg.AdminAPI, app, node, zerolog.Nop())
+	require.NoError(t, err)
+
+	return server, app, node
+}
+
+func doRequest(t *testing.T, server *Server, method, target, token string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, target, nil)
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	rec := httptest.NewRecorder()
+	server.Handlers().ServeHTTP(rec, req)
+
+	return rec
+}
+
+func TestNewServer(t *testing.T) {
+	app := zctx.New(config.New(false), nil, zerolog.Nop())
+
+	t.Run("should fail if port is not set", func(t *testing.T) {
+		_, err := NewServer(config.AdminAPIConfig{Token: testToken}, app, &fakeNode{}, zerolog.Nop())
+		require.ErrorContains(t, err, "port is not set")
+	})
+
+	t.Run("should fail if token is not set", func(t *testing.T) {
+		_, err := NewServer(config.AdminAPIConfig{Port: 8887}, app, &fakeNode{}, zerolog.Nop())
+		require.ErrorContains(t, err, "token is not set")
+	})
+
+	t.Run("should listen to the loopback", func(t *testing.T) {
+		server, err := NewServer(config.AdminAPIConfig{Port: 8887, Token: testToken}, app, &fakeNode{}, zerolog.Nop())
+		require.NoError(t, err)
+		require.Equal(t, "127.0.0.1:8887", server.s.Addr)
+	})
+}
+
+func TestServer_Handlers(t *testing.T) {
+	ethChainID := chains.Ethereum.ChainId
+
+	t.Run("should reject requests without a valid token", func(t *testing.T) {
+		server, _, _ := newTestServer(t)
+
+		rec := doRequest(t, server, http.MethodGet, "/chains", "")
+		require.Equal(t, http.StatusUnauthorized, rec.Code)
+
+		rec = doRequest(t, server, http.MethodGet, "/chains", "wrong-token")
+		require.Equal(t, http.StatusUnauthorized, rec.Code)
+	})
+
+	t.Run("should list chains", func(t *testing.T) {
+		server, _, _ := newTestServer(t)
+
+		rec := doRequest(t, server, http.MethodGet, "/chains", testToken)
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		var statuses []orchestrator.ChainStatus
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &statuses))
+		require.Len(t, statuses, 1)
+		require.Equal(t, ethChainID, statuses[0].ChainID)
+	})
+
+	t.Run("should list active outbounds", func(t *testing.T) {
+		server, _, _ := newTestServer(t)
+
+		rec := doRequest(t, server, http.MethodGet, "/outbounds", testToken)
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		var outbounds []outboundprocessor.ActiveOutbound
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &outbounds))
+		require.Len(t, outbounds, 1)
+		require.Equal(t, "0x123-1-2", outbounds[0].OutboundID)
+	})
+
+	t.Run("should pause and resume a chain", func(t *testing.T) {
+		server, app, _ := newTestServer(t)
+
+		rec := doRequest(t, server, http.MethodPost, "/chains/1/pause", testToken)
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.True(t, app.IsChainPaused(ethChainID))
+
+		rec = doRequest(t, server, http.MethodPost, "/chains/1/resume", testToken)
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.False(t, app.IsChainPaused(ethChainID))
+	})
+
+	t.Run("should not pause an unknown chain", func(t *testing.T) {
+		server, app, _ := newTestServer(t)
+
+		rec := doRequest(t, server, http.MethodPost, "/chains/56/pause", testToken)
+		require.Equal(t, http.StatusNotFound, rec.Code)
+		require.Empty(t, app.PausedChainIDs())
+
+		rec = doRequest(t, server, http.MethodPost, "/chains/abc/pause", testToken)
+		require.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("should rescan a chain from height", func(t *testing.T) {
+		server, _, node := newTestServer(t)
+
+		rec := doRequest(t, server, http.MethodPost, "/chains/1/rescan?height=1000", testToken)
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Equal(t, ethChainID, node.rescanChainID)
+		require.EqualValues(t, 1000, node.rescanHeight)
+	})
+
+	t.Run("should not rescan with an invalid height", func(t *testing.T) {
+		server, _, node := newTestServer(t)
+
+		rec := doRequest(t, server, http.MethodPost, "/chains/1/rescan?height=-1", testToken)
+		require.Equal(t, http.StatusBadRequest, rec.Code)
+		require.Zero(t, node.rescanChainID)
+	})
+
+	t.Run("should return the rescan error", func(t *testing.T) {
+		server, _, node := newTestServer(t)
+		node.rescanErr = errors.New("rescan failed")
+
+		rec := doRequest(t, server, http.MethodPost, "/chains/1/rescan?height=1000", testToken)
+		require.Equal(t, http.StatusBadRequest, rec.Code)
+		require.Contains(t, rec.Body.String(), "rescan failed")
+	})
+
+	t.Run("should backfill a chain", func(t *testing.T) {
+		server, _, node := newTestServer(t)
+
+		rec := doRequest(t, server, http.MethodPost, "/chains/1/backfill?from=100&to=200", testToken)
+		require.Equal(t, http.StatusAccepted, rec.Code)
+		require.Equal(t, ethChainID, node.backfillChainID)
+		require.EqualValues(t, 100, node.backfillFrom)
+		require.EqualValues(t, 200, node.backfillTo)
+	})
+
+	t.Run("should not backfill with an invalid range", func(t *testing.T) {
+		server, _, node := newTestServer(t)
+
+		rec := doRequest(t, server, http.MethodPost, "/chains/1/backfill?from=100", testToken)
+		require.Equal(t, http.StatusBadRequest, rec.Code)
+		require.Zero(t, node.backfillChainID)
+	})
+
+	t.Run("should return the backfill error", func(t *testing.T) {
+		server, _, node := newTestServer(t)
+		node.backfillErr = errors.New("backfill failed")
+
+		rec := doRequest(t, server, http.MethodPost, "/chains/1/backfill?from=100&to=200", testToken)
+		require.Equal(t, http.StatusBadRequest, rec.Code)
+		require.Contains(t, rec.Body.String(), "backfill failed")
+	})
+
+	t.Run("should dump the app context without secrets", func(t *testing.T) {
+		server, _, _ := newTestServer(t)
+
+		rec := doRequest(t, server, http.MethodGet, "/appcontext", testToken)
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.NotContains(t, rec.Body.String(), testToken)
+
+		var snapshot zctx.Snapshot
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &snapshot))
+		require.Equal(t, "tssPubKey", snapshot.CurrentTssPubKey)
+		require.Len(t, snapshot.Chains, 1)
+	})
+}
@@ -0,0 +1,76 @@
+package base
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	crosschaintypes "github.com/zeta-chain/node/x/crosschain/types"
+)
+
+const (
+	// BackfillBatchSize is the number of blocks scanned in a single batch when backfilling inbounds
+	BackfillBatchSize = 10
+
+	// BackfillBatchInterval is the pause between two batches when backfilling inbounds, so the RPC is not overloaded
+	BackfillBatchInterval = time.Second
+)
+
+// backfillKey is the context key marking a backfill
+type backfillKey struct{}
+
+// WithBackfill returns a context marking the inbound votes as part of a backfill.
+// Inbound votes posted with such context are skipped if their cctx is already created.
+func WithBackfill(ctx context.Context) context.Context {
+	return context.WithValue(ctx, backfillKey{}, true)
+}
+
+// IsBackfill returns true if the context is used to backfill missed inbounds
+func IsBackfill(ctx context.Context) bool {
+	backfill, ok := ctx.Value(backfillKey{}).(bool)
+	return ok && backfill
+}
+
+// ValidateBackfillRange checks the block range [from, to] can be backfilled.
+// Only blocks already scanned by the observer can be backfilled, the blocks above are scanned as usual.
+func ValidateBackfillRange(from, to, lastScanned uint64) error {
+	switch {
+	case from == 0:
+		return errors.New("backfill start block must be positive")
+	case from > to:
+		return fmt.Errorf("backfill start block %d is greater than end block %d", from, to)
+	case to > lastScanned:
+		return fmt.Errorf("backfill end block %d is greater than last scanned block %d", to, lastScanned)
+	}
+	return nil
+}
+
+// WaitBackfillBatchInterval pauses between two backfill batches, returns an error if the context is done
+func WaitBackfillBatchInterval(ctx context.Context) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(BackfillBatchInterval):
+		return nil
+	}
+}
+
+// IsInboundFinalized returns true if the cctx of the inbound vote is already created.
+// The cctx is indexed by the inbound hash and its index is the ballot index of the vote,
+// the ballot itself may be pruned once the cctx is created so it can't be relied on.
+func (ob *Observer) IsInboundFinalized(ctx context.Context, msg *crosschaintypes.MsgVoteInbound) (bool, error) {
+	inboundHashToCctx, err := ob.ZetacoreClient().GetInboundHashToCctx(ctx, msg.InboundHash)
+	switch {
+	case status.Code(err) == codes.NotFound:
+		return false, nil
+	case err != nil:
+		return false, errors.Wrapf(err, "unable to get cctxs of inbound %s", msg.InboundHash)
+	}
+
+	return slices.Contains(inboundHashToCctx.CctxIndex, msg.Digest()), nil
+}
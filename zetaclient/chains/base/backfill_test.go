@@ -0,0 +1,78 @@
+package base_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/node/zetaclient/chains/base"
+)
+
+func TestWithBackfill(t *testing.T) {
+	ctx := context.Background()
+	require.False(t, base.IsBackfill(ctx))
+	require.True(t, base.IsBackfill(base.WithBackfill(ctx)))
+}
+
+func TestValidateBackfillRange(t *testing.T) {
+	tests := []struct {
+		name        string
+		from        uint64
+		to          uint64
+		lastScanned uint64
+		errMsg      string
+	}{
+		{
+			name:        "valid range",
+			from:        100,
+			to:          200,
+			lastScanned: 200,
+		},
+		{
+			name:        "single block",
+			from:        100,
+			to:          100,
+			lastScanned: 200,
+		},
+		{
+			name:        "start block is zero",
+			from:        0,
+			to:          100,
+			lastScanned: 200,
+			errMsg:      "must be positive",
+		},
+		{
+			name:        "start block is greater than end block",
+			from:        200,
+			to:          100,
+			lastScanned: 200,
+			errMsg:      "is greater than end block",
+		},
+		{
+			name:        "end block is not scanned yet",
+			from:        100,
+			to:          201,
+			lastScanned: 200,
+			errMsg:      "is greater than last scanned block",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := base.ValidateBackfillRange(tt.from, tt.to, tt.lastScanned)
+			if tt.errMsg != "" {
+				require.ErrorContains(t, err, tt.errMsg)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestWaitBackfillBatchInterval(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	require.ErrorIs(t, base.WaitBackfillBatchInterval(ctx), context.Canceled)
+}
@@ -9,11 +9,14 @@ import (
 	"sync/atomic"
 	"time"
 
+	sdkmath "cosmossdk.io/math"
 	lru "github.com/hashicorp/golang-lru"
 	"github.com/pkg/errors"
 	"github.com/rs/zerolog"
+	"gorm.io/gorm"
 
 	"github.com/zeta-chain/node/pkg/chains"
+	"github.com/zeta-chain/node/pkg/coin"
 	crosschaintypes "github.com/zeta-chain/node/x/crosschain/types"
 	observertypes "github.com/zeta-chain/node/x/observer/types"
 	"github.com/zeta-chain/node/zetaclient/chains/interfaces"
@@ -35,6 +38,9 @@ const (
 	// DefaultHeaderCacheSize is the default number of headers that the observer will keep in cache for performance (without RPC calls)
 	// Cached headers can be used to get header information
 	DefaultHeaderCacheSize = 1000
+
+	// OutboundRetention is the period for which the outbound attempts are kept in the database
+	OutboundRetention = 7 * 24 * time.Hour
 )
 
 // Observer is the base structure for chain observers, grouping the common logic for each chain observer client.
@@ -258,6 +264,23 @@ func (ob *Observer) IsBlockConfirmed(blockNumber uint64) bool {
 	return lastBlock >= confBlock
 }
 
+// ConfirmationCount returns the number of confirmations required for the given asset and amount.
+// It falls back to the default confirmation count of the chain if no confirmation tier applies.
+func (ob *Observer) ConfirmationCount(coinType coin.CoinType, asset string, amount sdkmath.Uint) uint64 {
+	chainParams := ob.ChainParams()
+	return chainParams.ConfirmationCountForAmount(coinType, asset, amount)
+}
+
+// IsInboundConfirmed checks if the block of the given inbound vote has enough confirmations
+// according to the confirmation tier matching the inbound asset and amount.
+func (ob *Observer) IsInboundConfirmed(msg *crosschaintypes.MsgVoteInbound) bool {
+	confirmations := ob.ConfirmationCount(msg.CoinType, msg.Asset, msg.Amount)
+	if confirmations == 0 {
+		confirmations = 1
+	}
+	return ob.LastBlock() >= msg.InboundBlockHeight+confirmations-1
+}
+
 // LastBlockScanned get last block scanned (not necessarily caught up with the chain; could be slow/paused).
 func (ob *Observer) LastBlockScanned() uint64 {
 	height := atomic.LoadUint64(&ob.lastBlockScanned)
@@ -466,6 +489,123 @@ func (ob *Observer) ReadLastTxScannedFromDB() (string, error) {
 	return lastTx.Hash, nil
 }
 
+// SaveOutboundBroadcast records a broadcast outbound attempt with its signed payload to the database.
+func (ob *Observer) SaveOutboundBroadcast(nonce uint64, txHash string, signedPayload []byte) error {
+	return ob.upsertOutbound(nonce, txHash, map[string]any{"signed_payload": signedPayload})
+}
+
+// SetOutboundTrackerReported marks the outbound attempt as reported to the outbound tracker in the database.
+func (ob *Observer) SetOutboundTrackerReported(nonce uint64, txHash string) error {
+	return ob.upsertOutbound(nonce, txHash, map[string]any{"tracker_reported": true})
+}
+
+// SaveOutboundResult records the serialized result of an included outbound transaction to the database.
+func (ob *Observer) SaveOutboundResult(
+	nonce uint64,
+	txHash string,
+	result []byte,
+	status chains.ReceiveStatus,
+) error {
+	return ob.upsertOutbound(nonce, txHash, map[string]any{"result": result, "receive_status": status})
+}
+
+// SetOutboundVotePosted marks the outbound vote of the outbound attempt as posted in the database.
+func (ob *Observer) SetOutboundVotePosted(nonce uint64, txHash string) error {
+	return ob.upsertOutbound(nonce, txHash, map[string]any{"vote_status": clienttypes.OutboundVotePosted})
+}
+
+// LoadOutbounds loads the outbound attempts of the chain from the database.
+// Attempts older than the retention period are pruned from the database.
+func (ob *Observer) LoadOutbounds() ([]clienttypes.OutboundSQLType, error) {
+	chainID := ob.Chain().ChainId
+	cutoff := time.Now().Add(-OutboundRetention)
+
+	err := ob.db.Client().
+		Unscoped().
+		Where("chain_id = ? AND updated_at < ?", chainID, cutoff).
+		Delete(&clienttypes.OutboundSQLType{}).Error
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to prune outbounds")
+	}
+
+	var outbounds []clienttypes.OutboundSQLType
+	err = ob.db.Client().
+		Where("chain_id = ? AND schema_version = ?", chainID, clienttypes.OutboundSchemaVersion).
+		Order("nonce").
+		Find(&outbounds).Error
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to load outbounds")
+	}
+
+	return outbounds, nil
+}
+
+// ReportOutboundTrackersFromDB reports the broadcast outbounds that are not reported to the outbound tracker yet.
+// It covers the outbounds whose tracker reporter was interrupted (e.g. zetaclient restart).
+// Only the outbounds broadcast within the given timeout and included in the chain (isIncluded) are reported.
+func (ob *Observer) ReportOutboundTrackersFromDB(
+	ctx context.Context,
+	isIncluded func(ctx context.Context, txHash string) (bool, error),
+	timeout time.Duration,
+) error {
+	var pending []clienttypes.OutboundSQLType
+	err := ob.db.Client().
+		Where("chain_id = ? AND tracker_reported = ? AND vote_status = ? AND created_at > ?",
+			ob.Chain().ChainId, false, clienttypes.OutboundVoteNotPosted, time.Now().Add(-timeout)).
+		Find(&pending).Error
+	if err != nil {
+		return errors.Wrap(err, "unable to load pending outbound trackers")
+	}
+
+	for _, outbound := range pending {
+		logger := ob.logger.Outbound.With().
+			Uint64(logs.FieldNonce, outbound.Nonce).
+			Str(logs.FieldTx, outbound.TxHash).
+			Logger()
+
+		included, err := isIncluded(ctx, outbound.TxHash)
+		switch {
+		case err != nil:
+			logger.Error().Err(err).Msg("unable to check inclusion of outbound")
+			continue
+		case !included:
+			continue
+		}
+
+		zetaHash, err := ob.ZetacoreClient().
+			AddOutboundTracker(ctx, ob.Chain().ChainId, outbound.Nonce, outbound.TxHash, nil, "", -1)
+		if err != nil {
+			logger.Error().Err(err).Msg("error adding outbound to tracker")
+			continue
+		}
+		logger.Info().Str("zeta_tx_hash", zetaHash).Msg("reported outbound to tracker from db")
+
+		if err := ob.SetOutboundTrackerReported(outbound.Nonce, outbound.TxHash); err != nil {
+			logger.Error().Err(err).Msg("unable to mark outbound as reported")
+		}
+	}
+
+	return nil
+}
+
+// upsertOutbound creates or updates the outbound attempt of given nonce and tx hash with the given fields
+func (ob *Observer) upsertOutbound(nonce uint64, txHash string, fields map[string]any) error {
+	outbound := clienttypes.ToOutboundSQLType(ob.Chain().ChainId, nonce, txHash)
+
+	return ob.db.Client().Transaction(func(tx *gorm.DB) error {
+		// a struct condition would skip the zero nonce, so the condition is explicit
+		err := tx.
+			Where("chain_id = ? AND nonce = ? AND tx_hash = ?", outbound.ChainID, nonce, txHash).
+			FirstOrCreate(outbound).Error
+		if err != nil {
+			return errors.Wrap(err, "unable to create outbound")
+		}
+
+		// fields are updated separately, as byte slices in 'Assign' are treated as 'IN' conditions
+		return tx.Model(outbound).Updates(fields).Error
+	})
+}
+
 // PostVoteInbound posts a vote for the given vote message
 func (ob *Observer) PostVoteInbound(
 	ctx context.Context,
@@ -480,6 +620,21 @@ func (ob *Observer) PostVoteInbound(
 		chainID  = ob.Chain().ChainId
 	)
 
+	// when backfilling, only vote for inbounds whose cctx is not created yet
+	if IsBackfill(ctx) {
+		finalized, err := ob.IsInboundFinalized(ctx, msg)
+		if err != nil {
+			return "", err
+		}
+		if finalized {
+			ob.logger.Inbound.Info().
+				Int64("inbound.chain_id", chainID).
+				Str("inbound.external_tx_hash", txHash).
+				Msg("inbound backfill: cctx already created")
+			return msg.Digest(), nil
+		}
+	}
+
 	zetaHash, ballot, err := ob.ZetacoreClient().PostVoteInbound(ctx, gasLimit, retryGasLimit, msg)
 
 	lf := map[string]any{
@@ -7,15 +7,18 @@ import (
 	"testing"
 	"time"
 
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	lru "github.com/hashicorp/golang-lru"
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"github.com/zeta-chain/node/cmd"
 	"github.com/zeta-chain/node/pkg/chains"
 	"github.com/zeta-chain/node/pkg/coin"
 	"github.com/zeta-chain/node/testutil/sample"
+	crosschaintypes "github.com/zeta-chain/node/x/crosschain/types"
 	observertypes "github.com/zeta-chain/node/x/observer/types"
 	"github.com/zeta-chain/node/zetaclient/chains/base"
 	"github.com/zeta-chain/node/zetaclient/chains/interfaces"
@@ -25,6 +28,9 @@ import (
 	"github.com/zeta-chain/node/zetaclient/metrics"
 	"github.com/zeta-chain/node/zetaclient/testutils"
 	"github.com/zeta-chain/node/zetaclient/testutils/mocks"
+	clienttypes "github.com/zeta-chain/node/zetaclient/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 const (
@@ -364,6 +370,58 @@ func TestIsBlockConfirmed(t *testing.T) {
 	}
 }
 
+func TestIsInboundConfirmed(t *testing.T) {
+	tests := []struct {
+		name      string
+		amount    uint64
+		lastBlock uint64
+		confirmed bool
+	}{
+		{
+			name:      "should confirm small inbound with default confirmation count",
+			amount:    100,
+			lastBlock: 101,
+			confirmed: true,
+		},
+		{
+			name:      "should not confirm large inbound with default confirmation count",
+			amount:    1000,
+			lastBlock: 101,
+			confirmed: false,
+		},
+		{
+			name:      "should confirm large inbound with tier confirmation count",
+			amount:    1000,
+			lastBlock: 105,
+			confirmed: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// create observer with a confirmation tier of 6 blocks for 1000 units or more
+			ob := createObserver(t, chains.Ethereum, defaultAlertLatency)
+			chainParams := ob.ChainParams()
+			chainParams.ConfirmationTiers = []observertypes.ConfirmationTier{
+				{
+					CoinType:          coin.CoinType_Gas,
+					AmountThreshold:   sdkmath.NewUint(1000),
+					ConfirmationCount: 6,
+				},
+			}
+			ob.SetChainParams(chainParams)
+			ob = ob.WithLastBlock(tt.lastBlock)
+
+			msg := sample.InboundVote(coin.CoinType_Gas, chains.Ethereum.ChainId, chains.ZetaChainMainnet.ChainId)
+			msg.Asset = ""
+			msg.InboundBlockHeight = 100
+			msg.Amount = sdkmath.NewUint(tt.amount)
+
+			require.Equal(t, tt.confirmed, ob.IsInboundConfirmed(&msg))
+		})
+	}
+}
+
 func TestOutboundID(t *testing.T) {
 	tests := []struct {
 		name  string
@@ -610,6 +668,67 @@ func TestReadWriteDBLastTxScanned(t *testing.T) {
 	})
 }
 
+func TestOutboundsDB(t *testing.T) {
+	chain := chains.Ethereum
+	txHash := sample.EthAddress().Hex()
+
+	t.Run("should save and load outbound attempts", func(t *testing.T) {
+		// create observer
+		ob := createObserver(t, chain, defaultAlertLatency)
+
+		// save broadcast, result and vote status of the same attempt
+		require.NoError(t, ob.SaveOutboundBroadcast(0, txHash, []byte{0x01, 0x02}))
+		require.NoError(t, ob.SaveOutboundResult(0, txHash, []byte{0x03}, chains.ReceiveStatus_failed))
+		require.NoError(t, ob.SetOutboundTrackerReported(0, txHash))
+		require.NoError(t, ob.SetOutboundVotePosted(0, txHash))
+
+		outbounds, err := ob.LoadOutbounds()
+		require.NoError(t, err)
+		require.Len(t, outbounds, 1)
+		require.EqualValues(t, 0, outbounds[0].Nonce)
+		require.Equal(t, txHash, outbounds[0].TxHash)
+		require.Equal(t, []byte{0x01, 0x02}, outbounds[0].SignedPayload)
+		require.Equal(t, []byte{0x03}, outbounds[0].Result)
+		require.Equal(t, chains.ReceiveStatus_failed, outbounds[0].ReceiveStatus)
+		require.True(t, outbounds[0].TrackerReported)
+		require.Equal(t, clienttypes.OutboundVotePosted, outbounds[0].VoteStatus)
+	})
+
+	t.Run("should report included outbounds to tracker from db", func(t *testing.T) {
+		// create observer
+		ob := createObserver(t, chain, defaultAlertLatency)
+		otherHash := sample.EthAddress().Hex()
+
+		// mock zetacore client
+		zetacoreClient := mocks.NewZetacoreClient(t)
+		zetacoreClient.
+			On("AddOutboundTracker", mock.Anything, chain.ChainId, uint64(1), txHash, mock.Anything, mock.Anything, -int64(1)).
+			Return("zetaHash", nil).
+			Once()
+		ob = ob.WithZetacoreClient(zetacoreClient)
+
+		// save two broadcast outbounds, only the first one is included
+		require.NoError(t, ob.SaveOutboundBroadcast(1, txHash, nil))
+		require.NoError(t, ob.SaveOutboundBroadcast(2, otherHash, nil))
+		isIncluded := func(_ context.Context, hash string) (bool, error) {
+			return hash == txHash, nil
+		}
+
+		err := ob.ReportOutboundTrackersFromDB(context.Background(), isIncluded, time.Minute)
+		require.NoError(t, err)
+
+		// the included outbound is marked as reported and won't be reported again
+		outbounds, err := ob.LoadOutbounds()
+		require.NoError(t, err)
+		require.Len(t, outbounds, 2)
+		require.True(t, outbounds[0].TrackerReported)
+		require.False(t, outbounds[1].TrackerReported)
+
+		err = ob.ReportOutboundTrackersFromDB(context.Background(), isIncluded, time.Minute)
+		require.NoError(t, err)
+	})
+}
+
 func TestPostVoteInbound(t *testing.T) {
 	t.Run("should be able to post vote inbound", func(t *testing.T) {
 		// create observer
@@ -626,6 +745,82 @@ func TestPostVoteInbound(t *testing.T) {
 		require.NoError(t, err)
 		require.Equal(t, "sampleBallotIndex", ballot)
 	})
+
+	t.Run("should not post vote inbound for a created cctx when backfilling", func(t *testing.T) {
+		// create observer
+		ob := createObserver(t, chains.Ethereum, defaultAlertLatency)
+
+		// create mock zetacore client with the cctx of the inbound
+		msg := sample.InboundVote(coin.CoinType_Gas, chains.Ethereum.ChainId, chains.ZetaChainMainnet.ChainId)
+		zetacoreClient := mocks.NewZetacoreClient(t)
+		zetacoreClient.On("GetInboundHashToCctx", mock.Anything, msg.InboundHash).
+			Return(crosschaintypes.InboundHashToCctx{
+				InboundHash: msg.InboundHash,
+				CctxIndex:   []string{sample.ZetaIndex(t), msg.Digest()},
+			}, nil)
+		ob = ob.WithZetacoreClient(zetacoreClient)
+
+		// post vote inbound
+		ballot, err := ob.PostVoteInbound(base.WithBackfill(context.TODO()), &msg, 100000)
+		require.NoError(t, err)
+		require.Equal(t, msg.Digest(), ballot)
+		zetacoreClient.AssertNotCalled(t, "PostVoteInbound", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("should post vote inbound for an inbound without cctx when backfilling", func(t *testing.T) {
+		// create observer
+		ob := createObserver(t, chains.Ethereum, defaultAlertLatency)
+
+		// create mock zetacore client without the inbound hash
+		msg := sample.InboundVote(coin.CoinType_Gas, chains.Ethereum.ChainId, chains.ZetaChainMainnet.ChainId)
+		zetacoreClient := mocks.NewZetacoreClient(t)
+		zetacoreClient.On("GetInboundHashToCctx", mock.Anything, msg.InboundHash).
+			Return(crosschaintypes.InboundHashToCctx{}, status.Error(codes.NotFound, "not found"))
+		zetacoreClient.WithPostVoteInbound("", "sampleBallotIndex")
+		ob = ob.WithZetacoreClient(zetacoreClient)
+
+		// post vote inbound
+		ballot, err := ob.PostVoteInbound(base.WithBackfill(context.TODO()), &msg, 100000)
+		require.NoError(t, err)
+		require.Equal(t, "sampleBallotIndex", ballot)
+	})
+
+	t.Run("should post vote inbound for another event of the inbound when backfilling", func(t *testing.T) {
+		// create observer
+		ob := createObserver(t, chains.Ethereum, defaultAlertLatency)
+
+		// create mock zetacore client with the cctx of another event of the same inbound
+		msg := sample.InboundVote(coin.CoinType_Gas, chains.Ethereum.ChainId, chains.ZetaChainMainnet.ChainId)
+		zetacoreClient := mocks.NewZetacoreClient(t)
+		zetacoreClient.On("GetInboundHashToCctx", mock.Anything, msg.InboundHash).
+			Return(crosschaintypes.InboundHashToCctx{
+				InboundHash: msg.InboundHash,
+				CctxIndex:   []string{sample.ZetaIndex(t)},
+			}, nil)
+		zetacoreClient.WithPostVoteInbound("", "sampleBallotIndex")
+		ob = ob.WithZetacoreClient(zetacoreClient)
+
+		// post vote inbound
+		ballot, err := ob.PostVoteInbound(base.WithBackfill(context.TODO()), &msg, 100000)
+		require.NoError(t, err)
+		require.Equal(t, "sampleBallotIndex", ballot)
+	})
+
+	t.Run("should fail to post vote inbound if cctxs can't be queried when backfilling", func(t *testing.T) {
+		// create observer
+		ob := createObserver(t, chains.Ethereum, defaultAlertLatency)
+
+		// create mock zetacore client failing to query the cctxs of the inbound
+		msg := sample.InboundVote(coin.CoinType_Gas, chains.Ethereum.ChainId, chains.ZetaChainMainnet.ChainId)
+		zetacoreClient := mocks.NewZetacoreClient(t)
+		zetacoreClient.On("GetInboundHashToCctx", mock.Anything, msg.InboundHash).
+			Return(crosschaintypes.InboundHashToCctx{}, status.Error(codes.Unavailable, "connection refused"))
+		ob = ob.WithZetacoreClient(zetacoreClient)
+
+		// post vote inbound
+		_, err := ob.PostVoteInbound(base.WithBackfill(context.TODO()), &msg, 100000)
+		require.ErrorContains(t, err, "unable to get cctxs of inbound")
+	})
 }
 
 func TestAlertOnRPCLatency(t *testing.T) {
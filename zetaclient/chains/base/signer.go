@@ -127,3 +127,19 @@ func (s *Signer) Lock() {
 func (s *Signer) Unlock() {
 	s.mu.Unlock()
 }
+
+// OutboundRecorder returns the outbound recorder of the given observer.
+// A no-op recorder is returned if the observer does not persist outbound attempts.
+func OutboundRecorder(observer interfaces.ChainObserver) interfaces.OutboundRecorder {
+	if recorder, ok := observer.(interfaces.OutboundRecorder); ok {
+		return recorder
+	}
+	return noopOutboundRecorder{}
+}
+
+// noopOutboundRecorder is an outbound recorder that discards the outbound attempts
+type noopOutboundRecorder struct{}
+
+func (noopOutboundRecorder) SaveOutboundBroadcast(uint64, string, []byte) error { return nil }
+
+func (noopOutboundRecorder) SetOutboundTrackerReported(uint64, string) error { return nil }
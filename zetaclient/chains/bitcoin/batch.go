@@ -0,0 +1,61 @@
+package bitcoin
+
+import (
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcjson"
+	"github.com/pkg/errors"
+
+	"github.com/zeta-chain/node/pkg/chains"
+)
+
+// MaxOutboundBatchSize is the maximum number of cctxs (consecutive nonces) paid by one outbound
+const MaxOutboundBatchSize = 10
+
+// IsBatchedOutbound returns true if the outbound with given number of outputs pays more than one cctx
+//   - single outbound: [nonce-mark, payment to recipient, change to TSS (optional)]
+//   - batched outbound: [nonce-mark of the last nonce, payments to recipients (one per nonce), change to TSS]
+//
+// Note: the change output is mandatory in a batched outbound to tell it apart from a single outbound.
+func IsBatchedOutbound(numOutputs int) bool {
+	return numOutputs > 3
+}
+
+// OutboundNonceRange returns the range of nonces [first, last] paid by the outbound with given outputs.
+// The last nonce is told by the nonce-mark (1st output) and the first nonce by the number of payments.
+func OutboundNonceRange(vouts []btcjson.Vout) (uint64, uint64, error) {
+	if len(vouts) == 0 {
+		return 0, 0, fmt.Errorf("outbound has no output")
+	}
+
+	// the 1st output is the nonce-mark of the last nonce
+	nonceMark, err := GetSatoshis(vouts[0].Value)
+	if err != nil {
+		return 0, 0, errors.Wrap(err, "error getting nonce-mark satoshis")
+	}
+	if nonceMark < chains.NonceMarkAmount(0) {
+		return 0, 0, fmt.Errorf("invalid nonce-mark amount %d", nonceMark)
+	}
+	// #nosec G115 always positive
+	last := uint64(nonceMark - chains.NonceMarkAmount(0))
+
+	// single outbound pays only the last nonce
+	if !IsBatchedOutbound(len(vouts)) {
+		return last, last, nil
+	}
+
+	// #nosec G115 always positive
+	numPayments := uint64(len(vouts) - 2)
+	if numPayments > MaxOutboundBatchSize || numPayments > last+1 {
+		return 0, 0, fmt.Errorf("invalid number of payments %d for last nonce %d", numPayments, last)
+	}
+	return last + 1 - numPayments, last, nil
+}
+
+// OutboundBytesMaxBatch returns the maximum size (in vBytes) of an outbound paying the given number of cctxs
+func OutboundBytesMaxBatch(numPayments uint64) uint64 {
+	if numPayments <= 1 {
+		return OutboundBytesMax
+	}
+	return OutboundBytesMax + (numPayments-1)*bytesPerOutputP2TR
+}
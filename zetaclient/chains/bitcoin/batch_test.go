@@ -0,0 +1,85 @@
+package bitcoin
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/btcjson"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/node/pkg/chains"
+)
+
+func TestOutboundNonceRange(t *testing.T) {
+	// newVouts creates vouts with given nonce-mark and number of outputs
+	newVouts := func(nonceMark int64, numOutputs int) []btcjson.Vout {
+		vouts := make([]btcjson.Vout, numOutputs)
+		vouts[0].Value = float64(nonceMark) / 1e8
+		return vouts
+	}
+
+	tests := []struct {
+		name   string
+		vouts  []btcjson.Vout
+		first  uint64
+		last   uint64
+		errMsg string
+	}{
+		{
+			name:  "single outbound with change",
+			vouts: newVouts(chains.NonceMarkAmount(8), 3),
+			first: 8,
+			last:  8,
+		},
+		{
+			name:  "single outbound without change",
+			vouts: newVouts(chains.NonceMarkAmount(8), 2),
+			first: 8,
+			last:  8,
+		},
+		{
+			name:  "batched outbound",
+			vouts: newVouts(chains.NonceMarkAmount(8), 5),
+			first: 6,
+			last:  8,
+		},
+		{
+			name:  "max batched outbound",
+			vouts: newVouts(chains.NonceMarkAmount(20), MaxOutboundBatchSize+2),
+			first: 11,
+			last:  20,
+		},
+		{
+			name:   "no output",
+			vouts:  nil,
+			errMsg: "outbound has no output",
+		},
+		{
+			name:   "invalid nonce-mark amount",
+			vouts:  newVouts(chains.NonceMarkAmount(0)-1, 3),
+			errMsg: "invalid nonce-mark amount",
+		},
+		{
+			name:   "too many payments",
+			vouts:  newVouts(chains.NonceMarkAmount(20), MaxOutboundBatchSize+3),
+			errMsg: "invalid number of payments",
+		},
+		{
+			name:   "more payments than nonces",
+			vouts:  newVouts(chains.NonceMarkAmount(1), 5),
+			errMsg: "invalid number of payments",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			first, last, err := OutboundNonceRange(tt.vouts)
+			if tt.errMsg != "" {
+				require.ErrorContains(t, err, tt.errMsg)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.first, first)
+			require.Equal(t, tt.last, last)
+		})
+	}
+}
@@ -42,9 +42,15 @@ const (
 
 	// feeRateCountBackBlocks is the default number of blocks to look back for fee rate estimation
 	feeRateCountBackBlocks = 2
+
+	// RBFTxInSequenceNum is the sequence number of outbound inputs to opt in replace-by-fee (BIP-125)
+	RBFTxInSequenceNum = wire.MaxTxInSequenceNum - 2
 )
 
 var (
+	// BtcOutboundBytesCPFPChild is the size of the CPFP child tx (1 input, 1 output): 109vB
+	BtcOutboundBytesCPFPChild = OutboundSizeCPFPChild()
+
 	// BtcOutboundBytesDepositor is the outbound size incurred by the depositor: 68vB
 	BtcOutboundBytesDepositor = OutboundSizeDepositor()
 
@@ -148,6 +154,47 @@ func OutboundSizeWithdrawer() uint64 {
 	return bytesWiredTx + bytesInput + bytesOutput + bytes1stWitness/blockchain.WitnessScaleFactor
 }
 
+// OutboundSizeCPFPChild returns the size (109vB) of a CPFP child tx that spends the change of a stuck outbound
+func OutboundSizeCPFPChild() uint64 {
+	bytesWiredTx := WiredTxSize(1, 1)
+	bytesInput := uint64(1) * bytesPerInput         // change of the stuck outbound
+	bytesOutput := uint64(1) * bytesPerOutputP2WPKH // 1 P2WPKH output back to TSS
+
+	return bytesWiredTx + bytesInput + bytesOutput + bytes1stWitness/blockchain.WitnessScaleFactor
+}
+
+// IsRBFSignaled returns true if the tx opts in replace-by-fee (BIP-125) through any of its inputs
+func IsRBFSignaled(tx *wire.MsgTx) bool {
+	for _, txIn := range tx.TxIn {
+		if txIn.Sequence < wire.MaxTxInSequenceNum-1 {
+			return true
+		}
+	}
+	return false
+}
+
+// CalcRBFFee calculates the fee (in satoshis) a replacement tx has to pay to replace the original tx.
+// The replacement pays at least the given fee rate, and at least the original fee plus the relay fee
+// rate over its own size, as required by BIP-125 rule #4.
+func CalcRBFFee(originalFee int64, vsize int64, feeRate int64, relayFeeRate int64) int64 {
+	newFee := vsize * feeRate
+	minFee := originalFee + vsize*relayFeeRate
+	if newFee < minFee {
+		return minFee
+	}
+	return newFee
+}
+
+// CalcCPFPFee calculates the fee (in satoshis) a child tx has to pay to bring the package (parent + child)
+// fee rate to the given fee rate. It returns 0 if the parent already pays enough fee.
+func CalcCPFPFee(parentFee int64, parentVsize int64, childVsize int64, feeRate int64) int64 {
+	packageFee := (parentVsize + childVsize) * feeRate
+	if packageFee <= parentFee {
+		return 0
+	}
+	return packageFee - parentFee
+}
+
 // DepositorFee calculates the depositor fee in BTC for a given sat/byte fee rate
 // Note: the depositor fee is charged in order to cover the cost of spending the deposited UTXO in the future
 func DepositorFee(satPerByte int64) float64 {
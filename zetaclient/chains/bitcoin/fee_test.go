@@ -462,3 +462,42 @@ func TestOutboundSizeMinMaxError(t *testing.T) {
 	require.Error(t, err)
 	require.Equal(t, uint64(0), size)
 }
+
+func TestOutboundSizeCPFPChild(t *testing.T) {
+	require.Equal(t, uint64(109), OutboundSizeCPFPChild())
+	require.Equal(t, OutboundSizeCPFPChild(), BtcOutboundBytesCPFPChild)
+}
+
+func TestIsRBFSignaled(t *testing.T) {
+	newTx := func(sequences ...uint32) *wire.MsgTx {
+		tx := wire.NewMsgTx(wire.TxVersion)
+		for _, sequence := range sequences {
+			txIn := wire.NewTxIn(&wire.OutPoint{}, nil, nil)
+			txIn.Sequence = sequence
+			tx.AddTxIn(txIn)
+		}
+		return tx
+	}
+
+	require.True(t, IsRBFSignaled(newTx(RBFTxInSequenceNum)))
+	require.True(t, IsRBFSignaled(newTx(wire.MaxTxInSequenceNum, RBFTxInSequenceNum)))
+	require.False(t, IsRBFSignaled(newTx(wire.MaxTxInSequenceNum)))
+	require.False(t, IsRBFSignaled(newTx(wire.MaxTxInSequenceNum-1, wire.MaxTxInSequenceNum)))
+	require.False(t, IsRBFSignaled(newTx()))
+}
+
+func TestCalcRBFFee(t *testing.T) {
+	// fee rate increase covers the relay fee
+	require.Equal(t, int64(5000), CalcRBFFee(2500, 250, 20, 1))
+
+	// fee rate increase doesn't cover the relay fee, pay original fee + relay fee
+	require.Equal(t, int64(2750), CalcRBFFee(2500, 250, 10, 1))
+}
+
+func TestCalcCPFPFee(t *testing.T) {
+	// parent pays 10 sat/vB, package targets 20 sat/vB
+	require.Equal(t, int64(20*(250+109)-2500), CalcCPFPFee(2500, 250, 109, 20))
+
+	// parent already pays enough
+	require.Equal(t, int64(0), CalcCPFPFee(10000, 250, 109, 20))
+}
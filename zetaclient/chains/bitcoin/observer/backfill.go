@@ -0,0 +1,42 @@
+package observer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	"github.com/zeta-chain/node/zetaclient/chains/base"
+)
+
+// BackfillInbound replays the inbound scanning over the block range [from, to] to vote for missed inbounds.
+// Whole blocks are scanned one by one, the last scanned block of the observer is left untouched.
+func (ob *Observer) BackfillInbound(ctx context.Context, from, to uint64) error {
+	if err := base.ValidateBackfillRange(from, to, ob.LastBlockScanned()); err != nil {
+		return err
+	}
+	ctx = base.WithBackfill(ctx)
+
+	for blockNumber := from; blockNumber <= to; blockNumber++ {
+		confirmed, err := ob.observeInboundInBlock(ctx, blockNumber)
+		switch {
+		case err != nil:
+			return errors.Wrapf(err, "unable to backfill block %d for chain %d", blockNumber, ob.Chain().ChainId)
+		case !confirmed:
+			return fmt.Errorf("unable to backfill block %d for chain %d: inbound not confirmed yet", blockNumber, ob.Chain().ChainId)
+		}
+		ob.logger.Inbound.Info().
+			Uint64("backfill.from", from).
+			Uint64("backfill.to", to).
+			Uint64("backfill.scanned", blockNumber).
+			Msg("BackfillInbound: block scanned")
+
+		if blockNumber < to {
+			if err := base.WaitBackfillBatchInterval(ctx); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
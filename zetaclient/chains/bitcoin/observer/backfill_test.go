@@ -0,0 +1,64 @@
+package observer_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcjson"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/node/pkg/chains"
+	"github.com/zeta-chain/node/testutil/sample"
+	"github.com/zeta-chain/node/zetaclient/testutils/mocks"
+)
+
+func Test_BackfillInbound(t *testing.T) {
+	chain := chains.BitcoinMainnet
+	params := mocks.MockChainParams(chain.ChainId, 10)
+
+	t.Run("should not backfill blocks not scanned yet", func(t *testing.T) {
+		ob := MockBTCObserver(t, chain, params, nil)
+		ob.WithLastBlockScanned(90)
+
+		err := ob.BackfillInbound(context.Background(), 80, 91)
+		require.ErrorContains(t, err, "is greater than last scanned block")
+	})
+
+	t.Run("should backfill a block without inbound", func(t *testing.T) {
+		btcClient := mocks.NewBTCRPCClient(t)
+		btcClient.On("GetBlockCount").Return(int64(100), nil)
+
+		// block only contains the coinbase tx
+		hash := sample.BtcHash()
+		btcClient.On("GetBlockHash", int64(80)).Return(&hash, nil)
+		btcClient.On("GetBlockHeader", &hash).Return(&wire.BlockHeader{}, nil)
+		btcClient.On("GetBlockVerboseTx", &hash).Return(&btcjson.GetBlockVerboseTxResult{
+			Height: 80,
+			Tx:     []btcjson.TxRawResult{{}},
+		}, nil)
+
+		ob := MockBTCObserver(t, chain, params, btcClient)
+		ob.WithLastBlockScanned(90)
+
+		err := ob.BackfillInbound(context.Background(), 80, 80)
+		require.NoError(t, err)
+
+		// last scanned block is untouched
+		require.EqualValues(t, 90, ob.LastBlockScanned())
+	})
+
+	t.Run("should stop backfilling on RPC error", func(t *testing.T) {
+		btcClient := mocks.NewBTCRPCClient(t)
+		btcClient.On("GetBlockCount").Return(int64(100), nil)
+		btcClient.On("GetBlockHash", mock.Anything).Return(nil, errors.New("RPC error"))
+
+		ob := MockBTCObserver(t, chain, params, btcClient)
+		ob.WithLastBlockScanned(90)
+
+		err := ob.BackfillInbound(context.Background(), 80, 85)
+		require.ErrorContains(t, err, "unable to backfill block 80")
+	})
+}
@@ -0,0 +1,95 @@
+package observer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcjson"
+
+	observertypes "github.com/zeta-chain/node/x/observer/types"
+	"github.com/zeta-chain/node/zetaclient/chains/bitcoin"
+)
+
+// utxoDustFactor defines the dust UTXOs for the consolidation trigger: the UTXOs whose value is lower than
+// utxoDustFactor times the default depositor fee (cost of spending one input at 20 sat/vB)
+const utxoDustFactor = 10
+
+// UTXOConsolidationNeeded returns true if the TSS UTXOs should be consolidated according to the chain params,
+// along with the number of UTXOs and dust UTXOs to report in the consolidation vote.
+//
+// The consolidation is only needed within low-fee windows if a max fee rate is set.
+func (ob *Observer) UTXOConsolidationNeeded() (utxoCount uint64, dustCount uint64, needed bool) {
+	params := ob.ChainParams()
+	if !params.IsUTXOConsolidationEnabled() {
+		return 0, 0, false
+	}
+
+	ob.Mu().Lock()
+	utxos := ob.utxos
+	feeRate := ob.lastFeeRate
+	ob.Mu().Unlock()
+
+	// wait for a low-fee window, the fee rate is unknown until it's posted once
+	if params.UtxoConsolidationMaxFeeRate > 0 && (feeRate == 0 || feeRate > params.UtxoConsolidationMaxFeeRate) {
+		return 0, 0, false
+	}
+
+	for _, utxo := range utxos {
+		if utxo.Amount < utxoDustFactor*bitcoin.DefaultDepositorFee {
+			dustCount++
+		}
+	}
+	utxoCount = uint64(len(utxos))
+
+	return utxoCount, dustCount, params.IsUTXOConsolidationNeeded(utxoCount, dustCount)
+}
+
+// SelectConsolidationUTXOs selects the UTXOs to be swept by the consolidation of given nonce.
+//
+// The nonce-mark of the prior nonce comes first, followed by the smallest UTXOs up to utxosToSpend inputs in total.
+// Returns the selected UTXOs and their total value.
+func (ob *Observer) SelectConsolidationUTXOs(
+	ctx context.Context,
+	nonce uint64,
+	utxosToSpend uint16,
+) ([]btcjson.ListUnspentResult, float64, error) {
+	idx := -1
+	if nonce == 0 {
+		ob.Mu().Lock()
+		defer ob.Mu().Unlock()
+	} else {
+		// for nonce > 0; we proceed only when we see the nonce-mark utxo
+		preTxid, err := ob.getOutboundIDByNonce(ctx, nonce-1, false)
+		if err != nil {
+			return nil, 0, err
+		}
+		ob.Mu().Lock()
+		defer ob.Mu().Unlock()
+		idx, err = ob.findNonceMarkUTXO(nonce-1, preTxid)
+		if err != nil {
+			return nil, 0, err
+		}
+	}
+
+	results := make([]btcjson.ListUnspentResult, 0, utxosToSpend)
+	total := 0.0
+	if idx >= 0 {
+		results = append(results, ob.utxos[idx])
+		total += ob.utxos[idx].Amount
+	}
+
+	// sweep the smallest UTXOs first as they are the ones bloating the wallet
+	for i := 0; i < len(ob.utxos) && len(results) < int(utxosToSpend); i++ {
+		if i == idx {
+			continue
+		}
+		results = append(results, ob.utxos[i])
+		total += ob.utxos[i].Amount
+	}
+
+	if len(results) < observertypes.MinConsolidationUTXOs {
+		return nil, 0, fmt.Errorf("SelectConsolidationUTXOs: not enough UTXOs to consolidate: %d", len(results))
+	}
+
+	return results, total, nil
+}
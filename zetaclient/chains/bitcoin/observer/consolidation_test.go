@@ -0,0 +1,130 @@
+package observer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcjson"
+	"github.com/stretchr/testify/require"
+)
+
+// setConsolidationParams sets the UTXO consolidation thresholds of the observer chain params
+func setConsolidationParams(ob *Observer, threshold uint64, dustRatio uint32, maxFeeRate uint64) {
+	params := ob.ChainParams()
+	params.UtxoConsolidationThreshold = threshold
+	params.UtxoDustRatioThreshold = dustRatio
+	params.UtxoConsolidationMaxFeeRate = maxFeeRate
+	ob.SetChainParams(params)
+}
+
+func TestUTXOConsolidationNeeded(t *testing.T) {
+	t.Run("should not consolidate if disabled", func(t *testing.T) {
+		ob := createObserverWithUTXOs(t)
+		_, _, needed := ob.UTXOConsolidationNeeded()
+		require.False(t, needed)
+	})
+
+	t.Run("should consolidate if UTXO count reaches threshold", func(t *testing.T) {
+		ob := createObserverWithUTXOs(t)
+		setConsolidationParams(ob, 10, 0, 0)
+		utxoCount, dustCount, needed := ob.UTXOConsolidationNeeded()
+		require.True(t, needed)
+		require.EqualValues(t, 10, utxoCount)
+		require.EqualValues(t, 0, dustCount)
+
+		setConsolidationParams(ob, 11, 0, 0)
+		_, _, needed = ob.UTXOConsolidationNeeded()
+		require.False(t, needed)
+	})
+
+	t.Run("should consolidate if dust ratio reaches threshold", func(t *testing.T) {
+		ob := createObserverWithUTXOs(t)
+
+		// 2 dust UTXOs out of 10
+		ob.utxos[0].Amount = 0.00002
+		ob.utxos[1].Amount = 0.0001
+		setConsolidationParams(ob, 0, 20, 0)
+		utxoCount, dustCount, needed := ob.UTXOConsolidationNeeded()
+		require.True(t, needed)
+		require.EqualValues(t, 10, utxoCount)
+		require.EqualValues(t, 2, dustCount)
+
+		setConsolidationParams(ob, 0, 21, 0)
+		_, _, needed = ob.UTXOConsolidationNeeded()
+		require.False(t, needed)
+	})
+
+	t.Run("should consolidate only within low-fee window", func(t *testing.T) {
+		ob := createObserverWithUTXOs(t)
+		setConsolidationParams(ob, 10, 0, 5)
+
+		// fee rate unknown
+		_, _, needed := ob.UTXOConsolidationNeeded()
+		require.False(t, needed)
+
+		ob.lastFeeRate = 6
+		_, _, needed = ob.UTXOConsolidationNeeded()
+		require.False(t, needed)
+
+		ob.lastFeeRate = 5
+		_, _, needed = ob.UTXOConsolidationNeeded()
+		require.True(t, needed)
+	})
+
+	t.Run("should not consolidate too few UTXOs", func(t *testing.T) {
+		ob := createObserverWithUTXOs(t)
+		ob.utxos = ob.utxos[:2]
+		setConsolidationParams(ob, 1, 0, 0)
+		_, _, needed := ob.UTXOConsolidationNeeded()
+		require.False(t, needed)
+	})
+}
+
+func TestSelectConsolidationUTXOs(t *testing.T) {
+	ctx := context.Background()
+
+	dummyTxID := "6e6f71d281146c1fc5c755b35908ee449f26786c84e2ae18f98b268de40b7ec4"
+
+	t.Run("should select smallest UTXOs for nonce 0", func(t *testing.T) {
+		ob := createObserverWithUTXOs(t)
+
+		result, total, err := ob.SelectConsolidationUTXOs(ctx, 0, 5)
+		require.NoError(t, err)
+		require.Equal(t, ob.utxos[0:5], result)
+		require.InDelta(t, 1.05, total, 1e-8)
+	})
+
+	t.Run("should select nonce-mark first", func(t *testing.T) {
+		ob := createObserverWithUTXOs(t)
+		mineTxNSetNonceMark(t, ob, 0, dummyTxID, -1) // mine a transaction and set nonce-mark utxo for nonce 0
+
+		// add a UTXO smaller than the nonce-mark
+		smallest := btcjson.ListUnspentResult{Address: ob.utxos[0].Address, Amount: 0.00001}
+		ob.utxos = append([]btcjson.ListUnspentResult{smallest}, ob.utxos...)
+
+		result, total, err := ob.SelectConsolidationUTXOs(ctx, 1, 3)
+		require.NoError(t, err)
+		require.Equal(t, []btcjson.ListUnspentResult{ob.utxos[1], ob.utxos[0], ob.utxos[2]}, result)
+		require.InDelta(t, 0.00002+0.00001+0.01, total, 1e-8)
+	})
+
+	t.Run("should fail if nonce-mark not found", func(t *testing.T) {
+		ob := createObserverWithUTXOs(t)
+		ob.includedTxResults[ob.OutboundID(0)] = &btcjson.GetTransactionResult{TxID: dummyTxID}
+
+		result, total, err := ob.SelectConsolidationUTXOs(ctx, 1, 5)
+		require.Error(t, err)
+		require.Nil(t, result)
+		require.Zero(t, total)
+	})
+
+	t.Run("should fail if not enough UTXOs", func(t *testing.T) {
+		ob := createObserverWithUTXOs(t)
+		ob.utxos = ob.utxos[:2]
+
+		result, total, err := ob.SelectConsolidationUTXOs(ctx, 0, 5)
+		require.ErrorContains(t, err, "not enough UTXOs to consolidate")
+		require.Nil(t, result)
+		require.Zero(t, total)
+	})
+}
@@ -37,7 +37,7 @@ const (
 
 // BTCInboundEvent represents an incoming transaction event
 type BTCInboundEvent struct {
-	// FromAddress is the first input address
+	// FromAddress is the first input address, empty if the input script has no address (e.g., bare multisig)
 	FromAddress string
 
 	// ToAddress is the ZEVM receiver address
@@ -88,6 +88,15 @@ func (event *BTCInboundEvent) Processability() InboundProcessability {
 	return InboundProcessabilityGood
 }
 
+// RefundAddress returns the BTC address to refund if the inbound gets reverted
+// The revert address in the standard memo takes precedence over the sender address
+func (event *BTCInboundEvent) RefundAddress() string {
+	if event.MemoStd != nil && event.MemoStd.RevertOptions.RevertAddress != "" {
+		return event.MemoStd.RevertOptions.RevertAddress
+	}
+	return event.FromAddress
+}
+
 // DecodeMemoBytes decodes the contained memo bytes as either standard or legacy memo
 func (event *BTCInboundEvent) DecodeMemoBytes(chainID int64) error {
 	var (
@@ -223,10 +232,7 @@ func (ob *Observer) NewInboundVoteFromStdMemo(
 ) *crosschaintypes.MsgVoteInbound {
 	// replace 'sender' with 'revertAddress' if specified in the memo, so that
 	// zetacore will refund to the address specified by the user in the revert options.
-	sender := event.FromAddress
-	if event.MemoStd.RevertOptions.RevertAddress != "" {
-		sender = event.MemoStd.RevertOptions.RevertAddress
-	}
+	sender := event.RefundAddress()
 
 	// make a legacy message so that zetacore can process it as V1
 	msgBytes := append(event.MemoStd.Receiver.Bytes(), event.MemoStd.Payload...)
@@ -117,6 +117,43 @@ func Test_CheckProcessability(t *testing.T) {
 	}
 }
 
+func Test_RefundAddress(t *testing.T) {
+	revertAddress := sample.BtcAddressP2WPKH(t, &chaincfg.MainNetParams)
+	revertOptions := crosschaintypes.NewEmptyRevertOptions()
+	revertOptions.RevertAddress = revertAddress
+
+	t.Run("should return sender address for legacy memo", func(t *testing.T) {
+		event := createTestBtcEvent(t, &chaincfg.MainNetParams, []byte("a memo"), nil)
+		require.Equal(t, event.FromAddress, event.RefundAddress())
+	})
+
+	t.Run("should return sender address if no revert address in standard memo", func(t *testing.T) {
+		event := createTestBtcEvent(t, &chaincfg.MainNetParams, []byte("a memo"), &memo.InboundMemo{})
+		require.Equal(t, event.FromAddress, event.RefundAddress())
+	})
+
+	t.Run("should return revert address in standard memo", func(t *testing.T) {
+		event := createTestBtcEvent(t, &chaincfg.MainNetParams, []byte("a memo"), &memo.InboundMemo{
+			FieldsV0: memo.FieldsV0{RevertOptions: revertOptions},
+		})
+		require.Equal(t, revertAddress, event.RefundAddress())
+	})
+
+	t.Run("should return revert address for sender without address", func(t *testing.T) {
+		event := createTestBtcEvent(t, &chaincfg.MainNetParams, []byte("a memo"), &memo.InboundMemo{
+			FieldsV0: memo.FieldsV0{RevertOptions: revertOptions},
+		})
+		event.FromAddress = ""
+		require.Equal(t, revertAddress, event.RefundAddress())
+	})
+
+	t.Run("should return empty address if no sender and revert address", func(t *testing.T) {
+		event := createTestBtcEvent(t, &chaincfg.MainNetParams, []byte("a memo"), nil)
+		event.FromAddress = ""
+		require.Empty(t, event.RefundAddress())
+	})
+}
+
 func Test_DecodeEventMemoBytes(t *testing.T) {
 	// test cases
 	tests := []struct {
@@ -45,7 +45,7 @@ func (ob *Observer) WatchInbound(ctx context.Context) error {
 	for {
 		select {
 		case <-ticker.C():
-			if !app.IsInboundObservationEnabled() {
+			if !app.IsInboundObservationEnabled(ob.Chain().ChainId) {
 				sampledLogger.Info().
 					Msgf("WatchInbound: inbound observation is disabled for chain %d", ob.Chain().ChainId)
 				continue
@@ -103,47 +103,13 @@ func (ob *Observer) ObserveInbound(ctx context.Context) error {
 		return nil
 	}
 
-	// query incoming gas asset to TSS address
-	// #nosec G115 always in range
-	res, err := ob.GetBlockByNumberCached(int64(blockNumber))
+	// query incoming gas asset to TSS address and post inbound votes
+	confirmed, err := ob.observeInboundInBlock(ctx, blockNumber)
 	if err != nil {
-		ob.logger.Inbound.Error().Err(err).Msgf("observeInboundBTC: error getting bitcoin block %d", blockNumber)
-		return err
+		return err // we have to re-scan this block next time
 	}
-	ob.logger.Inbound.Info().Msgf("observeInboundBTC: block %d has %d txs, current block %d, last scanned %d",
-		blockNumber, len(res.Block.Tx), currentBlock, blockNumber-1)
-
-	// add block header to zetacore
-	if len(res.Block.Tx) > 1 {
-		// filter incoming txs to TSS address
-		tssAddress := ob.TSSAddressString()
-
-		// #nosec G115 always positive
-		events, err := FilterAndParseIncomingTx(
-			ob.btcClient,
-			res.Block.Tx,
-			uint64(res.Block.Height),
-			tssAddress,
-			ob.logger.Inbound,
-			ob.netParams,
-		)
-		if err != nil {
-			ob.logger.Inbound.Error().
-				Err(err).
-				Msgf("observeInboundBTC: error filtering incoming txs for block %d", blockNumber)
-			return err // we have to re-scan this block next time
-		}
-
-		// post inbound vote message to zetacore
-		for _, event := range events {
-			msg := ob.GetInboundVoteFromBtcEvent(event)
-			if msg != nil {
-				_, err = ob.PostVoteInbound(ctx, msg, zetacore.PostVoteInboundExecutionGasLimit)
-				if err != nil {
-					return errors.Wrapf(err, "error PostVoteInbound") // we have to re-scan this block next time
-				}
-			}
-		}
+	if !confirmed {
+		return nil // re-scan this block next time
 	}
 
 	// save last scanned block to both memory and db
@@ -156,6 +122,61 @@ func (ob *Observer) ObserveInbound(ctx context.Context) error {
 	return nil
 }
 
+// observeInboundInBlock filters the inbounds to TSS addresses in the given block and posts the votes to zetacore.
+// Returns false if an inbound of the block doesn't have enough confirmations yet, the block has to be scanned again.
+func (ob *Observer) observeInboundInBlock(ctx context.Context, blockNumber uint64) (bool, error) {
+	// #nosec G115 always in range
+	res, err := ob.GetBlockByNumberCached(int64(blockNumber))
+	if err != nil {
+		ob.logger.Inbound.Error().Err(err).Msgf("observeInboundBTC: error getting bitcoin block %d", blockNumber)
+		return false, err
+	}
+	ob.logger.Inbound.Info().Msgf("observeInboundBTC: block %d has %d txs", blockNumber, len(res.Block.Tx))
+
+	// the coinbase tx is never an inbound
+	if len(res.Block.Tx) <= 1 {
+		return true, nil
+	}
+
+	// filter incoming txs to TSS address
+	tssAddress := ob.TSSAddressString()
+
+	// #nosec G115 always positive
+	events, err := FilterAndParseIncomingTx(
+		ob.btcClient,
+		res.Block.Tx,
+		uint64(res.Block.Height),
+		tssAddress,
+		ob.logger.Inbound,
+		ob.netParams,
+	)
+	if err != nil {
+		ob.logger.Inbound.Error().
+			Err(err).
+			Msgf("observeInboundBTC: error filtering incoming txs for block %d", blockNumber)
+		return false, err
+	}
+
+	// post inbound vote message to zetacore
+	for _, event := range events {
+		msg := ob.GetInboundVoteFromBtcEvent(event)
+		if msg != nil {
+			// large amounts may require more confirmations
+			if !ob.IsInboundConfirmed(msg) {
+				ob.logger.Inbound.Info().
+					Msgf("observeInboundBTC: inbound %s of amount %s is not confirmed yet", msg.InboundHash, msg.Amount)
+				return false, nil
+			}
+			_, err = ob.PostVoteInbound(ctx, msg, zetacore.PostVoteInboundExecutionGasLimit)
+			if err != nil {
+				return false, errors.Wrapf(err, "error PostVoteInbound")
+			}
+		}
+	}
+
+	return true, nil
+}
+
 // WatchInboundTracker watches zetacore for bitcoin inbound trackers
 // TODO(revamp): move all ticker related methods in the same file
 func (ob *Observer) WatchInboundTracker(ctx context.Context) error {
@@ -174,7 +195,7 @@ func (ob *Observer) WatchInboundTracker(ctx context.Context) error {
 	for {
 		select {
 		case <-ticker.C():
-			if !app.IsInboundObservationEnabled() {
+			if !app.IsInboundObservationEnabled(ob.Chain().ChainId) {
 				continue
 			}
 			err := ob.ProcessInboundTrackers(ctx)
@@ -284,6 +305,11 @@ func (ob *Observer) CheckReceiptForBtcTxHash(ctx context.Context, txHash string,
 		return "", errors.New("no message built for btc sent to TSS")
 	}
 
+	// check confirmation required by the amount
+	if !ob.IsInboundConfirmed(msg) {
+		return "", fmt.Errorf("inbound %s of amount %s is not confirmed yet", msg.InboundHash, msg.Amount)
+	}
+
 	if !vote {
 		return msg.Digest(), nil
 	}
@@ -365,6 +391,13 @@ func (ob *Observer) GetInboundVoteFromBtcEvent(event *BTCInboundEvent) *crosscha
 		return nil
 	}
 
+	// skip the inbound and move on (e.g., sender of unknown script type and no revert address in memo)
+	// we don't know whom to refund if this tx gets reverted in zetacore
+	if event.RefundAddress() == "" {
+		ob.Logger().Inbound.Info().Fields(lf).Msg("no sender or revert address found for inbound, skipping")
+		return nil
+	}
+
 	// convert the amount to integer (satoshis)
 	amountSats, err := bitcoin.GetSatoshis(event.Value)
 	if err != nil {
@@ -459,12 +492,6 @@ func GetBtcEventWithoutWitness(
 			return nil, errors.Wrapf(err, "error getting sender address for inbound: %s", tx.Txid)
 		}
 
-		// skip this tx and move on (e.g., due to unknown script type)
-		// we don't know whom to refund if this tx gets reverted in zetacore
-		if fromAddress == "" {
-			return nil, nil
-		}
-
 		return &BTCInboundEvent{
 			FromAddress:  fromAddress,
 			ToAddress:    tssAddress,
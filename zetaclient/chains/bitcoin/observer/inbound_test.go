@@ -23,6 +23,7 @@ import (
 	"github.com/zeta-chain/node/testutil/sample"
 	"github.com/zeta-chain/node/zetaclient/chains/bitcoin"
 	"github.com/zeta-chain/node/zetaclient/chains/bitcoin/observer"
+	"github.com/zeta-chain/node/zetaclient/chains/bitcoin/rpc"
 	clientcommon "github.com/zeta-chain/node/zetaclient/common"
 	"github.com/zeta-chain/node/zetaclient/keys"
 	"github.com/zeta-chain/node/zetaclient/testutils"
@@ -172,6 +173,7 @@ func Test_GetInboundVoteFromBtcEvent(t *testing.T) {
 		{
 			name: "should return vote for legacy memo",
 			event: &observer.BTCInboundEvent{
+				FromAddress: sample.BtcAddressP2WPKH(t, &chaincfg.MainNetParams),
 				// raw address + payload
 				MemoBytes: testutil.HexToBytes(t, "2d07a9cbd57dcca3e2cf966c88bc874445b6e3b668656c6c6f207361746f736869"),
 			},
@@ -184,6 +186,15 @@ func Test_GetInboundVoteFromBtcEvent(t *testing.T) {
 			},
 			nilVote: true,
 		},
+		{
+			name: "should return nil if no address to refund",
+			event: &observer.BTCInboundEvent{
+				// sender of unknown script type (e.g., bare multisig)
+				FromAddress: "",
+				MemoBytes:   testutil.HexToBytes(t, "2d07a9cbd57dcca3e2cf966c88bc874445b6e3b668656c6c6f207361746f736869"),
+			},
+			nilVote: true,
+		},
 		{
 			name: "should return nil on donation message",
 			event: &observer.BTCInboundEvent{
@@ -232,6 +243,71 @@ func TestGetSenderAddressByVin(t *testing.T) {
 		require.Equal(t, "bc1q68kxnq52ahz5vd6c8czevsawu0ux9nfrzzrh6e", sender)
 	})
 
+	t.Run("should get sender address of each script type", func(t *testing.T) {
+		tests := []struct {
+			name           string
+			txHash         string
+			vout           uint32
+			expectedSender string
+		}{
+			{
+				name: "P2TR",
+				// https://mempool.space/tx/3618e869f9e87863c0f1cc46dbbaa8b767b4a5d6d60b143c2c50af52b257e867
+				txHash:         "3618e869f9e87863c0f1cc46dbbaa8b767b4a5d6d60b143c2c50af52b257e867",
+				vout:           2,
+				expectedSender: "bc1px3peqcd60hk7wqyqk36697u9hzugq0pd5lzvney93yzzrqy4fkpq6cj7m3",
+			},
+			{
+				name: "P2WSH",
+				// https://mempool.space/tx/d13de30b0cc53b5c4702b184ae0a0b0f318feaea283185c1cddb8b341c27c016
+				txHash:         "d13de30b0cc53b5c4702b184ae0a0b0f318feaea283185c1cddb8b341c27c016",
+				vout:           0,
+				expectedSender: "bc1q79kmcyc706d6nh7tpzhnn8lzp76rp0tepph3hqwrhacqfcy4lwxqft0ppq",
+			},
+			{
+				name: "P2SH",
+				// https://mempool.space/tx/211568441340fd5e10b1a8dcb211a18b9e853dbdf265ebb1c728f9b52813455a
+				txHash:         "211568441340fd5e10b1a8dcb211a18b9e853dbdf265ebb1c728f9b52813455a",
+				vout:           0,
+				expectedSender: "3MqRRSP76qxdVD9K4cfFnVtSLVwaaAjm3t",
+			},
+			{
+				name: "P2PKH",
+				// https://mempool.space/tx/781fc8d41b476dbceca283ebff9573fda52c8fdbba5e78152aeb4432286836a7
+				txHash:         "781fc8d41b476dbceca283ebff9573fda52c8fdbba5e78152aeb4432286836a7",
+				vout:           1,
+				expectedSender: "1ESQp1WQi7fzSpzCNs2oBTqaUBmNjLQLoV",
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				rpcClient := testrpc.CreateBTCRPCAndLoadTx(t, TestDataDir, chain.ChainId, tt.txHash)
+
+				sender, err := observer.GetSenderAddressByVin(rpcClient, btcjson.Vin{Txid: tt.txHash, Vout: tt.vout}, net)
+				require.NoError(t, err)
+				require.Equal(t, tt.expectedSender, sender)
+			})
+		}
+	})
+
+	t.Run("should query previous tx only once with cached client", func(t *testing.T) {
+		// https://mempool.space/tx/c5d224963832fc0b9a597251c2342a17b25e481a88cc9119008e8f8296652697
+		txHash := "c5d224963832fc0b9a597251c2342a17b25e481a88cc9119008e8f8296652697"
+		msgTx := testutils.LoadBTCMsgTx(t, TestDataDir, chain.ChainId, txHash)
+
+		rpcClient := mocks.NewBTCRPCClient(t)
+		rpcClient.On("GetRawTransaction", mock.Anything).Return(btcutil.NewTx(msgTx), nil).Once()
+		cachedClient := rpc.NewCachedClient(rpcClient)
+
+		// resolve the sender of the same input twice
+		for i := 0; i < 2; i++ {
+			sender, err := observer.GetSenderAddressByVin(cachedClient, btcjson.Vin{Txid: txHash, Vout: 2}, net)
+			require.NoError(t, err)
+			require.Equal(t, "bc1q68kxnq52ahz5vd6c8czevsawu0ux9nfrzzrh6e", sender)
+		}
+	})
+
 	t.Run("should return error on invalid txHash", func(t *testing.T) {
 		rpcClient := mocks.NewBTCRPCClient(t)
 		// use invalid tx hash
@@ -545,7 +621,7 @@ func TestGetBtcEventWithoutWitness(t *testing.T) {
 		require.Nil(t, event)
 	})
 
-	t.Run("should skip tx if sender address is empty", func(t *testing.T) {
+	t.Run("should get BTC inbound event with empty sender address on unknown script", func(t *testing.T) {
 		// https://mempool.space/tx/c5d224963832fc0b9a597251c2342a17b25e481a88cc9119008e8f8296652697
 		preVout := uint32(2)
 		preHash := "c5d224963832fc0b9a597251c2342a17b25e481a88cc9119008e8f8296652697"
@@ -573,7 +649,8 @@ func TestGetBtcEventWithoutWitness(t *testing.T) {
 			depositorFee,
 		)
 		require.NoError(t, err)
-		require.Nil(t, event)
+		require.NotNil(t, event)
+		require.Empty(t, event.FromAddress)
 	})
 }
 
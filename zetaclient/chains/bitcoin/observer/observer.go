@@ -9,6 +9,7 @@ import (
 	"sort"
 	"strings"
 
+	sdkmath "cosmossdk.io/math"
 	"github.com/btcsuite/btcd/btcjson"
 	"github.com/btcsuite/btcd/btcutil"
 	"github.com/btcsuite/btcd/chaincfg"
@@ -18,6 +19,7 @@ import (
 
 	"github.com/zeta-chain/node/pkg/bg"
 	"github.com/zeta-chain/node/pkg/chains"
+	"github.com/zeta-chain/node/pkg/coin"
 	observertypes "github.com/zeta-chain/node/x/observer/types"
 	"github.com/zeta-chain/node/zetaclient/chains/base"
 	"github.com/zeta-chain/node/zetaclient/chains/bitcoin"
@@ -33,12 +35,6 @@ const (
 
 	// RegnetStartBlock is the hardcoded start block for regnet
 	RegnetStartBlock = 100
-
-	// BigValueSats contains the threshold to determine a big value in Bitcoin represents 2 BTC
-	BigValueSats = 200000000
-
-	// BigValueConfirmationCount represents the number of confirmation necessary for bigger values: 6 confirmations
-	BigValueConfirmationCount = 6
 )
 
 var _ interfaces.ChainObserver = (*Observer)(nil)
@@ -75,6 +71,9 @@ type Observer struct {
 	// utxos contains the UTXOs owned by the TSS address
 	utxos []btcjson.ListUnspentResult
 
+	// lastFeeRate is the latest fee rate (sat/vB) posted to zetacore
+	lastFeeRate uint64
+
 	// includedTxHashes indexes included tx with tx hash
 	includedTxHashes map[string]bool
 
@@ -84,6 +83,9 @@ type Observer struct {
 	// broadcastedTx indexes the outbound hash with the outbound tx identifier
 	broadcastedTx map[string]string
 
+	// cpfpFeeRates indexes the package fee rate bumped by CPFP child tx with the outbound tx identifier
+	cpfpFeeRates map[string]int64
+
 	// logger contains the loggers used by the bitcoin observer
 	logger Logger
 }
@@ -133,6 +135,7 @@ func NewObserver(
 		includedTxHashes:  make(map[string]bool),
 		includedTxResults: make(map[string]*btcjson.GetTransactionResult),
 		broadcastedTx:     make(map[string]string),
+		cpfpFeeRates:      make(map[string]int64),
 		logger: Logger{
 			ObserverLogger: *baseObserver.Logger(),
 			UTXOs:          baseObserver.Logger().Chain.With().Str("module", "utxos").Logger(),
@@ -199,16 +202,15 @@ func (ob *Observer) GetPendingNonce() uint64 {
 }
 
 // ConfirmationsThreshold returns number of required Bitcoin confirmations depending on sent BTC amount.
+// The threshold is given by the BTC confirmation tiers of the chain params.
 func (ob *Observer) ConfirmationsThreshold(amount *big.Int) int64 {
-	if amount.Cmp(big.NewInt(BigValueSats)) >= 0 {
-		return BigValueConfirmationCount
-	}
-	if BigValueConfirmationCount < ob.ChainParams().ConfirmationCount {
-		return BigValueConfirmationCount
+	amountUint := sdkmath.ZeroUint()
+	if amount != nil && amount.Sign() > 0 {
+		amountUint = sdkmath.NewUintFromBigInt(amount)
 	}
 
 	// #nosec G115 always in range
-	return int64(ob.ChainParams().ConfirmationCount)
+	return int64(ob.ConfirmationCount(coin.CoinType_Gas, "", amountUint))
 }
 
 // WatchGasPrice watches Bitcoin chain for gas rate and post to zetacore
@@ -294,6 +296,10 @@ func (ob *Observer) PostGasPrice(ctx context.Context) error {
 		return errors.Wrap(err, "PostVoteGasPrice error")
 	}
 
+	ob.Mu().Lock()
+	ob.lastFeeRate = feeRateEstimated
+	ob.Mu().Unlock()
+
 	return nil
 }
 
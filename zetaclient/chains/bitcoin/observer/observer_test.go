@@ -316,18 +316,19 @@ func TestConfirmationThreshold(t *testing.T) {
 		require.Equal(t, int64(3), ob.ConfirmationsThreshold(big.NewInt(1000)))
 	})
 
-	t.Run("should return big value confirmations", func(t *testing.T) {
-		ob.SetChainParams(observertypes.ChainParams{ConfirmationCount: 3})
-		require.Equal(
-			t,
-			int64(observer.BigValueConfirmationCount),
-			ob.ConfirmationsThreshold(big.NewInt(observer.BigValueSats)),
-		)
+	t.Run("should return big value confirmations from confirmation tiers", func(t *testing.T) {
+		ob.SetChainParams(observertypes.ChainParams{
+			ConfirmationCount: 3,
+			ConfirmationTiers: observertypes.DefaultBtcConfirmationTiers(),
+		})
+		require.Equal(t, int64(3), ob.ConfirmationsThreshold(big.NewInt(199_999_999)))
+		require.Equal(t, int64(6), ob.ConfirmationsThreshold(big.NewInt(200_000_000)))
 	})
 
-	t.Run("big value confirmations is the upper cap", func(t *testing.T) {
-		ob.SetChainParams(observertypes.ChainParams{ConfirmationCount: observer.BigValueConfirmationCount + 1})
-		require.Equal(t, int64(observer.BigValueConfirmationCount), ob.ConfirmationsThreshold(big.NewInt(1000)))
+	t.Run("should return confirmations in chain param if no tier applies", func(t *testing.T) {
+		ob.SetChainParams(observertypes.ChainParams{ConfirmationCount: 3})
+		require.Equal(t, int64(3), ob.ConfirmationsThreshold(big.NewInt(200_000_000)))
+		require.Equal(t, int64(3), ob.ConfirmationsThreshold(nil))
 	})
 }
 
@@ -46,7 +46,7 @@ func (ob *Observer) WatchOutbound(ctx context.Context) error {
 	for {
 		select {
 		case <-ticker.C():
-			if !app.IsOutboundObservationEnabled() {
+			if !app.IsOutboundObservationEnabled(ob.Chain().ChainId) {
 				sampledLogger.Info().
 					Msgf("WatchOutbound: outbound observation is disabled for chain %d", chainID)
 				continue
@@ -177,6 +177,21 @@ func (ob *Observer) VoteOutboundIfConfirmed(
 	// It's safe to use cctx's amount to post confirmation because it has already been verified in observeOutbound()
 	amountInSat := params.Amount.BigInt()
 	if res.Confirmations < ob.ConfirmationsThreshold(amountInSat) {
+		// schedule keysign to bump the fee if the outbound is stuck in mempool
+		if res.Confirmations == 0 {
+			stuckTx, err := ob.GetStuckOutbound(cctx)
+			if err != nil {
+				ob.logger.Outbound.Error().
+					Err(err).
+					Msgf("VoteOutboundIfConfirmed: error checking stuck outbound %s", outboundID)
+			} else if stuckTx != nil {
+				ob.logger.Outbound.Info().
+					Msgf("VoteOutboundIfConfirmed: outbound %s is stuck in mempool with fee rate %d, gas price %s",
+						stuckTx.TxID, stuckTx.FeeRate, params.GasPrice)
+				return true, nil
+			}
+		}
+
 		ob.logger.Outbound.Debug().
 			Int64("currentConfirmations", res.Confirmations).
 			Int64("requiredConfirmations", ob.ConfirmationsThreshold(amountInSat)).
@@ -526,13 +541,22 @@ func (ob *Observer) checkTssOutboundResult(
 	if err != nil {
 		return errors.Wrapf(err, "checkTssOutboundResult: error GetRawTxResultByHash %s", hash.String())
 	}
-	err = ob.checkTSSVin(ctx, rawResult.Vin, nonce)
+
+	// the nonce-mark input of a batched outbound is the nonce-mark of the first nonce's prior nonce
+	firstNonce := nonce
+	if bitcoin.IsBatchedOutbound(len(rawResult.Vout)) {
+		firstNonce, _, err = bitcoin.OutboundNonceRange(rawResult.Vout)
+		if err != nil {
+			return errors.Wrapf(err, "checkTssOutboundResult: invalid nonce range in outbound %s nonce %d", hash, nonce)
+		}
+	}
+	err = ob.checkTSSVin(ctx, rawResult.Vin, firstNonce)
 	if err != nil {
 		return errors.Wrapf(err, "checkTssOutboundResult: invalid TSS Vin in outbound %s nonce %d", hash, nonce)
 	}
 
-	// differentiate between normal and restricted cctx
-	if compliance.IsCctxRestricted(cctx) {
+	// differentiate between normal and restricted cctx, a consolidation has the outputs of a cancelled outbound
+	if compliance.IsCctxRestricted(cctx) || cctx.IsUTXOConsolidation() {
 		err = ob.checkTSSVoutCancelled(params, rawResult.Vout)
 		if err != nil {
 			return errors.Wrapf(
@@ -589,22 +613,45 @@ func (ob *Observer) checkTSSVin(ctx context.Context, vins []btcjson.Vin, nonce u
 }
 
 // checkTSSVout vout is valid if:
-//   - The first output is the nonce-mark
-//   - The second output is the correct payment to recipient
-//   - The third output is the change to TSS (optional)
+//   - The first output is the nonce-mark (of the last nonce in batch)
+//   - The output of the nonce is the correct payment to recipient
+//   - The last output is the change to TSS (optional for single outbound)
 func (ob *Observer) checkTSSVout(params *crosschaintypes.OutboundParams, vouts []btcjson.Vout) error {
 	// vouts: [nonce-mark, payment to recipient, change to TSS (optional)]
-	if !(len(vouts) == 2 || len(vouts) == 3) {
+	// batched vouts: [nonce-mark of last nonce, payment to recipient 1, ..., payment to recipient N, change to TSS]
+	if len(vouts) < 2 || len(vouts) > bitcoin.MaxOutboundBatchSize+2 {
 		return fmt.Errorf("checkTSSVout: invalid number of vouts: %d", len(vouts))
 	}
 
+	// locate the payment of the nonce in the outbound
 	nonce := params.TssNonce
+	nonceMark := nonce
+	paymentN := uint32(1)
+	if bitcoin.IsBatchedOutbound(len(vouts)) {
+		first, last, err := bitcoin.OutboundNonceRange(vouts)
+		if err != nil {
+			return errors.Wrap(err, "checkTSSVout: error getting nonce range of batched outbound")
+		}
+		if nonce < first || nonce > last {
+			return fmt.Errorf("checkTSSVout: nonce %d not in nonce range [%d, %d] of batched outbound", nonce, first, last)
+		}
+		nonceMark = last
+		// #nosec G115 always in range of batch size
+		paymentN = uint32(1 + nonce - first)
+	}
+
 	tssAddress := ob.TSSAddressString()
+	// #nosec G115 always in range of batch size
+	changeN := uint32(len(vouts) - 1)
 	for _, vout := range vouts {
+		// skip the payments of other cctxs in the batch
+		if vout.N != 0 && vout.N != paymentN && vout.N != changeN {
+			continue
+		}
+
 		// decode receiver and amount from vout
 		receiverExpected := tssAddress
-		if vout.N == 1 {
-			// the 2nd output is the payment to recipient
+		if vout.N == paymentN {
 			receiverExpected = params.Receiver
 		}
 		receiverVout, amount, err := bitcoin.DecodeTSSVout(vout, receiverExpected, ob.Chain())
@@ -620,14 +667,14 @@ func (ob *Observer) checkTSSVout(params *crosschaintypes.OutboundParams, vouts [
 					tssAddress,
 				)
 			}
-			if amount != chains.NonceMarkAmount(nonce) {
+			if amount != chains.NonceMarkAmount(nonceMark) {
 				return fmt.Errorf(
 					"checkTSSVout: nonce-mark amount %d not match nonce-mark amount %d",
 					amount,
-					chains.NonceMarkAmount(nonce),
+					chains.NonceMarkAmount(nonceMark),
 				)
 			}
-		case 1: // 2nd vout: payment to recipient
+		case paymentN: // payment to recipient
 			if receiverVout != params.Receiver {
 				return fmt.Errorf(
 					"checkTSSVout: output address %s not match params receiver %s",
@@ -639,7 +686,7 @@ func (ob *Observer) checkTSSVout(params *crosschaintypes.OutboundParams, vouts [
 			if uint64(amount) != params.Amount.Uint64() {
 				return fmt.Errorf("checkTSSVout: output amount %d not match params amount %d", amount, params.Amount)
 			}
-		case 2: // 3rd vout: change to TSS (optional)
+		default: // last vout: change to TSS
 			if receiverVout != tssAddress {
 				return fmt.Errorf("checkTSSVout: change address %s not match TSS address %s", receiverVout, tssAddress)
 			}
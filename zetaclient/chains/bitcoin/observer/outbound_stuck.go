@@ -0,0 +1,138 @@
+package observer
+
+import (
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/pkg/errors"
+
+	crosschaintypes "github.com/zeta-chain/node/x/crosschain/types"
+	"github.com/zeta-chain/node/zetaclient/chains/bitcoin"
+	"github.com/zeta-chain/node/zetaclient/chains/bitcoin/rpc"
+)
+
+// StuckOutbound contains a pending outbound that pays a lower fee rate than the gas price of its cctx
+type StuckOutbound struct {
+	// TxID is the hash of the stuck outbound
+	TxID string
+
+	// Tx is the stuck outbound
+	Tx *btcutil.Tx
+
+	// Vsize is the virtual size of the stuck outbound in vBytes
+	Vsize int64
+
+	// Fee is the fee paid by the stuck outbound in satoshis
+	Fee int64
+
+	// FeeRate is the effective fee rate (sat/vB) of the stuck outbound, including the CPFP child (if any)
+	FeeRate int64
+
+	// HasDescendants is true if the outputs of the stuck outbound are already spent by the next outbound
+	HasDescendants bool
+
+	// LastNonce is the last nonce paid by the stuck outbound, it's greater than the cctx nonce if batched
+	LastNonce uint64
+}
+
+// GetStuckOutbound returns the outbound of the given cctx if it is pending in the mempool and
+// pays a fee rate lower than the gas price of the cctx. The gas price of a cctx is increased by
+// zetacore (paid by the gas stability pool) once the cctx has been pending for too long.
+//
+// Returns nil if the outbound is not broadcasted, already mined or doesn't need a fee bump.
+// A batched outbound is bumped only through the cctx of its first nonce.
+func (ob *Observer) GetStuckOutbound(cctx *crosschaintypes.CrossChainTx) (*StuckOutbound, error) {
+	params := cctx.GetCurrentOutboundParam()
+	nonce := params.TssNonce
+	outboundID := ob.OutboundID(nonce)
+
+	// prefer the outbound broadcasted by ourself as it is the latest replacement (if any)
+	ob.Mu().Lock()
+	txHash, broadcasted := ob.broadcastedTx[outboundID]
+	if !broadcasted {
+		if res, included := ob.includedTxResults[outboundID]; included {
+			txHash = res.TxID
+		}
+	}
+	ob.Mu().Unlock()
+	if txHash == "" {
+		return nil, nil
+	}
+
+	// only the outbound pending in mempool can be bumped
+	hash, txResult, err := rpc.GetTxResultByHash(ob.btcClient, txHash)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to get tx result for outbound %s", txHash)
+	}
+	if txResult.Confirmations != 0 {
+		return nil, nil
+	}
+
+	// calculate the fee rate paid by the outbound
+	rawResult, err := rpc.GetRawTxResult(ob.btcClient, hash, txResult)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to get raw tx result for outbound %s", txHash)
+	}
+
+	// the fee of a batched outbound is bumped only once (through the first nonce)
+	lastNonce := nonce
+	if bitcoin.IsBatchedOutbound(len(rawResult.Vout)) {
+		var firstNonce uint64
+		firstNonce, lastNonce, err = bitcoin.OutboundNonceRange(rawResult.Vout)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to get nonce range of outbound %s", txHash)
+		}
+		if nonce != firstNonce {
+			return nil, nil
+		}
+	}
+
+	fee, feeRate, err := rpc.GetTransactionFeeAndRate(ob.btcClient, &rawResult)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to get fee rate for outbound %s", txHash)
+	}
+	tx, err := rpc.GetRawTxByHash(ob.btcClient, txHash)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to get raw tx for outbound %s", txHash)
+	}
+
+	ob.Mu().Lock()
+	if cpfpFeeRate := ob.cpfpFeeRates[outboundID]; cpfpFeeRate > feeRate {
+		feeRate = cpfpFeeRate
+	}
+	_, nextBroadcasted := ob.broadcastedTx[ob.OutboundID(lastNonce+1)]
+	_, nextIncluded := ob.includedTxResults[ob.OutboundID(lastNonce+1)]
+	ob.Mu().Unlock()
+
+	// no need to bump if the outbound already pays the gas price of the cctx
+	gasPrice, err := params.GetGasPriceUInt64()
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to parse gas price of cctx %s", cctx.Index)
+	}
+	// #nosec G115 always positive
+	if uint64(feeRate) >= gasPrice {
+		return nil, nil
+	}
+
+	return &StuckOutbound{
+		TxID:           txHash,
+		Tx:             tx,
+		Vsize:          int64(rawResult.Vsize),
+		Fee:            fee,
+		FeeRate:        feeRate,
+		HasDescendants: nextBroadcasted || nextIncluded,
+		LastNonce:      lastNonce,
+	}, nil
+}
+
+// SaveCPFPFeeRate saves the package fee rate of a stuck outbound bumped by a CPFP child
+func (ob *Observer) SaveCPFPFeeRate(nonce uint64, feeRate int64) {
+	outboundID := ob.OutboundID(nonce)
+	ob.Mu().Lock()
+	defer ob.Mu().Unlock()
+	ob.cpfpFeeRates[outboundID] = feeRate
+	ob.logger.Outbound.Info().Msgf("SaveCPFPFeeRate: outbound %s bumped to fee rate %d", outboundID, feeRate)
+}
+
+// RemoveReplacedTx removes the included outbound replaced by a replace-by-fee (RBF) tx
+func (ob *Observer) RemoveReplacedTx(nonce uint64) {
+	ob.removeIncludedTx(nonce)
+}
@@ -0,0 +1,167 @@
+package observer
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/btcjson"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/node/pkg/chains"
+	crosschaintypes "github.com/zeta-chain/node/x/crosschain/types"
+	"github.com/zeta-chain/node/zetaclient/testutils/mocks"
+)
+
+// mockPendingOutbound mocks the RPC calls to query a pending outbound that pays 2500 sats fee with 250 vBytes
+func mockPendingOutbound(t *testing.T, ob *Observer, confirmations int64, vouts []btcjson.Vout) string {
+	prevTx := wire.NewMsgTx(wire.TxVersion)
+	prevTx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&chainhash.Hash{}, 0), nil, nil))
+	prevTx.AddTxOut(wire.NewTxOut(100_000, nil))
+	prevHash := prevTx.TxHash()
+
+	tx := wire.NewMsgTx(wire.TxVersion)
+	tx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&prevHash, 0), nil, nil))
+	tx.AddTxOut(wire.NewTxOut(100_000-2500, nil))
+	hash := tx.TxHash()
+
+	client := mocks.NewBTCRPCClient(t)
+	client.On("GetTransaction", &hash).
+		Return(&btcjson.GetTransactionResult{TxID: hash.String(), Confirmations: confirmations}, nil)
+	client.On("GetRawTransactionVerbose", &hash).Return(&btcjson.TxRawResult{
+		Txid:  hash.String(),
+		Vsize: 250,
+		Vin:   []btcjson.Vin{{Txid: prevHash.String(), Vout: 0}},
+		Vout:  vouts,
+	}, nil).Maybe()
+	client.On("GetRawTransaction", &prevHash).Return(btcutil.NewTx(prevTx), nil).Maybe()
+	client.On("GetRawTransaction", &hash).Return(btcutil.NewTx(tx), nil).Maybe()
+	ob.WithBtcClient(client)
+
+	return hash.String()
+}
+
+func TestGetStuckOutbound(t *testing.T) {
+	const nonce = uint64(10)
+	newCctx := func(gasPrice string) *crosschaintypes.CrossChainTx {
+		return &crosschaintypes.CrossChainTx{
+			Index:          "0x123",
+			OutboundParams: []*crosschaintypes.OutboundParams{{TssNonce: nonce, GasPrice: gasPrice}},
+		}
+	}
+
+	t.Run("should return nil if outbound is not broadcasted", func(t *testing.T) {
+		ob := MockBTCObserverMainnet(t)
+
+		stuckTx, err := ob.GetStuckOutbound(newCctx("20"))
+		require.NoError(t, err)
+		require.Nil(t, stuckTx)
+	})
+
+	t.Run("should return nil if outbound is mined", func(t *testing.T) {
+		ob := MockBTCObserverMainnet(t)
+		txHash := mockPendingOutbound(t, ob, 1, nil)
+		ob.broadcastedTx[ob.OutboundID(nonce)] = txHash
+
+		stuckTx, err := ob.GetStuckOutbound(newCctx("20"))
+		require.NoError(t, err)
+		require.Nil(t, stuckTx)
+	})
+
+	t.Run("should return nil if outbound pays the gas price", func(t *testing.T) {
+		ob := MockBTCObserverMainnet(t)
+		txHash := mockPendingOutbound(t, ob, 0, nil)
+		ob.broadcastedTx[ob.OutboundID(nonce)] = txHash
+
+		stuckTx, err := ob.GetStuckOutbound(newCctx("10"))
+		require.NoError(t, err)
+		require.Nil(t, stuckTx)
+	})
+
+	t.Run("should return stuck outbound if gas price is increased", func(t *testing.T) {
+		ob := MockBTCObserverMainnet(t)
+		txHash := mockPendingOutbound(t, ob, 0, nil)
+		ob.includedTxResults[ob.OutboundID(nonce)] = &btcjson.GetTransactionResult{TxID: txHash}
+
+		stuckTx, err := ob.GetStuckOutbound(newCctx("20"))
+		require.NoError(t, err)
+		require.NotNil(t, stuckTx)
+		require.Equal(t, txHash, stuckTx.TxID)
+		require.Equal(t, txHash, stuckTx.Tx.Hash().String())
+		require.Equal(t, int64(250), stuckTx.Vsize)
+		require.Equal(t, int64(2500), stuckTx.Fee)
+		require.Equal(t, int64(10), stuckTx.FeeRate)
+		require.False(t, stuckTx.HasDescendants)
+		require.Equal(t, nonce, stuckTx.LastNonce)
+	})
+
+	t.Run("should return stuck outbound with descendants", func(t *testing.T) {
+		ob := MockBTCObserverMainnet(t)
+		txHash := mockPendingOutbound(t, ob, 0, nil)
+		ob.broadcastedTx[ob.OutboundID(nonce)] = txHash
+		ob.broadcastedTx[ob.OutboundID(nonce+1)] = "next_tx_hash"
+
+		stuckTx, err := ob.GetStuckOutbound(newCctx("20"))
+		require.NoError(t, err)
+		require.NotNil(t, stuckTx)
+		require.True(t, stuckTx.HasDescendants)
+	})
+
+	t.Run("should return stuck batched outbound for the first nonce", func(t *testing.T) {
+		ob := MockBTCObserverMainnet(t)
+		txHash := mockPendingOutbound(t, ob, 0, batchedVouts(nonce+2, 3))
+		ob.broadcastedTx[ob.OutboundID(nonce)] = txHash
+		ob.broadcastedTx[ob.OutboundID(nonce+1)] = txHash
+		ob.broadcastedTx[ob.OutboundID(nonce+2)] = txHash
+		ob.broadcastedTx[ob.OutboundID(nonce+3)] = "next_tx_hash"
+
+		stuckTx, err := ob.GetStuckOutbound(newCctx("20"))
+		require.NoError(t, err)
+		require.NotNil(t, stuckTx)
+		require.Equal(t, nonce+2, stuckTx.LastNonce)
+		require.True(t, stuckTx.HasDescendants)
+	})
+
+	t.Run("should return nil for other nonces of batched outbound", func(t *testing.T) {
+		ob := MockBTCObserverMainnet(t)
+		txHash := mockPendingOutbound(t, ob, 0, batchedVouts(nonce+1, 3))
+		ob.broadcastedTx[ob.OutboundID(nonce)] = txHash
+
+		stuckTx, err := ob.GetStuckOutbound(newCctx("20"))
+		require.NoError(t, err)
+		require.Nil(t, stuckTx)
+	})
+
+	t.Run("should return nil if outbound is already bumped by CPFP", func(t *testing.T) {
+		ob := MockBTCObserverMainnet(t)
+		txHash := mockPendingOutbound(t, ob, 0, nil)
+		ob.broadcastedTx[ob.OutboundID(nonce)] = txHash
+		ob.SaveCPFPFeeRate(nonce, 20)
+
+		stuckTx, err := ob.GetStuckOutbound(newCctx("20"))
+		require.NoError(t, err)
+		require.Nil(t, stuckTx)
+	})
+
+	t.Run("should fail on invalid gas price", func(t *testing.T) {
+		ob := MockBTCObserverMainnet(t)
+		txHash := mockPendingOutbound(t, ob, 0, nil)
+		ob.broadcastedTx[ob.OutboundID(nonce)] = txHash
+
+		stuckTx, err := ob.GetStuckOutbound(newCctx("invalid"))
+		require.ErrorContains(t, err, "unable to parse gas price")
+		require.Nil(t, stuckTx)
+	})
+}
+
+// batchedVouts returns the vouts of a batched outbound paying given number of cctxs up to the last nonce
+func batchedVouts(lastNonce uint64, numPayments int) []btcjson.Vout {
+	vouts := []btcjson.Vout{{N: 0, Value: float64(chains.NonceMarkAmount(lastNonce)) / 1e8}}
+	for i := 0; i < numPayments; i++ {
+		// #nosec G115 always in range
+		vouts = append(vouts, btcjson.Vout{N: uint32(i + 1), Value: 0.0001})
+	}
+	// #nosec G115 always in range
+	return append(vouts, btcjson.Vout{N: uint32(numPayments + 1), Value: 0.01})
+}
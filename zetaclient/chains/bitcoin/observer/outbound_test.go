@@ -13,6 +13,7 @@ import (
 
 	"github.com/zeta-chain/node/pkg/chains"
 	"github.com/zeta-chain/node/zetaclient/chains/base"
+	"github.com/zeta-chain/node/zetaclient/chains/bitcoin"
 	"github.com/zeta-chain/node/zetaclient/testutils"
 	"github.com/zeta-chain/node/zetaclient/testutils/mocks"
 )
@@ -113,14 +114,14 @@ func TestCheckTSSVout(t *testing.T) {
 		err := ob.checkTSSVout(params, rawResult.Vout)
 		require.NoError(t, err)
 	})
-	t.Run("should fail if vout length < 2 or > 3", func(t *testing.T) {
+	t.Run("should fail if vout length < 2 or > max batch size + 2", func(t *testing.T) {
 		_, cctx := testutils.LoadBTCTxRawResultNCctx(t, TestDataDir, chainID, nonce)
 		params := cctx.GetCurrentOutboundParam()
 
 		err := ob.checkTSSVout(params, []btcjson.Vout{{}})
 		require.ErrorContains(t, err, "invalid number of vouts")
 
-		err = ob.checkTSSVout(params, []btcjson.Vout{{}, {}, {}, {}})
+		err = ob.checkTSSVout(params, make([]btcjson.Vout, bitcoin.MaxOutboundBatchSize+3))
 		require.ErrorContains(t, err, "invalid number of vouts")
 	})
 	t.Run("should fail on invalid TSS vout", func(t *testing.T) {
@@ -177,6 +178,57 @@ func TestCheckTSSVout(t *testing.T) {
 		err := ob.checkTSSVout(params, rawResult.Vout)
 		require.ErrorContains(t, err, "not match TSS address")
 	})
+
+	// batchVouts makes a batched outbound paying nonces [147, 148, 149] out of the archived outbound
+	batchVouts := func(vouts []btcjson.Vout) []btcjson.Vout {
+		batched := []btcjson.Vout{vouts[0], vouts[1], vouts[1], vouts[1], vouts[2]}
+		batched[0].Value = float64(chains.NonceMarkAmount(nonce+1)) / 1e8
+		for i := range batched {
+			// #nosec G115 always in range
+			batched[i].N = uint32(i)
+		}
+		return batched
+	}
+	t.Run("valid batched TSS vout should pass", func(t *testing.T) {
+		rawResult, cctx := testutils.LoadBTCTxRawResultNCctx(t, TestDataDir, chainID, nonce)
+		params := cctx.GetCurrentOutboundParam()
+
+		// payments of other cctxs in the batch are not checked
+		vouts := batchVouts(rawResult.Vout)
+		vouts[1].ScriptPubKey.Hex = "0014ba8be635673034d4d0ddc9447409b594385ec4aa"
+		err := ob.checkTSSVout(params, vouts)
+		require.NoError(t, err)
+	})
+	t.Run("should fail if nonce is not in the batch", func(t *testing.T) {
+		rawResult, cctx := testutils.LoadBTCTxRawResultNCctx(t, TestDataDir, chainID, nonce)
+		params := cctx.GetCurrentOutboundParam()
+
+		// batch pays nonces [150, 151, 152]
+		vouts := batchVouts(rawResult.Vout)
+		vouts[0].Value = float64(chains.NonceMarkAmount(nonce+4)) / 1e8
+		err := ob.checkTSSVout(params, vouts)
+		require.ErrorContains(t, err, "not in nonce range")
+	})
+	t.Run("should fail if batched payment is not to the receiver address", func(t *testing.T) {
+		rawResult, cctx := testutils.LoadBTCTxRawResultNCctx(t, TestDataDir, chainID, nonce)
+		params := cctx.GetCurrentOutboundParam()
+
+		// not receiver address, bc1qh297vdt8xq6df5xae9z8gzd4jsu9a392mp0dus
+		vouts := batchVouts(rawResult.Vout)
+		vouts[2].ScriptPubKey.Hex = "0014ba8be635673034d4d0ddc9447409b594385ec4aa"
+		err := ob.checkTSSVout(params, vouts)
+		require.ErrorContains(t, err, "not match params receiver")
+	})
+	t.Run("should fail if batched change is not to the TSS address", func(t *testing.T) {
+		rawResult, cctx := testutils.LoadBTCTxRawResultNCctx(t, TestDataDir, chainID, nonce)
+		params := cctx.GetCurrentOutboundParam()
+
+		// not TSS address, bc1qh297vdt8xq6df5xae9z8gzd4jsu9a392mp0dus
+		vouts := batchVouts(rawResult.Vout)
+		vouts[4].ScriptPubKey.Hex = "0014ba8be635673034d4d0ddc9447409b594385ec4aa"
+		err := ob.checkTSSVout(params, vouts)
+		require.ErrorContains(t, err, "not match TSS address")
+	})
 }
 
 func TestCheckTSSVoutCancelled(t *testing.T) {
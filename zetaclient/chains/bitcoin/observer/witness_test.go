@@ -240,7 +240,7 @@ func TestGetBtcEventWithWitness(t *testing.T) {
 		require.Nil(t, event)
 	})
 
-	t.Run("should skip tx if sender address is empty", func(t *testing.T) {
+	t.Run("should get BTC inbound event with empty sender address on unknown script", func(t *testing.T) {
 		// load tx
 		tx := testutils.LoadBTCInboundRawResult(t, TestDataDir, chain.ChainId, txHash, false)
 
@@ -271,6 +271,7 @@ func TestGetBtcEventWithWitness(t *testing.T) {
 			depositorFee,
 		)
 		require.NoError(t, err)
-		require.Nil(t, event)
+		require.NotNil(t, event)
+		require.Empty(t, event.FromAddress)
 	})
 }
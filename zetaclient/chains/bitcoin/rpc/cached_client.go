@@ -0,0 +1,49 @@
+package rpc
+
+import (
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	lru "github.com/hashicorp/golang-lru"
+
+	"github.com/zeta-chain/node/zetaclient/chains/interfaces"
+)
+
+// rawTxCacheSize is the number of raw transactions kept in the cache
+const rawTxCacheSize = 4096
+
+var _ interfaces.BTCRPCClient = (*CachedClient)(nil)
+
+// CachedClient extends BTCRPCClient with a LRU cache of raw transactions
+//
+// The inbound observation queries the previous transaction of the inputs to resolve the sender
+// and calculate the depositor fee. The transactions are immutable once queried by txid,
+// so caching them saves one RPC call per input queried again.
+type CachedClient struct {
+	interfaces.BTCRPCClient
+	rawTxCache *lru.Cache
+}
+
+// NewCachedClient creates a new client caching the raw transactions queried from the given client
+func NewCachedClient(client interfaces.BTCRPCClient) *CachedClient {
+	rawTxCache, _ := lru.New(rawTxCacheSize)
+
+	return &CachedClient{BTCRPCClient: client, rawTxCache: rawTxCache}
+}
+
+// GetRawTransaction returns the raw transaction by hash.
+// Uses LRU cache for network efficiency.
+func (c *CachedClient) GetRawTransaction(txHash *chainhash.Hash) (*btcutil.Tx, error) {
+	if cached, ok := c.rawTxCache.Get(*txHash); ok {
+		if tx, ok := cached.(*btcutil.Tx); ok {
+			return tx, nil
+		}
+	}
+
+	tx, err := c.BTCRPCClient.GetRawTransaction(txHash)
+	if err != nil {
+		return nil, err
+	}
+	c.rawTxCache.Add(*txHash, tx)
+
+	return tx, nil
+}
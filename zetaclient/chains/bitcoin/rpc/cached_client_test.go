@@ -0,0 +1,46 @@
+package rpc_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/node/zetaclient/chains/bitcoin/rpc"
+	"github.com/zeta-chain/node/zetaclient/testutils/mocks"
+)
+
+func TestCachedClient_GetRawTransaction(t *testing.T) {
+	hash := chainhash.HashH([]byte("previous tx"))
+	tx := btcutil.NewTx(wire.NewMsgTx(wire.TxVersion))
+
+	t.Run("should query the raw transaction only once", func(t *testing.T) {
+		rpcClient := mocks.NewBTCRPCClient(t)
+		rpcClient.On("GetRawTransaction", &hash).Return(tx, nil).Once()
+		client := rpc.NewCachedClient(rpcClient)
+
+		for i := 0; i < 3; i++ {
+			result, err := client.GetRawTransaction(&hash)
+			require.NoError(t, err)
+			require.Equal(t, tx, result)
+		}
+	})
+
+	t.Run("should not cache the error", func(t *testing.T) {
+		rpcClient := mocks.NewBTCRPCClient(t)
+		rpcClient.On("GetRawTransaction", &hash).Return(nil, errors.New("rpc error")).Once()
+		rpcClient.On("GetRawTransaction", &hash).Return(tx, nil).Once()
+		client := rpc.NewCachedClient(rpcClient)
+
+		result, err := client.GetRawTransaction(&hash)
+		require.ErrorContains(t, err, "rpc error")
+		require.Nil(t, result)
+
+		result, err = client.GetRawTransaction(&hash)
+		require.NoError(t, err)
+		require.Equal(t, tx, result)
+	})
+}
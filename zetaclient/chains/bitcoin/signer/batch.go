@@ -0,0 +1,183 @@
+package signer
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+
+	"github.com/zeta-chain/node/pkg/chains"
+	"github.com/zeta-chain/node/zetaclient/chains/bitcoin"
+	"github.com/zeta-chain/node/zetaclient/chains/bitcoin/observer"
+)
+
+// Payment is the payment of a cctx in a (batched) outbound
+type Payment struct {
+	// Nonce is the TSS nonce of the cctx
+	Nonce uint64
+
+	// To is the recipient of the cctx
+	To btcutil.Address
+
+	// Amount is the amount (in BTC) paid to the recipient
+	Amount float64
+}
+
+// AddBatchWithdrawTxOutputs adds the outputs of a batched outbound to the withdraw tx
+// 1st output: the nonce-mark btc (of the last nonce) to TSS itself
+// 2nd to (N+1)th output: the payments to the recipients, in the order of nonces
+// last output: the remaining btc to TSS itself (mandatory)
+func (signer *Signer) AddBatchWithdrawTxOutputs(
+	tx *wire.MsgTx,
+	payments []Payment,
+	total float64,
+	nonceMark int64,
+	fees *big.Int,
+) error {
+	// calculate remaining btc (the change) to TSS self
+	remainingSats, err := bitcoin.GetSatoshis(total)
+	if err != nil {
+		return err
+	}
+	amountsSatoshis := make([]int64, len(payments))
+	for i, payment := range payments {
+		amountsSatoshis[i], err = bitcoin.GetSatoshis(payment.Amount)
+		if err != nil {
+			return err
+		}
+		remainingSats -= amountsSatoshis[i]
+	}
+	remainingSats -= fees.Int64()
+	remainingSats -= nonceMark
+	if remainingSats <= 0 {
+		return fmt.Errorf("remainder value is not positive: %d", remainingSats)
+	} else if remainingSats == nonceMark {
+		signer.Logger().Std.Info().Msgf("adjust remainder value to avoid duplicate nonce-mark: %d", remainingSats)
+		remainingSats--
+	}
+
+	// 1st output: the nonce-mark btc to TSS self
+	tssAddrP2WPKH, err := signer.TSS().BTCAddress(signer.Chain().ChainId)
+	if err != nil {
+		return err
+	}
+	payToSelfScript, err := txscript.PayToAddrScript(tssAddrP2WPKH)
+	if err != nil {
+		return err
+	}
+	tx.AddTxOut(wire.NewTxOut(nonceMark, payToSelfScript))
+
+	// 2nd to (N+1)th output: the payments to the recipients
+	for i, payment := range payments {
+		pkScript, err := txscript.PayToAddrScript(payment.To)
+		if err != nil {
+			return err
+		}
+		tx.AddTxOut(wire.NewTxOut(amountsSatoshis[i], pkScript))
+	}
+
+	// last output: the remaining btc to TSS self
+	tx.AddTxOut(wire.NewTxOut(remainingSats, payToSelfScript))
+	return nil
+}
+
+// SignBatchWithdrawTx signs one outbound paying the given cctxs of consecutive nonces.
+// The outbound spends the nonce-mark of the nonce prior to the first payment and
+// carries the nonce-mark of the last payment, so it's chained with other outbounds like a single one.
+func (signer *Signer) SignBatchWithdrawTx(
+	ctx context.Context,
+	payments []Payment,
+	gasPrice *big.Int,
+	observer *observer.Observer,
+	height uint64,
+	chain chains.Chain,
+) (*wire.MsgTx, error) {
+	if len(payments) == 0 || len(payments) > bitcoin.MaxOutboundBatchSize {
+		return nil, fmt.Errorf("invalid number of payments: %d", len(payments))
+	}
+	firstNonce := payments[0].Nonce
+	lastNonce := payments[len(payments)-1].Nonce
+	// #nosec G115 always positive
+	if lastNonce-firstNonce != uint64(len(payments)-1) {
+		return nil, fmt.Errorf("payments are not of consecutive nonces [%d, %d]", firstNonce, lastNonce)
+	}
+
+	// #nosec G115 always positive
+	sizeMax := bitcoin.OutboundBytesMaxBatch(uint64(len(payments)))
+	estimateFee := float64(gasPrice.Uint64()*sizeMax) / 1e8
+	nonceMark := chains.NonceMarkAmount(lastNonce)
+	amount := 0.0
+	payees := make([]btcutil.Address, len(payments))
+	for i, payment := range payments {
+		amount += payment.Amount
+		payees[i] = payment.To
+	}
+
+	// refresh unspent UTXOs and continue with keysign regardless of error
+	err := observer.FetchUTXOs(ctx)
+	if err != nil {
+		signer.Logger().
+			Std.Error().
+			Err(err).
+			Msgf("SignBatchWithdrawTx: FetchUTXOs error: nonce %d chain %d", firstNonce, chain.ChainId)
+	}
+
+	// select N UTXOs to cover the total expense, starting from the nonce-mark prior to the first nonce
+	prevOuts, total, consolidatedUtxo, consolidatedValue, err := observer.SelectUTXOs(
+		ctx,
+		amount+estimateFee+float64(nonceMark)*1e-8,
+		MaxNoOfInputsPerTx,
+		firstNonce,
+		consolidationRank,
+		false,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// build tx with selected unspents
+	tx, err := newTxWithInputs(prevOuts)
+	if err != nil {
+		return nil, err
+	}
+
+	// size checking
+	// #nosec G115 always positive
+	txSize, err := bitcoin.EstimateOutboundSize(uint64(len(prevOuts)), payees)
+	if err != nil {
+		return nil, err
+	}
+	if txSize < bitcoin.OutboundBytesMin { // outbound shouldn't be blocked a low sizeLimit
+		txSize = bitcoin.OutboundBytesMin
+	}
+	if txSize > sizeMax { // in case of accident
+		signer.Logger().Std.Warn().
+			Msgf("txSize %d is greater than outboundBytesMax %d; use outboundBytesMax", txSize, sizeMax)
+		txSize = sizeMax
+	}
+
+	// fee calculation
+	// #nosec G115 always in range (checked above)
+	fees := new(big.Int).Mul(big.NewInt(int64(txSize)), gasPrice)
+	signer.Logger().
+		Std.Info().
+		Msgf("bitcoin batched outbound nonces [%d, %d] gasPrice %s size %d fees %s consolidated %d utxos of value %v",
+			firstNonce, lastNonce, gasPrice.String(), txSize, fees.String(), consolidatedUtxo, consolidatedValue)
+
+	// add tx outputs
+	err = signer.AddBatchWithdrawTxOutputs(tx, payments, total, nonceMark, fees)
+	if err != nil {
+		return nil, err
+	}
+
+	// sign the tx
+	err = signer.signTxInputs(ctx, tx, prevOuts, height, firstNonce, chain.ChainId)
+	if err != nil {
+		return nil, err
+	}
+
+	return tx, nil
+}
@@ -0,0 +1,140 @@
+package signer
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/node/pkg/chains"
+	"github.com/zeta-chain/node/zetaclient/chains/base"
+	"github.com/zeta-chain/node/zetaclient/chains/bitcoin"
+	"github.com/zeta-chain/node/zetaclient/config"
+	"github.com/zeta-chain/node/zetaclient/testutils/mocks"
+)
+
+func TestAddBatchWithdrawTxOutputs(t *testing.T) {
+	// Create test signer and receiver addresses
+	signer, err := NewSigner(
+		chains.Chain{},
+		mocks.NewTSSMainnet(),
+		nil,
+		base.DefaultLogger(),
+		config.BTCConfig{},
+	)
+	require.NoError(t, err)
+
+	// tss address and script
+	tssAddr, err := signer.TSS().BTCAddress(chains.BitcoinTestnet.ChainId)
+	require.NoError(t, err)
+	tssScript, err := txscript.PayToAddrScript(tssAddr)
+	require.NoError(t, err)
+
+	// receiver addresses
+	to1, err := chains.DecodeBtcAddress("bc1qaxf82vyzy8y80v000e7t64gpten7gawewzu42y", chains.BitcoinMainnet.ChainId)
+	require.NoError(t, err)
+	to1Script, err := txscript.PayToAddrScript(to1)
+	require.NoError(t, err)
+	to2, err := chains.DecodeBtcAddress(
+		"bc1p4scddlkkuw9486579autxumxmkvuphm5pz4jvf7f6pdh50p2uzqstawjt9",
+		chains.BitcoinMainnet.ChainId,
+	)
+	require.NoError(t, err)
+	to2Script, err := txscript.PayToAddrScript(to2)
+	require.NoError(t, err)
+
+	payments := []Payment{
+		{Nonce: 9, To: to1, Amount: 0.2},
+		{Nonce: 10, To: to2, Amount: 0.3},
+	}
+
+	tests := []struct {
+		name     string
+		payments []Payment
+		total    float64
+		message  string
+		txout    []*wire.TxOut
+	}{
+		{
+			name:     "should add outputs successfully",
+			payments: payments,
+			total:    1.00012000,
+			txout: []*wire.TxOut{
+				{Value: 10000, PkScript: tssScript},
+				{Value: 20000000, PkScript: to1Script},
+				{Value: 30000000, PkScript: to2Script},
+				{Value: 50000000, PkScript: tssScript},
+			},
+		},
+		{
+			name:     "should not produce duplicate nonce mark",
+			payments: payments,
+			total:    0.50022000, //  0.5 + fee + nonceMark * 2
+			txout: []*wire.TxOut{
+				{Value: 10000, PkScript: tssScript},
+				{Value: 20000000, PkScript: to1Script},
+				{Value: 30000000, PkScript: to2Script},
+				{Value: 9999, PkScript: tssScript}, // nonceMark - 1
+			},
+		},
+		{
+			name:     "should fail if there is no change",
+			payments: payments,
+			total:    0.50012000,
+			message:  "remainder value is not positive",
+		},
+		{
+			name:     "should fail on invalid amount",
+			payments: []Payment{{Nonce: 10, To: to1, Amount: -0.5}},
+			total:    1.00012000,
+			message:  "cannot be less than zero",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx := wire.NewMsgTx(wire.TxVersion)
+			err := signer.AddBatchWithdrawTxOutputs(tx, tt.payments, tt.total, 10000, big.NewInt(2000))
+			if tt.message != "" {
+				require.ErrorContains(t, err, tt.message)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.txout, tx.TxOut)
+			require.True(t, bitcoin.IsBatchedOutbound(len(tx.TxOut)))
+		})
+	}
+}
+
+func TestSignBatchWithdrawTx(t *testing.T) {
+	ctx := context.Background()
+	signer, _, _ := newFeeBumpTestSigner(t)
+	to, err := chains.DecodeBtcAddress("bc1qaxf82vyzy8y80v000e7t64gpten7gawewzu42y", chains.BitcoinMainnet.ChainId)
+	require.NoError(t, err)
+
+	t.Run("should fail on empty batch", func(t *testing.T) {
+		tx, err := signer.SignBatchWithdrawTx(ctx, nil, big.NewInt(10), nil, 1, signer.Chain())
+		require.ErrorContains(t, err, "invalid number of payments")
+		require.Nil(t, tx)
+	})
+
+	t.Run("should fail if batch is too large", func(t *testing.T) {
+		payments := make([]Payment, bitcoin.MaxOutboundBatchSize+1)
+		tx, err := signer.SignBatchWithdrawTx(ctx, payments, big.NewInt(10), nil, 1, signer.Chain())
+		require.ErrorContains(t, err, "invalid number of payments")
+		require.Nil(t, tx)
+	})
+
+	t.Run("should fail if nonces are not consecutive", func(t *testing.T) {
+		payments := []Payment{
+			{Nonce: 9, To: to, Amount: 0.1},
+			{Nonce: 11, To: to, Amount: 0.1},
+		}
+		tx, err := signer.SignBatchWithdrawTx(ctx, payments, big.NewInt(10), nil, 1, signer.Chain())
+		require.ErrorContains(t, err, "not of consecutive nonces")
+		require.Nil(t, tx)
+	})
+}
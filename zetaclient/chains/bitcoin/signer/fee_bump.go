@@ -0,0 +1,223 @@
+package signer
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+
+	"github.com/zeta-chain/node/pkg/chains"
+	"github.com/zeta-chain/node/pkg/constant"
+	"github.com/zeta-chain/node/zetaclient/chains/bitcoin"
+	"github.com/zeta-chain/node/zetaclient/chains/bitcoin/observer"
+	"github.com/zeta-chain/node/zetaclient/chains/bitcoin/rpc"
+	"github.com/zeta-chain/node/zetaclient/chains/interfaces"
+)
+
+// TryBumpOutbound bumps the fee of a stuck outbound to the given fee rate (sat/vB).
+//
+// The outbound is replaced by a higher-fee tx (RBF) if it opts in replace-by-fee. Otherwise, a child
+// tx (CPFP) spending the change output of the stuck outbound is signed to pull up the fee rate of the
+// package. The extra fee was already withdrawn from the gas stability pool when zetacore increased the
+// gas price of the cctx.
+//
+// The bump is skipped if the outputs of the stuck outbound are already spent by the next outbound, a
+// replacement or a second child would conflict with (and evict) that outbound. The descendant is bumped
+// through its own cctx instead, which also pulls up the fee rate of its ancestors.
+func (signer *Signer) TryBumpOutbound(
+	ctx context.Context,
+	stuckTx *observer.StuckOutbound,
+	feeRate int64,
+	relayFeeRate int64,
+	cancelTx bool,
+	btcObserver *observer.Observer,
+	zetacoreClient interfaces.ZetacoreClient,
+	height uint64,
+	nonce uint64,
+	logger zerolog.Logger,
+) {
+	logger = logger.With().
+		Str("stuck_tx", stuckTx.TxID).
+		Int64("stuck_fee_rate", stuckTx.FeeRate).
+		Int64("fee_rate", feeRate).
+		Logger()
+
+	// the change output is already spent by the next outbound
+	if stuckTx.HasDescendants {
+		logger.Info().Msg("TryBumpOutbound: skipped bumping outbound with descendants")
+		return
+	}
+
+	// replace the stuck outbound if possible
+	if bitcoin.IsRBFSignaled(stuckTx.Tx.MsgTx()) {
+		tx, err := signer.SignRBFTx(ctx, stuckTx, feeRate, relayFeeRate, cancelTx, height, nonce)
+		if err != nil {
+			logger.Error().Err(err).Msg("TryBumpOutbound: error signing RBF tx")
+			return
+		}
+		logger.Info().Msgf("TryBumpOutbound: signed RBF tx %s", tx.TxHash())
+
+		// the new outbound hash is reported to the outbound tracker and voted as usual
+		if signer.BroadcastOutbound(ctx, tx, nonce, stuckTx.LastNonce, btcObserver, zetacoreClient, logger) {
+			for n := nonce; n <= stuckTx.LastNonce; n++ {
+				btcObserver.RemoveReplacedTx(n)
+			}
+		}
+		return
+	}
+
+	// otherwise, pay the extra fee with a child tx
+	tx, packageFeeRate, err := signer.SignCPFPTx(ctx, stuckTx, feeRate, cancelTx, height, nonce)
+	if err != nil {
+		logger.Error().Err(err).Msg("TryBumpOutbound: error signing CPFP tx")
+		return
+	}
+	logger.Info().Msgf("TryBumpOutbound: signed CPFP tx %s with package fee rate %d", tx.TxHash(), packageFeeRate)
+
+	// try broacasting child tx with increasing backoff (1s, 2s, 4s, 8s, 16s) in case of RPC error
+	backOff := broadcastBackoff
+	for i := 0; i < broadcastRetries; i++ {
+		time.Sleep(backOff)
+		err := signer.Broadcast(tx)
+		if err != nil {
+			logger.Warn().Err(err).Msgf("TryBumpOutbound: error broadcasting CPFP tx %s, retry %d", tx.TxHash(), i)
+			backOff *= 2
+			continue
+		}
+		btcObserver.SaveCPFPFeeRate(nonce, packageFeeRate)
+		return
+	}
+}
+
+// SignRBFTx signs a replacement (BIP-125) of the stuck outbound paying the given fee rate (sat/vB).
+// The replacement spends the same inputs and pays the same outputs, the extra fee is deducted from the change.
+func (signer *Signer) SignRBFTx(
+	ctx context.Context,
+	stuckTx *observer.StuckOutbound,
+	feeRate int64,
+	relayFeeRate int64,
+	cancelTx bool,
+	height uint64,
+	nonce uint64,
+) (*wire.MsgTx, error) {
+	tx := stuckTx.Tx.MsgTx().Copy()
+	changeIdx, err := signer.getChangeOutputIndex(tx, cancelTx)
+	if err != nil {
+		return nil, err
+	}
+
+	// deduct the extra fee from the change
+	newFee := bitcoin.CalcRBFFee(stuckTx.Fee, stuckTx.Vsize, feeRate, relayFeeRate)
+	remainingSats := tx.TxOut[changeIdx].Value - (newFee - stuckTx.Fee)
+	if remainingSats < constant.BTCWithdrawalDustAmount {
+		return nil, fmt.Errorf("change %d is not enough to pay RBF fee %d", tx.TxOut[changeIdx].Value, newFee)
+	} else if remainingSats == chains.NonceMarkAmount(stuckTx.LastNonce) {
+		remainingSats--
+	}
+	tx.TxOut[changeIdx].Value = remainingSats
+
+	// collect the outputs spent by the inputs
+	amounts := make([]int64, len(tx.TxIn))
+	pkScripts := make([][]byte, len(tx.TxIn))
+	for ix, txIn := range tx.TxIn {
+		prevTx, err := rpc.GetRawTxByHash(signer.client, txIn.PreviousOutPoint.Hash.String())
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to get previous tx of input %d", ix)
+		}
+		prevOuts := prevTx.MsgTx().TxOut
+		if int(txIn.PreviousOutPoint.Index) >= len(prevOuts) {
+			return nil, fmt.Errorf("invalid previous outpoint %s", txIn.PreviousOutPoint)
+		}
+		amounts[ix] = prevOuts[txIn.PreviousOutPoint.Index].Value
+		pkScripts[ix] = prevOuts[txIn.PreviousOutPoint.Index].PkScript
+
+		txIn.Sequence = bitcoin.RBFTxInSequenceNum
+		txIn.Witness = nil
+	}
+
+	err = signer.SignTx(ctx, tx, amounts, pkScripts, height, nonce, signer.Chain().ChainId)
+	if err != nil {
+		return nil, err
+	}
+	return tx, nil
+}
+
+// SignCPFPTx signs a child tx that spends the change output of the stuck outbound back to TSS itself.
+// The child pays enough fee to bring the package (stuck outbound + child) to the given fee rate (sat/vB).
+//
+// Returns the signed child tx and the fee rate of the package.
+func (signer *Signer) SignCPFPTx(
+	ctx context.Context,
+	stuckTx *observer.StuckOutbound,
+	feeRate int64,
+	cancelTx bool,
+	height uint64,
+	nonce uint64,
+) (*wire.MsgTx, int64, error) {
+	parent := stuckTx.Tx.MsgTx()
+	changeIdx, err := signer.getChangeOutputIndex(parent, cancelTx)
+	if err != nil {
+		return nil, 0, err
+	}
+	change := parent.TxOut[changeIdx]
+
+	// calculate the fee paid by the child
+	// #nosec G115 always in range
+	childVsize := int64(bitcoin.BtcOutboundBytesCPFPChild)
+	childFee := bitcoin.CalcCPFPFee(stuckTx.Fee, stuckTx.Vsize, childVsize, feeRate)
+	if childFee == 0 {
+		return nil, 0, fmt.Errorf("stuck outbound %s already pays fee rate %d", stuckTx.TxID, feeRate)
+	}
+	remainingSats := change.Value - childFee
+	if remainingSats < constant.BTCWithdrawalDustAmount {
+		return nil, 0, fmt.Errorf("change %d is not enough to pay CPFP fee %d", change.Value, childFee)
+	}
+
+	// spend the change back to TSS itself
+	// #nosec G115 always in range
+	outpoint := wire.NewOutPoint(stuckTx.Tx.Hash(), uint32(changeIdx))
+	txIn := wire.NewTxIn(outpoint, nil, nil)
+	txIn.Sequence = bitcoin.RBFTxInSequenceNum
+	tx := wire.NewMsgTx(wire.TxVersion)
+	tx.AddTxIn(txIn)
+	tx.AddTxOut(wire.NewTxOut(remainingSats, change.PkScript))
+
+	err = signer.SignTx(ctx, tx, []int64{change.Value}, [][]byte{change.PkScript}, height, nonce, signer.Chain().ChainId)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	packageFeeRate := (stuckTx.Fee + childFee) / (stuckTx.Vsize + childVsize)
+	return tx, packageFeeRate, nil
+}
+
+// getChangeOutputIndex returns the index of the change output (paid to TSS itself) of the outbound
+//   - normal outbound: [nonce-mark, payment(s) to recipient(s), change to TSS]
+//   - cancelled outbound: [nonce-mark, change to TSS]
+func (signer *Signer) getChangeOutputIndex(tx *wire.MsgTx, cancelTx bool) (int, error) {
+	changeIdx, minIdx := len(tx.TxOut)-1, 2
+	if cancelTx {
+		changeIdx, minIdx = 1, 1
+	}
+	if changeIdx < minIdx || changeIdx >= len(tx.TxOut) {
+		return -1, fmt.Errorf("outbound %s has no change output", tx.TxHash())
+	}
+
+	tssAddrP2WPKH, err := signer.TSS().BTCAddress(signer.Chain().ChainId)
+	if err != nil {
+		return -1, err
+	}
+	payToSelfScript, err := txscript.PayToAddrScript(tssAddrP2WPKH)
+	if err != nil {
+		return -1, err
+	}
+	if !bytes.Equal(tx.TxOut[changeIdx].PkScript, payToSelfScript) {
+		return -1, fmt.Errorf("output %d of outbound %s is not paid to TSS", changeIdx, tx.TxHash())
+	}
+	return changeIdx, nil
+}
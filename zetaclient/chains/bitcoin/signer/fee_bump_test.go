@@ -0,0 +1,210 @@
+package signer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/node/pkg/chains"
+	"github.com/zeta-chain/node/zetaclient/chains/base"
+	"github.com/zeta-chain/node/zetaclient/chains/bitcoin"
+	"github.com/zeta-chain/node/zetaclient/chains/bitcoin/observer"
+	"github.com/zeta-chain/node/zetaclient/config"
+	"github.com/zeta-chain/node/zetaclient/testutils/mocks"
+)
+
+// newFeeBumpTestSigner creates a bitcoin signer with a generated TSS key and a mock RPC client
+func newFeeBumpTestSigner(t *testing.T) (*Signer, *mocks.BTCRPCClient, []byte) {
+	chain := chains.BitcoinTestnet
+	signer, err := NewSigner(chain, mocks.NewGeneratedTSS(t, chain), nil, base.DefaultLogger(), config.BTCConfig{})
+	require.NoError(t, err)
+
+	client := mocks.NewBTCRPCClient(t)
+	signer.client = client
+
+	tssAddr, err := signer.TSS().BTCAddress(chain.ChainId)
+	require.NoError(t, err)
+	tssScript, err := txscript.PayToAddrScript(tssAddr)
+	require.NoError(t, err)
+
+	return signer, client, tssScript
+}
+
+// newStuckOutbound creates a stuck outbound spending 2 TSS utxos and mocks the RPC to return the spent txs
+func newStuckOutbound(
+	t *testing.T,
+	client *mocks.BTCRPCClient,
+	tssScript []byte,
+	nonce uint64,
+	cancelTx bool,
+	change int64,
+) *observer.StuckOutbound {
+	tx := wire.NewMsgTx(wire.TxVersion)
+	for i, amount := range []int64{chains.NonceMarkAmount(nonce - 1), 1_000_000} {
+		prevTx := wire.NewMsgTx(wire.TxVersion)
+		prevTx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&chainhash.Hash{byte(i)}, 0), nil, nil))
+		prevTx.AddTxOut(wire.NewTxOut(amount, tssScript))
+		prevHash := prevTx.TxHash()
+		client.On("GetRawTransaction", &prevHash).Return(btcutil.NewTx(prevTx), nil).Maybe()
+
+		txIn := wire.NewTxIn(wire.NewOutPoint(&prevHash, 0), nil, nil)
+		txIn.Sequence = bitcoin.RBFTxInSequenceNum
+		tx.AddTxIn(txIn)
+	}
+
+	tx.AddTxOut(wire.NewTxOut(chains.NonceMarkAmount(nonce), tssScript))
+	if !cancelTx {
+		tx.AddTxOut(wire.NewTxOut(500_000, []byte{txscript.OP_0, 0x14, 0x01, 0x02}))
+	}
+	tx.AddTxOut(wire.NewTxOut(change, tssScript))
+
+	return &observer.StuckOutbound{
+		TxID:      tx.TxHash().String(),
+		Tx:        btcutil.NewTx(tx),
+		Vsize:     250,
+		Fee:       2500,
+		FeeRate:   10,
+		LastNonce: nonce,
+	}
+}
+
+// verifyTxSignatures executes the scripts of all the inputs of the signed tx
+func verifyTxSignatures(t *testing.T, tx *wire.MsgTx, amounts []int64, pkScripts [][]byte) {
+	prevOuts := txscript.NewMultiPrevOutFetcher(nil)
+	for ix, txIn := range tx.TxIn {
+		prevOuts.AddPrevOut(txIn.PreviousOutPoint, wire.NewTxOut(amounts[ix], pkScripts[ix]))
+	}
+	sigHashes := txscript.NewTxSigHashes(tx, prevOuts)
+	for ix := range tx.TxIn {
+		vm, err := txscript.NewEngine(
+			pkScripts[ix],
+			tx,
+			ix,
+			txscript.StandardVerifyFlags,
+			nil,
+			sigHashes,
+			amounts[ix],
+			prevOuts,
+		)
+		require.NoError(t, err)
+		require.NoError(t, vm.Execute())
+	}
+}
+
+func TestSignRBFTx(t *testing.T) {
+	ctx := context.Background()
+	const nonce = uint64(100)
+
+	t.Run("should sign replacement tx with higher fee", func(t *testing.T) {
+		signer, client, tssScript := newFeeBumpTestSigner(t)
+		stuckTx := newStuckOutbound(t, client, tssScript, nonce, false, 400_000)
+
+		tx, err := signer.SignRBFTx(ctx, stuckTx, 20, 1, false, 1, nonce)
+		require.NoError(t, err)
+		require.NotEqual(t, stuckTx.TxID, tx.TxHash().String())
+
+		// same inputs, same nonce-mark and payment, the extra fee (5000 - 2500) is deducted from change
+		stuck := stuckTx.Tx.MsgTx()
+		require.Len(t, tx.TxIn, len(stuck.TxIn))
+		for ix := range tx.TxIn {
+			require.Equal(t, stuck.TxIn[ix].PreviousOutPoint, tx.TxIn[ix].PreviousOutPoint)
+			require.Equal(t, uint32(bitcoin.RBFTxInSequenceNum), tx.TxIn[ix].Sequence)
+		}
+		require.Equal(t, stuck.TxOut[0], tx.TxOut[0])
+		require.Equal(t, stuck.TxOut[1], tx.TxOut[1])
+		require.Equal(t, int64(400_000-2500), tx.TxOut[2].Value)
+
+		verifyTxSignatures(t, tx, []int64{chains.NonceMarkAmount(nonce - 1), 1_000_000}, [][]byte{tssScript, tssScript})
+	})
+
+	t.Run("should sign replacement of cancelled tx", func(t *testing.T) {
+		signer, client, tssScript := newFeeBumpTestSigner(t)
+		stuckTx := newStuckOutbound(t, client, tssScript, nonce, true, 400_000)
+
+		tx, err := signer.SignRBFTx(ctx, stuckTx, 20, 1, true, 1, nonce)
+		require.NoError(t, err)
+		require.Len(t, tx.TxOut, 2)
+		require.Equal(t, int64(400_000-2500), tx.TxOut[1].Value)
+	})
+
+	t.Run("should fail if change is not enough to pay the fee", func(t *testing.T) {
+		signer, client, tssScript := newFeeBumpTestSigner(t)
+		stuckTx := newStuckOutbound(t, client, tssScript, nonce, false, 3000)
+
+		tx, err := signer.SignRBFTx(ctx, stuckTx, 20, 1, false, 1, nonce)
+		require.ErrorContains(t, err, "not enough to pay RBF fee")
+		require.Nil(t, tx)
+	})
+}
+
+func TestSignCPFPTx(t *testing.T) {
+	ctx := context.Background()
+	const nonce = uint64(100)
+
+	t.Run("should sign child tx spending the change", func(t *testing.T) {
+		signer, client, tssScript := newFeeBumpTestSigner(t)
+		stuckTx := newStuckOutbound(t, client, tssScript, nonce, false, 400_000)
+
+		tx, packageFeeRate, err := signer.SignCPFPTx(ctx, stuckTx, 20, false, 1, nonce)
+		require.NoError(t, err)
+		require.Equal(t, int64(20), packageFeeRate)
+
+		// child spends the change back to TSS
+		childFee := int64(20*(250+109) - 2500)
+		require.Len(t, tx.TxIn, 1)
+		require.Equal(t, *stuckTx.Tx.Hash(), tx.TxIn[0].PreviousOutPoint.Hash)
+		require.Equal(t, uint32(2), tx.TxIn[0].PreviousOutPoint.Index)
+		require.Len(t, tx.TxOut, 1)
+		require.Equal(t, int64(400_000)-childFee, tx.TxOut[0].Value)
+		require.Equal(t, tssScript, tx.TxOut[0].PkScript)
+
+		verifyTxSignatures(t, tx, []int64{400_000}, [][]byte{tssScript})
+	})
+
+	t.Run("should fail if change is not enough to pay the fee", func(t *testing.T) {
+		signer, client, tssScript := newFeeBumpTestSigner(t)
+		stuckTx := newStuckOutbound(t, client, tssScript, nonce, false, 5000)
+
+		tx, _, err := signer.SignCPFPTx(ctx, stuckTx, 20, false, 1, nonce)
+		require.ErrorContains(t, err, "not enough to pay CPFP fee")
+		require.Nil(t, tx)
+	})
+
+	t.Run("should fail if stuck outbound has no change", func(t *testing.T) {
+		signer, client, tssScript := newFeeBumpTestSigner(t)
+		stuckTx := newStuckOutbound(t, client, tssScript, nonce, true, 400_000)
+		stuckTx.Tx.MsgTx().TxOut = stuckTx.Tx.MsgTx().TxOut[:1]
+
+		tx, _, err := signer.SignCPFPTx(ctx, stuckTx, 20, true, 1, nonce)
+		require.ErrorContains(t, err, "has no change output")
+		require.Nil(t, tx)
+	})
+
+	t.Run("should fail if change is not paid to TSS", func(t *testing.T) {
+		signer, client, tssScript := newFeeBumpTestSigner(t)
+		stuckTx := newStuckOutbound(t, client, tssScript, nonce, false, 400_000)
+
+		// the 2nd output is the payment to recipient
+		tx, _, err := signer.SignCPFPTx(ctx, stuckTx, 20, true, 1, nonce)
+		require.ErrorContains(t, err, "is not paid to TSS")
+		require.Nil(t, tx)
+	})
+}
+
+func TestTryBumpOutbound(t *testing.T) {
+	ctx := context.Background()
+	signer, client, tssScript := newFeeBumpTestSigner(t)
+
+	t.Run("should skip bumping outbound with descendants", func(t *testing.T) {
+		stuckTx := newStuckOutbound(t, client, tssScript, 10, false, 400_000)
+		stuckTx.HasDescendants = true
+
+		// nothing is signed nor broadcasted, the mocked client would fail on any unexpected call
+		signer.TryBumpOutbound(ctx, stuckTx, 20, 1, false, nil, nil, 100, 10, signer.Logger().Std)
+	})
+}
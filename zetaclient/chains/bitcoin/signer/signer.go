@@ -11,6 +11,7 @@ import (
 
 	"github.com/btcsuite/btcd/btcec/v2"
 	btcecdsa "github.com/btcsuite/btcd/btcec/v2/ecdsa"
+	"github.com/btcsuite/btcd/btcjson"
 	"github.com/btcsuite/btcd/btcutil"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/rpcclient"
@@ -18,6 +19,7 @@ import (
 	"github.com/btcsuite/btcd/wire"
 	ethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
 
 	"github.com/zeta-chain/node/pkg/chains"
 	"github.com/zeta-chain/node/pkg/coin"
@@ -226,15 +228,9 @@ func (signer *Signer) SignWithdrawTx(
 	}
 
 	// build tx with selected unspents
-	tx := wire.NewMsgTx(wire.TxVersion)
-	for _, prevOut := range prevOuts {
-		hash, err := chainhash.NewHashFromStr(prevOut.TxID)
-		if err != nil {
-			return nil, err
-		}
-		outpoint := wire.NewOutPoint(hash, prevOut.Vout)
-		txIn := wire.NewTxIn(outpoint, nil, nil)
-		tx.AddTxIn(txIn)
+	tx, err := newTxWithInputs(prevOuts)
+	if err != nil {
+		return nil, err
 	}
 
 	// size checking
@@ -273,26 +269,161 @@ func (signer *Signer) SignWithdrawTx(
 	}
 
 	// sign the tx
-	sigHashes := txscript.NewTxSigHashes(tx, txscript.NewCannedPrevOutputFetcher([]byte{}, 0))
-	witnessHashes := make([][]byte, len(tx.TxIn))
-	for ix := range tx.TxIn {
-		amt, err := bitcoin.GetSatoshis(prevOuts[ix].Amount)
+	err = signer.signTxInputs(ctx, tx, prevOuts, height, nonce, chain.ChainId)
+	if err != nil {
+		return nil, err
+	}
+
+	return tx, nil
+}
+
+// SignConsolidationTx signs a tx sweeping the smallest TSS UTXOs into a single output to TSS itself
+// The tx outputs are the nonce-mark and the change to TSS, the same as a cancelled withdraw tx
+func (signer *Signer) SignConsolidationTx(
+	ctx context.Context,
+	gasPrice *big.Int,
+	observer *observer.Observer,
+	height uint64,
+	nonce uint64,
+	chain chains.Chain,
+) (*wire.MsgTx, error) {
+	nonceMark := chains.NonceMarkAmount(nonce)
+
+	// refresh unspent UTXOs and continue with keysign regardless of error
+	err := observer.FetchUTXOs(ctx)
+	if err != nil {
+		signer.Logger().
+			Std.Error().
+			Err(err).
+			Msgf("SignConsolidationTx: FetchUTXOs error: nonce %d chain %d", nonce, chain.ChainId)
+	}
+
+	// select the UTXOs to sweep
+	prevOuts, total, err := observer.SelectConsolidationUTXOs(ctx, nonce, MaxNoOfInputsPerTx)
+	if err != nil {
+		return nil, err
+	}
+
+	// build tx with selected unspents
+	tx, err := newTxWithInputs(prevOuts)
+	if err != nil {
+		return nil, err
+	}
+
+	// size checking, the consolidation pays no recipient
+	// #nosec G115 always positive
+	txSize, err := bitcoin.EstimateOutboundSize(uint64(len(prevOuts)), nil)
+	if err != nil {
+		return nil, err
+	}
+	if txSize < bitcoin.OutboundBytesMin {
+		txSize = bitcoin.OutboundBytesMin
+	}
+	if txSize > bitcoin.OutboundBytesMax { // in case of accident
+		signer.Logger().Std.Warn().
+			Msgf("txSize %d is greater than outboundBytesMax %d; use outboundBytesMax", txSize, bitcoin.OutboundBytesMax)
+		txSize = bitcoin.OutboundBytesMax
+	}
+
+	// fee calculation
+	// #nosec G115 always in range (checked above)
+	fees := new(big.Int).Mul(big.NewInt(int64(txSize)), gasPrice)
+	signer.Logger().
+		Std.Info().
+		Msgf("bitcoin consolidation nonce %d gasPrice %s size %d fees %s consolidated %d utxos of value %v",
+			nonce, gasPrice.String(), txSize, fees.String(), len(prevOuts), total)
+
+	// add tx outputs, all the swept value goes to the change to TSS
+	err = signer.AddWithdrawTxOutputs(tx, nil, total, 0, nonceMark, fees, true)
+	if err != nil {
+		return nil, err
+	}
+
+	// sign the tx
+	err = signer.signTxInputs(ctx, tx, prevOuts, height, nonce, chain.ChainId)
+	if err != nil {
+		return nil, err
+	}
+
+	return tx, nil
+}
+
+// newTxWithInputs creates a new tx spending the given TSS-owned UTXOs
+func newTxWithInputs(prevOuts []btcjson.ListUnspentResult) (*wire.MsgTx, error) {
+	tx := wire.NewMsgTx(wire.TxVersion)
+	for _, prevOut := range prevOuts {
+		hash, err := chainhash.NewHashFromStr(prevOut.TxID)
 		if err != nil {
 			return nil, err
 		}
-		pkScript, err := hex.DecodeString(prevOuts[ix].ScriptPubKey)
+		outpoint := wire.NewOutPoint(hash, prevOut.Vout)
+		txIn := wire.NewTxIn(outpoint, nil, nil)
+		txIn.Sequence = bitcoin.RBFTxInSequenceNum // opt in replace-by-fee to bump fee when stuck
+		tx.AddTxIn(txIn)
+	}
+	return tx, nil
+}
+
+// signTxInputs signs the inputs of the tx that spend the given TSS-owned UTXOs
+func (signer *Signer) signTxInputs(
+	ctx context.Context,
+	tx *wire.MsgTx,
+	prevOuts []btcjson.ListUnspentResult,
+	height uint64,
+	nonce uint64,
+	chainID int64,
+) error {
+	var err error
+	amounts := make([]int64, len(tx.TxIn))
+	pkScripts := make([][]byte, len(tx.TxIn))
+	for ix := range tx.TxIn {
+		amounts[ix], err = bitcoin.GetSatoshis(prevOuts[ix].Amount)
 		if err != nil {
-			return nil, err
+			return err
 		}
-		witnessHashes[ix], err = txscript.CalcWitnessSigHash(pkScript, sigHashes, txscript.SigHashAll, tx, ix, amt)
+		pkScripts[ix], err = hex.DecodeString(prevOuts[ix].ScriptPubKey)
 		if err != nil {
-			return nil, err
+			return err
+		}
+	}
+	return signer.SignTx(ctx, tx, amounts, pkScripts, height, nonce, chainID)
+}
+
+// SignTx signs all the inputs of the tx with TSS key
+// amounts and pkScripts are the values and scripts of the TSS-owned P2WPKH outputs spent by the inputs
+func (signer *Signer) SignTx(
+	ctx context.Context,
+	tx *wire.MsgTx,
+	amounts []int64,
+	pkScripts [][]byte,
+	height uint64,
+	nonce uint64,
+	chainID int64,
+) error {
+	if len(amounts) != len(tx.TxIn) || len(pkScripts) != len(tx.TxIn) {
+		return fmt.Errorf("expected %d amounts and pkScripts, got %d and %d", len(tx.TxIn), len(amounts), len(pkScripts))
+	}
+
+	sigHashes := txscript.NewTxSigHashes(tx, txscript.NewCannedPrevOutputFetcher([]byte{}, 0))
+	witnessHashes := make([][]byte, len(tx.TxIn))
+	for ix := range tx.TxIn {
+		var err error
+		witnessHashes[ix], err = txscript.CalcWitnessSigHash(
+			pkScripts[ix],
+			sigHashes,
+			txscript.SigHashAll,
+			tx,
+			ix,
+			amounts[ix],
+		)
+		if err != nil {
+			return err
 		}
 	}
 
-	sig65Bs, err := signer.TSS().SignBatch(ctx, witnessHashes, height, nonce, chain.ChainId)
+	sig65Bs, err := signer.TSS().SignBatch(ctx, witnessHashes, height, nonce, chainID)
 	if err != nil {
-		return nil, fmt.Errorf("SignBatch error: %v", err)
+		return fmt.Errorf("SignBatch error: %v", err)
 	}
 
 	for ix := range tx.TxIn {
@@ -309,7 +440,7 @@ func (signer *Signer) SignWithdrawTx(
 		tx.TxIn[ix].Witness = txWitness
 	}
 
-	return tx, nil
+	return nil
 }
 
 // Broadcast sends the signed transaction to the network
@@ -334,7 +465,6 @@ func (signer *Signer) Broadcast(signedTx *wire.MsgTx) error {
 }
 
 // TryProcessOutbound signs and broadcasts a BTC transaction from a new outbound
-// TODO(revamp): simplify the function
 func (signer *Signer) TryProcessOutbound(
 	ctx context.Context,
 	cctx *types.CrossChainTx,
@@ -343,16 +473,44 @@ func (signer *Signer) TryProcessOutbound(
 	chainObserver interfaces.ChainObserver,
 	zetacoreClient interfaces.ZetacoreClient,
 	height uint64,
+) {
+	signer.TryProcessOutboundBatch(
+		ctx,
+		[]*types.CrossChainTx{cctx},
+		outboundProcessor,
+		outboundID,
+		chainObserver,
+		zetacoreClient,
+		height,
+	)
+}
+
+// TryProcessOutboundBatch signs and broadcasts one BTC transaction paying the given cctxs of consecutive nonces.
+// The batch is cut before the first cctx (except the 1st one) that can't be paid together with the others,
+// the remaining cctxs will be processed by future keysigns.
+// TODO(revamp): simplify the function
+func (signer *Signer) TryProcessOutboundBatch(
+	ctx context.Context,
+	cctxs []*types.CrossChainTx,
+	outboundProcessor *outboundprocessor.Processor,
+	outboundID string,
+	chainObserver interfaces.ChainObserver,
+	zetacoreClient interfaces.ZetacoreClient,
+	height uint64,
 ) {
 	// end outbound process on panic
 	defer func() {
 		outboundProcessor.EndTryProcess(outboundID)
 		if err := recover(); err != nil {
-			signer.Logger().Std.Error().Msgf("BTC TryProcessOutbound: %s, caught panic error: %v", cctx.Index, err)
+			signer.Logger().Std.Error().Msgf("BTC TryProcessOutbound: %s, caught panic error: %v", outboundID, err)
 		}
 	}()
+	if len(cctxs) == 0 {
+		return
+	}
 
 	// prepare logger
+	cctx := cctxs[0]
 	params := cctx.GetCurrentOutboundParam()
 	logger := signer.Logger().Std.With().
 		Str("method", "TryProcessOutbound").
@@ -361,13 +519,6 @@ func (signer *Signer) TryProcessOutbound(
 		Str("cctx", cctx.Index).
 		Logger()
 
-	// support gas token only for Bitcoin outbound
-	coinType := cctx.InboundParams.CoinType
-	if coinType == coin.CoinType_Zeta || coinType == coin.CoinType_ERC20 {
-		logger.Error().Msg("can only send BTC to a BTC network")
-		return
-	}
-
 	// convert chain observer to BTC observer
 	btcObserver, ok := chainObserver.(*observer.Observer)
 	if !ok {
@@ -383,25 +534,13 @@ func (signer *Signer) TryProcessOutbound(
 		return
 	}
 
-	// get size limit and gas price
+	// get size limit, gas price and payment of the 1st cctx
 	sizelimit := params.CallOptions.GasLimit
-	gasprice, ok := new(big.Int).SetString(params.GasPrice, 10)
-	if !ok || gasprice.Cmp(big.NewInt(0)) < 0 {
-		logger.Error().Msgf("cannot convert gas price  %s ", params.GasPrice)
-		return
-	}
-
-	// Check receiver P2WPKH address
-	to, err := chains.DecodeBtcAddress(params.Receiver, params.ReceiverChainId)
+	payment, gasprice, err := getOutboundPayment(cctx)
 	if err != nil {
-		logger.Error().Err(err).Msgf("cannot decode address %s ", params.Receiver)
-		return
-	}
-	if !chains.IsBtcAddressSupported(to) {
-		logger.Error().Msgf("unsupported address %s", params.Receiver)
+		logger.Error().Err(err).Msg("invalid outbound")
 		return
 	}
-	amount := float64(params.Amount.Uint64()) / 1e8
 
 	// Add 1 satoshi/byte to gasPrice to avoid minRelayTxFee issue
 	networkInfo, err := signer.client.GetNetworkInfo()
@@ -417,31 +556,87 @@ func (signer *Signer) TryProcessOutbound(
 	if cancelTx {
 		compliance.PrintComplianceLog(logger, signer.Logger().Compliance,
 			true, chain.ChainId, cctx.Index, cctx.InboundParams.Sender, params.Receiver, "BTC")
-		amount = 0.0 // zero out the amount to cancel the tx
+		payment.Amount = 0.0 // zero out the amount to cancel the tx
 	}
-	logger.Info().Msgf("SignGasWithdraw: to %s, value %d sats", to.EncodeAddress(), params.Amount.Uint64())
+	logger.Info().Msgf("SignGasWithdraw: to %s, value %d sats", payment.To.EncodeAddress(), params.Amount.Uint64())
+
+	// a consolidation pays nothing but the change to TSS, it's bumped the same way as a cancelled outbound
+	isConsolidation := cctx.IsUTXOConsolidation()
+
+	// bump the fee of the outbound (instead of signing a new one) if it's stuck in mempool
+	stuckTx, err := btcObserver.GetStuckOutbound(cctx)
+	if err != nil {
+		logger.Error().Err(err).Msg("cannot check stuck outbound")
+		return
+	}
+	if stuckTx != nil {
+		signer.TryBumpOutbound(
+			ctx,
+			stuckTx,
+			gasprice.Int64(),
+			satPerByte.Int64(),
+			cancelTx || isConsolidation,
+			btcObserver,
+			zetacoreClient,
+			height,
+			outboundTssNonce,
+			logger,
+		)
+		return
+	}
+
+	// pack the following cctxs into the outbound, a cancelled cctx or a consolidation is always paid alone
+	payments := []Payment{payment}
+	for i := 1; i < len(cctxs) && i < bitcoin.MaxOutboundBatchSize && !cancelTx && !isConsolidation; i++ {
+		next := cctxs[i]
+		nextNonce := next.GetCurrentOutboundParam().TssNonce
+		// #nosec G115 always positive
+		if nextNonce != outboundTssNonce+uint64(i) || compliance.IsCctxRestricted(next) || next.IsUTXOConsolidation() {
+			break
+		}
+		nextPayment, nextGasPrice, err := getOutboundPayment(next)
+		if err != nil {
+			logger.Warn().Err(err).Msgf("cannot batch outbound of nonce %d", nextNonce)
+			break
+		}
+		// the batched outbound pays the highest gas price among the cctxs
+		nextGasPrice.Add(nextGasPrice, satPerByte)
+		if nextGasPrice.Cmp(gasprice) > 0 {
+			gasprice = nextGasPrice
+		}
+		payments = append(payments, nextPayment)
+	}
+	lastNonce := payments[len(payments)-1].Nonce
 
 	// sign withdraw tx
-	tx, err := signer.SignWithdrawTx(
-		ctx,
-		to,
-		amount,
-		gasprice,
-		sizelimit,
-		btcObserver,
-		height,
-		outboundTssNonce,
-		chain,
-		cancelTx,
-	)
+	var tx *wire.MsgTx
+	switch {
+	case isConsolidation:
+		tx, err = signer.SignConsolidationTx(ctx, gasprice, btcObserver, height, outboundTssNonce, chain)
+	case len(payments) == 1:
+		tx, err = signer.SignWithdrawTx(
+			ctx,
+			payment.To,
+			payment.Amount,
+			gasprice,
+			sizelimit,
+			btcObserver,
+			height,
+			outboundTssNonce,
+			chain,
+			cancelTx,
+		)
+	default:
+		tx, err = signer.SignBatchWithdrawTx(ctx, payments, gasprice, btcObserver, height, chain)
+	}
 	if err != nil {
 		logger.Warn().
 			Err(err).
-			Msgf("SignConnectorOnReceive error: nonce %d chain %d", outboundTssNonce, params.ReceiverChainId)
+			Msgf("SignConnectorOnReceive error: nonces [%d, %d] chain %d", outboundTssNonce, lastNonce, params.ReceiverChainId)
 		return
 	}
 	logger.Info().
-		Msgf("Key-sign success: %d => %s, nonce %d", cctx.InboundParams.SenderChainId, chain.Name, outboundTssNonce)
+		Msgf("Key-sign success: %d => %s, nonces [%d, %d]", cctx.InboundParams.SenderChainId, chain.Name, outboundTssNonce, lastNonce)
 
 	// FIXME: add prometheus metrics
 	_, err = zetacoreClient.GetObserverList(ctx)
@@ -453,26 +648,78 @@ func (signer *Signer) TryProcessOutbound(
 	if tx != nil {
 		outboundHash := tx.TxHash().String()
 		logger.Info().
-			Msgf("on chain %s nonce %d, outboundHash %s signer %s", chain.Name, outboundTssNonce, outboundHash, signerAddress)
+			Msgf("on chain %s nonces [%d, %d], outboundHash %s signer %s", chain.Name, outboundTssNonce, lastNonce, outboundHash, signerAddress)
 
-		// try broacasting tx with increasing backoff (1s, 2s, 4s, 8s, 16s) in case of RPC error
-		backOff := broadcastBackoff
-		for i := 0; i < broadcastRetries; i++ {
-			time.Sleep(backOff)
-			err := signer.Broadcast(tx)
-			if err != nil {
-				logger.Warn().
-					Err(err).
-					Msgf("broadcasting tx %s to chain %s: nonce %d, retry %d", outboundHash, chain.Name, outboundTssNonce, i)
-				backOff *= 2
-				continue
-			}
+		signer.BroadcastOutbound(ctx, tx, outboundTssNonce, lastNonce, btcObserver, zetacoreClient, logger)
+	}
+}
+
+// getOutboundPayment returns the payment and gas price of the given cctx
+func getOutboundPayment(cctx *types.CrossChainTx) (Payment, *big.Int, error) {
+	params := cctx.GetCurrentOutboundParam()
+
+	// support gas token only for Bitcoin outbound
+	coinType := cctx.InboundParams.CoinType
+	if coinType == coin.CoinType_Zeta || coinType == coin.CoinType_ERC20 {
+		return Payment{}, nil, fmt.Errorf("can only send BTC to a BTC network")
+	}
+
+	// get gas price
+	gasprice, ok := new(big.Int).SetString(params.GasPrice, 10)
+	if !ok || gasprice.Cmp(big.NewInt(0)) < 0 {
+		return Payment{}, nil, fmt.Errorf("cannot convert gas price %s", params.GasPrice)
+	}
+
+	// Check receiver P2WPKH address
+	to, err := chains.DecodeBtcAddress(params.Receiver, params.ReceiverChainId)
+	if err != nil {
+		return Payment{}, nil, errors.Wrapf(err, "cannot decode address %s", params.Receiver)
+	}
+	if !chains.IsBtcAddressSupported(to) {
+		return Payment{}, nil, fmt.Errorf("unsupported address %s", params.Receiver)
+	}
+
+	return Payment{
+		Nonce:  params.TssNonce,
+		To:     to,
+		Amount: float64(params.Amount.Uint64()) / 1e8,
+	}, gasprice, nil
+}
+
+// BroadcastOutbound broadcasts the outbound with increasing backoff and reports it to the outbound tracker
+// of each nonce in [firstNonce, lastNonce] paid by the outbound
+// Returns true if the outbound is broadcasted successfully
+func (signer *Signer) BroadcastOutbound(
+	ctx context.Context,
+	tx *wire.MsgTx,
+	firstNonce uint64,
+	lastNonce uint64,
+	btcObserver *observer.Observer,
+	zetacoreClient interfaces.ZetacoreClient,
+	logger zerolog.Logger,
+) bool {
+	chain := btcObserver.Chain()
+	outboundHash := tx.TxHash().String()
+
+	// try broacasting tx with increasing backoff (1s, 2s, 4s, 8s, 16s) in case of RPC error
+	backOff := broadcastBackoff
+	for i := 0; i < broadcastRetries; i++ {
+		time.Sleep(backOff)
+		err := signer.Broadcast(tx)
+		if err != nil {
+			logger.Warn().
+				Err(err).
+				Msgf("broadcasting tx %s to chain %s: nonce %d, retry %d", outboundHash, chain.Name, firstNonce, i)
+			backOff *= 2
+			continue
+		}
+		for nonce := firstNonce; nonce <= lastNonce; nonce++ {
 			logger.Info().
-				Msgf("Broadcast success: nonce %d to chain %s outboundHash %s", outboundTssNonce, chain.String(), outboundHash)
+				Msgf("Broadcast success: nonce %d to chain %s outboundHash %s", nonce, chain.String(), outboundHash)
 			zetaHash, err := zetacoreClient.AddOutboundTracker(
 				ctx,
 				chain.ChainId,
-				outboundTssNonce,
+				nonce,
 				outboundHash,
 				nil,
 				"",
@@ -480,14 +727,15 @@ func (signer *Signer) TryProcessOutbound(
 			)
 			if err != nil {
 				logger.Err(err).
-					Msgf("Unable to add to tracker on zetacore: nonce %d chain %s outboundHash %s", outboundTssNonce, chain.Name, outboundHash)
+					Msgf("Unable to add to tracker on zetacore: nonce %d chain %s outboundHash %s", nonce, chain.Name, outboundHash)
 			}
 			logger.Info().Msgf("Broadcast to core successful %s", zetaHash)
 
 			// Save successfully broadcasted transaction to btc chain observer
-			btcObserver.SaveBroadcastedTx(outboundHash, outboundTssNonce)
-
-			break // successful broadcast; no need to retry
+			btcObserver.SaveBroadcastedTx(outboundHash, nonce)
 		}
+
+		return true // successful broadcast; no need to retry
 	}
+	return false
 }
@@ -31,6 +31,12 @@ const (
 
 	// LengthScriptP2PKH is the length of P2PKH script [OP_DUP OP_HASH160 0x14 <20-byte-hash> OP_EQUALVERIFY OP_CHECKSIG]
 	LengthScriptP2PKH = 25
+
+	// LengthScriptP2PKCompressed is the length of P2PK script with compressed pubkey [0x21 <33-byte-pubkey> OP_CHECKSIG]
+	LengthScriptP2PKCompressed = 35
+
+	// LengthScriptP2PKUncompressed is the length of P2PK script with uncompressed pubkey [0x41 <65-byte-pubkey> OP_CHECKSIG]
+	LengthScriptP2PKUncompressed = 67
 )
 
 // IsPkScriptP2TR checks if the given script is a P2TR script
@@ -66,6 +72,26 @@ func IsPkScriptP2PKH(script []byte) bool {
 		script[24] == txscript.OP_CHECKSIG
 }
 
+// IsPkScriptP2PK checks if the given script is a P2PK script (compressed or uncompressed pubkey)
+func IsPkScriptP2PK(script []byte) bool {
+	switch len(script) {
+	case LengthScriptP2PKCompressed:
+		return script[0] == 0x21 &&
+			(script[1] == 0x02 || script[1] == 0x03) &&
+			script[34] == txscript.OP_CHECKSIG
+	case LengthScriptP2PKUncompressed:
+		return script[0] == 0x41 && script[1] == 0x04 && script[66] == txscript.OP_CHECKSIG
+	default:
+		return false
+	}
+}
+
+// IsPkScriptMultisig checks if the given script is a bare multisig script [OP_m <pubkeys...> OP_n OP_CHECKMULTISIG]
+func IsPkScriptMultisig(script []byte) bool {
+	isMultisig, err := txscript.IsMultisigScript(script)
+	return err == nil && isMultisig
+}
+
 // DecodeScriptP2TR decodes address from P2TR script
 func DecodeScriptP2TR(scriptHex string, net *chaincfg.Params) (string, error) {
 	script, err := hex.DecodeString(scriptHex)
@@ -153,6 +179,26 @@ func DecodeScriptP2PKH(scriptHex string, net *chaincfg.Params) (string, error) {
 	return EncodeAddress(pubKeyHash, net.PubKeyHashAddrID), nil
 }
 
+// DecodeScriptP2PK decodes address from P2PK script
+// The pay-to-pubkey output has no address of its own, the P2PKH address of the pubkey is returned
+func DecodeScriptP2PK(scriptHex string, net *chaincfg.Params) (string, error) {
+	script, err := hex.DecodeString(scriptHex)
+	if err != nil {
+		return "", errors.Wrapf(err, "error decoding script: %s", scriptHex)
+	}
+	if !IsPkScriptP2PK(script) {
+		return "", fmt.Errorf("invalid P2PK script: %s", scriptHex)
+	}
+
+	pubKey := script[1 : len(script)-1]
+	address, err := btcutil.NewAddressPubKey(pubKey, net)
+	if err != nil {
+		return "", errors.Wrapf(err, "error getting address from script: %s", scriptHex)
+	}
+
+	return address.AddressPubKeyHash().EncodeAddress(), nil
+}
+
 // DecodeOpReturnMemo decodes memo from OP_RETURN script
 // returns (memo, found, error)
 func DecodeOpReturnMemo(scriptHex string) ([]byte, bool, error) {
@@ -257,6 +303,11 @@ func DecodeSenderFromScript(pkScript []byte, net *chaincfg.Params) (string, erro
 		return DecodeScriptP2SH(scriptHex, net)
 	case IsPkScriptP2PKH(pkScript):
 		return DecodeScriptP2PKH(scriptHex, net)
+	case IsPkScriptP2PK(pkScript):
+		return DecodeScriptP2PK(scriptHex, net)
+	case IsPkScriptMultisig(pkScript):
+		// bare multisig has no single sender address, the revert address must be provided in the memo
+		return "", nil
 	default:
 		// sender address not found, return nil and move on to the next tx
 		return "", nil
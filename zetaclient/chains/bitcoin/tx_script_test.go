@@ -432,6 +432,7 @@ func TestDecodeSenderFromScript(t *testing.T) {
 		name           string
 		txHash         string
 		outputIndex    int
+		pkScript       []byte // use given script instead of archived tx
 		expectedSender string
 		invalidScript  bool // use invalid script or not
 	}{
@@ -470,6 +471,32 @@ func TestDecodeSenderFromScript(t *testing.T) {
 			outputIndex:    1,
 			expectedSender: "1ESQp1WQi7fzSpzCNs2oBTqaUBmNjLQLoV",
 		},
+		{
+			name: "should decode P2PKH sender address from P2PK script with compressed pubkey",
+			pkScript: testutil.HexToBytes(
+				t,
+				"210279be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798ac",
+			),
+			expectedSender: "1BgGZ9tcN4rm9KBzDn7KprQz87SZ26SAMH",
+		},
+		{
+			name: "should decode P2PKH sender address from P2PK script with uncompressed pubkey",
+			pkScript: testutil.HexToBytes(
+				t,
+				"410479be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798"+
+					"483ada7726a3c4655da4fbfc0e1108a8fd17b448a68554199c47d08ffb10d4b8ac",
+			),
+			expectedSender: "1EHNa6Q4Jz2uvNExL497mE43ikXhwF6kZm",
+		},
+		{
+			name: "should decode empty sender address on bare multisig script",
+			// 1-of-1 multisig [OP_1 <pubkey> OP_1 OP_CHECKMULTISIG]
+			pkScript: testutil.HexToBytes(
+				t,
+				"51210279be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f8179851ae",
+			),
+			expectedSender: "",
+		},
 		{
 			name:           "should decode empty sender address on unknown script",
 			expectedSender: "",
@@ -484,10 +511,13 @@ func TestDecodeSenderFromScript(t *testing.T) {
 			var pkScript []byte
 
 			// Load the archived tx or invalid script
-			if tt.invalidScript {
+			switch {
+			case tt.invalidScript:
 				// Use invalid script for the unknown script test case
 				pkScript = []byte{0x00, 0x01, 0x02, 0x03}
-			} else {
+			case tt.pkScript != nil:
+				pkScript = tt.pkScript
+			default:
 				msgTx := testutils.LoadBTCMsgTx(t, TestDataDir, chain.ChainId, tt.txHash)
 				pkScript = msgTx.TxOut[tt.outputIndex].PkScript
 			}
@@ -0,0 +1,70 @@
+package observer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	"github.com/zeta-chain/node/zetaclient/chains/base"
+)
+
+// BackfillInbound replays the inbound scanning over the block range [from, to] to vote for missed inbounds.
+// It scans the connector and custody events, the gas tokens sent to TSS and the gateway events.
+// The last scanned block of the observer is left untouched.
+func (ob *Observer) BackfillInbound(ctx context.Context, from, to uint64) error {
+	if err := base.ValidateBackfillRange(from, to, ob.LastBlockScanned()); err != nil {
+		return err
+	}
+	ctx = base.WithBackfill(ctx)
+
+	for startBlock := from; startBlock <= to; startBlock += base.BackfillBatchSize {
+		toBlock := min(startBlock+base.BackfillBatchSize-1, to)
+
+		if err := ob.backfillInboundRange(ctx, startBlock, toBlock); err != nil {
+			return errors.Wrapf(err, "unable to backfill blocks %d to %d for chain %d", startBlock, toBlock, ob.Chain().ChainId)
+		}
+		ob.Logger().Inbound.Info().
+			Uint64("backfill.from", from).
+			Uint64("backfill.to", to).
+			Uint64("backfill.scanned", toBlock).
+			Msg("BackfillInbound: blocks scanned")
+
+		if toBlock < to {
+			if err := base.WaitBackfillBatchInterval(ctx); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// backfillInboundRange scans all the inbound sources over the block range [startBlock, toBlock]
+func (ob *Observer) backfillInboundRange(ctx context.Context, startBlock, toBlock uint64) error {
+	scanners := []struct {
+		name string
+		scan func(ctx context.Context, startBlock, toBlock uint64) (uint64, error)
+	}{
+		{"ZetaSent", ob.ObserveZetaSent},
+		{"ERC20Deposited", func(ctx context.Context, startBlock, toBlock uint64) (uint64, error) {
+			return ob.ObserveERC20Deposited(ctx, startBlock, toBlock), nil
+		}},
+		{"TSSReceive", ob.ObserverTSSReceive},
+		{"GatewayDeposit", ob.ObserveGatewayDeposit},
+		{"GatewayCall", ob.ObserveGatewayCall},
+		{"GatewayDepositAndCall", ob.ObserveGatewayDepositAndCall},
+	}
+
+	for _, scanner := range scanners {
+		lastScanned, err := scanner.scan(ctx, startBlock, toBlock)
+		switch {
+		case err != nil:
+			return errors.Wrapf(err, "unable to scan %s events", scanner.name)
+		case lastScanned < toBlock:
+			return fmt.Errorf("%s events scanned up to block %d only", scanner.name, lastScanned)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,44 @@
+package observer_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/node/pkg/chains"
+	zctx "github.com/zeta-chain/node/zetaclient/context"
+	"github.com/zeta-chain/node/zetaclient/testutils/mocks"
+)
+
+func Test_BackfillInbound(t *testing.T) {
+	chain := chains.Ethereum
+	chainParam := mocks.MockChainParams(chain.ChainId, 1)
+
+	t.Run("should not backfill blocks not scanned yet", func(t *testing.T) {
+		ob, _ := MockEVMObserver(t, chain, nil, nil, nil, nil, 1000, chainParam)
+		ob.WithLastBlockScanned(500)
+
+		err := ob.BackfillInbound(context.Background(), 400, 501)
+		require.ErrorContains(t, err, "is greater than last scanned block")
+	})
+
+	t.Run("should stop backfilling on RPC error", func(t *testing.T) {
+		evmClient := mocks.NewEVMRPCClient(t)
+		evmClient.On("BlockNumber", mock.Anything).Return(uint64(1000), nil)
+		evmClient.On("FilterLogs", mock.Anything, mock.Anything).Return(nil, errors.New("RPC error"))
+
+		ob, app := MockEVMObserver(t, chain, evmClient, nil, nil, nil, 1000, chainParam)
+		ob.WithLastBlockScanned(500)
+
+		ctx := zctx.WithAppContext(context.Background(), app)
+		err := ob.BackfillInbound(ctx, 400, 450)
+		require.ErrorContains(t, err, "unable to backfill blocks 400 to 409")
+		require.ErrorContains(t, err, "unable to scan ZetaSent events")
+
+		// last scanned block is untouched
+		require.EqualValues(t, 500, ob.LastBlockScanned())
+	})
+}
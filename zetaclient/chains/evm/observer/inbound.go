@@ -61,7 +61,7 @@ func (ob *Observer) watchInboundOnce(ctx context.Context, t *ticker.Ticker, samp
 	}
 
 	// noop
-	if !app.IsInboundObservationEnabled() {
+	if !app.IsInboundObservationEnabled(ob.Chain().ChainId) {
 		ob.Logger().Inbound.Warn().Msg("WatchInbound: inbound observation is disabled")
 		return nil
 	}
@@ -99,7 +99,7 @@ func (ob *Observer) WatchInboundTracker(ctx context.Context) error {
 	for {
 		select {
 		case <-ticker.C():
-			if !app.IsInboundObservationEnabled() {
+			if !app.IsInboundObservationEnabled(ob.Chain().ChainId) {
 				continue
 			}
 			err := ob.ProcessInboundTrackers(ctx)
@@ -278,6 +278,27 @@ func (ob *Observer) ObserveInbound(ctx context.Context, sampledLogger zerolog.Lo
 	return nil
 }
 
+// txVerifier is implemented by EVM clients that cross-check txs across multiple RPC endpoints
+type txVerifier interface {
+	VerifyTx(ctx context.Context, txHash string) error
+}
+
+// PostVoteInbound cross-checks the inbound tx across the RPC endpoints if the client supports it,
+// then posts the inbound vote to zetacore
+func (ob *Observer) PostVoteInbound(
+	ctx context.Context,
+	msg *types.MsgVoteInbound,
+	retryGasLimit uint64,
+) (string, error) {
+	if verifier, ok := ob.evmClient.(txVerifier); ok {
+		if err := verifier.VerifyTx(ctx, msg.InboundHash); err != nil {
+			return "", errors.Wrapf(err, "unable to verify inbound %s across RPC endpoints", msg.InboundHash)
+		}
+	}
+
+	return ob.Observer.PostVoteInbound(ctx, msg, retryGasLimit)
+}
+
 // ObserveZetaSent queries the ZetaSent event from the connector contract and posts to zetacore
 // returns the last block successfully scanned
 func (ob *Observer) ObserveZetaSent(ctx context.Context, startBlock, toBlock uint64) (uint64, error) {
@@ -353,6 +374,11 @@ func (ob *Observer) ObserveZetaSent(ctx context.Context, startBlock, toBlock uin
 			continue
 		}
 
+		// large amounts may require more confirmations, re-scan from this block next time
+		if !ob.hasEnoughInboundConfirmations(msg) {
+			return beingScanned - 1, nil
+		}
+
 		const gasLimit = zetacore.PostVoteInboundMessagePassingExecutionGasLimit
 		if _, err = ob.PostVoteInbound(ctx, msg, gasLimit); err != nil {
 			// we have to re-scan from this block next time
@@ -438,6 +464,10 @@ func (ob *Observer) ObserveERC20Deposited(ctx context.Context, startBlock, toBlo
 
 		msg := ob.BuildInboundVoteMsgForDepositedEvent(event, sender)
 		if msg != nil {
+			// large amounts may require more confirmations, re-scan from this block next time
+			if !ob.hasEnoughInboundConfirmations(msg) {
+				return beingScanned - 1
+			}
 			_, err = ob.PostVoteInbound(ctx, msg, zetacore.PostVoteInboundExecutionGasLimit)
 			if err != nil {
 				return beingScanned - 1 // we have to re-scan from this block next time
@@ -486,7 +516,7 @@ func (ob *Observer) CheckAndVoteInboundTokenZeta(
 	}
 
 	// check confirmations
-	if confirmed := ob.HasEnoughConfirmations(receipt, ob.LastBlock()); !confirmed {
+	if confirmed := ob.HasEnoughConfirmations(receipt, ob.LastBlock(), ob.ChainParams().ConfirmationCount); !confirmed {
 		return "", fmt.Errorf(
 			"inbound %s has not been confirmed yet: receipt block %d",
 			tx.Hash,
@@ -521,6 +551,15 @@ func (ob *Observer) CheckAndVoteInboundTokenZeta(
 		ob.Logger().Inbound.Info().Msgf("no ZetaSent event found for inbound %s chain %d", tx.Hash, ob.Chain().ChainId)
 		return "", nil
 	}
+	// check confirmations required by the asset and amount tier
+	if !ob.hasEnoughInboundConfirmations(msg) {
+		return "", fmt.Errorf(
+			"inbound %s has not been confirmed yet for amount %s: receipt block %d",
+			tx.Hash,
+			msg.Amount,
+			receipt.BlockNumber.Uint64(),
+		)
+	}
 	if vote {
 		return ob.PostVoteInbound(ctx, msg, zetacore.PostVoteInboundMessagePassingExecutionGasLimit)
 	}
@@ -536,7 +575,7 @@ func (ob *Observer) CheckAndVoteInboundTokenERC20(
 	vote bool,
 ) (string, error) {
 	// check confirmations
-	if confirmed := ob.HasEnoughConfirmations(receipt, ob.LastBlock()); !confirmed {
+	if confirmed := ob.HasEnoughConfirmations(receipt, ob.LastBlock(), ob.ChainParams().ConfirmationCount); !confirmed {
 		return "", fmt.Errorf(
 			"inbound %s has not been confirmed yet: receipt block %d",
 			tx.Hash,
@@ -572,6 +611,15 @@ func (ob *Observer) CheckAndVoteInboundTokenERC20(
 		ob.Logger().Inbound.Info().Msgf("no Deposited event found for inbound %s chain %d", tx.Hash, ob.Chain().ChainId)
 		return "", nil
 	}
+	// check confirmations required by the asset and amount tier
+	if !ob.hasEnoughInboundConfirmations(msg) {
+		return "", fmt.Errorf(
+			"inbound %s has not been confirmed yet for amount %s: receipt block %d",
+			tx.Hash,
+			msg.Amount,
+			receipt.BlockNumber.Uint64(),
+		)
+	}
 	if vote {
 		return ob.PostVoteInbound(ctx, msg, zetacore.PostVoteInboundExecutionGasLimit)
 	}
@@ -587,7 +635,7 @@ func (ob *Observer) CheckAndVoteInboundTokenGas(
 	vote bool,
 ) (string, error) {
 	// check confirmations
-	if confirmed := ob.HasEnoughConfirmations(receipt, ob.LastBlock()); !confirmed {
+	if confirmed := ob.HasEnoughConfirmations(receipt, ob.LastBlock(), ob.ChainParams().ConfirmationCount); !confirmed {
 		return "", fmt.Errorf(
 			"inbound %s has not been confirmed yet: receipt block %d",
 			tx.Hash,
@@ -611,6 +659,15 @@ func (ob *Observer) CheckAndVoteInboundTokenGas(
 		ob.Logger().Inbound.Info().Msgf("no vote message built for inbound %s chain %d", tx.Hash, ob.Chain().ChainId)
 		return "", nil
 	}
+	// check confirmations required by the asset and amount tier
+	if !ob.hasEnoughInboundConfirmations(msg) {
+		return "", fmt.Errorf(
+			"inbound %s has not been confirmed yet for amount %s: receipt block %d",
+			tx.Hash,
+			msg.Amount,
+			receipt.BlockNumber.Uint64(),
+		)
+	}
 	if vote {
 		return ob.PostVoteInbound(ctx, msg, zetacore.PostVoteInboundExecutionGasLimit)
 	}
@@ -618,12 +675,19 @@ func (ob *Observer) CheckAndVoteInboundTokenGas(
 	return msg.Digest(), nil
 }
 
-// HasEnoughConfirmations checks if the given receipt has enough confirmations
-func (ob *Observer) HasEnoughConfirmations(receipt *ethtypes.Receipt, lastHeight uint64) bool {
-	confHeight := receipt.BlockNumber.Uint64() + ob.ChainParams().ConfirmationCount
+// HasEnoughConfirmations checks if the given receipt has the given number of confirmations
+func (ob *Observer) HasEnoughConfirmations(receipt *ethtypes.Receipt, lastHeight, confirmations uint64) bool {
+	confHeight := receipt.BlockNumber.Uint64() + confirmations
 	return lastHeight >= confHeight
 }
 
+// hasEnoughInboundConfirmations checks if the given inbound vote has the number of confirmations
+// required by the confirmation tier matching its asset and amount
+func (ob *Observer) hasEnoughInboundConfirmations(msg *types.MsgVoteInbound) bool {
+	confirmations := ob.ConfirmationCount(msg.CoinType, msg.Asset, msg.Amount)
+	return ob.LastBlock() >= msg.InboundBlockHeight+confirmations
+}
+
 // BuildInboundVoteMsgForDepositedEvent builds a inbound vote message for a Deposited event
 func (ob *Observer) BuildInboundVoteMsgForDepositedEvent(
 	event *erc20custody.ERC20CustodyDeposited,
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"testing"
 
+	sdkmath "cosmossdk.io/math"
 	ethcommon "github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/onrik/ethrpc"
@@ -18,7 +19,9 @@ import (
 	"github.com/zeta-chain/node/pkg/chains"
 	"github.com/zeta-chain/node/pkg/coin"
 	"github.com/zeta-chain/node/pkg/constant"
+	observertypes "github.com/zeta-chain/node/x/observer/types"
 	"github.com/zeta-chain/node/zetaclient/chains/evm"
+	evmrpc "github.com/zeta-chain/node/zetaclient/chains/evm/rpc"
 	"github.com/zeta-chain/node/zetaclient/chains/interfaces"
 	"github.com/zeta-chain/node/zetaclient/config"
 	"github.com/zeta-chain/node/zetaclient/testutils"
@@ -252,6 +255,57 @@ func Test_CheckAndVoteInboundTokenGas(t *testing.T) {
 		_, err := ob.CheckAndVoteInboundTokenGas(ctx, tx, receipt, false)
 		require.ErrorContains(t, err, "not been confirmed")
 	})
+	t.Run("should fail on inbound not confirmed for its amount tier", func(t *testing.T) {
+		tx, receipt, _ := testutils.LoadEVMInboundNReceiptNCctx(t, TestDataDir, chainID, inboundHash, coin.CoinType_Gas)
+		require.NoError(t, evm.ValidateEvmTransaction(tx))
+		lastBlock := receipt.BlockNumber.Uint64() + confirmation
+
+		// require more confirmations for any amount of gas token
+		tieredParams := chainParam
+		tieredParams.ConfirmationTiers = []observertypes.ConfirmationTier{
+			{
+				CoinType:          coin.CoinType_Gas,
+				AmountThreshold:   sdkmath.NewUint(1),
+				ConfirmationCount: confirmation + 1,
+			},
+		}
+
+		ob, _ := MockEVMObserver(t, chain, nil, nil, nil, nil, lastBlock, tieredParams)
+		_, err := ob.CheckAndVoteInboundTokenGas(ctx, tx, receipt, false)
+		require.ErrorContains(t, err, "not been confirmed")
+
+		// pass once the tier confirmations arrive
+		ob.WithLastBlock(lastBlock + 1)
+		_, err = ob.CheckAndVoteInboundTokenGas(ctx, tx, receipt, false)
+		require.NoError(t, err)
+	})
+	t.Run("should not vote if RPC endpoints disagree on inbound", func(t *testing.T) {
+		tx, receipt, _ := testutils.LoadEVMInboundNReceiptNCctx(t, TestDataDir, chainID, inboundHash, coin.CoinType_Gas)
+		require.NoError(t, evm.ValidateEvmTransaction(tx))
+		lastBlock := receipt.BlockNumber.Uint64() + confirmation
+
+		// the fallback endpoint reports a failed receipt
+		lyingReceipt := *receipt
+		lyingReceipt.Status = ethtypes.ReceiptStatusFailed
+		newEndpoint := func(r *ethtypes.Receipt) evmrpc.Endpoint {
+			client := mocks.NewEVMRPCClient(t)
+			client.On("BlockNumber", mock.Anything).Return(lastBlock, nil).Maybe()
+			client.On("TransactionReceipt", mock.Anything, receipt.TxHash).Return(r, nil)
+			jsonClient := mocks.NewMockJSONRPCClient().
+				WithBlock(&ethrpc.Block{Number: int(r.BlockNumber.Int64()), Hash: r.BlockHash.Hex()})
+			return evmrpc.Endpoint{Client: client, JSONRPCClient: jsonClient}
+		}
+		evmClient, err := evmrpc.NewFailoverClient(
+			[]evmrpc.Endpoint{newEndpoint(receipt), newEndpoint(&lyingReceipt)},
+			2,
+			zerolog.Nop(),
+		)
+		require.NoError(t, err)
+
+		ob, _ := MockEVMObserver(t, chain, evmClient, nil, nil, nil, lastBlock, chainParam)
+		_, err = ob.CheckAndVoteInboundTokenGas(ctx, tx, receipt, true)
+		require.ErrorContains(t, err, "unable to verify inbound")
+	})
 	t.Run("should not act if receiver is not TSS", func(t *testing.T) {
 		tx, receipt, _ := testutils.LoadEVMInboundNReceiptNCctx(t, TestDataDir, chainID, inboundHash, coin.CoinType_Gas)
 		tx.To = testutils.OtherAddress1 // use other address
@@ -26,6 +26,7 @@ import (
 	"github.com/zeta-chain/node/zetaclient/chains/evm"
 	"github.com/zeta-chain/node/zetaclient/chains/interfaces"
 	"github.com/zeta-chain/node/zetaclient/db"
+	"github.com/zeta-chain/node/zetaclient/logs"
 	"github.com/zeta-chain/node/zetaclient/metrics"
 )
 
@@ -103,6 +104,11 @@ func NewObserver(
 		return nil, errors.Wrap(err, "unable to load last block scanned")
 	}
 
+	// load confirmed outbounds
+	if err = ob.LoadConfirmedOutbounds(); err != nil {
+		return nil, errors.Wrap(err, "unable to load confirmed outbounds")
+	}
+
 	return ob, nil
 }
 
@@ -188,14 +194,78 @@ func (ob *Observer) Start(ctx context.Context) {
 	bg.Work(ctx, ob.watchRPCStatus, bg.WithName("watchRPCStatus"), bg.WithLogger(ob.Logger().Chain))
 }
 
-// SetTxNReceipt sets the receipt and transaction in memory
+// SetTxNReceipt sets the receipt and transaction in memory and persists them to the database
 func (ob *Observer) SetTxNReceipt(nonce uint64, receipt *ethtypes.Receipt, transaction *ethtypes.Transaction) {
+	ob.setTxNReceipt(nonce, receipt, transaction)
+
+	if err := ob.saveTxNReceipt(nonce, receipt, transaction); err != nil {
+		ob.Logger().Outbound.Error().Err(err).Uint64(logs.FieldNonce, nonce).Msg("unable to save outbound to db")
+	}
+}
+
+// setTxNReceipt sets the receipt and transaction in memory
+func (ob *Observer) setTxNReceipt(nonce uint64, receipt *ethtypes.Receipt, transaction *ethtypes.Transaction) {
 	ob.Mu().Lock()
 	defer ob.Mu().Unlock()
 	ob.outboundConfirmedReceipts[ob.OutboundID(nonce)] = receipt
 	ob.outboundConfirmedTransactions[ob.OutboundID(nonce)] = transaction
 }
 
+// saveTxNReceipt persists the confirmed outbound receipt and transaction to the database
+func (ob *Observer) saveTxNReceipt(nonce uint64, receipt *ethtypes.Receipt, transaction *ethtypes.Transaction) error {
+	if receipt == nil || transaction == nil {
+		return nil
+	}
+
+	receiptJSON, err := receipt.MarshalJSON()
+	if err != nil {
+		return errors.Wrap(err, "unable to marshal receipt")
+	}
+	txBytes, err := transaction.MarshalBinary()
+	if err != nil {
+		return errors.Wrap(err, "unable to marshal transaction")
+	}
+
+	status := chains.ReceiveStatus_success
+	if receipt.Status != ethtypes.ReceiptStatusSuccessful {
+		status = chains.ReceiveStatus_failed
+	}
+
+	txHash := transaction.Hash().Hex()
+	if err := ob.SaveOutboundBroadcast(nonce, txHash, txBytes); err != nil {
+		return err
+	}
+
+	return ob.SaveOutboundResult(nonce, txHash, receiptJSON, status)
+}
+
+// LoadConfirmedOutbounds loads the confirmed outbound receipts and transactions from the database into memory
+func (ob *Observer) LoadConfirmedOutbounds() error {
+	outbounds, err := ob.LoadOutbounds()
+	if err != nil {
+		return err
+	}
+
+	for _, outbound := range outbounds {
+		if !outbound.HasResult() {
+			continue
+		}
+
+		receipt := &ethtypes.Receipt{}
+		if err := receipt.UnmarshalJSON(outbound.Result); err != nil {
+			return errors.Wrapf(err, "unable to unmarshal receipt of outbound %s", outbound.TxHash)
+		}
+		transaction := &ethtypes.Transaction{}
+		if err := transaction.UnmarshalBinary(outbound.SignedPayload); err != nil {
+			return errors.Wrapf(err, "unable to unmarshal transaction of outbound %s", outbound.TxHash)
+		}
+
+		ob.setTxNReceipt(outbound.Nonce, receipt, transaction)
+	}
+
+	return nil
+}
+
 // GetTxNReceipt gets the receipt and transaction from memory
 func (ob *Observer) GetTxNReceipt(nonce uint64) (*ethtypes.Receipt, *ethtypes.Transaction) {
 	ob.Mu().Lock()
@@ -328,6 +328,62 @@ func Test_LoadLastBlockScanned(t *testing.T) {
 	})
 }
 
+func Test_LoadConfirmedOutbounds(t *testing.T) {
+	// load archived outbound receipt and transaction
+	chain := chains.Ethereum
+	params := mocks.MockChainParams(chain.ChainId, 1)
+	nonce := uint64(9718)
+	_, outbound, receipt := testutils.LoadEVMCctxNOutboundNReceipt(
+		t,
+		TestDataDir,
+		chain.ChainId,
+		nonce,
+		testutils.EventZetaReceived,
+	)
+
+	t.Run("should rehydrate confirmed outbounds from db on restart", func(t *testing.T) {
+		// create observer and confirm the outbound
+		evmClient := mocks.NewEVMRPCClient(t)
+		evmClient.On("BlockNumber", mock.Anything).Return(uint64(1000), nil)
+		ob, _ := MockEVMObserver(t, chain, evmClient, nil, nil, nil, 1, params)
+		ob.SetTxNReceipt(nonce, receipt, outbound)
+
+		// create a new observer on the same db
+		obNew, err := observer.NewObserver(
+			context.Background(),
+			chain,
+			evmClient,
+			mocks.NewMockJSONRPCClient(),
+			params,
+			ob.ZetacoreClient(),
+			ob.TSS(),
+			60,
+			ob.DB(),
+			base.DefaultLogger(),
+			nil,
+		)
+		require.NoError(t, err)
+
+		// the confirmed outbound should be loaded
+		require.True(t, obNew.IsTxConfirmed(nonce))
+		receiptLoaded, outboundLoaded := obNew.GetTxNReceipt(nonce)
+		require.Equal(t, receipt.TxHash, receiptLoaded.TxHash)
+		require.Equal(t, receipt.Status, receiptLoaded.Status)
+		require.Equal(t, receipt.BlockNumber, receiptLoaded.BlockNumber)
+		require.Equal(t, outbound.Hash(), outboundLoaded.Hash())
+	})
+
+	t.Run("should skip outbounds that are not confirmed", func(t *testing.T) {
+		// create observer and record a broadcast outbound only
+		ob, _ := MockEVMObserver(t, chain, nil, nil, nil, nil, 1, params)
+		require.NoError(t, ob.SaveOutboundBroadcast(nonce, outbound.Hash().Hex(), []byte{0x01}))
+
+		err := ob.LoadConfirmedOutbounds()
+		require.NoError(t, err)
+		require.False(t, ob.IsTxConfirmed(nonce))
+	})
+}
+
 func Test_BlockCache(t *testing.T) {
 	t.Run("should get block from cache", func(t *testing.T) {
 		// create observer
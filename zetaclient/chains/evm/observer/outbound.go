@@ -23,6 +23,7 @@ import (
 	crosschainkeeper "github.com/zeta-chain/node/x/crosschain/keeper"
 	crosschaintypes "github.com/zeta-chain/node/x/crosschain/types"
 	"github.com/zeta-chain/node/zetaclient/chains/evm"
+	"github.com/zeta-chain/node/zetaclient/chains/evm/rpc"
 	"github.com/zeta-chain/node/zetaclient/chains/interfaces"
 	"github.com/zeta-chain/node/zetaclient/compliance"
 	zctx "github.com/zeta-chain/node/zetaclient/context"
@@ -57,7 +58,7 @@ func (ob *Observer) WatchOutbound(ctx context.Context) error {
 	for {
 		select {
 		case <-ticker.C():
-			if !app.IsOutboundObservationEnabled() {
+			if !app.IsOutboundObservationEnabled(ob.Chain().ChainId) {
 				sampledLogger.Info().
 					Msgf("WatchOutbound: outbound observation is disabled for chain %d", ob.Chain().ChainId)
 				continue
@@ -72,6 +73,11 @@ func (ob *Observer) WatchOutbound(ctx context.Context) error {
 					Msgf("WatchOutbound: error ProcessOutboundTrackers for chain %d", chainID)
 			}
 
+			// report the broadcast outbounds missing in the tracker (e.g. after restart)
+			if err := ob.ReportOutboundTrackersFromDB(ctx, ob.isOutboundIncluded, evm.OutboundInclusionTimeout); err != nil {
+				ob.Logger().Outbound.Error().Err(err).Msg("WatchOutbound: error reporting outbound trackers from db")
+			}
+
 			ticker.UpdateInterval(ob.ChainParams().OutboundTicker, ob.Logger().Outbound)
 		case <-ob.StopChannel():
 			ob.Logger().Outbound.Info().Msg("WatchOutbound: stopped")
@@ -80,6 +86,11 @@ func (ob *Observer) WatchOutbound(ctx context.Context) error {
 	}
 }
 
+// isOutboundIncluded returns true if the outbound tx is included and protected against reorg
+func (ob *Observer) isOutboundIncluded(ctx context.Context, txHash string) (bool, error) {
+	return rpc.IsTxConfirmed(ctx, ob.evmClient, txHash, evm.ReorgProtectBlockCount)
+}
+
 // ProcessOutboundTrackers processes outbound trackers
 func (ob *Observer) ProcessOutboundTrackers(ctx context.Context) error {
 	chainID := ob.Chain().ChainId
@@ -194,6 +205,10 @@ func (ob *Observer) PostVoteOutbound(
 		logFields["ballot"] = ballot
 		logger.Info().Fields(logFields).Msgf("PostVoteOutbound: posted vote for chain %d", chainID)
 	}
+
+	if err := ob.SetOutboundVotePosted(nonce, transaction.Hash().Hex()); err != nil {
+		logger.Error().Err(err).Fields(logFields).Msg("PostVoteOutbound: unable to save vote status to db")
+	}
 }
 
 // VoteOutboundIfConfirmed checks outbound status and returns (continueKeysign, error)
@@ -210,6 +225,19 @@ func (ob *Observer) VoteOutboundIfConfirmed(
 	sendID := fmt.Sprintf("%d-%d", ob.Chain().ChainId, nonce)
 	logger := ob.Logger().Outbound.With().Str("sendID", sendID).Logger()
 
+	// large amounts may require more confirmations than the default one used to confirm the tx
+	confirmations := ob.ConfirmationCount(
+		cctx.InboundParams.CoinType,
+		cctx.InboundParams.Asset,
+		cctx.GetCurrentOutboundParam().Amount,
+	)
+	if confirmations > ob.ChainParams().ConfirmationCount &&
+		!ob.HasEnoughConfirmations(receipt, ob.LastBlock(), confirmations) {
+		logger.Debug().
+			Msgf("VoteOutboundIfConfirmed: tx %s requires %d confirmations", receipt.TxHash, confirmations)
+		return true, nil
+	}
+
 	// get connector and erce20Custody contracts
 	connectorAddr, connector, err := ob.GetConnectorContract()
 	if err != nil {
@@ -548,7 +576,7 @@ func (ob *Observer) checkConfirmedTx(
 		logger.Error().Err(err).Msg("BlockNumber error")
 		return nil, nil, false
 	}
-	if !ob.HasEnoughConfirmations(receipt, lastHeight) {
+	if !ob.HasEnoughConfirmations(receipt, lastHeight, ob.ChainParams().ConfirmationCount) {
 		logger.Debug().
 			Msgf("tx included but not confirmed, receipt block %d current block %d", receipt.BlockNumber.Uint64(), lastHeight)
 		return nil, nil, false
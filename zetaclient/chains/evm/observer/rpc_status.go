@@ -28,9 +28,24 @@ func (ob *Observer) watchRPCStatus(ctx context.Context) error {
 	}
 }
 
+// healthChecker is implemented by EVM clients that check the health of multiple RPC endpoints
+type healthChecker interface {
+	CheckHealth(ctx context.Context, maxLatency time.Duration) (time.Time, error)
+}
+
 // checkRPCStatus checks the RPC status of the EVM chain
 func (ob *Observer) checkRPCStatus(ctx context.Context) {
-	blockTime, err := rpc.CheckRPCStatus(ctx, ob.evmClient)
+	var (
+		blockTime time.Time
+		err       error
+	)
+
+	// fail over to a healthy endpoint if the client has multiple endpoints
+	if checker, ok := ob.evmClient.(healthChecker); ok {
+		blockTime, err = checker.CheckHealth(ctx, rpc.RPCAlertLatency)
+	} else {
+		blockTime, err = rpc.CheckRPCStatus(ctx, ob.evmClient)
+	}
 	if err != nil {
 		ob.Logger().Chain.Error().Err(err).Msg("CheckRPCStatus failed")
 		return
@@ -105,6 +105,11 @@ func (ob *Observer) ObserveGatewayDeposit(ctx context.Context, startBlock, toBlo
 
 		msg := ob.newDepositInboundVote(event)
 
+		// large amounts may require more confirmations, re-scan from this block next time
+		if !ob.hasEnoughInboundConfirmations(&msg) {
+			return lastScanned - 1, nil
+		}
+
 		ob.Logger().Inbound.Info().
 			Msgf("ObserveGateway: Deposit inbound detected on chain %d tx %s block %d from %s value %s message %s",
 				ob.Chain().
@@ -384,6 +389,11 @@ func (ob *Observer) ObserveGatewayDepositAndCall(ctx context.Context, startBlock
 
 		msg := ob.newDepositAndCallInboundVote(event)
 
+		// large amounts may require more confirmations, re-scan from this block next time
+		if !ob.hasEnoughInboundConfirmations(&msg) {
+			return lastScanned - 1, nil
+		}
+
 		ob.Logger().Inbound.Info().
 			Msgf("ObserveGateway: DepositAndCall inbound detected on chain %d tx %s block %d from %s value %s message %s",
 				ob.Chain().
@@ -0,0 +1,430 @@
+package rpc
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	gethrpc "github.com/ethereum/go-ethereum/rpc"
+	"github.com/onrik/ethrpc"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+
+	"github.com/zeta-chain/node/zetaclient/chains/interfaces"
+	"github.com/zeta-chain/node/zetaclient/metrics"
+)
+
+// Endpoint is an EVM RPC endpoint with its clients
+type Endpoint struct {
+	Client        interfaces.EVMRPCClient
+	JSONRPCClient interfaces.EVMJSONRPCClient
+}
+
+// FailoverClient is an EVM RPC client that forwards the requests to the active endpoint.
+// When the active endpoint is unavailable, the requests are sent to the other endpoints in order of priority
+// and the first available endpoint becomes the active one.
+//
+// FailoverClient implements both interfaces.EVMRPCClient and interfaces.EVMJSONRPCClient.
+type FailoverClient struct {
+	endpoints []Endpoint
+
+	// quorum is the number of endpoints that must agree on a tx in VerifyTx
+	quorum int
+
+	logger zerolog.Logger
+
+	mu     sync.RWMutex
+	active int
+}
+
+var (
+	_ interfaces.EVMRPCClient     = (*FailoverClient)(nil)
+	_ interfaces.EVMJSONRPCClient = (*FailoverClient)(nil)
+)
+
+// errNoJSONRPCClient is returned when an endpoint has no JSON RPC client
+var errNoJSONRPCClient = errors.New("no JSON RPC client for endpoint")
+
+// DialEndpoints dials the given EVM RPC endpoint URLs using instrumented HTTP clients
+func DialEndpoints(urls []string) ([]Endpoint, error) {
+	endpoints := make([]Endpoint, 0, len(urls))
+	for i, url := range urls {
+		httpClient, err := metrics.GetInstrumentedHTTPClient(url)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to create HTTP client for endpoint %d", i)
+		}
+
+		rpcClient, err := gethrpc.DialHTTPWithClient(url, httpClient)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to dial EVM RPC endpoint %d", i)
+		}
+
+		endpoints = append(endpoints, Endpoint{
+			Client:        ethclient.NewClient(rpcClient),
+			JSONRPCClient: ethrpc.NewEthRPC(url, ethrpc.WithHttpClient(httpClient)),
+		})
+	}
+
+	return endpoints, nil
+}
+
+// NewFailoverClient creates a new failover client from endpoints given in order of priority.
+// A quorum greater than 1 enables the cross-check of txs in VerifyTx.
+func NewFailoverClient(endpoints []Endpoint, quorum int, logger zerolog.Logger) (*FailoverClient, error) {
+	if len(endpoints) == 0 {
+		return nil, errors.New("no endpoint provided")
+	}
+	if quorum > len(endpoints) {
+		return nil, fmt.Errorf("quorum %d is greater than the number of endpoints %d", quorum, len(endpoints))
+	}
+
+	return &FailoverClient{
+		endpoints: endpoints,
+		quorum:    quorum,
+		logger:    logger.With().Str("module", "evm_rpc_failover").Logger(),
+	}, nil
+}
+
+// Active returns the index of the active endpoint
+func (c *FailoverClient) Active() int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.active
+}
+
+// setActive switches the active endpoint
+func (c *FailoverClient) setActive(index int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.active != index {
+		c.logger.Warn().Int("rpc.from", c.active).Int("rpc.to", index).Msg("switching active RPC endpoint")
+		c.active = index
+	}
+}
+
+// CheckHealth checks the RPC status of the endpoints in order of priority and switches to the first healthy one.
+// An endpoint is unhealthy if its latest block is older than maxLatency (if non-zero).
+// It returns the latest block time of the active endpoint.
+func (c *FailoverClient) CheckHealth(ctx context.Context, maxLatency time.Duration) (time.Time, error) {
+	var firstErr error
+	for i, endpoint := range c.endpoints {
+		blockTime, err := CheckRPCStatus(ctx, endpoint.Client)
+		if err == nil && maxLatency > 0 && time.Since(blockTime) > maxLatency {
+			err = fmt.Errorf("latest block time %s is older than %s", blockTime, maxLatency)
+		}
+		if err != nil {
+			c.logger.Warn().Err(err).Int("rpc.endpoint", i).Msg("RPC endpoint is unhealthy")
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+
+		c.setActive(i)
+		return blockTime, nil
+	}
+
+	return time.Time{}, errors.Wrap(firstErr, "all RPC endpoints are unhealthy")
+}
+
+// VerifyTx cross-checks the receipt of the given tx and the hash of the block including it across the endpoints.
+// It returns an error unless at least 'quorum' endpoints, including the active one, return the same data.
+func (c *FailoverClient) VerifyTx(ctx context.Context, txHash string) error {
+	if c.quorum <= 1 {
+		return nil
+	}
+
+	hash := ethcommon.HexToHash(txHash)
+	active := c.Active()
+	expected, err := txFingerprint(ctx, c.endpoints[active], hash)
+	if err != nil {
+		return errors.Wrapf(err, "unable to get tx %s from active endpoint", txHash)
+	}
+
+	agreed := 1
+	for i, endpoint := range c.endpoints {
+		if i == active {
+			continue
+		}
+		if agreed >= c.quorum {
+			break
+		}
+
+		fingerprint, err := txFingerprint(ctx, endpoint, hash)
+		switch {
+		case err != nil:
+			c.logger.Warn().Err(err).Int("rpc.endpoint", i).Str("tx", txHash).Msg("unable to get tx from endpoint")
+		case fingerprint != expected:
+			c.logger.Error().
+				Int("rpc.endpoint", i).
+				Int("rpc.active", active).
+				Str("tx", txHash).
+				Msg("RPC endpoints disagree on tx")
+		default:
+			agreed++
+		}
+	}
+
+	if agreed < c.quorum {
+		return fmt.Errorf("only %d endpoints agree on tx %s, quorum is %d", agreed, txHash, c.quorum)
+	}
+
+	return nil
+}
+
+// txFingerprint returns a hash of the consensus fields of the tx receipt and of the block hash at the receipt height
+func txFingerprint(ctx context.Context, endpoint Endpoint, txHash ethcommon.Hash) (ethcommon.Hash, error) {
+	if endpoint.JSONRPCClient == nil {
+		return ethcommon.Hash{}, errNoJSONRPCClient
+	}
+
+	receipt, err := endpoint.Client.TransactionReceipt(ctx, txHash)
+	switch {
+	case err != nil:
+		return ethcommon.Hash{}, errors.Wrap(err, "unable to get receipt")
+	case receipt == nil || receipt.BlockNumber == nil:
+		return ethcommon.Hash{}, errors.New("receipt is nil")
+	}
+
+	receiptBytes, err := receipt.MarshalBinary()
+	if err != nil {
+		return ethcommon.Hash{}, errors.Wrap(err, "unable to encode receipt")
+	}
+
+	// the receipt must belong to the block reported by the same endpoint at that height
+	// #nosec G115 always in range
+	block, err := endpoint.JSONRPCClient.EthGetBlockByNumber(int(receipt.BlockNumber.Int64()), false)
+	switch {
+	case err != nil:
+		return ethcommon.Hash{}, errors.Wrapf(err, "unable to get block %d", receipt.BlockNumber)
+	case block == nil:
+		return ethcommon.Hash{}, fmt.Errorf("block %d not found", receipt.BlockNumber)
+	case ethcommon.HexToHash(block.Hash) != receipt.BlockHash:
+		return ethcommon.Hash{}, fmt.Errorf(
+			"receipt block hash %s does not match block %d",
+			receipt.BlockHash,
+			receipt.BlockNumber,
+		)
+	}
+
+	index := new(big.Int).SetUint64(uint64(receipt.TransactionIndex)).Bytes()
+
+	return crypto.Keccak256Hash(receiptBytes, receipt.BlockHash.Bytes(), receipt.TxHash.Bytes(), index), nil
+}
+
+// isEndpointError returns true if the error is caused by an unavailable endpoint,
+// false if the error is a response from an available endpoint (e.g. not found or reverted call)
+func isEndpointError(err error) bool {
+	var (
+		rpcErr gethrpc.Error
+		ethErr ethrpc.EthError
+	)
+
+	switch {
+	case errors.Is(err, ethereum.NotFound),
+		errors.Is(err, context.Canceled),
+		errors.As(err, &rpcErr),
+		errors.As(err, &ethErr):
+		return false
+	default:
+		return true
+	}
+}
+
+// call runs fn against the active endpoint.
+// If the active endpoint is unavailable, it runs fn against the other endpoints in order of priority
+// and switches to the first available one.
+func call[T any](c *FailoverClient, fn func(Endpoint) (T, error)) (T, error) {
+	active := c.Active()
+	result, err := fn(c.endpoints[active])
+	if err == nil || !isEndpointError(err) {
+		return result, err
+	}
+
+	for i, endpoint := range c.endpoints {
+		if i == active {
+			continue
+		}
+
+		otherResult, otherErr := fn(endpoint)
+		if otherErr == nil || !isEndpointError(otherErr) {
+			c.logger.Warn().Err(err).Int("rpc.endpoint", active).Msg("RPC endpoint is unavailable")
+			c.setActive(i)
+			return otherResult, otherErr
+		}
+	}
+
+	return result, err
+}
+
+// ChainID retrieves the chain ID
+func (c *FailoverClient) ChainID(ctx context.Context) (*big.Int, error) {
+	return call(c, func(e Endpoint) (*big.Int, error) {
+		return e.Client.ChainID(ctx)
+	})
+}
+
+// BlockNumber returns the most recent block number
+func (c *FailoverClient) BlockNumber(ctx context.Context) (uint64, error) {
+	return call(c, func(e Endpoint) (uint64, error) {
+		return e.Client.BlockNumber(ctx)
+	})
+}
+
+// BlockByNumber returns the block with the given number
+func (c *FailoverClient) BlockByNumber(ctx context.Context, number *big.Int) (*ethtypes.Block, error) {
+	return call(c, func(e Endpoint) (*ethtypes.Block, error) {
+		return e.Client.BlockByNumber(ctx, number)
+	})
+}
+
+// HeaderByNumber returns the block header with the given number
+func (c *FailoverClient) HeaderByNumber(ctx context.Context, number *big.Int) (*ethtypes.Header, error) {
+	return call(c, func(e Endpoint) (*ethtypes.Header, error) {
+		return e.Client.HeaderByNumber(ctx, number)
+	})
+}
+
+// TransactionByHash returns the transaction with the given hash
+func (c *FailoverClient) TransactionByHash(
+	ctx context.Context,
+	hash ethcommon.Hash,
+) (*ethtypes.Transaction, bool, error) {
+	type result struct {
+		tx        *ethtypes.Transaction
+		isPending bool
+	}
+
+	res, err := call(c, func(e Endpoint) (result, error) {
+		tx, isPending, err := e.Client.TransactionByHash(ctx, hash)
+		return result{tx: tx, isPending: isPending}, err
+	})
+
+	return res.tx, res.isPending, err
+}
+
+// TransactionReceipt returns the receipt of the transaction with the given hash
+func (c *FailoverClient) TransactionReceipt(ctx context.Context, txHash ethcommon.Hash) (*ethtypes.Receipt, error) {
+	return call(c, func(e Endpoint) (*ethtypes.Receipt, error) {
+		return e.Client.TransactionReceipt(ctx, txHash)
+	})
+}
+
+// TransactionSender returns the sender address of the given transaction
+func (c *FailoverClient) TransactionSender(
+	ctx context.Context,
+	tx *ethtypes.Transaction,
+	block ethcommon.Hash,
+	index uint,
+) (ethcommon.Address, error) {
+	return call(c, func(e Endpoint) (ethcommon.Address, error) {
+		return e.Client.TransactionSender(ctx, tx, block, index)
+	})
+}
+
+// SendTransaction injects a signed transaction into the pending pool for execution
+func (c *FailoverClient) SendTransaction(ctx context.Context, tx *ethtypes.Transaction) error {
+	_, err := call(c, func(e Endpoint) (struct{}, error) {
+		return struct{}{}, e.Client.SendTransaction(ctx, tx)
+	})
+	return err
+}
+
+// SuggestGasPrice retrieves the currently suggested gas price
+func (c *FailoverClient) SuggestGasPrice(ctx context.Context) (*big.Int, error) {
+	return call(c, func(e Endpoint) (*big.Int, error) {
+		return e.Client.SuggestGasPrice(ctx)
+	})
+}
+
+// SuggestGasTipCap retrieves the currently suggested gas tip cap
+func (c *FailoverClient) SuggestGasTipCap(ctx context.Context) (*big.Int, error) {
+	return call(c, func(e Endpoint) (*big.Int, error) {
+		return e.Client.SuggestGasTipCap(ctx)
+	})
+}
+
+// CodeAt returns the contract code of the given account
+func (c *FailoverClient) CodeAt(ctx context.Context, contract ethcommon.Address, blockNumber *big.Int) ([]byte, error) {
+	return call(c, func(e Endpoint) ([]byte, error) {
+		return e.Client.CodeAt(ctx, contract, blockNumber)
+	})
+}
+
+// CallContract executes a message call transaction
+func (c *FailoverClient) CallContract(
+	ctx context.Context,
+	msg ethereum.CallMsg,
+	blockNumber *big.Int,
+) ([]byte, error) {
+	return call(c, func(e Endpoint) ([]byte, error) {
+		return e.Client.CallContract(ctx, msg, blockNumber)
+	})
+}
+
+// PendingCodeAt returns the contract code of the given account in the pending state
+func (c *FailoverClient) PendingCodeAt(ctx context.Context, account ethcommon.Address) ([]byte, error) {
+	return call(c, func(e Endpoint) ([]byte, error) {
+		return e.Client.PendingCodeAt(ctx, account)
+	})
+}
+
+// PendingNonceAt returns the account nonce of the given account in the pending state
+func (c *FailoverClient) PendingNonceAt(ctx context.Context, account ethcommon.Address) (uint64, error) {
+	return call(c, func(e Endpoint) (uint64, error) {
+		return e.Client.PendingNonceAt(ctx, account)
+	})
+}
+
+// EstimateGas tries to estimate the gas needed to execute a specific transaction
+func (c *FailoverClient) EstimateGas(ctx context.Context, msg ethereum.CallMsg) (uint64, error) {
+	return call(c, func(e Endpoint) (uint64, error) {
+		return e.Client.EstimateGas(ctx, msg)
+	})
+}
+
+// FilterLogs executes a log filter operation
+func (c *FailoverClient) FilterLogs(ctx context.Context, query ethereum.FilterQuery) ([]ethtypes.Log, error) {
+	return call(c, func(e Endpoint) ([]ethtypes.Log, error) {
+		return e.Client.FilterLogs(ctx, query)
+	})
+}
+
+// SubscribeFilterLogs creates a background log filtering operation
+func (c *FailoverClient) SubscribeFilterLogs(
+	ctx context.Context,
+	query ethereum.FilterQuery,
+	ch chan<- ethtypes.Log,
+) (ethereum.Subscription, error) {
+	return call(c, func(e Endpoint) (ethereum.Subscription, error) {
+		return e.Client.SubscribeFilterLogs(ctx, query, ch)
+	})
+}
+
+// EthGetBlockByNumber returns the block with the given number using the JSON RPC client
+func (c *FailoverClient) EthGetBlockByNumber(number int, withTransactions bool) (*ethrpc.Block, error) {
+	return call(c, func(e Endpoint) (*ethrpc.Block, error) {
+		if e.JSONRPCClient == nil {
+			return nil, errNoJSONRPCClient
+		}
+		return e.JSONRPCClient.EthGetBlockByNumber(number, withTransactions)
+	})
+}
+
+// EthGetTransactionByHash returns the transaction with the given hash using the JSON RPC client
+func (c *FailoverClient) EthGetTransactionByHash(hash string) (*ethrpc.Transaction, error) {
+	return call(c, func(e Endpoint) (*ethrpc.Transaction, error) {
+		if e.JSONRPCClient == nil {
+			return nil, errNoJSONRPCClient
+		}
+		return e.JSONRPCClient.EthGetTransactionByHash(hash)
+	})
+}
@@ -0,0 +1,222 @@
+package rpc_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	onrikrpc "github.com/onrik/ethrpc"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/node/testutil/sample"
+	"github.com/zeta-chain/node/zetaclient/chains/evm/rpc"
+	"github.com/zeta-chain/node/zetaclient/testutils/mocks"
+)
+
+func newFailoverClient(t *testing.T, quorum int, clients ...*mocks.EVMRPCClient) *rpc.FailoverClient {
+	endpoints := make([]rpc.Endpoint, 0, len(clients))
+	for _, client := range clients {
+		endpoints = append(endpoints, rpc.Endpoint{Client: client, JSONRPCClient: mocks.NewMockJSONRPCClient()})
+	}
+
+	client, err := rpc.NewFailoverClient(endpoints, quorum, zerolog.Nop())
+	require.NoError(t, err)
+
+	return client
+}
+
+func Test_NewFailoverClient(t *testing.T) {
+	t.Run("should fail with no endpoint", func(t *testing.T) {
+		_, err := rpc.NewFailoverClient(nil, 0, zerolog.Nop())
+		require.ErrorContains(t, err, "no endpoint")
+	})
+
+	t.Run("should fail if quorum is greater than the number of endpoints", func(t *testing.T) {
+		endpoints := []rpc.Endpoint{{Client: mocks.NewEVMRPCClient(t)}}
+		_, err := rpc.NewFailoverClient(endpoints, 2, zerolog.Nop())
+		require.ErrorContains(t, err, "quorum 2 is greater than the number of endpoints 1")
+	})
+}
+
+func Test_FailoverClientCall(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("should use the active endpoint", func(t *testing.T) {
+		primary := mocks.NewEVMRPCClient(t)
+		fallback := mocks.NewEVMRPCClient(t)
+		primary.On("BlockNumber", mock.Anything).Return(uint64(100), nil)
+
+		client := newFailoverClient(t, 0, primary, fallback)
+		bn, err := client.BlockNumber(ctx)
+		require.NoError(t, err)
+		require.Equal(t, uint64(100), bn)
+		require.Equal(t, 0, client.Active())
+	})
+
+	t.Run("should fail over to the next endpoint if the active endpoint is unavailable", func(t *testing.T) {
+		primary := mocks.NewEVMRPCClient(t)
+		fallback := mocks.NewEVMRPCClient(t)
+		primary.On("BlockNumber", mock.Anything).Return(uint64(0), errors.New("connection refused")).Once()
+		fallback.On("BlockNumber", mock.Anything).Return(uint64(101), nil)
+
+		client := newFailoverClient(t, 0, primary, fallback)
+		bn, err := client.BlockNumber(ctx)
+		require.NoError(t, err)
+		require.Equal(t, uint64(101), bn)
+		require.Equal(t, 1, client.Active())
+
+		// the fallback endpoint is now used directly
+		bn, err = client.BlockNumber(ctx)
+		require.NoError(t, err)
+		require.Equal(t, uint64(101), bn)
+	})
+
+	t.Run("should not fail over on a response from an available endpoint", func(t *testing.T) {
+		primary := mocks.NewEVMRPCClient(t)
+		fallback := mocks.NewEVMRPCClient(t)
+		primary.On("TransactionReceipt", mock.Anything, mock.Anything).Return(nil, ethereum.NotFound)
+
+		client := newFailoverClient(t, 0, primary, fallback)
+		_, err := client.TransactionReceipt(ctx, sample.EthAddress().Hash())
+		require.ErrorIs(t, err, ethereum.NotFound)
+		require.Equal(t, 0, client.Active())
+	})
+
+	t.Run("should return the error of the active endpoint if all endpoints are unavailable", func(t *testing.T) {
+		primary := mocks.NewEVMRPCClient(t)
+		fallback := mocks.NewEVMRPCClient(t)
+		primary.On("SuggestGasPrice", mock.Anything).Return(nil, errors.New("primary down"))
+		fallback.On("SuggestGasPrice", mock.Anything).Return(nil, errors.New("fallback down"))
+
+		client := newFailoverClient(t, 0, primary, fallback)
+		_, err := client.SuggestGasPrice(ctx)
+		require.ErrorContains(t, err, "primary down")
+		require.Equal(t, 0, client.Active())
+	})
+}
+
+func Test_FailoverClientCheckHealth(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+
+	mockHealthy := func(client *mocks.EVMRPCClient, blockTime time.Time) {
+		client.On("BlockNumber", mock.Anything).Return(uint64(100), nil)
+		client.On("SuggestGasPrice", mock.Anything).Return(big.NewInt(1), nil)
+		client.On("HeaderByNumber", mock.Anything, mock.Anything).
+			Return(&ethtypes.Header{Time: uint64(blockTime.Unix())}, nil)
+	}
+
+	t.Run("should switch back to the primary endpoint once healthy", func(t *testing.T) {
+		primary := mocks.NewEVMRPCClient(t)
+		fallback := mocks.NewEVMRPCClient(t)
+		primary.On("BlockNumber", mock.Anything).Return(uint64(0), errors.New("primary down")).Once()
+		mockHealthy(fallback, now)
+
+		client := newFailoverClient(t, 0, primary, fallback)
+		_, err := client.CheckHealth(ctx, time.Minute)
+		require.NoError(t, err)
+		require.Equal(t, 1, client.Active())
+
+		mockHealthy(primary, now)
+		_, err = client.CheckHealth(ctx, time.Minute)
+		require.NoError(t, err)
+		require.Equal(t, 0, client.Active())
+	})
+
+	t.Run("should consider a lagging endpoint unhealthy", func(t *testing.T) {
+		primary := mocks.NewEVMRPCClient(t)
+		fallback := mocks.NewEVMRPCClient(t)
+		mockHealthy(primary, now.Add(-time.Hour))
+		mockHealthy(fallback, now)
+
+		client := newFailoverClient(t, 0, primary, fallback)
+		blockTime, err := client.CheckHealth(ctx, time.Minute)
+		require.NoError(t, err)
+		require.Equal(t, now.Unix(), blockTime.Unix())
+		require.Equal(t, 1, client.Active())
+	})
+
+	t.Run("should fail if all endpoints are unhealthy", func(t *testing.T) {
+		primary := mocks.NewEVMRPCClient(t)
+		primary.On("BlockNumber", mock.Anything).Return(uint64(0), errors.New("primary down"))
+
+		client := newFailoverClient(t, 0, primary)
+		_, err := client.CheckHealth(ctx, time.Minute)
+		require.ErrorContains(t, err, "all RPC endpoints are unhealthy")
+	})
+}
+
+func Test_FailoverClientVerifyTx(t *testing.T) {
+	ctx := context.Background()
+	txHash := sample.EthAddress().Hash()
+	blockHash := sample.EthAddress().Hash()
+
+	newReceipt := func(status uint64) *ethtypes.Receipt {
+		return &ethtypes.Receipt{
+			Status:      status,
+			TxHash:      txHash,
+			BlockHash:   blockHash,
+			BlockNumber: big.NewInt(100),
+		}
+	}
+
+	// newEndpoint creates an endpoint returning the given receipt and the canonical block
+	newEndpoint := func(receipt *ethtypes.Receipt) rpc.Endpoint {
+		client := mocks.NewEVMRPCClient(t)
+		client.On("TransactionReceipt", mock.Anything, txHash).Return(receipt, nil).Maybe()
+
+		jsonClient := mocks.NewMockJSONRPCClient()
+		jsonClient.WithBlock(&onrikrpc.Block{Number: 100, Hash: blockHash.Hex()})
+
+		return rpc.Endpoint{Client: client, JSONRPCClient: jsonClient}
+	}
+
+	t.Run("should skip verification if quorum is disabled", func(t *testing.T) {
+		client, err := rpc.NewFailoverClient([]rpc.Endpoint{{Client: mocks.NewEVMRPCClient(t)}}, 1, zerolog.Nop())
+		require.NoError(t, err)
+		require.NoError(t, client.VerifyTx(ctx, txHash.Hex()))
+	})
+
+	t.Run("should pass if quorum endpoints agree", func(t *testing.T) {
+		endpoints := []rpc.Endpoint{
+			newEndpoint(newReceipt(ethtypes.ReceiptStatusSuccessful)),
+			newEndpoint(newReceipt(ethtypes.ReceiptStatusFailed)),
+			newEndpoint(newReceipt(ethtypes.ReceiptStatusSuccessful)),
+		}
+
+		client, err := rpc.NewFailoverClient(endpoints, 2, zerolog.Nop())
+		require.NoError(t, err)
+		require.NoError(t, client.VerifyTx(ctx, txHash.Hex()))
+	})
+
+	t.Run("should fail if endpoints disagree", func(t *testing.T) {
+		endpoints := []rpc.Endpoint{
+			newEndpoint(newReceipt(ethtypes.ReceiptStatusSuccessful)),
+			newEndpoint(newReceipt(ethtypes.ReceiptStatusFailed)),
+		}
+
+		client, err := rpc.NewFailoverClient(endpoints, 2, zerolog.Nop())
+		require.NoError(t, err)
+		require.ErrorContains(t, client.VerifyTx(ctx, txHash.Hex()), "only 1 endpoints agree")
+	})
+
+	t.Run("should fail if the receipt is not in the canonical block", func(t *testing.T) {
+		receipt := newReceipt(ethtypes.ReceiptStatusSuccessful)
+		receipt.BlockHash = ethcommon.Hash{}
+		endpoints := []rpc.Endpoint{
+			newEndpoint(receipt),
+			newEndpoint(newReceipt(ethtypes.ReceiptStatusSuccessful)),
+		}
+
+		client, err := rpc.NewFailoverClient(endpoints, 2, zerolog.Nop())
+		require.NoError(t, err)
+		require.ErrorContains(t, client.VerifyTx(ctx, txHash.Hex()), "does not match block")
+	})
+}
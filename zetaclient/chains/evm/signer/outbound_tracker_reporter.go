@@ -18,6 +18,7 @@ import (
 func (signer *Signer) reportToOutboundTracker(
 	ctx context.Context,
 	zetacoreClient interfaces.ZetacoreClient,
+	recorder interfaces.OutboundRecorder,
 	chainID int64,
 	nonce uint64,
 	outboundHash string,
@@ -78,6 +79,9 @@ func (signer *Signer) reportToOutboundTracker(
 			} else {
 				// exit goroutine until the tracker contains the hash (reported by either this or other signers)
 				logger.Info().Msg("outbound now exists in tracker")
+				if err := recorder.SetOutboundTrackerReported(nonce, outboundHash); err != nil {
+					logger.Err(err).Msg("unable to mark outbound as reported in db")
+				}
 				return nil
 			}
 		}
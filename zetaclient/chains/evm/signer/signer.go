@@ -14,8 +14,6 @@ import (
 	ethcommon "github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
-	"github.com/ethereum/go-ethereum/ethclient"
-	ethrpc "github.com/ethereum/go-ethereum/rpc"
 	"github.com/pkg/errors"
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
@@ -24,6 +22,7 @@ import (
 	"github.com/zeta-chain/node/pkg/coin"
 	crosschaintypes "github.com/zeta-chain/node/x/crosschain/types"
 	"github.com/zeta-chain/node/zetaclient/chains/base"
+	"github.com/zeta-chain/node/zetaclient/chains/evm/rpc"
 	"github.com/zeta-chain/node/zetaclient/chains/interfaces"
 	"github.com/zeta-chain/node/zetaclient/compliance"
 	zctx "github.com/zeta-chain/node/zetaclient/context"
@@ -77,7 +76,7 @@ func NewSigner(
 	tss interfaces.TSSSigner,
 	ts *metrics.TelemetryServer,
 	logger base.Logger,
-	endpoint string,
+	endpoints []string,
 	zetaConnectorAddress ethcommon.Address,
 	erc20CustodyAddress ethcommon.Address,
 	gatewayAddress ethcommon.Address,
@@ -86,7 +85,7 @@ func NewSigner(
 	baseSigner := base.NewSigner(chain, tss, ts, logger)
 
 	// create EVM client
-	client, ethSigner, err := getEVMRPC(ctx, endpoint)
+	client, ethSigner, err := getEVMRPC(ctx, endpoints, logger.Std)
 	if err != nil {
 		return nil, errors.Wrap(err, "unable to create EVM client")
 	}
@@ -243,7 +242,7 @@ func (signer *Signer) TryProcessOutbound(
 	cctx *crosschaintypes.CrossChainTx,
 	outboundProc *outboundprocessor.Processor,
 	outboundID string,
-	_ interfaces.ChainObserver,
+	chainObserver interfaces.ChainObserver,
 	zetacoreClient interfaces.ZetacoreClient,
 	height uint64,
 ) {
@@ -321,7 +320,8 @@ func (signer *Signer) TryProcessOutbound(
 	)
 
 	// Broadcast Signed Tx
-	signer.BroadcastOutbound(ctx, tx, cctx, logger, myID, zetacoreClient, txData)
+	recorder := base.OutboundRecorder(chainObserver)
+	signer.BroadcastOutbound(ctx, tx, cctx, logger, myID, zetacoreClient, recorder, txData)
 }
 
 // SignOutboundFromCCTX signs an outbound transaction from a given cctx
@@ -462,6 +462,7 @@ func (signer *Signer) BroadcastOutbound(
 	logger zerolog.Logger,
 	myID sdk.AccAddress,
 	zetacoreClient interfaces.ZetacoreClient,
+	recorder interfaces.OutboundRecorder,
 	txData *OutboundData,
 ) {
 	app, err := zctx.FromContext(ctx)
@@ -487,6 +488,11 @@ func (signer *Signer) BroadcastOutbound(
 	// broadcast transaction
 	outboundHash := tx.Hash().Hex()
 
+	// record the signed tx before broadcasting, so the tracker can be reported after a restart
+	if err := saveOutboundBroadcast(recorder, tx); err != nil {
+		logger.Error().Err(err).Msgf("BroadcastOutbound: unable to save outbound %s to db", outboundHash)
+	}
+
 	// try broacasting tx with increasing backoff (1s, 2s, 4s, 8s, 16s) in case of RPC error
 	backOff := broadcastBackoff
 	for i := 0; i < broadcastRetries; i++ {
@@ -504,7 +510,15 @@ func (signer *Signer) BroadcastOutbound(
 				outboundHash,
 			)
 			if report {
-				signer.reportToOutboundTracker(ctx, zetacoreClient, toChain.ID(), tx.Nonce(), outboundHash, logger)
+				signer.reportToOutboundTracker(
+					ctx,
+					zetacoreClient,
+					recorder,
+					toChain.ID(),
+					tx.Nonce(),
+					outboundHash,
+					logger,
+				)
 			}
 			if !retry {
 				break
@@ -514,11 +528,21 @@ func (signer *Signer) BroadcastOutbound(
 		}
 		logger.Info().Msgf("BroadcastOutbound: broadcasted tx %s on chain %d nonce %d signer %s",
 			outboundHash, toChain.ID(), cctx.GetCurrentOutboundParam().TssNonce, myID)
-		signer.reportToOutboundTracker(ctx, zetacoreClient, toChain.ID(), tx.Nonce(), outboundHash, logger)
+		signer.reportToOutboundTracker(ctx, zetacoreClient, recorder, toChain.ID(), tx.Nonce(), outboundHash, logger)
 		break // successful broadcast; no need to retry
 	}
 }
 
+// saveOutboundBroadcast records the signed outbound tx with the given recorder
+func saveOutboundBroadcast(recorder interfaces.OutboundRecorder, tx *ethtypes.Transaction) error {
+	txBytes, err := tx.MarshalBinary()
+	if err != nil {
+		return errors.Wrap(err, "unable to marshal tx")
+	}
+
+	return recorder.SaveOutboundBroadcast(tx.Nonce(), tx.Hash().Hex(), txBytes)
+}
+
 // EvmClient returns the EVM RPC client
 func (signer *Signer) EvmClient() interfaces.EVMRPCClient {
 	return signer.client
@@ -550,23 +574,28 @@ func ErrorMsg(cctx *crosschaintypes.CrossChainTx) string {
 }
 
 // getEVMRPC is a helper function to set up the client and signer, also initializes a mock client for unit tests
-func getEVMRPC(ctx context.Context, endpoint string) (interfaces.EVMRPCClient, ethtypes.Signer, error) {
-	if endpoint == testutils.MockEVMRPCEndpoint {
+func getEVMRPC(
+	ctx context.Context,
+	endpoints []string,
+	logger zerolog.Logger,
+) (interfaces.EVMRPCClient, ethtypes.Signer, error) {
+	if len(endpoints) == 1 && endpoints[0] == testutils.MockEVMRPCEndpoint {
 		chainID := big.NewInt(chains.BscMainnet.ChainId)
 		ethSigner := ethtypes.NewLondonSigner(chainID)
 		client := &mocks.EVMRPCClient{}
 		return client, ethSigner, nil
 	}
-	httpClient, err := metrics.GetInstrumentedHTTPClient(endpoint)
+
+	rpcEndpoints, err := rpc.DialEndpoints(endpoints)
 	if err != nil {
-		return nil, nil, errors.Wrap(err, "unable to get instrumented HTTP client")
+		return nil, nil, errors.Wrap(err, "unable to dial EVM endpoints")
 	}
 
-	rpcClient, err := ethrpc.DialHTTPWithClient(endpoint, httpClient)
+	// the signer only broadcasts txs, so no quorum is needed
+	client, err := rpc.NewFailoverClient(rpcEndpoints, 1, logger)
 	if err != nil {
-		return nil, nil, errors.Wrapf(err, "unable to dial EVM client (endpoint %q)", endpoint)
+		return nil, nil, errors.Wrap(err, "unable to create EVM client")
 	}
-	client := ethclient.NewClient(rpcClient)
 
 	chainID, err := client.ChainID(ctx)
 	if err != nil {
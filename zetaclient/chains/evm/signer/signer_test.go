@@ -55,7 +55,7 @@ func getNewEvmSigner(tss interfaces.TSSSigner) (*Signer, error) {
 		tss,
 		nil,
 		logger,
-		testutils.MockEVMRPCEndpoint,
+		[]string{testutils.MockEVMRPCEndpoint},
 		connectorAddress,
 		erc20CustodyAddress,
 		sample.EthAddress(),
@@ -207,6 +207,10 @@ func TestSigner_BroadcastOutbound(t *testing.T) {
 	evmClient.On("SendTransaction", mock.Anything, mock.Anything).Return(nil)
 	evmSigner.WithEvmClient(evmClient)
 
+	// Setup evm observer as outbound recorder
+	ob, err := getNewEvmChainObserver(t, nil)
+	require.NoError(t, err)
+
 	t.Run("BroadcastOutbound - should successfully broadcast", func(t *testing.T) {
 		// Call SignERC20Withdraw
 		tx, err := evmSigner.SignERC20Withdraw(ctx, txData)
@@ -219,12 +223,21 @@ func TestSigner_BroadcastOutbound(t *testing.T) {
 			zerolog.Logger{},
 			sdktypes.AccAddress{},
 			mocks.NewZetacoreClient(t),
+			ob,
 			txData,
 		)
 
 		//Check if cctx was signed and broadcasted
 		list := evmSigner.GetReportedTxList()
 		require.Len(t, *list, 1)
+
+		// Check if the signed tx was recorded in the db
+		outbounds, err := ob.LoadOutbounds()
+		require.NoError(t, err)
+		require.Len(t, outbounds, 1)
+		require.Equal(t, tx.Nonce(), outbounds[0].Nonce)
+		require.Equal(t, tx.Hash().Hex(), outbounds[0].TxHash)
+		require.False(t, outbounds[0].TrackerReported)
 	})
 }
 
@@ -232,7 +245,7 @@ func TestSigner_getEVMRPC(t *testing.T) {
 	ctx := context.Background()
 
 	t.Run("getEVMRPC error dialing", func(t *testing.T) {
-		client, signer, err := getEVMRPC(ctx, "invalidEndpoint")
+		client, signer, err := getEVMRPC(ctx, []string{"invalidEndpoint"}, zerolog.Nop())
 		require.Nil(t, client)
 		require.Nil(t, signer)
 		require.Error(t, err)
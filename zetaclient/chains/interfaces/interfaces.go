@@ -56,6 +56,15 @@ type ChainObserver interface {
 	VoteOutboundIfConfirmed(ctx context.Context, cctx *crosschaintypes.CrossChainTx) (bool, error)
 }
 
+// OutboundRecorder is the interface to persist the outbound attempts of a chain observer
+type OutboundRecorder interface {
+	// SaveOutboundBroadcast records a broadcast outbound attempt with its signed payload
+	SaveOutboundBroadcast(nonce uint64, txHash string, signedPayload []byte) error
+
+	// SetOutboundTrackerReported marks the outbound attempt as reported to the outbound tracker
+	SetOutboundTrackerReported(nonce uint64, txHash string) error
+}
+
 // ChainSigner is the interface to sign transactions for a chain
 type ChainSigner interface {
 	TryProcessOutbound(
@@ -137,6 +146,7 @@ type ZetacoreClient interface {
 	GetCrosschainFlags(ctx context.Context) (observertypes.CrosschainFlags, error)
 	GetRateLimiterFlags(ctx context.Context) (crosschaintypes.RateLimiterFlags, error)
 	GetObserverList(ctx context.Context) ([]string, error)
+	GetInboundHashToCctx(ctx context.Context, inboundHash string) (crosschaintypes.InboundHashToCctx, error)
 	GetBTCTSSAddress(ctx context.Context, chainID int64) (string, error)
 	GetZetaHotKeyBalance(ctx context.Context) (sdkmath.Int, error)
 	GetInboundTrackersForChain(ctx context.Context, chainID int64) ([]crosschaintypes.InboundTracker, error)
@@ -151,6 +161,7 @@ type ZetacoreClient interface {
 		blockHash string,
 		txIndex int64,
 	) (string, error)
+	PostConsolidateUTXOs(ctx context.Context, chainID int64, utxoCount uint64, dustCount uint64) (string, error)
 
 	Stop()
 	OnBeforeStop(callback func())
@@ -180,6 +191,7 @@ type BTCRPCClient interface {
 // EVMRPCClient is the interface for EVM RPC client
 type EVMRPCClient interface {
 	bind.ContractBackend
+	ChainID(ctx context.Context) (*big.Int, error)
 	SendTransaction(ctx context.Context, tx *ethtypes.Transaction) error
 	SuggestGasPrice(ctx context.Context) (*big.Int, error)
 	BlockNumber(ctx context.Context) (uint64, error)
@@ -0,0 +1,78 @@
+package observer
+
+import (
+	"context"
+
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/pkg/errors"
+
+	"github.com/zeta-chain/node/zetaclient/chains/base"
+	solanarpc "github.com/zeta-chain/node/zetaclient/chains/solana/rpc"
+)
+
+// BackfillInbound replays the inbound scanning over the slot range [from, to] to vote for missed inbounds.
+// The gateway signatures in the slot range are scanned, the last scanned signature of the observer is left untouched.
+func (ob *Observer) BackfillInbound(ctx context.Context, from, to uint64) error {
+	chainID := ob.Chain().ChainId
+
+	finalizedSlot, err := ob.solClient.GetSlot(ctx, rpc.CommitmentFinalized)
+	if err != nil {
+		return errors.Wrapf(err, "error GetSlot for chain %d", chainID)
+	}
+	if err := base.ValidateBackfillRange(from, to, finalizedSlot); err != nil {
+		return err
+	}
+	ctx = base.WithBackfill(ctx)
+
+	signatures, err := solanarpc.GetSignaturesForAddressInSlotRange(
+		ctx,
+		ob.solClient,
+		ob.gatewayID,
+		from,
+		to,
+		solanarpc.DefaultPageLimit,
+	)
+	if err != nil {
+		return errors.Wrapf(err, "error GetSignaturesForAddressInSlotRange for chain %d", chainID)
+	}
+
+	// loop signature from oldest to latest to filter inbound events
+	for i := len(signatures) - 1; i >= 0; i-- {
+		sig := signatures[i]
+		sigString := sig.Signature.String()
+
+		// process successfully signature only
+		if sig.Err == nil {
+			txResult, err := ob.solClient.GetTransaction(ctx, sig.Signature, &rpc.GetTransactionOpts{})
+			if err != nil {
+				return errors.Wrapf(err, "error GetTransaction for chain %d sig %s", chainID, sigString)
+			}
+
+			if err := ob.FilterInboundEventsAndVote(ctx, txResult); err != nil {
+				return errors.Wrapf(err, "error FilterInboundEventAndVote for chain %d sig %s", chainID, sigString)
+			}
+		}
+
+		// take a rest after each batch of signatures
+		scanned := len(signatures) - i
+		if scanned%base.BackfillBatchSize == 0 && i > 0 {
+			ob.Logger().Inbound.Info().
+				Uint64("backfill.from", from).
+				Uint64("backfill.to", to).
+				Uint64("backfill.slot", sig.Slot).
+				Msg("BackfillInbound: signatures scanned")
+
+			if err := base.WaitBackfillBatchInterval(ctx); err != nil {
+				return err
+			}
+		}
+	}
+
+	ob.Logger().Inbound.Info().
+		Uint64("backfill.from", from).
+		Uint64("backfill.to", to).
+		Int("backfill.signatures", len(signatures)).
+		Msg("BackfillInbound: slot range scanned")
+
+	return nil
+}
@@ -0,0 +1,71 @@
+package observer_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gagliardetto/solana-go"
+	solrpc "github.com/gagliardetto/solana-go/rpc"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/zeta-chain/node/pkg/chains"
+	"github.com/zeta-chain/node/testutil/sample"
+	crosschaintypes "github.com/zeta-chain/node/x/crosschain/types"
+	"github.com/zeta-chain/node/zetaclient/keys"
+	"github.com/zeta-chain/node/zetaclient/testutils"
+	"github.com/zeta-chain/node/zetaclient/testutils/mocks"
+)
+
+func Test_BackfillInbound(t *testing.T) {
+	// load archived inbound vote tx result
+	// https://explorer.solana.com/tx/MS3MPLN7hkbyCZFwKqXcg8fmEvQMD74fN6Ps2LSWXJoRxPW5ehaxBorK9q1JFVbqnAvu9jXm6ertj7kT7HpYw1j?cluster=devnet
+	txHash := "MS3MPLN7hkbyCZFwKqXcg8fmEvQMD74fN6Ps2LSWXJoRxPW5ehaxBorK9q1JFVbqnAvu9jXm6ertj7kT7HpYw1j"
+	chain := chains.SolanaDevnet
+	txResult := testutils.LoadSolanaInboundTxResult(t, TestDataDir, chain.ChainId, txHash, false)
+
+	chainParams := sample.ChainParams(chain.ChainId)
+	chainParams.GatewayAddress = testutils.GatewayAddresses[chain.ChainId]
+
+	t.Run("should not backfill slots not finalized yet", func(t *testing.T) {
+		solClient := mocks.NewSolanaRPCClient(t)
+		solClient.On("GetSlot", mock.Anything, solrpc.CommitmentFinalized).Return(uint64(1000), nil)
+
+		ob := MockSolanaObserver(t, chain, solClient, *chainParams, nil, nil)
+
+		err := ob.BackfillInbound(context.Background(), 900, 1001)
+		require.ErrorContains(t, err, "is greater than last scanned block")
+	})
+
+	t.Run("should backfill the gateway signatures in the slot range", func(t *testing.T) {
+		// the gateway has one signature before, one in and one after the slot range
+		sig := solana.MustSignatureFromBase58(txHash)
+		solClient := mocks.NewSolanaRPCClient(t)
+		solClient.On("GetSlot", mock.Anything, solrpc.CommitmentFinalized).Return(txResult.Slot+1000, nil)
+		solClient.On("GetSignaturesForAddressWithOpts", mock.Anything, mock.Anything, mock.Anything).
+			Return([]*solrpc.TransactionSignature{
+				{Signature: sample.SolanaSignature(t), Slot: txResult.Slot + 500},
+				{Signature: sig, Slot: txResult.Slot},
+				{Signature: sample.SolanaSignature(t), Slot: txResult.Slot - 10},
+			}, nil).
+			Once()
+		solClient.On("GetTransaction", mock.Anything, sig, mock.Anything).Return(txResult, nil).Once()
+
+		// the cctx of the inbound is not created
+		zetacoreClient := mocks.NewZetacoreClient(t).WithKeys(&keys.Keys{}).WithZetaChain()
+		zetacoreClient.On("GetInboundHashToCctx", mock.Anything, mock.Anything).
+			Return(crosschaintypes.InboundHashToCctx{}, status.Error(codes.NotFound, "not found")).
+			Once()
+		zetacoreClient.On("PostVoteInbound", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return("", "", nil).
+			Once()
+
+		ob := MockSolanaObserver(t, chain, solClient, *chainParams, zetacoreClient, nil)
+
+		err := ob.BackfillInbound(context.Background(), txResult.Slot-1, txResult.Slot+100)
+		require.NoError(t, err)
+		require.Empty(t, ob.LastTxScanned())
+	})
+}
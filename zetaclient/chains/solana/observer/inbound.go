@@ -52,7 +52,7 @@ func (ob *Observer) WatchInbound(ctx context.Context) error {
 	for {
 		select {
 		case <-ticker.C():
-			if !app.IsInboundObservationEnabled() {
+			if !app.IsInboundObservationEnabled(ob.Chain().ChainId) {
 				sampledLogger.Info().
 					Msgf("WatchInbound: inbound observation is disabled for chain %d", ob.Chain().ChainId)
 				continue
@@ -147,6 +147,15 @@ func (ob *Observer) FilterInboundEventsAndVote(ctx context.Context, txResult *rp
 	for _, event := range events {
 		msg := ob.BuildInboundVoteMsgFromEvent(event)
 		if msg != nil {
+			// large amounts may require more confirmations than the finality of the transaction
+			confirmed, err := ob.isInboundConfirmed(ctx, msg)
+			if err != nil {
+				return errors.Wrapf(err, "error checking inbound confirmations")
+			}
+			if !confirmed {
+				return fmt.Errorf("inbound %s is not confirmed yet for amount %s", msg.InboundHash, msg.Amount)
+			}
+
 			_, err = ob.PostVoteInbound(ctx, msg, zetacore.PostVoteInboundExecutionGasLimit)
 			if err != nil {
 				return errors.Wrapf(err, "error PostVoteInbound")
@@ -157,6 +166,23 @@ func (ob *Observer) FilterInboundEventsAndVote(ctx context.Context, txResult *rp
 	return nil
 }
 
+// isInboundConfirmed checks if the inbound vote has the number of confirmations (in slots) required by
+// the confirmation tier matching its asset and amount. The default confirmation is given by transaction finality.
+func (ob *Observer) isInboundConfirmed(ctx context.Context, msg *crosschaintypes.MsgVoteInbound) (bool, error) {
+	if ob.ConfirmationCount(msg.CoinType, msg.Asset, msg.Amount) <= ob.ChainParams().ConfirmationCount {
+		return true, nil
+	}
+
+	// get latest finalized slot
+	slot, err := ob.solClient.GetSlot(ctx, rpc.CommitmentFinalized)
+	if err != nil {
+		return false, errors.Wrap(err, "GetSlot error")
+	}
+	ob.WithLastBlock(slot)
+
+	return ob.IsInboundConfirmed(msg), nil
+}
+
 // FilterInboundEvents filters inbound events from a tx result.
 // Note: for consistency with EVM chains, this method
 //   - takes at one event (the first) per token (SOL or SPL) per transaction.
@@ -33,7 +33,7 @@ func (ob *Observer) WatchInboundTracker(ctx context.Context) error {
 	for {
 		select {
 		case <-ticker.C():
-			if !app.IsInboundObservationEnabled() {
+			if !app.IsInboundObservationEnabled(ob.Chain().ChainId) {
 				continue
 			}
 			err := ob.ProcessInboundTrackers(ctx)
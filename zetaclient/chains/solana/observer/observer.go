@@ -2,6 +2,7 @@ package observer
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/gagliardetto/solana-go"
 	"github.com/gagliardetto/solana-go/rpc"
@@ -14,6 +15,7 @@ import (
 	"github.com/zeta-chain/node/zetaclient/chains/base"
 	"github.com/zeta-chain/node/zetaclient/chains/interfaces"
 	"github.com/zeta-chain/node/zetaclient/db"
+	"github.com/zeta-chain/node/zetaclient/logs"
 	"github.com/zeta-chain/node/zetaclient/metrics"
 )
 
@@ -83,6 +85,11 @@ func NewObserver(
 
 	ob.Observer.LoadLastTxScanned()
 
+	// load finalized outbounds
+	if err = ob.LoadFinalizedOutbounds(); err != nil {
+		return nil, errors.Wrap(err, "unable to load finalized outbounds")
+	}
+
 	return ob, nil
 }
 
@@ -129,13 +136,63 @@ func (ob *Observer) LoadLastTxScanned() error {
 	return nil
 }
 
-// SetTxResult sets the tx result for the given nonce
+// SetTxResult sets the tx result for the given nonce and persists it to the database
 func (ob *Observer) SetTxResult(nonce uint64, result *rpc.GetTransactionResult) {
+	ob.setTxResult(nonce, result)
+
+	if err := ob.saveTxResult(nonce, result); err != nil {
+		ob.Logger().Outbound.Error().Err(err).Uint64(logs.FieldNonce, nonce).Msg("unable to save outbound to db")
+	}
+}
+
+// setTxResult sets the tx result for the given nonce in memory
+func (ob *Observer) setTxResult(nonce uint64, result *rpc.GetTransactionResult) {
 	ob.Mu().Lock()
 	defer ob.Mu().Unlock()
 	ob.finalizedTxResults[ob.OutboundID(nonce)] = result
 }
 
+// saveTxResult persists the finalized tx result to the database
+func (ob *Observer) saveTxResult(nonce uint64, result *rpc.GetTransactionResult) error {
+	if result == nil {
+		return nil
+	}
+
+	tx, err := result.Transaction.GetTransaction()
+	if err != nil {
+		return errors.Wrap(err, "unable to get transaction")
+	}
+	resultJSON, err := json.Marshal(result)
+	if err != nil {
+		return errors.Wrap(err, "unable to marshal tx result")
+	}
+
+	return ob.SaveOutboundResult(nonce, tx.Signatures[0].String(), resultJSON, OutboundStatus(tx))
+}
+
+// LoadFinalizedOutbounds loads the finalized outbound tx results from the database into memory
+func (ob *Observer) LoadFinalizedOutbounds() error {
+	outbounds, err := ob.LoadOutbounds()
+	if err != nil {
+		return err
+	}
+
+	for _, outbound := range outbounds {
+		if !outbound.HasResult() {
+			continue
+		}
+
+		result := &rpc.GetTransactionResult{}
+		if err := json.Unmarshal(outbound.Result, result); err != nil {
+			return errors.Wrapf(err, "unable to unmarshal tx result of outbound %s", outbound.TxHash)
+		}
+
+		ob.setTxResult(outbound.Nonce, result)
+	}
+
+	return nil
+}
+
 // GetTxResult returns the tx result for the given nonce
 func (ob *Observer) GetTxResult(nonce uint64) *rpc.GetTransactionResult {
 	ob.Mu().Lock()
@@ -13,6 +13,7 @@ import (
 	"github.com/zeta-chain/node/zetaclient/chains/base"
 	"github.com/zeta-chain/node/zetaclient/chains/interfaces"
 	"github.com/zeta-chain/node/zetaclient/chains/solana/observer"
+	"github.com/zeta-chain/node/zetaclient/testutils"
 	"github.com/zeta-chain/node/zetaclient/testutils/mocks"
 )
 
@@ -74,3 +75,55 @@ func Test_LoadLastTxScanned(t *testing.T) {
 		require.Equal(t, lastTx, ob.LastTxScanned())
 	})
 }
+
+func Test_LoadFinalizedOutbounds(t *testing.T) {
+	// parepare params
+	chain := chains.SolanaDevnet
+	params := sample.ChainParams(chain.ChainId)
+	params.GatewayAddress = GatewayAddressTest
+	nonce := uint64(0)
+
+	// load archived outbound tx result
+	txResult := testutils.LoadSolanaOutboundTxResult(t, TestDataDir, chain.ChainId, withdrawTxTest)
+
+	t.Run("should rehydrate finalized outbounds from db on restart", func(t *testing.T) {
+		// create observer and finalize the outbound
+		ob := MockSolanaObserver(t, chain, nil, *params, nil, nil)
+		ob.SetTxResult(nonce, txResult)
+
+		// create a new observer on the same db
+		obNew, err := observer.NewObserver(
+			chain,
+			nil,
+			*params,
+			ob.ZetacoreClient(),
+			ob.TSS(),
+			60,
+			ob.DB(),
+			base.DefaultLogger(),
+			nil,
+		)
+		require.NoError(t, err)
+
+		// the finalized outbound should be loaded
+		require.True(t, obNew.IsTxFinalized(nonce))
+		loaded := obNew.GetTxResult(nonce)
+		require.Equal(t, txResult.Slot, loaded.Slot)
+
+		tx, err := txResult.Transaction.GetTransaction()
+		require.NoError(t, err)
+		txLoaded, err := loaded.Transaction.GetTransaction()
+		require.NoError(t, err)
+		require.Equal(t, tx.Signatures, txLoaded.Signatures)
+	})
+
+	t.Run("should skip outbounds that are not finalized", func(t *testing.T) {
+		// create observer and record a broadcast outbound only
+		ob := MockSolanaObserver(t, chain, nil, *params, nil, nil)
+		require.NoError(t, ob.SaveOutboundBroadcast(nonce, withdrawTxTest, []byte{0x01}))
+
+		err := ob.LoadFinalizedOutbounds()
+		require.NoError(t, err)
+		require.False(t, ob.IsTxFinalized(nonce))
+	})
+}
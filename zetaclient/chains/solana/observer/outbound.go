@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"math/big"
+	"time"
 
 	"cosmossdk.io/math"
 	"github.com/gagliardetto/solana-go"
@@ -23,6 +24,9 @@ import (
 	"github.com/zeta-chain/node/zetaclient/zetacore"
 )
 
+// OutboundTrackerReportTimeout is the period within which a broadcast outbound is reported to the tracker from db
+const OutboundTrackerReportTimeout = 10 * time.Minute
+
 // WatchOutbound watches solana chain for outgoing txs status
 // TODO(revamp): move ticker function to ticker file
 func (ob *Observer) WatchOutbound(ctx context.Context) error {
@@ -49,7 +53,7 @@ func (ob *Observer) WatchOutbound(ctx context.Context) error {
 	for {
 		select {
 		case <-ticker.C():
-			if !app.IsOutboundObservationEnabled() {
+			if !app.IsOutboundObservationEnabled(ob.Chain().ChainId) {
 				sampledLogger.Info().Msgf("WatchOutbound: outbound observation is disabled for chain %d", chainID)
 				continue
 			}
@@ -63,6 +67,12 @@ func (ob *Observer) WatchOutbound(ctx context.Context) error {
 					Msgf("WatchOutbound: error ProcessOutboundTrackers for chain %d", chainID)
 			}
 
+			// report the broadcast outbounds missing in the tracker (e.g. after restart)
+			err = ob.ReportOutboundTrackersFromDB(ctx, ob.isOutboundIncluded, OutboundTrackerReportTimeout)
+			if err != nil {
+				ob.Logger().Outbound.Error().Err(err).Msg("WatchOutbound: error reporting outbound trackers from db")
+			}
+
 			ticker.UpdateInterval(ob.ChainParams().OutboundTicker, ob.Logger().Outbound)
 		case <-ob.StopChannel():
 			ob.Logger().Outbound.Info().Msgf("WatchOutbound: watcher stopped for chain %d", chainID)
@@ -71,6 +81,27 @@ func (ob *Observer) WatchOutbound(ctx context.Context) error {
 	}
 }
 
+// isOutboundIncluded returns true if the outbound tx is confirmed and successful
+func (ob *Observer) isOutboundIncluded(ctx context.Context, txHash string) (bool, error) {
+	sig, err := solana.SignatureFromBase58(txHash)
+	if err != nil {
+		return false, errors.Wrapf(err, "SignatureFromBase58 error for tx %s", txHash)
+	}
+
+	txResult, err := ob.solClient.GetTransaction(ctx, sig, &rpc.GetTransactionOpts{
+		Commitment: rpc.CommitmentConfirmed,
+	})
+	switch {
+	case errors.Is(err, rpc.ErrNotFound):
+		return false, nil
+	case err != nil:
+		return false, errors.Wrapf(err, "GetTransaction error for tx %s", txHash)
+	}
+
+	// a failed tx will never be able to increment the gateway nonce
+	return txResult.Meta.Err == nil, nil
+}
+
 // ProcessOutboundTrackers processes Solana outbound trackers
 func (ob *Observer) ProcessOutboundTrackers(ctx context.Context) error {
 	chainID := ob.Chain().ChainId
@@ -157,8 +188,12 @@ func (ob *Observer) VoteOutboundIfConfirmed(ctx context.Context, cctx *crosschai
 
 	// the amount and status of the outbound
 	outboundAmount := new(big.Int).SetUint64(inst.TokenAmount())
-	// status was already verified as successful in CheckFinalizedTx
+	// tx was already verified as successful in CheckFinalizedTx,
+	// but an 'increment_nonce' means the outbound failed (e.g. the contract call reverted)
 	outboundStatus := chains.ReceiveStatus_success
+	if _, ok := inst.(*contracts.IncrementNonceInstructionParams); ok {
+		outboundStatus = chains.ReceiveStatus_failed
+	}
 
 	// compliance check, special handling the cancelled cctx
 	if compliance.IsCctxRestricted(cctx) {
@@ -193,11 +228,12 @@ func (ob *Observer) PostVoteOutbound(
 	}
 
 	// so we set retryGasLimit to 0 because the solana gateway withdrawal will always succeed
-	// and the vote msg won't trigger ZEVM interaction
-	const (
-		gasLimit      = zetacore.PostVoteOutboundGasLimit
-		retryGasLimit = 0
-	)
+	// and the vote msg won't trigger ZEVM interaction, unless the outbound failed and is reverted to ZEVM
+	const gasLimit = zetacore.PostVoteOutboundGasLimit
+	var retryGasLimit uint64
+	if status == chains.ReceiveStatus_failed {
+		retryGasLimit = zetacore.PostVoteOutboundRevertGasLimit
+	}
 
 	// post vote to zetacore
 	zetaTxHash, ballot, err := ob.ZetacoreClient().PostVoteOutbound(ctx, gasLimit, retryGasLimit, msg)
@@ -212,6 +248,10 @@ func (ob *Observer) PostVoteOutbound(
 		logFields["ballot"] = ballot
 		ob.Logger().Outbound.Info().Fields(logFields).Msg("PostVoteOutbound: posted outbound vote successfully")
 	}
+
+	if err := ob.SetOutboundVotePosted(nonce, outboundHash); err != nil {
+		ob.Logger().Outbound.Error().Err(err).Fields(logFields).Msg("PostVoteOutbound: unable to save vote status to db")
+	}
 }
 
 // CreateMsgVoteOutbound creates a vote outbound message for Solana chain
@@ -319,6 +359,23 @@ func (ob *Observer) CheckFinalizedTx(
 	return txResult, true
 }
 
+// OutboundStatus returns the status of the given finalized outbound tx.
+// A trailing 'increment_nonce' instruction means the outbound failed (e.g. the contract call reverted)
+func OutboundStatus(tx *solana.Transaction) chains.ReceiveStatus {
+	instructions := tx.Message.Instructions
+	if len(instructions) == 0 {
+		return chains.ReceiveStatus_success
+	}
+
+	var discriminator [8]byte
+	copy(discriminator[:], instructions[len(instructions)-1].Data)
+	if discriminator == contracts.DiscriminatorIncrementNonce() {
+		return chains.ReceiveStatus_failed
+	}
+
+	return chains.ReceiveStatus_success
+}
+
 // ParseGatewayInstruction parses the outbound instruction from tx result
 func ParseGatewayInstruction(
 	txResult *rpc.GetTransactionResult,
@@ -331,11 +388,35 @@ func ParseGatewayInstruction(
 		return nil, errors.Wrap(err, "error unmarshaling transaction")
 	}
 
-	// there should be only one single instruction ('withdraw' or 'withdraw_spl_token')
-	if len(tx.Message.Instructions) != 1 {
-		return nil, fmt.Errorf("want 1 instruction, got %d", len(tx.Message.Instructions))
+	// the gateway instruction may be preceded by a compute unit price instruction (priority fee)
+	instructions := tx.Message.Instructions
+	if len(instructions) > 1 {
+		programID, err := tx.Message.Program(instructions[0].ProgramIDIndex)
+		if err != nil {
+			return nil, errors.Wrap(err, "error getting program ID")
+		}
+		if programID.Equals(solana.ComputeBudget) {
+			instructions = instructions[1:]
+		}
 	}
-	instruction := tx.Message.Instructions[0]
+
+	// a 'withdraw_spl_token' may be preceded by the creation of the recipient's associated token account
+	if coinType == coin.CoinType_ERC20 && len(instructions) == 2 {
+		programID, err := tx.Message.Program(instructions[0].ProgramIDIndex)
+		if err != nil {
+			return nil, errors.Wrap(err, "error getting program ID")
+		}
+		if !programID.Equals(solana.SPLAssociatedTokenAccountProgramID) {
+			return nil, fmt.Errorf("programID %s is not associated token account program", programID)
+		}
+		instructions = instructions[1:]
+	}
+
+	// there should be only one single gateway instruction ('withdraw', 'withdraw_spl_token', 'execute', etc.)
+	if len(instructions) != 1 {
+		return nil, fmt.Errorf("want 1 instruction, got %d", len(instructions))
+	}
+	instruction := instructions[0]
 
 	// get the program ID
 	programID, err := tx.Message.Program(instruction.ProgramIDIndex)
@@ -348,10 +429,24 @@ func ParseGatewayInstruction(
 		return nil, fmt.Errorf("programID %s is not matching gatewayID %s", programID, gatewayID)
 	}
 
-	// parse the instruction as a 'withdraw' or 'withdraw_spl_token'
+	// the nonce of an outbound that can't be executed is consumed by an 'increment_nonce'
+	var discriminator [8]byte
+	copy(discriminator[:], instruction.Data)
+	if discriminator == contracts.DiscriminatorIncrementNonce() {
+		return contracts.ParseInstructionIncrementNonce(instruction)
+	}
+
+	// parse the instruction as a 'withdraw', 'withdraw_spl_token' or 'execute'
 	switch coinType {
 	case coin.CoinType_Gas:
+		if discriminator == contracts.DiscriminatorExecute() {
+			return contracts.ParseInstructionExecute(instruction)
+		}
 		return contracts.ParseInstructionWithdraw(instruction)
+	case coin.CoinType_ERC20:
+		return contracts.ParseInstructionWithdrawSPL(instruction)
+	case coin.CoinType_NoAssetCall:
+		return contracts.ParseInstructionExecute(instruction)
 	default:
 		return nil, fmt.Errorf("unsupported outbound coin type %s", coinType)
 	}
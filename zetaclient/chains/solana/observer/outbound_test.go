@@ -3,9 +3,16 @@ package observer_test
 import (
 	"context"
 	"encoding/hex"
+	"encoding/json"
 	"testing"
 
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/gagliardetto/solana-go"
+	ata "github.com/gagliardetto/solana-go/programs/associated-token-account"
+	computebudget "github.com/gagliardetto/solana-go/programs/compute-budget"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/near/borsh-go"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -56,6 +63,84 @@ func createTestObserver(
 	return ob
 }
 
+// signMessageHash signs the message hash with a random ECDSA key and returns the [R+S] signature, V and the signer
+func signMessageHash(t *testing.T, hash [32]byte) ([64]byte, uint8, ethcommon.Address) {
+	privKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	sig, err := crypto.Sign(hash[:], privKey)
+	require.NoError(t, err)
+
+	var sigRS [64]byte
+	copy(sigRS[:], sig[:64])
+	return sigRS, sig[64], crypto.PubkeyToAddress(privKey.PublicKey)
+}
+
+// createGatewayTxResult creates a tx result invoking the gateway program with the given instruction params.
+// The gateway instruction is preceded by the given 'preInstructions' if any.
+func createGatewayTxResult(
+	t *testing.T,
+	gatewayID solana.PublicKey,
+	params any,
+	preInstructions ...solana.Instruction,
+) *rpc.GetTransactionResult {
+	relayer := sample.SolanaPrivateKey(t)
+
+	// create the gateway instruction
+	var err error
+	var inst solana.GenericInstruction
+	inst.ProgID = gatewayID
+	inst.AccountValues = []*solana.AccountMeta{solana.Meta(relayer.PublicKey()).WRITE().SIGNER()}
+	inst.DataBytes, err = borsh.Serialize(params)
+	require.NoError(t, err)
+
+	instructions := append(preInstructions, &inst)
+	tx, err := solana.NewTransaction(instructions, solana.Hash{}, solana.TransactionPayer(relayer.PublicKey()))
+	require.NoError(t, err)
+
+	// wrap the transaction into a tx result
+	txJSON, err := json.Marshal(tx)
+	require.NoError(t, err)
+	envelope := &rpc.TransactionResultEnvelope{}
+	require.NoError(t, envelope.UnmarshalJSON(txJSON))
+
+	return &rpc.GetTransactionResult{Transaction: envelope, Meta: &rpc.TransactionMeta{}}
+}
+
+// createWithdrawSPLTxResult creates a tx result invoking gateway 'withdraw_spl_token' signed by a random ECDSA key.
+// The 'withdraw_spl_token' is preceded by the creation of the recipient ATA if 'createATA' is true.
+func createWithdrawSPLTxResult(
+	t *testing.T,
+	gatewayID solana.PublicKey,
+	createATA bool,
+) (*rpc.GetTransactionResult, ethcommon.Address) {
+	mint := solana.MustPublicKeyFromBase58(sample.SolanaAddress(t))
+	to := solana.MustPublicKeyFromBase58(sample.SolanaAddress(t))
+	// #nosec G115 always positive
+	chainID := uint64(chains.SolanaDevnet.ChainId)
+
+	// sign the withdraw_spl_token message
+	msg, err := contracts.NewMsgWithdrawSPL(chainID, 1, 1000, mint, to)
+	require.NoError(t, err)
+	sigRS, sigV, signer := signMessageHash(t, msg.Hash())
+
+	params := contracts.WithdrawSPLInstructionParams{
+		Discriminator: contracts.DiscriminatorWithdrawSPL(),
+		Amount:        msg.Amount(),
+		Signature:     sigRS,
+		RecoveryID:    sigV,
+		MessageHash:   msg.Hash(),
+		Nonce:         msg.Nonce(),
+	}
+
+	var preInstructions []solana.Instruction
+	if createATA {
+		payer := solana.MustPublicKeyFromBase58(sample.SolanaAddress(t))
+		preInstructions = append(preInstructions, ata.NewCreateInstruction(payer, to, mint).Build())
+	}
+
+	return createGatewayTxResult(t, gatewayID, params, preInstructions...), signer
+}
+
 func Test_CheckFinalizedTx(t *testing.T) {
 	// the test chain and transaction hash
 	chain := chains.SolanaDevnet
@@ -227,16 +312,161 @@ func Test_ParseGatewayInstruction(t *testing.T) {
 		require.Nil(t, inst)
 	})
 
+	t.Run("should parse withdraw_spl_token instruction", func(t *testing.T) {
+		txResult, signer := createWithdrawSPLTxResult(t, gatewayID, false)
+
+		inst, err := observer.ParseGatewayInstruction(txResult, gatewayID, coin.CoinType_ERC20)
+		require.NoError(t, err)
+
+		// check sender, nonce and amount
+		sender, err := inst.Signer()
+		require.NoError(t, err)
+		require.Equal(t, signer, sender)
+		require.EqualValues(t, 1, inst.GatewayNonce())
+		require.EqualValues(t, 1000, inst.TokenAmount())
+	})
+
+	t.Run("should parse withdraw_spl_token instruction preceded by ATA creation", func(t *testing.T) {
+		txResult, signer := createWithdrawSPLTxResult(t, gatewayID, true)
+
+		inst, err := observer.ParseGatewayInstruction(txResult, gatewayID, coin.CoinType_ERC20)
+		require.NoError(t, err)
+
+		sender, err := inst.Signer()
+		require.NoError(t, err)
+		require.Equal(t, signer, sender)
+	})
+
+	t.Run("should return error if first instruction is not ATA creation", func(t *testing.T) {
+		txResult, _ := createWithdrawSPLTxResult(t, gatewayID, true)
+		tx, err := txResult.Transaction.GetTransaction()
+		require.NoError(t, err)
+
+		// invoke the gateway program in the first instruction
+		tx.Message.Instructions[0].ProgramIDIndex = tx.Message.Instructions[1].ProgramIDIndex
+
+		inst, err := observer.ParseGatewayInstruction(txResult, gatewayID, coin.CoinType_ERC20)
+		require.ErrorContains(t, err, "is not associated token account program")
+		require.Nil(t, inst)
+	})
+
+	t.Run("should not parse withdraw instruction as withdraw_spl_token", func(t *testing.T) {
+		txResult := testutils.LoadSolanaOutboundTxResult(t, TestDataDir, chain.ChainId, txHash)
+
+		inst, err := observer.ParseGatewayInstruction(txResult, gatewayID, coin.CoinType_ERC20)
+		require.ErrorContains(t, err, "not a withdraw_spl_token instruction")
+		require.Nil(t, inst)
+	})
+
+	t.Run("should parse execute instruction", func(t *testing.T) {
+		hash := contracts.NewMsgExecute(1, 2, 1000, gatewayID, ethcommon.Address{}, []byte("hello"), nil).Hash()
+		sigRS, sigV, signer := signMessageHash(t, hash)
+		txResult := createGatewayTxResult(t, gatewayID, contracts.ExecuteInstructionParams{
+			Discriminator: contracts.DiscriminatorExecute(),
+			Amount:        1000,
+			Data:          []byte("hello"),
+			Signature:     sigRS,
+			RecoveryID:    sigV,
+			MessageHash:   hash,
+			Nonce:         2,
+		})
+
+		for _, coinType := range []coin.CoinType{coin.CoinType_Gas, coin.CoinType_NoAssetCall} {
+			inst, err := observer.ParseGatewayInstruction(txResult, gatewayID, coinType)
+			require.NoError(t, err)
+			require.IsType(t, &contracts.ExecuteInstructionParams{}, inst)
+
+			sender, err := inst.Signer()
+			require.NoError(t, err)
+			require.Equal(t, signer, sender)
+			require.EqualValues(t, 2, inst.GatewayNonce())
+			require.EqualValues(t, 1000, inst.TokenAmount())
+		}
+	})
+
+	t.Run("should parse increment_nonce instruction", func(t *testing.T) {
+		hash := contracts.NewMsgIncrementNonce(1, 2).Hash()
+		sigRS, sigV, signer := signMessageHash(t, hash)
+		txResult := createGatewayTxResult(t, gatewayID, contracts.IncrementNonceInstructionParams{
+			Discriminator: contracts.DiscriminatorIncrementNonce(),
+			Signature:     sigRS,
+			RecoveryID:    sigV,
+			MessageHash:   hash,
+			Nonce:         2,
+		})
+
+		inst, err := observer.ParseGatewayInstruction(txResult, gatewayID, coin.CoinType_NoAssetCall)
+		require.NoError(t, err)
+		require.IsType(t, &contracts.IncrementNonceInstructionParams{}, inst)
+
+		sender, err := inst.Signer()
+		require.NoError(t, err)
+		require.Equal(t, signer, sender)
+		require.EqualValues(t, 2, inst.GatewayNonce())
+		require.Zero(t, inst.TokenAmount())
+	})
+
+	t.Run("should parse gateway instruction preceded by compute unit price", func(t *testing.T) {
+		hash := contracts.NewMsgIncrementNonce(1, 2).Hash()
+		sigRS, sigV, signer := signMessageHash(t, hash)
+		instPrice := computebudget.NewSetComputeUnitPriceInstruction(5000).Build()
+		txResult := createGatewayTxResult(t, gatewayID, contracts.IncrementNonceInstructionParams{
+			Discriminator: contracts.DiscriminatorIncrementNonce(),
+			Signature:     sigRS,
+			RecoveryID:    sigV,
+			MessageHash:   hash,
+			Nonce:         2,
+		}, instPrice)
+
+		inst, err := observer.ParseGatewayInstruction(txResult, gatewayID, coin.CoinType_NoAssetCall)
+		require.NoError(t, err)
+
+		sender, err := inst.Signer()
+		require.NoError(t, err)
+		require.Equal(t, signer, sender)
+		require.EqualValues(t, 2, inst.GatewayNonce())
+	})
+
 	t.Run("should return error on unsupported coin type", func(t *testing.T) {
 		// load and unmarshal archived transaction
 		txResult := testutils.LoadSolanaOutboundTxResult(t, TestDataDir, chain.ChainId, txHash)
 
-		inst, err := observer.ParseGatewayInstruction(txResult, gatewayID, coin.CoinType_ERC20)
+		inst, err := observer.ParseGatewayInstruction(txResult, gatewayID, coin.CoinType_Zeta)
 		require.ErrorContains(t, err, "unsupported outbound coin type")
 		require.Nil(t, inst)
 	})
 }
 
+func Test_OutboundStatus(t *testing.T) {
+	chain := chains.SolanaDevnet
+	gatewayID, err := solana.PublicKeyFromBase58(GatewayAddressTest)
+	require.NoError(t, err)
+
+	t.Run("should return success for withdraw", func(t *testing.T) {
+		txResult := testutils.LoadSolanaOutboundTxResult(t, TestDataDir, chain.ChainId, withdrawTxTest)
+		tx, err := txResult.Transaction.GetTransaction()
+		require.NoError(t, err)
+
+		require.Equal(t, chains.ReceiveStatus_success, observer.OutboundStatus(tx))
+	})
+
+	t.Run("should return failed for increment_nonce", func(t *testing.T) {
+		hash := contracts.NewMsgIncrementNonce(1, 2).Hash()
+		sigRS, sigV, _ := signMessageHash(t, hash)
+		txResult := createGatewayTxResult(t, gatewayID, contracts.IncrementNonceInstructionParams{
+			Discriminator: contracts.DiscriminatorIncrementNonce(),
+			Signature:     sigRS,
+			RecoveryID:    sigV,
+			MessageHash:   hash,
+			Nonce:         2,
+		})
+		tx, err := txResult.Transaction.GetTransaction()
+		require.NoError(t, err)
+
+		require.Equal(t, chains.ReceiveStatus_failed, observer.OutboundStatus(tx))
+	})
+}
+
 func Test_ParseInstructionWithdraw(t *testing.T) {
 	// the test chain and transaction hash
 	chain := chains.SolanaDevnet
@@ -122,6 +122,61 @@ func GetSignaturesForAddressUntil(
 	return allSignatures, nil
 }
 
+// GetSignaturesForAddressInSlotRange searches for signatures for the given address in the slot range [fromSlot, toSlot].
+// The signatures are returned from the latest to the oldest.
+// Note: make sure that the rpc provider used has enough transaction history.
+func GetSignaturesForAddressInSlotRange(
+	ctx context.Context,
+	client interfaces.SolanaRPCClient,
+	address solana.PublicKey,
+	fromSlot, toSlot uint64,
+	pageLimit int,
+) ([]*rpc.TransactionSignature, error) {
+	var lastSignature solana.Signature
+	var allSignatures []*rpc.TransactionSignature
+
+	// search backwards until we go below the 'fromSlot'
+	for {
+		fetchedSignatures, err := client.GetSignaturesForAddressWithOpts(
+			ctx,
+			address,
+			&rpc.GetSignaturesForAddressOpts{
+				Limit:      &pageLimit,
+				Before:     lastSignature, // exclusive
+				Commitment: rpc.CommitmentFinalized,
+			},
+		)
+		if err != nil {
+			return nil, errors.Wrapf(
+				err,
+				"error GetSignaturesForAddressWithOpts for address %s",
+				address,
+			)
+		}
+
+		// no more signatures, stop searching
+		if len(fetchedSignatures) == 0 {
+			break
+		}
+
+		// keep the signatures in the slot range
+		for _, sig := range fetchedSignatures {
+			switch {
+			case sig.Slot > toSlot:
+				continue
+			case sig.Slot < fromSlot:
+				return allSignatures, nil
+			}
+			allSignatures = append(allSignatures, sig)
+		}
+
+		// update last signature for next search
+		lastSignature = fetchedSignatures[len(fetchedSignatures)-1].Signature
+	}
+
+	return allSignatures, nil
+}
+
 // CheckRPCStatus checks the RPC status of the solana chain
 func CheckRPCStatus(ctx context.Context, client interfaces.SolanaRPCClient, privnet bool) (time.Time, error) {
 	// query solana health (always return "ok" unless --trusted-validator is provided)
@@ -0,0 +1,305 @@
+package signer
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc/jsonrpc"
+	"github.com/near/borsh-go"
+	"github.com/pkg/errors"
+
+	"github.com/zeta-chain/node/pkg/coin"
+	contracts "github.com/zeta-chain/node/pkg/contracts/solana"
+	"github.com/zeta-chain/node/x/crosschain/types"
+)
+
+// rpcErrorCodeSimulationFailed is the JSON-RPC error code returned by Solana nodes when preflight simulation fails
+const rpcErrorCodeSimulationFailed = -32002
+
+// IsExecuteOutbound returns true if the cctx is a contract call (gateway execute) to a Solana program
+func IsExecuteOutbound(cctx *types.CrossChainTx) bool {
+	if cctx.ProtocolContractVersion != types.ProtocolContractVersion_V2 ||
+		cctx.CctxStatus.Status != types.CctxStatus_PendingOutbound {
+		return false
+	}
+
+	switch cctx.InboundParams.CoinType {
+	case coin.CoinType_NoAssetCall:
+		return true
+	case coin.CoinType_Gas:
+		return cctx.InboundParams.IsCrossChainCall
+	default:
+		return false
+	}
+}
+
+// DecodeExecuteMsg decodes the hex encoded relayed message of the cctx into a gateway execute message
+func DecodeExecuteMsg(cctx *types.CrossChainTx) (contracts.ExecuteMsg, error) {
+	message, err := hex.DecodeString(cctx.RelayedMessage)
+	if err != nil {
+		return contracts.ExecuteMsg{}, errors.Wrapf(err, "cannot decode relayed message %s", cctx.RelayedMessage)
+	}
+
+	return contracts.DecodeExecuteMsg(message)
+}
+
+// SignMsgExecute signs an execute message (for gateway execute instruction) with TSS.
+// The destination program is the cctx receiver, the data and accounts come from the relayed message.
+func (signer *Signer) SignMsgExecute(
+	ctx context.Context,
+	params *types.OutboundParams,
+	height uint64,
+	sender string,
+	executeMsg contracts.ExecuteMsg,
+	cancelTx bool,
+) (*contracts.MsgExecute, error) {
+	chain := signer.Chain()
+	// #nosec G115 always positive
+	chainID := uint64(signer.Chain().ChainId)
+	nonce := params.TssNonce
+	amount := params.Amount.Uint64()
+
+	// zero out the amount if cancelTx is set. It's legal to execute with 0 lamports thru the gateway.
+	if cancelTx {
+		amount = 0
+	}
+
+	// check destination program address
+	to, err := solana.PublicKeyFromBase58(params.Receiver)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot decode destination program address %s", params.Receiver)
+	}
+
+	// prepare execute msg and compute hash
+	msg := contracts.NewMsgExecute(
+		chainID,
+		nonce,
+		amount,
+		to,
+		ethcommon.HexToAddress(sender),
+		executeMsg.Data,
+		executeMsg.RemainingAccounts(),
+	)
+	msgHash := msg.Hash()
+
+	// sign the message with TSS to get an ECDSA signature.
+	// the produced signature is in the [R || S || V] format where V is 0 or 1.
+	signature, err := signer.TSS().Sign(ctx, msgHash[:], height, nonce, chain.ChainId, "")
+	if err != nil {
+		return nil, errors.Wrap(err, "Key-sign failed")
+	}
+	signer.Logger().Std.Info().Msgf("Key-sign succeed for chain %d nonce %d", chainID, nonce)
+
+	// attach the signature and return
+	return msg.SetSignature(signature), nil
+}
+
+// signMsgsExecute signs the execute message and the increment_nonce fallback message with TSS.
+// If the cctx can't be executed (e.g. invalid relayed message), only the increment_nonce message is signed
+// so the outbound fails and gets reverted.
+func (signer *Signer) signMsgsExecute(
+	ctx context.Context,
+	cctx *types.CrossChainTx,
+	height uint64,
+	cancelTx bool,
+) (*contracts.MsgExecute, *contracts.MsgIncrementNonce, error) {
+	params := cctx.GetCurrentOutboundParam()
+
+	// sign the execute message if the cctx is executable
+	var msgExecute *contracts.MsgExecute
+	executeMsg, err := DecodeExecuteMsg(cctx)
+	if err == nil {
+		_, err = solana.PublicKeyFromBase58(params.Receiver)
+	}
+	if err != nil {
+		signer.Logger().Std.Warn().Err(err).Msgf("cctx %s can't be executed, outbound will fail", cctx.Index)
+	} else {
+		msgExecute, err = signer.SignMsgExecute(ctx, params, height, cctx.InboundParams.Sender, executeMsg, cancelTx)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	// sign the increment_nonce message used as fallback
+	msgIncrementNonce, err := signer.SignMsgIncrementNonce(ctx, params, height)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return msgExecute, msgIncrementNonce, nil
+}
+
+// SignMsgIncrementNonce signs an increment_nonce message (for gateway increment_nonce instruction) with TSS.
+func (signer *Signer) SignMsgIncrementNonce(
+	ctx context.Context,
+	params *types.OutboundParams,
+	height uint64,
+) (*contracts.MsgIncrementNonce, error) {
+	chain := signer.Chain()
+	// #nosec G115 always positive
+	chainID := uint64(signer.Chain().ChainId)
+	nonce := params.TssNonce
+
+	// prepare increment_nonce msg and compute hash
+	msg := contracts.NewMsgIncrementNonce(chainID, nonce)
+	msgHash := msg.Hash()
+
+	// sign the message with TSS to get an ECDSA signature.
+	// the produced signature is in the [R || S || V] format where V is 0 or 1.
+	signature, err := signer.TSS().Sign(ctx, msgHash[:], height, nonce, chain.ChainId, "")
+	if err != nil {
+		return nil, errors.Wrap(err, "Key-sign failed")
+	}
+	signer.Logger().Std.Info().Msgf("Key-sign succeed for chain %d nonce %d", chainID, nonce)
+
+	// attach the signature and return
+	return msg.SetSignature(signature), nil
+}
+
+// SignExecuteTx wraps the execute 'msg' into a Solana transaction and signs it with the relayer key.
+func (signer *Signer) SignExecuteTx(
+	ctx context.Context,
+	msg contracts.MsgExecute,
+	priorityFee uint64,
+) (*solana.Transaction, error) {
+	// create execute instruction with program call data
+	var err error
+	var inst solana.GenericInstruction
+	inst.DataBytes, err = borsh.Serialize(contracts.ExecuteInstructionParams{
+		Discriminator: contracts.DiscriminatorExecute(),
+		Amount:        msg.Amount(),
+		Sender:        msg.Sender(),
+		Data:          msg.Data(),
+		Signature:     msg.SigRS(),
+		RecoveryID:    msg.SigV(),
+		MessageHash:   msg.Hash(),
+		Nonce:         msg.Nonce(),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot serialize execute instruction")
+	}
+
+	// the relayer account must not be passed to the destination program as it signs the transaction
+	privkey := signer.relayerKey
+	if err := checkRemainingAccounts(msg.RemainingAccounts(), privkey.PublicKey()); err != nil {
+		return nil, err
+	}
+
+	// attach required accounts to the instruction
+	attachExecuteAccounts(&inst, privkey.PublicKey(), signer.pda, msg.To(), msg.RemainingAccounts(), signer.gatewayID)
+
+	return signer.signTx(ctx, []solana.Instruction{&inst}, priorityFee)
+}
+
+// SignIncrementNonceTx wraps the increment_nonce 'msg' into a Solana transaction and signs it with the relayer key.
+func (signer *Signer) SignIncrementNonceTx(
+	ctx context.Context,
+	msg contracts.MsgIncrementNonce,
+	priorityFee uint64,
+) (*solana.Transaction, error) {
+	// create increment_nonce instruction with program call data
+	var err error
+	var inst solana.GenericInstruction
+	inst.DataBytes, err = borsh.Serialize(contracts.IncrementNonceInstructionParams{
+		Discriminator: contracts.DiscriminatorIncrementNonce(),
+		Signature:     msg.SigRS(),
+		RecoveryID:    msg.SigV(),
+		MessageHash:   msg.Hash(),
+		Nonce:         msg.Nonce(),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot serialize increment_nonce instruction")
+	}
+
+	// attach required accounts to the instruction
+	privkey := signer.relayerKey
+	inst.ProgID = signer.gatewayID
+	inst.AccountValues = []*solana.AccountMeta{
+		solana.Meta(privkey.PublicKey()).WRITE().SIGNER(),
+		solana.Meta(signer.pda).WRITE(),
+	}
+
+	return signer.signTx(ctx, []solana.Instruction{&inst}, priorityFee)
+}
+
+// signExecuteTxs signs the execute transaction and the increment_nonce fallback transaction with relayer key.
+// The increment_nonce transaction is returned as the outbound transaction if the execute can't be relayed.
+func (signer *Signer) signExecuteTxs(
+	ctx context.Context,
+	msgExecute *contracts.MsgExecute,
+	msgIncrementNonce *contracts.MsgIncrementNonce,
+	priorityFee uint64,
+) (tx *solana.Transaction, fallbackTx *solana.Transaction, err error) {
+	fallbackTx, err = signer.SignIncrementNonceTx(ctx, *msgIncrementNonce, priorityFee)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// no execute message, the nonce is consumed right away
+	if msgExecute == nil {
+		return fallbackTx, nil, nil
+	}
+
+	// the execute can't be relayed if the relayer account is passed to the destination program
+	if err := checkRemainingAccounts(msgExecute.RemainingAccounts(), signer.relayerKey.PublicKey()); err != nil {
+		signer.Logger().Std.Warn().Err(err).Msgf("execute can't be relayed for nonce %d", msgExecute.Nonce())
+		return fallbackTx, nil, nil
+	}
+
+	tx, err = signer.SignExecuteTx(ctx, *msgExecute, priorityFee)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return tx, fallbackTx, nil
+}
+
+// attachExecuteAccounts attaches the required accounts for the gateway execute instruction.
+// The remaining accounts are passed through to the destination program.
+func attachExecuteAccounts(
+	inst *solana.GenericInstruction,
+	signer solana.PublicKey,
+	pda solana.PublicKey,
+	destinationProgram solana.PublicKey,
+	remainingAccounts []*solana.AccountMeta,
+	gatewayID solana.PublicKey,
+) {
+	// attach required accounts to the instruction
+	var accountSlice []*solana.AccountMeta
+	accountSlice = append(accountSlice, solana.Meta(signer).WRITE().SIGNER())
+	accountSlice = append(accountSlice, solana.Meta(pda).WRITE())
+	accountSlice = append(accountSlice, solana.Meta(destinationProgram))
+	accountSlice = append(accountSlice, remainingAccounts...)
+	inst.ProgID = gatewayID
+
+	inst.AccountValues = accountSlice
+}
+
+// checkRemainingAccounts checks that the relayer account is not passed to the destination program.
+// The relayer signs the transaction, passing it would allow the destination program to spend relayer funds.
+func checkRemainingAccounts(remainingAccounts []*solana.AccountMeta, relayer solana.PublicKey) error {
+	for _, account := range remainingAccounts {
+		if account.PublicKey.Equals(relayer) {
+			return fmt.Errorf("relayer account %s can't be passed to destination program", account.PublicKey)
+		}
+	}
+	return nil
+}
+
+// isExecuteFailure returns true if the broadcast error indicates that the gateway 'execute' instruction
+// fails in preflight simulation, e.g. the destination program returns an error.
+// A nonce mismatch means the nonce is already consumed, so the outbound must not be replaced by 'increment_nonce'.
+func isExecuteFailure(err error) bool {
+	var rpcErr *jsonrpc.RPCError
+	if !errors.As(err, &rpcErr) || rpcErr.Code != rpcErrorCodeSimulationFailed {
+		return false
+	}
+
+	errMsg := rpcErr.Error()
+	return strings.Contains(errMsg, "Error processing Instruction") &&
+		!strings.Contains(errMsg, contracts.ErrorNonceMismatch)
+}
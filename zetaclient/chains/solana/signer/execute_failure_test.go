@@ -0,0 +1,59 @@
+package signer
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/gagliardetto/solana-go/rpc/jsonrpc"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_isExecuteFailure(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{
+			name: "destination program error",
+			err: &jsonrpc.RPCError{
+				Code:    rpcErrorCodeSimulationFailed,
+				Message: "Transaction simulation failed: Error processing Instruction 0: custom program error: 0x1",
+			},
+			want: true,
+		},
+		{
+			name: "nonce mismatch",
+			err: &jsonrpc.RPCError{
+				Code:    rpcErrorCodeSimulationFailed,
+				Message: "Transaction simulation failed: Error processing Instruction 0: custom program error: 0x1772",
+				Data:    map[string]any{"logs": []string{"Program log: AnchorError. Error Code: NonceMismatch."}},
+			},
+			want: false,
+		},
+		{
+			name: "insufficient relayer funds",
+			err: &jsonrpc.RPCError{
+				Code:    rpcErrorCodeSimulationFailed,
+				Message: "Transaction simulation failed: Attempt to debit an account but found no record of a prior credit.",
+			},
+			want: false,
+		},
+		{
+			name: "other RPC error",
+			err:  &jsonrpc.RPCError{Code: -32005, Message: "Node is unhealthy"},
+			want: false,
+		},
+		{
+			name: "network error",
+			err:  errors.New("connection refused"),
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, isExecuteFailure(tt.err))
+		})
+	}
+}
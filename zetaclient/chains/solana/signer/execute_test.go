@@ -0,0 +1,189 @@
+package signer_test
+
+import (
+	"context"
+	"encoding/hex"
+	"testing"
+
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/node/pkg/chains"
+	"github.com/zeta-chain/node/pkg/coin"
+	contracts "github.com/zeta-chain/node/pkg/contracts/solana"
+	"github.com/zeta-chain/node/pkg/crypto"
+	"github.com/zeta-chain/node/testutil/sample"
+	crosschaintypes "github.com/zeta-chain/node/x/crosschain/types"
+	"github.com/zeta-chain/node/zetaclient/chains/base"
+	"github.com/zeta-chain/node/zetaclient/chains/solana/signer"
+	"github.com/zeta-chain/node/zetaclient/keys"
+	"github.com/zeta-chain/node/zetaclient/testutils"
+	"github.com/zeta-chain/node/zetaclient/testutils/mocks"
+)
+
+func Test_IsExecuteOutbound(t *testing.T) {
+	newCctx := func(
+		version crosschaintypes.ProtocolContractVersion,
+		status crosschaintypes.CctxStatus,
+		coinType coin.CoinType,
+		isCall bool,
+	) *crosschaintypes.CrossChainTx {
+		cctx := sample.CrossChainTx(t, "index")
+		cctx.ProtocolContractVersion = version
+		cctx.CctxStatus.Status = status
+		cctx.InboundParams.CoinType = coinType
+		cctx.InboundParams.IsCrossChainCall = isCall
+		return cctx
+	}
+
+	v2 := crosschaintypes.ProtocolContractVersion_V2
+	pending := crosschaintypes.CctxStatus_PendingOutbound
+
+	tests := []struct {
+		name string
+		cctx *crosschaintypes.CrossChainTx
+		want bool
+	}{
+		{
+			name: "no asset call",
+			cctx: newCctx(v2, pending, coin.CoinType_NoAssetCall, false),
+			want: true,
+		},
+		{
+			name: "gas withdraw and call",
+			cctx: newCctx(v2, pending, coin.CoinType_Gas, true),
+			want: true,
+		},
+		{
+			name: "gas withdraw",
+			cctx: newCctx(v2, pending, coin.CoinType_Gas, false),
+			want: false,
+		},
+		{
+			name: "SPL withdraw and call is not supported",
+			cctx: newCctx(v2, pending, coin.CoinType_ERC20, true),
+			want: false,
+		},
+		{
+			name: "revert is a withdraw",
+			cctx: newCctx(v2, crosschaintypes.CctxStatus_PendingRevert, coin.CoinType_Gas, true),
+			want: false,
+		},
+		{
+			name: "protocol contract v1",
+			cctx: newCctx(crosschaintypes.ProtocolContractVersion_V1, pending, coin.CoinType_NoAssetCall, false),
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, signer.IsExecuteOutbound(tt.cctx))
+		})
+	}
+}
+
+func Test_DecodeExecuteMsg(t *testing.T) {
+	executeMsg := contracts.ExecuteMsg{
+		Accounts: []contracts.AccountMeta{{PublicKey: solana.MustPublicKeyFromBase58(sample.SolanaAddress(t))}},
+		Data:     []byte("hello"),
+	}
+	message, err := contracts.EncodeExecuteMsg(executeMsg)
+	require.NoError(t, err)
+
+	t.Run("should decode hex encoded relayed message", func(t *testing.T) {
+		cctx := sample.CrossChainTx(t, "index")
+		cctx.RelayedMessage = hex.EncodeToString(message)
+
+		decoded, err := signer.DecodeExecuteMsg(cctx)
+		require.NoError(t, err)
+		require.Equal(t, executeMsg, decoded)
+	})
+
+	t.Run("should fail on non hex relayed message", func(t *testing.T) {
+		cctx := sample.CrossChainTx(t, "index")
+		cctx.RelayedMessage = "not hex"
+
+		_, err := signer.DecodeExecuteMsg(cctx)
+		require.ErrorContains(t, err, "cannot decode relayed message")
+	})
+}
+
+func Test_SignExecuteTx(t *testing.T) {
+	// test parameters
+	chain := chains.SolanaDevnet
+	chainParams := sample.ChainParams(chain.ChainId)
+	chainParams.GatewayAddress = testutils.GatewayAddresses[chain.ChainId]
+	relayerKey := &keys.RelayerKey{
+		PrivateKey: "3EMjCcCJg53fMEGVj13UPQpo6py9AKKyLE2qroR4yL1SvAN2tUznBvDKRYjntw7m6Jof1R2CSqjTddL27rEb6sFQ",
+	}
+	relayer, err := crypto.SolanaPrivateKeyFromString(relayerKey.PrivateKey)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	// mock solana client
+	client := mocks.NewSolanaRPCClient(t)
+	client.On("GetLatestBlockhash", mock.Anything, rpc.CommitmentFinalized).
+		Return(&rpc.GetLatestBlockhashResult{Value: &rpc.LatestBlockhashResult{}}, nil).Maybe()
+
+	s, err := signer.NewSigner(chain, *chainParams, client, nil, relayerKey, nil, base.DefaultLogger())
+	require.NoError(t, err)
+
+	program := solana.MustPublicKeyFromBase58(sample.SolanaAddress(t))
+	account := solana.MustPublicKeyFromBase58(sample.SolanaAddress(t))
+
+	t.Run("should sign execute tx with remaining accounts", func(t *testing.T) {
+		msg := contracts.NewMsgExecute(1, 2, 1000, program, ethcommon.Address{}, []byte("hello"),
+			[]*solana.AccountMeta{solana.Meta(account).WRITE()})
+
+		tx, err := s.SignExecuteTx(ctx, *msg, 0)
+		require.NoError(t, err)
+		require.Len(t, tx.Message.Instructions, 1)
+
+		// [signer, pda, destination program, remaining accounts...]
+		accounts, err := tx.Message.Instructions[0].ResolveInstructionAccounts(&tx.Message)
+		require.NoError(t, err)
+		require.Len(t, accounts, 4)
+		require.Equal(t, relayer.PublicKey(), accounts[0].PublicKey)
+		require.Equal(t, program, accounts[2].PublicKey)
+		require.Equal(t, account, accounts[3].PublicKey)
+		require.True(t, accounts[3].IsWritable)
+	})
+
+	t.Run("should fail if relayer account is passed to destination program", func(t *testing.T) {
+		msg := contracts.NewMsgExecute(1, 2, 1000, program, ethcommon.Address{}, []byte("hello"),
+			[]*solana.AccountMeta{solana.Meta(relayer.PublicKey())})
+
+		tx, err := s.SignExecuteTx(ctx, *msg, 0)
+		require.ErrorContains(t, err, "can't be passed to destination program")
+		require.Nil(t, tx)
+	})
+
+	t.Run("should sign increment_nonce tx", func(t *testing.T) {
+		tx, err := s.SignIncrementNonceTx(ctx, *contracts.NewMsgIncrementNonce(1, 2), 0)
+		require.NoError(t, err)
+		require.Len(t, tx.Message.Instructions, 1)
+
+		inst, err := contracts.ParseInstructionIncrementNonce(tx.Message.Instructions[0])
+		require.NoError(t, err)
+		require.EqualValues(t, 2, inst.GatewayNonce())
+	})
+
+	t.Run("should set compute unit price if priority fee is set", func(t *testing.T) {
+		tx, err := s.SignIncrementNonceTx(ctx, *contracts.NewMsgIncrementNonce(1, 2), 5000)
+		require.NoError(t, err)
+		require.Len(t, tx.Message.Instructions, 2)
+
+		// compute unit price comes first, gateway instruction last
+		programID, err := tx.Message.Program(tx.Message.Instructions[0].ProgramIDIndex)
+		require.NoError(t, err)
+		require.Equal(t, solana.ComputeBudget, programID)
+
+		inst, err := contracts.ParseInstructionIncrementNonce(tx.Message.Instructions[1])
+		require.NoError(t, err)
+		require.EqualValues(t, 2, inst.GatewayNonce())
+	})
+}
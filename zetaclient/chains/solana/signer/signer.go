@@ -96,7 +96,7 @@ func (signer *Signer) TryProcessOutbound(
 	cctx *types.CrossChainTx,
 	outboundProc *outboundprocessor.Processor,
 	outboundID string,
-	_ interfaces.ChainObserver,
+	chainObserver interfaces.ChainObserver,
 	zetacoreClient interfaces.ZetacoreClient,
 	height uint64,
 ) {
@@ -117,19 +117,25 @@ func (signer *Signer) TryProcessOutbound(
 		Str("cctx", cctx.Index).
 		Logger()
 
-	// support gas token only for Solana outbound
+	// support gas token, SPL token and contract call for Solana outbound
 	chainID := signer.Chain().ChainId
 	nonce := params.TssNonce
 	coinType := cctx.InboundParams.CoinType
-	if coinType != coin.CoinType_Gas {
+	isExecute := IsExecuteOutbound(cctx)
+	if coinType != coin.CoinType_Gas && coinType != coin.CoinType_ERC20 && !isExecute {
 		logger.Error().
-			Msgf("TryProcessOutbound: can only send SOL to the Solana network for chain %d nonce %d", chainID, nonce)
+			Msgf("TryProcessOutbound: can only send SOL or SPL token to the Solana network for chain %d nonce %d",
+				chainID, nonce)
 		return
 	}
 
 	// compliance check
 	cancelTx := compliance.IsCctxRestricted(cctx)
 	if cancelTx {
+		token := "SOL"
+		if coinType == coin.CoinType_ERC20 {
+			token = cctx.InboundParams.Asset
+		}
 		compliance.PrintComplianceLog(
 			logger,
 			signer.Logger().Compliance,
@@ -138,15 +144,37 @@ func (signer *Signer) TryProcessOutbound(
 			cctx.Index,
 			cctx.InboundParams.Sender,
 			params.Receiver,
-			"SOL",
+			token,
 		)
 	}
 
-	// sign gateway withdraw message by TSS
-	msg, err := signer.SignMsgWithdraw(ctx, params, height, cancelTx)
-	if err != nil {
-		logger.Error().Err(err).Msgf("TryProcessOutbound: SignMsgWithdraw error for chain %d nonce %d", chainID, nonce)
-		return
+	// sign gateway message(s) by TSS
+	var (
+		msg               *contracts.MsgWithdraw
+		msgSPL            *contracts.MsgWithdrawSPL
+		msgExecute        *contracts.MsgExecute
+		msgIncrementNonce *contracts.MsgIncrementNonce
+		err               error
+	)
+	switch {
+	case isExecute:
+		msgExecute, msgIncrementNonce, err = signer.signMsgsExecute(ctx, cctx, height, cancelTx)
+		if err != nil {
+			logger.Error().Err(err).Msgf("TryProcessOutbound: SignMsgExecute error for chain %d nonce %d", chainID, nonce)
+			return
+		}
+	case coinType == coin.CoinType_ERC20:
+		msgSPL, err = signer.SignMsgWithdrawSPL(ctx, params, height, cctx.InboundParams.Asset, cancelTx)
+		if err != nil {
+			logger.Error().Err(err).Msgf("TryProcessOutbound: SignMsgWithdrawSPL error for chain %d nonce %d", chainID, nonce)
+			return
+		}
+	default:
+		msg, err = signer.SignMsgWithdraw(ctx, params, height, cancelTx)
+		if err != nil {
+			logger.Error().Err(err).Msgf("TryProcessOutbound: SignMsgWithdraw error for chain %d nonce %d", chainID, nonce)
+			return
+		}
 	}
 
 	// skip relaying the transaction if this signer hasn't set the relayer key
@@ -157,24 +185,37 @@ func (signer *Signer) TryProcessOutbound(
 	// set relayer balance metrics
 	signer.SetRelayerBalanceMetrics(ctx)
 
-	// sign the withdraw transaction by relayer key
-	tx, err := signer.SignWithdrawTx(ctx, *msg)
+	// the priority fee of a pending outbound is increased by zetacore, so the tx is re-signed with the new price on retry
+	priorityFee, err := params.GetGasPriorityFeeUInt64()
+	if err != nil {
+		logger.Error().Err(err).Msgf("TryProcessOutbound: invalid priority fee for chain %d nonce %d", chainID, nonce)
+		return
+	}
+
+	// sign the outbound transaction by relayer key
+	// the fallback transaction consumes the nonce if the contract call fails, so the outbound can be reverted
+	var tx, fallbackTx *solana.Transaction
+	switch {
+	case isExecute:
+		tx, fallbackTx, err = signer.signExecuteTxs(ctx, msgExecute, msgIncrementNonce, priorityFee)
+	case msgSPL != nil:
+		tx, err = signer.SignWithdrawSPLTx(ctx, *msgSPL, priorityFee)
+	default:
+		tx, err = signer.SignWithdrawTx(ctx, *msg, priorityFee)
+	}
 	if err != nil {
-		logger.Error().Err(err).Msgf("TryProcessOutbound: SignGasWithdraw error for chain %d nonce %d", chainID, nonce)
+		logger.Error().Err(err).Msgf("TryProcessOutbound: SignWithdrawTx error for chain %d nonce %d", chainID, nonce)
 		return
 	}
 
 	// broadcast the signed tx to the Solana network with preflight check
-	txSig, err := signer.client.SendTransactionWithOpts(
-		ctx,
-		tx,
-		// Commitment "finalized" is too conservative for preflight check and
-		// it results in repeated broadcast attempts that only 1 will succeed.
-		// Commitment "processed" will simulate tx against more recent state
-		// thus fails faster once a tx is already broadcasted and processed by the cluster.
-		// This reduces the number of "failed" txs due to repeated broadcast attempts.
-		rpc.TransactionOpts{PreflightCommitment: rpc.CommitmentProcessed},
-	)
+	txSig, err := signer.broadcast(ctx, tx)
+	if err != nil && fallbackTx != nil && isExecuteFailure(err) {
+		logger.Warn().Err(err).Msgf("TryProcessOutbound: execute failed, broadcasting increment_nonce for chain %d nonce %d",
+			chainID, nonce)
+		tx = fallbackTx
+		txSig, err = signer.broadcast(ctx, tx)
+	}
 	if err != nil {
 		signer.Logger().
 			Std.Warn().
@@ -183,8 +224,38 @@ func (signer *Signer) TryProcessOutbound(
 		return
 	}
 
+	// record the broadcast tx, so the tracker can be reported after a restart
+	recorder := base.OutboundRecorder(chainObserver)
+	if err := saveOutboundBroadcast(recorder, nonce, tx); err != nil {
+		logger.Error().Err(err).Msgf("TryProcessOutbound: unable to save outbound %s to db", txSig)
+	}
+
 	// report the outbound to the outbound tracker
-	signer.reportToOutboundTracker(ctx, zetacoreClient, chainID, nonce, txSig, logger)
+	signer.reportToOutboundTracker(ctx, zetacoreClient, recorder, chainID, nonce, txSig, logger)
+}
+
+// saveOutboundBroadcast records the broadcast outbound tx with the given recorder
+func saveOutboundBroadcast(recorder interfaces.OutboundRecorder, nonce uint64, tx *solana.Transaction) error {
+	txBytes, err := tx.MarshalBinary()
+	if err != nil {
+		return errors.Wrap(err, "unable to marshal tx")
+	}
+
+	return recorder.SaveOutboundBroadcast(nonce, tx.Signatures[0].String(), txBytes)
+}
+
+// broadcast broadcasts the signed tx to the Solana network with preflight check
+func (signer *Signer) broadcast(ctx context.Context, tx *solana.Transaction) (solana.Signature, error) {
+	return signer.client.SendTransactionWithOpts(
+		ctx,
+		tx,
+		// Commitment "finalized" is too conservative for preflight check and
+		// it results in repeated broadcast attempts that only 1 will succeed.
+		// Commitment "processed" will simulate tx against more recent state
+		// thus fails faster once a tx is already broadcasted and processed by the cluster.
+		// This reduces the number of "failed" txs due to repeated broadcast attempts.
+		rpc.TransactionOpts{PreflightCommitment: rpc.CommitmentProcessed},
+	)
 }
 
 // SetGatewayAddress sets the gateway address
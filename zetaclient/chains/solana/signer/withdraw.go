@@ -5,6 +5,7 @@ import (
 
 	"cosmossdk.io/errors"
 	"github.com/gagliardetto/solana-go"
+	computebudget "github.com/gagliardetto/solana-go/programs/compute-budget"
 	"github.com/gagliardetto/solana-go/rpc"
 	"github.com/near/borsh-go"
 
@@ -54,7 +55,11 @@ func (signer *Signer) SignMsgWithdraw(
 }
 
 // SignWithdrawTx wraps the withdraw 'msg' into a Solana transaction and signs it with the relayer key.
-func (signer *Signer) SignWithdrawTx(ctx context.Context, msg contracts.MsgWithdraw) (*solana.Transaction, error) {
+func (signer *Signer) SignWithdrawTx(
+	ctx context.Context,
+	msg contracts.MsgWithdraw,
+	priorityFee uint64,
+) (*solana.Transaction, error) {
 	// create withdraw instruction with program call data
 	var err error
 	var inst solana.GenericInstruction
@@ -74,20 +79,37 @@ func (signer *Signer) SignWithdrawTx(ctx context.Context, msg contracts.MsgWithd
 	privkey := signer.relayerKey
 	attachWithdrawAccounts(&inst, privkey.PublicKey(), signer.pda, msg.To(), signer.gatewayID)
 
+	return signer.signTx(ctx, []solana.Instruction{&inst}, priorityFee)
+}
+
+// signTx wraps the instructions into a Solana transaction and signs it with the relayer key.
+// The compute unit price is set to the given priority fee (in micro-lamports) if not zero.
+func (signer *Signer) signTx(
+	ctx context.Context,
+	instructions []solana.Instruction,
+	priorityFee uint64,
+) (*solana.Transaction, error) {
+	privkey := signer.relayerKey
+
 	// get a recent blockhash
 	recent, err := signer.client.GetLatestBlockhash(ctx, rpc.CommitmentFinalized)
 	if err != nil {
 		return nil, errors.Wrap(err, "GetLatestBlockhash error")
 	}
 
-	// create a transaction that wraps the instruction
+	// the priority fee is increased by zetacore if the outbound is pending for too long,
+	// the compute unit price instruction has to come first so the gateway instruction can be located
+	if priorityFee > 0 {
+		instPrice, err := computebudget.NewSetComputeUnitPriceInstruction(priorityFee).ValidateAndBuild()
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot create compute unit price instruction")
+		}
+		instructions = append([]solana.Instruction{instPrice}, instructions...)
+	}
+
+	// create a transaction that wraps the instructions
 	tx, err := solana.NewTransaction(
-		[]solana.Instruction{
-			// TODO: outbound now uses 5K lamports as the fixed fee, we could explore priority fee and compute budget
-			// https://github.com/zeta-chain/node/issues/2599
-			// programs.ComputeBudgetSetComputeUnitLimit(computeUnitLimit),
-			// programs.ComputeBudgetSetComputeUnitPrice(computeUnitPrice),
-			&inst},
+		instructions,
 		recent.Value.Blockhash,
 		solana.TransactionPayer(privkey.PublicKey()),
 	)
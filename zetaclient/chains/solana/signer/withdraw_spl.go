@@ -0,0 +1,149 @@
+package signer
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	"github.com/gagliardetto/solana-go"
+	ata "github.com/gagliardetto/solana-go/programs/associated-token-account"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/near/borsh-go"
+
+	"github.com/zeta-chain/node/pkg/chains"
+	contracts "github.com/zeta-chain/node/pkg/contracts/solana"
+	"github.com/zeta-chain/node/x/crosschain/types"
+)
+
+// SignMsgWithdrawSPL signs a withdraw_spl_token message (for gateway withdraw_spl_token instruction) with TSS.
+func (signer *Signer) SignMsgWithdrawSPL(
+	ctx context.Context,
+	params *types.OutboundParams,
+	height uint64,
+	asset string,
+	cancelTx bool,
+) (*contracts.MsgWithdrawSPL, error) {
+	chain := signer.Chain()
+	// #nosec G115 always positive
+	chainID := uint64(signer.Chain().ChainId)
+	nonce := params.TssNonce
+	amount := params.Amount.Uint64()
+
+	// zero out the amount if cancelTx is set. It's legal to withdraw 0 tokens thru the gateway.
+	if cancelTx {
+		amount = 0
+	}
+
+	// check receiver address
+	to, err := chains.DecodeSolanaWalletAddress(params.Receiver)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot decode receiver address %s", params.Receiver)
+	}
+
+	// the asset of a SPL token is the mint account address
+	mintAccount, err := solana.PublicKeyFromBase58(asset)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot decode mint account address %s", asset)
+	}
+
+	// prepare withdraw_spl_token msg and compute hash
+	msg, err := contracts.NewMsgWithdrawSPL(chainID, nonce, amount, mintAccount, to)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot create withdraw_spl_token message")
+	}
+	msgHash := msg.Hash()
+
+	// sign the message with TSS to get an ECDSA signature.
+	// the produced signature is in the [R || S || V] format where V is 0 or 1.
+	signature, err := signer.TSS().Sign(ctx, msgHash[:], height, nonce, chain.ChainId, "")
+	if err != nil {
+		return nil, errors.Wrap(err, "Key-sign failed")
+	}
+	signer.Logger().Std.Info().Msgf("Key-sign succeed for chain %d nonce %d", chainID, nonce)
+
+	// attach the signature and return
+	return msg.SetSignature(signature), nil
+}
+
+// SignWithdrawSPLTx wraps the withdraw_spl_token 'msg' into a Solana transaction and signs it with the relayer key.
+// If the recipient's associated token account does not exist yet, the transaction creates it first (paid by relayer).
+func (signer *Signer) SignWithdrawSPLTx(
+	ctx context.Context,
+	msg contracts.MsgWithdrawSPL,
+	priorityFee uint64,
+) (*solana.Transaction, error) {
+	// create withdraw_spl_token instruction with program call data
+	var err error
+	var inst solana.GenericInstruction
+	inst.DataBytes, err = borsh.Serialize(contracts.WithdrawSPLInstructionParams{
+		Discriminator: contracts.DiscriminatorWithdrawSPL(),
+		Amount:        msg.Amount(),
+		Signature:     msg.SigRS(),
+		RecoveryID:    msg.SigV(),
+		MessageHash:   msg.Hash(),
+		Nonce:         msg.Nonce(),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot serialize withdraw_spl_token instruction")
+	}
+
+	// the gateway PDA holds the SPL tokens in its own associated token account
+	pdaAta, err := contracts.FindAssociatedTokenAccount(signer.pda, msg.MintAccount())
+	if err != nil {
+		return nil, err
+	}
+
+	// attach required accounts to the instruction
+	privkey := signer.relayerKey
+	attachWithdrawSPLAccounts(&inst, privkey.PublicKey(), signer.pda, pdaAta, msg.RecipientAta(), signer.gatewayID)
+
+	// create the recipient's associated token account if it doesn't exist yet
+	instructions := make([]solana.Instruction, 0, 2)
+	exists, err := signer.accountExists(ctx, msg.RecipientAta())
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot check recipient ATA %s", msg.RecipientAta())
+	}
+	if !exists {
+		instCreate, err := ata.NewCreateInstruction(privkey.PublicKey(), msg.To(), msg.MintAccount()).ValidateAndBuild()
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot create associated token account instruction")
+		}
+		instructions = append(instructions, instCreate)
+	}
+	instructions = append(instructions, &inst)
+
+	return signer.signTx(ctx, instructions, priorityFee)
+}
+
+// accountExists returns true if the given account exists on the Solana chain
+func (signer *Signer) accountExists(ctx context.Context, account solana.PublicKey) (bool, error) {
+	_, err := signer.client.GetAccountInfo(ctx, account)
+	switch {
+	case errors.IsOf(err, rpc.ErrNotFound):
+		return false, nil
+	case err != nil:
+		return false, err
+	default:
+		return true, nil
+	}
+}
+
+// attachWithdrawSPLAccounts attaches the required accounts for the gateway withdraw_spl_token instruction.
+func attachWithdrawSPLAccounts(
+	inst *solana.GenericInstruction,
+	signer solana.PublicKey,
+	pda solana.PublicKey,
+	pdaAta solana.PublicKey,
+	recipientAta solana.PublicKey,
+	gatewayID solana.PublicKey,
+) {
+	// attach required accounts to the instruction
+	var accountSlice []*solana.AccountMeta
+	accountSlice = append(accountSlice, solana.Meta(signer).WRITE().SIGNER())
+	accountSlice = append(accountSlice, solana.Meta(pda).WRITE())
+	accountSlice = append(accountSlice, solana.Meta(pdaAta).WRITE())
+	accountSlice = append(accountSlice, solana.Meta(recipientAta).WRITE())
+	accountSlice = append(accountSlice, solana.Meta(solana.TokenProgramID))
+	inst.ProgID = gatewayID
+
+	inst.AccountValues = accountSlice
+}
@@ -0,0 +1,80 @@
+package signer_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/node/pkg/chains"
+	contracts "github.com/zeta-chain/node/pkg/contracts/solana"
+	"github.com/zeta-chain/node/testutil/sample"
+	"github.com/zeta-chain/node/zetaclient/chains/base"
+	"github.com/zeta-chain/node/zetaclient/chains/solana/signer"
+	"github.com/zeta-chain/node/zetaclient/keys"
+	"github.com/zeta-chain/node/zetaclient/testutils"
+	"github.com/zeta-chain/node/zetaclient/testutils/mocks"
+)
+
+func Test_SignWithdrawSPLTx(t *testing.T) {
+	// test parameters
+	chain := chains.SolanaDevnet
+	chainParams := sample.ChainParams(chain.ChainId)
+	chainParams.GatewayAddress = testutils.GatewayAddresses[chain.ChainId]
+	relayerKey := &keys.RelayerKey{
+		PrivateKey: "3EMjCcCJg53fMEGVj13UPQpo6py9AKKyLE2qroR4yL1SvAN2tUznBvDKRYjntw7m6Jof1R2CSqjTddL27rEb6sFQ",
+	}
+	ctx := context.Background()
+
+	// create withdraw_spl_token message
+	mint := solana.MustPublicKeyFromBase58(sample.SolanaAddress(t))
+	to := solana.MustPublicKeyFromBase58(sample.SolanaAddress(t))
+	// #nosec G115 always positive
+	msg, err := contracts.NewMsgWithdrawSPL(uint64(chain.ChainId), 1, 1000, mint, to)
+	require.NoError(t, err)
+
+	// newSigner creates a signer with a mocked client returning given recipient ATA info
+	newSigner := func(ataErr error) *signer.Signer {
+		client := mocks.NewSolanaRPCClient(t)
+		client.On("GetAccountInfo", mock.Anything, msg.RecipientAta()).Return(&rpc.GetAccountInfoResult{}, ataErr)
+		client.On("GetLatestBlockhash", mock.Anything, rpc.CommitmentFinalized).
+			Return(&rpc.GetLatestBlockhashResult{Value: &rpc.LatestBlockhashResult{}}, nil).Maybe()
+
+		s, err := signer.NewSigner(chain, *chainParams, client, nil, relayerKey, nil, base.DefaultLogger())
+		require.NoError(t, err)
+		return s
+	}
+
+	t.Run("should sign withdraw_spl_token tx", func(t *testing.T) {
+		tx, err := newSigner(nil).SignWithdrawSPLTx(ctx, *msg, 0)
+		require.NoError(t, err)
+		require.Len(t, tx.Message.Instructions, 1)
+
+		// check the accounts of the gateway instruction
+		accounts, err := tx.Message.Instructions[0].ResolveInstructionAccounts(&tx.Message)
+		require.NoError(t, err)
+		require.Len(t, accounts, 5)
+		require.Equal(t, msg.RecipientAta(), accounts[3].PublicKey)
+		require.Equal(t, solana.TokenProgramID, accounts[4].PublicKey)
+	})
+
+	t.Run("should create recipient ATA if it doesn't exist", func(t *testing.T) {
+		tx, err := newSigner(rpc.ErrNotFound).SignWithdrawSPLTx(ctx, *msg, 0)
+		require.NoError(t, err)
+		require.Len(t, tx.Message.Instructions, 2)
+
+		programID, err := tx.Message.Program(tx.Message.Instructions[0].ProgramIDIndex)
+		require.NoError(t, err)
+		require.Equal(t, solana.SPLAssociatedTokenAccountProgramID, programID)
+	})
+
+	t.Run("should fail if unable to check recipient ATA", func(t *testing.T) {
+		tx, err := newSigner(errors.New("rpc error")).SignWithdrawSPLTx(ctx, *msg, 0)
+		require.ErrorContains(t, err, "cannot check recipient ATA")
+		require.Nil(t, tx)
+	})
+}
@@ -9,10 +9,12 @@ import (
 
 	lru "github.com/hashicorp/golang-lru"
 	"github.com/pkg/errors"
+	"github.com/tonkeeper/tongo/boc"
 	"github.com/tonkeeper/tongo/liteapi"
 	"github.com/tonkeeper/tongo/tlb"
 	"github.com/tonkeeper/tongo/ton"
 
+	toncontracts "github.com/zeta-chain/node/pkg/contracts/ton"
 	zetaton "github.com/zeta-chain/node/zetaclient/chains/ton"
 )
 
@@ -255,3 +257,65 @@ func TransactionHashFromString(encoded string) (uint64, ton.Bits256, error) {
 
 	return lt, hashBits, nil
 }
+
+// TransactionToBoc serializes transaction into a bag of cells.
+// Transactions fetched from liteapi keep their source cell, so they're serialized as is to preserve the hash.
+// Otherwise (e.g. a transaction built in memory) it's encoded field by field
+// because tlb.Transaction has unexported fields that tlb.Marshal can't handle.
+// see https://github.com/ton-blockchain/ton/blob/master/crypto/block/block.tlb
+func TransactionToBoc(tx ton.Transaction) ([]byte, error) {
+	if raw, err := tx.SourceBoc(); err == nil {
+		return raw, nil
+	}
+
+	var (
+		t     = tx.Transaction
+		cell  = boc.NewCell()
+		msgs  = boc.NewCell()
+		state = boc.NewCell()
+		descr = boc.NewCell()
+	)
+
+	err := toncontracts.ErrCollect(
+		cell.WriteUint(0b0111, 4), // transaction$0111
+		tlb.Marshal(cell, t.AccountAddr),
+		tlb.Marshal(cell, t.Lt),
+		tlb.Marshal(cell, t.PrevTransHash),
+		tlb.Marshal(cell, t.PrevTransLt),
+		tlb.Marshal(cell, t.Now),
+		tlb.Marshal(cell, t.OutMsgCnt),
+		tlb.Marshal(cell, t.OrigStatus),
+		tlb.Marshal(cell, t.EndStatus),
+		tlb.Marshal(msgs, t.Msgs),
+		cell.AddRef(msgs),
+		tlb.Marshal(cell, t.TotalFees),
+		tlb.Marshal(state, t.StateUpdate),
+		cell.AddRef(state),
+		tlb.Marshal(descr, t.Description),
+		cell.AddRef(descr),
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to marshal transaction")
+	}
+
+	return cell.ToBoc()
+}
+
+// TransactionFromBoc deserializes transaction from a bag of cells.
+// Note that the block id is not part of the transaction's cell, so it's left empty
+func TransactionFromBoc(raw []byte) (ton.Transaction, error) {
+	cells, err := boc.DeserializeBoc(raw)
+	switch {
+	case err != nil:
+		return ton.Transaction{}, errors.Wrap(err, "unable to deserialize boc")
+	case len(cells) != 1:
+		return ton.Transaction{}, errors.Errorf("want 1 root cell, got %d", len(cells))
+	}
+
+	var tx tlb.Transaction
+	if err = tlb.Unmarshal(cells[0], &tx); err != nil {
+		return ton.Transaction{}, errors.Wrap(err, "unable to unmarshal transaction")
+	}
+
+	return ton.Transaction{Transaction: tx}, nil
+}
@@ -1,9 +1,17 @@
 package liteapi
 
 import (
+	"encoding/json"
+	"os"
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"github.com/tonkeeper/tongo/boc"
+	"github.com/tonkeeper/tongo/tlb"
+	"github.com/tonkeeper/tongo/ton"
+
+	toncontracts "github.com/zeta-chain/node/pkg/contracts/ton"
+	"github.com/zeta-chain/node/testutil/sample"
 )
 
 func TestHashes(t *testing.T) {
@@ -98,3 +106,62 @@ func TestTransactionHashFromString(t *testing.T) {
 		})
 	}
 }
+
+func TestTransactionBoc(t *testing.T) {
+	t.Run("real transaction", func(t *testing.T) {
+		// ARRANGE
+		// Given a withdrawal tx fetched from TON
+		b, err := os.ReadFile("../../../../pkg/contracts/ton/testdata/06-withdrawal.json")
+		require.NoError(t, err)
+
+		var fx struct {
+			BOC  string `json:"boc"`
+			Hash string `json:"hash"`
+		}
+		require.NoError(t, json.Unmarshal(b, &fx))
+
+		cells, err := boc.DeserializeBocHex(fx.BOC)
+		require.NoError(t, err)
+
+		var tx ton.Transaction
+		require.NoError(t, tx.UnmarshalTLB(cells[0], &tlb.Decoder{}))
+
+		// ACT
+		raw, err := TransactionToBoc(tx)
+		require.NoError(t, err)
+
+		decoded, err := TransactionFromBoc(raw)
+		require.NoError(t, err)
+
+		// ASSERT
+		require.Equal(t, fx.Hash, decoded.Hash().Hex())
+		require.Equal(t, TransactionToHashString(tx), TransactionToHashString(decoded))
+	})
+
+	t.Run("sample transaction", func(t *testing.T) {
+		// ARRANGE
+		gw := ton.MustParseAccountID("0:997d889c815aeac21c47f86ae0e38383efc3c3463067582f6263ad48c5a1485b")
+		tx := sample.TONWithdrawal(t, gw, toncontracts.Withdrawal{
+			Recipient: ton.MustParseAccountID("0:552f6db5da0cae7f0b3ab4ab58d85927f6beb962cda426a6a6ee751c82cead1f"),
+			Amount:    toncontracts.Coins(2),
+			Seqno:     3,
+		})
+
+		// ACT
+		raw, err := TransactionToBoc(tx)
+		require.NoError(t, err)
+
+		decoded, err := TransactionFromBoc(raw)
+		require.NoError(t, err)
+
+		// ASSERT
+		require.Equal(t, tx.Lt, decoded.Lt)
+		require.Equal(t, tx.AccountAddr, decoded.AccountAddr)
+		require.Equal(t, tx.IsSuccess(), decoded.IsSuccess())
+	})
+
+	t.Run("invalid boc", func(t *testing.T) {
+		_, err := TransactionFromBoc([]byte("invalid"))
+		require.Error(t, err)
+	})
+}
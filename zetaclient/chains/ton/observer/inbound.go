@@ -13,6 +13,7 @@ import (
 	"github.com/zeta-chain/node/pkg/coin"
 	toncontracts "github.com/zeta-chain/node/pkg/contracts/ton"
 	"github.com/zeta-chain/node/pkg/ticker"
+	cc "github.com/zeta-chain/node/x/crosschain/types"
 	"github.com/zeta-chain/node/zetaclient/chains/ton/liteapi"
 	zctx "github.com/zeta-chain/node/zetaclient/context"
 	"github.com/zeta-chain/node/zetaclient/zetacore"
@@ -38,7 +39,7 @@ func (ob *Observer) watchInbound(ctx context.Context) error {
 	ob.Logger().Inbound.Info().Msgf("WatchInbound started")
 
 	task := func(ctx context.Context, t *ticker.Ticker) error {
-		if !app.IsInboundObservationEnabled() {
+		if !app.IsInboundObservationEnabled(ob.Chain().ChainId) {
 			sampledLogger.Info().Msg("WatchInbound: inbound observation is disabled")
 			return nil
 		}
@@ -172,50 +173,82 @@ func (ob *Observer) voteInbound(ctx context.Context, tx *toncontracts.Transactio
 		return "", errors.Wrapf(err, "unable to get block header %s", tx.BlockID.String())
 	}
 
-	sender, amount, memo, err := extractInboundData(tx)
+	deposit, err := extractInboundData(tx)
 	if err != nil {
 		return "", err
 	}
 
 	seqno := blockHeader.MinRefMcSeqno
 
-	return ob.voteDeposit(ctx, tx, sender, amount, memo, seqno)
+	return ob.voteDeposit(ctx, tx, deposit, seqno)
 }
 
-// extractInboundData parses Gateway tx into deposit (TON sender, amount, memo)
-func extractInboundData(tx *toncontracts.Transaction) (string, math.Uint, []byte, error) {
+// inboundData represents a deposit parsed from Gateway tx
+type inboundData struct {
+	sender   string
+	amount   math.Uint
+	memo     []byte
+	coinType coin.CoinType
+
+	// asset is empty for gas coin and is the Gateway's jetton wallet for jettons
+	asset string
+}
+
+// extractInboundData parses Gateway tx into deposit (TON sender, amount, memo, coin type and asset)
+func extractInboundData(tx *toncontracts.Transaction) (inboundData, error) {
 	switch tx.Operation {
 	case toncontracts.OpDeposit:
 		d, err := tx.Deposit()
 		if err != nil {
-			return "", math.NewUint(0), nil, err
+			return inboundData{}, err
 		}
 
-		return d.Sender.ToRaw(), d.Amount, d.Memo(), nil
+		return inboundData{
+			sender:   d.Sender.ToRaw(),
+			amount:   d.Amount,
+			memo:     d.Memo(),
+			coinType: coin.CoinType_Gas,
+		}, nil
 	case toncontracts.OpDepositAndCall:
 		d, err := tx.DepositAndCall()
 		if err != nil {
-			return "", math.NewUint(0), nil, err
+			return inboundData{}, err
+		}
+
+		return inboundData{
+			sender:   d.Sender.ToRaw(),
+			amount:   d.Amount,
+			memo:     d.Memo(),
+			coinType: coin.CoinType_Gas,
+		}, nil
+	case toncontracts.OpJettonTransferNotification:
+		// the jetton wallet is mapped to ZRC20 as the foreign coin asset;
+		// deposits from unknown jetton wallets are not mapped to any ZRC20 and fail in zetacore
+		d, err := tx.JettonDeposit()
+		if err != nil {
+			return inboundData{}, err
 		}
 
-		return d.Sender.ToRaw(), d.Amount, d.Memo(), nil
+		return inboundData{
+			sender:   d.Sender.ToRaw(),
+			amount:   d.Amount,
+			memo:     d.Memo(),
+			coinType: coin.CoinType_ERC20,
+			asset:    d.Jetton.ToRaw(),
+		}, nil
 	default:
-		return "", math.NewUint(0), nil, fmt.Errorf("unknown operation %d", tx.Operation)
+		return inboundData{}, fmt.Errorf("unknown operation %d", tx.Operation)
 	}
 }
 
 func (ob *Observer) voteDeposit(
 	ctx context.Context,
 	tx *toncontracts.Transaction,
-	sender string,
-	amount math.Uint,
-	memo []byte,
+	deposit inboundData,
 	seqno uint32,
 ) (string, error) {
 	const (
 		eventIndex    = 0 // not a smart contract call
-		coinType      = coin.CoinType_Gas
-		asset         = "" // empty for gas coin
 		gasLimit      = 0
 		retryGasLimit = zetacore.PostVoteInboundExecutionGasLimit
 	)
@@ -229,25 +262,51 @@ func (ob *Observer) voteDeposit(
 	// https://github.com/zeta-chain/node/issues/2967
 
 	msg := zetacore.GetInboundVoteMessage(
-		sender,
+		deposit.sender,
 		ob.Chain().ChainId,
-		sender,
-		sender,
+		deposit.sender,
+		deposit.sender,
 		ob.ZetacoreClient().Chain().ChainId,
-		amount,
-		hex.EncodeToString(memo),
+		deposit.amount,
+		hex.EncodeToString(deposit.memo),
 		inboundHash,
 		uint64(seqno),
 		gasLimit,
-		coinType,
-		asset,
+		deposit.coinType,
+		deposit.asset,
 		operatorAddress.String(),
 		eventIndex,
 	)
 
+	// large amounts may require more confirmations (in masterchain blocks)
+	confirmed, err := ob.isInboundConfirmed(ctx, msg)
+	switch {
+	case err != nil:
+		return "", errors.Wrap(err, "unable to check inbound confirmations")
+	case !confirmed:
+		return "", fmt.Errorf("inbound %s is not confirmed yet for amount %s", inboundHash, deposit.amount)
+	}
+
 	return ob.PostVoteInbound(ctx, msg, retryGasLimit)
 }
 
+// isInboundConfirmed checks if the inbound vote has the number of masterchain confirmations
+// required by the confirmation tier matching its amount.
+// The default confirmation is given by the finality of the observed transaction.
+func (ob *Observer) isInboundConfirmed(ctx context.Context, msg *cc.MsgVoteInbound) (bool, error) {
+	if ob.ConfirmationCount(msg.CoinType, msg.Asset, msg.Amount) <= ob.ChainParams().ConfirmationCount {
+		return true, nil
+	}
+
+	blockID, err := ob.getLatestMasterchainBlock(ctx)
+	if err != nil {
+		return false, errors.Wrap(err, "unable to get latest masterchain block")
+	}
+	ob.WithLastBlock(uint64(blockID.Seqno))
+
+	return ob.IsInboundConfirmed(msg), nil
+}
+
 func (ob *Observer) ensureLastScannedTX(ctx context.Context) error {
 	// noop
 	if ob.LastTxScanned() != "" {
@@ -4,11 +4,13 @@ import (
 	"encoding/hex"
 	"testing"
 
+	"cosmossdk.io/math"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/tonkeeper/tongo/tlb"
 	"github.com/tonkeeper/tongo/ton"
+	"github.com/zeta-chain/node/pkg/coin"
 	toncontracts "github.com/zeta-chain/node/pkg/contracts/ton"
 	"github.com/zeta-chain/node/testutil/sample"
 	"github.com/zeta-chain/node/zetaclient/chains/ton/liteapi"
@@ -245,6 +247,57 @@ func TestInbound(t *testing.T) {
 		assert.Equal(t, uint64(blockInfo.MinRefMcSeqno), cctx.InboundBlockHeight)
 	})
 
+	t.Run("Jetton deposit", func(t *testing.T) {
+		// ARRANGE
+		ts := newTestSuite(t)
+
+		// Given observer
+		ob, err := New(ts.baseObserver, ts.liteClient, gw)
+		require.NoError(t, err)
+
+		lastScanned := ts.SetupLastScannedTX(gw.AccountID())
+
+		// Given jetton deposit (transfer_notification from gateway's jetton wallet)
+		jettonDeposit := toncontracts.JettonDeposit{
+			Deposit: toncontracts.Deposit{
+				Sender:    sample.GenerateTONAccountID(),
+				Amount:    math.NewUint(1_000_000),
+				Recipient: sample.EthAddress(),
+			},
+			Jetton:   sample.GenerateTONAccountID(),
+			CallData: []byte("hello"),
+		}
+
+		jettonDepositTX := sample.TONJettonDeposit(t, gw.AccountID(), jettonDeposit)
+		txs := []ton.Transaction{jettonDepositTX}
+
+		ts.
+			OnGetTransactionsSince(gw.AccountID(), lastScanned.Lt, txHash(lastScanned), txs, nil).
+			Once()
+
+		ts.MockGetBlockHeader(jettonDepositTX.BlockID)
+
+		// ACT
+		err = ob.observeGateway(ts.ctx)
+
+		// ASSERT
+		assert.NoError(t, err)
+
+		// Check that cctx was sent to zetacore
+		require.Len(t, ts.votesBag, 1)
+
+		cctx := ts.votesBag[0]
+
+		assert.Equal(t, jettonDeposit.Sender.ToRaw(), cctx.Sender)
+		assert.Equal(t, coin.CoinType_ERC20, cctx.CoinType)
+		assert.Equal(t, jettonDeposit.Jetton.ToRaw(), cctx.Asset)
+		assert.Equal(t, jettonDeposit.Amount.Uint64(), cctx.Amount.Uint64())
+		assert.Equal(t, hex.EncodeToString(jettonDeposit.Memo()), cctx.Message)
+
+		expectedHash := liteapi.TransactionHashToString(jettonDepositTX.Lt, txHash(jettonDepositTX))
+		assert.Equal(t, expectedHash, cctx.InboundHash)
+	})
+
 	// Yep, it's possible to have withdrawals here because we scroll through all gateway's txs
 	t.Run("Withdrawal", func(t *testing.T) {
 		// ARRANGE
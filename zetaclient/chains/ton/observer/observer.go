@@ -67,12 +67,18 @@ func New(bo *base.Observer, client LiteClient, gateway *toncontracts.Gateway) (*
 
 	bo.LoadLastTxScanned()
 
-	return &Observer{
+	ob := &Observer{
 		Observer:  bo,
 		client:    client,
 		gateway:   gateway,
 		outbounds: outbounds,
-	}, nil
+	}
+
+	if err = ob.loadOutbounds(); err != nil {
+		return nil, errors.Wrap(err, "unable to load outbounds")
+	}
+
+	return ob, nil
 }
 
 // Start starts the observer. This method is NOT blocking.
@@ -7,6 +7,7 @@ import (
 	eth "github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
 	"github.com/rs/zerolog"
+	"github.com/tonkeeper/tongo/ton"
 
 	"github.com/zeta-chain/node/pkg/chains"
 	"github.com/zeta-chain/node/pkg/coin"
@@ -34,16 +35,16 @@ func (ob *Observer) VoteOutboundIfConfirmed(ctx context.Context, cctx *cc.CrossC
 		return true, nil
 	}
 
-	withdrawal, err := outboundRes.tx.Withdrawal()
+	msg, err := outboundRes.tx.OutboundMsg()
 	if err != nil {
-		return false, errors.Wrap(err, "unable to get withdrawal")
+		return false, errors.Wrap(err, "unable to get outbound message")
 	}
 
 	// TODO: Add compliance check
 	// https://github.com/zeta-chain/node/issues/2916
 
 	txHash := liteapi.TransactionToHashString(outboundRes.tx.Transaction)
-	if err = ob.postVoteOutbound(ctx, cctx, withdrawal, txHash, outboundRes.receiveStatus); err != nil {
+	if err = ob.postVoteOutbound(ctx, cctx, msg, txHash, outboundRes.receiveStatus); err != nil {
 		return false, errors.Wrap(err, "unable to post vote")
 	}
 
@@ -64,7 +65,7 @@ func (ob *Observer) watchOutbound(ctx context.Context) error {
 	)
 
 	task := func(ctx context.Context, t *ticker.Ticker) error {
-		if !app.IsOutboundObservationEnabled() {
+		if !app.IsOutboundObservationEnabled(ob.Chain().ChainId) {
 			sampledLogger.Info().Msg("WatchOutbound: outbound observation is disabled")
 			return nil
 		}
@@ -137,8 +138,11 @@ func (ob *Observer) observeOutboundTrackers(ctx context.Context) error {
 // processOutboundTracker checks TON tx and stores it in memory for further processing
 // by VoteOutboundIfConfirmed.
 func (ob *Observer) processOutboundTracker(ctx context.Context, cctx *cc.CrossChainTx, txHash string) error {
-	if cctx.InboundParams.CoinType != coin.CoinType_Gas {
-		return errors.New("only gas cctxs are supported")
+	switch cctx.InboundParams.CoinType {
+	case coin.CoinType_Gas, coin.CoinType_ERC20, coin.CoinType_NoAssetCall:
+		// supported
+	default:
+		return errors.Errorf("unsupported coin type %s", cctx.InboundParams.CoinType.String())
 	}
 
 	lt, hash, err := liteapi.TransactionHashFromString(txHash)
@@ -167,11 +171,56 @@ func (ob *Observer) processOutboundTracker(ctx context.Context, cctx *cc.CrossCh
 	nonce := cctx.GetCurrentOutboundParam().TssNonce
 	ob.setOutboundByNonce(outbound{tx, receiveStatus, nonce})
 
+	if err := ob.saveOutbound(nonce, txHash, rawTX, receiveStatus); err != nil {
+		ob.Logger().Outbound.Error().Err(err).
+			Uint64("outbound.nonce", nonce).
+			Str("outbound.hash", txHash).
+			Msg("Unable to save outbound to db")
+	}
+
+	return nil
+}
+
+// saveOutbound persists the outbound tx and its status to the database
+func (ob *Observer) saveOutbound(nonce uint64, txHash string, rawTX ton.Transaction, status chains.ReceiveStatus) error {
+	raw, err := liteapi.TransactionToBoc(rawTX)
+	if err != nil {
+		return errors.Wrap(err, "unable to serialize transaction")
+	}
+
+	return ob.SaveOutboundResult(nonce, txHash, raw, status)
+}
+
+// loadOutbounds loads the observed outbounds from the database into memory
+func (ob *Observer) loadOutbounds() error {
+	outbounds, err := ob.LoadOutbounds()
+	if err != nil {
+		return err
+	}
+
+	for _, o := range outbounds {
+		if !o.HasResult() {
+			continue
+		}
+
+		rawTX, err := liteapi.TransactionFromBoc(o.Result)
+		if err != nil {
+			return errors.Wrapf(err, "unable to deserialize outbound %s", o.TxHash)
+		}
+
+		tx, err := ob.gateway.ParseTransaction(rawTX)
+		if err != nil {
+			return errors.Wrapf(err, "unable to parse outbound %s", o.TxHash)
+		}
+
+		ob.setOutboundByNonce(outbound{tx, o.ReceiveStatus, o.Nonce})
+	}
+
 	return nil
 }
 
 func (ob *Observer) determineReceiveStatus(tx *toncontracts.Transaction) (chains.ReceiveStatus, error) {
-	_, evmSigner, err := extractWithdrawal(tx)
+	_, evmSigner, err := extractOutboundMsg(tx)
 	switch {
 	case err != nil:
 		return 0, err
@@ -188,7 +237,7 @@ func (ob *Observer) determineReceiveStatus(tx *toncontracts.Transaction) (chains
 // In most cases will be a noop because the tracker is already published by the signer.
 // See Signer{}.trackOutbound(...) for more details.
 func (ob *Observer) addOutboundTracker(ctx context.Context, tx *toncontracts.Transaction) error {
-	w, evmSigner, err := extractWithdrawal(tx)
+	msg, evmSigner, err := extractOutboundMsg(tx)
 	switch {
 	case err != nil:
 		return err
@@ -196,14 +245,14 @@ func (ob *Observer) addOutboundTracker(ctx context.Context, tx *toncontracts.Tra
 		ob.Logger().Inbound.Warn().
 			Fields(txLogFields(tx)).
 			Str("transaction.ton.signer", evmSigner.String()).
-			Msg("observeGateway: addOutboundTracker: withdrawal signer is not TSS. Skipping")
+			Msg("observeGateway: addOutboundTracker: outbound signer is not TSS. Skipping")
 
 		return nil
 	}
 
 	var (
 		chainID = ob.Chain().ChainId
-		nonce   = uint64(w.Seqno)
+		nonce   = uint64(msg.GetSeqno())
 		hash    = liteapi.TransactionToHashString(tx.Transaction)
 	)
 
@@ -215,19 +264,19 @@ func (ob *Observer) addOutboundTracker(ctx context.Context, tx *toncontracts.Tra
 	return err
 }
 
-// return withdrawal and tx signer
-func extractWithdrawal(tx *toncontracts.Transaction) (toncontracts.Withdrawal, eth.Address, error) {
-	w, err := tx.Withdrawal()
+// return outbound message (withdrawal, jetton withdrawal or withdrawal and call) and tx signer
+func extractOutboundMsg(tx *toncontracts.Transaction) (toncontracts.OutboundMsg, eth.Address, error) {
+	msg, err := tx.OutboundMsg()
 	if err != nil {
-		return toncontracts.Withdrawal{}, eth.Address{}, errors.Wrap(err, "not a withdrawal")
+		return nil, eth.Address{}, errors.Wrap(err, "not an outbound message")
 	}
 
-	s, err := w.Signer()
+	s, err := msg.Signer()
 	if err != nil {
-		return toncontracts.Withdrawal{}, eth.Address{}, errors.Wrap(err, "unable to get signer")
+		return nil, eth.Address{}, errors.Wrap(err, "unable to get signer")
 	}
 
-	return w, s, nil
+	return msg, s, nil
 }
 
 // getOutboundByNonce returns outbound by nonce
@@ -248,7 +297,7 @@ func (ob *Observer) setOutboundByNonce(o outbound) {
 func (ob *Observer) postVoteOutbound(
 	ctx context.Context,
 	cctx *cc.CrossChainTx,
-	w toncontracts.Withdrawal,
+	msg toncontracts.OutboundMsg,
 	txHash string,
 	status chains.ReceiveStatus,
 ) error {
@@ -279,7 +328,7 @@ func (ob *Observer) postVoteOutbound(
 		coinType      = cctx.InboundParams.CoinType
 	)
 
-	msg := cc.NewMsgVoteOutbound(
+	vote := cc.NewMsgVoteOutbound(
 		signerAddress.String(),
 		cctx.Index,
 		txHash,
@@ -287,7 +336,7 @@ func (ob *Observer) postVoteOutbound(
 		outboundGasUsed,
 		math.NewInt(outboundGasPrice),
 		outboundGasLimit,
-		w.Amount,
+		msg.GetAmount(),
 		status,
 		chainID,
 		nonce,
@@ -297,7 +346,7 @@ func (ob *Observer) postVoteOutbound(
 	const gasLimit = gasconst.PostVoteOutboundGasLimit
 
 	var retryGasLimit uint64
-	if msg.Status == chains.ReceiveStatus_failed {
+	if vote.Status == chains.ReceiveStatus_failed {
 		retryGasLimit = gasconst.PostVoteOutboundRevertGasLimit
 	}
 
@@ -306,7 +355,7 @@ func (ob *Observer) postVoteOutbound(
 		Str("outbound.outbound_tx_hash", txHash).
 		Logger()
 
-	zetaTxHash, ballot, err := ob.ZetacoreClient().PostVoteOutbound(ctx, gasLimit, retryGasLimit, msg)
+	zetaTxHash, ballot, err := ob.ZetacoreClient().PostVoteOutbound(ctx, gasLimit, retryGasLimit, vote)
 	if err != nil {
 		log.Error().Err(err).Msg("PostVoteOutbound: error posting vote")
 		return err
@@ -319,5 +368,9 @@ func (ob *Observer) postVoteOutbound(
 			Msg("PostVoteOutbound: posted vote")
 	}
 
+	if err := ob.SetOutboundVotePosted(nonce, txHash); err != nil {
+		log.Error().Err(err).Msg("PostVoteOutbound: unable to save vote status to db")
+	}
+
 	return nil
 }
@@ -3,6 +3,7 @@ package observer
 import (
 	"testing"
 
+	"cosmossdk.io/math"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/tonkeeper/tongo/ton"
@@ -77,4 +78,137 @@ func TestOutbound(t *testing.T) {
 		assert.NoError(t, err)
 		assert.Equal(t, withdrawal, w2)
 	})
+
+	t.Run("rehydrate outbounds from db", func(t *testing.T) {
+		// ARRANGE
+		ts := newTestSuite(t)
+
+		ob, err := New(ts.baseObserver, ts.liteClient, gw)
+		require.NoError(t, err)
+
+		// Given withdrawal
+		withdrawal := toncontracts.Withdrawal{
+			Recipient: ton.MustParseAccountID("0:552f6db5da0cae7f0b3ab4ab58d85927f6beb962cda426a6a6ee751c82cead1f"),
+			Amount:    toncontracts.Coins(3),
+			Seqno:     6,
+		}
+		ts.sign(&withdrawal)
+
+		nonce := uint64(withdrawal.Seqno)
+
+		// Given TON tx
+		withdrawalTX := sample.TONWithdrawal(t, gw.AccountID(), withdrawal)
+
+		ts.MockGetTransaction(gw.AccountID(), withdrawalTX)
+
+		// Given cctx
+		cctx := sample.CrossChainTx(t, "index789")
+		cctx.InboundParams.CoinType = coin.CoinType_Gas
+		cctx.GetCurrentOutboundParam().TssNonce = nonce
+
+		// Given observed outbound
+		err = ob.processOutboundTracker(ts.ctx, cctx, liteapi.TransactionToHashString(withdrawalTX))
+		require.NoError(t, err)
+
+		// ACT
+		// Observer restarts on the same db
+		obNew, err := New(ts.baseObserver, ts.liteClient, gw)
+		require.NoError(t, err)
+
+		// ASSERT
+		res, exists := obNew.getOutboundByNonce(nonce)
+		require.True(t, exists)
+
+		assert.Equal(t, nonce, res.nonce)
+		assert.Equal(t, chains.ReceiveStatus_success, res.receiveStatus)
+
+		w2, err := res.tx.Withdrawal()
+		require.NoError(t, err)
+		assert.Equal(t, withdrawal, w2)
+	})
+
+	t.Run("observeOutboundTrackers jettons and calls", func(t *testing.T) {
+		recipient := ton.MustParseAccountID("0:552f6db5da0cae7f0b3ab4ab58d85927f6beb962cda426a6a6ee751c82cead1f")
+
+		for _, tt := range []struct {
+			name     string
+			coinType coin.CoinType
+			msg      toncontracts.OutboundMsg
+			makeTX   func(t *testing.T, msg toncontracts.OutboundMsg) ton.Transaction
+		}{
+			{
+				name:     "jetton withdrawal",
+				coinType: coin.CoinType_ERC20,
+				msg: &toncontracts.JettonWithdrawal{
+					Jetton:     sample.GenerateTONAccountID(),
+					Recipient:  recipient,
+					Amount:     math.NewUint(42_000),
+					ForwardFee: math.NewUint(50_000_000),
+					Seqno:      4,
+				},
+				makeTX: func(t *testing.T, msg toncontracts.OutboundMsg) ton.Transaction {
+					return sample.TONJettonWithdrawal(t, gw.AccountID(), *msg.(*toncontracts.JettonWithdrawal))
+				},
+			},
+			{
+				name:     "withdrawal and call",
+				coinType: coin.CoinType_NoAssetCall,
+				msg: &toncontracts.WithdrawalAndCall{
+					Recipient:  recipient,
+					Amount:     math.ZeroUint(),
+					ForwardFee: math.NewUint(50_000_000),
+					Seqno:      5,
+					CallData:   []byte("ping"),
+				},
+				makeTX: func(t *testing.T, msg toncontracts.OutboundMsg) ton.Transaction {
+					return sample.TONWithdrawalAndCall(t, gw.AccountID(), *msg.(*toncontracts.WithdrawalAndCall))
+				},
+			},
+		} {
+			t.Run(tt.name, func(t *testing.T) {
+				// ARRANGE
+				ts := newTestSuite(t)
+
+				ob, err := New(ts.baseObserver, ts.liteClient, gw)
+				require.NoError(t, err)
+
+				ts.sign(tt.msg)
+
+				nonce := uint64(tt.msg.GetSeqno())
+
+				// Given TON tx
+				outboundTX := tt.makeTX(t, tt.msg)
+				ts.MockGetTransaction(gw.AccountID(), outboundTX)
+
+				// Given outbound tracker
+				ts.OnGetAllOutboundTrackerByChain([]cc.OutboundTracker{{
+					Index:    "index123",
+					ChainId:  ts.chain.ChainId,
+					Nonce:    nonce,
+					HashList: []*cc.TxHash{{TxHash: liteapi.TransactionToHashString(outboundTX)}},
+				}})
+
+				// Given cctx
+				cctx := sample.CrossChainTx(t, "index456")
+				cctx.InboundParams.CoinType = tt.coinType
+				cctx.GetCurrentOutboundParam().TssNonce = nonce
+
+				ts.MockCCTXByNonce(cctx)
+
+				// ACT
+				err = ob.observeOutboundTrackers(ts.ctx)
+
+				// ASSERT
+				require.NoError(t, err)
+
+				res, exists := ob.getOutboundByNonce(nonce)
+				require.True(t, exists)
+				assert.Equal(t, chains.ReceiveStatus_success, res.receiveStatus)
+
+				msg, err := res.tx.OutboundMsg()
+				require.NoError(t, err)
+				assert.Equal(t, tt.msg, msg)
+			})
+		}
+	})
 }
@@ -2,7 +2,9 @@ package signer
 
 import (
 	"context"
+	"encoding/hex"
 
+	"cosmossdk.io/math"
 	ethcommon "github.com/ethereum/go-ethereum/common"
 	lru "github.com/hashicorp/golang-lru"
 	"github.com/pkg/errors"
@@ -73,7 +75,7 @@ func (s *Signer) TryProcessOutbound(
 	cctx *cc.CrossChainTx,
 	proc *outboundprocessor.Processor,
 	outboundID string,
-	_ interfaces.ChainObserver,
+	chainObserver interfaces.ChainObserver,
 	zetacore interfaces.ZetacoreClient,
 	zetaBlockHeight uint64,
 ) {
@@ -83,7 +85,9 @@ func (s *Signer) TryProcessOutbound(
 		proc.EndTryProcess(outboundID)
 	}()
 
-	outcome, err := s.ProcessOutbound(ctx, cctx, zetacore, zetaBlockHeight)
+	recorder := base.OutboundRecorder(chainObserver)
+
+	outcome, err := s.ProcessOutbound(ctx, cctx, zetacore, recorder, zetaBlockHeight)
 	if err != nil {
 		s.Logger().Std.Error().
 			Err(err).
@@ -99,14 +103,9 @@ func (s *Signer) ProcessOutbound(
 	ctx context.Context,
 	cctx *cc.CrossChainTx,
 	zetacore interfaces.ZetacoreClient,
+	recorder interfaces.OutboundRecorder,
 	zetaHeight uint64,
 ) (Outcome, error) {
-	// TODO: note that *InboundParams* are use used on purpose due to legacy reasons.
-	// https://github.com/zeta-chain/node/issues/1949
-	if cctx.InboundParams.CoinType != coin.CoinType_Gas {
-		return Invalid, errors.New("only gas coin outbounds are supported")
-	}
-
 	params := cctx.GetCurrentOutboundParam()
 
 	// TODO: add compliance check
@@ -117,23 +116,22 @@ func (s *Signer) ProcessOutbound(
 		return Invalid, errors.Wrapf(err, "unable to parse recipient %q", params.Receiver)
 	}
 
-	withdrawal := &toncontracts.Withdrawal{
-		Recipient: receiver,
-		Amount:    params.Amount,
-		// #nosec G115 always in range
-		Seqno: uint32(params.TssNonce),
+	msg, err := composeOutboundMsg(cctx, receiver)
+	if err != nil {
+		return Invalid, errors.Wrap(err, "unable to compose outbound message")
 	}
 
 	lf := map[string]any{
-		"outbound.recipient": withdrawal.Recipient.ToRaw(),
-		"outbound.amount":    withdrawal.Amount.Uint64(),
-		"outbound.nonce":     withdrawal.Seqno,
+		"outbound.recipient": receiver.ToRaw(),
+		"outbound.amount":    msg.GetAmount().String(),
+		"outbound.nonce":     msg.GetSeqno(),
+		"outbound.coin_type": cctx.InboundParams.CoinType.String(),
 	}
 
-	s.Logger().Std.Info().Fields(lf).Msg("Signing withdrawal")
+	s.Logger().Std.Info().Fields(lf).Msg("Signing outbound message")
 
-	if err = s.SignMessage(ctx, withdrawal, zetaHeight, params.TssNonce); err != nil {
-		return Fail, errors.Wrap(err, "unable to sign withdrawal message")
+	if err = s.SignMessage(ctx, msg, zetaHeight, params.TssNonce); err != nil {
+		return Fail, errors.Wrap(err, "unable to sign outbound message")
 	}
 
 	gwState, err := s.client.GetAccountState(ctx, s.gateway.AccountID())
@@ -146,7 +144,7 @@ func (s *Signer) ProcessOutbound(
 	//
 	// Example: If a cctx has amount of 5 TON, the recipient will receive 5 TON,
 	// and gateway's balance will be decreased by 5 TON + txFees.
-	exitCode, err := s.gateway.SendExternalMessage(ctx, s.client, withdrawal)
+	exitCode, err := s.gateway.SendExternalMessage(ctx, s.client, msg)
 	switch {
 	case err != nil:
 		return Fail, errors.Wrap(err, "unable to send external message")
@@ -161,13 +159,97 @@ func (s *Signer) ProcessOutbound(
 
 	// it's okay to run this in the same goroutine
 	// because TryProcessOutbound method should be called in a goroutine
-	if err = s.trackOutbound(ctx, zetacore, withdrawal, gwState); err != nil {
+	if err = s.trackOutbound(ctx, zetacore, recorder, msg, gwState); err != nil {
 		return Fail, errors.Wrap(err, "unable to track outbound")
 	}
 
 	return Success, nil
 }
 
+// composeOutboundMsg composes Gateway's external message for the cctx.
+// TODO: note that *InboundParams* are use used on purpose due to legacy reasons.
+// https://github.com/zeta-chain/node/issues/1949
+//
+//   - gas withdrawal: native TON is sent to the recipient
+//   - gas withdrawal with a call / no asset call: TON (if any) and the relayed message
+//     are forwarded to the recipient contract
+//   - ERC20 withdrawal: jettons are sent by the Gateway's jetton wallet (cctx asset) to the recipient
+//
+// The forward fee of calls and jetton withdrawals is derived from the cctx gas price,
+// so the message is re-signed with a higher fee when zetacore bumps the gas price of a pending outbound.
+func composeOutboundMsg(cctx *cc.CrossChainTx, receiver ton.AccountID) (toncontracts.OutboundMsg, error) {
+	var (
+		params   = cctx.GetCurrentOutboundParam()
+		coinType = cctx.InboundParams.CoinType
+
+		// #nosec G115 always in range
+		seqno = uint32(params.TssNonce)
+	)
+
+	if coinType == coin.CoinType_Gas && !cctx.InboundParams.IsCrossChainCall {
+		return &toncontracts.Withdrawal{
+			Recipient: receiver,
+			Amount:    params.Amount,
+			Seqno:     seqno,
+		}, nil
+	}
+
+	forwardFee, err := outboundForwardFee(params)
+	if err != nil {
+		return nil, err
+	}
+
+	switch coinType {
+	case coin.CoinType_Gas, coin.CoinType_NoAssetCall:
+		callData, err := hex.DecodeString(cctx.RelayedMessage)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to decode relayed message %q", cctx.RelayedMessage)
+		}
+
+		return &toncontracts.WithdrawalAndCall{
+			Recipient:  receiver,
+			Amount:     params.Amount,
+			ForwardFee: forwardFee,
+			Seqno:      seqno,
+			CallData:   callData,
+		}, nil
+	case coin.CoinType_ERC20:
+		jetton, err := ton.ParseAccountID(cctx.InboundParams.Asset)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to parse jetton wallet %q", cctx.InboundParams.Asset)
+		}
+
+		return &toncontracts.JettonWithdrawal{
+			Jetton:     jetton,
+			Recipient:  receiver,
+			Amount:     params.Amount,
+			ForwardFee: forwardFee,
+			Seqno:      seqno,
+		}, nil
+	default:
+		return nil, errors.Errorf("unsupported coin type %s", coinType.String())
+	}
+}
+
+// outboundForwardFee returns the TON attached by the Gateway to the outbound message (gas limit * gas price).
+func outboundForwardFee(params *cc.OutboundParams) (math.Uint, error) {
+	var gasLimit uint64
+	if params.CallOptions != nil {
+		gasLimit = params.CallOptions.GasLimit
+	}
+
+	if params.GasPrice == "" {
+		return math.ZeroUint(), nil
+	}
+
+	gasPrice, err := params.GetGasPriceUInt64()
+	if err != nil {
+		return math.ZeroUint(), errors.Wrap(err, "unable to parse gas price")
+	}
+
+	return math.NewUint(gasLimit).MulUint64(gasPrice), nil
+}
+
 // SignMessage signs TON external message using TSS
 func (s *Signer) SignMessage(ctx context.Context, msg Signable, zetaHeight, nonce uint64) error {
 	hash, err := msg.Hash()
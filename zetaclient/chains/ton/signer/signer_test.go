@@ -85,6 +85,130 @@ func TestSigner(t *testing.T) {
 	require.Equal(t, liteapi.TransactionToHashString(withdrawalTX), tracker.hash)
 }
 
+func TestComposeOutboundMsg(t *testing.T) {
+	var (
+		receiver = ton.MustParseAccountID("0QAyaVdkvWSuax8luWhDXY_0X9Am1ASWlJz4OI7M-jqcM5wK")
+		jetton   = ton.MustParseAccountID("0:997d889c815aeac21c47f86ae0e38383efc3c3463067582f6263ad48c5a1485b")
+		amount   = tonCoins(t, "1.5")
+
+		// gas limit * gas price
+		forwardFee = math.NewUint(40_000_000)
+	)
+
+	const nonce = 7
+
+	newCCTX := func(coinType coin.CoinType, isCall bool, asset, message string) *cc.CrossChainTx {
+		cctx := sample.CrossChainTx(t, "123")
+		cctx.InboundParams.CoinType = coinType
+		cctx.InboundParams.IsCrossChainCall = isCall
+		cctx.InboundParams.Asset = asset
+		cctx.RelayedMessage = message
+		cctx.OutboundParams = []*cc.OutboundParams{{
+			Receiver:    receiver.ToRaw(),
+			Amount:      amount,
+			TssNonce:    nonce,
+			GasPrice:    "400",
+			CallOptions: &cc.CallOptions{GasLimit: 100_000},
+		}}
+
+		return cctx
+	}
+
+	for _, tt := range []struct {
+		name        string
+		cctx        *cc.CrossChainTx
+		expected    toncontracts.OutboundMsg
+		errContains string
+	}{
+		{
+			name:     "gas withdrawal",
+			cctx:     newCCTX(coin.CoinType_Gas, false, "", ""),
+			expected: &toncontracts.Withdrawal{Recipient: receiver, Amount: amount, Seqno: nonce},
+		},
+		{
+			name: "gas withdrawal and call",
+			cctx: newCCTX(coin.CoinType_Gas, true, "", hex.EncodeToString([]byte("hello"))),
+			expected: &toncontracts.WithdrawalAndCall{
+				Recipient:  receiver,
+				Amount:     amount,
+				ForwardFee: forwardFee,
+				Seqno:      nonce,
+				CallData:   []byte("hello"),
+			},
+		},
+		{
+			name: "no asset call",
+			cctx: newCCTX(coin.CoinType_NoAssetCall, false, "", hex.EncodeToString([]byte("hi"))),
+			expected: &toncontracts.WithdrawalAndCall{
+				Recipient:  receiver,
+				Amount:     amount,
+				ForwardFee: forwardFee,
+				Seqno:      nonce,
+				CallData:   []byte("hi"),
+			},
+		},
+		{
+			name: "jetton withdrawal",
+			cctx: newCCTX(coin.CoinType_ERC20, false, jetton.ToRaw(), ""),
+			expected: &toncontracts.JettonWithdrawal{
+				Jetton:     jetton,
+				Recipient:  receiver,
+				Amount:     amount,
+				ForwardFee: forwardFee,
+				Seqno:      nonce,
+			},
+		},
+		{
+			name:        "invalid relayed message",
+			cctx:        newCCTX(coin.CoinType_NoAssetCall, false, "", "not hex"),
+			errContains: "unable to decode relayed message",
+		},
+		{
+			name:        "invalid jetton wallet",
+			cctx:        newCCTX(coin.CoinType_ERC20, false, "0xabc", ""),
+			errContains: "unable to parse jetton wallet",
+		},
+		{
+			name:        "unsupported coin type",
+			cctx:        newCCTX(coin.CoinType_Zeta, false, "", ""),
+			errContains: "unsupported coin type",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			// ACT
+			msg, err := composeOutboundMsg(tt.cctx, receiver)
+
+			// ASSERT
+			if tt.errContains != "" {
+				require.ErrorContains(t, err, tt.errContains)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, msg)
+		})
+	}
+
+	t.Run("bumped gas price changes the message hash", func(t *testing.T) {
+		cctx := newCCTX(coin.CoinType_ERC20, false, jetton.ToRaw(), "")
+
+		msg, err := composeOutboundMsg(cctx, receiver)
+		require.NoError(t, err)
+		hash, err := msg.Hash()
+		require.NoError(t, err)
+
+		cctx.GetCurrentOutboundParam().GasPrice = "800"
+
+		bumped, err := composeOutboundMsg(cctx, receiver)
+		require.NoError(t, err)
+		bumpedHash, err := bumped.Hash()
+		require.NoError(t, err)
+
+		require.Equal(t, math.NewUint(80_000_000), bumped.(*toncontracts.JettonWithdrawal).ForwardFee)
+		require.NotEqual(t, hash, bumpedHash)
+	})
+}
+
 type testSuite struct {
 	ctx context.Context
 	t   *testing.T
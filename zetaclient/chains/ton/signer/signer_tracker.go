@@ -23,7 +23,8 @@ import (
 func (s *Signer) trackOutbound(
 	ctx context.Context,
 	zetacore interfaces.ZetacoreClient,
-	w *toncontracts.Withdrawal,
+	recorder interfaces.OutboundRecorder,
+	msg toncontracts.OutboundMsg,
 	prevState tlb.ShardAccount,
 ) error {
 	const (
@@ -38,11 +39,14 @@ func (s *Signer) trackOutbound(
 		acc   = s.gateway.AccountID()
 		lt    = prevState.LastTransLt
 		hash  = ton.Bits256(prevState.LastTransHash)
-		nonce = uint64(w.Seqno)
-
-		filter = withdrawalFilter(w)
+		nonce = uint64(msg.GetSeqno())
 	)
 
+	filter, err := outboundFilter(msg)
+	if err != nil {
+		return errors.Wrap(err, "unable to create outbound filter")
+	}
+
 	for time.Since(start) <= timeout {
 		txs, err := s.client.GetTransactionsSince(ctx, acc, lt, hash)
 		if err != nil {
@@ -63,30 +67,66 @@ func (s *Signer) trackOutbound(
 			return errors.Errorf("transaction %q is not successful", txHash)
 		}
 
+		// Record the outbound before reporting it, so the tracker can be reported after a restart
+		if err = saveOutboundBroadcast(recorder, nonce, txHash, msg); err != nil {
+			s.Logger().Std.Error().Err(err).Str("outbound.hash", txHash).Msg("Unable to save outbound to db")
+		}
+
 		// Note that this method has a check for noop
 		_, err = zetacore.AddOutboundTracker(ctx, chainID, nonce, txHash, nil, "", 0)
 		if err != nil {
 			return errors.Wrap(err, "unable to add outbound tracker")
 		}
 
+		if err = recorder.SetOutboundTrackerReported(nonce, txHash); err != nil {
+			s.Logger().Std.Error().Err(err).Str("outbound.hash", txHash).Msg("Unable to mark outbound as reported in db")
+		}
+
 		return nil
 	}
 
 	return errors.Errorf("timeout exceeded (%s)", time.Since(start).String())
 }
 
-// creates a tx filter for this very withdrawal
-func withdrawalFilter(w *toncontracts.Withdrawal) func(tx *toncontracts.Transaction) bool {
+// saveOutboundBroadcast records the signed outbound message body with the given recorder
+func saveOutboundBroadcast(
+	recorder interfaces.OutboundRecorder,
+	nonce uint64,
+	txHash string,
+	msg toncontracts.OutboundMsg,
+) error {
+	body, err := msg.AsBody()
+	if err != nil {
+		return errors.Wrap(err, "unable to get message body")
+	}
+
+	payload, err := body.ToBoc()
+	if err != nil {
+		return errors.Wrap(err, "unable to serialize message body")
+	}
+
+	return recorder.SaveOutboundBroadcast(nonce, txHash, payload)
+}
+
+// creates a tx filter for this very outbound message
+func outboundFilter(msg toncontracts.OutboundMsg) (toncontracts.Filter, error) {
+	hash, err := msg.Hash()
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to hash outbound message")
+	}
+
 	return func(tx *toncontracts.Transaction) bool {
-		if !tx.IsOutbound() || tx.Operation != toncontracts.OpWithdraw {
+		if !tx.IsOutbound() {
 			return false
 		}
 
-		wd, err := tx.Withdrawal()
-		if err != nil {
+		txMsg, err := tx.OutboundMsg()
+		if err != nil || txMsg.GetSeqno() != msg.GetSeqno() {
 			return false
 		}
 
-		return wd.Seqno == w.Seqno && wd.Sig == w.Sig
-	}
+		txHash, err := txMsg.Hash()
+
+		return err == nil && txHash == hash
+	}, nil
 }
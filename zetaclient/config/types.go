@@ -39,8 +39,16 @@ type ClientConfiguration struct {
 
 // EVMConfig is the config for EVM chain
 type EVMConfig struct {
-	Chain           chains.Chain
-	Endpoint        string `mask:"filled"`
+	Chain    chains.Chain
+	Endpoint string `mask:"filled"`
+
+	// FallbackEndpoints are the endpoints used in order of priority when the primary endpoint is unhealthy
+	FallbackEndpoints []string `mask:"filled"`
+
+	// RPCQuorum is the number of endpoints that must return the same inbound tx receipt and block hash
+	// before voting on the inbound. 0 or 1 disables the cross-check.
+	RPCQuorum int
+
 	RPCAlertLatency int64
 }
 
@@ -73,6 +81,15 @@ type ComplianceConfig struct {
 	RestrictedAddresses []string `json:"RestrictedAddresses"`
 }
 
+// AdminAPIConfig is the config for the local admin API used to inspect and steer a running zetaclient
+type AdminAPIConfig struct {
+	// Port is the loopback port the admin API listens on, the admin API is disabled if zero
+	Port int `json:"Port"`
+
+	// Token is the bearer token that must be presented to call the admin API
+	Token string `json:"Token" mask:"filled"`
+}
+
 // Config is the config for ZetaClient
 // TODO: use snake case for json fields
 // https://github.com/zeta-chain/node/issues/1020
@@ -109,6 +126,9 @@ type Config struct {
 	// compliance config
 	ComplianceConfig ComplianceConfig `json:"ComplianceConfig"`
 
+	// admin API config
+	AdminAPI AdminAPIConfig `json:"AdminAPI"`
+
 	mu *sync.RWMutex
 }
 
@@ -220,6 +240,25 @@ func (c EVMConfig) Empty() bool {
 	return c.Endpoint == "" || c.Chain.IsEmpty()
 }
 
+// Endpoints returns the primary endpoint followed by the fallback endpoints, without duplicates
+func (c EVMConfig) Endpoints() []string {
+	endpoints := make([]string, 0, len(c.FallbackEndpoints)+1)
+	seen := make(map[string]bool)
+	for _, endpoint := range append([]string{c.Endpoint}, c.FallbackEndpoints...) {
+		if endpoint == "" || seen[endpoint] {
+			continue
+		}
+		seen[endpoint] = true
+		endpoints = append(endpoints, endpoint)
+	}
+	return endpoints
+}
+
 func (c BTCConfig) Empty() bool {
 	return c.RPCHost == ""
 }
+
+// Enabled returns true if the admin API is enabled
+func (c AdminAPIConfig) Enabled() bool {
+	return c.Port != 0
+}
@@ -129,6 +129,10 @@ func Test_StringMasked(t *testing.T) {
 	cfg := config.New(true)
 
 	cfg.SolanaConfig.Endpoint += "?api-key=123"
+	evmCfg := cfg.EVMChainConfigs[chains.GoerliLocalnet.ChainId]
+	evmCfg.FallbackEndpoints = []string{"http://fallback:8545?api-key=456"}
+	cfg.EVMChainConfigs[chains.GoerliLocalnet.ChainId] = evmCfg
+	cfg.AdminAPI = config.AdminAPIConfig{Port: 8124, Token: "admin-token-789"}
 
 	// mask the config JSON string
 	masked := cfg.StringMasked()
@@ -140,4 +144,27 @@ func Test_StringMasked(t *testing.T) {
 
 	// should not contain endpoint
 	require.NotContains(t, masked, "?api-key=123")
+	require.NotContains(t, masked, "?api-key=456")
+
+	// should not contain admin API token
+	require.NotContains(t, masked, "admin-token-789")
+}
+
+func Test_EVMConfigEndpoints(t *testing.T) {
+	t.Run("should return primary endpoint only", func(t *testing.T) {
+		cfg := config.EVMConfig{Endpoint: "http://primary"}
+		require.Equal(t, []string{"http://primary"}, cfg.Endpoints())
+	})
+
+	t.Run("should return primary endpoint followed by fallback endpoints without duplicates", func(t *testing.T) {
+		cfg := config.EVMConfig{
+			Endpoint:          "http://primary",
+			FallbackEndpoints: []string{"http://fallback1", "", "http://primary", "http://fallback2", "http://fallback1"},
+		}
+		require.Equal(t, []string{"http://primary", "http://fallback1", "http://fallback2"}, cfg.Endpoints())
+	})
+
+	t.Run("should return empty list if no endpoint", func(t *testing.T) {
+		require.Empty(t, config.EVMConfig{}.Endpoints())
+	})
 }
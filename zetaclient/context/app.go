@@ -2,6 +2,7 @@
 package context
 
 import (
+	"encoding/json"
 	"fmt"
 	"sync"
 
@@ -37,9 +38,28 @@ type AppContext struct {
 	// keygen is the current tss keygen state
 	keygen observertypes.Keygen
 
+	// pausedChains is the set of chains paused locally by the operator
+	pausedChains map[int64]struct{}
+
 	mu sync.RWMutex
 }
 
+// Snapshot is a point-in-time copy of the AppContext used for inspection
+type Snapshot struct {
+	Config           json.RawMessage               `json:"config"`
+	Chains           []ChainSnapshot               `json:"chains"`
+	CurrentTssPubKey string                        `json:"current_tss_pubkey"`
+	CrosschainFlags  observertypes.CrosschainFlags `json:"crosschain_flags"`
+	Keygen           observertypes.Keygen          `json:"keygen"`
+	PausedChainIDs   []int64                       `json:"paused_chain_ids"`
+}
+
+// ChainSnapshot is a point-in-time copy of a chain and its params
+type ChainSnapshot struct {
+	Chain  chains.Chain               `json:"chain"`
+	Params *observertypes.ChainParams `json:"params"`
+}
+
 // New creates and returns new empty AppContext
 func New(cfg config.Config, relayerKeyPasswords map[string]string, logger zerolog.Logger) *AppContext {
 	return &AppContext{
@@ -51,6 +71,7 @@ func New(cfg config.Config, relayerKeyPasswords map[string]string, logger zerolo
 		crosschainFlags:  observertypes.CrosschainFlags{},
 		currentTssPubKey: "",
 		keygen:           observertypes.Keygen{},
+		pausedChains:     make(map[int64]struct{}),
 
 		mu: sync.RWMutex{},
 	}
@@ -92,14 +113,82 @@ func (a *AppContext) FilterChains(filter func(Chain) bool) []Chain {
 	return out
 }
 
-// IsOutboundObservationEnabled returns true if outbound flag is enabled
-func (a *AppContext) IsOutboundObservationEnabled() bool {
-	return a.GetCrossChainFlags().IsOutboundEnabled
+// IsOutboundObservationEnabled returns true if outbound flag is enabled and the outbound of the chain is not paused
+// either by zetacore or locally by the operator
+func (a *AppContext) IsOutboundObservationEnabled(chainID int64) bool {
+	flags := a.GetCrossChainFlags()
+	return flags.IsOutboundEnabled && !flags.IsChainOutboundPaused(chainID) && !a.IsChainPaused(chainID)
+}
+
+// IsInboundObservationEnabled returns true if inbound flag is enabled and the inbound of the chain is not paused
+// either by zetacore or locally by the operator
+// Note: the ZRC20 pause flags are enforced by zetacore when the CCTX is created and its outbound is scheduled
+func (a *AppContext) IsInboundObservationEnabled(chainID int64) bool {
+	flags := a.GetCrossChainFlags()
+	return flags.IsInboundEnabled && !flags.IsChainInboundPaused(chainID) && !a.IsChainPaused(chainID)
+}
+
+// PauseChain locally pauses the inbound and outbound observation of the chain
+func (a *AppContext) PauseChain(chainID int64) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.pausedChains[chainID] = struct{}{}
+}
+
+// ResumeChain resumes the observation of a chain previously paused with PauseChain
+func (a *AppContext) ResumeChain(chainID int64) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	delete(a.pausedChains, chainID)
+}
+
+// IsChainPaused returns true if the chain is locally paused
+func (a *AppContext) IsChainPaused(chainID int64) bool {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	_, paused := a.pausedChains[chainID]
+	return paused
 }
 
-// IsInboundObservationEnabled returns true if inbound flag is enabled
-func (a *AppContext) IsInboundObservationEnabled() bool {
-	return a.GetCrossChainFlags().IsInboundEnabled
+// PausedChainIDs returns the sorted list of locally paused chain ids
+func (a *AppContext) PausedChainIDs() []int64 {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	chainIDs := maps.Keys(a.pausedChains)
+	slices.Sort(chainIDs)
+
+	return chainIDs
+}
+
+// Snapshot returns a copy of the AppContext state, sensitive config fields are masked
+func (a *AppContext) Snapshot() Snapshot {
+	all := a.ListChains()
+	chainSnapshots := make([]ChainSnapshot, 0, len(all))
+	for _, chain := range all {
+		chainSnapshots = append(chainSnapshots, ChainSnapshot{
+			Chain:  *chain.RawChain(),
+			Params: chain.Params(),
+		})
+	}
+
+	// config is left null if it can't be masked
+	var cfg json.RawMessage
+	if masked := a.config.StringMasked(); masked != "" {
+		cfg = json.RawMessage(masked)
+	}
+
+	return Snapshot{
+		Config:           cfg,
+		Chains:           chainSnapshots,
+		CurrentTssPubKey: a.GetCurrentTssPubKey(),
+		CrosschainFlags:  a.GetCrossChainFlags(),
+		Keygen:           a.GetKeygen(),
+		PausedChainIDs:   a.PausedChainIDs(),
+	}
 }
 
 // GetKeygen returns the current keygen
@@ -137,7 +226,7 @@ func (a *AppContext) GetCrossChainFlags() observertypes.CrosschainFlags {
 }
 
 // Update updates AppContext and params for all chains
-// this must be the ONLY function that writes to AppContext
+// this must be the ONLY function that writes zetacore state to AppContext
 func (a *AppContext) Update(
 	keygen observertypes.Keygen,
 	freshChains, additionalChains []chains.Chain,
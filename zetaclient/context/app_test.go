@@ -1,6 +1,7 @@
 package context
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/rs/zerolog"
@@ -67,8 +68,8 @@ func TestAppContext(t *testing.T) {
 		require.Empty(t, appContext.GetKeygen())
 		require.Empty(t, appContext.GetCurrentTssPubKey())
 		require.Empty(t, appContext.GetCrossChainFlags())
-		require.False(t, appContext.IsInboundObservationEnabled())
-		require.False(t, appContext.IsOutboundObservationEnabled())
+		require.False(t, appContext.IsInboundObservationEnabled(chains.Ethereum.ChainId))
+		require.False(t, appContext.IsOutboundObservationEnabled(chains.Ethereum.ChainId))
 
 		// Given some data that is supposed to come from ZetaCore RPC
 		newChains := []chains.Chain{
@@ -99,8 +100,8 @@ func TestAppContext(t *testing.T) {
 		assert.Equal(t, keyGen, appContext.GetKeygen())
 		assert.Equal(t, ttsPubKey, appContext.GetCurrentTssPubKey())
 		assert.Equal(t, ccFlags, appContext.GetCrossChainFlags())
-		assert.True(t, appContext.IsInboundObservationEnabled())
-		assert.True(t, appContext.IsOutboundObservationEnabled())
+		assert.True(t, appContext.IsInboundObservationEnabled(chains.Ethereum.ChainId))
+		assert.True(t, appContext.IsOutboundObservationEnabled(chains.Ethereum.ChainId))
 
 		// Check ETH Chain
 		ethChain, err := appContext.GetChain(1)
@@ -160,6 +161,20 @@ func TestAppContext(t *testing.T) {
 						mustBeNotFound(t, a, chains.OptimismMainnet.ChainId)
 					},
 				},
+				{
+					name: "paused chain disables observation of that chain only",
+					act: func(a *AppContext) error {
+						pausedFlags := ccFlags
+						pausedFlags.PauseChain(chains.BitcoinMainnet.ChainId, true, false)
+						return a.Update(keyGen, newChains, additionalChains, chainParams, ttsPubKey, pausedFlags)
+					},
+					assert: func(t *testing.T, a *AppContext, err error) {
+						assert.NoError(t, err)
+						assert.False(t, a.IsInboundObservationEnabled(chains.BitcoinMainnet.ChainId))
+						assert.True(t, a.IsOutboundObservationEnabled(chains.BitcoinMainnet.ChainId))
+						assert.True(t, a.IsInboundObservationEnabled(chains.Ethereum.ChainId))
+					},
+				},
 				{
 					name: "trying to add zeta chain without chain params is allowed",
 					act: func(a *AppContext) error {
@@ -228,6 +243,70 @@ func TestAppContext(t *testing.T) {
 			}
 		})
 	})
+
+	t.Run("PauseChain", func(t *testing.T) {
+		// Given AppContext with enabled observation
+		appContext := New(testCfg, nil, logger)
+		newChains := []chains.Chain{chains.Ethereum, chains.BitcoinMainnet}
+		chainParams := map[int64]*types.ChainParams{
+			chains.Ethereum.ChainId:       ethParams,
+			chains.BitcoinMainnet.ChainId: btcParams,
+		}
+		require.NoError(t, appContext.Update(keyGen, newChains, nil, chainParams, ttsPubKey, ccFlags))
+
+		// ACT
+		appContext.PauseChain(chains.Ethereum.ChainId)
+
+		// ASSERT
+		assert.True(t, appContext.IsChainPaused(chains.Ethereum.ChainId))
+		assert.False(t, appContext.IsInboundObservationEnabled(chains.Ethereum.ChainId))
+		assert.False(t, appContext.IsOutboundObservationEnabled(chains.Ethereum.ChainId))
+		assert.True(t, appContext.IsInboundObservationEnabled(chains.BitcoinMainnet.ChainId))
+		assert.True(t, appContext.IsOutboundObservationEnabled(chains.BitcoinMainnet.ChainId))
+		assert.Equal(t, []int64{chains.Ethereum.ChainId}, appContext.PausedChainIDs())
+
+		// local pause is kept across updates from zetacore
+		require.NoError(t, appContext.Update(keyGen, newChains, nil, chainParams, ttsPubKey, ccFlags))
+		assert.True(t, appContext.IsChainPaused(chains.Ethereum.ChainId))
+
+		// ACT
+		appContext.ResumeChain(chains.Ethereum.ChainId)
+
+		// ASSERT
+		assert.False(t, appContext.IsChainPaused(chains.Ethereum.ChainId))
+		assert.True(t, appContext.IsInboundObservationEnabled(chains.Ethereum.ChainId))
+		assert.True(t, appContext.IsOutboundObservationEnabled(chains.Ethereum.ChainId))
+		assert.Empty(t, appContext.PausedChainIDs())
+	})
+
+	t.Run("Snapshot", func(t *testing.T) {
+		// Given AppContext with a secret in the config
+		cfg := config.New(false)
+		cfg.AdminAPI = config.AdminAPIConfig{Port: 8887, Token: "admin-secret"}
+
+		appContext := New(cfg, nil, logger)
+		newChains := []chains.Chain{chains.Ethereum}
+		chainParams := map[int64]*types.ChainParams{chains.Ethereum.ChainId: ethParams}
+		require.NoError(t, appContext.Update(keyGen, newChains, nil, chainParams, ttsPubKey, ccFlags))
+		appContext.PauseChain(chains.Ethereum.ChainId)
+
+		// ACT
+		snapshot := appContext.Snapshot()
+
+		// ASSERT
+		require.Len(t, snapshot.Chains, 1)
+		assert.Equal(t, chains.Ethereum, snapshot.Chains[0].Chain)
+		assert.Equal(t, ethParams, snapshot.Chains[0].Params)
+		assert.Equal(t, ttsPubKey, snapshot.CurrentTssPubKey)
+		assert.Equal(t, ccFlags, snapshot.CrosschainFlags)
+		assert.Equal(t, keyGen, snapshot.Keygen)
+		assert.Equal(t, []int64{chains.Ethereum.ChainId}, snapshot.PausedChainIDs)
+
+		// the snapshot is serializable and secrets are masked
+		b, err := json.Marshal(snapshot)
+		require.NoError(t, err)
+		assert.NotContains(t, string(b), "admin-secret")
+	})
 }
 
 func mustBeNotFound(t *testing.T, a *AppContext, chainID int64) {
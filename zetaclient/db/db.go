@@ -33,6 +33,8 @@ var (
 		&types.TransactionResultSQLType{},
 		&types.OutboundHashSQLType{},
 		&types.LastTransactionSQLType{},
+		&types.SchemaVersionSQLType{},
+		&types.OutboundSQLType{},
 	}
 )
 
@@ -68,6 +70,10 @@ func New(dial gorm.Dialector, migrate bool) (*DB, error) {
 		if err := db.AutoMigrate(migrationEntities...); err != nil {
 			return nil, errors.Wrap(err, "unable to migrate database")
 		}
+
+		if err := ensureSchemaVersion(db, types.OutboundSchemaVersion); err != nil {
+			return nil, errors.Wrap(err, "unable to ensure schema version")
+		}
 	}
 
 	return &DB{db}, nil
@@ -92,6 +98,27 @@ func (db *DB) Close() error {
 	return nil
 }
 
+// ensureSchemaVersion records the schema version in the database.
+// It fails if the database was written by a newer zetaclient that uses an unsupported schema.
+func ensureSchemaVersion(db *gorm.DB, version uint32) error {
+	var stored types.SchemaVersionSQLType
+	err := db.Where("id = ?", types.SchemaVersionID).Limit(1).Find(&stored).Error
+	switch {
+	case err != nil:
+		return errors.Wrap(err, "unable to read schema version")
+	case stored.Version > version:
+		return fmt.Errorf("database schema version %d is newer than supported version %d", stored.Version, version)
+	case stored.Version == version:
+		return nil
+	}
+
+	if err := db.Save(types.ToSchemaVersionSQLType(version)).Error; err != nil {
+		return errors.Wrap(err, "unable to save schema version")
+	}
+
+	return nil
+}
+
 func ensurePath(directory, dbName string) (string, error) {
 	// pass in-memory database as is
 	if strings.Contains(directory, SqliteInMemory) {
@@ -0,0 +1,203 @@
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	"github.com/zeta-chain/node/pkg/bg"
+	zctx "github.com/zeta-chain/node/zetaclient/context"
+	"github.com/zeta-chain/node/zetaclient/outboundprocessor"
+)
+
+// ChainStatus is the status of the observer and signer of a chain
+type ChainStatus struct {
+	ChainID          int64        `json:"chain_id"`
+	ChainName        string       `json:"chain_name"`
+	HasObserver      bool         `json:"has_observer"`
+	HasSigner        bool         `json:"has_signer"`
+	Paused           bool         `json:"paused"`
+	Backfilling      bool         `json:"backfilling"`
+	LastBlock        uint64       `json:"last_block"`
+	LastBlockScanned uint64       `json:"last_block_scanned"`
+	LastTxScanned    string       `json:"last_tx_scanned"`
+	Tickers          ChainTickers `json:"tickers"`
+}
+
+// ChainTickers are the ticker intervals (in seconds) the observer and signer of a chain run with
+type ChainTickers struct {
+	InboundTicker             uint64 `json:"inbound_ticker"`
+	OutboundTicker            uint64 `json:"outbound_ticker"`
+	GasPriceTicker            uint64 `json:"gas_price_ticker"`
+	WatchUtxoTicker           uint64 `json:"watch_utxo_ticker"`
+	OutboundScheduleInterval  int64  `json:"outbound_schedule_interval"`
+	OutboundScheduleLookahead int64  `json:"outbound_schedule_lookahead"`
+}
+
+// scanProgress is implemented by the observers to report how far they scanned the chain
+type scanProgress interface {
+	LastBlock() uint64
+	LastBlockScanned() uint64
+	LastTxScanned() string
+}
+
+// inboundBackfiller is implemented by the observers able to backfill the missed inbounds of a block range
+type inboundBackfiller interface {
+	BackfillInbound(ctx context.Context, from, to uint64) error
+}
+
+// blockRescanner is implemented by the observers that scan the chain block by block
+type blockRescanner interface {
+	SaveLastBlockScanned(blockNumber uint64) error
+}
+
+// ChainStatuses returns the status of the observers and signers of all the external chains
+func (oc *Orchestrator) ChainStatuses(app *zctx.AppContext) []ChainStatus {
+	chains := app.FilterChains(func(c zctx.Chain) bool { return !c.IsZeta() })
+
+	statuses := make([]ChainStatus, 0, len(chains))
+	for _, chain := range chains {
+		status := ChainStatus{
+			ChainID:   chain.ID(),
+			ChainName: chain.Name(),
+			Paused:    app.IsChainPaused(chain.ID()),
+		}
+
+		// prefer the params the observer is running with over the ones from zetacore
+		params := *chain.Params()
+		if observer, err := oc.getObserver(chain.ID()); err == nil {
+			status.HasObserver = true
+			params = observer.ChainParams()
+
+			if progress, ok := observer.(scanProgress); ok {
+				status.LastBlock = progress.LastBlock()
+				status.LastBlockScanned = progress.LastBlockScanned()
+				status.LastTxScanned = progress.LastTxScanned()
+			}
+		}
+		if _, err := oc.getSigner(chain.ID()); err == nil {
+			status.HasSigner = true
+		}
+		status.Backfilling = oc.isBackfilling(chain.ID())
+
+		status.Tickers = ChainTickers{
+			InboundTicker:             params.InboundTicker,
+			OutboundTicker:            params.OutboundTicker,
+			GasPriceTicker:            params.GasPriceTicker,
+			WatchUtxoTicker:           params.WatchUtxoTicker,
+			OutboundScheduleInterval:  params.OutboundScheduleInterval,
+			OutboundScheduleLookahead: params.OutboundScheduleLookahead,
+		}
+
+		statuses = append(statuses, status)
+	}
+
+	return statuses
+}
+
+// ActiveOutbounds returns the outbounds being processed by the signers
+func (oc *Orchestrator) ActiveOutbounds() []outboundprocessor.ActiveOutbound {
+	return oc.outboundProc.ActiveOutbounds()
+}
+
+// RescanFromHeight forces the observer of the chain to scan inbounds again starting from the given height
+// Only the chains scanned block by block (EVM and Bitcoin) can be rescanned from a height
+func (oc *Orchestrator) RescanFromHeight(app *zctx.AppContext, chainID int64, height uint64) error {
+	chain, err := app.GetChain(chainID)
+	switch {
+	case err != nil:
+		return errors.Wrapf(err, "unable to get chain %d", chainID)
+	case !chain.IsEVM() && !chain.IsBitcoin():
+		return fmt.Errorf("rescan from height is not supported for chain %d", chainID)
+	case height == 0:
+		return errors.New("rescan height must be positive")
+	}
+
+	observer, err := oc.getObserver(chainID)
+	if err != nil {
+		return err
+	}
+
+	rescanner, ok := observer.(blockRescanner)
+	if !ok {
+		return fmt.Errorf("observer of chain %d can't be rescanned", chainID)
+	}
+
+	// the observer resumes scanning from the block following the last scanned one
+	if err := rescanner.SaveLastBlockScanned(height - 1); err != nil {
+		return errors.Wrapf(err, "unable to save last block scanned for chain %d", chainID)
+	}
+
+	oc.logger.Warn().
+		Int64("chain_id", chainID).
+		Uint64("height", height).
+		Msg("forced inbound rescan from height")
+
+	return nil
+}
+
+// BackfillInbound starts replaying the inbound scanning of the chain over the block range [from, to] in the background
+// to vote for the missed inbounds. Only one backfill can run at a time for a chain.
+func (oc *Orchestrator) BackfillInbound(app *zctx.AppContext, chainID int64, from, to uint64) error {
+	if _, err := app.GetChain(chainID); err != nil {
+		return errors.Wrapf(err, "unable to get chain %d", chainID)
+	}
+
+	observer, err := oc.getObserver(chainID)
+	if err != nil {
+		return err
+	}
+
+	backfiller, ok := observer.(inboundBackfiller)
+	if !ok {
+		return fmt.Errorf("inbound backfill is not supported for chain %d", chainID)
+	}
+
+	oc.mu.Lock()
+	if _, found := oc.backfills[chainID]; found {
+		oc.mu.Unlock()
+		return fmt.Errorf("an inbound backfill is already running for chain %d", chainID)
+	}
+	oc.backfills[chainID] = struct{}{}
+	oc.mu.Unlock()
+
+	// the backfill is cancelled when the orchestrator stops
+	ctx, cancel := context.WithCancel(zctx.WithAppContext(context.Background(), app))
+	go func() {
+		select {
+		case <-oc.stop:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	oc.logger.Warn().
+		Int64("chain_id", chainID).
+		Uint64("from", from).
+		Uint64("to", to).
+		Msg("started inbound backfill")
+
+	bg.Work(ctx, func(ctx context.Context) error {
+		defer func() {
+			cancel()
+
+			oc.mu.Lock()
+			delete(oc.backfills, chainID)
+			oc.mu.Unlock()
+		}()
+
+		return backfiller.BackfillInbound(ctx, from, to)
+	}, bg.WithName("BackfillInbound"), bg.WithLogger(oc.logger.Logger))
+
+	return nil
+}
+
+// isBackfilling returns true if an inbound backfill is running for the chain
+func (oc *Orchestrator) isBackfilling(chainID int64) bool {
+	oc.mu.RLock()
+	defer oc.mu.RUnlock()
+
+	_, found := oc.backfills[chainID]
+	return found
+}
@@ -0,0 +1,235 @@
+package orchestrator
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/node/pkg/chains"
+	"github.com/zeta-chain/node/zetaclient/outboundprocessor"
+	"github.com/zeta-chain/node/zetaclient/testutils/mocks"
+)
+
+// scanningObserver is a mock observer that records the scanning progress
+type scanningObserver struct {
+	*mocks.EVMObserver
+	lastBlock        uint64
+	lastBlockScanned uint64
+}
+
+func (ob *scanningObserver) LastBlock() uint64 { return ob.lastBlock }
+
+func (ob *scanningObserver) LastBlockScanned() uint64 { return ob.lastBlockScanned }
+
+func (ob *scanningObserver) LastTxScanned() string { return "" }
+
+func (ob *scanningObserver) SaveLastBlockScanned(blockNumber uint64) error {
+	ob.lastBlockScanned = blockNumber
+	return nil
+}
+
+// backfillingObserver is a mock observer that records the inbound backfills
+type backfillingObserver struct {
+	*mocks.EVMObserver
+	release chan struct{}
+	from    uint64
+	to      uint64
+}
+
+func (ob *backfillingObserver) BackfillInbound(ctx context.Context, from, to uint64) error {
+	ob.from, ob.to = from, to
+
+	select {
+	case <-ob.release:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func Test_ChainStatuses(t *testing.T) {
+	var (
+		evmChain       = chains.Ethereum
+		btcChain       = chains.BitcoinMainnet
+		evmChainParams = mocks.MockChainParams(evmChain.ChainId, 100)
+		btcChainParams = mocks.MockChainParams(btcChain.ChainId, 100)
+	)
+
+	orchestrator := mockOrchestrator(t, nil, evmChain, btcChain, evmChainParams, btcChainParams)
+	appContext := createAppContext(t, evmChain, btcChain, evmChainParams, btcChainParams)
+
+	// replace the evm observer with one reporting its progress and remove the btc signer
+	orchestrator.observerMap[evmChain.ChainId] = &scanningObserver{
+		EVMObserver:      mocks.NewEVMObserver(&evmChainParams),
+		lastBlock:        1000,
+		lastBlockScanned: 990,
+	}
+	delete(orchestrator.signerMap, btcChain.ChainId)
+	appContext.PauseChain(btcChain.ChainId)
+
+	statuses := orchestrator.ChainStatuses(appContext)
+	require.Len(t, statuses, 2)
+
+	// chains are sorted by chain id
+	evmStatus := statuses[0]
+	require.Equal(t, evmChain.ChainId, evmStatus.ChainID)
+	require.Equal(t, evmChain.Name, evmStatus.ChainName)
+	require.True(t, evmStatus.HasObserver)
+	require.True(t, evmStatus.HasSigner)
+	require.False(t, evmStatus.Paused)
+	require.EqualValues(t, 1000, evmStatus.LastBlock)
+	require.EqualValues(t, 990, evmStatus.LastBlockScanned)
+	require.Equal(t, evmChainParams.InboundTicker, evmStatus.Tickers.InboundTicker)
+	require.Equal(t, evmChainParams.OutboundScheduleInterval, evmStatus.Tickers.OutboundScheduleInterval)
+
+	btcStatus := statuses[1]
+	require.Equal(t, btcChain.ChainId, btcStatus.ChainID)
+	require.True(t, btcStatus.HasObserver)
+	require.False(t, btcStatus.HasSigner)
+	require.True(t, btcStatus.Paused)
+	require.Zero(t, btcStatus.LastBlockScanned)
+}
+
+func Test_ActiveOutbounds(t *testing.T) {
+	orchestrator := mockOrchestrator(t, nil)
+	orchestrator.outboundProc = outboundprocessor.NewProcessor(orchestrator.logger.Logger)
+
+	outboundID := outboundprocessor.ToOutboundID("0x123", chains.Ethereum.ChainId, 1)
+	orchestrator.outboundProc.StartTryProcess(outboundID)
+
+	outbounds := orchestrator.ActiveOutbounds()
+	require.Len(t, outbounds, 1)
+	require.Equal(t, outboundID, outbounds[0].OutboundID)
+}
+
+func Test_RescanFromHeight(t *testing.T) {
+	var (
+		evmChain       = chains.Ethereum
+		btcChain       = chains.BitcoinMainnet
+		solChain       = chains.SolanaMainnet
+		evmChainParams = mocks.MockChainParams(evmChain.ChainId, 100)
+		btcChainParams = mocks.MockChainParams(btcChain.ChainId, 100)
+		solChainParams = mocks.MockChainParams(solChain.ChainId, 100)
+	)
+
+	setup := func(t *testing.T) (*Orchestrator, *scanningObserver) {
+		orchestrator := mockOrchestrator(t, nil,
+			evmChain, btcChain, solChain,
+			evmChainParams, btcChainParams, solChainParams,
+		)
+		observer := &scanningObserver{EVMObserver: mocks.NewEVMObserver(&evmChainParams), lastBlockScanned: 990}
+		orchestrator.observerMap[evmChain.ChainId] = observer
+
+		return orchestrator, observer
+	}
+	appContext := createAppContext(t,
+		evmChain, btcChain, solChain,
+		evmChainParams, btcChainParams, solChainParams,
+	)
+
+	t.Run("should rescan from height", func(t *testing.T) {
+		orchestrator, observer := setup(t)
+
+		err := orchestrator.RescanFromHeight(appContext, evmChain.ChainId, 500)
+		require.NoError(t, err)
+		require.EqualValues(t, 499, observer.lastBlockScanned)
+	})
+
+	t.Run("should fail for unknown chain", func(t *testing.T) {
+		orchestrator, _ := setup(t)
+
+		err := orchestrator.RescanFromHeight(appContext, chains.BscMainnet.ChainId, 500)
+		require.ErrorContains(t, err, "unable to get chain")
+	})
+
+	t.Run("should fail for chain not scanned by height", func(t *testing.T) {
+		orchestrator, _ := setup(t)
+
+		err := orchestrator.RescanFromHeight(appContext, solChain.ChainId, 500)
+		require.ErrorContains(t, err, "not supported")
+	})
+
+	t.Run("should fail for zero height", func(t *testing.T) {
+		orchestrator, observer := setup(t)
+
+		err := orchestrator.RescanFromHeight(appContext, evmChain.ChainId, 0)
+		require.ErrorContains(t, err, "must be positive")
+		require.EqualValues(t, 990, observer.lastBlockScanned)
+	})
+
+	t.Run("should fail if observer can't be rescanned", func(t *testing.T) {
+		orchestrator, _ := setup(t)
+
+		err := orchestrator.RescanFromHeight(appContext, btcChain.ChainId, 500)
+		require.ErrorContains(t, err, "can't be rescanned")
+	})
+}
+
+func Test_BackfillInbound(t *testing.T) {
+	var (
+		evmChain       = chains.Ethereum
+		btcChain       = chains.BitcoinMainnet
+		evmChainParams = mocks.MockChainParams(evmChain.ChainId, 100)
+		btcChainParams = mocks.MockChainParams(btcChain.ChainId, 100)
+	)
+
+	setup := func(t *testing.T) (*Orchestrator, *backfillingObserver) {
+		orchestrator := mockOrchestrator(t, nil, evmChain, btcChain, evmChainParams, btcChainParams)
+		observer := &backfillingObserver{
+			EVMObserver: mocks.NewEVMObserver(&evmChainParams),
+			release:     make(chan struct{}),
+		}
+		orchestrator.observerMap[evmChain.ChainId] = observer
+
+		return orchestrator, observer
+	}
+	appContext := createAppContext(t, evmChain, btcChain, evmChainParams, btcChainParams)
+
+	t.Run("should run a single backfill at a time", func(t *testing.T) {
+		orchestrator, observer := setup(t)
+
+		err := orchestrator.BackfillInbound(appContext, evmChain.ChainId, 100, 200)
+		require.NoError(t, err)
+		require.True(t, orchestrator.isBackfilling(evmChain.ChainId))
+
+		err = orchestrator.BackfillInbound(appContext, evmChain.ChainId, 300, 400)
+		require.ErrorContains(t, err, "already running")
+
+		close(observer.release)
+		require.Eventually(t, func() bool {
+			return !orchestrator.isBackfilling(evmChain.ChainId)
+		}, time.Second, 10*time.Millisecond)
+		require.EqualValues(t, 100, observer.from)
+		require.EqualValues(t, 200, observer.to)
+	})
+
+	t.Run("should cancel the backfill when the orchestrator stops", func(t *testing.T) {
+		orchestrator, _ := setup(t)
+		orchestrator.stop = make(chan struct{})
+
+		err := orchestrator.BackfillInbound(appContext, evmChain.ChainId, 100, 200)
+		require.NoError(t, err)
+
+		close(orchestrator.stop)
+		require.Eventually(t, func() bool {
+			return !orchestrator.isBackfilling(evmChain.ChainId)
+		}, time.Second, 10*time.Millisecond)
+	})
+
+	t.Run("should fail for unknown chain", func(t *testing.T) {
+		orchestrator, _ := setup(t)
+
+		err := orchestrator.BackfillInbound(appContext, chains.BscMainnet.ChainId, 100, 200)
+		require.ErrorContains(t, err, "unable to get chain")
+	})
+
+	t.Run("should fail if observer can't backfill", func(t *testing.T) {
+		orchestrator, _ := setup(t)
+
+		err := orchestrator.BackfillInbound(appContext, btcChain.ChainId, 100, 200)
+		require.ErrorContains(t, err, "not supported")
+		require.False(t, orchestrator.isBackfilling(btcChain.ChainId))
+	})
+}
@@ -4,10 +4,7 @@ import (
 	"context"
 
 	ethcommon "github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/ethclient"
-	ethrpc "github.com/ethereum/go-ethereum/rpc"
 	solrpc "github.com/gagliardetto/solana-go/rpc"
-	ethrpc2 "github.com/onrik/ethrpc"
 	"github.com/pkg/errors"
 	"github.com/tonkeeper/tongo/ton"
 
@@ -17,6 +14,7 @@ import (
 	"github.com/zeta-chain/node/zetaclient/chains/bitcoin/rpc"
 	btcsigner "github.com/zeta-chain/node/zetaclient/chains/bitcoin/signer"
 	evmobserver "github.com/zeta-chain/node/zetaclient/chains/evm/observer"
+	evmrpc "github.com/zeta-chain/node/zetaclient/chains/evm/rpc"
 	evmsigner "github.com/zeta-chain/node/zetaclient/chains/evm/signer"
 	"github.com/zeta-chain/node/zetaclient/chains/interfaces"
 	solbserver "github.com/zeta-chain/node/zetaclient/chains/solana/observer"
@@ -132,7 +130,7 @@ func syncSignerMap(
 				tss,
 				ts,
 				logger,
-				cfg.Endpoint,
+				cfg.Endpoints(),
 				zetaConnectorAddress,
 				erc20CustodyAddress,
 				gatewayAddress,
@@ -310,17 +308,16 @@ func syncObserverMap(
 				continue
 			}
 
-			httpClient, err := metrics.GetInstrumentedHTTPClient(cfg.Endpoint)
+			endpoints, err := evmrpc.DialEndpoints(cfg.Endpoints())
 			if err != nil {
-				logger.Std.Error().Err(err).Str("rpc.endpoint", cfg.Endpoint).Msgf("Unable to create HTTP client")
+				logger.Std.Error().Err(err).Msgf("Unable to dial EVM RPC endpoints for chain %d", chainID)
 				continue
 			}
-			rpcClient, err := ethrpc.DialHTTPWithClient(cfg.Endpoint, httpClient)
+			evmClient, err := evmrpc.NewFailoverClient(endpoints, cfg.RPCQuorum, logger.Std)
 			if err != nil {
-				logger.Std.Error().Err(err).Str("rpc.endpoint", cfg.Endpoint).Msgf("Unable to dial EVM RPC")
+				logger.Std.Error().Err(err).Msgf("Unable to create EVM RPC client for chain %d", chainID)
 				continue
 			}
-			evmClient := ethclient.NewClient(rpcClient)
 
 			database, err := db.NewFromSqlite(dbpath, chainName, true)
 			if err != nil {
@@ -328,14 +325,12 @@ func syncObserverMap(
 				continue
 			}
 
-			evmJSONRPCClient := ethrpc2.NewEthRPC(cfg.Endpoint, ethrpc2.WithHttpClient(httpClient))
-
 			// create EVM chain observer
 			observer, err := evmobserver.NewObserver(
 				ctx,
 				*rawChain,
 				evmClient,
-				evmJSONRPCClient,
+				evmClient,
 				*params,
 				client,
 				tss,
@@ -371,7 +366,7 @@ func syncObserverMap(
 
 			btcObserver, err := btcobserver.NewObserver(
 				*rawChain,
-				btcRPC,
+				rpc.NewCachedClient(btcRPC),
 				*params,
 				client,
 				tss,
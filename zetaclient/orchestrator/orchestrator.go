@@ -21,7 +21,9 @@ import (
 	"github.com/zeta-chain/node/x/crosschain/types"
 	observertypes "github.com/zeta-chain/node/x/observer/types"
 	"github.com/zeta-chain/node/zetaclient/chains/base"
+	"github.com/zeta-chain/node/zetaclient/chains/bitcoin"
 	btcobserver "github.com/zeta-chain/node/zetaclient/chains/bitcoin/observer"
+	btcsigner "github.com/zeta-chain/node/zetaclient/chains/bitcoin/signer"
 	"github.com/zeta-chain/node/zetaclient/chains/interfaces"
 	solanaobserver "github.com/zeta-chain/node/zetaclient/chains/solana/observer"
 	tonobserver "github.com/zeta-chain/node/zetaclient/chains/ton/observer"
@@ -42,6 +44,9 @@ const (
 
 	// sampling rate for sampled orchestrator logger
 	loggerSamplingRate = 10
+
+	// utxoConsolidationInterval is the interval (in ZetaChain blocks) to check the need of Bitcoin UTXO consolidation
+	utxoConsolidationInterval = 100
 )
 
 var defaultLogSampler = &zerolog.BasicSampler{N: loggerSamplingRate}
@@ -60,6 +65,9 @@ type Orchestrator struct {
 	// outbound processor
 	outboundProc *outboundprocessor.Processor
 
+	// backfills contains the chains with an inbound backfill running
+	backfills map[int64]struct{}
+
 	// last operator balance
 	lastOperatorBalance sdkmath.Int
 
@@ -110,6 +118,7 @@ func New(
 		observerMap: observerMap,
 
 		outboundProc:        outboundprocessor.NewProcessor(logger.Std),
+		backfills:           make(map[int64]struct{}),
 		lastOperatorBalance: balance,
 
 		// observer & signer props
@@ -287,7 +296,7 @@ func (oc *Orchestrator) GetPendingCctxsWithinRateLimit(ctx context.Context, chai
 		for _, chainID := range chainIDs {
 			resp, _, err := oc.zetacoreClient.ListPendingCCTX(ctx, chainID)
 			if err == nil && resp != nil {
-				cctxsMap[chainID] = resp
+				cctxsMap[chainID] = skipDelayedCctxs(resp)
 			}
 		}
 		return cctxsMap, nil
@@ -314,8 +323,23 @@ func (oc *Orchestrator) GetPendingCctxsWithinRateLimit(ctx context.Context, chai
 		oc.logger.Sampled.Info().Msgf("current rate limiter window: %d rate: %s, percentage: %f",
 			output.CurrentWithdrawWindow, output.CurrentWithdrawRate.String(), percentageFloat)
 	}
+	if output.QuotaExceeded {
+		oc.logger.Sampled.Info().Msg("pending cctxs are held back by the rate limiter chain or asset quotas")
+	}
+
+	for chainID, cctxs := range output.CctxsMap {
+		cctxsMap[chainID] = skipDelayedCctxs(cctxs)
+	}
+
+	return cctxsMap, nil
+}
 
-	return output.CctxsMap, nil
+// skipDelayedCctxs removes the cctxs whose outbound is held back by the outbound delay
+// the outbound of a delayed cctx is scheduled by zetacore once the delay is over
+func skipDelayedCctxs(cctxs []*types.CrossChainTx) []*types.CrossChainTx {
+	return lo.Filter(cctxs, func(cctx *types.CrossChainTx, _ int) bool {
+		return cctx.CctxStatus.Status != types.CctxStatus_PendingDelay
+	})
 }
 
 // schedules keysigns for cctxs on each ZetaChain block (the ticker)
@@ -411,17 +435,21 @@ func (oc *Orchestrator) runScheduler(ctx context.Context) error {
 							WithLabelValues(chain.Name()).
 							Set(float64(len(cctxList)))
 
-						if len(cctxList) == 0 {
-							continue
-						}
-
-						if !app.IsOutboundObservationEnabled() {
+						if !app.IsOutboundObservationEnabled(chainID) {
 							continue
 						}
 
 						// #nosec G115 range is verified
 						zetaHeight := uint64(bn)
 
+						// consolidate the TSS UTXOs during quiet periods
+						if len(cctxList) == 0 {
+							if chain.IsBitcoin() {
+								oc.ScheduleUTXOConsolidation(ctx, zetaHeight, chainID, ob)
+							}
+							continue
+						}
+
 						switch {
 						case chain.IsEVM():
 							oc.ScheduleCctxEVM(ctx, zetaHeight, chainID, cctxList, ob, signer)
@@ -562,6 +590,11 @@ func (oc *Orchestrator) ScheduleCctxBTC(
 		oc.logger.Error().Msgf("ScheduleCctxBTC: chain observer is not a bitcoin observer")
 		return
 	}
+	btcSigner, ok := signer.(*btcsigner.Signer)
+	if !ok { // should never happen
+		oc.logger.Error().Msgf("ScheduleCctxBTC: chain signer is not a bitcoin signer")
+		return
+	}
 	// #nosec G115 positive
 	interval := uint64(observer.ChainParams().OutboundScheduleInterval)
 	lookahead := observer.ChainParams().OutboundScheduleLookahead
@@ -605,11 +638,14 @@ func (oc *Orchestrator) ScheduleCctxBTC(
 		}
 		// schedule a TSS keysign
 		if nonce%interval == zetaHeight%interval && !oc.outboundProc.IsOutboundActive(outboundID) {
+			// pack the following pending cctxs into the same outbound
+			batch := btcOutboundBatch(cctxList[idx:])
 			oc.outboundProc.StartTryProcess(outboundID)
-			oc.logger.Debug().Msgf("ScheduleCctxBTC: sign outbound %s with value %d", outboundID, params.Amount)
-			go signer.TryProcessOutbound(
+			oc.logger.Debug().
+				Msgf("ScheduleCctxBTC: sign outbound %s with value %d, batch size %d", outboundID, params.Amount, len(batch))
+			go btcSigner.TryProcessOutboundBatch(
 				ctx,
-				cctx,
+				batch,
 				oc.outboundProc,
 				outboundID,
 				observer,
@@ -620,6 +656,62 @@ func (oc *Orchestrator) ScheduleCctxBTC(
 	}
 }
 
+// btcOutboundBatch returns the leading cctxs of consecutive nonces (up to the max batch size) to be paid by one outbound
+func btcOutboundBatch(cctxList []*types.CrossChainTx) []*types.CrossChainTx {
+	batch := make([]*types.CrossChainTx, 0, bitcoin.MaxOutboundBatchSize)
+	for i, cctx := range cctxList {
+		if i == bitcoin.MaxOutboundBatchSize {
+			break
+		}
+		params := cctx.GetCurrentOutboundParam()
+		if i > 0 {
+			prev := batch[i-1].GetCurrentOutboundParam()
+			if params.ReceiverChainId != prev.ReceiverChainId || params.TssNonce != prev.TssNonce+1 {
+				break
+			}
+			// a consolidation is never batched with other cctxs
+			if cctx.IsUTXOConsolidation() || batch[0].IsUTXOConsolidation() {
+				break
+			}
+		}
+		batch = append(batch, cctx)
+	}
+	return batch
+}
+
+// ScheduleUTXOConsolidation schedules the consolidation of the TSS UTXOs of a bitcoin chain
+// It's checked every utxoConsolidationInterval ZetaChain blocks while the chain has no pending cctx
+func (oc *Orchestrator) ScheduleUTXOConsolidation(
+	ctx context.Context,
+	zetaHeight uint64,
+	chainID int64,
+	observer interfaces.ChainObserver,
+) {
+	if zetaHeight%utxoConsolidationInterval != 0 {
+		return
+	}
+
+	btcObserver, ok := observer.(*btcobserver.Observer)
+	if !ok { // should never happen
+		oc.logger.Error().Msgf("ScheduleUTXOConsolidation: chain observer is not a bitcoin observer")
+		return
+	}
+	utxoCount, dustCount, needed := btcObserver.UTXOConsolidationNeeded()
+	if !needed {
+		return
+	}
+
+	zetaHash, err := oc.zetacoreClient.PostConsolidateUTXOs(ctx, chainID, utxoCount, dustCount)
+	if err != nil {
+		oc.logger.Error().
+			Err(err).
+			Msgf("ScheduleUTXOConsolidation: PostConsolidateUTXOs failed for chain %d", chainID)
+		return
+	}
+	oc.logger.Info().
+		Msgf("ScheduleUTXOConsolidation: voted UTXO consolidation for chain %d, zeta tx %s", chainID, zetaHash)
+}
+
 // ScheduleCctxSolana schedules solana outbound keysign on each ZetaChain block (the ticker)
 func (oc *Orchestrator) ScheduleCctxSolana(
 	ctx context.Context,
@@ -14,11 +14,13 @@ import (
 
 	"github.com/zeta-chain/node/pkg/chains"
 	"github.com/zeta-chain/node/pkg/coin"
+	"github.com/zeta-chain/node/pkg/constant"
 	solanacontracts "github.com/zeta-chain/node/pkg/contracts/solana"
 	"github.com/zeta-chain/node/testutil/sample"
 	crosschainkeeper "github.com/zeta-chain/node/x/crosschain/keeper"
 	crosschaintypes "github.com/zeta-chain/node/x/crosschain/types"
 	observertypes "github.com/zeta-chain/node/x/observer/types"
+	"github.com/zeta-chain/node/zetaclient/chains/bitcoin"
 	"github.com/zeta-chain/node/zetaclient/chains/interfaces"
 	"github.com/zeta-chain/node/zetaclient/config"
 	"github.com/zeta-chain/node/zetaclient/testutils"
@@ -436,6 +438,20 @@ func Test_GetPendingCctxsWithinRateLimit(t *testing.T) {
 	allCctxsPending := crosschainkeeper.SortCctxsByHeightAndChainID(
 		append(append([]*crosschaintypes.CrossChainTx{}, ethCctxsPending...), btcCctxsPending...))
 
+	// a cctx held back by the outbound delay
+	ethCctxDelayed := sample.CustomCctxsInBlockRange(
+		t,
+		101,
+		101,
+		zetaChainID,
+		ethChain.ChainId,
+		coin.CoinType_Gas,
+		"",
+		uint64(2e18),
+		crosschaintypes.CctxStatus_PendingDelay,
+	)
+	ethCctxsAllWithDelayed := append(append([]*crosschaintypes.CrossChainTx{}, ethCctxsAll...), ethCctxDelayed...)
+
 	// define test cases
 	tests := []struct {
 		name             string
@@ -483,6 +499,17 @@ func Test_GetPendingCctxsWithinRateLimit(t *testing.T) {
 				btcChain.ChainId: btcCctxsAll,
 			},
 		},
+		{
+			name:             "should skip delayed cctxs",
+			rateLimiterFlags: &crosschaintypes.RateLimiterFlags{Enabled: false},
+			response:         &crosschaintypes.QueryRateLimiterInputResponse{},
+			ethCctxsFallback: ethCctxsAllWithDelayed,
+			btcCctxsFallback: btcCctxsAll,
+			expectedCctxsMap: map[int64][]*crosschaintypes.CrossChainTx{
+				ethChain.ChainId: ethCctxsAll,
+				btcChain.ChainId: btcCctxsAll,
+			},
+		},
 		{
 			name:             "should fail if cannot query rate limiter flags",
 			rateLimiterFlags: nil,
@@ -541,6 +568,62 @@ func Test_GetPendingCctxsWithinRateLimit(t *testing.T) {
 	}
 }
 
+func Test_BtcOutboundBatch(t *testing.T) {
+	btcChainID := chains.BitcoinMainnet.ChainId
+	zetaChainID := chains.ZetaChainMainnet.ChainId
+
+	// create 15 pending cctxs of nonces [0, 14]
+	cctxs := sample.CustomCctxsInBlockRange(
+		t,
+		1,
+		15,
+		zetaChainID,
+		btcChainID,
+		coin.CoinType_Gas,
+		"",
+		2000,
+		crosschaintypes.CctxStatus_PendingOutbound,
+	)
+
+	t.Run("should batch up to max batch size", func(t *testing.T) {
+		batch := btcOutboundBatch(cctxs)
+		require.Len(t, batch, bitcoin.MaxOutboundBatchSize)
+		require.Equal(t, cctxs[:bitcoin.MaxOutboundBatchSize], batch)
+	})
+
+	t.Run("should batch the remaining cctxs", func(t *testing.T) {
+		batch := btcOutboundBatch(cctxs[12:])
+		require.Equal(t, cctxs[12:], batch)
+	})
+
+	t.Run("should stop at nonce gap", func(t *testing.T) {
+		list := append([]*crosschaintypes.CrossChainTx{}, cctxs[:3]...)
+		list = append(list, cctxs[4:]...)
+		batch := btcOutboundBatch(list)
+		require.Equal(t, cctxs[:3], batch)
+	})
+
+	t.Run("should never batch consolidation", func(t *testing.T) {
+		// turn the cctx of nonce 3 into a consolidation
+		consolidation := *cctxs[3]
+		inboundParams := *consolidation.InboundParams
+		inboundParams.CoinType = coin.CoinType_Cmd
+		consolidation.InboundParams = &inboundParams
+		consolidation.RelayedMessage = constant.CmdConsolidateUTXOs
+
+		list := append([]*crosschaintypes.CrossChainTx{}, cctxs[:3]...)
+		list = append(list, &consolidation)
+		list = append(list, cctxs[4:]...)
+
+		require.Equal(t, cctxs[:3], btcOutboundBatch(list))
+		require.Equal(t, []*crosschaintypes.CrossChainTx{&consolidation}, btcOutboundBatch(list[3:]))
+	})
+
+	t.Run("should return empty batch for empty list", func(t *testing.T) {
+		require.Empty(t, btcOutboundBatch(nil))
+	})
+}
+
 func mockOrchestrator(t *testing.T, zetaClient interfaces.ZetacoreClient, chainsOrParams ...any) *Orchestrator {
 	supportedChains, obsParams := parseChainsWithParams(t, chainsOrParams...)
 
@@ -590,6 +673,7 @@ func mockOrchestrator(t *testing.T, zetaClient interfaces.ZetacoreClient, chains
 		zetacoreClient: zetaClient,
 		signerMap:      signers,
 		observerMap:    observers,
+		backfills:      make(map[int64]struct{}),
 	}
 }
 
@@ -3,6 +3,7 @@ package outboundprocessor
 
 import (
 	"fmt"
+	"sort"
 	"sync"
 	"time"
 
@@ -21,6 +22,13 @@ type Processor struct {
 	numActiveProcessor int64
 }
 
+// ActiveOutbound is an outbound being processed
+type ActiveOutbound struct {
+	OutboundID string        `json:"outbound_id"`
+	StartTime  time.Time     `json:"start_time"`
+	Elapsed    time.Duration `json:"elapsed"`
+}
+
 // NewProcessor creates a new Processor
 func NewProcessor(logger zerolog.Logger) *Processor {
 	return &Processor{
@@ -72,6 +80,27 @@ func (p *Processor) TimeInTryProcess(outboundID string) time.Duration {
 	return 0
 }
 
+// ActiveOutbounds returns the outbounds being processed sorted by outbound ID
+func (p *Processor) ActiveOutbounds() []ActiveOutbound {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	outbounds := make([]ActiveOutbound, 0, len(p.outboundActive))
+	for outboundID := range p.outboundActive {
+		startTime := p.outboundStartTime[outboundID]
+		outbounds = append(outbounds, ActiveOutbound{
+			OutboundID: outboundID,
+			StartTime:  startTime,
+			Elapsed:    time.Since(startTime),
+		})
+	}
+	sort.Slice(outbounds, func(i, j int) bool {
+		return outbounds[i].OutboundID < outbounds[j].OutboundID
+	})
+
+	return outbounds
+}
+
 // ToOutboundID returns the outbound ID for OutboundProcessor to track
 func ToOutboundID(index string, receiverChainID int64, nonce uint64) string {
 	return fmt.Sprintf("%s-%d-%d", index, receiverChainID, nonce)
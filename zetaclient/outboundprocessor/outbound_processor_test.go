@@ -1 +1,33 @@
 package outboundprocessor_test
+
+import (
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/node/zetaclient/outboundprocessor"
+)
+
+func TestProcessor_ActiveOutbounds(t *testing.T) {
+	p := outboundprocessor.NewProcessor(zerolog.Nop())
+	require.Empty(t, p.ActiveOutbounds())
+
+	outboundID1 := outboundprocessor.ToOutboundID("0x123", 1, 2)
+	outboundID2 := outboundprocessor.ToOutboundID("0x456", 1, 3)
+	p.StartTryProcess(outboundID2)
+	p.StartTryProcess(outboundID1)
+
+	// outbounds are sorted by ID
+	outbounds := p.ActiveOutbounds()
+	require.Len(t, outbounds, 2)
+	require.Equal(t, outboundID1, outbounds[0].OutboundID)
+	require.Equal(t, outboundID2, outbounds[1].OutboundID)
+	require.False(t, outbounds[0].StartTime.IsZero())
+
+	// ended outbounds are no longer listed
+	p.EndTryProcess(outboundID1)
+	outbounds = p.ActiveOutbounds()
+	require.Len(t, outbounds, 1)
+	require.Equal(t, outboundID2, outbounds[0].OutboundID)
+}
@@ -27,6 +27,9 @@ type Input struct {
 
 	// the lowest height of the pending (not missed) cctxs across all chains
 	LowestPendingCctxHeight int64
+
+	// whether pending cctxs are held back by zetacore for exceeding a chain or asset quota
+	QuotaExceeded bool
 }
 
 // Output is the output data for the rate limiter
@@ -42,6 +45,9 @@ type Output struct {
 
 	// wehther the current withdraw rate exceeds the given rate limit or not
 	RateLimitExceeded bool
+
+	// whether pending cctxs are held back for exceeding a chain or asset quota
+	QuotaExceeded bool
 }
 
 // NewInput creates a rate limiter input from gRPC response
@@ -65,6 +71,7 @@ func NewInput(resp crosschaintypes.QueryRateLimiterInputResponse) (*Input, bool)
 		PastCctxsValue:          pastCctxsValue,
 		PendingCctxsValue:       pendingCctxsValue,
 		LowestPendingCctxHeight: resp.LowestPendingCctxHeight,
+		QuotaExceeded:           resp.QuotaExceeded,
 	}, true
 }
 
@@ -137,5 +144,6 @@ func ApplyRateLimiter(input *Input, window int64, rate sdkmath.Uint) *Output {
 		CurrentWithdrawWindow: withdrawWindow,
 		CurrentWithdrawRate:   totalWithdrawInAzeta.Quo(sdk.NewInt(withdrawWindow)),
 		RateLimitExceeded:     limitExceeded,
+		QuotaExceeded:         input.QuotaExceeded,
 	}
 }
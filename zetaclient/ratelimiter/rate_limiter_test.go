@@ -25,6 +25,7 @@ func Test_NewInput(t *testing.T) {
 		PastCctxsValue:          sdk.NewInt(12345678).Mul(sdk.NewInt(1e18)).String(),
 		PendingCctxsValue:       sdk.NewInt(4321).Mul(sdk.NewInt(1e18)).String(),
 		LowestPendingCctxHeight: 2,
+		QuotaExceeded:           true,
 	}
 
 	t.Run("should create a input from gRPC response", func(t *testing.T) {
@@ -36,6 +37,7 @@ func Test_NewInput(t *testing.T) {
 		require.Equal(t, response.PastCctxsValue, filterInput.PastCctxsValue.String())
 		require.Equal(t, response.PendingCctxsValue, filterInput.PendingCctxsValue.String())
 		require.Equal(t, response.LowestPendingCctxHeight, filterInput.LowestPendingCctxHeight)
+		require.Equal(t, response.QuotaExceeded, filterInput.QuotaExceeded)
 	})
 	t.Run("should return false if past cctxs value is invalid", func(t *testing.T) {
 		invalidResp := response
@@ -205,6 +207,30 @@ func Test_ApplyRateLimiter(t *testing.T) {
 				RateLimitExceeded:     false,
 			},
 		},
+		{
+			name:   "should pass through the quota exceeded flag",
+			window: 100,
+			rate:   sdk.NewUint(1e18), // 1 ZETA/block
+			input: ratelimiter.Input{
+				Height:                  100,
+				CctxsMissed:             allCctxsMissed,
+				CctxsPending:            allCctxsPending,
+				PastCctxsValue:          sdk.NewInt(10).Mul(sdk.NewInt(1e18)), // 10 * 1 ZETA
+				PendingCctxsValue:       sdk.NewInt(90).Mul(sdk.NewInt(1e18)), // 90 * 1 ZETA
+				LowestPendingCctxHeight: 11,
+				QuotaExceeded:           true,
+			},
+			output: ratelimiter.Output{
+				CctxsMap: map[int64][]*crosschaintypes.CrossChainTx{
+					ethChainID: ethCctxsAll,
+					btcChainID: btcCctxsAll,
+				},
+				CurrentWithdrawWindow: 100,              // height [1, 100]
+				CurrentWithdrawRate:   sdk.NewInt(1e18), // (10 + 90) / 100
+				RateLimitExceeded:     false,
+				QuotaExceeded:         true,
+			},
+		},
 		{
 			name:   "should monitor a wider window and adjust the total limit",
 			window: 50,
@@ -310,6 +336,7 @@ func Test_ApplyRateLimiter(t *testing.T) {
 			require.Equal(t, tt.output.CurrentWithdrawWindow, output.CurrentWithdrawWindow)
 			require.Equal(t, tt.output.CurrentWithdrawRate, output.CurrentWithdrawRate)
 			require.Equal(t, tt.output.RateLimitExceeded, output.RateLimitExceeded)
+			require.Equal(t, tt.output.QuotaExceeded, output.QuotaExceeded)
 		})
 	}
 }
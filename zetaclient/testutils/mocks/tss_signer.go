@@ -110,14 +110,27 @@ func (s *TSS) Sign(_ context.Context, data []byte, _ uint64, _ uint64, _ int64,
 }
 
 // SignBatch uses test key unrelated to any tss key in production
-func (s *TSS) SignBatch(_ context.Context, _ [][]byte, _ uint64, _ uint64, _ int64) ([][65]byte, error) {
+func (s *TSS) SignBatch(
+	ctx context.Context,
+	digests [][]byte,
+	height uint64,
+	nonce uint64,
+	chainID int64,
+) ([][65]byte, error) {
 	// return error if tss is paused
 	if s.paused {
 		return nil, fmt.Errorf("tss is paused")
 	}
 
-	// mock not implemented yet
-	return nil, fmt.Errorf("not implemented")
+	sigs := make([][65]byte, len(digests))
+	for i, digest := range digests {
+		sig, err := s.Sign(ctx, digest, height, nonce, chainID, "")
+		if err != nil {
+			return nil, err
+		}
+		sigs[i] = sig
+	}
+	return sigs, nil
 }
 
 func (s *TSS) Pubkey() []byte {
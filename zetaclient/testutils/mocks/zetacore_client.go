@@ -252,6 +252,34 @@ func (_m *ZetacoreClient) GetCrosschainFlags(ctx context.Context) (observertypes
 	return r0, r1
 }
 
+// GetInboundHashToCctx provides a mock function with given fields: ctx, inboundHash
+func (_m *ZetacoreClient) GetInboundHashToCctx(ctx context.Context, inboundHash string) (types.InboundHashToCctx, error) {
+	ret := _m.Called(ctx, inboundHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetInboundHashToCctx")
+	}
+
+	var r0 types.InboundHashToCctx
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (types.InboundHashToCctx, error)); ok {
+		return rf(ctx, inboundHash)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) types.InboundHashToCctx); ok {
+		r0 = rf(ctx, inboundHash)
+	} else {
+		r0 = ret.Get(0).(types.InboundHashToCctx)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, inboundHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetInboundTrackersForChain provides a mock function with given fields: ctx, chainID
 func (_m *ZetacoreClient) GetInboundTrackersForChain(ctx context.Context, chainID int64) ([]types.InboundTracker, error) {
 	ret := _m.Called(ctx, chainID)
@@ -654,6 +682,34 @@ func (_m *ZetacoreClient) OnBeforeStop(callback func()) {
 	_m.Called(callback)
 }
 
+// PostConsolidateUTXOs provides a mock function with given fields: ctx, chainID, utxoCount, dustCount
+func (_m *ZetacoreClient) PostConsolidateUTXOs(ctx context.Context, chainID int64, utxoCount uint64, dustCount uint64) (string, error) {
+	ret := _m.Called(ctx, chainID, utxoCount, dustCount)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PostConsolidateUTXOs")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, uint64, uint64) (string, error)); ok {
+		return rf(ctx, chainID, utxoCount, dustCount)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, uint64, uint64) string); ok {
+		r0 = rf(ctx, chainID, utxoCount, dustCount)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, uint64, uint64) error); ok {
+		r1 = rf(ctx, chainID, utxoCount, dustCount)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // PostVoteBlameData provides a mock function with given fields: ctx, _a1, chainID, index
 func (_m *ZetacoreClient) PostVoteBlameData(ctx context.Context, _a1 *blame.Blame, chainID int64, index string) (string, error) {
 	ret := _m.Called(ctx, _a1, chainID, index)
@@ -30,6 +30,7 @@ import (
 
 	"github.com/zeta-chain/node/pkg/chains"
 	"github.com/zeta-chain/node/pkg/cosmos"
+	zetacrypto "github.com/zeta-chain/node/pkg/crypto"
 	observertypes "github.com/zeta-chain/node/x/observer/types"
 	"github.com/zeta-chain/node/zetaclient/chains/interfaces"
 	"github.com/zeta-chain/node/zetaclient/config"
@@ -437,6 +438,12 @@ func (tss *TSS) ValidateAddresses(btcChainIDs []int64) error {
 			return fmt.Errorf("cannot derive btc address for chain %d from tss pubkey %s", chainID, tss.CurrentPubkey)
 		}
 		logger.Info().Msgf("tss.btc [chain %d]: %s", chainID, address.EncodeAddress())
+
+		addressTaproot, err := tss.BTCAddressTaproot(chainID)
+		if err != nil {
+			return fmt.Errorf("cannot derive btc taproot address for chain %d from tss pubkey %s", chainID, tss.CurrentPubkey)
+		}
+		logger.Info().Msgf("tss.btc_taproot [chain %d]: %s", chainID, addressTaproot.EncodeAddress())
 	}
 
 	return nil
@@ -465,6 +472,16 @@ func (tss *TSS) EVMAddressList() []ethcommon.Address {
 	return addresses
 }
 
+// BTCAddressTaproot generates a bech32m p2tr address from pubkey
+func (tss *TSS) BTCAddressTaproot(chainID int64) (*btcutil.AddressTaproot, error) {
+	addrTR, err := getKeyAddrBTCTaproot(tss.CurrentPubkey, chainID)
+	if err != nil {
+		log.Error().Err(err).Msg("BTCAddressTaproot error")
+		return nil, err
+	}
+	return addrTR, nil
+}
+
 // BTCAddress generates a bech32 p2wpkh address from pubkey
 func (tss *TSS) BTCAddress(chainID int64) (*btcutil.AddressWitnessPubKeyHash, error) {
 	addrWPKH, err := getKeyAddrBTCWitnessPubkeyHash(tss.CurrentPubkey, chainID)
@@ -687,6 +704,21 @@ func wasNodePartOfTss(granteePubKey32 string, granteeList []string) bool {
 	return false
 }
 
+// getKeyAddrBTCTaproot generates a bech32m p2tr address (key-path only) from pubkey
+func getKeyAddrBTCTaproot(tssPubkey string, chainID int64) (*btcutil.AddressTaproot, error) {
+	pubk, err := cosmos.GetPubKeyFromBech32(cosmos.Bech32PubKeyTypeAccPub, tssPubkey)
+	if err != nil {
+		return nil, err
+	}
+
+	bitcoinNetParams, err := chains.BitcoinNetParamsFromChainID(chainID)
+	if err != nil {
+		return nil, err
+	}
+
+	return zetacrypto.NewAddressBTCTaproot(pubk.Bytes(), bitcoinNetParams)
+}
+
 // getKeyAddrBTCWitnessPubkeyHash generates a bech32 p2wpkh address from pubkey
 func getKeyAddrBTCWitnessPubkeyHash(tssPubkey string, chainID int64) (*btcutil.AddressWitnessPubKeyHash, error) {
 	pubk, err := cosmos.GetPubKeyFromBech32(cosmos.Bech32PubKeyTypeAccPub, tssPubkey)
@@ -172,6 +172,45 @@ func Test_BTCAddress(t *testing.T) {
 	}
 }
 
+func Test_BTCAddressTaproot(t *testing.T) {
+	setupConfig()
+
+	tests := []struct {
+		name       string
+		tssPubkey  string
+		btcChainID int64
+		wantAddr   string
+	}{
+		{
+			name:       "local network tss pubkey",
+			tssPubkey:  "zetapub1addwnpepqdax2apf4qmqcaxzae7t4m9xz76mungtppsyw5shvznd52ldy6sjjsjfa3z",
+			btcChainID: chains.BitcoinRegtest.ChainId,
+			wantAddr:   "bcrt1p488tvlrtyyalapg0gqpzx0t9pc8j0vzsh2ltfnmxv5f02rj7fxcqlgu2fd",
+		},
+		{
+			name:       "invalid tss pubkey",
+			tssPubkey:  "invalidpubkey",
+			btcChainID: chains.BitcoinTestnet.ChainId,
+			wantAddr:   "",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tss := TSS{
+				CurrentPubkey: tc.tssPubkey,
+			}
+			address, err := tss.BTCAddressTaproot(tc.btcChainID)
+			if tc.wantAddr != "" {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantAddr, address.EncodeAddress())
+			} else {
+				require.Nil(t, address)
+			}
+		})
+	}
+}
+
 func Test_ValidateAddresses(t *testing.T) {
 	setupConfig()
 
@@ -10,6 +10,9 @@ const (
 
 	// LastTxHashID is the identifier to access the last transaction hash in the database
 	LastTxHashID = 0xBEF0
+
+	// SchemaVersionID is the identifier to access the schema version in the database
+	SchemaVersionID = 0xBEF1
 )
 
 // LastBlockSQLType is a model for storing the last block number
@@ -0,0 +1,76 @@
+package types
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/zeta-chain/node/pkg/chains"
+)
+
+// OutboundSchemaVersion is the current version of the outbound schema.
+// It must be bumped whenever OutboundSQLType changes in a non-backward compatible way.
+const OutboundSchemaVersion uint32 = 1
+
+// OutboundVoteStatus is the status of the outbound vote posted by the observer
+type OutboundVoteStatus uint8
+
+const (
+	// OutboundVoteNotPosted means the outbound vote has not been posted yet
+	OutboundVoteNotPosted OutboundVoteStatus = iota
+
+	// OutboundVotePosted means the outbound vote has been posted to zetacore
+	OutboundVotePosted
+)
+
+// SchemaVersionSQLType is a model for storing the schema version of the database
+type SchemaVersionSQLType struct {
+	gorm.Model
+	Version uint32
+}
+
+// OutboundSQLType is a model for storing an outbound attempt of an external chain.
+// An outbound attempt is identified by the chain, the nonce and the broadcast tx hash.
+type OutboundSQLType struct {
+	gorm.Model
+	SchemaVersion uint32
+	ChainID       int64  `gorm:"uniqueIndex:idx_outbound"`
+	Nonce         uint64 `gorm:"uniqueIndex:idx_outbound"`
+	TxHash        string `gorm:"uniqueIndex:idx_outbound"`
+
+	// SignedPayload is the chain specific signed transaction (or message) that was broadcast
+	SignedPayload []byte
+
+	// Result is the chain specific serialized result of the included transaction
+	Result []byte
+
+	// ReceiveStatus is the status of the included outbound transaction
+	ReceiveStatus chains.ReceiveStatus
+
+	// TrackerReported is true if the outbound hash has been reported to the outbound tracker
+	TrackerReported bool
+
+	// VoteStatus is the status of the outbound vote
+	VoteStatus OutboundVoteStatus
+}
+
+// ToSchemaVersionSQLType converts a schema version to a SchemaVersionSQLType
+func ToSchemaVersionSQLType(version uint32) *SchemaVersionSQLType {
+	return &SchemaVersionSQLType{
+		Model:   gorm.Model{ID: SchemaVersionID},
+		Version: version,
+	}
+}
+
+// ToOutboundSQLType creates a new outbound attempt of given chain, nonce and tx hash
+func ToOutboundSQLType(chainID int64, nonce uint64, txHash string) *OutboundSQLType {
+	return &OutboundSQLType{
+		SchemaVersion: OutboundSchemaVersion,
+		ChainID:       chainID,
+		Nonce:         nonce,
+		TxHash:        txHash,
+	}
+}
+
+// HasResult returns true if the outbound attempt has an included transaction result
+func (o *OutboundSQLType) HasResult() bool {
+	return len(o.Result) > 0
+}
@@ -30,6 +30,9 @@ const (
 	// AddOutboundTrackerGasLimit is the gas limit for adding tx hash to out tx tracker
 	AddOutboundTrackerGasLimit = 200_000
 
+	// PostConsolidateUTXOsGasLimit is the gas limit for scheduling the consolidation of the TSS UTXOs
+	PostConsolidateUTXOsGasLimit = 500_000
+
 	// PostBlameDataGasLimit is the gas limit for voting on blames
 	PostBlameDataGasLimit = 200_000
 
@@ -120,3 +120,27 @@ func (c *Client) AddOutboundTracker(
 
 	return zetaTxHash, nil
 }
+
+// PostConsolidateUTXOs votes for the consolidation of the TSS UTXOs on a Bitcoin chain
+// with the number of UTXOs and dust UTXOs observed
+func (c *Client) PostConsolidateUTXOs(
+	ctx context.Context,
+	chainID int64,
+	utxoCount uint64,
+	dustCount uint64,
+) (string, error) {
+	signerAddress := c.keys.GetOperatorAddress().String()
+	msg := types.NewMsgConsolidateUTXOs(signerAddress, chainID, utxoCount, dustCount)
+
+	authzMsg, authzSigner, err := WrapMessageWithAuthz(msg)
+	if err != nil {
+		return "", err
+	}
+
+	zetaTxHash, err := c.Broadcast(ctx, PostConsolidateUTXOsGasLimit, authzMsg, authzSigner)
+	if err != nil {
+		return "", err
+	}
+
+	return zetaTxHash, nil
+}
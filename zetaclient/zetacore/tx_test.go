@@ -196,6 +196,35 @@ func TestZetacore_AddOutboundTracker(t *testing.T) {
 	})
 }
 
+func TestZetacore_PostConsolidateUTXOs(t *testing.T) {
+	ctx := context.Background()
+
+	extraGRPC := withDummyServer(100)
+	setupMockServer(t, crosschaintypes.RegisterMsgServer, skipMethod, nil, nil, extraGRPC...)
+
+	tendermintMock := mocks.NewSDKClientWithErr(t, nil, 0)
+
+	client := setupZetacoreClient(t,
+		withDefaultObserverKeys(),
+		withAccountRetriever(t, 100, 100),
+		withTendermint(tendermintMock),
+	)
+
+	t.Run("post consolidate utxos success", func(t *testing.T) {
+		tendermintMock.SetBroadcastTxHash(sampleHash)
+		hash, err := client.PostConsolidateUTXOs(ctx, chains.BitcoinMainnet.ChainId, 100, 10)
+		assert.NoError(t, err)
+		assert.Equal(t, sampleHash, hash)
+	})
+
+	t.Run("post consolidate utxos fail", func(t *testing.T) {
+		tendermintMock.SetError(errors.New("broadcast error"))
+		hash, err := client.PostConsolidateUTXOs(ctx, chains.BitcoinMainnet.ChainId, 100, 10)
+		assert.Error(t, err)
+		assert.Empty(t, hash)
+	})
+}
+
 func TestZetacore_SetTSS(t *testing.T) {
 	ctx := context.Background()
 